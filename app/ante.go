@@ -11,12 +11,16 @@ import (
 	bankKeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	// FeeGrant
 	feeGrantKeeper "cosmossdk.io/x/feegrant/keeper"
+	// Funders
+	fundersKeeper "github.com/KYVENetwork/chain/x/funders/keeper"
 	// Global
 	"github.com/KYVENetwork/chain/x/global"
 	globalKeeper "github.com/KYVENetwork/chain/x/global/keeper"
 	// IBC Core
 	ibcAnte "github.com/cosmos/ibc-go/v8/modules/core/ante"
 	ibcKeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
+	// Stakers
+	stakersKeeper "github.com/KYVENetwork/chain/x/stakers/keeper"
 	// Staking
 	stakingKeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 )
@@ -27,16 +31,20 @@ func NewAnteHandler(
 	accountKeeper authKeeper.AccountKeeper,
 	bankKeeper bankKeeper.Keeper,
 	feeGrantKeeper feeGrantKeeper.Keeper,
+	fundersKeeper fundersKeeper.Keeper,
 	globalKeeper globalKeeper.Keeper,
 	ibcKeeper *ibcKeeper.Keeper,
+	stakersKeeper *stakersKeeper.Keeper,
 	stakingKeeper *stakingKeeper.Keeper,
 	sigGasConsumer ante.SignatureVerificationGasConsumer,
 	signModeHandler *txsigning.HandlerMap,
 ) (sdk.AnteHandler, error) {
-	deductFeeDecorator := global.NewDeductFeeDecorator(accountKeeper, bankKeeper, feeGrantKeeper, globalKeeper, stakingKeeper)
+	deductFeeDecorator := global.NewDeductFeeDecorator(accountKeeper, bankKeeper, feeGrantKeeper, globalKeeper, stakingKeeper, fundersKeeper)
 
 	gasAdjustmentDecorator := global.NewGasAdjustmentDecorator(globalKeeper)
 
+	rateLimitDecorator := global.NewRateLimitDecorator(globalKeeper, stakersKeeper)
+
 	anteDecorators := []sdk.AnteDecorator{
 		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
 		gasAdjustmentDecorator,
@@ -51,6 +59,7 @@ func NewAnteHandler(
 		ante.NewSigGasConsumeDecorator(accountKeeper, sigGasConsumer),
 		ante.NewSigVerificationDecorator(accountKeeper, signModeHandler),
 		ante.NewIncrementSequenceDecorator(accountKeeper),
+		rateLimitDecorator,
 		ibcAnte.NewRedundantRelayDecorator(ibcKeeper),
 	}
 
@@ -81,7 +81,7 @@ import (
 	bundleskeeper "github.com/KYVENetwork/chain/x/bundles/keeper"
 	_ "github.com/KYVENetwork/chain/x/funders" // import for side-effects
 	funderskeeper "github.com/KYVENetwork/chain/x/funders/keeper"
-	_ "github.com/KYVENetwork/chain/x/global" // import for side-effects
+	"github.com/KYVENetwork/chain/x/global"
 	globalkeeper "github.com/KYVENetwork/chain/x/global/keeper"
 	_ "github.com/KYVENetwork/chain/x/liquid"
 	_ "github.com/KYVENetwork/chain/x/multi_coin_rewards" // import for side-effects
@@ -356,13 +356,18 @@ func New(
 	// Register legacy modules
 	app.registerIBCModules()
 
+	// Swap venues for the convert mode of the multi-coin rewards, governance selects the active one
+	app.MultiCoinRewardsKeeper.RegisterSwapVenue(CommunityPoolSwapVenueName, NewCommunityPoolSwapVenue(app.DistributionKeeper))
+
 	// Ante handler
 	anteHandler, err := NewAnteHandler(
 		app.AccountKeeper,
 		app.BankKeeper,
 		app.FeeGrantKeeper,
+		app.FundersKeeper,
 		app.GlobalKeeper,
 		app.IBCKeeper,
+		app.StakersKeeper,
 		app.StakingKeeper,
 		ante.DefaultSigVerificationGasConsumer,
 		app.txConfig.SignModeHandler(),
@@ -385,6 +390,10 @@ func New(
 
 	app.SetPostHandler(postHandler)
 
+	// The message router records the gas consumed before every message, which
+	// is used to weight the gas refunds of transactions with multiple messages.
+	app.MsgServiceRouter().SetCircuit(global.NewMsgGasTracker(app.GlobalKeeper))
+
 	// register streaming services
 	if err := app.RegisterStreamingServices(appOpts, app.kvStoreKeys()); err != nil {
 		return nil, err
@@ -425,6 +434,11 @@ func New(
 			app.Configurator(),
 			app.LiquidKeeper,
 			app.StakingKeeper,
+			app.PoolKeeper,
+			app.StakersKeeper,
+			app.BundlesKeeper,
+			app.FundersKeeper,
+			app.MultiCoinRewardsKeeper,
 		),
 	)
 
@@ -461,6 +475,11 @@ func (app *App) AppCodec() codec.Codec {
 	return app.appCodec
 }
 
+// TxConfig returns App's tx config.
+func (app *App) TxConfig() client.TxConfig {
+	return app.txConfig
+}
+
 // GetKey returns the KVStoreKey for the provided store key.
 func (app *App) GetKey(storeKey string) *storetypes.KVStoreKey {
 	kvStoreKey, ok := app.UnsafeFindStoreKey(storeKey).(*storetypes.KVStoreKey)
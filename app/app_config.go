@@ -191,6 +191,7 @@ var (
 		{Account: multicoinrewardstypes.ModuleName},
 		{Account: multicoinrewardstypes.MultiCoinRewardsRedistributionAccountName},
 		{Account: teamtypes.ModuleName},
+		{Account: teamtypes.TeamDelegationAccountName},
 		{Account: funderstypes.ModuleName},
 
 		// Liquid
@@ -222,6 +223,7 @@ var (
 
 		// We allow the following module accounts to receive funds:
 		// govtypes.ModuleName
+		// teamtypes.TeamDelegationAccountName, it receives staking rewards and unbonded tokens
 	}
 
 	// appConfig application configuration (used by depinject)
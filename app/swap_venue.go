@@ -0,0 +1,50 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/math"
+	globalTypes "github.com/KYVENetwork/chain/x/global/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CommunityPoolSwapVenueName is the name under which the community pool venue is registered
+// in the multi-coin rewards module. Governance enables it with the multi_coin_convert_venue param.
+const CommunityPoolSwapVenueName = "community_pool"
+
+type communityPoolKeeper interface {
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
+	DistributeFromFeePool(ctx context.Context, amount sdk.Coins, receiveAddr sdk.AccAddress) error
+}
+
+// CommunityPoolSwapVenue lets the community pool buy the non-native rewards. The community
+// pool pays the lowest amount the convert mode accepts, which is the reference price of the
+// funders module reduced by the max slippage of the denom. The swap fails if the community
+// pool does not hold enough native coins.
+type CommunityPoolSwapVenue struct {
+	distributionKeeper communityPoolKeeper
+}
+
+func NewCommunityPoolSwapVenue(distributionKeeper communityPoolKeeper) *CommunityPoolSwapVenue {
+	return &CommunityPoolSwapVenue{
+		distributionKeeper: distributionKeeper,
+	}
+}
+
+func (venue *CommunityPoolSwapVenue) Swap(ctx sdk.Context, sender sdk.AccAddress, coin sdk.Coin, minAmountOut math.Int) (math.Int, error) {
+	if !minAmountOut.IsPositive() {
+		return math.ZeroInt(), fmt.Errorf("proceeds of %s are zero", coin)
+	}
+
+	if err := venue.distributionKeeper.FundCommunityPool(ctx, sdk.NewCoins(coin), sender); err != nil {
+		return math.ZeroInt(), err
+	}
+
+	proceeds := sdk.NewCoins(sdk.NewCoin(globalTypes.Denom, minAmountOut))
+	if err := venue.distributionKeeper.DistributeFromFeePool(ctx, proceeds, sender); err != nil {
+		return math.ZeroInt(), err
+	}
+
+	return minAmountOut, nil
+}
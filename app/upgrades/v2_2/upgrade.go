@@ -5,8 +5,18 @@ import (
 	"fmt"
 
 	"cosmossdk.io/math"
+	bundleskeeper "github.com/KYVENetwork/chain/x/bundles/keeper"
+	bundlestypes "github.com/KYVENetwork/chain/x/bundles/types"
+	funderskeeper "github.com/KYVENetwork/chain/x/funders/keeper"
+	funderstypes "github.com/KYVENetwork/chain/x/funders/types"
 	liquidkeeper "github.com/KYVENetwork/chain/x/liquid/keeper"
 	liquidtypes "github.com/KYVENetwork/chain/x/liquid/types"
+	multicoinrewardskeeper "github.com/KYVENetwork/chain/x/multi_coin_rewards/keeper"
+	multicoinrewardstypes "github.com/KYVENetwork/chain/x/multi_coin_rewards/types"
+	poolkeeper "github.com/KYVENetwork/chain/x/pool/keeper"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	stakerskeeper "github.com/KYVENetwork/chain/x/stakers/keeper"
+	stakerstypes "github.com/KYVENetwork/chain/x/stakers/types"
 	stakingKeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 
 	"cosmossdk.io/log"
@@ -27,6 +37,11 @@ func CreateUpgradeHandler(
 	configurator module.Configurator,
 	liquidStakingKeeper *liquidkeeper.Keeper,
 	stakingKeeper *stakingKeeper.Keeper,
+	poolKeeper *poolkeeper.Keeper,
+	stakersKeeper *stakerskeeper.Keeper,
+	bundlesKeeper bundleskeeper.Keeper,
+	fundersKeeper funderskeeper.Keeper,
+	multiCoinRewardsKeeper multicoinrewardskeeper.Keeper,
 ) upgradetypes.UpgradeHandler {
 	return func(ctx context.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
 		sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -57,8 +72,59 @@ func CreateUpgradeHandler(
 			return nil, err
 		}
 
+		migrateCommissionRules(sdkCtx, poolKeeper, stakersKeeper)
+
+		// Initialize the new stakers params
+		stakersParams := stakersKeeper.GetParams(sdkCtx)
+		stakersParams.MaxStakeFractionSum = stakerstypes.DefaultMaxStakeFractionSum
+		stakersParams.InactiveValidatorGracePeriod = stakerstypes.DefaultInactiveValidatorGracePeriod
+		stakersKeeper.SetParams(sdkCtx, stakersParams)
+
+		// Initialize the new bundles params
+		bundlesParams := bundlesKeeper.GetParams(sdkCtx)
+		bundlesParams.ReputationWeight = bundlestypes.DefaultReputationWeight
+		bundlesKeeper.SetParams(sdkCtx, bundlesParams)
+
+		// Initialize the new funders params
+		fundersParams := fundersKeeper.GetParams(sdkCtx)
+		fundersParams.PriceReporters = []string{}
+		fundersParams.MaxPriceAge = funderstypes.DefaultMaxPriceAge
+		fundersParams.MinPriceReports = funderstypes.DefaultMinPriceReports
+		fundersParams.MaxCoinWeightChange = funderstypes.DefaultMaxCoinWeightChange
+		fundersParams.FundingLedgerRetention = funderstypes.DefaultFundingLedgerRetention
+		fundersKeeper.SetParams(sdkCtx, fundersParams)
+
+		// Initialize the new multi coin rewards params, the convert mode stays disabled
+		// until governance selects a swap venue
+		multiCoinRewardsParams := multiCoinRewardsKeeper.GetParams(sdkCtx)
+		multiCoinRewardsParams.MultiCoinConvertMaxAttempts = multicoinrewardstypes.DefaultMultiCoinConvertMaxAttempts
+		multiCoinRewardsParams.MultiCoinConvertMaxEntriesPerBlock = multicoinrewardstypes.DefaultMultiCoinConvertMaxEntriesPerBlock
+		multiCoinRewardsKeeper.SetParams(sdkCtx, multiCoinRewardsParams)
+
+		// Store the existing distribution policy as the first policy version
+		if err := multiCoinRewardsKeeper.MigrateMultiCoinDistributionPolicy(sdkCtx); err != nil {
+			return nil, err
+		}
+
 		logger.Info(fmt.Sprintf("finished upgrade %v", UpgradeName))
 
 		return migratedVersionMap, err
 	}
 }
+
+// migrateCommissionRules initializes the commission limits of all existing
+// pools and the commission rules of all existing pool accounts. As there
+// were no limits before, everything is set to the least restrictive value.
+func migrateCommissionRules(ctx sdk.Context, poolKeeper *poolkeeper.Keeper, stakersKeeper *stakerskeeper.Keeper) {
+	for _, pool := range poolKeeper.GetAllPools(ctx) {
+		pool.MaxCommission = pooltypes.DefaultMaxCommission
+		pool.MaxCommissionChangeRate = pooltypes.DefaultMaxCommissionChangeRate
+		poolKeeper.SetPool(ctx, pool)
+	}
+
+	for _, poolAccount := range stakersKeeper.GetAllPoolAccounts(ctx) {
+		poolAccount.MaxCommission = math.LegacyOneDec()
+		poolAccount.MaxCommissionChangeRate = math.LegacyOneDec()
+		stakersKeeper.SetPoolAccount(ctx, poolAccount)
+	}
+}
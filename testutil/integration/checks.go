@@ -14,6 +14,7 @@ import (
 	storeTypes "cosmossdk.io/store/types"
 
 	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	teamkeeper "github.com/KYVENetwork/chain/x/team/keeper"
 	teamtypes "github.com/KYVENetwork/chain/x/team/types"
 
 	"github.com/KYVENetwork/chain/x/funders"
@@ -51,7 +52,7 @@ func (suite *KeeperTestSuite) PerformValidityChecks() {
 	suite.VerifyBundlesGenesisImportExport()
 
 	// verify team module
-	// TODO(@troy): implement team funds integrity checks
+	suite.VerifyTeamModuleAssetsIntegrity()
 	suite.VerifyTeamGenesisImportExport()
 }
 
@@ -297,6 +298,41 @@ func (suite *KeeperTestSuite) VerifyBundlesGenesisImportExport() {
 // team module checks
 // =========================
 
+func (suite *KeeperTestSuite) VerifyTeamModuleAssetsIntegrity() {
+	info := suite.App().TeamKeeper.GetTeamInfo(suite.Ctx())
+
+	// the team module has to hold at least the required balance
+	Expect(info.TeamModuleBalance).To(BeNumerically(">=", info.RequiredModuleBalance))
+
+	// the issued allocation, which already accounts for full and partial clawbacks,
+	// can never exceed the team allocation
+	Expect(info.IssuedTeamAllocation).To(BeNumerically("<=", teamtypes.TEAM_ALLOCATION))
+
+	now := uint64(suite.Ctx().BlockTime().Unix())
+	for _, account := range suite.App().TeamKeeper.GetTeamVestingAccounts(suite.Ctx()) {
+		status := teamkeeper.GetVestingStatus(account, now)
+
+		Expect(account.GetPartialClawbackAmount()).To(BeNumerically("<=", account.TotalAllocation))
+		Expect(status.TotalUnlockedAmount).To(BeNumerically("<=", status.TotalVestedAmount))
+		Expect(account.UnlockedClaimed).To(BeNumerically("<=", status.TotalUnlockedAmount))
+		Expect(account.RewardsClaimed).To(BeNumerically("<=", account.TotalRewards))
+	}
+
+	// the tracked team delegations and unbondings can never exceed the actual
+	// delegations and unbondings of the team module
+	trackedDelegations := uint64(0)
+	for _, delegation := range suite.App().TeamKeeper.GetAllTeamDelegations(suite.Ctx()) {
+		trackedDelegations += delegation.Amount
+	}
+	Expect(trackedDelegations).To(BeNumerically("<=", info.TotalDelegated))
+
+	trackedUnbondings := uint64(0)
+	for _, unbonding := range suite.App().TeamKeeper.GetAllTeamUnbondings(suite.Ctx()) {
+		trackedUnbondings += unbonding.Amount
+	}
+	Expect(trackedUnbondings).To(BeNumerically("<=", info.TotalUnbonding))
+}
+
 func (suite *KeeperTestSuite) VerifyTeamGenesisImportExport() {
 	genState := team.ExportGenesis(suite.Ctx(), suite.App().TeamKeeper)
 
@@ -397,6 +433,12 @@ func (suite *KeeperTestSuite) VerifyFundersModuleAssetsIntegrity() {
 		expectedFundingStateTotalAmount = expectedFundingStateTotalAmount.Add(totalAmount...)
 	}
 
+	// the remaining escrow of matching campaigns is also held by the funders module
+	for _, campaign := range suite.App().FundersKeeper.GetAllMatchingCampaigns(suite.Ctx()) {
+		expectedBalance = expectedBalance.Add(campaign.Remaining...)
+		expectedFundingStateTotalAmount = expectedFundingStateTotalAmount.Add(campaign.Remaining...)
+	}
+
 	// total amount of fundings should be equal to the amount of the funders module account
 	moduleAcc := suite.App().AccountKeeper.GetModuleAccount(suite.Ctx(), funderstypes.ModuleName).GetAddress()
 	actualBalance := suite.App().BankKeeper.GetAllBalances(suite.Ctx(), moduleAcc)
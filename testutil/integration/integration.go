@@ -370,10 +370,17 @@ func (suite *KeeperTestSuite) CommitAfterSeconds(seconds uint64) {
 }
 
 func (suite *KeeperTestSuite) CommitAfter(t time.Duration) {
+	suite.finalizeBlock(t, nil)
+}
+
+// finalizeBlock executes and commits a block with the given transactions and
+// returns the results of the block.
+func (suite *KeeperTestSuite) finalizeBlock(t time.Duration, txs [][]byte) *abci.ResponseFinalizeBlock {
 	header := suite.ctx.BlockHeader()
 	header.Time = header.Time.Add(t)
 
-	_, err := suite.app.FinalizeBlock(&abci.RequestFinalizeBlock{
+	res, err := suite.app.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Txs:    txs,
 		Height: header.Height,
 		Time:   header.Time,
 		DecidedLastCommit: abci.CommitInfo{
@@ -392,6 +399,8 @@ func (suite *KeeperTestSuite) CommitAfter(t time.Duration) {
 	header.Height += 1
 
 	suite.ctx = suite.app.BaseApp.NewUncachedContext(false, header)
+
+	return res
 }
 
 func (suite *KeeperTestSuite) WaitSeconds(seconds uint64) {
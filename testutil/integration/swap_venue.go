@@ -0,0 +1,64 @@
+package integration
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/math"
+	globalTypes "github.com/KYVENetwork/chain/x/global/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type swapVenueBankKeeper interface {
+	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+}
+
+// MockSwapVenue is a local constant-product pool which swaps coins against the native denom.
+// The reserves of the pool are the balances of the reserve address.
+type MockSwapVenue struct {
+	bankKeeper swapVenueBankKeeper
+	Reserve    sdk.AccAddress
+}
+
+func NewMockSwapVenue(bankKeeper swapVenueBankKeeper, reserve sdk.AccAddress) *MockSwapVenue {
+	return &MockSwapVenue{
+		bankKeeper: bankKeeper,
+		Reserve:    reserve,
+	}
+}
+
+func (venue *MockSwapVenue) reserves(ctx sdk.Context, denom string) (math.Int, math.Int, error) {
+	balances := venue.bankKeeper.GetAllBalances(ctx, venue.Reserve)
+
+	reserveIn := balances.AmountOf(denom)
+	reserveOut := balances.AmountOf(globalTypes.Denom)
+	if reserveIn.IsZero() || reserveOut.IsZero() {
+		return math.ZeroInt(), math.ZeroInt(), fmt.Errorf("no liquidity for denom %s", denom)
+	}
+
+	return reserveIn, reserveOut, nil
+}
+
+func (venue *MockSwapVenue) Swap(ctx sdk.Context, sender sdk.AccAddress, coin sdk.Coin, minAmountOut math.Int) (math.Int, error) {
+	reserveIn, reserveOut, err := venue.reserves(ctx, coin.Denom)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	// x * y = k
+	amountOut := reserveOut.Mul(coin.Amount).Quo(reserveIn.Add(coin.Amount))
+	if amountOut.LT(minAmountOut) {
+		return math.ZeroInt(), fmt.Errorf("slippage exceeded: %s < %s", amountOut, minAmountOut)
+	}
+
+	if err := venue.bankKeeper.SendCoins(ctx, sender, venue.Reserve, sdk.NewCoins(coin)); err != nil {
+		return math.ZeroInt(), err
+	}
+
+	if err := venue.bankKeeper.SendCoins(ctx, venue.Reserve, sender, sdk.NewCoins(sdk.NewCoin(globalTypes.Denom, amountOut))); err != nil {
+		return math.ZeroInt(), err
+	}
+
+	return amountOut, nil
+}
@@ -1,7 +1,14 @@
 package integration
 
 import (
+	"context"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	clientTx "github.com/cosmos/cosmos-sdk/client/tx"
+	cryptoTypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authSigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 
 	. "github.com/onsi/gomega"
 )
@@ -87,3 +94,49 @@ func (suite *KeeperTestSuite) RunTxFundersError(msg sdk.Msg) {
 	_, err := suite.RunTx(msg)
 	Expect(err).To(HaveOccurred())
 }
+
+// DeliverTx signs the messages with the given private key and includes the
+// transaction in the next block. Other than RunTx the transaction passes the
+// ante and post handlers, so it is executed like every transaction of the
+// chain. The signer account has to exist already.
+func (suite *KeeperTestSuite) DeliverTx(privKey cryptoTypes.PrivKey, gasLimit uint64, fees sdk.Coins, msgs ...sdk.Msg) *abci.ExecTxResult {
+	txConfig := suite.app.TxConfig()
+	txBuilder := txConfig.NewTxBuilder()
+
+	Expect(txBuilder.SetMsgs(msgs...)).To(Succeed())
+	txBuilder.SetGasLimit(gasLimit)
+	txBuilder.SetFeeAmount(fees)
+
+	address := sdk.AccAddress(privKey.PubKey().Address())
+	account := suite.app.AccountKeeper.GetAccount(suite.ctx, address)
+	Expect(account).NotTo(BeNil())
+
+	signMode := signing.SignMode_SIGN_MODE_DIRECT
+
+	// The signer infos are part of the sign bytes, so they have to be set
+	// with an empty signature first.
+	Expect(txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey:   privKey.PubKey(),
+		Data:     &signing.SingleSignatureData{SignMode: signMode},
+		Sequence: account.GetSequence(),
+	})).To(Succeed())
+
+	signerData := authSigning.SignerData{
+		Address:       address.String(),
+		ChainID:       "kyve-test",
+		AccountNumber: account.GetAccountNumber(),
+		Sequence:      account.GetSequence(),
+		PubKey:        privKey.PubKey(),
+	}
+	signature, err := clientTx.SignWithPrivKey(context.Background(), signMode, signerData, txBuilder, privKey, txConfig, account.GetSequence())
+	Expect(err).NotTo(HaveOccurred())
+	Expect(txBuilder.SetSignatures(signature)).To(Succeed())
+
+	txBytes, err := txConfig.TxEncoder()(txBuilder.GetTx())
+	Expect(err).NotTo(HaveOccurred())
+
+	res := suite.finalizeBlock(0, [][]byte{txBytes})
+	Expect(res.TxResults).To(HaveLen(1))
+
+	return res.TxResults[0]
+}
@@ -41,6 +41,11 @@ func (k Keeper) GetMaxPoints(ctx sdk.Context) (res uint64) {
 	return k.GetParams(ctx).MaxPoints
 }
 
+// GetReputationWeight returns the ReputationWeight param
+func (k Keeper) GetReputationWeight(ctx sdk.Context) (res math.LegacyDec) {
+	return k.GetParams(ctx).ReputationWeight
+}
+
 // SetParams sets the x/bundles module parameters.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	_ = k.BundlesParams.Set(ctx, params)
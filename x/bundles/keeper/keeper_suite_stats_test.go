@@ -0,0 +1,349 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	bundletypes "github.com/KYVENetwork/chain/x/bundles/types"
+	funderstypes "github.com/KYVENetwork/chain/x/funders/types"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	stakertypes "github.com/KYVENetwork/chain/x/stakers/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+/*
+
+TEST CASES - stats
+
+* Produce a valid bundle and track the stats of uploader and voters
+* Produce an invalid bundle and track the stats of uploader and voters
+* Drop a bundle because no quorum was reached and track the stats of uploader and voters
+* Track an upload timeout of the next uploader
+* Scale the uploader selection power with the reliability score
+
+*/
+
+var _ = Describe("stats", Ordered, func() {
+	var s *i.KeeperTestSuite
+
+	getStats := func(staker string) stakertypes.PoolAccountStats {
+		stats, _ := s.App().StakersKeeper.GetPoolAccountStats(s.Ctx(), staker, 0)
+		return stats
+	}
+
+	BeforeEach(func() {
+		// init new clean chain
+		s = i.NewCleanChain()
+
+		// create clean pool for every test case
+		gov := s.App().GovKeeper.GetGovernanceAccount(s.Ctx()).GetAddress().String()
+		msg := &pooltypes.MsgCreatePool{
+			Authority:            gov,
+			Name:                 "PoolTest",
+			Runtime:              "@kyve/test",
+			Logo:                 "ar://Tewyv2P5VEG8EJ6AUQORdqNTectY9hlOrWPK8wwo-aU",
+			Config:               "ar://DgdB-2hLrxjhyEEbCML__dgZN5_uS7T6Z5XDkaFh3P0",
+			StartKey:             "0",
+			UploadInterval:       60,
+			InflationShareWeight: math.LegacyNewDec(10_000),
+			MinDelegation:        100 * i.KYVE,
+			MaxBundleSize:        100,
+			Version:              "0.0.0",
+			Binaries:             "{}",
+			StorageProviderId:    2,
+			CompressionId:        1,
+		}
+		s.RunTxPoolSuccess(msg)
+
+		s.RunTxFundersSuccess(&funderstypes.MsgCreateFunder{
+			Creator: i.ALICE,
+			Moniker: "Alice",
+		})
+
+		s.RunTxFundersSuccess(&funderstypes.MsgFundPool{
+			Creator:          i.ALICE,
+			PoolId:           0,
+			Amounts:          i.KYVECoins(100 * i.T_KYVE),
+			AmountsPerBundle: i.KYVECoins(1 * i.T_KYVE),
+		})
+
+		s.CreateValidator(i.STAKER_0, "Staker-0", int64(100*i.KYVE))
+
+		s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+			Creator:       i.STAKER_0,
+			PoolId:        0,
+			PoolAddress:   i.POOL_ADDRESS_0_A,
+			Commission:    math.LegacyMustNewDecFromStr("0.1"),
+			StakeFraction: math.LegacyMustNewDecFromStr("1"),
+		})
+
+		s.CreateValidator(i.STAKER_1, "Staker-1", int64(100*i.KYVE))
+
+		s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+			Creator:       i.STAKER_1,
+			PoolId:        0,
+			PoolAddress:   i.POOL_ADDRESS_1_A,
+			Commission:    math.LegacyMustNewDecFromStr("0.1"),
+			StakeFraction: math.LegacyMustNewDecFromStr("1"),
+		})
+
+		s.CreateValidator(i.STAKER_2, "Staker-2", int64(50*i.KYVE))
+
+		s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+			Creator:       i.STAKER_2,
+			PoolId:        0,
+			PoolAddress:   i.POOL_ADDRESS_2_A,
+			Commission:    math.LegacyMustNewDecFromStr("0.1"),
+			StakeFraction: math.LegacyMustNewDecFromStr("1"),
+		})
+
+		s.CommitAfterSeconds(60)
+
+		// Claim Uploader role for Staker 0
+		pool, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+		pool.NextUploader = i.STAKER_0
+		s.App().BundlesKeeper.SetBundleProposal(s.Ctx(), pool)
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgSubmitBundleProposal{
+			Creator:       i.POOL_ADDRESS_0_A,
+			Staker:        i.STAKER_0,
+			PoolId:        0,
+			StorageId:     "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			DataSize:      100,
+			DataHash:      "test_hash",
+			FromIndex:     0,
+			BundleSize:    100,
+			FromKey:       "test_key",
+			ToKey:         "test_key",
+			BundleSummary: "test_value",
+		})
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Produce a valid bundle and track the stats of uploader and voters", func() {
+		// ARRANGE
+		s.RunTxBundlesSuccess(&bundletypes.MsgVoteBundleProposal{
+			Creator:   i.POOL_ADDRESS_1_A,
+			Staker:    i.STAKER_1,
+			PoolId:    0,
+			StorageId: "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			Vote:      bundletypes.VOTE_TYPE_VALID,
+		})
+
+		s.CommitAfterSeconds(60)
+
+		// ACT
+		s.RunTxBundlesSuccess(&bundletypes.MsgSubmitBundleProposal{
+			Creator:       i.POOL_ADDRESS_0_A,
+			Staker:        i.STAKER_0,
+			PoolId:        0,
+			StorageId:     "P9edn0bjEfMU_lecFDIPLvGO2v2ltpFNUMWp5kgPddg",
+			DataSize:      100,
+			DataHash:      "test_hash",
+			FromIndex:     100,
+			BundleSize:    100,
+			FromKey:       "test_key",
+			ToKey:         "test_key",
+			BundleSummary: "test_value",
+		})
+
+		// ASSERT
+		Expect(getStats(i.STAKER_0)).To(Equal(stakertypes.PoolAccountStats{
+			Staker:           i.STAKER_0,
+			PoolId:           0,
+			BundlesUploaded:  1,
+			BundlesFinalized: 1,
+			VotesValid:       1,
+			VotesAgreed:      1,
+		}))
+
+		Expect(getStats(i.STAKER_1)).To(Equal(stakertypes.PoolAccountStats{
+			Staker:      i.STAKER_1,
+			PoolId:      0,
+			VotesValid:  1,
+			VotesAgreed: 1,
+		}))
+
+		Expect(getStats(i.STAKER_2)).To(Equal(stakertypes.PoolAccountStats{
+			Staker:      i.STAKER_2,
+			PoolId:      0,
+			MissedVotes: 1,
+		}))
+
+		Expect(s.App().StakersKeeper.GetReliabilityScore(s.Ctx(), i.STAKER_0, 0)).To(Equal(math.LegacyOneDec()))
+		Expect(s.App().StakersKeeper.GetReliabilityScore(s.Ctx(), i.STAKER_1, 0)).To(Equal(math.LegacyOneDec()))
+		Expect(s.App().StakersKeeper.GetReliabilityScore(s.Ctx(), i.STAKER_2, 0)).To(Equal(math.LegacyZeroDec()))
+	})
+
+	It("Produce an invalid bundle and track the stats of uploader and voters", func() {
+		// ARRANGE
+		s.RunTxBundlesSuccess(&bundletypes.MsgVoteBundleProposal{
+			Creator:   i.POOL_ADDRESS_1_A,
+			Staker:    i.STAKER_1,
+			PoolId:    0,
+			StorageId: "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			Vote:      bundletypes.VOTE_TYPE_INVALID,
+		})
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgVoteBundleProposal{
+			Creator:   i.POOL_ADDRESS_2_A,
+			Staker:    i.STAKER_2,
+			PoolId:    0,
+			StorageId: "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			Vote:      bundletypes.VOTE_TYPE_INVALID,
+		})
+
+		s.CommitAfterSeconds(60)
+
+		// ACT
+		s.RunTxBundlesSuccess(&bundletypes.MsgSubmitBundleProposal{
+			Creator:       i.POOL_ADDRESS_0_A,
+			Staker:        i.STAKER_0,
+			PoolId:        0,
+			StorageId:     "P9edn0bjEfMU_lecFDIPLvGO2v2ltpFNUMWp5kgPddg",
+			DataSize:      100,
+			DataHash:      "test_hash",
+			FromIndex:     100,
+			BundleSize:    100,
+			FromKey:       "test_key",
+			ToKey:         "test_key",
+			BundleSummary: "test_value",
+		})
+
+		// ASSERT
+		// the stats are kept although the uploader got removed from the pool
+		_, found := s.App().StakersKeeper.GetPoolAccount(s.Ctx(), i.STAKER_0, 0)
+		Expect(found).To(BeFalse())
+
+		Expect(getStats(i.STAKER_0)).To(Equal(stakertypes.PoolAccountStats{
+			Staker:          i.STAKER_0,
+			PoolId:          0,
+			BundlesUploaded: 1,
+			VotesValid:      1,
+			Slashes:         1,
+		}))
+
+		Expect(getStats(i.STAKER_1)).To(Equal(stakertypes.PoolAccountStats{
+			Staker:       i.STAKER_1,
+			PoolId:       0,
+			VotesInvalid: 1,
+			VotesAgreed:  1,
+		}))
+
+		Expect(getStats(i.STAKER_2)).To(Equal(stakertypes.PoolAccountStats{
+			Staker:       i.STAKER_2,
+			PoolId:       0,
+			VotesInvalid: 1,
+			VotesAgreed:  1,
+		}))
+
+		Expect(s.App().StakersKeeper.GetReliabilityScore(s.Ctx(), i.STAKER_0, 0)).To(Equal(math.LegacyZeroDec()))
+	})
+
+	It("Drop a bundle because no quorum was reached and track the stats of uploader and voters", func() {
+		// ARRANGE
+		s.RunTxBundlesSuccess(&bundletypes.MsgVoteBundleProposal{
+			Creator:   i.POOL_ADDRESS_2_A,
+			Staker:    i.STAKER_2,
+			PoolId:    0,
+			StorageId: "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			Vote:      bundletypes.VOTE_TYPE_ABSTAIN,
+		})
+
+		// ACT
+		s.CommitAfterSeconds(60)
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+		Expect(bundleProposal.StorageId).To(BeEmpty())
+
+		Expect(getStats(i.STAKER_0)).To(Equal(stakertypes.PoolAccountStats{
+			Staker:          i.STAKER_0,
+			PoolId:          0,
+			BundlesUploaded: 1,
+			VotesValid:      1,
+		}))
+
+		Expect(getStats(i.STAKER_1)).To(Equal(stakertypes.PoolAccountStats{
+			Staker:      i.STAKER_1,
+			PoolId:      0,
+			MissedVotes: 1,
+		}))
+
+		Expect(getStats(i.STAKER_2)).To(Equal(stakertypes.PoolAccountStats{
+			Staker:       i.STAKER_2,
+			PoolId:       0,
+			VotesAbstain: 1,
+		}))
+
+		// abstain votes are ignored in the reliability score
+		Expect(s.App().StakersKeeper.GetReliabilityScore(s.Ctx(), i.STAKER_0, 0)).To(Equal(math.LegacyZeroDec()))
+		Expect(s.App().StakersKeeper.GetReliabilityScore(s.Ctx(), i.STAKER_2, 0)).To(Equal(math.LegacyOneDec()))
+	})
+
+	It("Track an upload timeout of the next uploader", func() {
+		// ARRANGE
+		s.RunTxBundlesSuccess(&bundletypes.MsgVoteBundleProposal{
+			Creator:   i.POOL_ADDRESS_1_A,
+			Staker:    i.STAKER_1,
+			PoolId:    0,
+			StorageId: "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			Vote:      bundletypes.VOTE_TYPE_VALID,
+		})
+
+		s.RunTxBundlesSuccess(&bundletypes.MsgVoteBundleProposal{
+			Creator:   i.POOL_ADDRESS_2_A,
+			Staker:    i.STAKER_2,
+			PoolId:    0,
+			StorageId: "y62A3tfbSNcNYDGoL-eXwzyV-Zc9Q0OVtDvR1biJmNI",
+			Vote:      bundletypes.VOTE_TYPE_VALID,
+		})
+
+		bundleProposal, _ := s.App().BundlesKeeper.GetBundleProposal(s.Ctx(), 0)
+		bundleProposal.NextUploader = i.STAKER_1
+		s.App().BundlesKeeper.SetBundleProposal(s.Ctx(), bundleProposal)
+
+		// ACT
+		s.CommitAfterSeconds(s.App().BundlesKeeper.GetUploadTimeout(s.Ctx()))
+		s.CommitAfterSeconds(60)
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		Expect(getStats(i.STAKER_1).Timeouts).To(Equal(uint64(1)))
+		Expect(getStats(i.STAKER_1).MissedVotes).To(BeZero())
+
+		Expect(getStats(i.STAKER_2).Timeouts).To(BeZero())
+		Expect(getStats(i.STAKER_2).MissedVotes).To(BeZero())
+	})
+
+	It("Scale the uploader selection power with the reliability score", func() {
+		// ARRANGE
+		params := s.App().BundlesKeeper.GetParams(s.Ctx())
+		params.ReputationWeight = math.LegacyMustNewDecFromStr("0.5")
+		s.App().BundlesKeeper.SetParams(s.Ctx(), params)
+
+		s.App().StakersKeeper.SetPoolAccountStats(s.Ctx(), stakertypes.PoolAccountStats{
+			Staker:     i.STAKER_1,
+			PoolId:     0,
+			VotesValid: 1,
+		})
+
+		// ACT
+		vs := s.App().BundlesKeeper.LoadRoundRobinValidatorSet(s.Ctx(), 0)
+
+		// ASSERT
+		powers := make(map[string]int64)
+		for _, validator := range vs.Validators {
+			powers[validator.Address] = validator.Power
+		}
+
+		// staker 0 and staker 2 have a perfect score, staker 1 a score of zero
+		Expect(powers[i.STAKER_0]).To(Equal(int64(100 * i.KYVE)))
+		Expect(powers[i.STAKER_1]).To(Equal(int64(50 * i.KYVE)))
+		Expect(powers[i.STAKER_2]).To(Equal(int64(50 * i.KYVE)))
+	})
+})
@@ -5,6 +5,7 @@ import (
 	"cosmossdk.io/math"
 	"github.com/KYVENetwork/chain/util"
 	"github.com/KYVENetwork/chain/x/bundles/types"
+	fundersTypes "github.com/KYVENetwork/chain/x/funders/types"
 	globalTypes "github.com/KYVENetwork/chain/x/global/types"
 	poolTypes "github.com/KYVENetwork/chain/x/pool/types"
 	stakersTypes "github.com/KYVENetwork/chain/x/stakers/types"
@@ -221,11 +222,49 @@ func (k Keeper) handleNonVoters(ctx sdk.Context, poolId uint64) {
 
 	for _, staker := range k.stakerKeeper.GetAllStakerAddressesOfPool(ctx, poolId) {
 		if !voters[staker] {
+			k.stakerKeeper.UpdatePoolAccountStats(ctx, staker, poolId, func(stats *stakersTypes.PoolAccountStats) {
+				stats.MissedVotes += 1
+			})
 			k.addPoint(ctx, staker, poolId)
 		}
 	}
 }
 
+// updatePoolAccountStats updates the performance stats of the uploader and all voters
+// of a bundle proposal once the outcome of the proposal is known
+func (k Keeper) updatePoolAccountStats(ctx sdk.Context, bundleProposal types.BundleProposal, poolId uint64, status types.BundleStatus) {
+	k.stakerKeeper.UpdatePoolAccountStats(ctx, bundleProposal.Uploader, poolId, func(stats *stakersTypes.PoolAccountStats) {
+		stats.BundlesUploaded += 1
+		if status == types.BUNDLE_STATUS_VALID {
+			stats.BundlesFinalized += 1
+		}
+	})
+
+	for _, voter := range bundleProposal.VotersValid {
+		k.stakerKeeper.UpdatePoolAccountStats(ctx, voter, poolId, func(stats *stakersTypes.PoolAccountStats) {
+			stats.VotesValid += 1
+			if status == types.BUNDLE_STATUS_VALID {
+				stats.VotesAgreed += 1
+			}
+		})
+	}
+
+	for _, voter := range bundleProposal.VotersInvalid {
+		k.stakerKeeper.UpdatePoolAccountStats(ctx, voter, poolId, func(stats *stakersTypes.PoolAccountStats) {
+			stats.VotesInvalid += 1
+			if status == types.BUNDLE_STATUS_INVALID {
+				stats.VotesAgreed += 1
+			}
+		})
+	}
+
+	for _, voter := range bundleProposal.VotersAbstain {
+		k.stakerKeeper.UpdatePoolAccountStats(ctx, voter, poolId, func(stats *stakersTypes.PoolAccountStats) {
+			stats.VotesAbstain += 1
+		})
+	}
+}
+
 // calculatePayouts calculates the different payouts to treasury, uploader and delegators from the total payout
 // the pool module provides for this bundle round
 func (k Keeper) calculatePayouts(ctx sdk.Context, poolId uint64, totalPayout sdk.Coins) (bundleReward types.BundleReward) {
@@ -580,11 +619,19 @@ func (k Keeper) tallyBundleProposal(ctx sdk.Context, bundleProposal types.Bundle
 	// evaluate all votes and determine status based on the votes weighted with stake + delegation
 	voteDistribution := k.GetVoteDistribution(ctx, poolId)
 
+	// The performance of the uploader and the voters is tracked once the outcome
+	// can not fail anymore, since the stats are not reverted in the end block.
 	// Handle tally outcome
 	switch voteDistribution.Status {
 	case types.BUNDLE_STATUS_VALID:
-		// charge the funders of the pool
-		fundersPayout, err := k.fundersKeeper.ChargeFundersOfPool(ctx, poolId, poolTypes.ModuleName)
+		// the bundle gets the next id of the pool once it is finalized
+		pool, err := k.poolKeeper.GetPoolWithError(ctx, poolId)
+		if err != nil {
+			return types.TallyResult{}, err
+		}
+
+		// charge the funders of the pool whose funding constraints are met by the bundle
+		fundersPayout, err := k.fundersKeeper.ChargeFundersOfPool(ctx, poolId, pool.TotalBundles, poolTypes.ModuleName, getBundleQuality(bundleProposal, voteDistribution))
 		if err != nil {
 			return types.TallyResult{}, err
 		}
@@ -607,15 +654,18 @@ func (k Keeper) tallyBundleProposal(ctx sdk.Context, bundleProposal types.Bundle
 
 		// payout rewards to uploader through commission rewards
 		uploaderReward := bundleReward.UploaderCommission.Add(bundleReward.UploaderStorageCost...)
-		if err := k.stakerKeeper.PayoutAdditionalCommissionRewards(ctx, bundleProposal.Uploader, poolTypes.ModuleName, uploaderReward); err != nil {
+		if err := k.stakerKeeper.PayoutAdditionalCommissionRewards(ctx, bundleProposal.Uploader, poolId, poolTypes.ModuleName, uploaderReward); err != nil {
 			return types.TallyResult{}, err
 		}
 
 		// payout rewards to delegators through delegation rewards
-		if err := k.stakerKeeper.PayoutRewards(ctx, bundleProposal.Uploader, bundleReward.Delegation, poolTypes.ModuleName); err != nil {
+		if err := k.stakerKeeper.PayoutRewards(ctx, bundleProposal.Uploader, poolId, bundleReward.Delegation, poolTypes.ModuleName); err != nil {
 			return types.TallyResult{}, err
 		}
 
+		// track the performance of the uploader and the voters
+		k.updatePoolAccountStats(ctx, bundleProposal, poolId, voteDistribution.Status)
+
 		// slash stakers who voted incorrectly
 		for _, voter := range bundleProposal.VotersInvalid {
 			k.slashDelegatorsAndRemoveStaker(ctx, voter, poolId, stakersTypes.SLASH_TYPE_VOTE)
@@ -634,6 +684,9 @@ func (k Keeper) tallyBundleProposal(ctx sdk.Context, bundleProposal types.Bundle
 		// turned out to be incorrect.
 		// There this round needs to start again and the message-sender stays uploader.
 
+		// track the performance of the uploader and the voters
+		k.updatePoolAccountStats(ctx, bundleProposal, poolId, voteDistribution.Status)
+
 		// slash stakers who voted incorrectly - uploader receives upload slash
 		for _, voter := range bundleProposal.VotersValid {
 			if voter == bundleProposal.Uploader {
@@ -652,6 +705,8 @@ func (k Keeper) tallyBundleProposal(ctx sdk.Context, bundleProposal types.Bundle
 		}, nil
 	default:
 		// If the bundle is neither valid nor invalid the quorum has not been reached yet.
+		k.updatePoolAccountStats(ctx, bundleProposal, poolId, voteDistribution.Status)
+
 		return types.TallyResult{
 			Status:           types.TallyResultNoQuorum,
 			VoteDistribution: voteDistribution,
@@ -661,3 +716,20 @@ func (k Keeper) tallyBundleProposal(ctx sdk.Context, bundleProposal types.Bundle
 		}, nil
 	}
 }
+
+// getBundleQuality returns the properties of a bundle proposal which are
+// checked against the constraints of the fundings.
+func getBundleQuality(bundleProposal types.BundleProposal, voteDistribution types.VoteDistribution) fundersTypes.BundleQuality {
+	validVoteRatio := math.LegacyZeroDec()
+	if voteDistribution.Total > 0 {
+		validVoteRatio = math.LegacyNewDecFromInt(math.NewIntFromUint64(voteDistribution.Valid)).
+			Quo(math.LegacyNewDecFromInt(math.NewIntFromUint64(voteDistribution.Total)))
+	}
+
+	return fundersTypes.BundleQuality{
+		ValidVoteRatio: validVoteRatio,
+		ValidVoters:    uint64(len(bundleProposal.VotersValid)),
+		DataSize:       bundleProposal.DataSize,
+		BundleSize:     bundleProposal.BundleSize,
+	}
+}
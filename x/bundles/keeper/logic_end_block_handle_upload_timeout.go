@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/KYVENetwork/chain/x/bundles/types"
+	stakersTypes "github.com/KYVENetwork/chain/x/stakers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -53,6 +54,9 @@ func (k Keeper) HandleUploadTimeout(goCtx context.Context) {
 				// handle stakers who did not vote at all
 				k.handleNonVoters(ctx, pool.Id)
 
+				// track the performance of the uploader and the voters
+				k.updatePoolAccountStats(ctx, bundleProposal, pool.Id, voteDistribution.Status)
+
 				// Get next uploader from all pool stakers
 				nextUploader := k.chooseNextUploader(ctx, pool.Id)
 
@@ -114,6 +118,9 @@ func (k Keeper) HandleUploadTimeout(goCtx context.Context) {
 		// Now we increase the points of the pool account
 		// (if he is still active in the pool)
 		if _, active := k.stakerKeeper.GetPoolAccount(ctx, timedoutUploader, pool.Id); active {
+			k.stakerKeeper.UpdatePoolAccountStats(ctx, timedoutUploader, pool.Id, func(stats *stakersTypes.PoolAccountStats) {
+				stats.Timeouts += 1
+			})
 			k.addPoint(ctx, timedoutUploader, pool.Id)
 		}
 	}
@@ -26,6 +26,9 @@ Let $N$ denote the number of total validators and $n$ the index of the n-th vali
 The stake (+ delegation) of each validator for each round is given by
     $s(n, r)$
 
+If the reputation weight $w$ is set, the stake is additionally scaled with the reliability score $q(n)$
+of the validator in the pool, i.e. $s(n, r) * ((1 - w) + w * q(n))$.
+
 Then the total stake for round r is given by
     $S(r) = \sum_{i=1}^N s(i, r)$
 
@@ -73,17 +76,19 @@ func (k Keeper) LoadRoundRobinValidatorSet(ctx sdk.Context, poolId uint64) Round
 	totalDelegation := int64(0)
 	// Used for calculating the set difference of active validators and existing round-robin set
 	newValidators := make(map[string]bool, 0)
+	reputationWeight := k.GetReputationWeight(ctx)
 	// Add all current pool validators to the round-robin set
 	for _, address := range k.stakerKeeper.GetAllStakerAddressesOfPool(ctx, poolId) {
 		stake := k.stakerKeeper.GetValidatorPoolStake(ctx, address, poolId)
 		if stake > 0 {
 			// If a validator has no delegation do not add to the round-robin set. Validator is basically non-existent.
+			power := k.getRoundRobinPower(ctx, address, poolId, stake, reputationWeight)
 			vs.Validators = append(vs.Validators, RoundRobinValidatorPower{
 				Address: address,
-				Power:   int64(stake),
+				Power:   power,
 			})
 			vs.Progress[address] = 0
-			totalDelegation += int64(stake)
+			totalDelegation += power
 			newValidators[address] = true
 		}
 	}
@@ -113,6 +118,25 @@ func (k Keeper) LoadRoundRobinValidatorSet(ctx sdk.Context, poolId uint64) Round
 	return vs
 }
 
+// getRoundRobinPower returns the power of a validator in the round-robin set. If the reputation
+// weight is set, the stake of the validator is scaled with its reliability score in the pool.
+// The power never drops below one, so a validator with stake is always part of the set.
+func (k Keeper) getRoundRobinPower(ctx sdk.Context, staker string, poolId uint64, stake uint64, reputationWeight math.LegacyDec) int64 {
+	if !reputationWeight.IsPositive() {
+		return int64(stake)
+	}
+
+	score := k.stakerKeeper.GetReliabilityScore(ctx, staker, poolId)
+	factor := math.LegacyOneDec().Sub(reputationWeight).Add(reputationWeight.Mul(score))
+
+	power := factor.MulInt64(int64(stake)).TruncateInt64()
+	if power < 1 {
+		return 1
+	}
+
+	return power
+}
+
 // SaveRoundRobinValidatorSet saves the current round-robin progress for the given poolId to the KV-Store
 func (k Keeper) SaveRoundRobinValidatorSet(ctx sdk.Context, vs RoundRobinValidatorSet) {
 	roundRobinProgress := types.RoundRobinProgress{
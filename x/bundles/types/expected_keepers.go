@@ -38,6 +38,9 @@ type StakerKeeper interface {
 	IncrementPoints(ctx sdk.Context, stakerAddress string, poolId uint64) (newPoints uint64)
 	ResetPoints(ctx sdk.Context, stakerAddress string, poolId uint64) (previousPoints uint64)
 
+	UpdatePoolAccountStats(ctx sdk.Context, staker string, poolId uint64, update func(stats *stakersTypes.PoolAccountStats))
+	GetReliabilityScore(ctx sdk.Context, staker string, poolId uint64) math.LegacyDec
+
 	GetValidator(ctx sdk.Context, stakerAddress string) (stakingtypes.Validator, bool)
 	GetValidatorPoolCommission(ctx sdk.Context, staker string, poolId uint64) math.LegacyDec
 	GetValidatorPoolStake(ctx sdk.Context, staker string, poolId uint64) uint64
@@ -45,13 +48,13 @@ type StakerKeeper interface {
 	GetValidatorPoolStakes(ctx sdk.Context, poolId uint64, mustIncludeStakers ...string) map[string]uint64
 	IsVotingPowerTooHigh(ctx sdk.Context, poolId uint64) bool
 	Slash(ctx sdk.Context, poolId uint64, staker string, slashType stakersTypes.SlashType)
-	PayoutRewards(ctx sdk.Context, staker string, amount sdk.Coins, payerModuleName string) error
-	PayoutAdditionalCommissionRewards(ctx sdk.Context, validator string, payerModuleName string, amount sdk.Coins) error
+	PayoutRewards(ctx sdk.Context, staker string, poolId uint64, amount sdk.Coins, payerModuleName string) error
+	PayoutAdditionalCommissionRewards(ctx sdk.Context, validator string, poolId uint64, payerModuleName string, amount sdk.Coins) error
 }
 
 type FundersKeeper interface {
 	GetCoinWhitelistMap(ctx sdk.Context) (whitelist map[string]fundersTypes.WhitelistCoinEntry)
-	ChargeFundersOfPool(ctx sdk.Context, poolId uint64, recipient string) (payout sdk.Coins, err error)
+	ChargeFundersOfPool(ctx sdk.Context, poolId uint64, bundleId uint64, recipient string, quality fundersTypes.BundleQuality) (payout sdk.Coins, err error)
 }
 
 type TeamKeeper interface {
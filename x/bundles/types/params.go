@@ -18,18 +18,23 @@ var DefaultNetworkFee = math.LegacyMustNewDecFromStr("0.01")
 // DefaultMaxPoints ...
 var DefaultMaxPoints = uint64(24)
 
+// DefaultReputationWeight ...
+var DefaultReputationWeight = math.LegacyZeroDec()
+
 // NewParams creates a new Params instance
 func NewParams(
 	uploadTimeout uint64,
 	storageCosts []StorageCost,
 	networkFee math.LegacyDec,
 	maxPoints uint64,
+	reputationWeight math.LegacyDec,
 ) Params {
 	return Params{
-		UploadTimeout: uploadTimeout,
-		StorageCosts:  storageCosts,
-		NetworkFee:    networkFee,
-		MaxPoints:     maxPoints,
+		UploadTimeout:    uploadTimeout,
+		StorageCosts:     storageCosts,
+		NetworkFee:       networkFee,
+		MaxPoints:        maxPoints,
+		ReputationWeight: reputationWeight,
 	}
 }
 
@@ -40,6 +45,7 @@ func DefaultParams() Params {
 		DefaultStorageCosts,
 		DefaultNetworkFee,
 		DefaultMaxPoints,
+		DefaultReputationWeight,
 	)
 }
 
@@ -63,5 +69,9 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := util.ValidatePercentage(p.ReputationWeight); err != nil {
+		return err
+	}
+
 	return nil
 }
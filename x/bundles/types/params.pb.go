@@ -82,6 +82,10 @@ type Params struct {
 	NetworkFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=network_fee,json=networkFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"network_fee"`
 	// max_points ...
 	MaxPoints uint64 `protobuf:"varint,4,opt,name=max_points,json=maxPoints,proto3" json:"max_points,omitempty"`
+	// reputation_weight is the weight of the reliability score of a staker
+	// in the uploader selection. With zero the uploader is selected only
+	// based on stake, with one the stake is fully scaled by the score.
+	ReputationWeight cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=reputation_weight,json=reputationWeight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"reputation_weight"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -146,30 +150,32 @@ func init() {
 func init() { proto.RegisterFile("kyve/bundles/v1beta1/params.proto", fileDescriptor_cfd3a74b72a01aaa) }
 
 var fileDescriptor_cfd3a74b72a01aaa = []byte{
-	// 364 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xcd, 0x4a, 0xeb, 0x40,
-	0x14, 0xc7, 0x93, 0x36, 0x14, 0x3a, 0xbd, 0xbd, 0x70, 0x73, 0xbb, 0x08, 0x8a, 0xe9, 0x87, 0x08,
-	0x5d, 0xc8, 0x0c, 0xd5, 0x85, 0xfb, 0x5a, 0x0b, 0x62, 0x91, 0x12, 0x45, 0xd0, 0x4d, 0x98, 0x24,
-	0x63, 0x1a, 0xda, 0xe4, 0x84, 0xcc, 0x24, 0xb6, 0x6f, 0xe1, 0x63, 0x75, 0xd9, 0xa5, 0xb8, 0x28,
-	0x92, 0xbe, 0x88, 0xe4, 0xc3, 0x8f, 0x85, 0x0b, 0x77, 0xc9, 0xff, 0xfc, 0xce, 0x99, 0xdf, 0xe1,
-	0xa0, 0xee, 0x7c, 0x95, 0x30, 0x62, 0xc5, 0x81, 0xb3, 0x60, 0x9c, 0x24, 0x03, 0x8b, 0x09, 0x3a,
-	0x20, 0x21, 0x8d, 0xa8, 0xcf, 0x71, 0x18, 0x81, 0x00, 0xb5, 0x95, 0x21, 0xb8, 0x44, 0x70, 0x89,
-	0xec, 0xb5, 0x5c, 0x70, 0x21, 0x07, 0x48, 0xf6, 0x55, 0xb0, 0xbd, 0x04, 0x35, 0x6e, 0x04, 0x44,
-	0xd4, 0x65, 0xe7, 0xc0, 0x85, 0x8a, 0xd1, 0x7f, 0x5e, 0xfc, 0x9a, 0x61, 0x04, 0x89, 0xe7, 0xb0,
-	0xc8, 0xf4, 0x1c, 0x4d, 0xee, 0xc8, 0xfd, 0xa6, 0xf1, 0xaf, 0x2c, 0x4d, 0xcb, 0xca, 0xa5, 0xa3,
-	0x9e, 0x21, 0xc5, 0x06, 0x2e, 0xb4, 0x4a, 0x47, 0xee, 0xd7, 0x87, 0x87, 0xeb, 0x6d, 0x5b, 0x7a,
-	0xdd, 0xb6, 0xf7, 0x6d, 0xe0, 0x3e, 0x70, 0xee, 0xcc, 0xb1, 0x07, 0xc4, 0xa7, 0x62, 0x86, 0x27,
-	0xcc, 0xa5, 0xf6, 0x6a, 0xc4, 0x6c, 0x23, 0x6f, 0xe8, 0xa5, 0x32, 0xaa, 0x4d, 0x73, 0x69, 0xf5,
-	0x08, 0xfd, 0x8d, 0xc3, 0x05, 0x50, 0xc7, 0x14, 0x9e, 0xcf, 0x20, 0x16, 0xf9, 0x73, 0x8a, 0xd1,
-	0x2c, 0xd2, 0xdb, 0x22, 0x54, 0x27, 0xa8, 0xf9, 0xa1, 0x96, 0x4d, 0xe0, 0x5a, 0xa5, 0x53, 0xed,
-	0x37, 0x4e, 0xba, 0xf8, 0xa7, 0x6d, 0xf1, 0xb7, 0xa5, 0x86, 0x4a, 0xa6, 0x65, 0xfc, 0xe1, 0x5f,
-	0x11, 0x57, 0x47, 0xa8, 0x11, 0x30, 0xf1, 0x04, 0xd1, 0xdc, 0x7c, 0x64, 0x4c, 0xab, 0xfe, 0xde,
-	0x1f, 0x95, 0x7d, 0x63, 0xc6, 0xd4, 0x03, 0x84, 0x7c, 0xba, 0x34, 0x43, 0xf0, 0x02, 0xc1, 0x35,
-	0x25, 0xd7, 0xae, 0xfb, 0x74, 0x39, 0xcd, 0x83, 0xe1, 0x78, 0x9d, 0xea, 0xf2, 0x26, 0xd5, 0xe5,
-	0xb7, 0x54, 0x97, 0x9f, 0x77, 0xba, 0xb4, 0xd9, 0xe9, 0xd2, 0xcb, 0x4e, 0x97, 0x1e, 0x8e, 0x5d,
-	0x4f, 0xcc, 0x62, 0x0b, 0xdb, 0xe0, 0x93, 0xab, 0xfb, 0xbb, 0x8b, 0xeb, 0x62, 0x26, 0xb1, 0x67,
-	0xd4, 0x0b, 0xc8, 0xf2, 0xf3, 0xbe, 0x62, 0x15, 0x32, 0x6e, 0xd5, 0xf2, 0x5b, 0x9d, 0xbe, 0x07,
-	0x00, 0x00, 0xff, 0xff, 0xc3, 0xde, 0x6b, 0xd2, 0xfc, 0x01, 0x00, 0x00,
+	// 392 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0xed, 0xd4, 0x54, 0xea, 0x86, 0x20, 0xba, 0xf4, 0x60, 0x81, 0x70, 0xd3, 0x22, 0xa4,
+	0x1c, 0xd0, 0xae, 0x0a, 0x07, 0xee, 0xa1, 0x54, 0x42, 0x54, 0xc8, 0x32, 0x08, 0x04, 0x17, 0x6b,
+	0x6d, 0x0f, 0xf6, 0x2a, 0xb5, 0xc7, 0xf2, 0xae, 0xdd, 0xe4, 0x2d, 0x78, 0x18, 0x1e, 0x22, 0xc7,
+	0x1c, 0x11, 0x87, 0x08, 0x25, 0x2f, 0x82, 0xbc, 0x36, 0x84, 0x03, 0x87, 0xdc, 0x76, 0xff, 0xf9,
+	0xff, 0x9d, 0x6f, 0xb4, 0x43, 0xce, 0x66, 0x8b, 0x06, 0x78, 0x54, 0x17, 0xc9, 0x0d, 0x28, 0xde,
+	0x5c, 0x44, 0xa0, 0xc5, 0x05, 0x2f, 0x45, 0x25, 0x72, 0xc5, 0xca, 0x0a, 0x35, 0xd2, 0x93, 0xd6,
+	0xc2, 0x7a, 0x0b, 0xeb, 0x2d, 0x0f, 0x4f, 0x52, 0x4c, 0xd1, 0x18, 0x78, 0x7b, 0xea, 0xbc, 0xe7,
+	0x0d, 0x19, 0xbe, 0xd7, 0x58, 0x89, 0x14, 0x5e, 0xa1, 0xd2, 0x94, 0x91, 0x07, 0xaa, 0xbb, 0x86,
+	0x65, 0x85, 0x8d, 0x4c, 0xa0, 0x0a, 0x65, 0xe2, 0xda, 0x63, 0x7b, 0x32, 0x0a, 0x8e, 0xfb, 0x92,
+	0xdf, 0x57, 0xde, 0x24, 0xf4, 0x25, 0x71, 0x62, 0x54, 0xda, 0x1d, 0x8c, 0xed, 0xc9, 0xd1, 0xf4,
+	0xc9, 0x72, 0x7d, 0x6a, 0xfd, 0x5c, 0x9f, 0x3e, 0x8a, 0x51, 0xe5, 0xa8, 0x54, 0x32, 0x63, 0x12,
+	0x79, 0x2e, 0x74, 0xc6, 0xae, 0x21, 0x15, 0xf1, 0xe2, 0x12, 0xe2, 0xc0, 0x04, 0xce, 0xbf, 0x0f,
+	0xc8, 0xa1, 0x6f, 0xa0, 0xe9, 0x53, 0x72, 0xaf, 0x2e, 0x6f, 0x50, 0x24, 0xa1, 0x96, 0x39, 0x60,
+	0xad, 0x4d, 0x3b, 0x27, 0x18, 0x75, 0xea, 0x87, 0x4e, 0xa4, 0xd7, 0x64, 0xf4, 0x07, 0xad, 0x7d,
+	0x41, 0xb9, 0x83, 0xf1, 0xc1, 0x64, 0xf8, 0xfc, 0x8c, 0xfd, 0x6f, 0x5a, 0xf6, 0xcf, 0x50, 0x53,
+	0xa7, 0xc5, 0x0a, 0xee, 0xaa, 0x9d, 0xa4, 0xe8, 0x25, 0x19, 0x16, 0xa0, 0x6f, 0xb1, 0x9a, 0x85,
+	0x5f, 0x01, 0xdc, 0x83, 0xfd, 0xf9, 0x49, 0x9f, 0xbb, 0x02, 0xa0, 0x8f, 0x09, 0xc9, 0xc5, 0x3c,
+	0x2c, 0x51, 0x16, 0x5a, 0xb9, 0x8e, 0xc1, 0x3e, 0xca, 0xc5, 0xdc, 0x37, 0x02, 0xf5, 0xc9, 0x71,
+	0x05, 0x65, 0xad, 0x85, 0x96, 0x58, 0x84, 0xb7, 0x20, 0xd3, 0x4c, 0xbb, 0x77, 0xf6, 0x6f, 0x75,
+	0x7f, 0x97, 0xfe, 0x64, 0xc2, 0xd3, 0xab, 0xe5, 0xc6, 0xb3, 0x57, 0x1b, 0xcf, 0xfe, 0xb5, 0xf1,
+	0xec, 0x6f, 0x5b, 0xcf, 0x5a, 0x6d, 0x3d, 0xeb, 0xc7, 0xd6, 0xb3, 0xbe, 0x3c, 0x4b, 0xa5, 0xce,
+	0xea, 0x88, 0xc5, 0x98, 0xf3, 0xb7, 0x9f, 0x3f, 0xbe, 0x7e, 0xd7, 0x51, 0xf2, 0x38, 0x13, 0xb2,
+	0xe0, 0xf3, 0xbf, 0x1b, 0xa3, 0x17, 0x25, 0xa8, 0xe8, 0xd0, 0xfc, 0xfe, 0x8b, 0xdf, 0x03, 0x00,
+	0x79, 0x4b, 0x33, 0xaa, 0x4e, 0x02, 0x00, 0x00,
 }
 
 func (m *StorageCost) Marshal() (dAtA []byte, err error) {
@@ -230,6 +236,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.ReputationWeight.Size()
+		i -= size
+		if _, err := m.ReputationWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	if m.MaxPoints != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.MaxPoints))
 		i--
@@ -312,6 +328,8 @@ func (m *Params) Size() (n int) {
 	if m.MaxPoints != 0 {
 		n += 1 + sovParams(uint64(m.MaxPoints))
 	}
+	l = m.ReputationWeight.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -559,6 +577,40 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputationWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReputationWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"cosmossdk.io/math"
+	"github.com/KYVENetwork/chain/x/funders/types"
 	flag "github.com/spf13/pflag"
 )
 
@@ -23,3 +25,67 @@ func flagSetFunderCreate() *flag.FlagSet {
 
 	return fs
 }
+
+const (
+	FlagFunder                    = "funder"
+	FlagCanFund                   = "can-fund"
+	FlagCanDefund                 = "can-defund"
+	FlagCanUpdateAmountsPerBundle = "can-update-amounts-per-bundle"
+	FlagSpendLimit                = "spend-limit"
+	FlagExpiration                = "expiration"
+	FlagUnlimited                 = "unlimited"
+)
+
+func flagSetFunderDelegateGrant() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.Bool(FlagCanFund, false, "Allow the delegate to fund pools on behalf of the funder")
+	fs.Bool(FlagCanDefund, false, "Allow the delegate to defund pools on behalf of the funder")
+	fs.Bool(FlagCanUpdateAmountsPerBundle, false, "Allow the delegate to update the amounts per bundle of fundings")
+	fs.StringArray(FlagSpendLimit, []string{}, "Spend limit of the delegate for a pool in the format [pool_id]:[amounts] (can be repeated)")
+	fs.Uint64(FlagExpiration, 0, "Unix timestamp after which the permissions expire (0 for no expiration)")
+	fs.Bool(FlagUnlimited, false, "Allow the delegate to fund every pool without spend limits")
+
+	return fs
+}
+
+const (
+	FlagMinValidVoteRatio = "min-valid-vote-ratio"
+	FlagMinValidVoters    = "min-valid-voters"
+	FlagMaxDataSizeRatio  = "max-data-size-ratio"
+)
+
+func flagSetFundingConstraints() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagMinValidVoteRatio, "", "Minimum ratio of valid voting power a bundle needs to be paid by the funding (ex. 0.8)")
+	fs.Uint64(FlagMinValidVoters, 0, "Minimum number of distinct valid voters a bundle needs to be paid by the funding")
+	fs.Uint64(FlagMaxDataSizeRatio, 0, "Maximum average size of a data item in bytes a bundle may have to be paid by the funding")
+
+	return fs
+}
+
+// parseFundingConstraints returns the funding constraints from the flags or nil
+// if none of the constraint flags were set.
+func parseFundingConstraints(fs *flag.FlagSet) (*types.FundingConstraints, error) {
+	if !fs.Changed(FlagMinValidVoteRatio) && !fs.Changed(FlagMinValidVoters) && !fs.Changed(FlagMaxDataSizeRatio) {
+		return nil, nil
+	}
+
+	constraints := types.FundingConstraints{
+		MinValidVoteRatio: math.LegacyZeroDec(),
+	}
+
+	if ratio, _ := fs.GetString(FlagMinValidVoteRatio); ratio != "" {
+		dec, err := math.LegacyNewDecFromStr(ratio)
+		if err != nil {
+			return nil, err
+		}
+		constraints.MinValidVoteRatio = dec
+	}
+
+	constraints.MinValidVoters, _ = fs.GetUint64(FlagMinValidVoters)
+	constraints.MaxDataSizeRatio, _ = fs.GetUint64(FlagMaxDataSizeRatio)
+
+	return &constraints, nil
+}
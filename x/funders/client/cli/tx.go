@@ -24,6 +24,13 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdUpdateFunder())
 	cmd.AddCommand(CmdFundPool())
 	cmd.AddCommand(CmdDefundPool())
+	cmd.AddCommand(CmdGrantFunderDelegate())
+	cmd.AddCommand(CmdRevokeFunderDelegate())
+	cmd.AddCommand(CmdReportCoinPrice())
+	cmd.AddCommand(CmdCreateMatchingCampaign())
+	cmd.AddCommand(CmdWithdrawMatchingCampaign())
+	cmd.AddCommand(CmdAttestFunder())
+	cmd.AddCommand(CmdRevokeFunderAttestation())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
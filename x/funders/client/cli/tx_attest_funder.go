@@ -0,0 +1,44 @@
+package cli
+
+import (
+	"github.com/KYVENetwork/chain/x/funders/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdAttestFunder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attest-funder [funder] [expiry] [reason]",
+		Short: "Broadcast message attest-funder",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argExpiry, err := cast.ToUint64E(args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgAttestFunder{
+				Creator: clientCtx.GetFromAddress().String(),
+				Funder:  args[0],
+				Reason:  args[2],
+				Expiry:  argExpiry,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,74 @@
+package cli
+
+import (
+	"strings"
+
+	"cosmossdk.io/math"
+	"github.com/KYVENetwork/chain/x/funders/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdCreateMatchingCampaign() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-matching-campaign [pool_ids] [match_ratio] [amount] [amount_per_bundle] [end_time]",
+		Short: "Broadcast message create-matching-campaign",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var argPoolIds []uint64
+			for _, rawPoolId := range strings.Split(args[0], ",") {
+				poolId, err := cast.ToUint64E(strings.TrimSpace(rawPoolId))
+				if err != nil {
+					return err
+				}
+				argPoolIds = append(argPoolIds, poolId)
+			}
+
+			argMatchRatio, err := math.LegacyNewDecFromStr(args[1])
+			if err != nil {
+				return err
+			}
+
+			argAmounts, err := sdk.ParseCoinsNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			argAmountsPerBundle, err := sdk.ParseCoinsNormalized(args[3])
+			if err != nil {
+				return err
+			}
+
+			argEndTime, err := cast.ToUint64E(args[4])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgCreateMatchingCampaign{
+				Creator:          clientCtx.GetFromAddress().String(),
+				PoolIds:          argPoolIds,
+				MatchRatio:       argMatchRatio,
+				Amounts:          argAmounts,
+				AmountsPerBundle: argAmountsPerBundle,
+				EndTime:          argEndTime,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
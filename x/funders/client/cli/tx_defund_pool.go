@@ -16,6 +16,8 @@ func CmdDefundPool() *cobra.Command {
 		Short: "Broadcast message defund-pool",
 		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			funder, _ := cmd.Flags().GetString(FlagFunder)
+
 			argId, err := cast.ToUint64E(args[0])
 			if err != nil {
 				return err
@@ -35,6 +37,7 @@ func CmdDefundPool() *cobra.Command {
 				Creator: clientCtx.GetFromAddress().String(),
 				PoolId:  argId,
 				Amounts: argAmounts,
+				Funder:  funder,
 			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -43,6 +46,7 @@ func CmdDefundPool() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagFunder, "", "Address of the funder on whose behalf the transaction is executed")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
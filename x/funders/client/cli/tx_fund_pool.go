@@ -16,6 +16,8 @@ func CmdFundPool() *cobra.Command {
 		Short: "Broadcast message fund-pool",
 		Args:  cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			funder, _ := cmd.Flags().GetString(FlagFunder)
+
 			argId, err := cast.ToUint64E(args[0])
 			if err != nil {
 				return err
@@ -29,6 +31,11 @@ func CmdFundPool() *cobra.Command {
 				return err
 			}
 
+			constraints, err := parseFundingConstraints(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
@@ -39,6 +46,8 @@ func CmdFundPool() *cobra.Command {
 				PoolId:           argId,
 				Amounts:          argAmounts,
 				AmountsPerBundle: argAmountsPerBundle,
+				Funder:           funder,
+				Constraints:      constraints,
 			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -47,6 +56,8 @@ func CmdFundPool() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagFunder, "", "Address of the funder on whose behalf the transaction is executed")
+	cmd.Flags().AddFlagSet(flagSetFundingConstraints())
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/KYVENetwork/chain/x/funders/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdGrantFunderDelegate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-funder-delegate [delegate]",
+		Short: "Broadcast message grant-funder-delegate",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			canFund, _ := cmd.Flags().GetBool(FlagCanFund)
+			canDefund, _ := cmd.Flags().GetBool(FlagCanDefund)
+			canUpdateAmountsPerBundle, _ := cmd.Flags().GetBool(FlagCanUpdateAmountsPerBundle)
+			rawSpendLimits, _ := cmd.Flags().GetStringArray(FlagSpendLimit)
+			expiration, _ := cmd.Flags().GetUint64(FlagExpiration)
+			unlimited, _ := cmd.Flags().GetBool(FlagUnlimited)
+
+			spendLimits := make([]types.FunderSpendLimit, 0, len(rawSpendLimits))
+			for _, rawSpendLimit := range rawSpendLimits {
+				parts := strings.SplitN(rawSpendLimit, ":", 2)
+				if len(parts) != 2 {
+					return fmt.Errorf("invalid spend limit %s, expected [pool_id]:[amounts]", rawSpendLimit)
+				}
+
+				poolId, err := cast.ToUint64E(parts[0])
+				if err != nil {
+					return err
+				}
+
+				amounts, err := sdk.ParseCoinsNormalized(parts[1])
+				if err != nil {
+					return err
+				}
+
+				spendLimits = append(spendLimits, types.FunderSpendLimit{
+					PoolId:  poolId,
+					Amounts: amounts,
+				})
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgGrantFunderDelegate{
+				Creator:                   clientCtx.GetFromAddress().String(),
+				Delegate:                  args[0],
+				CanFund:                   canFund,
+				CanDefund:                 canDefund,
+				CanUpdateAmountsPerBundle: canUpdateAmountsPerBundle,
+				SpendLimits:               spendLimits,
+				Expiration:                expiration,
+				Unlimited:                 unlimited,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetFunderDelegateGrant())
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
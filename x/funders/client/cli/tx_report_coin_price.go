@@ -0,0 +1,43 @@
+package cli
+
+import (
+	"cosmossdk.io/math"
+	"github.com/KYVENetwork/chain/x/funders/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+)
+
+func CmdReportCoinPrice() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "report-coin-price [denom] [price]",
+		Short: "Broadcast message report-coin-price",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argPrice, err := math.LegacyNewDecFromStr(args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgReportCoinPrice{
+				Creator: clientCtx.GetFromAddress().String(),
+				Denom:   args[0],
+				Price:   argPrice,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -19,6 +19,31 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, entry := range genState.FundingStateList {
 		k.SetFundingState(ctx, &entry)
 	}
+	for _, entry := range genState.FunderDelegateList {
+		k.SetFunderDelegate(ctx, &entry)
+	}
+	for _, entry := range genState.CoinPriceReportList {
+		k.SetCoinPriceReport(ctx, &entry)
+	}
+	for _, entry := range genState.OracleCoinWeightList {
+		k.SetOracleCoinWeight(ctx, &entry)
+	}
+	for _, entry := range genState.MatchingCampaignList {
+		k.SetMatchingCampaign(ctx, &entry)
+	}
+	k.SetMatchingCampaignCount(ctx, genState.MatchingCampaignCount)
+	for _, entry := range genState.FundingContributionList {
+		k.SetFundingContribution(ctx, &entry)
+	}
+	for _, entry := range genState.FunderAttestationList {
+		k.SetFunderAttestation(ctx, &entry)
+	}
+	for _, entry := range genState.FunderStatsList {
+		k.SetFunderStats(ctx, &entry)
+	}
+	for _, entry := range genState.MatchedFundingList {
+		k.SetMatchedFunding(ctx, &entry)
+	}
 }
 
 // ExportGenesis returns the module's exported genesis
@@ -28,6 +53,15 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.FunderList = k.GetAllFunders(ctx)
 	genesis.FundingList = k.GetAllFundings(ctx)
 	genesis.FundingStateList = k.GetAllFundingStates(ctx)
+	genesis.FunderDelegateList = k.GetAllFunderDelegates(ctx)
+	genesis.CoinPriceReportList = k.GetAllCoinPriceReports(ctx)
+	genesis.OracleCoinWeightList = k.GetAllOracleCoinWeights(ctx)
+	genesis.MatchingCampaignList = k.GetAllMatchingCampaigns(ctx)
+	genesis.MatchingCampaignCount = k.GetMatchingCampaignCount(ctx)
+	genesis.FundingContributionList = k.GetAllFundingContributions(ctx)
+	genesis.FunderAttestationList = k.GetAllFunderAttestations(ctx)
+	genesis.FunderStatsList = k.GetAllFunderStats(ctx)
+	genesis.MatchedFundingList = k.GetAllMatchedFundings(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 	return genesis
 }
@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	storeTypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/KYVENetwork/chain/x/funders/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetCoinPriceReportsOfDenom returns the latest price reports of all reporters for a coin
+func (k Keeper) GetCoinPriceReportsOfDenom(ctx sdk.Context, denom string) (reports []types.CoinPriceReport) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.CoinPriceReportKeyPrefix)
+
+	iterator := storeTypes.KVStorePrefixIterator(store, types.CoinPriceReportKeyIter(denom))
+	//goland:noinspection GoUnhandledErrorResult
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var report types.CoinPriceReport
+		k.cdc.MustUnmarshal(iterator.Value(), &report)
+
+		// skip reports of other denoms which share the same prefix
+		if report.Denom != denom {
+			continue
+		}
+
+		reports = append(reports, report)
+	}
+	return reports
+}
+
+// GetAllCoinPriceReports returns all price reports
+func (k Keeper) GetAllCoinPriceReports(ctx sdk.Context) (reports []types.CoinPriceReport) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.CoinPriceReportKeyPrefix)
+	iterator := storeTypes.KVStorePrefixIterator(store, []byte{})
+
+	//goland:noinspection GoUnhandledErrorResult
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.CoinPriceReport
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		reports = append(reports, val)
+	}
+
+	return reports
+}
+
+// SetCoinPriceReport sets the latest price report of a reporter in the store
+func (k Keeper) SetCoinPriceReport(ctx sdk.Context, report *types.CoinPriceReport) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.CoinPriceReportKeyPrefix)
+	b := k.cdc.MustMarshal(report)
+	store.Set(types.CoinPriceReportKey(
+		report.Denom,
+		report.Reporter,
+	), b)
+}
+
+// GetOracleCoinWeight returns the oracle coin weight of a coin
+func (k Keeper) GetOracleCoinWeight(ctx sdk.Context, denom string) (oracleCoinWeight types.OracleCoinWeight, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.OracleCoinWeightKeyPrefix)
+
+	b := store.Get(types.OracleCoinWeightKey(denom))
+	if b == nil {
+		return oracleCoinWeight, false
+	}
+
+	k.cdc.MustUnmarshal(b, &oracleCoinWeight)
+	return oracleCoinWeight, true
+}
+
+// GetAllOracleCoinWeights returns the oracle coin weights of all coins
+func (k Keeper) GetAllOracleCoinWeights(ctx sdk.Context) (oracleCoinWeights []types.OracleCoinWeight) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.OracleCoinWeightKeyPrefix)
+	iterator := storeTypes.KVStorePrefixIterator(store, []byte{})
+
+	//goland:noinspection GoUnhandledErrorResult
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.OracleCoinWeight
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		oracleCoinWeights = append(oracleCoinWeights, val)
+	}
+
+	return oracleCoinWeights
+}
+
+// SetOracleCoinWeight sets the oracle coin weight of a coin in the store
+func (k Keeper) SetOracleCoinWeight(ctx sdk.Context, oracleCoinWeight *types.OracleCoinWeight) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.OracleCoinWeightKeyPrefix)
+	b := k.cdc.MustMarshal(oracleCoinWeight)
+	store.Set(types.OracleCoinWeightKey(oracleCoinWeight.Denom), b)
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	storeTypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/KYVENetwork/chain/x/funders/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetFunderAttestation returns the attestation of a funder
+func (k Keeper) GetFunderAttestation(ctx sdk.Context, funderAddress string) (attestation types.FunderAttestation, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.FunderAttestationKeyPrefix)
+
+	b := store.Get(types.FunderAttestationKey(funderAddress))
+	if b == nil {
+		return attestation, false
+	}
+
+	k.cdc.MustUnmarshal(b, &attestation)
+	return attestation, true
+}
+
+// GetAllFunderAttestations returns the attestations of all funders
+func (k Keeper) GetAllFunderAttestations(ctx sdk.Context) (attestations []types.FunderAttestation) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.FunderAttestationKeyPrefix)
+	iterator := storeTypes.KVStorePrefixIterator(store, []byte{})
+
+	//goland:noinspection GoUnhandledErrorResult
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.FunderAttestation
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		attestations = append(attestations, val)
+	}
+
+	return attestations
+}
+
+// SetFunderAttestation sets the attestation of a funder in the store
+func (k Keeper) SetFunderAttestation(ctx sdk.Context, attestation *types.FunderAttestation) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.FunderAttestationKeyPrefix)
+	b := k.cdc.MustMarshal(attestation)
+	store.Set(types.FunderAttestationKey(attestation.FunderAddress), b)
+}
+
+// RemoveFunderAttestation removes the attestation of a funder from the store
+func (k Keeper) RemoveFunderAttestation(ctx sdk.Context, funderAddress string) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.FunderAttestationKeyPrefix)
+	store.Delete(types.FunderAttestationKey(funderAddress))
+}
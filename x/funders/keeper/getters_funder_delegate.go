@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	storeTypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/KYVENetwork/chain/x/funders/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetFunderDelegate returns the permissions of a delegate of a funder
+func (k Keeper) GetFunderDelegate(ctx sdk.Context, funderAddress string, delegateAddress string) (funderDelegate types.FunderDelegate, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.FunderDelegateKeyPrefix)
+
+	b := store.Get(types.FunderDelegateKey(
+		funderAddress,
+		delegateAddress,
+	))
+	if b == nil {
+		return funderDelegate, false
+	}
+
+	k.cdc.MustUnmarshal(b, &funderDelegate)
+	return funderDelegate, true
+}
+
+// GetFunderDelegatesOfFunder returns all delegates of a funder
+func (k Keeper) GetFunderDelegatesOfFunder(ctx sdk.Context, funderAddress string) (funderDelegates []types.FunderDelegate) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.FunderDelegateKeyPrefix)
+
+	iterator := storeTypes.KVStorePrefixIterator(store, types.FunderDelegateKeyIter(funderAddress))
+	//goland:noinspection GoUnhandledErrorResult
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var funderDelegate types.FunderDelegate
+		k.cdc.MustUnmarshal(iterator.Value(), &funderDelegate)
+		funderDelegates = append(funderDelegates, funderDelegate)
+	}
+	return funderDelegates
+}
+
+// GetAllFunderDelegates returns all delegates of all funders
+func (k Keeper) GetAllFunderDelegates(ctx sdk.Context) (funderDelegates []types.FunderDelegate) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.FunderDelegateKeyPrefix)
+	iterator := storeTypes.KVStorePrefixIterator(store, []byte{})
+
+	//goland:noinspection GoUnhandledErrorResult
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.FunderDelegate
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		funderDelegates = append(funderDelegates, val)
+	}
+
+	return funderDelegates
+}
+
+// SetFunderDelegate sets the permissions of a delegate in the store
+func (k Keeper) SetFunderDelegate(ctx sdk.Context, funderDelegate *types.FunderDelegate) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.FunderDelegateKeyPrefix)
+	b := k.cdc.MustMarshal(funderDelegate)
+	store.Set(types.FunderDelegateKey(
+		funderDelegate.Funder,
+		funderDelegate.Delegate,
+	), b)
+}
+
+// RemoveFunderDelegate removes the permissions of a delegate from the store
+func (k Keeper) RemoveFunderDelegate(ctx sdk.Context, funderAddress string, delegateAddress string) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.FunderDelegateKeyPrefix)
+	store.Delete(types.FunderDelegateKey(
+		funderAddress,
+		delegateAddress,
+	))
+}
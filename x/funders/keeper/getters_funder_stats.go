@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	storeTypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/KYVENetwork/chain/x/funders/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetFunderStats returns the lifetime stats of a funder. If the funder
+// has no stats yet, empty stats are returned.
+func (k Keeper) GetFunderStats(ctx sdk.Context, funderAddress string) (stats types.FunderStats) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.FunderStatsKeyPrefix)
+
+	b := store.Get(types.FunderStatsKey(funderAddress))
+	if b == nil {
+		return types.FunderStats{
+			FunderAddress:  funderAddress,
+			LifetimeFunded: sdk.NewCoins(),
+			PoolsFunded:    []uint64{},
+		}
+	}
+
+	k.cdc.MustUnmarshal(b, &stats)
+	return stats
+}
+
+// GetAllFunderStats returns the lifetime stats of all funders
+func (k Keeper) GetAllFunderStats(ctx sdk.Context) (stats []types.FunderStats) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.FunderStatsKeyPrefix)
+	iterator := storeTypes.KVStorePrefixIterator(store, []byte{})
+
+	//goland:noinspection GoUnhandledErrorResult
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.FunderStats
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		stats = append(stats, val)
+	}
+
+	return stats
+}
+
+// SetFunderStats sets the lifetime stats of a funder in the store
+func (k Keeper) SetFunderStats(ctx sdk.Context, stats *types.FunderStats) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.FunderStatsKeyPrefix)
+	b := k.cdc.MustMarshal(stats)
+	store.Set(types.FunderStatsKey(stats.FunderAddress), b)
+}
@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	storeTypes "cosmossdk.io/store/types"
+	"github.com/KYVENetwork/chain/util"
+	"github.com/KYVENetwork/chain/x/funders/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetFundingContributionsOfBundle returns the contributions of all funders to a bundle
+func (k Keeper) GetFundingContributionsOfBundle(ctx sdk.Context, poolId uint64, bundleId uint64) (contributions []types.FundingContribution) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.FundingContributionKeyPrefixByPool)
+
+	iterator := storeTypes.KVStorePrefixIterator(store, types.FundingContributionKeyByBundleIter(poolId, bundleId))
+	//goland:noinspection GoUnhandledErrorResult
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var contribution types.FundingContribution
+		k.cdc.MustUnmarshal(iterator.Value(), &contribution)
+		contributions = append(contributions, contribution)
+	}
+	return contributions
+}
+
+// GetAllFundingContributions returns all funding contributions
+func (k Keeper) GetAllFundingContributions(ctx sdk.Context) (contributions []types.FundingContribution) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.FundingContributionKeyPrefixByPool)
+	iterator := storeTypes.KVStorePrefixIterator(store, []byte{})
+
+	//goland:noinspection GoUnhandledErrorResult
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.FundingContribution
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		contributions = append(contributions, val)
+	}
+
+	return contributions
+}
+
+// SetFundingContribution sets a specific funding contribution in the store from its index
+func (k Keeper) SetFundingContribution(ctx sdk.Context, contribution *types.FundingContribution) {
+	b := k.cdc.MustMarshal(contribution)
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	storeByPool := prefix.NewStore(storeAdapter, types.FundingContributionKeyPrefixByPool)
+	storeByPool.Set(types.FundingContributionKeyByPool(
+		contribution.PoolId,
+		contribution.BundleId,
+		contribution.FunderAddress,
+	), b)
+
+	storeByFunder := prefix.NewStore(storeAdapter, types.FundingContributionKeyPrefixByFunder)
+	storeByFunder.Set(types.FundingContributionKeyByFunder(
+		contribution.FunderAddress,
+		contribution.PoolId,
+		contribution.BundleId,
+	), b)
+}
+
+// RemoveFundingContribution removes a funding contribution from the store
+func (k Keeper) RemoveFundingContribution(ctx sdk.Context, contribution *types.FundingContribution) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	storeByPool := prefix.NewStore(storeAdapter, types.FundingContributionKeyPrefixByPool)
+	storeByPool.Delete(types.FundingContributionKeyByPool(
+		contribution.PoolId,
+		contribution.BundleId,
+		contribution.FunderAddress,
+	))
+
+	storeByFunder := prefix.NewStore(storeAdapter, types.FundingContributionKeyPrefixByFunder)
+	storeByFunder.Delete(types.FundingContributionKeyByFunder(
+		contribution.FunderAddress,
+		contribution.PoolId,
+		contribution.BundleId,
+	))
+}
+
+// GetPaginatedFundingContributionsOfFunder returns the contributions of a funder
+// in a pool ordered by the bundle id.
+func (k Keeper) GetPaginatedFundingContributionsOfFunder(
+	ctx sdk.Context,
+	pagination *query.PageRequest,
+	funderAddress string,
+	poolId uint64,
+) ([]types.FundingContribution, *query.PageResponse, error) {
+	var contributions []types.FundingContribution
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, util.GetByteKey(types.FundingContributionKeyPrefixByFunder, funderAddress, poolId))
+
+	pageRes, err := query.Paginate(store, pagination, func(key []byte, value []byte) error {
+		var contribution types.FundingContribution
+		if err := k.cdc.Unmarshal(value, &contribution); err != nil {
+			return err
+		}
+
+		contributions = append(contributions, contribution)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return contributions, pageRes, nil
+}
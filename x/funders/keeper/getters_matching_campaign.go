@@ -0,0 +1,228 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	storeTypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/KYVENetwork/chain/x/funders/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetMatchingCampaignCount get the total number of matching campaigns
+func (k Keeper) GetMatchingCampaignCount(ctx sdk.Context) uint64 {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.MatchingCampaignCountKey)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetMatchingCampaignCount sets the total number of matching campaigns
+func (k Keeper) SetMatchingCampaignCount(ctx sdk.Context, count uint64) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(types.MatchingCampaignCountKey, bz)
+}
+
+// AppendMatchingCampaign appends a matching campaign in the store with a new id and updates the count
+func (k Keeper) AppendMatchingCampaign(ctx sdk.Context, matchingCampaign types.MatchingCampaign) uint64 {
+	count := k.GetMatchingCampaignCount(ctx)
+	// Set the ID of the appended value
+	matchingCampaign.Id = count
+
+	k.SetMatchingCampaign(ctx, &matchingCampaign)
+
+	// Update matching campaign count
+	k.SetMatchingCampaignCount(ctx, count+1)
+
+	return count
+}
+
+// GetMatchingCampaign returns a matching campaign by its id
+func (k Keeper) GetMatchingCampaign(ctx sdk.Context, id uint64) (matchingCampaign types.MatchingCampaign, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.MatchingCampaignKeyPrefix)
+
+	b := store.Get(types.MatchingCampaignKey(id))
+	if b == nil {
+		return matchingCampaign, false
+	}
+
+	k.cdc.MustUnmarshal(b, &matchingCampaign)
+	return matchingCampaign, true
+}
+
+// GetAllMatchingCampaigns returns all matching campaigns ordered by their id
+func (k Keeper) GetAllMatchingCampaigns(ctx sdk.Context) (matchingCampaigns []types.MatchingCampaign) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.MatchingCampaignKeyPrefix)
+	iterator := storeTypes.KVStorePrefixIterator(store, []byte{})
+
+	//goland:noinspection GoUnhandledErrorResult
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.MatchingCampaign
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		matchingCampaigns = append(matchingCampaigns, val)
+	}
+
+	return matchingCampaigns
+}
+
+// GetMatchingCampaignIdsOfPool returns the ids of all campaigns which can still
+// match fundings in the given pool ordered by their id
+func (k Keeper) GetMatchingCampaignIdsOfPool(ctx sdk.Context, poolId uint64) (ids []uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.MatchingCampaignKeyPrefixByPool)
+
+	iterator := storeTypes.KVStorePrefixIterator(store, types.MatchingCampaignKeyByPoolIter(poolId))
+	//goland:noinspection GoUnhandledErrorResult
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		ids = append(ids, binary.BigEndian.Uint64(key[len(key)-8:]))
+	}
+
+	return ids
+}
+
+// SetMatchingCampaign sets a matching campaign in the store. The campaign is
+// only indexed by its pools as long as it can match fundings, so exhausted and
+// expired campaigns are not iterated when a pool gets funded.
+func (k Keeper) SetMatchingCampaign(ctx sdk.Context, matchingCampaign *types.MatchingCampaign) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.MatchingCampaignKeyPrefix)
+	b := k.cdc.MustMarshal(matchingCampaign)
+	store.Set(types.MatchingCampaignKey(matchingCampaign.Id), b)
+
+	indexStore := prefix.NewStore(storeAdapter, types.MatchingCampaignKeyPrefixByPool)
+	active := matchingCampaign.IsActive(uint64(ctx.BlockTime().Unix()))
+	for _, poolId := range matchingCampaign.PoolIds {
+		if active {
+			indexStore.Set(types.MatchingCampaignKeyByPool(poolId, matchingCampaign.Id), []byte{1})
+		} else {
+			indexStore.Delete(types.MatchingCampaignKeyByPool(poolId, matchingCampaign.Id))
+		}
+	}
+}
+
+// GetMatchedFunding returns the amount a campaign matched for a funder in a pool
+func (k Keeper) GetMatchedFunding(ctx sdk.Context, poolId uint64, funderAddress string, campaignId uint64) (matchedFunding types.MatchedFunding, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.MatchedFundingKeyPrefixByFunder)
+
+	b := store.Get(types.MatchedFundingKeyByFunder(poolId, funderAddress, campaignId))
+	if b == nil {
+		return matchedFunding, false
+	}
+
+	k.cdc.MustUnmarshal(b, &matchedFunding)
+	return matchedFunding, true
+}
+
+// GetMatchedFundingsOfFunder returns all matched fundings of a funder in a pool
+func (k Keeper) GetMatchedFundingsOfFunder(ctx sdk.Context, poolId uint64, funderAddress string) (matchedFundings []types.MatchedFunding) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.MatchedFundingKeyPrefixByFunder)
+
+	iterator := storeTypes.KVStorePrefixIterator(store, types.MatchedFundingKeyByFunderIter(poolId, funderAddress))
+	//goland:noinspection GoUnhandledErrorResult
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.MatchedFunding
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		// addresses can be prefixes of other addresses
+		if val.Funder == funderAddress {
+			matchedFundings = append(matchedFundings, val)
+		}
+	}
+
+	return matchedFundings
+}
+
+// GetMatchedFundingsOfSponsor returns all matched fundings of all campaigns of
+// a sponsor in a pool
+func (k Keeper) GetMatchedFundingsOfSponsor(ctx sdk.Context, poolId uint64, sponsorAddress string) (matchedFundings []types.MatchedFunding) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.MatchedFundingKeyPrefixBySponsor)
+
+	iterator := storeTypes.KVStorePrefixIterator(store, types.MatchedFundingKeyBySponsorIter(poolId, sponsorAddress))
+	//goland:noinspection GoUnhandledErrorResult
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.MatchedFunding
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		// addresses can be prefixes of other addresses
+		if val.Sponsor == sponsorAddress {
+			matchedFundings = append(matchedFundings, val)
+		}
+	}
+
+	return matchedFundings
+}
+
+// GetAllMatchedFundings returns all matched fundings
+func (k Keeper) GetAllMatchedFundings(ctx sdk.Context) (matchedFundings []types.MatchedFunding) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.MatchedFundingKeyPrefixBySponsor)
+	iterator := storeTypes.KVStorePrefixIterator(store, []byte{})
+
+	//goland:noinspection GoUnhandledErrorResult
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.MatchedFunding
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		matchedFundings = append(matchedFundings, val)
+	}
+
+	return matchedFundings
+}
+
+// SetMatchedFunding sets a matched funding in the store
+func (k Keeper) SetMatchedFunding(ctx sdk.Context, matchedFunding *types.MatchedFunding) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	b := k.cdc.MustMarshal(matchedFunding)
+
+	storeBySponsor := prefix.NewStore(storeAdapter, types.MatchedFundingKeyPrefixBySponsor)
+	storeBySponsor.Set(types.MatchedFundingKeyBySponsor(
+		matchedFunding.PoolId,
+		matchedFunding.Sponsor,
+		matchedFunding.CampaignId,
+		matchedFunding.Funder,
+	), b)
+
+	storeByFunder := prefix.NewStore(storeAdapter, types.MatchedFundingKeyPrefixByFunder)
+	storeByFunder.Set(types.MatchedFundingKeyByFunder(
+		matchedFunding.PoolId,
+		matchedFunding.Funder,
+		matchedFunding.CampaignId,
+	), b)
+}
+
+// RemoveMatchedFunding removes a matched funding from the store
+func (k Keeper) RemoveMatchedFunding(ctx sdk.Context, matchedFunding *types.MatchedFunding) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+
+	storeBySponsor := prefix.NewStore(storeAdapter, types.MatchedFundingKeyPrefixBySponsor)
+	storeBySponsor.Delete(types.MatchedFundingKeyBySponsor(
+		matchedFunding.PoolId,
+		matchedFunding.Sponsor,
+		matchedFunding.CampaignId,
+		matchedFunding.Funder,
+	))
+
+	storeByFunder := prefix.NewStore(storeAdapter, types.MatchedFundingKeyPrefixByFunder)
+	storeByFunder.Delete(types.MatchedFundingKeyByFunder(
+		matchedFunding.PoolId,
+		matchedFunding.Funder,
+		matchedFunding.CampaignId,
+	))
+}
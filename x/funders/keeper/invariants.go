@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/KYVENetwork/chain/x/funders/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterInvariants registers all funders invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "module-balance", ModuleBalanceInvariant(k))
+}
+
+// AllInvariants runs all invariants of the funders module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return ModuleBalanceInvariant(k)(ctx)
+	}
+}
+
+// ModuleBalanceInvariant checks that the funders module balance equals the
+// amounts of all fundings plus the remaining escrow of all matching campaigns.
+func ModuleBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		expected := sdk.NewCoins()
+		for _, funding := range k.GetAllFundings(ctx) {
+			expected = expected.Add(funding.Amounts...)
+		}
+
+		for _, campaign := range k.GetAllMatchingCampaigns(ctx) {
+			expected = expected.Add(campaign.Remaining...)
+		}
+
+		balance := k.bankKeeper.GetAllBalances(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName))
+
+		broken := !balance.Equal(expected)
+
+		return sdk.FormatInvariant(types.ModuleName, "module-balance", fmt.Sprintf(
+			"\tfunders module balance: %v\n\texpected balance: %v\n",
+			balance, expected,
+		)), broken
+	}
+}
@@ -0,0 +1,105 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	"github.com/KYVENetwork/chain/x/funders/keeper"
+	funderstypes "github.com/KYVENetwork/chain/x/funders/types"
+	globaltypes "github.com/KYVENetwork/chain/x/global/types"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+/*
+
+TEST CASES - invariants.go
+
+* Invariants hold after funding and defunding
+* Module balance invariant breaks if a funding amount does not match the module balance
+
+*/
+
+var _ = Describe("invariants.go", Ordered, func() {
+	s := i.NewCleanChain()
+
+	BeforeEach(func() {
+		// init new clean chain
+		s = i.NewCleanChain()
+
+		// create clean pool for every test case
+		gov := s.App().GovKeeper.GetGovernanceAccount(s.Ctx()).GetAddress().String()
+		s.RunTxPoolSuccess(&pooltypes.MsgCreatePool{
+			Authority:            gov,
+			UploadInterval:       60,
+			InflationShareWeight: math.LegacyNewDec(10_000),
+			MinDelegation:        100 * i.KYVE,
+			MaxBundleSize:        100,
+			Binaries:             "{}",
+		})
+
+		// set whitelist
+		s.App().FundersKeeper.SetParams(s.Ctx(), funderstypes.NewParams([]*funderstypes.WhitelistCoinEntry{
+			{
+				CoinDenom:                 globaltypes.Denom,
+				MinFundingAmount:          math.NewIntFromUint64(10 * i.KYVE),
+				MinFundingAmountPerBundle: math.NewIntFromUint64(1 * i.KYVE),
+				CoinWeight:                math.LegacyNewDec(1),
+			},
+			{
+				CoinDenom:                 i.A_DENOM,
+				MinFundingAmount:          math.NewIntFromUint64(10 * i.KYVE),
+				MinFundingAmountPerBundle: math.NewIntFromUint64(1 * i.KYVE),
+				CoinWeight:                math.LegacyNewDec(1),
+			},
+		}, 20))
+
+		// create funder and fund pool
+		s.RunTxFundersSuccess(&funderstypes.MsgCreateFunder{
+			Creator: i.ALICE,
+			Moniker: "Alice",
+		})
+		s.RunTxFundersSuccess(&funderstypes.MsgFundPool{
+			Creator:          i.ALICE,
+			PoolId:           0,
+			Amounts:          i.ACoins(100 * i.T_KYVE),
+			AmountsPerBundle: i.ACoins(1 * i.T_KYVE),
+		})
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Invariants hold after funding and defunding", func() {
+		// ACT
+		s.RunTxFundersSuccess(&funderstypes.MsgDefundPool{
+			Creator: i.ALICE,
+			PoolId:  0,
+			Amounts: i.ACoins(50 * i.T_KYVE),
+		})
+
+		// ASSERT
+		_, broken := keeper.AllInvariants(s.App().FundersKeeper)(s.Ctx())
+		Expect(broken).To(BeFalse())
+	})
+
+	It("Module balance invariant breaks if a funding amount does not match the module balance", func() {
+		// ARRANGE
+		funding, found := s.App().FundersKeeper.GetFunding(s.Ctx(), i.ALICE, 0)
+		Expect(found).To(BeTrue())
+		amounts := funding.Amounts
+
+		// ACT
+		funding.Amounts = i.ACoins(101 * i.T_KYVE)
+		s.App().FundersKeeper.SetFunding(s.Ctx(), &funding)
+
+		// ASSERT
+		_, broken := keeper.ModuleBalanceInvariant(s.App().FundersKeeper)(s.Ctx())
+		Expect(broken).To(BeTrue())
+
+		// restore the state for the validity checks
+		funding.Amounts = amounts
+		s.App().FundersKeeper.SetFunding(s.Ctx(), &funding)
+	})
+})
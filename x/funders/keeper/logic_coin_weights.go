@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"sort"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/KYVENetwork/chain/x/funders/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ReportCoinPrice stores the reported price of a whitelisted coin and updates the
+// oracle coin weight with the median of all recent price reports. This is the
+// entry point for every price feed, callers are responsible for authenticating
+// the reporter.
+func (k Keeper) ReportCoinPrice(ctx sdk.Context, reporter string, denom string, price math.LegacyDec) error {
+	if price.IsNil() || !price.IsPositive() {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, types.ErrInvalidPrice.Error(), price)
+	}
+
+	var entry *types.WhitelistCoinEntry
+	for _, e := range k.GetParams(ctx).CoinWhitelist {
+		if e.CoinDenom == denom {
+			entry = e
+			break
+		}
+	}
+	if entry == nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, types.ErrCoinNotWhitelisted.Error())
+	}
+
+	k.SetCoinPriceReport(ctx, &types.CoinPriceReport{
+		Denom:     denom,
+		Reporter:  reporter,
+		Price:     price,
+		Timestamp: uint64(ctx.BlockTime().Unix()),
+	})
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventReportCoinPrice{
+		Reporter: reporter,
+		Denom:    denom,
+		Price:    price,
+	})
+
+	k.updateOracleCoinWeight(ctx, *entry)
+	return nil
+}
+
+// GetCoinWeight returns the coin weight which is currently used for the given
+// whitelist entry. This is the oracle coin weight if it is recent enough,
+// otherwise the static coin weight of the whitelist entry is used.
+func (k Keeper) GetCoinWeight(ctx sdk.Context, entry types.WhitelistCoinEntry) math.LegacyDec {
+	return k.getCoinWeight(ctx, k.GetParams(ctx), entry)
+}
+
+func (k Keeper) getCoinWeight(ctx sdk.Context, params types.Params, entry types.WhitelistCoinEntry) math.LegacyDec {
+	oracleCoinWeight, found := k.GetOracleCoinWeight(ctx, entry.CoinDenom)
+	if found && isPriceFresh(ctx, params, oracleCoinWeight.UpdatedAt) {
+		return oracleCoinWeight.Weight
+	}
+
+	return entry.CoinWeight
+}
+
+// updateOracleCoinWeight sets the oracle coin weight of a coin to the median of
+// all recent price reports. The change is bounded by the MaxCoinWeightChange
+// param relative to the coin weight at the start of the current window, which
+// lasts MaxPriceAge seconds. This way many reports within a short time can not
+// move the weight further than a single report. If there are not enough recent
+// reports the oracle coin weight is not updated.
+func (k Keeper) updateOracleCoinWeight(ctx sdk.Context, entry types.WhitelistCoinEntry) {
+	params := k.GetParams(ctx)
+
+	prices := make([]math.LegacyDec, 0)
+	for _, report := range k.GetCoinPriceReportsOfDenom(ctx, entry.CoinDenom) {
+		if isPriceFresh(ctx, params, report.Timestamp) {
+			prices = append(prices, report.Price)
+		}
+	}
+
+	if len(prices) == 0 || uint64(len(prices)) < params.MinPriceReports {
+		return
+	}
+
+	medianPrice := median(prices)
+	oldWeight := k.getCoinWeight(ctx, params, entry)
+	newWeight := medianPrice
+
+	// start a new window with the current coin weight if the previous one expired
+	anchorWeight, anchorTime := oldWeight, uint64(ctx.BlockTime().Unix())
+	if oracleCoinWeight, found := k.GetOracleCoinWeight(ctx, entry.CoinDenom); found {
+		if !oracleCoinWeight.AnchorWeight.IsNil() && isPriceFresh(ctx, params, oracleCoinWeight.AnchorTime) {
+			anchorWeight, anchorTime = oracleCoinWeight.AnchorWeight, oracleCoinWeight.AnchorTime
+		}
+	}
+
+	// bound how fast the weight can change, a zero value disables the bound
+	if params.MaxCoinWeightChange.IsPositive() && anchorWeight.IsPositive() {
+		maxWeight := anchorWeight.Mul(math.LegacyOneDec().Add(params.MaxCoinWeightChange))
+		minWeight := anchorWeight.Mul(math.LegacyOneDec().Sub(params.MaxCoinWeightChange))
+
+		newWeight = math.LegacyMinDec(math.LegacyMaxDec(newWeight, minWeight), maxWeight)
+	}
+
+	k.SetOracleCoinWeight(ctx, &types.OracleCoinWeight{
+		Denom:        entry.CoinDenom,
+		Weight:       newWeight,
+		UpdatedAt:    uint64(ctx.BlockTime().Unix()),
+		AnchorWeight: anchorWeight,
+		AnchorTime:   anchorTime,
+	})
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventUpdateCoinWeight{
+		Denom:       entry.CoinDenom,
+		MedianPrice: medianPrice,
+		OldWeight:   oldWeight,
+		NewWeight:   newWeight,
+	})
+}
+
+// isPriceFresh checks if a price with the given timestamp is younger than
+// the MaxPriceAge param.
+func isPriceFresh(ctx sdk.Context, params types.Params, timestamp uint64) bool {
+	return timestamp+params.MaxPriceAge > uint64(ctx.BlockTime().Unix())
+}
+
+// median returns the median of the given values. For an even amount of
+// values the mean of the two middle values is returned.
+func median(values []math.LegacyDec) math.LegacyDec {
+	sorted := make([]math.LegacyDec, len(values))
+	copy(sorted, values)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].LT(sorted[j])
+	})
+
+	middle := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return sorted[middle-1].Add(sorted[middle]).QuoInt64(2)
+	}
+
+	return sorted[middle]
+}
@@ -0,0 +1,297 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	funderstypes "github.com/KYVENetwork/chain/x/funders/types"
+	globaltypes "github.com/KYVENetwork/chain/x/global/types"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	querytypes "github.com/KYVENetwork/chain/x/query/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+/*
+
+TEST CASES - logic_coin_weights.go
+
+* Use static coin weight without price reports
+* Try to report a price as a non price reporter
+* Try to report a price of a coin which is not whitelisted
+* Update coin weight with a single price report
+* Update coin weight with the median of multiple price reports
+* Do not update coin weight without enough price reports
+* Bound the change of the coin weight
+* Bound the change of the coin weight within a window
+* Fall back to the static coin weight when the oracle coin weight is stale
+* Use oracle coin weights to rank funders
+
+*/
+
+var _ = Describe("logic_coin_weights.go", Ordered, func() {
+	s := i.NewCleanChain()
+
+	setPriceParams := func(minPriceReports uint64, maxCoinWeightChange string) {
+		params := s.App().FundersKeeper.GetParams(s.Ctx())
+		params.PriceReporters = []string{i.ALICE, i.BOB, i.CHARLIE}
+		params.MaxPriceAge = 3600
+		params.MinPriceReports = minPriceReports
+		params.MaxCoinWeightChange = math.LegacyMustNewDecFromStr(maxCoinWeightChange)
+		s.App().FundersKeeper.SetParams(s.Ctx(), params)
+	}
+
+	reportPrice := func(reporter string, denom string, price string) {
+		s.RunTxFundersSuccess(&funderstypes.MsgReportCoinPrice{
+			Creator: reporter,
+			Denom:   denom,
+			Price:   math.LegacyMustNewDecFromStr(price),
+		})
+	}
+
+	coinWeight := func(denom string) math.LegacyDec {
+		return s.App().FundersKeeper.GetCoinWhitelistMap(s.Ctx())[denom].CoinWeight
+	}
+
+	BeforeEach(func() {
+		// init new clean chain
+		s = i.NewCleanChain()
+
+		// create clean pool for every test case
+		gov := s.App().GovKeeper.GetGovernanceAccount(s.Ctx()).GetAddress().String()
+		s.RunTxPoolSuccess(&pooltypes.MsgCreatePool{
+			Authority:            gov,
+			UploadInterval:       60,
+			InflationShareWeight: math.LegacyNewDec(10_000),
+			MinDelegation:        100 * i.KYVE,
+			MaxBundleSize:        100,
+			Binaries:             "{}",
+		})
+
+		// set whitelist
+		s.App().FundersKeeper.SetParams(s.Ctx(), funderstypes.NewParams([]*funderstypes.WhitelistCoinEntry{
+			{
+				CoinDenom:                 globaltypes.Denom,
+				MinFundingAmount:          math.NewIntFromUint64(10 * i.KYVE),
+				MinFundingAmountPerBundle: math.NewIntFromUint64(1 * i.KYVE),
+				CoinWeight:                math.LegacyNewDec(1),
+			},
+			{
+				CoinDenom:                 i.A_DENOM,
+				MinFundingAmount:          math.NewIntFromUint64(10 * i.KYVE),
+				MinFundingAmountPerBundle: math.NewIntFromUint64(1 * i.KYVE),
+				CoinWeight:                math.LegacyNewDec(1),
+			},
+			{
+				CoinDenom:                 i.B_DENOM,
+				MinFundingAmount:          math.NewIntFromUint64(10 * i.KYVE),
+				MinFundingAmountPerBundle: math.NewIntFromUint64(1 * i.KYVE),
+				CoinWeight:                math.LegacyNewDec(2),
+			},
+		}, 20))
+
+		setPriceParams(1, "0")
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Use static coin weight without price reports", func() {
+		// ASSERT
+		Expect(coinWeight(i.A_DENOM)).To(Equal(math.LegacyNewDec(1)))
+		Expect(coinWeight(i.B_DENOM)).To(Equal(math.LegacyNewDec(2)))
+
+		_, found := s.App().FundersKeeper.GetOracleCoinWeight(s.Ctx(), i.A_DENOM)
+		Expect(found).To(BeFalse())
+	})
+
+	It("Try to report a price as a non price reporter", func() {
+		// ACT
+		s.RunTxFundersError(&funderstypes.MsgReportCoinPrice{
+			Creator: i.DAVID,
+			Denom:   i.A_DENOM,
+			Price:   math.LegacyNewDec(3),
+		})
+
+		// ASSERT
+		Expect(s.App().FundersKeeper.GetCoinPriceReportsOfDenom(s.Ctx(), i.A_DENOM)).To(BeEmpty())
+		Expect(coinWeight(i.A_DENOM)).To(Equal(math.LegacyNewDec(1)))
+	})
+
+	It("Try to report a price of a coin which is not whitelisted", func() {
+		// ACT
+		s.RunTxFundersError(&funderstypes.MsgReportCoinPrice{
+			Creator: i.ALICE,
+			Denom:   i.C_DENOM,
+			Price:   math.LegacyNewDec(3),
+		})
+
+		// ASSERT
+		Expect(s.App().FundersKeeper.GetCoinPriceReportsOfDenom(s.Ctx(), i.C_DENOM)).To(BeEmpty())
+	})
+
+	It("Update coin weight with a single price report", func() {
+		// ACT
+		reportPrice(i.ALICE, i.A_DENOM, "3")
+
+		// ASSERT
+		Expect(coinWeight(i.A_DENOM)).To(Equal(math.LegacyNewDec(3)))
+		Expect(coinWeight(i.B_DENOM)).To(Equal(math.LegacyNewDec(2)))
+
+		oracleCoinWeight, found := s.App().FundersKeeper.GetOracleCoinWeight(s.Ctx(), i.A_DENOM)
+		Expect(found).To(BeTrue())
+		Expect(oracleCoinWeight.Weight).To(Equal(math.LegacyNewDec(3)))
+		Expect(oracleCoinWeight.UpdatedAt).To(Equal(uint64(s.Ctx().BlockTime().Unix())))
+
+		res, err := s.App().QueryKeeper.CoinWeights(s.Ctx(), &querytypes.QueryCoinWeightsRequest{})
+		Expect(err).To(BeNil())
+		Expect(res.CoinWeights).To(HaveLen(3))
+		Expect(res.CoinWeights[1].Denom).To(Equal(i.A_DENOM))
+		Expect(res.CoinWeights[1].StaticWeight).To(Equal(math.LegacyNewDec(1)))
+		Expect(res.CoinWeights[1].OracleWeight).To(Equal(math.LegacyNewDec(3)))
+		Expect(res.CoinWeights[1].Weight).To(Equal(math.LegacyNewDec(3)))
+	})
+
+	It("Update coin weight with the median of multiple price reports", func() {
+		// ACT
+		reportPrice(i.ALICE, i.A_DENOM, "2")
+		reportPrice(i.BOB, i.A_DENOM, "100")
+		reportPrice(i.CHARLIE, i.A_DENOM, "3")
+
+		// ASSERT
+		Expect(coinWeight(i.A_DENOM)).To(Equal(math.LegacyNewDec(3)))
+		Expect(s.App().FundersKeeper.GetCoinPriceReportsOfDenom(s.Ctx(), i.A_DENOM)).To(HaveLen(3))
+
+		// ACT
+		reportPrice(i.BOB, i.A_DENOM, "5")
+
+		// ASSERT
+		Expect(coinWeight(i.A_DENOM)).To(Equal(math.LegacyNewDec(3)))
+		Expect(s.App().FundersKeeper.GetCoinPriceReportsOfDenom(s.Ctx(), i.A_DENOM)).To(HaveLen(3))
+	})
+
+	It("Do not update coin weight without enough price reports", func() {
+		// ARRANGE
+		setPriceParams(2, "0")
+
+		// ACT
+		reportPrice(i.ALICE, i.A_DENOM, "3")
+
+		// ASSERT
+		Expect(coinWeight(i.A_DENOM)).To(Equal(math.LegacyNewDec(1)))
+
+		// ACT
+		reportPrice(i.BOB, i.A_DENOM, "5")
+
+		// ASSERT
+		Expect(coinWeight(i.A_DENOM)).To(Equal(math.LegacyNewDec(4)))
+	})
+
+	It("Bound the change of the coin weight", func() {
+		// ARRANGE
+		setPriceParams(1, "0.1")
+
+		// ACT
+		reportPrice(i.ALICE, i.A_DENOM, "3")
+
+		// ASSERT
+		Expect(coinWeight(i.A_DENOM)).To(Equal(math.LegacyMustNewDecFromStr("1.1")))
+
+		// ACT
+		reportPrice(i.ALICE, i.B_DENOM, "0.5")
+
+		// ASSERT
+		Expect(coinWeight(i.B_DENOM)).To(Equal(math.LegacyMustNewDecFromStr("1.8")))
+	})
+
+	It("Bound the change of the coin weight within a window", func() {
+		// ARRANGE
+		setPriceParams(1, "0.1")
+
+		// ACT
+		reportPrice(i.ALICE, i.A_DENOM, "3")
+		reportPrice(i.BOB, i.A_DENOM, "3")
+		reportPrice(i.CHARLIE, i.A_DENOM, "3")
+
+		// ASSERT
+		Expect(coinWeight(i.A_DENOM)).To(Equal(math.LegacyMustNewDecFromStr("1.1")))
+
+		oracleCoinWeight, found := s.App().FundersKeeper.GetOracleCoinWeight(s.Ctx(), i.A_DENOM)
+		Expect(found).To(BeTrue())
+		Expect(oracleCoinWeight.AnchorWeight).To(Equal(math.LegacyNewDec(1)))
+		Expect(oracleCoinWeight.AnchorTime).To(Equal(uint64(s.Ctx().BlockTime().Unix())))
+
+		// ACT
+		s.CommitAfterSeconds(1800)
+		reportPrice(i.ALICE, i.A_DENOM, "3")
+
+		// ASSERT
+		Expect(coinWeight(i.A_DENOM)).To(Equal(math.LegacyMustNewDecFromStr("1.1")))
+
+		// ACT
+		s.CommitAfterSeconds(1800)
+		reportPrice(i.ALICE, i.A_DENOM, "3")
+
+		// ASSERT
+		Expect(coinWeight(i.A_DENOM)).To(Equal(math.LegacyMustNewDecFromStr("1.21")))
+
+		oracleCoinWeight, _ = s.App().FundersKeeper.GetOracleCoinWeight(s.Ctx(), i.A_DENOM)
+		Expect(oracleCoinWeight.AnchorWeight).To(Equal(math.LegacyMustNewDecFromStr("1.1")))
+		Expect(oracleCoinWeight.AnchorTime).To(Equal(uint64(s.Ctx().BlockTime().Unix())))
+	})
+
+	It("Fall back to the static coin weight when the oracle coin weight is stale", func() {
+		// ARRANGE
+		reportPrice(i.ALICE, i.A_DENOM, "3")
+		Expect(coinWeight(i.A_DENOM)).To(Equal(math.LegacyNewDec(3)))
+
+		// ACT
+		s.CommitAfterSeconds(3600)
+
+		// ASSERT
+		Expect(coinWeight(i.A_DENOM)).To(Equal(math.LegacyNewDec(1)))
+
+		// stale reports are not considered for the next update
+		setPriceParams(2, "0")
+		reportPrice(i.BOB, i.A_DENOM, "5")
+		Expect(coinWeight(i.A_DENOM)).To(Equal(math.LegacyNewDec(1)))
+	})
+
+	It("Use oracle coin weights to rank funders", func() {
+		// ARRANGE
+		s.RunTxFundersSuccess(&funderstypes.MsgCreateFunder{
+			Creator: i.ALICE,
+			Moniker: "Alice",
+		})
+		s.RunTxFundersSuccess(&funderstypes.MsgCreateFunder{
+			Creator: i.BOB,
+			Moniker: "Bob",
+		})
+
+		s.RunTxFundersSuccess(&funderstypes.MsgFundPool{
+			Creator:          i.ALICE,
+			PoolId:           0,
+			Amounts:          i.ACoins(100 * i.T_KYVE),
+			AmountsPerBundle: i.ACoins(1 * i.T_KYVE),
+		})
+		s.RunTxFundersSuccess(&funderstypes.MsgFundPool{
+			Creator:          i.BOB,
+			PoolId:           0,
+			Amounts:          i.BCoins(100 * i.T_KYVE),
+			AmountsPerBundle: i.BCoins(1 * i.T_KYVE),
+		})
+
+		fundingState, _ := s.App().FundersKeeper.GetFundingState(s.Ctx(), 0)
+		lowestFunding, err := s.App().FundersKeeper.GetLowestFunding(s.Ctx(), s.App().FundersKeeper.GetActiveFundings(s.Ctx(), fundingState))
+		Expect(err).To(BeNil())
+		Expect(lowestFunding.FunderAddress).To(Equal(i.ALICE))
+
+		// ACT
+		reportPrice(i.CHARLIE, i.A_DENOM, "3")
+
+		// ASSERT
+		lowestFunding, err = s.App().FundersKeeper.GetLowestFunding(s.Ctx(), s.App().FundersKeeper.GetActiveFundings(s.Ctx(), fundingState))
+		Expect(err).To(BeNil())
+		Expect(lowestFunding.FunderAddress).To(Equal(i.BOB))
+	})
+})
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	"github.com/KYVENetwork/chain/x/funders/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// getActiveFunderDelegate returns the permissions of a delegate of a funder.
+// An error is returned if the delegate does not exist or if the permissions
+// are already expired.
+func (k Keeper) getActiveFunderDelegate(ctx sdk.Context, funderAddress string, delegateAddress string) (types.FunderDelegate, error) {
+	funderDelegate, found := k.GetFunderDelegate(ctx, funderAddress, delegateAddress)
+	if !found {
+		return funderDelegate, errors.Wrapf(errorsTypes.ErrUnauthorized, types.ErrFunderDelegateDoesNotExist.Error(), delegateAddress, funderAddress)
+	}
+
+	if funderDelegate.Expiration > 0 && uint64(ctx.BlockTime().Unix()) >= funderDelegate.Expiration {
+		return funderDelegate, errors.Wrapf(errorsTypes.ErrUnauthorized, types.ErrFunderDelegateExpired.Error(), delegateAddress, funderAddress)
+	}
+
+	return funderDelegate, nil
+}
+
+// consumeSpendLimit subtracts the given amounts from the spend limit the delegate
+// has in the given pool. The delegate is not written to the KV-Store.
+// Unlimited delegates can fund every pool, all other delegates can only fund
+// pools they have a spend limit for. If the amounts exceed the spend limit
+// an error is returned.
+func consumeSpendLimit(funderDelegate *types.FunderDelegate, poolId uint64, amounts sdk.Coins) error {
+	if funderDelegate.Unlimited {
+		return nil
+	}
+
+	for i, spendLimit := range funderDelegate.SpendLimits {
+		if spendLimit.PoolId != poolId {
+			continue
+		}
+
+		remaining, hasNeg := spendLimit.Amounts.SafeSub(amounts...)
+		if hasNeg {
+			return errors.Wrapf(errorsTypes.ErrUnauthorized, types.ErrSpendLimitExceeded.Error(), funderDelegate.Delegate, poolId)
+		}
+
+		funderDelegate.SpendLimits[i].Amounts = remaining
+		return nil
+	}
+
+	return errors.Wrapf(errorsTypes.ErrUnauthorized, types.ErrNoSpendLimit.Error(), funderDelegate.Delegate, poolId)
+}
+
+// amountsPerBundleChanged checks if the given amounts per bundle would change
+// the amounts per bundle of an existing funding. Setting the amounts per bundle
+// of a new funding is not considered a change.
+func amountsPerBundleChanged(funding *types.Funding, found bool, amountsPerBundle sdk.Coins) bool {
+	if !found {
+		return false
+	}
+
+	for _, coin := range amountsPerBundle {
+		if !funding.AmountsPerBundle.AmountOf(coin.Denom).Equal(coin.Amount) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"slices"
+
+	"github.com/KYVENetwork/chain/x/funders/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// IsFunderVerified returns true if the funder has an attestation which
+// is not expired and whose attestor is still part of the attestor set.
+func (k Keeper) IsFunderVerified(ctx sdk.Context, funderAddress string) bool {
+	attestation, found := k.GetFunderAttestation(ctx, funderAddress)
+	if !found {
+		return false
+	}
+
+	if attestation.Expiry <= uint64(ctx.BlockTime().Unix()) {
+		return false
+	}
+
+	return slices.Contains(k.GetParams(ctx).FunderAttestors, attestation.Attestor)
+}
+
+// recordFunding adds the funded amounts and the pool to the lifetime stats of the funder.
+func (k Keeper) recordFunding(ctx sdk.Context, funderAddress string, poolId uint64, amounts sdk.Coins) {
+	if amounts.IsZero() {
+		return
+	}
+
+	stats := k.GetFunderStats(ctx, funderAddress)
+	stats.LifetimeFunded = stats.LifetimeFunded.Add(amounts...)
+
+	if !slices.Contains(stats.PoolsFunded, poolId) {
+		stats.PoolsFunded = append(stats.PoolsFunded, poolId)
+		slices.Sort(stats.PoolsFunded)
+	}
+
+	k.SetFunderStats(ctx, &stats)
+}
+
+// startFunding marks the time a funding became active. Fundings which
+// are already active keep their original start time.
+func (k Keeper) startFunding(ctx sdk.Context, funding *types.Funding) {
+	if funding.ActiveSince == 0 {
+		funding.ActiveSince = uint64(ctx.BlockTime().Unix())
+	}
+}
+
+// endFunding adds the duration of a funding which ran out or got defunded
+// to the lifetime stats of the funder.
+func (k Keeper) endFunding(ctx sdk.Context, funding *types.Funding) {
+	if funding.ActiveSince == 0 {
+		return
+	}
+
+	stats := k.GetFunderStats(ctx, funding.FunderAddress)
+	stats.TotalFundingDuration += uint64(ctx.BlockTime().Unix()) - funding.ActiveSince
+	stats.CompletedFundings += 1
+	k.SetFunderStats(ctx, &stats)
+
+	funding.ActiveSince = 0
+}
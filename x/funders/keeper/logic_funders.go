@@ -31,12 +31,15 @@ func (k Keeper) GetTotalActiveFunding(ctx sdk.Context, poolId uint64) (amounts s
 	return
 }
 
-// GetCoinWhitelist gets the coin whitelist from the params of the funding module
+// GetCoinWhitelist gets the coin whitelist from the params of the funding module.
+// The coin weights are replaced by the oracle coin weights if they are recent enough.
 func (k Keeper) GetCoinWhitelist(ctx sdk.Context) (whitelist []types.WhitelistCoinEntry) {
 	params := k.GetParams(ctx)
 
 	for _, entry := range params.CoinWhitelist {
-		whitelist = append(whitelist, *entry)
+		e := *entry
+		e.CoinWeight = k.getCoinWeight(ctx, params, e)
+		whitelist = append(whitelist, e)
 	}
 
 	return
@@ -60,14 +63,18 @@ func (k Keeper) GetCoinWhitelistMap(ctx sdk.Context) (whitelist map[string]types
 // the max amount is charged and the funder is removed from the active funders list.
 // The amount is transferred from the funders to the recipient module account.
 // If there are no more active funders, an event is emitted. This method only charges
-// coins which are whitelisted.
-func (k Keeper) ChargeFundersOfPool(ctx sdk.Context, poolId uint64, recipient string) (sdk.Coins, error) {
+// coins which are whitelisted. Fundings whose constraints are not met by the bundle
+// are skipped. The amounts every funder paid for the bundle are recorded in the funding ledger.
+func (k Keeper) ChargeFundersOfPool(ctx sdk.Context, poolId uint64, bundleId uint64, recipient string, quality types.BundleQuality) (sdk.Coins, error) {
 	// Get funding state for pool
 	fundingState, found := k.GetFundingState(ctx, poolId)
 	if !found {
 		return sdk.NewCoins(), errors.Wrapf(errorsTypes.ErrNotFound, types.ErrFundingStateDoesNotExist.Error(), poolId)
 	}
 
+	// Remove the contributions which drop out of the retention window
+	k.pruneFundingContributions(ctx, poolId, bundleId)
+
 	// If there are no active fundings we immediately return
 	activeFundings := k.GetActiveFundings(ctx, fundingState)
 	if len(activeFundings) == 0 {
@@ -77,11 +84,24 @@ func (k Keeper) ChargeFundersOfPool(ctx sdk.Context, poolId uint64, recipient st
 	whitelist := k.GetCoinWhitelistMap(ctx)
 	payouts := sdk.NewCoins()
 
-	// Charge every active funder and collect payouts
+	chargedFunders := make([]string, 0)
+	skippedFunders := make([]string, 0)
+
+	// Charge every active funder whose constraints are met and collect payouts
 	for _, funding := range activeFundings {
-		payouts = payouts.Add(funding.ChargeOneBundle(whitelist)...)
+		if !funding.MeetsConstraints(quality) {
+			skippedFunders = append(skippedFunders, funding.FunderAddress)
+			continue
+		}
+
+		payout := funding.ChargeOneBundle(whitelist)
+		k.recordFundingContribution(ctx, poolId, bundleId, funding.FunderAddress, payout)
+
+		payouts = payouts.Add(payout...)
+		chargedFunders = append(chargedFunders, funding.FunderAddress)
 		if funding.Amounts.IsZero() {
 			fundingState.SetInactive(&funding)
+			k.endFunding(ctx, &funding)
 		}
 		k.SetFunding(ctx, &funding)
 	}
@@ -89,6 +109,13 @@ func (k Keeper) ChargeFundersOfPool(ctx sdk.Context, poolId uint64, recipient st
 	// Save funding state
 	k.SetFundingState(ctx, &fundingState)
 
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventChargeFunders{
+		PoolId:         poolId,
+		ChargedFunders: chargedFunders,
+		SkippedFunders: skippedFunders,
+		Amounts:        payouts.String(),
+	})
+
 	// Emit a pool out of funds event if there are no more active funders
 	if len(fundingState.ActiveFunderAddresses) == 0 {
 		_ = ctx.EventManager().EmitTypedEvent(&types.EventPoolOutOfFunds{
@@ -225,6 +252,7 @@ func (k Keeper) ensureFreeSlot(ctx sdk.Context, newFunding *types.Funding, fundi
 	lowestFunding.Amounts = sdk.NewCoins()
 	lowestFunding.AmountsPerBundle = sdk.NewCoins()
 	fundingState.SetInactive(lowestFunding)
+	k.endFunding(ctx, lowestFunding)
 	k.SetFunding(ctx, lowestFunding)
 
 	// Emit a defund event.
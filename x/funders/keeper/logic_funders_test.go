@@ -124,7 +124,7 @@ var _ = Describe("logic_funders.go", Ordered, func() {
 
 	It("Charge funders once with one coin", func() {
 		// ACT
-		payout, err := s.App().FundersKeeper.ChargeFundersOfPool(s.Ctx(), 0, pooltypes.ModuleName)
+		payout, err := s.App().FundersKeeper.ChargeFundersOfPool(s.Ctx(), 0, 0, pooltypes.ModuleName, funderstypes.BundleQuality{})
 		Expect(err).NotTo(HaveOccurred())
 
 		// ASSERT
@@ -167,7 +167,7 @@ var _ = Describe("logic_funders.go", Ordered, func() {
 		})
 
 		// ACT
-		payout, err := s.App().FundersKeeper.ChargeFundersOfPool(s.Ctx(), 0, pooltypes.ModuleName)
+		payout, err := s.App().FundersKeeper.ChargeFundersOfPool(s.Ctx(), 0, 0, pooltypes.ModuleName, funderstypes.BundleQuality{})
 		Expect(err).NotTo(HaveOccurred())
 
 		// ASSERT
@@ -197,7 +197,7 @@ var _ = Describe("logic_funders.go", Ordered, func() {
 	It("Charge funders until one funder runs out of funds", func() {
 		// ACT
 		for range [5]struct{}{} {
-			payout, err := s.App().FundersKeeper.ChargeFundersOfPool(s.Ctx(), 0, pooltypes.ModuleName)
+			payout, err := s.App().FundersKeeper.ChargeFundersOfPool(s.Ctx(), 0, 0, pooltypes.ModuleName, funderstypes.BundleQuality{})
 			Expect(err).NotTo(HaveOccurred())
 			Expect(payout.String()).To(Equal(i.ACoins(11 * i.T_KYVE).String()))
 		}
@@ -239,13 +239,13 @@ var _ = Describe("logic_funders.go", Ordered, func() {
 		})
 
 		for range [5]struct{}{} {
-			payout, err := s.App().FundersKeeper.ChargeFundersOfPool(s.Ctx(), 0, pooltypes.ModuleName)
+			payout, err := s.App().FundersKeeper.ChargeFundersOfPool(s.Ctx(), 0, 0, pooltypes.ModuleName, funderstypes.BundleQuality{})
 			Expect(err).NotTo(HaveOccurred())
 			Expect(payout.String()).To(Equal(sdk.NewCoins(i.ACoin(11*i.T_KYVE), i.BCoin(20*i.T_KYVE), i.CCoin(10*i.T_KYVE)).String()))
 		}
 
 		for range [5]struct{}{} {
-			payout, err := s.App().FundersKeeper.ChargeFundersOfPool(s.Ctx(), 0, pooltypes.ModuleName)
+			payout, err := s.App().FundersKeeper.ChargeFundersOfPool(s.Ctx(), 0, 0, pooltypes.ModuleName, funderstypes.BundleQuality{})
 			Expect(err).NotTo(HaveOccurred())
 			Expect(payout.String()).To(Equal(sdk.NewCoins(i.ACoin(1*i.T_KYVE), i.BCoin(20*i.T_KYVE), i.CCoin(10*i.T_KYVE)).String()))
 		}
@@ -282,7 +282,7 @@ var _ = Describe("logic_funders.go", Ordered, func() {
 			fundingState, _ := s.App().FundersKeeper.GetFundingState(s.Ctx(), 0)
 			Expect(fundingState.ActiveFunderAddresses).To(HaveLen(2))
 
-			payout, err := s.App().FundersKeeper.ChargeFundersOfPool(s.Ctx(), 0, pooltypes.ModuleName)
+			payout, err := s.App().FundersKeeper.ChargeFundersOfPool(s.Ctx(), 0, 0, pooltypes.ModuleName, funderstypes.BundleQuality{})
 			Expect(err).NotTo(HaveOccurred())
 			Expect(payout.String()).To(Equal(i.ACoins(20 * i.T_KYVE).String()))
 		}
@@ -304,7 +304,7 @@ var _ = Describe("logic_funders.go", Ordered, func() {
 			fundingState, _ := s.App().FundersKeeper.GetFundingState(s.Ctx(), 0)
 			Expect(fundingState.ActiveFunderAddresses).To(HaveLen(1))
 
-			payout, err := s.App().FundersKeeper.ChargeFundersOfPool(s.Ctx(), 0, pooltypes.ModuleName)
+			payout, err := s.App().FundersKeeper.ChargeFundersOfPool(s.Ctx(), 0, 0, pooltypes.ModuleName, funderstypes.BundleQuality{})
 			Expect(err).NotTo(HaveOccurred())
 			Expect(payout.String()).To(Equal(i.ACoins(10 * i.T_KYVE).String()))
 		}
@@ -321,7 +321,7 @@ var _ = Describe("logic_funders.go", Ordered, func() {
 		Expect(fundingBob.Amounts.IsZero()).To(BeTrue())
 		Expect(fundingBob.TotalFunded.String()).To(Equal(i.ACoins(50 * i.T_KYVE).String()))
 
-		payout, err := s.App().FundersKeeper.ChargeFundersOfPool(s.Ctx(), 0, pooltypes.ModuleName)
+		payout, err := s.App().FundersKeeper.ChargeFundersOfPool(s.Ctx(), 0, 0, pooltypes.ModuleName, funderstypes.BundleQuality{})
 		Expect(err).NotTo(HaveOccurred())
 		Expect(payout.IsZero()).To(BeTrue())
 
@@ -341,7 +341,7 @@ var _ = Describe("logic_funders.go", Ordered, func() {
 		s.App().FundersKeeper.SetFunding(s.Ctx(), &funding)
 
 		// ACT
-		payout, err := s.App().FundersKeeper.ChargeFundersOfPool(s.Ctx(), 0, pooltypes.ModuleName)
+		payout, err := s.App().FundersKeeper.ChargeFundersOfPool(s.Ctx(), 0, 0, pooltypes.ModuleName, funderstypes.BundleQuality{})
 		Expect(err).NotTo(HaveOccurred())
 		Expect(payout.String()).To(Equal(i.ACoins(110 * i.T_KYVE).String()))
 
@@ -390,7 +390,7 @@ var _ = Describe("logic_funders.go", Ordered, func() {
 		}, 20))
 
 		// ACT
-		payout, err := s.App().FundersKeeper.ChargeFundersOfPool(s.Ctx(), 0, pooltypes.ModuleName)
+		payout, err := s.App().FundersKeeper.ChargeFundersOfPool(s.Ctx(), 0, 0, pooltypes.ModuleName, funderstypes.BundleQuality{})
 		Expect(err).NotTo(HaveOccurred())
 
 		// ASSERT
@@ -431,7 +431,7 @@ var _ = Describe("logic_funders.go", Ordered, func() {
 		})
 
 		// ACT
-		payout, err := s.App().FundersKeeper.ChargeFundersOfPool(s.Ctx(), 0, pooltypes.ModuleName)
+		payout, err := s.App().FundersKeeper.ChargeFundersOfPool(s.Ctx(), 0, 0, pooltypes.ModuleName, funderstypes.BundleQuality{})
 
 		// ASSERT
 		Expect(err).NotTo(HaveOccurred())
@@ -0,0 +1,300 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	funderstypes "github.com/KYVENetwork/chain/x/funders/types"
+	globaltypes "github.com/KYVENetwork/chain/x/global/types"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+/*
+
+TEST CASES - funding constraints
+
+* Fund a pool with constraints
+* Update only the constraints of an existing funding
+* Try to fund a pool with an invalid min valid vote ratio
+* Try to update the constraints on behalf of a funder without permission
+* Charge funders without constraints
+* Charge funders with a min valid vote ratio which is not met
+* Charge funders with a min valid voters constraint which is not met
+* Charge funders with a max data size ratio which is exceeded
+* Charge funders where all constraints are met
+
+*/
+
+var _ = Describe("funding constraints", Ordered, func() {
+	s := i.NewCleanChain()
+
+	quality := funderstypes.BundleQuality{
+		ValidVoteRatio: math.LegacyMustNewDecFromStr("0.8"),
+		ValidVoters:    3,
+		DataSize:       1000,
+		BundleSize:     10,
+	}
+
+	BeforeEach(func() {
+		// init new clean chain
+		s = i.NewCleanChain()
+
+		// create clean pool for every test case
+		gov := s.App().GovKeeper.GetGovernanceAccount(s.Ctx()).GetAddress().String()
+		s.RunTxPoolSuccess(&pooltypes.MsgCreatePool{
+			Authority:            gov,
+			UploadInterval:       60,
+			InflationShareWeight: math.LegacyNewDec(10_000),
+			MinDelegation:        100 * i.KYVE,
+			MaxBundleSize:        100,
+			Binaries:             "{}",
+		})
+
+		// set whitelist
+		s.App().FundersKeeper.SetParams(s.Ctx(), funderstypes.NewParams([]*funderstypes.WhitelistCoinEntry{
+			{
+				CoinDenom:                 globaltypes.Denom,
+				MinFundingAmount:          math.NewIntFromUint64(10 * i.KYVE),
+				MinFundingAmountPerBundle: math.NewIntFromUint64(1 * i.KYVE),
+				CoinWeight:                math.LegacyNewDec(1),
+			},
+			{
+				CoinDenom:                 i.A_DENOM,
+				MinFundingAmount:          math.NewIntFromUint64(10 * i.KYVE),
+				MinFundingAmountPerBundle: math.NewIntFromUint64(1 * i.KYVE),
+				CoinWeight:                math.LegacyNewDec(1),
+			},
+		}, 20))
+
+		// create funders
+		s.RunTxFundersSuccess(&funderstypes.MsgCreateFunder{
+			Creator: i.ALICE,
+			Moniker: "Alice",
+		})
+		s.RunTxFundersSuccess(&funderstypes.MsgCreateFunder{
+			Creator: i.BOB,
+			Moniker: "Bob",
+		})
+
+		// fund pool without constraints
+		s.RunTxFundersSuccess(&funderstypes.MsgFundPool{
+			Creator:          i.ALICE,
+			PoolId:           0,
+			Amounts:          i.ACoins(100 * i.T_KYVE),
+			AmountsPerBundle: i.ACoins(1 * i.T_KYVE),
+		})
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Fund a pool with constraints", func() {
+		// ACT
+		s.RunTxFundersSuccess(&funderstypes.MsgFundPool{
+			Creator:          i.BOB,
+			PoolId:           0,
+			Amounts:          i.ACoins(100 * i.T_KYVE),
+			AmountsPerBundle: i.ACoins(2 * i.T_KYVE),
+			Constraints: &funderstypes.FundingConstraints{
+				MinValidVoteRatio: math.LegacyMustNewDecFromStr("0.9"),
+				MinValidVoters:    2,
+				MaxDataSizeRatio:  500,
+			},
+		})
+
+		// ASSERT
+		funding, found := s.App().FundersKeeper.GetFunding(s.Ctx(), i.BOB, 0)
+		Expect(found).To(BeTrue())
+		Expect(funding.Constraints.MinValidVoteRatio).To(Equal(math.LegacyMustNewDecFromStr("0.9")))
+		Expect(funding.Constraints.MinValidVoters).To(Equal(uint64(2)))
+		Expect(funding.Constraints.MaxDataSizeRatio).To(Equal(uint64(500)))
+	})
+
+	It("Update only the constraints of an existing funding", func() {
+		// ACT
+		s.RunTxFundersSuccess(&funderstypes.MsgFundPool{
+			Creator: i.ALICE,
+			PoolId:  0,
+			Constraints: &funderstypes.FundingConstraints{
+				MinValidVoteRatio: math.LegacyZeroDec(),
+				MinValidVoters:    4,
+			},
+		})
+
+		// ASSERT
+		funding, found := s.App().FundersKeeper.GetFunding(s.Ctx(), i.ALICE, 0)
+		Expect(found).To(BeTrue())
+		Expect(funding.Amounts.String()).To(Equal(i.ACoins(100 * i.T_KYVE).String()))
+		Expect(funding.AmountsPerBundle.String()).To(Equal(i.ACoins(1 * i.T_KYVE).String()))
+		Expect(funding.Constraints.MinValidVoters).To(Equal(uint64(4)))
+
+		// a funding message without constraints keeps the existing ones
+		s.RunTxFundersSuccess(&funderstypes.MsgFundPool{
+			Creator: i.ALICE,
+			PoolId:  0,
+			Amounts: i.ACoins(10 * i.T_KYVE),
+		})
+
+		funding, _ = s.App().FundersKeeper.GetFunding(s.Ctx(), i.ALICE, 0)
+		Expect(funding.Constraints.MinValidVoters).To(Equal(uint64(4)))
+	})
+
+	It("Try to fund a pool with an invalid min valid vote ratio", func() {
+		// ACT
+		s.RunTxFundersError(&funderstypes.MsgFundPool{
+			Creator:          i.BOB,
+			PoolId:           0,
+			Amounts:          i.ACoins(100 * i.T_KYVE),
+			AmountsPerBundle: i.ACoins(1 * i.T_KYVE),
+			Constraints: &funderstypes.FundingConstraints{
+				MinValidVoteRatio: math.LegacyMustNewDecFromStr("1.5"),
+			},
+		})
+
+		// ASSERT
+		_, found := s.App().FundersKeeper.GetFunding(s.Ctx(), i.BOB, 0)
+		Expect(found).To(BeFalse())
+	})
+
+	It("Try to update the constraints on behalf of a funder without permission", func() {
+		// ARRANGE
+		s.RunTxFundersSuccess(&funderstypes.MsgGrantFunderDelegate{
+			Creator:  i.ALICE,
+			Delegate: i.BOB,
+			CanFund:  true,
+			SpendLimits: []funderstypes.FunderSpendLimit{
+				{PoolId: 0, Amounts: i.ACoins(100 * i.T_KYVE)},
+			},
+		})
+
+		// ACT
+		s.RunTxFundersError(&funderstypes.MsgFundPool{
+			Creator: i.BOB,
+			PoolId:  0,
+			Funder:  i.ALICE,
+			Constraints: &funderstypes.FundingConstraints{
+				MinValidVoteRatio: math.LegacyZeroDec(),
+				MinValidVoters:    4,
+			},
+		})
+
+		// ASSERT
+		funding, _ := s.App().FundersKeeper.GetFunding(s.Ctx(), i.ALICE, 0)
+		Expect(funding.Constraints.MinValidVoters).To(BeZero())
+	})
+
+	It("Charge funders without constraints", func() {
+		// ACT
+		payout, err := s.App().FundersKeeper.ChargeFundersOfPool(s.Ctx(), 0, 0, pooltypes.ModuleName, funderstypes.BundleQuality{})
+		Expect(err).NotTo(HaveOccurred())
+
+		// ASSERT
+		Expect(payout.String()).To(Equal(i.ACoins(1 * i.T_KYVE).String()))
+
+		funding, _ := s.App().FundersKeeper.GetFunding(s.Ctx(), i.ALICE, 0)
+		Expect(funding.Amounts.String()).To(Equal(i.ACoins(99 * i.T_KYVE).String()))
+	})
+
+	It("Charge funders with a min valid vote ratio which is not met", func() {
+		// ARRANGE
+		s.RunTxFundersSuccess(&funderstypes.MsgFundPool{
+			Creator:          i.BOB,
+			PoolId:           0,
+			Amounts:          i.ACoins(100 * i.T_KYVE),
+			AmountsPerBundle: i.ACoins(2 * i.T_KYVE),
+			Constraints: &funderstypes.FundingConstraints{
+				MinValidVoteRatio: math.LegacyMustNewDecFromStr("0.9"),
+			},
+		})
+
+		// ACT
+		payout, err := s.App().FundersKeeper.ChargeFundersOfPool(s.Ctx(), 0, 0, pooltypes.ModuleName, quality)
+		Expect(err).NotTo(HaveOccurred())
+
+		// ASSERT
+		Expect(payout.String()).To(Equal(i.ACoins(1 * i.T_KYVE).String()))
+
+		fundingBob, _ := s.App().FundersKeeper.GetFunding(s.Ctx(), i.BOB, 0)
+		Expect(fundingBob.Amounts.String()).To(Equal(i.ACoins(100 * i.T_KYVE).String()))
+		Expect(fundingBob.TotalFunded.IsZero()).To(BeTrue())
+
+		fundingState, _ := s.App().FundersKeeper.GetFundingState(s.Ctx(), 0)
+		Expect(fundingState.ActiveFunderAddresses).To(ContainElement(i.BOB))
+	})
+
+	It("Charge funders with a min valid voters constraint which is not met", func() {
+		// ARRANGE
+		s.RunTxFundersSuccess(&funderstypes.MsgFundPool{
+			Creator:          i.BOB,
+			PoolId:           0,
+			Amounts:          i.ACoins(100 * i.T_KYVE),
+			AmountsPerBundle: i.ACoins(2 * i.T_KYVE),
+			Constraints: &funderstypes.FundingConstraints{
+				MinValidVoteRatio: math.LegacyZeroDec(),
+				MinValidVoters:    4,
+			},
+		})
+
+		// ACT
+		payout, err := s.App().FundersKeeper.ChargeFundersOfPool(s.Ctx(), 0, 0, pooltypes.ModuleName, quality)
+		Expect(err).NotTo(HaveOccurred())
+
+		// ASSERT
+		Expect(payout.String()).To(Equal(i.ACoins(1 * i.T_KYVE).String()))
+
+		fundingBob, _ := s.App().FundersKeeper.GetFunding(s.Ctx(), i.BOB, 0)
+		Expect(fundingBob.Amounts.String()).To(Equal(i.ACoins(100 * i.T_KYVE).String()))
+	})
+
+	It("Charge funders with a max data size ratio which is exceeded", func() {
+		// ARRANGE
+		s.RunTxFundersSuccess(&funderstypes.MsgFundPool{
+			Creator:          i.BOB,
+			PoolId:           0,
+			Amounts:          i.ACoins(100 * i.T_KYVE),
+			AmountsPerBundle: i.ACoins(2 * i.T_KYVE),
+			Constraints: &funderstypes.FundingConstraints{
+				MinValidVoteRatio: math.LegacyZeroDec(),
+				MaxDataSizeRatio:  99,
+			},
+		})
+
+		// ACT
+		payout, err := s.App().FundersKeeper.ChargeFundersOfPool(s.Ctx(), 0, 0, pooltypes.ModuleName, quality)
+		Expect(err).NotTo(HaveOccurred())
+
+		// ASSERT
+		Expect(payout.String()).To(Equal(i.ACoins(1 * i.T_KYVE).String()))
+
+		fundingBob, _ := s.App().FundersKeeper.GetFunding(s.Ctx(), i.BOB, 0)
+		Expect(fundingBob.Amounts.String()).To(Equal(i.ACoins(100 * i.T_KYVE).String()))
+	})
+
+	It("Charge funders where all constraints are met", func() {
+		// ARRANGE
+		s.RunTxFundersSuccess(&funderstypes.MsgFundPool{
+			Creator:          i.BOB,
+			PoolId:           0,
+			Amounts:          i.ACoins(100 * i.T_KYVE),
+			AmountsPerBundle: i.ACoins(2 * i.T_KYVE),
+			Constraints: &funderstypes.FundingConstraints{
+				MinValidVoteRatio: math.LegacyMustNewDecFromStr("0.8"),
+				MinValidVoters:    3,
+				MaxDataSizeRatio:  100,
+			},
+		})
+
+		// ACT
+		payout, err := s.App().FundersKeeper.ChargeFundersOfPool(s.Ctx(), 0, 0, pooltypes.ModuleName, quality)
+		Expect(err).NotTo(HaveOccurred())
+
+		// ASSERT
+		Expect(payout.String()).To(Equal(i.ACoins(3 * i.T_KYVE).String()))
+
+		fundingBob, _ := s.App().FundersKeeper.GetFunding(s.Ctx(), i.BOB, 0)
+		Expect(fundingBob.Amounts.String()).To(Equal(i.ACoins(98 * i.T_KYVE).String()))
+		Expect(fundingBob.TotalFunded.String()).To(Equal(i.ACoins(2 * i.T_KYVE).String()))
+	})
+})
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	storeTypes "cosmossdk.io/store/types"
+	"github.com/KYVENetwork/chain/x/funders/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// recordFundingContribution stores the amounts a funder paid for a bundle.
+func (k Keeper) recordFundingContribution(ctx sdk.Context, poolId uint64, bundleId uint64, funderAddress string, amounts sdk.Coins) {
+	if amounts.IsZero() {
+		return
+	}
+
+	k.SetFundingContribution(ctx, &types.FundingContribution{
+		PoolId:        poolId,
+		BundleId:      bundleId,
+		FunderAddress: funderAddress,
+		Amounts:       amounts,
+	})
+}
+
+// pruneFundingContributions removes the contributions of a pool which are outside
+// the retention window, relative to the given bundle id. Since the contributions
+// are ordered by their bundle id the iteration stops at the first one which is kept.
+// At most MaxFundingContributionPrunes contributions are removed per call, the rest
+// is removed with the next bundles.
+func (k Keeper) pruneFundingContributions(ctx sdk.Context, poolId uint64, bundleId uint64) {
+	retention := k.GetParams(ctx).FundingLedgerRetention
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.FundingContributionKeyPrefixByPool)
+
+	iterator := storeTypes.KVStorePrefixIterator(store, types.FundingContributionKeyByPoolIter(poolId))
+
+	expired := make([]types.FundingContribution, 0)
+	for ; iterator.Valid() && len(expired) < types.MaxFundingContributionPrunes; iterator.Next() {
+		var contribution types.FundingContribution
+		k.cdc.MustUnmarshal(iterator.Value(), &contribution)
+
+		if contribution.BundleId+retention > bundleId {
+			break
+		}
+
+		expired = append(expired, contribution)
+	}
+	iterator.Close()
+
+	for _, contribution := range expired {
+		k.RemoveFundingContribution(ctx, &contribution)
+	}
+}
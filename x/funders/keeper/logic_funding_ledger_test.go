@@ -0,0 +1,215 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	funderstypes "github.com/KYVENetwork/chain/x/funders/types"
+	globaltypes "github.com/KYVENetwork/chain/x/global/types"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	querytypes "github.com/KYVENetwork/chain/x/query/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+/*
+
+TEST CASES - logic_funding_ledger.go
+
+* Record the contributions of all funders to a bundle
+* Do not record contributions of skipped fundings
+* Query the bundles a funder paid for
+* Remove contributions outside the retention window
+* Remove a limited number of contributions per bundle
+
+*/
+
+var _ = Describe("logic_funding_ledger.go", Ordered, func() {
+	s := i.NewCleanChain()
+
+	BeforeEach(func() {
+		// init new clean chain
+		s = i.NewCleanChain()
+
+		// create clean pool for every test case
+		gov := s.App().GovKeeper.GetGovernanceAccount(s.Ctx()).GetAddress().String()
+		s.RunTxPoolSuccess(&pooltypes.MsgCreatePool{
+			Authority:            gov,
+			UploadInterval:       60,
+			InflationShareWeight: math.LegacyNewDec(10_000),
+			MinDelegation:        100 * i.KYVE,
+			MaxBundleSize:        100,
+			Binaries:             "{}",
+		})
+
+		// set whitelist and enable the funding ledger
+		params := funderstypes.NewParams([]*funderstypes.WhitelistCoinEntry{
+			{
+				CoinDenom:                 globaltypes.Denom,
+				MinFundingAmount:          math.NewIntFromUint64(10 * i.KYVE),
+				MinFundingAmountPerBundle: math.NewIntFromUint64(1 * i.KYVE),
+				CoinWeight:                math.LegacyNewDec(1),
+			},
+			{
+				CoinDenom:                 i.A_DENOM,
+				MinFundingAmount:          math.NewIntFromUint64(10 * i.KYVE),
+				MinFundingAmountPerBundle: math.NewIntFromUint64(1 * i.KYVE),
+				CoinWeight:                math.LegacyNewDec(1),
+			},
+		}, 20)
+		params.FundingLedgerRetention = 2
+		s.App().FundersKeeper.SetParams(s.Ctx(), params)
+
+		// create funders
+		s.RunTxFundersSuccess(&funderstypes.MsgCreateFunder{
+			Creator: i.ALICE,
+			Moniker: "Alice",
+		})
+		s.RunTxFundersSuccess(&funderstypes.MsgCreateFunder{
+			Creator: i.BOB,
+			Moniker: "Bob",
+		})
+
+		// fund pool
+		s.RunTxFundersSuccess(&funderstypes.MsgFundPool{
+			Creator:          i.ALICE,
+			PoolId:           0,
+			Amounts:          i.ACoins(100 * i.T_KYVE),
+			AmountsPerBundle: i.ACoins(1 * i.T_KYVE),
+		})
+		s.RunTxFundersSuccess(&funderstypes.MsgFundPool{
+			Creator:          i.BOB,
+			PoolId:           0,
+			Amounts:          i.ACoins(100 * i.T_KYVE),
+			AmountsPerBundle: i.ACoins(2 * i.T_KYVE),
+		})
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Record the contributions of all funders to a bundle", func() {
+		// ACT
+		_, err := s.App().FundersKeeper.ChargeFundersOfPool(s.Ctx(), 0, 0, pooltypes.ModuleName, funderstypes.BundleQuality{})
+		Expect(err).NotTo(HaveOccurred())
+
+		// ASSERT
+		res, err := s.App().QueryKeeper.BundleFunders(s.Ctx(), &querytypes.QueryBundleFundersRequest{PoolId: 0, BundleId: 0})
+		Expect(err).To(BeNil())
+		Expect(res.Contributions).To(HaveLen(2))
+
+		for _, contribution := range res.Contributions {
+			Expect(contribution.PoolId).To(Equal(uint64(0)))
+			Expect(contribution.BundleId).To(Equal(uint64(0)))
+
+			switch contribution.FunderAddress {
+			case i.ALICE:
+				Expect(contribution.Amounts.String()).To(Equal(i.ACoins(1 * i.T_KYVE).String()))
+			case i.BOB:
+				Expect(contribution.Amounts.String()).To(Equal(i.ACoins(2 * i.T_KYVE).String()))
+			default:
+				Fail("unexpected funder " + contribution.FunderAddress)
+			}
+		}
+	})
+
+	It("Do not record contributions of skipped fundings", func() {
+		// ARRANGE
+		s.RunTxFundersSuccess(&funderstypes.MsgFundPool{
+			Creator: i.BOB,
+			PoolId:  0,
+			Constraints: &funderstypes.FundingConstraints{
+				MinValidVoteRatio: math.LegacyZeroDec(),
+				MinValidVoters:    2,
+			},
+		})
+
+		// ACT
+		_, err := s.App().FundersKeeper.ChargeFundersOfPool(s.Ctx(), 0, 0, pooltypes.ModuleName, funderstypes.BundleQuality{})
+		Expect(err).NotTo(HaveOccurred())
+
+		// ASSERT
+		contributions := s.App().FundersKeeper.GetFundingContributionsOfBundle(s.Ctx(), 0, 0)
+		Expect(contributions).To(HaveLen(1))
+		Expect(contributions[0].FunderAddress).To(Equal(i.ALICE))
+	})
+
+	It("Query the bundles a funder paid for", func() {
+		// ACT
+		_, err := s.App().FundersKeeper.ChargeFundersOfPool(s.Ctx(), 0, 0, pooltypes.ModuleName, funderstypes.BundleQuality{})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = s.App().FundersKeeper.ChargeFundersOfPool(s.Ctx(), 0, 1, pooltypes.ModuleName, funderstypes.BundleQuality{})
+		Expect(err).NotTo(HaveOccurred())
+
+		// ASSERT
+		res, err := s.App().QueryKeeper.FundedBundles(s.Ctx(), &querytypes.QueryFundedBundlesRequest{Address: i.ALICE, PoolId: 0})
+		Expect(err).To(BeNil())
+		Expect(res.Contributions).To(HaveLen(2))
+		Expect(res.Contributions[0].BundleId).To(Equal(uint64(0)))
+		Expect(res.Contributions[1].BundleId).To(Equal(uint64(1)))
+		Expect(res.Contributions[1].Amounts.String()).To(Equal(i.ACoins(1 * i.T_KYVE).String()))
+
+		res, err = s.App().QueryKeeper.FundedBundles(s.Ctx(), &querytypes.QueryFundedBundlesRequest{Address: i.CHARLIE, PoolId: 0})
+		Expect(err).To(BeNil())
+		Expect(res.Contributions).To(BeEmpty())
+	})
+
+	It("Remove contributions outside the retention window", func() {
+		// ACT
+		for bundleId := uint64(0); bundleId < 4; bundleId++ {
+			_, err := s.App().FundersKeeper.ChargeFundersOfPool(s.Ctx(), 0, bundleId, pooltypes.ModuleName, funderstypes.BundleQuality{})
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		// ASSERT
+		Expect(s.App().FundersKeeper.GetFundingContributionsOfBundle(s.Ctx(), 0, 0)).To(BeEmpty())
+		Expect(s.App().FundersKeeper.GetFundingContributionsOfBundle(s.Ctx(), 0, 1)).To(BeEmpty())
+		Expect(s.App().FundersKeeper.GetFundingContributionsOfBundle(s.Ctx(), 0, 2)).To(HaveLen(2))
+		Expect(s.App().FundersKeeper.GetFundingContributionsOfBundle(s.Ctx(), 0, 3)).To(HaveLen(2))
+
+		res, err := s.App().QueryKeeper.FundedBundles(s.Ctx(), &querytypes.QueryFundedBundlesRequest{Address: i.BOB, PoolId: 0})
+		Expect(err).To(BeNil())
+		Expect(res.Contributions).To(HaveLen(2))
+		Expect(res.Contributions[0].BundleId).To(Equal(uint64(2)))
+
+		Expect(s.App().FundersKeeper.GetAllFundingContributions(s.Ctx())).To(HaveLen(4))
+	})
+
+	It("Remove a limited number of contributions per bundle", func() {
+		// ARRANGE
+		for t := 0; t < 50; t++ {
+			for _, funderAddress := range []string{i.DUMMY[t], i.VALDUMMY[t]} {
+				s.App().FundersKeeper.SetFundingContribution(s.Ctx(), &funderstypes.FundingContribution{
+					PoolId:        0,
+					BundleId:      0,
+					FunderAddress: funderAddress,
+					Amounts:       i.ACoins(1 * i.T_KYVE),
+				})
+			}
+			s.App().FundersKeeper.SetFundingContribution(s.Ctx(), &funderstypes.FundingContribution{
+				PoolId:        0,
+				BundleId:      1,
+				FunderAddress: i.DUMMY[t],
+				Amounts:       i.ACoins(1 * i.T_KYVE),
+			})
+		}
+
+		// ACT
+		_, err := s.App().FundersKeeper.ChargeFundersOfPool(s.Ctx(), 0, 3, pooltypes.ModuleName, funderstypes.BundleQuality{})
+		Expect(err).NotTo(HaveOccurred())
+
+		// ASSERT
+		Expect(s.App().FundersKeeper.GetFundingContributionsOfBundle(s.Ctx(), 0, 0)).To(BeEmpty())
+		Expect(s.App().FundersKeeper.GetFundingContributionsOfBundle(s.Ctx(), 0, 1)).To(HaveLen(50))
+		Expect(s.App().FundersKeeper.GetFundingContributionsOfBundle(s.Ctx(), 0, 3)).To(HaveLen(2))
+
+		// ACT
+		_, err = s.App().FundersKeeper.ChargeFundersOfPool(s.Ctx(), 0, 4, pooltypes.ModuleName, funderstypes.BundleQuality{})
+		Expect(err).NotTo(HaveOccurred())
+
+		// ASSERT
+		Expect(s.App().FundersKeeper.GetFundingContributionsOfBundle(s.Ctx(), 0, 1)).To(BeEmpty())
+		Expect(s.App().FundersKeeper.GetFundingContributionsOfBundle(s.Ctx(), 0, 3)).To(HaveLen(2))
+		Expect(s.App().FundersKeeper.GetFundingContributionsOfBundle(s.Ctx(), 0, 4)).To(HaveLen(2))
+	})
+})
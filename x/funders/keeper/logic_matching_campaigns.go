@@ -0,0 +1,220 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	"github.com/KYVENetwork/chain/x/funders/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// matchFunding adds the matching amounts of all active campaigns which are
+// eligible for the given pool to the fundings of their sponsors. Matching is
+// best effort, if a matched funding is not compatible with the module params
+// or can not get a funding slot the campaign is skipped.
+func (k Keeper) matchFunding(ctx sdk.Context, funderAddress string, poolId uint64, amounts sdk.Coins) {
+	if amounts.IsZero() {
+		return
+	}
+
+	now := uint64(ctx.BlockTime().Unix())
+
+	for _, id := range k.GetMatchingCampaignIdsOfPool(ctx, poolId) {
+		campaign, found := k.GetMatchingCampaign(ctx, id)
+		if !found {
+			continue
+		}
+
+		// campaigns which expired since the last funding get removed from the pool index
+		if !campaign.IsActive(now) {
+			k.SetMatchingCampaign(ctx, &campaign)
+			continue
+		}
+
+		// sponsors can not match their own fundings
+		if campaign.Sponsor == funderAddress {
+			continue
+		}
+
+		matched := campaign.MatchAmounts(amounts)
+		if matched.IsZero() {
+			continue
+		}
+
+		// only write the changes if the matched funding was added successfully
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.addMatchedFunding(cacheCtx, &campaign, funderAddress, poolId, matched); err != nil {
+			k.Logger().Info("failed to match funding", "campaign", campaign.Id, "pool", poolId, "error", err.Error())
+			continue
+		}
+		write()
+	}
+}
+
+// addMatchedFunding adds the matched amounts from the escrow of the campaign to the
+// funding of the sponsor in the given pool. Since the escrow is already held by the
+// module no coins are transferred. The matched amounts are recorded per funder, so
+// they stay locked until the campaign ends and can be reversed if the funder defunds.
+func (k Keeper) addMatchedFunding(ctx sdk.Context, campaign *types.MatchingCampaign, funderAddress string, poolId uint64, matched sdk.Coins) error {
+	fundingState, found := k.GetFundingState(ctx, poolId)
+	if !found {
+		return errors.Wrapf(errorsTypes.ErrNotFound, types.ErrFundingStateDoesNotExist.Error(), poolId)
+	}
+
+	funding, found := k.GetFunding(ctx, campaign.Sponsor, poolId)
+	if found {
+		funding.Amounts = funding.Amounts.Add(matched...)
+
+		// use the amounts per bundle of the campaign for all newly funded coins
+		for _, coin := range campaign.AmountsPerBundle {
+			if f, _ := funding.AmountsPerBundle.Find(coin.Denom); !f {
+				funding.AmountsPerBundle = funding.AmountsPerBundle.Add(coin)
+			}
+		}
+	} else {
+		funding = types.Funding{
+			FunderAddress:    campaign.Sponsor,
+			PoolId:           poolId,
+			Amounts:          matched,
+			AmountsPerBundle: campaign.AmountsPerBundle,
+			TotalFunded:      sdk.NewCoins(),
+		}
+	}
+
+	if err := k.ensureParamsCompatibility(ctx, &funding); err != nil {
+		return err
+	}
+
+	if err := k.ensureFreeSlot(ctx, &funding, &fundingState); err != nil {
+		return err
+	}
+
+	campaign.Remaining = campaign.Remaining.Sub(matched...)
+
+	matchedFunding, found := k.GetMatchedFunding(ctx, poolId, funderAddress, campaign.Id)
+	if !found {
+		matchedFunding = types.MatchedFunding{
+			CampaignId: campaign.Id,
+			PoolId:     poolId,
+			Sponsor:    campaign.Sponsor,
+			Funder:     funderAddress,
+			Amounts:    sdk.NewCoins(),
+		}
+	}
+	matchedFunding.Amounts = matchedFunding.Amounts.Add(matched...)
+
+	fundingState.SetActive(&funding)
+	k.startFunding(ctx, &funding)
+	k.recordFunding(ctx, campaign.Sponsor, poolId, matched)
+	k.SetFunding(ctx, &funding)
+	k.SetFundingState(ctx, &fundingState)
+	k.SetMatchingCampaign(ctx, campaign)
+	k.SetMatchedFunding(ctx, &matchedFunding)
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventMatchFunding{
+		Id:      campaign.Id,
+		PoolId:  poolId,
+		Sponsor: campaign.Sponsor,
+		Funder:  funderAddress,
+		Amounts: matched.String(),
+	})
+
+	return nil
+}
+
+// reverseMatchedFundings takes back the amounts running campaigns matched for a
+// funder who defunded from a pool. The reversed amounts are removed from the
+// funding of the sponsor and returned to the escrow of the campaign, so the same
+// coins can not be used to drain a campaign by funding and defunding repeatedly.
+func (k Keeper) reverseMatchedFundings(ctx sdk.Context, funderAddress string, poolId uint64, defunded sdk.Coins) {
+	now := uint64(ctx.BlockTime().Unix())
+
+	for _, matchedFunding := range k.GetMatchedFundingsOfFunder(ctx, poolId, funderAddress) {
+		campaign, found := k.GetMatchingCampaign(ctx, matchedFunding.CampaignId)
+		if !found || now >= campaign.EndTime {
+			// matched fundings of ended campaigns belong to the sponsor
+			k.RemoveMatchedFunding(ctx, &matchedFunding)
+			continue
+		}
+
+		funding, found := k.GetFunding(ctx, campaign.Sponsor, poolId)
+		if !found {
+			k.RemoveMatchedFunding(ctx, &matchedFunding)
+			continue
+		}
+
+		// matched coins which were already paid out can not be reversed
+		reversed := campaign.ReverseAmounts(defunded).Min(matchedFunding.Amounts).Min(funding.Amounts)
+		if reversed.IsZero() {
+			continue
+		}
+
+		fundingState, found := k.GetFundingState(ctx, poolId)
+		if !found {
+			continue
+		}
+
+		funding.Amounts = funding.Amounts.Sub(reversed...)
+		if funding.Amounts.IsZero() {
+			fundingState.SetInactive(&funding)
+			k.endFunding(ctx, &funding)
+		}
+
+		matchedFunding.Amounts = matchedFunding.Amounts.Sub(reversed...)
+		if matchedFunding.Amounts.IsZero() {
+			k.RemoveMatchedFunding(ctx, &matchedFunding)
+		} else {
+			k.SetMatchedFunding(ctx, &matchedFunding)
+		}
+
+		campaign.Remaining = campaign.Remaining.Add(reversed...)
+
+		k.SetFunding(ctx, &funding)
+		k.SetFundingState(ctx, &fundingState)
+		k.SetMatchingCampaign(ctx, &campaign)
+
+		_ = ctx.EventManager().EmitTypedEvent(&types.EventReverseMatchFunding{
+			Id:      campaign.Id,
+			PoolId:  poolId,
+			Sponsor: campaign.Sponsor,
+			Funder:  funderAddress,
+			Amounts: reversed.String(),
+		})
+	}
+}
+
+// getLockedMatchedAmounts returns the amounts of the funding of a sponsor in a
+// pool which were matched by campaigns that have not ended yet. These amounts
+// can not be defunded by the sponsor.
+func (k Keeper) getLockedMatchedAmounts(ctx sdk.Context, sponsorAddress string, poolId uint64) sdk.Coins {
+	now := uint64(ctx.BlockTime().Unix())
+
+	locked := sdk.NewCoins()
+	endTimes := make(map[uint64]uint64)
+
+	for _, matchedFunding := range k.GetMatchedFundingsOfSponsor(ctx, poolId, sponsorAddress) {
+		endTime, ok := endTimes[matchedFunding.CampaignId]
+		if !ok {
+			campaign, _ := k.GetMatchingCampaign(ctx, matchedFunding.CampaignId)
+			endTime = campaign.EndTime
+			endTimes[matchedFunding.CampaignId] = endTime
+		}
+
+		if now < endTime {
+			locked = locked.Add(matchedFunding.Amounts...)
+		}
+	}
+
+	return locked
+}
+
+// removeMatchedFundings removes all matched fundings of an ended campaign. Once
+// a campaign has ended the matched amounts belong to the sponsor.
+func (k Keeper) removeMatchedFundings(ctx sdk.Context, campaign *types.MatchingCampaign) {
+	for _, poolId := range campaign.PoolIds {
+		for _, matchedFunding := range k.GetMatchedFundingsOfSponsor(ctx, poolId, campaign.Sponsor) {
+			if matchedFunding.CampaignId == campaign.Id {
+				k.RemoveMatchedFunding(ctx, &matchedFunding)
+			}
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+	"slices"
+
+	"cosmossdk.io/errors"
+	"github.com/KYVENetwork/chain/x/funders/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// AttestFunder allows a funder attestor to mark the profile of a funder as
+// verified until the given expiry. An existing attestation is replaced.
+func (k msgServer) AttestFunder(goCtx context.Context, msg *types.MsgAttestFunder) (*types.MsgAttestFunderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Only funder attestors are allowed to verify funders
+	if !slices.Contains(k.GetParams(ctx).FunderAttestors, msg.Creator) {
+		return nil, errors.Wrapf(errorsTypes.ErrUnauthorized, types.ErrNotFunderAttestor.Error(), msg.Creator)
+	}
+
+	// Funder has to exist
+	if !k.DoesFunderExist(ctx, msg.Funder) {
+		return nil, errors.Wrapf(errorsTypes.ErrNotFound, types.ErrFunderDoesNotExist.Error(), msg.Funder)
+	}
+
+	// Expiry has to be in the future
+	if msg.Expiry <= uint64(ctx.BlockTime().Unix()) {
+		return nil, errors.Wrapf(errorsTypes.ErrInvalidRequest, types.ErrInvalidExpiration.Error(), msg.Expiry)
+	}
+
+	k.SetFunderAttestation(ctx, &types.FunderAttestation{
+		FunderAddress: msg.Funder,
+		Attestor:      msg.Creator,
+		Reason:        msg.Reason,
+		Expiry:        msg.Expiry,
+		CreatedAt:     uint64(ctx.BlockTime().Unix()),
+	})
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventAttestFunder{
+		Funder:   msg.Funder,
+		Attestor: msg.Creator,
+		Reason:   msg.Reason,
+		Expiry:   msg.Expiry,
+	})
+
+	return &types.MsgAttestFunderResponse{}, nil
+}
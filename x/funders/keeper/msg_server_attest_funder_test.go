@@ -0,0 +1,272 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	funderstypes "github.com/KYVENetwork/chain/x/funders/types"
+	globaltypes "github.com/KYVENetwork/chain/x/global/types"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	querytypes "github.com/KYVENetwork/chain/x/query/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+/*
+
+TEST CASES - msg_server_attest_funder.go, msg_server_revoke_funder_attestation.go, logic_funder_profile.go
+
+* Attest a funder and query it
+* Try to attest a funder as a non-attestor
+* Try to attest a non-existent funder
+* Try to attest a funder with an expiry in the past
+* Funder is no longer verified after the attestation expired
+* Funder is no longer verified after the attestor got removed
+* Revoke an attestation
+* Try to revoke a non-existent attestation
+* Record the lifetime funded amounts and pools of a funder
+* Record the average funding duration of a funder
+
+*/
+
+var _ = Describe("msg_server_attest_funder.go", Ordered, func() {
+	s := i.NewCleanChain()
+
+	BeforeEach(func() {
+		// init new clean chain
+		s = i.NewCleanChain()
+
+		// create clean pools for every test case
+		gov := s.App().GovKeeper.GetGovernanceAccount(s.Ctx()).GetAddress().String()
+		msg := &pooltypes.MsgCreatePool{
+			Authority:            gov,
+			UploadInterval:       60,
+			InflationShareWeight: math.LegacyNewDec(10_000),
+			MinDelegation:        100 * i.KYVE,
+			MaxBundleSize:        100,
+			Binaries:             "{}",
+		}
+		s.RunTxPoolSuccess(msg)
+		s.RunTxPoolSuccess(msg)
+
+		// set whitelist and attestors
+		params := funderstypes.NewParams([]*funderstypes.WhitelistCoinEntry{
+			{
+				CoinDenom:                 globaltypes.Denom,
+				MinFundingAmount:          math.NewIntFromUint64(10 * i.KYVE),
+				MinFundingAmountPerBundle: math.NewIntFromUint64(1 * i.KYVE),
+				CoinWeight:                math.LegacyNewDec(1),
+			},
+			{
+				CoinDenom:                 i.A_DENOM,
+				MinFundingAmount:          math.NewIntFromUint64(10 * i.KYVE),
+				MinFundingAmountPerBundle: math.NewIntFromUint64(1 * i.KYVE),
+				CoinWeight:                math.LegacyNewDec(1),
+			},
+		}, 20)
+		params.FunderAttestors = []string{i.BOB}
+		s.App().FundersKeeper.SetParams(s.Ctx(), params)
+
+		// create funder
+		s.RunTxFundersSuccess(&funderstypes.MsgCreateFunder{
+			Creator: i.ALICE,
+			Moniker: "Alice",
+		})
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Attest a funder and query it", func() {
+		// ACT
+		expiry := uint64(s.Ctx().BlockTime().Unix()) + 3600
+		s.RunTxFundersSuccess(&funderstypes.MsgAttestFunder{
+			Creator: i.BOB,
+			Funder:  i.ALICE,
+			Reason:  "KYC",
+			Expiry:  expiry,
+		})
+
+		// ASSERT
+		Expect(s.App().FundersKeeper.IsFunderVerified(s.Ctx(), i.ALICE)).To(BeTrue())
+
+		res, err := s.App().QueryKeeper.Funder(s.Ctx(), &querytypes.QueryFunderRequest{Address: i.ALICE})
+		Expect(err).To(BeNil())
+		Expect(res.Funder.Verified).To(BeTrue())
+		Expect(res.Funder.Attestation.Attestor).To(Equal(i.BOB))
+		Expect(res.Funder.Attestation.Reason).To(Equal("KYC"))
+		Expect(res.Funder.Attestation.Expiry).To(Equal(expiry))
+	})
+
+	It("Try to attest a funder as a non-attestor", func() {
+		// ACT
+		s.RunTxFundersError(&funderstypes.MsgAttestFunder{
+			Creator: i.CHARLIE,
+			Funder:  i.ALICE,
+			Reason:  "KYC",
+			Expiry:  uint64(s.Ctx().BlockTime().Unix()) + 3600,
+		})
+
+		// ASSERT
+		_, found := s.App().FundersKeeper.GetFunderAttestation(s.Ctx(), i.ALICE)
+		Expect(found).To(BeFalse())
+	})
+
+	It("Try to attest a non-existent funder", func() {
+		// ACT
+		s.RunTxFundersError(&funderstypes.MsgAttestFunder{
+			Creator: i.BOB,
+			Funder:  i.CHARLIE,
+			Reason:  "KYC",
+			Expiry:  uint64(s.Ctx().BlockTime().Unix()) + 3600,
+		})
+
+		// ASSERT
+		Expect(s.App().FundersKeeper.GetAllFunderAttestations(s.Ctx())).To(BeEmpty())
+	})
+
+	It("Try to attest a funder with an expiry in the past", func() {
+		// ACT
+		s.RunTxFundersError(&funderstypes.MsgAttestFunder{
+			Creator: i.BOB,
+			Funder:  i.ALICE,
+			Reason:  "KYC",
+			Expiry:  uint64(s.Ctx().BlockTime().Unix()),
+		})
+
+		// ASSERT
+		Expect(s.App().FundersKeeper.GetAllFunderAttestations(s.Ctx())).To(BeEmpty())
+	})
+
+	It("Funder is no longer verified after the attestation expired", func() {
+		// ARRANGE
+		s.RunTxFundersSuccess(&funderstypes.MsgAttestFunder{
+			Creator: i.BOB,
+			Funder:  i.ALICE,
+			Reason:  "KYC",
+			Expiry:  uint64(s.Ctx().BlockTime().Unix()) + 60,
+		})
+		Expect(s.App().FundersKeeper.IsFunderVerified(s.Ctx(), i.ALICE)).To(BeTrue())
+
+		// ACT
+		s.CommitAfterSeconds(60)
+
+		// ASSERT
+		Expect(s.App().FundersKeeper.IsFunderVerified(s.Ctx(), i.ALICE)).To(BeFalse())
+
+		res, err := s.App().QueryKeeper.Funder(s.Ctx(), &querytypes.QueryFunderRequest{Address: i.ALICE})
+		Expect(err).To(BeNil())
+		Expect(res.Funder.Verified).To(BeFalse())
+		Expect(res.Funder.Attestation).NotTo(BeNil())
+	})
+
+	It("Funder is no longer verified after the attestor got removed", func() {
+		// ARRANGE
+		s.RunTxFundersSuccess(&funderstypes.MsgAttestFunder{
+			Creator: i.BOB,
+			Funder:  i.ALICE,
+			Reason:  "KYC",
+			Expiry:  uint64(s.Ctx().BlockTime().Unix()) + 3600,
+		})
+
+		// ACT
+		params := s.App().FundersKeeper.GetParams(s.Ctx())
+		params.FunderAttestors = []string{}
+		s.App().FundersKeeper.SetParams(s.Ctx(), params)
+
+		// ASSERT
+		Expect(s.App().FundersKeeper.IsFunderVerified(s.Ctx(), i.ALICE)).To(BeFalse())
+	})
+
+	It("Revoke an attestation", func() {
+		// ARRANGE
+		s.RunTxFundersSuccess(&funderstypes.MsgAttestFunder{
+			Creator: i.BOB,
+			Funder:  i.ALICE,
+			Reason:  "KYC",
+			Expiry:  uint64(s.Ctx().BlockTime().Unix()) + 3600,
+		})
+
+		// ACT
+		s.RunTxFundersSuccess(&funderstypes.MsgRevokeFunderAttestation{
+			Creator: i.BOB,
+			Funder:  i.ALICE,
+		})
+
+		// ASSERT
+		Expect(s.App().FundersKeeper.IsFunderVerified(s.Ctx(), i.ALICE)).To(BeFalse())
+
+		res, err := s.App().QueryKeeper.Funder(s.Ctx(), &querytypes.QueryFunderRequest{Address: i.ALICE})
+		Expect(err).To(BeNil())
+		Expect(res.Funder.Attestation).To(BeNil())
+	})
+
+	It("Try to revoke a non-existent attestation", func() {
+		// ACT
+		s.RunTxFundersError(&funderstypes.MsgRevokeFunderAttestation{
+			Creator: i.BOB,
+			Funder:  i.ALICE,
+		})
+	})
+
+	It("Record the lifetime funded amounts and pools of a funder", func() {
+		// ACT
+		s.RunTxFundersSuccess(&funderstypes.MsgFundPool{
+			Creator:          i.ALICE,
+			PoolId:           1,
+			Amounts:          i.ACoins(100 * i.T_KYVE),
+			AmountsPerBundle: i.ACoins(1 * i.T_KYVE),
+		})
+		s.RunTxFundersSuccess(&funderstypes.MsgFundPool{
+			Creator:          i.ALICE,
+			PoolId:           0,
+			Amounts:          i.ACoins(50 * i.T_KYVE),
+			AmountsPerBundle: i.ACoins(1 * i.T_KYVE),
+		})
+		s.RunTxFundersSuccess(&funderstypes.MsgDefundPool{
+			Creator: i.ALICE,
+			PoolId:  0,
+			Amounts: i.ACoins(20 * i.T_KYVE),
+		})
+
+		// ASSERT
+		res, err := s.App().QueryKeeper.Funder(s.Ctx(), &querytypes.QueryFunderRequest{Address: i.ALICE})
+		Expect(err).To(BeNil())
+		Expect(res.Funder.LifetimeStats.LifetimeFunded.String()).To(Equal(i.ACoins(150 * i.T_KYVE).String()))
+		Expect(res.Funder.LifetimeStats.PoolsFunded).To(Equal([]uint64{0, 1}))
+		Expect(res.Funder.LifetimeStats.AverageFundingDuration).To(BeZero())
+	})
+
+	It("Record the average funding duration of a funder", func() {
+		// ARRANGE
+		s.RunTxFundersSuccess(&funderstypes.MsgFundPool{
+			Creator:          i.ALICE,
+			PoolId:           0,
+			Amounts:          i.ACoins(100 * i.T_KYVE),
+			AmountsPerBundle: i.ACoins(1 * i.T_KYVE),
+		})
+		start := uint64(s.Ctx().BlockTime().Unix())
+
+		s.CommitAfterSeconds(100)
+
+		// ACT
+		s.RunTxFundersSuccess(&funderstypes.MsgDefundPool{
+			Creator: i.ALICE,
+			PoolId:  0,
+			Amounts: i.ACoins(100 * i.T_KYVE),
+		})
+		duration := uint64(s.Ctx().BlockTime().Unix()) - start
+
+		// ASSERT
+		funding, _ := s.App().FundersKeeper.GetFunding(s.Ctx(), i.ALICE, 0)
+		Expect(funding.ActiveSince).To(BeZero())
+
+		stats := s.App().FundersKeeper.GetFunderStats(s.Ctx(), i.ALICE)
+		Expect(stats.CompletedFundings).To(Equal(uint64(1)))
+		Expect(stats.TotalFundingDuration).To(Equal(duration))
+
+		res, err := s.App().QueryKeeper.Funder(s.Ctx(), &querytypes.QueryFunderRequest{Address: i.ALICE})
+		Expect(err).To(BeNil())
+		Expect(res.Funder.LifetimeStats.AverageFundingDuration).To(Equal(duration))
+	})
+})
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"github.com/KYVENetwork/chain/x/funders/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CreateMatchingCampaign escrows the coins of a sponsor which are then used
+// to match the fundings of other funders in the given pools until the
+// campaign ends.
+func (k msgServer) CreateMatchingCampaign(goCtx context.Context, msg *types.MsgCreateMatchingCampaign) (*types.MsgCreateMatchingCampaignResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Sponsor has to be a funder
+	if !k.DoesFunderExist(ctx, msg.Creator) {
+		return nil, errors.Wrapf(errorsTypes.ErrNotFound, types.ErrFunderDoesNotExist.Error(), msg.Creator)
+	}
+
+	// Pools have to exist
+	for _, poolId := range msg.PoolIds {
+		if err := k.poolKeeper.AssertPoolExists(ctx, poolId); err != nil {
+			return nil, err
+		}
+	}
+
+	// End time has to be in the future
+	if msg.EndTime <= uint64(ctx.BlockTime().Unix()) {
+		return nil, errors.Wrapf(errorsTypes.ErrInvalidRequest, types.ErrInvalidEndTime.Error(), msg.EndTime)
+	}
+
+	// Escrowed coins have to be whitelisted
+	whitelist := k.GetCoinWhitelistMap(ctx)
+	for _, coin := range msg.Amounts {
+		if _, found := whitelist[coin.Denom]; !found {
+			return nil, errors.Wrapf(errorsTypes.ErrInvalidRequest, types.ErrCoinNotWhitelisted.Error())
+		}
+	}
+
+	// Transfer the escrow from the sponsor to the module
+	sender := sdk.MustAccAddressFromBech32(msg.Creator)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, msg.Amounts); err != nil {
+		return nil, err
+	}
+
+	id := k.AppendMatchingCampaign(ctx, types.MatchingCampaign{
+		Sponsor:          msg.Creator,
+		PoolIds:          msg.PoolIds,
+		MatchRatio:       msg.MatchRatio,
+		Cap:              msg.Amounts,
+		Remaining:        msg.Amounts,
+		AmountsPerBundle: msg.AmountsPerBundle,
+		EndTime:          msg.EndTime,
+	})
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventCreateMatchingCampaign{
+		Id:         id,
+		Sponsor:    msg.Creator,
+		PoolIds:    msg.PoolIds,
+		MatchRatio: msg.MatchRatio,
+		Amounts:    msg.Amounts.String(),
+		EndTime:    msg.EndTime,
+	})
+
+	return &types.MsgCreateMatchingCampaignResponse{}, nil
+}
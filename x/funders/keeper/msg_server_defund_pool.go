@@ -15,13 +15,28 @@ import (
 // DefundPool handles the logic to defund a pool.
 // If the user is a funder, it will subtract the provided amount
 // and send the tokens back. If there are no more funds left, the funding will get inactive.
+// If the creator is a delegate of a funder, the tokens are sent back to the funder.
 func (k msgServer) DefundPool(goCtx context.Context, msg *types.MsgDefundPool) (*types.MsgDefundPoolResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	funderAddress := msg.GetFunderAddress()
+
+	// Delegates need the permission of the funder to defund
+	if funderAddress != msg.Creator {
+		funderDelegate, err := k.getActiveFunderDelegate(ctx, funderAddress, msg.Creator)
+		if err != nil {
+			return nil, err
+		}
+
+		if !funderDelegate.CanDefund {
+			return nil, errors.Wrapf(errorsTypes.ErrUnauthorized, types.ErrFunderDelegateUnauthorized.Error(), msg.Creator, "defund", funderAddress)
+		}
+	}
+
 	// Funding has to exist
-	funding, found := k.GetFunding(ctx, msg.Creator, msg.PoolId)
+	funding, found := k.GetFunding(ctx, funderAddress, msg.PoolId)
 	if !found {
-		return nil, errors.Wrapf(errorsTypes.ErrNotFound, types.ErrFundingDoesNotExist.Error(), msg.PoolId, msg.Creator)
+		return nil, errors.Wrapf(errorsTypes.ErrNotFound, types.ErrFundingDoesNotExist.Error(), msg.PoolId, funderAddress)
 	}
 
 	// FundingState has to exist
@@ -30,9 +45,16 @@ func (k msgServer) DefundPool(goCtx context.Context, msg *types.MsgDefundPool) (
 		util.PanicHalt(k.upgradeKeeper, ctx, fmt.Sprintf("FundingState for pool %d does not exist", msg.PoolId))
 	}
 
+	// Amounts matched by running campaigns are locked until the campaigns end
+	locked := k.getLockedMatchedAmounts(ctx, funderAddress, msg.PoolId).Min(funding.Amounts)
+	defundable := funding.Amounts.Sub(locked...)
+
 	// If funder defunds more than he has we defund the entire amount of that coin
-	defundAmounts := funding.Amounts.Min(msg.Amounts)
+	defundAmounts := defundable.Min(msg.Amounts)
 	if defundAmounts.IsZero() {
+		if !funding.Amounts.Min(msg.Amounts).IsZero() {
+			return nil, errors.Wrapf(errorsTypes.ErrInvalidRequest, types.ErrMatchedFundingLocked.Error(), funderAddress, msg.PoolId)
+		}
 		return nil, errors.Wrapf(errorsTypes.ErrInvalidRequest, types.ErrFundsTooLow.Error())
 	}
 
@@ -45,10 +67,11 @@ func (k msgServer) DefundPool(goCtx context.Context, msg *types.MsgDefundPool) (
 
 	if funding.Amounts.IsZero() {
 		fundingState.SetInactive(&funding)
+		k.endFunding(ctx, &funding)
 	}
 
-	// Transfer tokens from this module to sender.
-	recipient := sdk.MustAccAddressFromBech32(msg.Creator)
+	// Transfer tokens from this module to the funder.
+	recipient := sdk.MustAccAddressFromBech32(funding.FunderAddress)
 	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, defundAmounts); err != nil {
 		return nil, err
 	}
@@ -57,12 +80,19 @@ func (k msgServer) DefundPool(goCtx context.Context, msg *types.MsgDefundPool) (
 	k.SetFunding(ctx, &funding)
 	k.SetFundingState(ctx, &fundingState)
 
+	// Take back the amounts campaigns matched for the defunded coins
+	k.reverseMatchedFundings(ctx, funderAddress, msg.PoolId, defundAmounts)
+
 	// Emit a defund event.
-	_ = ctx.EventManager().EmitTypedEvent(&types.EventDefundPool{
+	event := types.EventDefundPool{
 		PoolId:  msg.PoolId,
-		Address: msg.Creator,
+		Address: funderAddress,
 		Amounts: defundAmounts.String(),
-	})
+	}
+	if funderAddress != msg.Creator {
+		event.Delegate = msg.Creator
+	}
+	_ = ctx.EventManager().EmitTypedEvent(&event)
 
 	return &types.MsgDefundPoolResponse{}, nil
 }
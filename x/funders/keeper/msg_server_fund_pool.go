@@ -14,12 +14,17 @@ import (
 // If the funders list is full, it checks if the funder wants to fund
 // more than the current lowest funder. If so, the current lowest funder
 // will get their tokens back and removed form the active funders list.
+// If the creator is a delegate of a funder, the creator pays for the funding
+// but the funding is attributed to the funder. Afterwards, the funded amounts
+// get matched by all eligible matching campaigns.
 func (k msgServer) FundPool(goCtx context.Context, msg *types.MsgFundPool) (*types.MsgFundPoolResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	funderAddress := msg.GetFunderAddress()
+
 	// Funder has to exist
-	if !k.DoesFunderExist(ctx, msg.Creator) {
-		return nil, errors.Wrapf(errorsTypes.ErrNotFound, types.ErrFunderDoesNotExist.Error(), msg.Creator)
+	if !k.DoesFunderExist(ctx, funderAddress) {
+		return nil, errors.Wrapf(errorsTypes.ErrNotFound, types.ErrFunderDoesNotExist.Error(), funderAddress)
 	}
 
 	// Pool has to exist
@@ -36,7 +41,37 @@ func (k msgServer) FundPool(goCtx context.Context, msg *types.MsgFundPool) (*typ
 	amountsPerBundle := msg.AmountsPerBundle
 
 	// Check if funding already exists
-	funding, found := k.GetFunding(ctx, msg.Creator, msg.PoolId)
+	funding, found := k.GetFunding(ctx, funderAddress, msg.PoolId)
+
+	// Delegates need the corresponding permissions of the funder
+	var funderDelegate *types.FunderDelegate
+	if funderAddress != msg.Creator {
+		delegate, err := k.getActiveFunderDelegate(ctx, funderAddress, msg.Creator)
+		if err != nil {
+			return nil, err
+		}
+
+		if !msg.Amounts.Empty() {
+			if !delegate.CanFund {
+				return nil, errors.Wrapf(errorsTypes.ErrUnauthorized, types.ErrFunderDelegateUnauthorized.Error(), msg.Creator, "fund", funderAddress)
+			}
+
+			if err := consumeSpendLimit(&delegate, msg.PoolId, msg.Amounts); err != nil {
+				return nil, err
+			}
+		}
+
+		if !delegate.CanUpdateAmountsPerBundle && amountsPerBundleChanged(&funding, found, msg.AmountsPerBundle) {
+			return nil, errors.Wrapf(errorsTypes.ErrUnauthorized, types.ErrFunderDelegateUnauthorized.Error(), msg.Creator, "update amounts per bundle", funderAddress)
+		}
+
+		if !delegate.CanUpdateAmountsPerBundle && msg.Constraints != nil {
+			return nil, errors.Wrapf(errorsTypes.ErrUnauthorized, types.ErrFunderDelegateUnauthorized.Error(), msg.Creator, "update constraints", funderAddress)
+		}
+
+		funderDelegate = &delegate
+	}
+
 	if found {
 		// If so, update funding amounts
 		funding.Amounts = funding.Amounts.Add(msg.Amounts...)
@@ -51,7 +86,7 @@ func (k msgServer) FundPool(goCtx context.Context, msg *types.MsgFundPool) (*typ
 	} else {
 		// If not, create new funding
 		funding = types.Funding{
-			FunderAddress:    msg.Creator,
+			FunderAddress:    funderAddress,
 			PoolId:           msg.PoolId,
 			Amounts:          msg.Amounts,
 			AmountsPerBundle: amountsPerBundle,
@@ -59,6 +94,11 @@ func (k msgServer) FundPool(goCtx context.Context, msg *types.MsgFundPool) (*typ
 		}
 	}
 
+	// Replace the constraints if provided
+	if msg.Constraints != nil {
+		funding.Constraints = *msg.Constraints
+	}
+
 	// Check if updated (or new) funding is compatible with module params
 	if err := k.ensureParamsCompatibility(ctx, &funding); err != nil {
 		return nil, err
@@ -71,7 +111,7 @@ func (k msgServer) FundPool(goCtx context.Context, msg *types.MsgFundPool) (*typ
 		return nil, err
 	}
 
-	// All checks passed, transfer funds from funder to module
+	// All checks passed, transfer funds from funder (or its delegate) to module
 	sender := sdk.MustAccAddressFromBech32(msg.Creator)
 	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, msg.Amounts); err != nil {
 		return nil, err
@@ -79,18 +119,32 @@ func (k msgServer) FundPool(goCtx context.Context, msg *types.MsgFundPool) (*typ
 
 	// Funding must be active
 	fundingState.SetActive(&funding)
+	k.startFunding(ctx, &funding)
+	k.recordFunding(ctx, funderAddress, msg.PoolId, msg.Amounts)
 
 	// Save funding and funding state
 	k.SetFunding(ctx, &funding)
 	k.SetFundingState(ctx, &fundingState)
 
+	// Save the reduced spend limit of the delegate
+	if funderDelegate != nil {
+		k.SetFunderDelegate(ctx, funderDelegate)
+	}
+
 	// Emit a fund event.
-	_ = ctx.EventManager().EmitTypedEvent(&types.EventFundPool{
+	event := types.EventFundPool{
 		PoolId:           msg.PoolId,
-		Address:          msg.Creator,
+		Address:          funderAddress,
 		Amounts:          msg.Amounts.String(),
 		AmountsPerBundle: msg.AmountsPerBundle.String(),
-	})
+	}
+	if funderDelegate != nil {
+		event.Delegate = msg.Creator
+	}
+	_ = ctx.EventManager().EmitTypedEvent(&event)
+
+	// Match the funding with all eligible matching campaigns
+	k.matchFunding(ctx, funderAddress, msg.PoolId, msg.Amounts)
 
 	return &types.MsgFundPoolResponse{}, nil
 }
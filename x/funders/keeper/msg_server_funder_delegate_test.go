@@ -0,0 +1,474 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	funderstypes "github.com/KYVENetwork/chain/x/funders/types"
+	globaltypes "github.com/KYVENetwork/chain/x/global/types"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	querytypes "github.com/KYVENetwork/chain/x/query/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+/*
+
+TEST CASES - msg_server_grant_funder_delegate.go, msg_server_revoke_funder_delegate.go
+
+* Grant a delegate and query it
+* Try to grant a delegate as a non-existent funder
+* Try to grant a delegate with an expiration in the past
+* Fund a pool on behalf of a funder
+* Try to fund a pool on behalf of a funder without permission
+* Try to fund a pool on behalf of a funder above the spend limit
+* Try to fund a pool on behalf of a funder in a pool without spend limit
+* Fund any pool on behalf of a funder as an unlimited delegate
+* Try to grant an unlimited delegate with spend limits
+* Create a new funding on behalf of a funder without permission to update the amounts per bundle
+* Try to change the amounts per bundle on behalf of a funder without permission
+* Try to fund a pool on behalf of a funder after the permissions expired
+* Defund a pool on behalf of a funder
+* Try to defund a pool on behalf of a funder without permission
+* Revoke a delegate
+* Try to revoke a non-existent delegate
+
+*/
+
+var _ = Describe("msg_server_funder_delegate.go", Ordered, func() {
+	s := i.NewCleanChain()
+
+	initialBalance := s.GetCoinsFromAddress(i.ALICE)
+
+	BeforeEach(func() {
+		// init new clean chain
+		s = i.NewCleanChain()
+
+		// create clean pools for every test case
+		gov := s.App().GovKeeper.GetGovernanceAccount(s.Ctx()).GetAddress().String()
+		msg := &pooltypes.MsgCreatePool{
+			Authority:            gov,
+			UploadInterval:       60,
+			InflationShareWeight: math.LegacyNewDec(10_000),
+			MinDelegation:        100 * i.KYVE,
+			MaxBundleSize:        100,
+			Binaries:             "{}",
+		}
+		s.RunTxPoolSuccess(msg)
+		s.RunTxPoolSuccess(msg)
+
+		// set whitelist
+		s.App().FundersKeeper.SetParams(s.Ctx(), funderstypes.NewParams([]*funderstypes.WhitelistCoinEntry{
+			{
+				CoinDenom:                 globaltypes.Denom,
+				MinFundingAmount:          math.NewIntFromUint64(10 * i.KYVE),
+				MinFundingAmountPerBundle: math.NewIntFromUint64(1 * i.KYVE),
+				CoinWeight:                math.LegacyNewDec(1),
+			},
+			{
+				CoinDenom:                 i.A_DENOM,
+				MinFundingAmount:          math.NewIntFromUint64(10 * i.KYVE),
+				MinFundingAmountPerBundle: math.NewIntFromUint64(1 * i.KYVE),
+				CoinWeight:                math.LegacyNewDec(1),
+			},
+		}, 20))
+
+		// create funder
+		s.RunTxFundersSuccess(&funderstypes.MsgCreateFunder{
+			Creator: i.ALICE,
+			Moniker: "Alice",
+		})
+
+		// fund pool
+		s.RunTxFundersSuccess(&funderstypes.MsgFundPool{
+			Creator:          i.ALICE,
+			PoolId:           0,
+			Amounts:          i.ACoins(100 * i.T_KYVE),
+			AmountsPerBundle: i.ACoins(1 * i.T_KYVE),
+		})
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Grant a delegate and query it", func() {
+		// ACT
+		s.RunTxFundersSuccess(&funderstypes.MsgGrantFunderDelegate{
+			Creator:  i.ALICE,
+			Delegate: i.BOB,
+			CanFund:  true,
+			SpendLimits: []funderstypes.FunderSpendLimit{
+				{PoolId: 0, Amounts: i.ACoins(100 * i.T_KYVE)},
+			},
+		})
+
+		// ASSERT
+		funderDelegate, found := s.App().FundersKeeper.GetFunderDelegate(s.Ctx(), i.ALICE, i.BOB)
+		Expect(found).To(BeTrue())
+		Expect(funderDelegate.CanFund).To(BeTrue())
+		Expect(funderDelegate.CanDefund).To(BeFalse())
+		Expect(funderDelegate.CanUpdateAmountsPerBundle).To(BeFalse())
+		Expect(funderDelegate.SpendLimits).To(HaveLen(1))
+		Expect(funderDelegate.SpendLimits[0].Amounts.String()).To(Equal(i.ACoins(100 * i.T_KYVE).String()))
+		Expect(funderDelegate.Expiration).To(BeZero())
+
+		res, err := s.App().QueryKeeper.FunderDelegates(s.Ctx(), &querytypes.QueryFunderDelegatesRequest{Address: i.ALICE})
+		Expect(err).To(BeNil())
+		Expect(res.Delegates).To(HaveLen(1))
+		Expect(res.Delegates[0].Delegate).To(Equal(i.BOB))
+	})
+
+	It("Try to grant a delegate as a non-existent funder", func() {
+		// ACT
+		s.RunTxFundersError(&funderstypes.MsgGrantFunderDelegate{
+			Creator:  i.BOB,
+			Delegate: i.CHARLIE,
+			CanFund:  true,
+		})
+
+		// ASSERT
+		_, found := s.App().FundersKeeper.GetFunderDelegate(s.Ctx(), i.BOB, i.CHARLIE)
+		Expect(found).To(BeFalse())
+	})
+
+	It("Try to grant a delegate with an expiration in the past", func() {
+		// ACT
+		s.RunTxFundersError(&funderstypes.MsgGrantFunderDelegate{
+			Creator:    i.ALICE,
+			Delegate:   i.BOB,
+			CanFund:    true,
+			Expiration: uint64(s.Ctx().BlockTime().Unix()),
+		})
+
+		// ASSERT
+		_, found := s.App().FundersKeeper.GetFunderDelegate(s.Ctx(), i.ALICE, i.BOB)
+		Expect(found).To(BeFalse())
+	})
+
+	It("Fund a pool on behalf of a funder", func() {
+		// ARRANGE
+		s.RunTxFundersSuccess(&funderstypes.MsgGrantFunderDelegate{
+			Creator:  i.ALICE,
+			Delegate: i.BOB,
+			CanFund:  true,
+			SpendLimits: []funderstypes.FunderSpendLimit{
+				{PoolId: 0, Amounts: i.ACoins(100 * i.T_KYVE)},
+			},
+		})
+		bobBalance := s.GetCoinsFromAddress(i.BOB)
+
+		// ACT
+		s.RunTxFundersSuccess(&funderstypes.MsgFundPool{
+			Creator: i.BOB,
+			PoolId:  0,
+			Amounts: i.ACoins(40 * i.T_KYVE),
+			Funder:  i.ALICE,
+		})
+
+		// ASSERT
+		Expect(bobBalance.Sub(s.GetCoinsFromAddress(i.BOB)...).String()).To(Equal(i.ACoins(40 * i.T_KYVE).String()))
+
+		funding, found := s.App().FundersKeeper.GetFunding(s.Ctx(), i.ALICE, 0)
+		Expect(found).To(BeTrue())
+		Expect(funding.Amounts.String()).To(Equal(i.ACoins(140 * i.T_KYVE).String()))
+
+		_, found = s.App().FundersKeeper.GetFunding(s.Ctx(), i.BOB, 0)
+		Expect(found).To(BeFalse())
+
+		funderDelegate, _ := s.App().FundersKeeper.GetFunderDelegate(s.Ctx(), i.ALICE, i.BOB)
+		Expect(funderDelegate.SpendLimits[0].Amounts.String()).To(Equal(i.ACoins(60 * i.T_KYVE).String()))
+	})
+
+	It("Try to fund a pool on behalf of a funder without permission", func() {
+		// ARRANGE
+		s.RunTxFundersSuccess(&funderstypes.MsgGrantFunderDelegate{
+			Creator:   i.ALICE,
+			Delegate:  i.BOB,
+			CanDefund: true,
+			SpendLimits: []funderstypes.FunderSpendLimit{
+				{PoolId: 0, Amounts: i.ACoins(100 * i.T_KYVE)},
+			},
+		})
+
+		// ACT
+		s.RunTxFundersError(&funderstypes.MsgFundPool{
+			Creator: i.BOB,
+			PoolId:  0,
+			Amounts: i.ACoins(40 * i.T_KYVE),
+			Funder:  i.ALICE,
+		})
+		s.RunTxFundersError(&funderstypes.MsgFundPool{
+			Creator: i.CHARLIE,
+			PoolId:  0,
+			Amounts: i.ACoins(40 * i.T_KYVE),
+			Funder:  i.ALICE,
+		})
+
+		// ASSERT
+		funding, _ := s.App().FundersKeeper.GetFunding(s.Ctx(), i.ALICE, 0)
+		Expect(funding.Amounts.String()).To(Equal(i.ACoins(100 * i.T_KYVE).String()))
+	})
+
+	It("Try to fund a pool on behalf of a funder above the spend limit", func() {
+		// ARRANGE
+		s.RunTxFundersSuccess(&funderstypes.MsgGrantFunderDelegate{
+			Creator:  i.ALICE,
+			Delegate: i.BOB,
+			CanFund:  true,
+			SpendLimits: []funderstypes.FunderSpendLimit{
+				{PoolId: 0, Amounts: i.ACoins(50 * i.T_KYVE)},
+			},
+		})
+
+		s.RunTxFundersSuccess(&funderstypes.MsgFundPool{
+			Creator: i.BOB,
+			PoolId:  0,
+			Amounts: i.ACoins(30 * i.T_KYVE),
+			Funder:  i.ALICE,
+		})
+
+		// ACT
+		s.RunTxFundersError(&funderstypes.MsgFundPool{
+			Creator: i.BOB,
+			PoolId:  0,
+			Amounts: i.ACoins(30 * i.T_KYVE),
+			Funder:  i.ALICE,
+		})
+
+		// ASSERT
+		funding, _ := s.App().FundersKeeper.GetFunding(s.Ctx(), i.ALICE, 0)
+		Expect(funding.Amounts.String()).To(Equal(i.ACoins(130 * i.T_KYVE).String()))
+
+		funderDelegate, _ := s.App().FundersKeeper.GetFunderDelegate(s.Ctx(), i.ALICE, i.BOB)
+		Expect(funderDelegate.SpendLimits[0].Amounts.String()).To(Equal(i.ACoins(20 * i.T_KYVE).String()))
+	})
+
+	It("Try to fund a pool on behalf of a funder in a pool without spend limit", func() {
+		// ARRANGE
+		s.RunTxFundersSuccess(&funderstypes.MsgGrantFunderDelegate{
+			Creator:  i.ALICE,
+			Delegate: i.BOB,
+			CanFund:  true,
+			SpendLimits: []funderstypes.FunderSpendLimit{
+				{PoolId: 0, Amounts: i.ACoins(50 * i.T_KYVE)},
+			},
+		})
+
+		// ACT
+		s.RunTxFundersError(&funderstypes.MsgFundPool{
+			Creator:          i.BOB,
+			PoolId:           1,
+			Amounts:          i.ACoins(30 * i.T_KYVE),
+			AmountsPerBundle: i.ACoins(1 * i.T_KYVE),
+			Funder:           i.ALICE,
+		})
+
+		// ASSERT
+		_, found := s.App().FundersKeeper.GetFunding(s.Ctx(), i.ALICE, 1)
+		Expect(found).To(BeFalse())
+
+		// the spend limit of the other pool is not touched
+		funderDelegate, _ := s.App().FundersKeeper.GetFunderDelegate(s.Ctx(), i.ALICE, i.BOB)
+		Expect(funderDelegate.SpendLimits[0].Amounts.String()).To(Equal(i.ACoins(50 * i.T_KYVE).String()))
+	})
+
+	It("Fund any pool on behalf of a funder as an unlimited delegate", func() {
+		// ARRANGE
+		s.RunTxFundersSuccess(&funderstypes.MsgGrantFunderDelegate{
+			Creator:   i.ALICE,
+			Delegate:  i.BOB,
+			CanFund:   true,
+			Unlimited: true,
+		})
+
+		// ACT
+		s.RunTxFundersSuccess(&funderstypes.MsgFundPool{
+			Creator:          i.BOB,
+			PoolId:           1,
+			Amounts:          i.ACoins(80 * i.T_KYVE),
+			AmountsPerBundle: i.ACoins(1 * i.T_KYVE),
+			Funder:           i.ALICE,
+		})
+
+		// ASSERT
+		funding, found := s.App().FundersKeeper.GetFunding(s.Ctx(), i.ALICE, 1)
+		Expect(found).To(BeTrue())
+		Expect(funding.Amounts.String()).To(Equal(i.ACoins(80 * i.T_KYVE).String()))
+	})
+
+	It("Try to grant an unlimited delegate with spend limits", func() {
+		// ACT
+		s.RunTxFundersError(&funderstypes.MsgGrantFunderDelegate{
+			Creator:   i.ALICE,
+			Delegate:  i.BOB,
+			CanFund:   true,
+			Unlimited: true,
+			SpendLimits: []funderstypes.FunderSpendLimit{
+				{PoolId: 0, Amounts: i.ACoins(50 * i.T_KYVE)},
+			},
+		})
+
+		// ASSERT
+		_, found := s.App().FundersKeeper.GetFunderDelegate(s.Ctx(), i.ALICE, i.BOB)
+		Expect(found).To(BeFalse())
+	})
+
+	It("Create a new funding on behalf of a funder without permission to update the amounts per bundle", func() {
+		// ARRANGE
+		s.RunTxFundersSuccess(&funderstypes.MsgGrantFunderDelegate{
+			Creator:  i.ALICE,
+			Delegate: i.BOB,
+			CanFund:  true,
+			SpendLimits: []funderstypes.FunderSpendLimit{
+				{PoolId: 1, Amounts: i.ACoins(50 * i.T_KYVE)},
+			},
+		})
+
+		// ACT
+		s.RunTxFundersSuccess(&funderstypes.MsgFundPool{
+			Creator:          i.BOB,
+			PoolId:           1,
+			Amounts:          i.ACoins(30 * i.T_KYVE),
+			AmountsPerBundle: i.ACoins(2 * i.T_KYVE),
+			Funder:           i.ALICE,
+		})
+
+		// ASSERT
+		funding, found := s.App().FundersKeeper.GetFunding(s.Ctx(), i.ALICE, 1)
+		Expect(found).To(BeTrue())
+		Expect(funding.AmountsPerBundle.String()).To(Equal(i.ACoins(2 * i.T_KYVE).String()))
+	})
+
+	It("Try to change the amounts per bundle on behalf of a funder without permission", func() {
+		// ARRANGE
+		s.RunTxFundersSuccess(&funderstypes.MsgGrantFunderDelegate{
+			Creator:  i.ALICE,
+			Delegate: i.BOB,
+			CanFund:  true,
+			SpendLimits: []funderstypes.FunderSpendLimit{
+				{PoolId: 0, Amounts: i.ACoins(50 * i.T_KYVE)},
+			},
+		})
+
+		// ACT
+		s.RunTxFundersError(&funderstypes.MsgFundPool{
+			Creator:          i.BOB,
+			PoolId:           0,
+			Amounts:          i.ACoins(30 * i.T_KYVE),
+			AmountsPerBundle: i.ACoins(2 * i.T_KYVE),
+			Funder:           i.ALICE,
+		})
+
+		// ASSERT
+		funding, _ := s.App().FundersKeeper.GetFunding(s.Ctx(), i.ALICE, 0)
+		Expect(funding.Amounts.String()).To(Equal(i.ACoins(100 * i.T_KYVE).String()))
+		Expect(funding.AmountsPerBundle.String()).To(Equal(i.ACoins(1 * i.T_KYVE).String()))
+	})
+
+	It("Try to fund a pool on behalf of a funder after the permissions expired", func() {
+		// ARRANGE
+		s.RunTxFundersSuccess(&funderstypes.MsgGrantFunderDelegate{
+			Creator:  i.ALICE,
+			Delegate: i.BOB,
+			CanFund:  true,
+			SpendLimits: []funderstypes.FunderSpendLimit{
+				{PoolId: 0, Amounts: i.ACoins(50 * i.T_KYVE)},
+			},
+			Expiration: uint64(s.Ctx().BlockTime().Unix()) + 60,
+		})
+
+		s.CommitAfterSeconds(60)
+
+		// ACT
+		s.RunTxFundersError(&funderstypes.MsgFundPool{
+			Creator: i.BOB,
+			PoolId:  0,
+			Amounts: i.ACoins(30 * i.T_KYVE),
+			Funder:  i.ALICE,
+		})
+
+		// ASSERT
+		funding, _ := s.App().FundersKeeper.GetFunding(s.Ctx(), i.ALICE, 0)
+		Expect(funding.Amounts.String()).To(Equal(i.ACoins(100 * i.T_KYVE).String()))
+	})
+
+	It("Defund a pool on behalf of a funder", func() {
+		// ARRANGE
+		s.RunTxFundersSuccess(&funderstypes.MsgGrantFunderDelegate{
+			Creator:   i.ALICE,
+			Delegate:  i.BOB,
+			CanDefund: true,
+		})
+		bobBalance := s.GetCoinsFromAddress(i.BOB)
+
+		// ACT
+		s.RunTxFundersSuccess(&funderstypes.MsgDefundPool{
+			Creator: i.BOB,
+			PoolId:  0,
+			Amounts: i.ACoins(40 * i.T_KYVE),
+			Funder:  i.ALICE,
+		})
+
+		// ASSERT
+		Expect(s.GetCoinsFromAddress(i.BOB).String()).To(Equal(bobBalance.String()))
+		Expect(initialBalance.Sub(s.GetCoinsFromAddress(i.ALICE)...).String()).To(Equal(i.ACoins(60 * i.T_KYVE).String()))
+
+		funding, _ := s.App().FundersKeeper.GetFunding(s.Ctx(), i.ALICE, 0)
+		Expect(funding.Amounts.String()).To(Equal(i.ACoins(60 * i.T_KYVE).String()))
+	})
+
+	It("Try to defund a pool on behalf of a funder without permission", func() {
+		// ARRANGE
+		s.RunTxFundersSuccess(&funderstypes.MsgGrantFunderDelegate{
+			Creator:  i.ALICE,
+			Delegate: i.BOB,
+			CanFund:  true,
+		})
+
+		// ACT
+		s.RunTxFundersError(&funderstypes.MsgDefundPool{
+			Creator: i.BOB,
+			PoolId:  0,
+			Amounts: i.ACoins(40 * i.T_KYVE),
+			Funder:  i.ALICE,
+		})
+
+		// ASSERT
+		funding, _ := s.App().FundersKeeper.GetFunding(s.Ctx(), i.ALICE, 0)
+		Expect(funding.Amounts.String()).To(Equal(i.ACoins(100 * i.T_KYVE).String()))
+	})
+
+	It("Revoke a delegate", func() {
+		// ARRANGE
+		s.RunTxFundersSuccess(&funderstypes.MsgGrantFunderDelegate{
+			Creator:   i.ALICE,
+			Delegate:  i.BOB,
+			CanDefund: true,
+		})
+
+		// ACT
+		s.RunTxFundersSuccess(&funderstypes.MsgRevokeFunderDelegate{
+			Creator:  i.ALICE,
+			Delegate: i.BOB,
+		})
+
+		// ASSERT
+		_, found := s.App().FundersKeeper.GetFunderDelegate(s.Ctx(), i.ALICE, i.BOB)
+		Expect(found).To(BeFalse())
+
+		s.RunTxFundersError(&funderstypes.MsgDefundPool{
+			Creator: i.BOB,
+			PoolId:  0,
+			Amounts: i.ACoins(40 * i.T_KYVE),
+			Funder:  i.ALICE,
+		})
+	})
+
+	It("Try to revoke a non-existent delegate", func() {
+		// ACT
+		s.RunTxFundersError(&funderstypes.MsgRevokeFunderDelegate{
+			Creator:  i.ALICE,
+			Delegate: i.BOB,
+		})
+	})
+})
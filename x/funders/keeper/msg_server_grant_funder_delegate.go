@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"github.com/KYVENetwork/chain/x/funders/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GrantFunderDelegate allows a funder to grant another address the permission
+// to manage its fundings. Existing permissions of the delegate are replaced.
+func (k msgServer) GrantFunderDelegate(goCtx context.Context, msg *types.MsgGrantFunderDelegate) (*types.MsgGrantFunderDelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Funder has to exist
+	if !k.DoesFunderExist(ctx, msg.Creator) {
+		return nil, errors.Wrapf(errorsTypes.ErrNotFound, types.ErrFunderDoesNotExist.Error(), msg.Creator)
+	}
+
+	// Expiration has to be in the future
+	if msg.Expiration > 0 && msg.Expiration <= uint64(ctx.BlockTime().Unix()) {
+		return nil, errors.Wrapf(errorsTypes.ErrInvalidRequest, types.ErrInvalidExpiration.Error(), msg.Expiration)
+	}
+
+	k.SetFunderDelegate(ctx, &types.FunderDelegate{
+		Funder:                    msg.Creator,
+		Delegate:                  msg.Delegate,
+		CanFund:                   msg.CanFund,
+		CanDefund:                 msg.CanDefund,
+		CanUpdateAmountsPerBundle: msg.CanUpdateAmountsPerBundle,
+		SpendLimits:               msg.SpendLimits,
+		Expiration:                msg.Expiration,
+		Unlimited:                 msg.Unlimited,
+	})
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventGrantFunderDelegate{
+		Funder:                    msg.Creator,
+		Delegate:                  msg.Delegate,
+		CanFund:                   msg.CanFund,
+		CanDefund:                 msg.CanDefund,
+		CanUpdateAmountsPerBundle: msg.CanUpdateAmountsPerBundle,
+		Expiration:                msg.Expiration,
+		Unlimited:                 msg.Unlimited,
+	})
+
+	return &types.MsgGrantFunderDelegateResponse{}, nil
+}
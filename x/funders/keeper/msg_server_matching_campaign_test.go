@@ -0,0 +1,471 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	funderstypes "github.com/KYVENetwork/chain/x/funders/types"
+	globaltypes "github.com/KYVENetwork/chain/x/global/types"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	querytypes "github.com/KYVENetwork/chain/x/query/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+/*
+
+TEST CASES - msg_server_create_matching_campaign.go, msg_server_withdraw_matching_campaign.go
+
+* Create a matching campaign
+* Try to create a matching campaign as a non-existent funder
+* Try to create a matching campaign with an end time in the past
+* Match a funding in an eligible pool
+* Match fundings up to the cap
+* Do not match a funding in a non-eligible pool
+* Do not match a funding of the sponsor
+* Do not match a funding after the campaign ended
+* Skip matching if the matched funding is below the minimum funding amount
+* Remove exhausted and expired campaigns from the pool index
+* Try to defund a matched funding before the campaign ended
+* Defund a matched funding after the campaign ended
+* Reverse a matched funding when the funder defunds
+* Reverse a matched funding partially when the funder defunds partially
+* Withdraw the remaining escrow after the campaign ended
+* Try to withdraw the remaining escrow before the campaign ended
+* Try to withdraw the remaining escrow as a non sponsor
+
+*/
+
+var _ = Describe("msg_server_matching_campaign.go", Ordered, func() {
+	s := i.NewCleanChain()
+
+	initialBalance := s.GetCoinsFromAddress(i.ALICE)
+
+	createCampaign := func(matchRatio string, amounts int64) {
+		s.RunTxFundersSuccess(&funderstypes.MsgCreateMatchingCampaign{
+			Creator:          i.ALICE,
+			PoolIds:          []uint64{0},
+			MatchRatio:       math.LegacyMustNewDecFromStr(matchRatio),
+			Amounts:          i.ACoins(amounts),
+			AmountsPerBundle: i.ACoins(1 * i.T_KYVE),
+			EndTime:          uint64(s.Ctx().BlockTime().Unix()) + 3600,
+		})
+	}
+
+	fundPool := func(funder string, poolId uint64, amounts int64) {
+		s.RunTxFundersSuccess(&funderstypes.MsgFundPool{
+			Creator:          funder,
+			PoolId:           poolId,
+			Amounts:          i.ACoins(amounts),
+			AmountsPerBundle: i.ACoins(1 * i.T_KYVE),
+		})
+	}
+
+	BeforeEach(func() {
+		// init new clean chain
+		s = i.NewCleanChain()
+
+		// create clean pools for every test case
+		gov := s.App().GovKeeper.GetGovernanceAccount(s.Ctx()).GetAddress().String()
+		msg := &pooltypes.MsgCreatePool{
+			Authority:            gov,
+			UploadInterval:       60,
+			InflationShareWeight: math.LegacyNewDec(10_000),
+			MinDelegation:        100 * i.KYVE,
+			MaxBundleSize:        100,
+			Binaries:             "{}",
+		}
+		s.RunTxPoolSuccess(msg)
+		s.RunTxPoolSuccess(msg)
+
+		// set whitelist
+		s.App().FundersKeeper.SetParams(s.Ctx(), funderstypes.NewParams([]*funderstypes.WhitelistCoinEntry{
+			{
+				CoinDenom:                 globaltypes.Denom,
+				MinFundingAmount:          math.NewIntFromUint64(10 * i.KYVE),
+				MinFundingAmountPerBundle: math.NewIntFromUint64(1 * i.KYVE),
+				CoinWeight:                math.LegacyNewDec(1),
+			},
+			{
+				CoinDenom:                 i.A_DENOM,
+				MinFundingAmount:          math.NewIntFromUint64(10 * i.KYVE),
+				MinFundingAmountPerBundle: math.NewIntFromUint64(1 * i.KYVE),
+				CoinWeight:                math.LegacyNewDec(1),
+			},
+		}, 20))
+
+		// create funders
+		s.RunTxFundersSuccess(&funderstypes.MsgCreateFunder{
+			Creator: i.ALICE,
+			Moniker: "Alice",
+		})
+		s.RunTxFundersSuccess(&funderstypes.MsgCreateFunder{
+			Creator: i.BOB,
+			Moniker: "Bob",
+		})
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Create a matching campaign", func() {
+		// ACT
+		createCampaign("1", 100*i.T_KYVE)
+
+		// ASSERT
+		Expect(initialBalance.Sub(s.GetCoinsFromAddress(i.ALICE)...).String()).To(Equal(i.ACoins(100 * i.T_KYVE).String()))
+
+		campaign, found := s.App().FundersKeeper.GetMatchingCampaign(s.Ctx(), 0)
+		Expect(found).To(BeTrue())
+		Expect(campaign.Sponsor).To(Equal(i.ALICE))
+		Expect(campaign.PoolIds).To(Equal([]uint64{0}))
+		Expect(campaign.MatchRatio).To(Equal(math.LegacyOneDec()))
+		Expect(campaign.Cap.String()).To(Equal(i.ACoins(100 * i.T_KYVE).String()))
+		Expect(campaign.Remaining.String()).To(Equal(i.ACoins(100 * i.T_KYVE).String()))
+		Expect(s.App().FundersKeeper.GetMatchingCampaignCount(s.Ctx())).To(Equal(uint64(1)))
+
+		res, err := s.App().QueryKeeper.MatchingCampaigns(s.Ctx(), &querytypes.QueryMatchingCampaignsRequest{Sponsor: i.ALICE})
+		Expect(err).To(BeNil())
+		Expect(res.Campaigns).To(HaveLen(1))
+
+		res, err = s.App().QueryKeeper.MatchingCampaigns(s.Ctx(), &querytypes.QueryMatchingCampaignsRequest{Sponsor: i.BOB})
+		Expect(err).To(BeNil())
+		Expect(res.Campaigns).To(BeEmpty())
+	})
+
+	It("Try to create a matching campaign as a non-existent funder", func() {
+		// ACT
+		s.RunTxFundersError(&funderstypes.MsgCreateMatchingCampaign{
+			Creator:          i.CHARLIE,
+			PoolIds:          []uint64{0},
+			MatchRatio:       math.LegacyOneDec(),
+			Amounts:          i.ACoins(100 * i.T_KYVE),
+			AmountsPerBundle: i.ACoins(1 * i.T_KYVE),
+			EndTime:          uint64(s.Ctx().BlockTime().Unix()) + 3600,
+		})
+
+		// ASSERT
+		_, found := s.App().FundersKeeper.GetMatchingCampaign(s.Ctx(), 0)
+		Expect(found).To(BeFalse())
+	})
+
+	It("Try to create a matching campaign with an end time in the past", func() {
+		// ACT
+		s.RunTxFundersError(&funderstypes.MsgCreateMatchingCampaign{
+			Creator:          i.ALICE,
+			PoolIds:          []uint64{0},
+			MatchRatio:       math.LegacyOneDec(),
+			Amounts:          i.ACoins(100 * i.T_KYVE),
+			AmountsPerBundle: i.ACoins(1 * i.T_KYVE),
+			EndTime:          uint64(s.Ctx().BlockTime().Unix()),
+		})
+
+		// ASSERT
+		_, found := s.App().FundersKeeper.GetMatchingCampaign(s.Ctx(), 0)
+		Expect(found).To(BeFalse())
+	})
+
+	It("Match a funding in an eligible pool", func() {
+		// ARRANGE
+		createCampaign("1", 100*i.T_KYVE)
+
+		// ACT
+		fundPool(i.BOB, 0, 40*i.T_KYVE)
+
+		// ASSERT
+		funding, found := s.App().FundersKeeper.GetFunding(s.Ctx(), i.ALICE, 0)
+		Expect(found).To(BeTrue())
+		Expect(funding.Amounts.String()).To(Equal(i.ACoins(40 * i.T_KYVE).String()))
+		Expect(funding.AmountsPerBundle.String()).To(Equal(i.ACoins(1 * i.T_KYVE).String()))
+
+		funding, _ = s.App().FundersKeeper.GetFunding(s.Ctx(), i.BOB, 0)
+		Expect(funding.Amounts.String()).To(Equal(i.ACoins(40 * i.T_KYVE).String()))
+
+		fundingState, _ := s.App().FundersKeeper.GetFundingState(s.Ctx(), 0)
+		Expect(fundingState.ActiveFunderAddresses).To(ConsistOf(i.ALICE, i.BOB))
+
+		campaign, _ := s.App().FundersKeeper.GetMatchingCampaign(s.Ctx(), 0)
+		Expect(campaign.Remaining.String()).To(Equal(i.ACoins(60 * i.T_KYVE).String()))
+	})
+
+	It("Match fundings up to the cap", func() {
+		// ARRANGE
+		createCampaign("2", 100*i.T_KYVE)
+
+		// ACT
+		fundPool(i.BOB, 0, 30*i.T_KYVE)
+		fundPool(i.BOB, 0, 30*i.T_KYVE)
+
+		// ASSERT
+		funding, _ := s.App().FundersKeeper.GetFunding(s.Ctx(), i.ALICE, 0)
+		Expect(funding.Amounts.String()).To(Equal(i.ACoins(100 * i.T_KYVE).String()))
+
+		campaign, _ := s.App().FundersKeeper.GetMatchingCampaign(s.Ctx(), 0)
+		Expect(campaign.Remaining.IsZero()).To(BeTrue())
+
+		// ACT
+		fundPool(i.BOB, 0, 30*i.T_KYVE)
+
+		// ASSERT
+		funding, _ = s.App().FundersKeeper.GetFunding(s.Ctx(), i.ALICE, 0)
+		Expect(funding.Amounts.String()).To(Equal(i.ACoins(100 * i.T_KYVE).String()))
+	})
+
+	It("Do not match a funding in a non-eligible pool", func() {
+		// ARRANGE
+		createCampaign("1", 100*i.T_KYVE)
+
+		// ACT
+		fundPool(i.BOB, 1, 40*i.T_KYVE)
+
+		// ASSERT
+		_, found := s.App().FundersKeeper.GetFunding(s.Ctx(), i.ALICE, 1)
+		Expect(found).To(BeFalse())
+
+		campaign, _ := s.App().FundersKeeper.GetMatchingCampaign(s.Ctx(), 0)
+		Expect(campaign.Remaining.String()).To(Equal(i.ACoins(100 * i.T_KYVE).String()))
+	})
+
+	It("Do not match a funding of the sponsor", func() {
+		// ARRANGE
+		createCampaign("1", 100*i.T_KYVE)
+
+		// ACT
+		fundPool(i.ALICE, 0, 40*i.T_KYVE)
+
+		// ASSERT
+		funding, _ := s.App().FundersKeeper.GetFunding(s.Ctx(), i.ALICE, 0)
+		Expect(funding.Amounts.String()).To(Equal(i.ACoins(40 * i.T_KYVE).String()))
+
+		campaign, _ := s.App().FundersKeeper.GetMatchingCampaign(s.Ctx(), 0)
+		Expect(campaign.Remaining.String()).To(Equal(i.ACoins(100 * i.T_KYVE).String()))
+	})
+
+	It("Do not match a funding after the campaign ended", func() {
+		// ARRANGE
+		createCampaign("1", 100*i.T_KYVE)
+		s.CommitAfterSeconds(3600)
+
+		// ACT
+		fundPool(i.BOB, 0, 40*i.T_KYVE)
+
+		// ASSERT
+		_, found := s.App().FundersKeeper.GetFunding(s.Ctx(), i.ALICE, 0)
+		Expect(found).To(BeFalse())
+	})
+
+	It("Skip matching if the matched funding is below the minimum funding amount", func() {
+		// ARRANGE
+		createCampaign("0.1", 100*i.T_KYVE)
+
+		// ACT
+		fundPool(i.BOB, 0, 50*i.T_KYVE)
+
+		// ASSERT
+		funding, _ := s.App().FundersKeeper.GetFunding(s.Ctx(), i.BOB, 0)
+		Expect(funding.Amounts.String()).To(Equal(i.ACoins(50 * i.T_KYVE).String()))
+
+		_, found := s.App().FundersKeeper.GetFunding(s.Ctx(), i.ALICE, 0)
+		Expect(found).To(BeFalse())
+
+		campaign, _ := s.App().FundersKeeper.GetMatchingCampaign(s.Ctx(), 0)
+		Expect(campaign.Remaining.String()).To(Equal(i.ACoins(100 * i.T_KYVE).String()))
+	})
+
+	It("Remove exhausted and expired campaigns from the pool index", func() {
+		// ARRANGE
+		createCampaign("1", 100*i.T_KYVE)
+		createCampaign("1", 200*i.T_KYVE)
+		Expect(s.App().FundersKeeper.GetMatchingCampaignIdsOfPool(s.Ctx(), 0)).To(Equal([]uint64{0, 1}))
+
+		// ACT
+		fundPool(i.BOB, 0, 100*i.T_KYVE)
+
+		// ASSERT
+		Expect(s.App().FundersKeeper.GetMatchingCampaignIdsOfPool(s.Ctx(), 0)).To(Equal([]uint64{1}))
+
+		campaign, _ := s.App().FundersKeeper.GetMatchingCampaign(s.Ctx(), 1)
+		Expect(campaign.Remaining.String()).To(Equal(i.ACoins(100 * i.T_KYVE).String()))
+
+		// ACT
+		s.CommitAfterSeconds(3600)
+		fundPool(i.BOB, 0, 100*i.T_KYVE)
+
+		// ASSERT
+		Expect(s.App().FundersKeeper.GetMatchingCampaignIdsOfPool(s.Ctx(), 0)).To(BeEmpty())
+
+		campaign, _ = s.App().FundersKeeper.GetMatchingCampaign(s.Ctx(), 1)
+		Expect(campaign.Remaining.String()).To(Equal(i.ACoins(100 * i.T_KYVE).String()))
+	})
+
+	It("Try to defund a matched funding before the campaign ended", func() {
+		// ARRANGE
+		createCampaign("1", 100*i.T_KYVE)
+		fundPool(i.BOB, 0, 40*i.T_KYVE)
+
+		// ACT
+		s.RunTxFundersError(&funderstypes.MsgDefundPool{
+			Creator: i.ALICE,
+			PoolId:  0,
+			Amounts: i.ACoins(40 * i.T_KYVE),
+		})
+
+		// ASSERT
+		funding, _ := s.App().FundersKeeper.GetFunding(s.Ctx(), i.ALICE, 0)
+		Expect(funding.Amounts.String()).To(Equal(i.ACoins(40 * i.T_KYVE).String()))
+
+		// ARRANGE
+		fundPool(i.ALICE, 0, 20*i.T_KYVE)
+
+		// ACT
+		s.RunTxFundersSuccess(&funderstypes.MsgDefundPool{
+			Creator: i.ALICE,
+			PoolId:  0,
+			Amounts: i.ACoins(60 * i.T_KYVE),
+		})
+
+		// ASSERT
+		funding, _ = s.App().FundersKeeper.GetFunding(s.Ctx(), i.ALICE, 0)
+		Expect(funding.Amounts.String()).To(Equal(i.ACoins(40 * i.T_KYVE).String()))
+
+		Expect(initialBalance.Sub(s.GetCoinsFromAddress(i.ALICE)...).String()).To(Equal(i.ACoins(100 * i.T_KYVE).String()))
+	})
+
+	It("Defund a matched funding after the campaign ended", func() {
+		// ARRANGE
+		createCampaign("1", 100*i.T_KYVE)
+		fundPool(i.BOB, 0, 40*i.T_KYVE)
+		s.CommitAfterSeconds(3600)
+
+		// ACT
+		s.RunTxFundersSuccess(&funderstypes.MsgDefundPool{
+			Creator: i.ALICE,
+			PoolId:  0,
+			Amounts: i.ACoins(40 * i.T_KYVE),
+		})
+
+		// ASSERT
+		funding, _ := s.App().FundersKeeper.GetFunding(s.Ctx(), i.ALICE, 0)
+		Expect(funding.Amounts.IsZero()).To(BeTrue())
+
+		Expect(initialBalance.Sub(s.GetCoinsFromAddress(i.ALICE)...).String()).To(Equal(i.ACoins(60 * i.T_KYVE).String()))
+	})
+
+	It("Reverse a matched funding when the funder defunds", func() {
+		// ARRANGE
+		createCampaign("2", 100*i.T_KYVE)
+		fundPool(i.BOB, 0, 50*i.T_KYVE)
+
+		campaign, _ := s.App().FundersKeeper.GetMatchingCampaign(s.Ctx(), 0)
+		Expect(campaign.Remaining.IsZero()).To(BeTrue())
+		Expect(s.App().FundersKeeper.GetMatchingCampaignIdsOfPool(s.Ctx(), 0)).To(BeEmpty())
+
+		// ACT
+		s.RunTxFundersSuccess(&funderstypes.MsgDefundPool{
+			Creator: i.BOB,
+			PoolId:  0,
+			Amounts: i.ACoins(50 * i.T_KYVE),
+		})
+
+		// ASSERT
+		funding, _ := s.App().FundersKeeper.GetFunding(s.Ctx(), i.ALICE, 0)
+		Expect(funding.Amounts.IsZero()).To(BeTrue())
+
+		fundingState, _ := s.App().FundersKeeper.GetFundingState(s.Ctx(), 0)
+		Expect(fundingState.ActiveFunderAddresses).To(BeEmpty())
+
+		campaign, _ = s.App().FundersKeeper.GetMatchingCampaign(s.Ctx(), 0)
+		Expect(campaign.Remaining.String()).To(Equal(i.ACoins(100 * i.T_KYVE).String()))
+		Expect(s.App().FundersKeeper.GetMatchingCampaignIdsOfPool(s.Ctx(), 0)).To(Equal([]uint64{0}))
+
+		_, found := s.App().FundersKeeper.GetMatchedFunding(s.Ctx(), 0, i.BOB, 0)
+		Expect(found).To(BeFalse())
+
+		// the campaign matches new fundings again
+		fundPool(i.BOB, 0, 10*i.T_KYVE)
+
+		funding, _ = s.App().FundersKeeper.GetFunding(s.Ctx(), i.ALICE, 0)
+		Expect(funding.Amounts.String()).To(Equal(i.ACoins(20 * i.T_KYVE).String()))
+	})
+
+	It("Reverse a matched funding partially when the funder defunds partially", func() {
+		// ARRANGE
+		createCampaign("1", 100*i.T_KYVE)
+		fundPool(i.BOB, 0, 40*i.T_KYVE)
+
+		// ACT
+		s.RunTxFundersSuccess(&funderstypes.MsgDefundPool{
+			Creator: i.BOB,
+			PoolId:  0,
+			Amounts: i.ACoins(15 * i.T_KYVE),
+		})
+
+		// ASSERT
+		funding, _ := s.App().FundersKeeper.GetFunding(s.Ctx(), i.ALICE, 0)
+		Expect(funding.Amounts.String()).To(Equal(i.ACoins(25 * i.T_KYVE).String()))
+
+		matchedFunding, found := s.App().FundersKeeper.GetMatchedFunding(s.Ctx(), 0, i.BOB, 0)
+		Expect(found).To(BeTrue())
+		Expect(matchedFunding.Amounts.String()).To(Equal(i.ACoins(25 * i.T_KYVE).String()))
+
+		campaign, _ := s.App().FundersKeeper.GetMatchingCampaign(s.Ctx(), 0)
+		Expect(campaign.Remaining.String()).To(Equal(i.ACoins(75 * i.T_KYVE).String()))
+	})
+
+	It("Withdraw the remaining escrow after the campaign ended", func() {
+		// ARRANGE
+		createCampaign("1", 100*i.T_KYVE)
+		fundPool(i.BOB, 0, 40*i.T_KYVE)
+		s.CommitAfterSeconds(3600)
+
+		// ACT
+		s.RunTxFundersSuccess(&funderstypes.MsgWithdrawMatchingCampaign{
+			Creator: i.ALICE,
+			Id:      0,
+		})
+
+		// ASSERT
+		Expect(initialBalance.Sub(s.GetCoinsFromAddress(i.ALICE)...).String()).To(Equal(i.ACoins(40 * i.T_KYVE).String()))
+
+		campaign, _ := s.App().FundersKeeper.GetMatchingCampaign(s.Ctx(), 0)
+		Expect(campaign.Remaining.IsZero()).To(BeTrue())
+
+		// matched fundings stay with the sponsor
+		funding, _ := s.App().FundersKeeper.GetFunding(s.Ctx(), i.ALICE, 0)
+		Expect(funding.Amounts.String()).To(Equal(i.ACoins(40 * i.T_KYVE).String()))
+
+		_, found := s.App().FundersKeeper.GetMatchedFunding(s.Ctx(), 0, i.BOB, 0)
+		Expect(found).To(BeFalse())
+	})
+
+	It("Try to withdraw the remaining escrow before the campaign ended", func() {
+		// ARRANGE
+		createCampaign("1", 100*i.T_KYVE)
+
+		// ACT
+		s.RunTxFundersError(&funderstypes.MsgWithdrawMatchingCampaign{
+			Creator: i.ALICE,
+			Id:      0,
+		})
+
+		// ASSERT
+		campaign, _ := s.App().FundersKeeper.GetMatchingCampaign(s.Ctx(), 0)
+		Expect(campaign.Remaining.String()).To(Equal(i.ACoins(100 * i.T_KYVE).String()))
+	})
+
+	It("Try to withdraw the remaining escrow as a non sponsor", func() {
+		// ARRANGE
+		createCampaign("1", 100*i.T_KYVE)
+		s.CommitAfterSeconds(3600)
+
+		// ACT
+		s.RunTxFundersError(&funderstypes.MsgWithdrawMatchingCampaign{
+			Creator: i.BOB,
+			Id:      0,
+		})
+
+		// ASSERT
+		campaign, _ := s.App().FundersKeeper.GetMatchingCampaign(s.Ctx(), 0)
+		Expect(campaign.Remaining.String()).To(Equal(i.ACoins(100 * i.T_KYVE).String()))
+	})
+})
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"context"
+	"slices"
+
+	"cosmossdk.io/errors"
+	"github.com/KYVENetwork/chain/x/funders/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ReportCoinPrice allows a price reporter to report the current market
+// price of a whitelisted coin which is then used for the oracle coin weight.
+func (k msgServer) ReportCoinPrice(goCtx context.Context, msg *types.MsgReportCoinPrice) (*types.MsgReportCoinPriceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Only price reporters are allowed to report prices
+	if !slices.Contains(k.GetParams(ctx).PriceReporters, msg.Creator) {
+		return nil, errors.Wrapf(errorsTypes.ErrUnauthorized, types.ErrNotPriceReporter.Error(), msg.Creator)
+	}
+
+	if err := k.ReportCoinPrice(ctx, msg.Creator, msg.Denom, msg.Price); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgReportCoinPriceResponse{}, nil
+}
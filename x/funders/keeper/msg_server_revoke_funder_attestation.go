@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+	"slices"
+
+	"cosmossdk.io/errors"
+	"github.com/KYVENetwork/chain/x/funders/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// RevokeFunderAttestation allows a funder attestor to remove the
+// verification of a funder profile.
+func (k msgServer) RevokeFunderAttestation(goCtx context.Context, msg *types.MsgRevokeFunderAttestation) (*types.MsgRevokeFunderAttestationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Only funder attestors are allowed to revoke attestations
+	if !slices.Contains(k.GetParams(ctx).FunderAttestors, msg.Creator) {
+		return nil, errors.Wrapf(errorsTypes.ErrUnauthorized, types.ErrNotFunderAttestor.Error(), msg.Creator)
+	}
+
+	// Attestation has to exist
+	if _, found := k.GetFunderAttestation(ctx, msg.Funder); !found {
+		return nil, errors.Wrapf(errorsTypes.ErrNotFound, types.ErrFunderAttestationDoesNotExist.Error(), msg.Funder)
+	}
+
+	k.RemoveFunderAttestation(ctx, msg.Funder)
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventRevokeFunderAttestation{
+		Funder:   msg.Funder,
+		Attestor: msg.Creator,
+	})
+
+	return &types.MsgRevokeFunderAttestationResponse{}, nil
+}
@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"github.com/KYVENetwork/chain/x/funders/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// RevokeFunderDelegate removes all permissions a funder has granted to a delegate.
+func (k msgServer) RevokeFunderDelegate(goCtx context.Context, msg *types.MsgRevokeFunderDelegate) (*types.MsgRevokeFunderDelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Delegate has to exist
+	if _, found := k.GetFunderDelegate(ctx, msg.Creator, msg.Delegate); !found {
+		return nil, errors.Wrapf(errorsTypes.ErrNotFound, types.ErrFunderDelegateDoesNotExist.Error(), msg.Delegate, msg.Creator)
+	}
+
+	k.RemoveFunderDelegate(ctx, msg.Creator, msg.Delegate)
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventRevokeFunderDelegate{
+		Funder:   msg.Creator,
+		Delegate: msg.Delegate,
+	})
+
+	return &types.MsgRevokeFunderDelegateResponse{}, nil
+}
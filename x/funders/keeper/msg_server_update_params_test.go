@@ -38,6 +38,8 @@ TEST CASES - msg_server_update_params.go
 * Update min-funding-multiple
 * Update min-funding-multiple with invalid value
 
+* Update funding-ledger-retention with zero
+
 */
 
 var _ = Describe("msg_server_update_params.go", Ordered, func() {
@@ -478,4 +480,33 @@ var _ = Describe("msg_server_update_params.go", Ordered, func() {
 		Expect(updatedParams.CoinWhitelist).To(Equal(types.DefaultParams().CoinWhitelist))
 		Expect(updatedParams.MinFundingMultiple).To(Equal(types.DefaultMinFundingMultiple))
 	})
+
+	It("Update funding-ledger-retention with zero", func() {
+		// ARRANGE
+		payload := `{
+			"funding_ledger_retention": 0
+		}`
+
+		msg := &types.MsgUpdateParams{
+			Authority: gov,
+			Payload:   payload,
+		}
+
+		proposal, _ := govV1Types.NewMsgSubmitProposal(
+			[]sdk.Msg{msg}, minDeposit, i.DUMMY[0], "", "title", "summary", false,
+		)
+
+		// ACT
+		_, submitErr := s.RunTx(proposal)
+
+		s.CommitAfter(*votingPeriod)
+		s.Commit()
+
+		// ASSERT
+		updatedParams := s.App().FundersKeeper.GetParams(s.Ctx())
+
+		Expect(submitErr).To(HaveOccurred())
+
+		Expect(updatedParams.FundingLedgerRetention).To(Equal(types.DefaultFundingLedgerRetention))
+	})
 })
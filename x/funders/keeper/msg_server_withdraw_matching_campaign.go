@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"github.com/KYVENetwork/chain/x/funders/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// WithdrawMatchingCampaign refunds the remaining escrow of an ended
+// matching campaign to its sponsor.
+func (k msgServer) WithdrawMatchingCampaign(goCtx context.Context, msg *types.MsgWithdrawMatchingCampaign) (*types.MsgWithdrawMatchingCampaignResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Campaign has to exist
+	campaign, found := k.GetMatchingCampaign(ctx, msg.Id)
+	if !found {
+		return nil, errors.Wrapf(errorsTypes.ErrNotFound, types.ErrMatchingCampaignDoesNotExist.Error(), msg.Id)
+	}
+
+	// Only the sponsor can withdraw
+	if campaign.Sponsor != msg.Creator {
+		return nil, errors.Wrapf(errorsTypes.ErrUnauthorized, types.ErrNotMatchingCampaignSponsor.Error(), msg.Creator, msg.Id)
+	}
+
+	// Campaign has to be ended
+	if uint64(ctx.BlockTime().Unix()) < campaign.EndTime {
+		return nil, errors.Wrapf(errorsTypes.ErrInvalidRequest, types.ErrMatchingCampaignNotEnded.Error(), msg.Id)
+	}
+
+	refund := campaign.Remaining
+	if !refund.IsZero() {
+		recipient := sdk.MustAccAddressFromBech32(campaign.Sponsor)
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, refund); err != nil {
+			return nil, err
+		}
+	}
+
+	campaign.Remaining = sdk.NewCoins()
+	k.SetMatchingCampaign(ctx, &campaign)
+
+	// The matched fundings are no longer locked
+	k.removeMatchedFundings(ctx, &campaign)
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventWithdrawMatchingCampaign{
+		Id:      campaign.Id,
+		Sponsor: campaign.Sponsor,
+		Amounts: refund.String(),
+	})
+
+	return &types.MsgWithdrawMatchingCampaignResponse{}, nil
+}
@@ -18,4 +18,19 @@ var (
 	ErrCoinNotWhitelisted                = errors.Register(ModuleName, 1109, "coin in amount not in whitelist")
 	ErrAmountPerBundleCoinNotWhitelisted = errors.Register(ModuleName, 1110, "coin in amount per bundle not in whitelist")
 	ErrInvalidAmountPerBundleCoin        = errors.Register(ModuleName, 1111, "coin in amount per bundle is not in funding amounts")
+	ErrFunderDelegateDoesNotExist        = errors.Register(ModuleName, 1112, "delegate %v of funder %v does not exist")
+	ErrFunderDelegateExpired             = errors.Register(ModuleName, 1113, "permissions of delegate %v of funder %v expired")
+	ErrFunderDelegateUnauthorized        = errors.Register(ModuleName, 1114, "delegate %v is not allowed to %v on behalf of funder %v")
+	ErrSpendLimitExceeded                = errors.Register(ModuleName, 1115, "spend limit of delegate %v in pool %v exceeded")
+	ErrInvalidExpiration                 = errors.Register(ModuleName, 1116, "expiration %v is not in the future")
+	ErrNotPriceReporter                  = errors.Register(ModuleName, 1117, "address %v is not a price reporter")
+	ErrInvalidPrice                      = errors.Register(ModuleName, 1118, "price %v has to be positive")
+	ErrMatchingCampaignDoesNotExist      = errors.Register(ModuleName, 1119, "matching campaign with id %v does not exist")
+	ErrMatchingCampaignNotEnded          = errors.Register(ModuleName, 1120, "matching campaign with id %v has not ended yet")
+	ErrNotMatchingCampaignSponsor        = errors.Register(ModuleName, 1121, "address %v is not the sponsor of matching campaign %v")
+	ErrInvalidEndTime                    = errors.Register(ModuleName, 1122, "end time %v is not in the future")
+	ErrNotFunderAttestor                 = errors.Register(ModuleName, 1123, "address %v is not a funder attestor")
+	ErrFunderAttestationDoesNotExist     = errors.Register(ModuleName, 1124, "attestation of funder %v does not exist")
+	ErrMatchedFundingLocked              = errors.Register(ModuleName, 1125, "funding of %v in pool %v is locked by a matching campaign")
+	ErrNoSpendLimit                      = errors.Register(ModuleName, 1126, "delegate %v has no spend limit in pool %v")
 )
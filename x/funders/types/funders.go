@@ -2,9 +2,54 @@ package types
 
 import (
 	"cosmossdk.io/math"
+	"github.com/KYVENetwork/chain/util"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// BundleQuality describes the properties of a finalized bundle which are
+// checked against the constraints of a funding.
+type BundleQuality struct {
+	// ValidVoteRatio is the ratio of the valid voting power to the total voting power
+	ValidVoteRatio math.LegacyDec
+	// ValidVoters is the number of distinct stakers which voted valid
+	ValidVoters uint64
+	// DataSize is the size of the bundle in bytes
+	DataSize uint64
+	// BundleSize is the amount of data items in the bundle
+	BundleSize uint64
+}
+
+// MeetsConstraints checks if the given bundle fulfills all constraints of the funding
+func (f *Funding) MeetsConstraints(quality BundleQuality) bool {
+	c := f.Constraints
+
+	if !c.MinValidVoteRatio.IsNil() && c.MinValidVoteRatio.IsPositive() {
+		if quality.ValidVoteRatio.IsNil() || quality.ValidVoteRatio.LT(c.MinValidVoteRatio) {
+			return false
+		}
+	}
+
+	if quality.ValidVoters < c.MinValidVoters {
+		return false
+	}
+
+	// compare data_size / bundle_size > max_data_size_ratio without dividing
+	if c.MaxDataSizeRatio > 0 && math.NewIntFromUint64(quality.DataSize).GT(math.NewIntFromUint64(c.MaxDataSizeRatio).Mul(math.NewIntFromUint64(quality.BundleSize))) {
+		return false
+	}
+
+	return true
+}
+
+// Validate validates the funding constraints
+func (c *FundingConstraints) Validate() error {
+	if c.MinValidVoteRatio.IsNil() {
+		return nil
+	}
+
+	return util.ValidatePercentage(c.MinValidVoteRatio)
+}
+
 func (f *Funding) GetScore(whitelist map[string]WhitelistCoinEntry) (score uint64) {
 	for _, coin := range f.Amounts {
 		if entry, found := whitelist[coin.Denom]; found {
@@ -76,3 +121,31 @@ func (fs *FundingState) SetActive(funding *Funding) {
 	}
 	fs.ActiveFunderAddresses = append(fs.ActiveFunderAddresses, funding.FunderAddress)
 }
+
+// IsActive checks if the campaign still matches fundings at the given UNIX-timestamp
+func (c *MatchingCampaign) IsActive(now uint64) bool {
+	return now < c.EndTime && !c.Remaining.IsZero()
+}
+
+// MatchAmounts returns the amounts the campaign adds for the given funded amounts.
+// The result is limited by the remaining escrow of the campaign.
+func (c *MatchingCampaign) MatchAmounts(amounts sdk.Coins) sdk.Coins {
+	matched := sdk.NewCoins()
+	for _, coin := range amounts {
+		matched = matched.Add(sdk.NewCoin(coin.Denom, c.MatchRatio.MulInt(coin.Amount).TruncateInt()))
+	}
+
+	return matched.Min(c.Remaining)
+}
+
+// ReverseAmounts returns the matched amounts the campaign takes back for the given
+// defunded amounts. The result is rounded up, so defunding in small steps can not
+// avoid the reversal.
+func (c *MatchingCampaign) ReverseAmounts(amounts sdk.Coins) sdk.Coins {
+	reversed := sdk.NewCoins()
+	for _, coin := range amounts {
+		reversed = reversed.Add(sdk.NewCoin(coin.Denom, c.MatchRatio.MulInt(coin.Amount).Ceil().TruncateInt()))
+	}
+
+	return reversed
+}
@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
@@ -133,6 +134,12 @@ type Funding struct {
 	AmountsPerBundle github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amounts_per_bundle,json=amountsPerBundle,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amounts_per_bundle"`
 	// total_funded is the total amount of coins that the funder has funded
 	TotalFunded github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=total_funded,json=totalFunded,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_funded"`
+	// constraints are the requirements a bundle has to fulfill so that
+	// this funding pays for it
+	Constraints FundingConstraints `protobuf:"bytes,6,opt,name=constraints,proto3" json:"constraints"`
+	// active_since is the UNIX-timestamp (in seconds) since when the
+	// funding is active. Zero means the funding is inactive.
+	ActiveSince uint64 `protobuf:"varint,7,opt,name=active_since,json=activeSince,proto3" json:"active_since,omitempty"`
 }
 
 func (m *Funding) Reset()         { *m = Funding{} }
@@ -203,6 +210,81 @@ func (m *Funding) GetTotalFunded() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *Funding) GetConstraints() FundingConstraints {
+	if m != nil {
+		return m.Constraints
+	}
+	return FundingConstraints{}
+}
+
+func (m *Funding) GetActiveSince() uint64 {
+	if m != nil {
+		return m.ActiveSince
+	}
+	return 0
+}
+
+// FundingConstraints are optional requirements a finalized bundle has to
+// fulfill so that a funding pays for it. Zero values disable a constraint.
+type FundingConstraints struct {
+	// min_valid_vote_ratio is the minimum ratio of the valid voting power
+	// to the total voting power of the pool
+	MinValidVoteRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=min_valid_vote_ratio,json=minValidVoteRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_valid_vote_ratio"`
+	// min_valid_voters is the minimum number of distinct stakers which
+	// voted valid, including the uploader
+	MinValidVoters uint64 `protobuf:"varint,2,opt,name=min_valid_voters,json=minValidVoters,proto3" json:"min_valid_voters,omitempty"`
+	// max_data_size_ratio is the maximum average size of a data item
+	// in bytes (data_size / bundle_size)
+	MaxDataSizeRatio uint64 `protobuf:"varint,3,opt,name=max_data_size_ratio,json=maxDataSizeRatio,proto3" json:"max_data_size_ratio,omitempty"`
+}
+
+func (m *FundingConstraints) Reset()         { *m = FundingConstraints{} }
+func (m *FundingConstraints) String() string { return proto.CompactTextString(m) }
+func (*FundingConstraints) ProtoMessage()    {}
+func (*FundingConstraints) Descriptor() ([]byte, []int) {
+	return fileDescriptor_252d80f89b0fa299, []int{2}
+}
+func (m *FundingConstraints) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FundingConstraints) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FundingConstraints.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FundingConstraints) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundingConstraints.Merge(m, src)
+}
+func (m *FundingConstraints) XXX_Size() int {
+	return m.Size()
+}
+func (m *FundingConstraints) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundingConstraints.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundingConstraints proto.InternalMessageInfo
+
+func (m *FundingConstraints) GetMinValidVoters() uint64 {
+	if m != nil {
+		return m.MinValidVoters
+	}
+	return 0
+}
+
+func (m *FundingConstraints) GetMaxDataSizeRatio() uint64 {
+	if m != nil {
+		return m.MaxDataSizeRatio
+	}
+	return 0
+}
+
 // FundingState is the object which holds info about the funding state of a pool
 type FundingState struct {
 	// pool_id is the id of the pool this funding is for
@@ -215,7 +297,7 @@ func (m *FundingState) Reset()         { *m = FundingState{} }
 func (m *FundingState) String() string { return proto.CompactTextString(m) }
 func (*FundingState) ProtoMessage()    {}
 func (*FundingState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252d80f89b0fa299, []int{2}
+	return fileDescriptor_252d80f89b0fa299, []int{3}
 }
 func (m *FundingState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -258,333 +340,3831 @@ func (m *FundingState) GetActiveFunderAddresses() []string {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*Funder)(nil), "kyve.funders.v1beta1.Funder")
-	proto.RegisterType((*Funding)(nil), "kyve.funders.v1beta1.Funding")
-	proto.RegisterType((*FundingState)(nil), "kyve.funders.v1beta1.FundingState")
+// FunderDelegate is an address which is allowed to manage the
+// fundings of a funder. Fundings created by a delegate are attributed
+// to the funder and refunds are always sent back to the funder.
+type FunderDelegate struct {
+	// funder is the address of the funder who granted the permissions
+	Funder string `protobuf:"bytes,1,opt,name=funder,proto3" json:"funder,omitempty"`
+	// delegate is the address which is allowed to act on behalf of the funder
+	Delegate string `protobuf:"bytes,2,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	// can_fund allows the delegate to fund pools on behalf of the funder
+	CanFund bool `protobuf:"varint,3,opt,name=can_fund,json=canFund,proto3" json:"can_fund,omitempty"`
+	// can_defund allows the delegate to defund pools on behalf of the funder
+	CanDefund bool `protobuf:"varint,4,opt,name=can_defund,json=canDefund,proto3" json:"can_defund,omitempty"`
+	// can_update_amounts_per_bundle allows the delegate to change the
+	// amounts per bundle of the fundings of the funder
+	CanUpdateAmountsPerBundle bool `protobuf:"varint,5,opt,name=can_update_amounts_per_bundle,json=canUpdateAmountsPerBundle,proto3" json:"can_update_amounts_per_bundle,omitempty"`
+	// spend_limits are the remaining amounts the delegate is allowed
+	// to fund per pool. Pools which are not listed here can not be funded
+	// unless the delegate is unlimited.
+	SpendLimits []FunderSpendLimit `protobuf:"bytes,6,rep,name=spend_limits,json=spendLimits,proto3" json:"spend_limits"`
+	// expiration is the UNIX-timestamp (in seconds) after which the
+	// permissions are no longer valid. Zero means no expiration.
+	Expiration uint64 `protobuf:"varint,7,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	// unlimited allows the delegate to fund every pool without spend limits
+	Unlimited bool `protobuf:"varint,8,opt,name=unlimited,proto3" json:"unlimited,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("kyve/funders/v1beta1/funders.proto", fileDescriptor_252d80f89b0fa299)
+func (m *FunderDelegate) Reset()         { *m = FunderDelegate{} }
+func (m *FunderDelegate) String() string { return proto.CompactTextString(m) }
+func (*FunderDelegate) ProtoMessage()    {}
+func (*FunderDelegate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_252d80f89b0fa299, []int{4}
+}
+func (m *FunderDelegate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FunderDelegate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FunderDelegate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FunderDelegate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FunderDelegate.Merge(m, src)
+}
+func (m *FunderDelegate) XXX_Size() int {
+	return m.Size()
+}
+func (m *FunderDelegate) XXX_DiscardUnknown() {
+	xxx_messageInfo_FunderDelegate.DiscardUnknown(m)
 }
 
-var fileDescriptor_252d80f89b0fa299 = []byte{
-	// 482 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0x41, 0x8b, 0xd3, 0x40,
-	0x14, 0x6e, 0x36, 0xbb, 0xad, 0x3b, 0x5d, 0x45, 0xc3, 0xca, 0x8e, 0x3d, 0x64, 0x4b, 0x41, 0x28,
-	0xa2, 0x09, 0xab, 0xe8, 0xdd, 0x8a, 0x05, 0x11, 0x44, 0x2a, 0x08, 0x7a, 0x09, 0x93, 0xcc, 0xb3,
-	0x3b, 0xb6, 0x99, 0x17, 0x32, 0xd3, 0xae, 0xbd, 0xf8, 0x1b, 0xfc, 0x19, 0xb2, 0x07, 0xf1, 0x67,
-	0xec, 0x71, 0x8f, 0x9e, 0x54, 0xda, 0x83, 0x7f, 0x43, 0x32, 0x33, 0x29, 0xed, 0x0f, 0xe8, 0x25,
-	0x79, 0xdf, 0xfb, 0xde, 0x9b, 0xef, 0xe5, 0x7b, 0x19, 0xd2, 0x9b, 0x2c, 0xe6, 0x10, 0x7f, 0x9a,
-	0x49, 0x0e, 0xa5, 0x8a, 0xe7, 0x67, 0x29, 0x68, 0x76, 0x56, 0xe3, 0xa8, 0x28, 0x51, 0x63, 0x70,
-	0x5c, 0xd5, 0x44, 0x75, 0xce, 0xd5, 0x74, 0xee, 0xb0, 0x5c, 0x48, 0x8c, 0xcd, 0xd3, 0x16, 0x76,
-	0xc2, 0x0c, 0x55, 0x8e, 0x2a, 0x4e, 0x99, 0x82, 0xf5, 0x59, 0x19, 0x0a, 0xe9, 0xf8, 0xe3, 0x31,
-	0x8e, 0xd1, 0x84, 0x71, 0x15, 0xd9, 0x6c, 0xef, 0x87, 0x47, 0x9a, 0x43, 0x73, 0x78, 0x40, 0x49,
-	0x8b, 0x71, 0x5e, 0x82, 0x52, 0xd4, 0xeb, 0x7a, 0xfd, 0xc3, 0x51, 0x0d, 0x2b, 0x26, 0x47, 0x29,
-	0x26, 0x50, 0xd2, 0x3d, 0xcb, 0x38, 0x18, 0x74, 0xc8, 0x0d, 0xc1, 0x41, 0x6a, 0xa1, 0x17, 0xd4,
-	0x37, 0xd4, 0x1a, 0x57, 0x5d, 0x17, 0x90, 0x2a, 0xa1, 0x81, 0xee, 0xdb, 0x2e, 0x07, 0x2b, 0x26,
-	0x43, 0xa9, 0x59, 0xa6, 0xe9, 0x81, 0x65, 0x1c, 0x0c, 0xba, 0xa4, 0xcd, 0x41, 0x65, 0xa5, 0x28,
-	0xb4, 0x40, 0x49, 0x9b, 0x86, 0xdd, 0x4c, 0xf5, 0x2e, 0x7d, 0xd2, 0xaa, 0x06, 0x16, 0x72, 0x1c,
-	0xdc, 0x27, 0xb7, 0xac, 0x31, 0xc9, 0xf6, 0xe0, 0x37, 0x6d, 0xf6, 0xb9, 0x1b, 0xff, 0x84, 0xb4,
-	0x0a, 0xc4, 0x69, 0x22, 0xb8, 0x19, 0x7f, 0x7f, 0xd4, 0xac, 0xe0, 0x2b, 0x1e, 0x7c, 0x26, 0x2d,
-	0x96, 0xe3, 0x4c, 0x6a, 0x45, 0xfd, 0xae, 0xdf, 0x6f, 0x3f, 0xbe, 0x17, 0x59, 0x13, 0xa3, 0xca,
-	0xc4, 0xda, 0xec, 0xe8, 0x05, 0x0a, 0x39, 0x78, 0x7a, 0xf5, 0xfb, 0xb4, 0x71, 0xf9, 0xe7, 0xb4,
-	0x3f, 0x16, 0xfa, 0x7c, 0x96, 0x46, 0x19, 0xe6, 0xb1, 0x73, 0xdc, 0xbe, 0x1e, 0x29, 0x3e, 0x89,
-	0xf5, 0xa2, 0x00, 0x65, 0x1a, 0xd4, 0xf7, 0x7f, 0x3f, 0x1f, 0x78, 0xa3, 0x5a, 0x20, 0xf8, 0x4a,
-	0x02, 0x17, 0x26, 0x05, 0x94, 0x49, 0x3a, 0x93, 0x7c, 0x5a, 0x19, 0xb3, 0x1b, 0xd9, 0xdb, 0x4e,
-	0xeb, 0x2d, 0x94, 0x03, 0xa3, 0x14, 0x28, 0x72, 0xa4, 0x51, 0xb3, 0x69, 0x62, 0xbc, 0xe1, 0xf4,
-	0x60, 0x47, 0xca, 0x6d, 0xa3, 0x62, 0x7e, 0x29, 0xde, 0x4b, 0xc8, 0x91, 0xdb, 0xd5, 0x3b, 0xcd,
-	0x34, 0x6c, 0x6e, 0xc2, 0xdb, 0xda, 0xc4, 0x33, 0x72, 0xc2, 0x32, 0x2d, 0xe6, 0x90, 0x6c, 0x2f,
-	0x14, 0x14, 0xdd, 0xeb, 0xfa, 0xfd, 0xc3, 0xd1, 0x5d, 0x4b, 0x0f, 0x37, 0x17, 0x0b, 0x6a, 0x30,
-	0xbc, 0x5a, 0x86, 0xde, 0xf5, 0x32, 0xf4, 0xfe, 0x2e, 0x43, 0xef, 0xdb, 0x2a, 0x6c, 0x5c, 0xaf,
-	0xc2, 0xc6, 0xaf, 0x55, 0xd8, 0xf8, 0xf8, 0x70, 0x63, 0xec, 0xd7, 0x1f, 0xde, 0xbf, 0x7c, 0x03,
-	0xfa, 0x02, 0xcb, 0x49, 0x9c, 0x9d, 0x33, 0x21, 0xe3, 0x2f, 0xeb, 0x5b, 0x67, 0x3e, 0x20, 0x6d,
-	0x9a, 0xdb, 0xf0, 0xe4, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2e, 0xf7, 0xe8, 0x0e, 0x92, 0x03,
-	0x00, 0x00,
+var xxx_messageInfo_FunderDelegate proto.InternalMessageInfo
+
+func (m *FunderDelegate) GetFunder() string {
+	if m != nil {
+		return m.Funder
+	}
+	return ""
 }
 
-func (m *Funder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FunderDelegate) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Funder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *FunderDelegate) GetCanFund() bool {
+	if m != nil {
+		return m.CanFund
+	}
+	return false
 }
 
-func (m *Funder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintFunders(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x32
+func (m *FunderDelegate) GetCanDefund() bool {
+	if m != nil {
+		return m.CanDefund
 	}
-	if len(m.Contact) > 0 {
-		i -= len(m.Contact)
-		copy(dAtA[i:], m.Contact)
-		i = encodeVarintFunders(dAtA, i, uint64(len(m.Contact)))
-		i--
-		dAtA[i] = 0x2a
+	return false
+}
+
+func (m *FunderDelegate) GetCanUpdateAmountsPerBundle() bool {
+	if m != nil {
+		return m.CanUpdateAmountsPerBundle
 	}
-	if len(m.Website) > 0 {
-		i -= len(m.Website)
-		copy(dAtA[i:], m.Website)
-		i = encodeVarintFunders(dAtA, i, uint64(len(m.Website)))
-		i--
-		dAtA[i] = 0x22
+	return false
+}
+
+func (m *FunderDelegate) GetSpendLimits() []FunderSpendLimit {
+	if m != nil {
+		return m.SpendLimits
 	}
-	if len(m.Identity) > 0 {
-		i -= len(m.Identity)
-		copy(dAtA[i:], m.Identity)
-		i = encodeVarintFunders(dAtA, i, uint64(len(m.Identity)))
-		i--
-		dAtA[i] = 0x1a
+	return nil
+}
+
+func (m *FunderDelegate) GetExpiration() uint64 {
+	if m != nil {
+		return m.Expiration
 	}
-	if len(m.Moniker) > 0 {
-		i -= len(m.Moniker)
-		copy(dAtA[i:], m.Moniker)
-		i = encodeVarintFunders(dAtA, i, uint64(len(m.Moniker)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *FunderDelegate) GetUnlimited() bool {
+	if m != nil {
+		return m.Unlimited
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintFunders(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	return false
+}
+
+// FunderSpendLimit is the remaining amount a delegate is allowed
+// to fund in a single pool on behalf of a funder.
+type FunderSpendLimit struct {
+	// pool_id is the id of the pool
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// amounts is the remaining amount per denom the delegate can fund
+	Amounts github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amounts,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amounts"`
+}
+
+func (m *FunderSpendLimit) Reset()         { *m = FunderSpendLimit{} }
+func (m *FunderSpendLimit) String() string { return proto.CompactTextString(m) }
+func (*FunderSpendLimit) ProtoMessage()    {}
+func (*FunderSpendLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_252d80f89b0fa299, []int{5}
+}
+func (m *FunderSpendLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FunderSpendLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FunderSpendLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *FunderSpendLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FunderSpendLimit.Merge(m, src)
+}
+func (m *FunderSpendLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *FunderSpendLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_FunderSpendLimit.DiscardUnknown(m)
 }
 
-func (m *Funding) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_FunderSpendLimit proto.InternalMessageInfo
+
+func (m *FunderSpendLimit) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Funding) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *FunderSpendLimit) GetAmounts() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amounts
+	}
+	return nil
 }
 
-func (m *Funding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TotalFunded) > 0 {
-		for iNdEx := len(m.TotalFunded) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TotalFunded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintFunders(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
+// CoinPriceReport is the latest price a price reporter has reported for a coin.
+type CoinPriceReport struct {
+	// denom is the denom of the whitelisted coin
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// reporter is the address of the price reporter
+	Reporter string `protobuf:"bytes,2,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	// price is the reported market price of the coin in USD/coin
+	Price cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=price,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price"`
+	// timestamp is the UNIX-timestamp (in seconds) of the report
+	Timestamp uint64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *CoinPriceReport) Reset()         { *m = CoinPriceReport{} }
+func (m *CoinPriceReport) String() string { return proto.CompactTextString(m) }
+func (*CoinPriceReport) ProtoMessage()    {}
+func (*CoinPriceReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_252d80f89b0fa299, []int{6}
+}
+func (m *CoinPriceReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CoinPriceReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CoinPriceReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.AmountsPerBundle) > 0 {
-		for iNdEx := len(m.AmountsPerBundle) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AmountsPerBundle[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintFunders(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+}
+func (m *CoinPriceReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CoinPriceReport.Merge(m, src)
+}
+func (m *CoinPriceReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *CoinPriceReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_CoinPriceReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CoinPriceReport proto.InternalMessageInfo
+
+func (m *CoinPriceReport) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *CoinPriceReport) GetReporter() string {
+	if m != nil {
+		return m.Reporter
+	}
+	return ""
+}
+
+func (m *CoinPriceReport) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+// OracleCoinWeight is the coin weight of a coin which is derived from
+// the median of recent price reports.
+type OracleCoinWeight struct {
+	// denom is the denom of the whitelisted coin
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// weight is the current oracle weight of the coin in USD/coin
+	Weight cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"weight"`
+	// updated_at is the UNIX-timestamp (in seconds) of the last update
+	UpdatedAt uint64 `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// anchor_weight is the coin weight at the start of the current window.
+	// All updates within the window are bounded relative to this weight.
+	AnchorWeight cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=anchor_weight,json=anchorWeight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"anchor_weight"`
+	// anchor_time is the UNIX-timestamp (in seconds) of the start of the
+	// current window.
+	AnchorTime uint64 `protobuf:"varint,5,opt,name=anchor_time,json=anchorTime,proto3" json:"anchor_time,omitempty"`
+}
+
+func (m *OracleCoinWeight) Reset()         { *m = OracleCoinWeight{} }
+func (m *OracleCoinWeight) String() string { return proto.CompactTextString(m) }
+func (*OracleCoinWeight) ProtoMessage()    {}
+func (*OracleCoinWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_252d80f89b0fa299, []int{7}
+}
+func (m *OracleCoinWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OracleCoinWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleCoinWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Amounts) > 0 {
-		for iNdEx := len(m.Amounts) - 1; iNdEx >= 0; iNdEx-- {
-			{
+}
+func (m *OracleCoinWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleCoinWeight.Merge(m, src)
+}
+func (m *OracleCoinWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *OracleCoinWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleCoinWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleCoinWeight proto.InternalMessageInfo
+
+func (m *OracleCoinWeight) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *OracleCoinWeight) GetUpdatedAt() uint64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
+func (m *OracleCoinWeight) GetAnchorTime() uint64 {
+	if m != nil {
+		return m.AnchorTime
+	}
+	return 0
+}
+
+// MatchingCampaign is a campaign of a sponsor who matches the fundings of
+// other funders in a set of pools with escrowed coins.
+type MatchingCampaign struct {
+	// id is the unique identifier of the campaign
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// sponsor is the funder who escrowed the coins
+	Sponsor string `protobuf:"bytes,2,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	// pool_ids are the pools in which fundings get matched
+	PoolIds []uint64 `protobuf:"varint,3,rep,packed,name=pool_ids,json=poolIds,proto3" json:"pool_ids,omitempty"`
+	// match_ratio is the amount the sponsor adds per funded coin
+	MatchRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=match_ratio,json=matchRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"match_ratio"`
+	// cap is the total amount the sponsor escrowed for matching
+	Cap github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=cap,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"cap"`
+	// remaining is the escrowed amount which was not matched yet
+	Remaining github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=remaining,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"remaining"`
+	// amounts_per_bundle are used for fundings of the sponsor which
+	// get created by the campaign
+	AmountsPerBundle github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=amounts_per_bundle,json=amountsPerBundle,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amounts_per_bundle"`
+	// end_time is the UNIX-timestamp (in seconds) after which no more
+	// fundings get matched and the remaining escrow can be withdrawn
+	EndTime uint64 `protobuf:"varint,8,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (m *MatchingCampaign) Reset()         { *m = MatchingCampaign{} }
+func (m *MatchingCampaign) String() string { return proto.CompactTextString(m) }
+func (*MatchingCampaign) ProtoMessage()    {}
+func (*MatchingCampaign) Descriptor() ([]byte, []int) {
+	return fileDescriptor_252d80f89b0fa299, []int{8}
+}
+func (m *MatchingCampaign) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MatchingCampaign) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MatchingCampaign.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MatchingCampaign) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MatchingCampaign.Merge(m, src)
+}
+func (m *MatchingCampaign) XXX_Size() int {
+	return m.Size()
+}
+func (m *MatchingCampaign) XXX_DiscardUnknown() {
+	xxx_messageInfo_MatchingCampaign.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MatchingCampaign proto.InternalMessageInfo
+
+func (m *MatchingCampaign) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MatchingCampaign) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+func (m *MatchingCampaign) GetPoolIds() []uint64 {
+	if m != nil {
+		return m.PoolIds
+	}
+	return nil
+}
+
+func (m *MatchingCampaign) GetCap() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Cap
+	}
+	return nil
+}
+
+func (m *MatchingCampaign) GetRemaining() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Remaining
+	}
+	return nil
+}
+
+func (m *MatchingCampaign) GetAmountsPerBundle() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.AmountsPerBundle
+	}
+	return nil
+}
+
+func (m *MatchingCampaign) GetEndTime() uint64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+// MatchedFunding is the amount a matching campaign added to the funding of its
+// sponsor in a pool for the fundings of a single funder. It is locked in the
+// funding of the sponsor until the campaign ends and gets reversed if the
+// funder defunds.
+type MatchedFunding struct {
+	// campaign_id is the id of the matching campaign
+	CampaignId uint64 `protobuf:"varint,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	// pool_id is the id of the pool
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// sponsor is the sponsor of the matching campaign
+	Sponsor string `protobuf:"bytes,3,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	// funder is the funder whose fundings got matched
+	Funder string `protobuf:"bytes,4,opt,name=funder,proto3" json:"funder,omitempty"`
+	// amounts is the matched amount which is still in the funding of the sponsor
+	Amounts github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=amounts,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amounts"`
+}
+
+func (m *MatchedFunding) Reset()         { *m = MatchedFunding{} }
+func (m *MatchedFunding) String() string { return proto.CompactTextString(m) }
+func (*MatchedFunding) ProtoMessage()    {}
+func (*MatchedFunding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_252d80f89b0fa299, []int{9}
+}
+func (m *MatchedFunding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MatchedFunding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MatchedFunding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MatchedFunding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MatchedFunding.Merge(m, src)
+}
+func (m *MatchedFunding) XXX_Size() int {
+	return m.Size()
+}
+func (m *MatchedFunding) XXX_DiscardUnknown() {
+	xxx_messageInfo_MatchedFunding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MatchedFunding proto.InternalMessageInfo
+
+func (m *MatchedFunding) GetCampaignId() uint64 {
+	if m != nil {
+		return m.CampaignId
+	}
+	return 0
+}
+
+func (m *MatchedFunding) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *MatchedFunding) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+func (m *MatchedFunding) GetFunder() string {
+	if m != nil {
+		return m.Funder
+	}
+	return ""
+}
+
+func (m *MatchedFunding) GetAmounts() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amounts
+	}
+	return nil
+}
+
+// FundingContribution is the amount a funder paid for a single finalized
+// bundle of a pool. Contributions are only kept for the most recent bundles
+// of a pool, defined by the funding_ledger_retention param.
+type FundingContribution struct {
+	// pool_id is the id of the pool the bundle belongs to
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// bundle_id is the id of the finalized bundle
+	BundleId uint64 `protobuf:"varint,2,opt,name=bundle_id,json=bundleId,proto3" json:"bundle_id,omitempty"`
+	// funder_address is the address of the funder
+	FunderAddress string `protobuf:"bytes,3,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	// amounts is the list of coins the funder paid for the bundle
+	Amounts github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amounts,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amounts"`
+}
+
+func (m *FundingContribution) Reset()         { *m = FundingContribution{} }
+func (m *FundingContribution) String() string { return proto.CompactTextString(m) }
+func (*FundingContribution) ProtoMessage()    {}
+func (*FundingContribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_252d80f89b0fa299, []int{10}
+}
+func (m *FundingContribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FundingContribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FundingContribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FundingContribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundingContribution.Merge(m, src)
+}
+func (m *FundingContribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *FundingContribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundingContribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundingContribution proto.InternalMessageInfo
+
+func (m *FundingContribution) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *FundingContribution) GetBundleId() uint64 {
+	if m != nil {
+		return m.BundleId
+	}
+	return 0
+}
+
+func (m *FundingContribution) GetFunderAddress() string {
+	if m != nil {
+		return m.FunderAddress
+	}
+	return ""
+}
+
+func (m *FundingContribution) GetAmounts() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amounts
+	}
+	return nil
+}
+
+// FunderAttestation marks the profile of a funder as verified by
+// a funder attestor.
+type FunderAttestation struct {
+	// funder_address is the address of the verified funder
+	FunderAddress string `protobuf:"bytes,1,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	// attestor is the address of the funder attestor
+	Attestor string `protobuf:"bytes,2,opt,name=attestor,proto3" json:"attestor,omitempty"`
+	// reason describes why the profile got verified
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// expiry is the UNIX-timestamp (in seconds) after which the
+	// attestation is no longer valid
+	Expiry uint64 `protobuf:"varint,4,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	// created_at is the UNIX-timestamp (in seconds) of the attestation
+	CreatedAt uint64 `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (m *FunderAttestation) Reset()         { *m = FunderAttestation{} }
+func (m *FunderAttestation) String() string { return proto.CompactTextString(m) }
+func (*FunderAttestation) ProtoMessage()    {}
+func (*FunderAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_252d80f89b0fa299, []int{11}
+}
+func (m *FunderAttestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FunderAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FunderAttestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FunderAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FunderAttestation.Merge(m, src)
+}
+func (m *FunderAttestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *FunderAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_FunderAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FunderAttestation proto.InternalMessageInfo
+
+func (m *FunderAttestation) GetFunderAddress() string {
+	if m != nil {
+		return m.FunderAddress
+	}
+	return ""
+}
+
+func (m *FunderAttestation) GetAttestor() string {
+	if m != nil {
+		return m.Attestor
+	}
+	return ""
+}
+
+func (m *FunderAttestation) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *FunderAttestation) GetExpiry() uint64 {
+	if m != nil {
+		return m.Expiry
+	}
+	return 0
+}
+
+func (m *FunderAttestation) GetCreatedAt() uint64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+// FunderStats are the aggregated lifetime statistics of a funder.
+type FunderStats struct {
+	// funder_address is the address of the funder
+	FunderAddress string `protobuf:"bytes,1,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	// lifetime_funded is the total amount the funder ever funded
+	LifetimeFunded github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=lifetime_funded,json=lifetimeFunded,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"lifetime_funded"`
+	// pools_funded are the ids of all pools the funder ever funded
+	PoolsFunded []uint64 `protobuf:"varint,3,rep,packed,name=pools_funded,json=poolsFunded,proto3" json:"pools_funded,omitempty"`
+	// total_funding_duration is the sum of the durations (in seconds)
+	// of all fundings which ran out or got defunded
+	TotalFundingDuration uint64 `protobuf:"varint,4,opt,name=total_funding_duration,json=totalFundingDuration,proto3" json:"total_funding_duration,omitempty"`
+	// completed_fundings is the number of fundings which ran out
+	// or got defunded
+	CompletedFundings uint64 `protobuf:"varint,5,opt,name=completed_fundings,json=completedFundings,proto3" json:"completed_fundings,omitempty"`
+}
+
+func (m *FunderStats) Reset()         { *m = FunderStats{} }
+func (m *FunderStats) String() string { return proto.CompactTextString(m) }
+func (*FunderStats) ProtoMessage()    {}
+func (*FunderStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_252d80f89b0fa299, []int{12}
+}
+func (m *FunderStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FunderStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FunderStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FunderStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FunderStats.Merge(m, src)
+}
+func (m *FunderStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *FunderStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_FunderStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FunderStats proto.InternalMessageInfo
+
+func (m *FunderStats) GetFunderAddress() string {
+	if m != nil {
+		return m.FunderAddress
+	}
+	return ""
+}
+
+func (m *FunderStats) GetLifetimeFunded() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.LifetimeFunded
+	}
+	return nil
+}
+
+func (m *FunderStats) GetPoolsFunded() []uint64 {
+	if m != nil {
+		return m.PoolsFunded
+	}
+	return nil
+}
+
+func (m *FunderStats) GetTotalFundingDuration() uint64 {
+	if m != nil {
+		return m.TotalFundingDuration
+	}
+	return 0
+}
+
+func (m *FunderStats) GetCompletedFundings() uint64 {
+	if m != nil {
+		return m.CompletedFundings
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Funder)(nil), "kyve.funders.v1beta1.Funder")
+	proto.RegisterType((*Funding)(nil), "kyve.funders.v1beta1.Funding")
+	proto.RegisterType((*FundingConstraints)(nil), "kyve.funders.v1beta1.FundingConstraints")
+	proto.RegisterType((*FundingState)(nil), "kyve.funders.v1beta1.FundingState")
+	proto.RegisterType((*FunderDelegate)(nil), "kyve.funders.v1beta1.FunderDelegate")
+	proto.RegisterType((*FunderSpendLimit)(nil), "kyve.funders.v1beta1.FunderSpendLimit")
+	proto.RegisterType((*CoinPriceReport)(nil), "kyve.funders.v1beta1.CoinPriceReport")
+	proto.RegisterType((*OracleCoinWeight)(nil), "kyve.funders.v1beta1.OracleCoinWeight")
+	proto.RegisterType((*MatchingCampaign)(nil), "kyve.funders.v1beta1.MatchingCampaign")
+	proto.RegisterType((*MatchedFunding)(nil), "kyve.funders.v1beta1.MatchedFunding")
+	proto.RegisterType((*FundingContribution)(nil), "kyve.funders.v1beta1.FundingContribution")
+	proto.RegisterType((*FunderAttestation)(nil), "kyve.funders.v1beta1.FunderAttestation")
+	proto.RegisterType((*FunderStats)(nil), "kyve.funders.v1beta1.FunderStats")
+}
+
+func init() {
+	proto.RegisterFile("kyve/funders/v1beta1/funders.proto", fileDescriptor_252d80f89b0fa299)
+}
+
+var fileDescriptor_252d80f89b0fa299 = []byte{
+	// 1272 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4d, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xcf, 0xda, 0x1b, 0xbf, 0x3c, 0x4e, 0xd3, 0x64, 0x9a, 0x7f, 0xbb, 0x49, 0xff, 0x75, 0x5a,
+	0x23, 0x50, 0x84, 0xa8, 0xad, 0x96, 0x17, 0x09, 0x71, 0x21, 0xa9, 0xa9, 0xa8, 0x28, 0xb4, 0xda,
+	0x94, 0x22, 0xb8, 0xac, 0xc6, 0x3b, 0x53, 0x7b, 0x88, 0x77, 0x66, 0xb5, 0x33, 0x4e, 0xe3, 0x1e,
+	0xf8, 0x04, 0x1c, 0xb8, 0x71, 0x41, 0xe2, 0x84, 0x84, 0x38, 0x20, 0x6e, 0x7c, 0x00, 0x2e, 0xbd,
+	0xd1, 0x23, 0xe2, 0x50, 0x50, 0x73, 0x80, 0x1b, 0x5f, 0x01, 0xcd, 0xcb, 0xae, 0xed, 0x12, 0x4b,
+	0xe1, 0x60, 0x2e, 0xc9, 0x3e, 0xef, 0x2f, 0xf3, 0x9b, 0xe7, 0x19, 0x43, 0xeb, 0x60, 0x7c, 0x48,
+	0x3b, 0x0f, 0x46, 0x9c, 0xd0, 0x4c, 0x76, 0x0e, 0xaf, 0xf5, 0xa8, 0xc2, 0xd7, 0x72, 0xba, 0x9d,
+	0x66, 0x42, 0x09, 0xb4, 0xa1, 0x75, 0xda, 0x39, 0xcf, 0xe9, 0x6c, 0xad, 0xe3, 0x84, 0x71, 0xd1,
+	0x31, 0x7f, 0xad, 0xe2, 0x56, 0x33, 0x16, 0x32, 0x11, 0xb2, 0xd3, 0xc3, 0x92, 0x16, 0xbe, 0x62,
+	0xc1, 0xb8, 0x93, 0x6f, 0xf4, 0x45, 0x5f, 0x98, 0xcf, 0x8e, 0xfe, 0xb2, 0xdc, 0xd6, 0xf7, 0x1e,
+	0x54, 0x6e, 0x1a, 0xe7, 0x28, 0x80, 0x2a, 0x26, 0x24, 0xa3, 0x52, 0x06, 0xde, 0x65, 0x6f, 0xa7,
+	0x1e, 0xe6, 0xa4, 0x96, 0x24, 0x82, 0xb3, 0x03, 0x9a, 0x05, 0x25, 0x2b, 0x71, 0x24, 0xda, 0x82,
+	0x1a, 0x23, 0x94, 0x2b, 0xa6, 0xc6, 0x41, 0xd9, 0x88, 0x0a, 0x5a, 0x5b, 0x3d, 0xa4, 0x3d, 0xc9,
+	0x14, 0x0d, 0x7c, 0x6b, 0xe5, 0x48, 0x2d, 0x89, 0x05, 0x57, 0x38, 0x56, 0xc1, 0xb2, 0x95, 0x38,
+	0x12, 0x5d, 0x86, 0x06, 0xa1, 0x32, 0xce, 0x58, 0xaa, 0x98, 0xe0, 0x41, 0xc5, 0x48, 0xa7, 0x59,
+	0xad, 0xaf, 0x7d, 0xa8, 0xea, 0x84, 0x19, 0xef, 0xa3, 0x17, 0x61, 0xd5, 0x36, 0x26, 0x9a, 0x4d,
+	0xfc, 0x8c, 0xe5, 0xee, 0xba, 0xf4, 0x2f, 0x40, 0x35, 0x15, 0x62, 0x18, 0x31, 0x62, 0xd2, 0xf7,
+	0xc3, 0x8a, 0x26, 0x6f, 0x11, 0xf4, 0x29, 0x54, 0x71, 0x22, 0x46, 0x5c, 0xc9, 0xa0, 0x7c, 0xb9,
+	0xbc, 0xd3, 0xb8, 0xbe, 0xd9, 0xb6, 0x4d, 0x6c, 0xeb, 0x26, 0xe6, 0xcd, 0x6e, 0xdf, 0x10, 0x8c,
+	0xef, 0xbd, 0xfe, 0xf8, 0xe9, 0xf6, 0xd2, 0x77, 0xbf, 0x6d, 0xef, 0xf4, 0x99, 0x1a, 0x8c, 0x7a,
+	0xed, 0x58, 0x24, 0x1d, 0xd7, 0x71, 0xfb, 0xef, 0xaa, 0x24, 0x07, 0x1d, 0x35, 0x4e, 0xa9, 0x34,
+	0x06, 0xf2, 0xdb, 0x3f, 0x7e, 0x78, 0xd9, 0x0b, 0xf3, 0x00, 0xe8, 0x33, 0x40, 0xee, 0x33, 0x4a,
+	0x69, 0x16, 0xf5, 0x46, 0x9c, 0x0c, 0x75, 0x63, 0x16, 0x13, 0x76, 0xcd, 0xc5, 0xba, 0x4b, 0xb3,
+	0x3d, 0x13, 0x09, 0x49, 0x58, 0x51, 0x42, 0xe1, 0x61, 0x64, 0x7a, 0x43, 0x82, 0xe5, 0x05, 0x45,
+	0x6e, 0x98, 0x28, 0x06, 0x52, 0x04, 0xdd, 0x85, 0x46, 0x2c, 0xb8, 0x54, 0x19, 0x66, 0xba, 0xc9,
+	0xfa, 0x38, 0x1b, 0xd7, 0x77, 0xda, 0x27, 0x41, 0xba, 0xed, 0x0e, 0xf5, 0xc6, 0x44, 0x7f, 0xcf,
+	0xd7, 0x29, 0x84, 0xd3, 0x2e, 0xd0, 0x15, 0x58, 0xc1, 0xb1, 0x62, 0x87, 0x34, 0x92, 0x8c, 0xc7,
+	0x34, 0xa8, 0x9a, 0x03, 0x6d, 0x58, 0xde, 0xbe, 0x66, 0xb5, 0x7e, 0xf2, 0x00, 0xfd, 0xd3, 0x19,
+	0xba, 0x07, 0x1b, 0x09, 0xe3, 0xd1, 0x21, 0x1e, 0x32, 0x12, 0x1d, 0x0a, 0x45, 0xa3, 0x0c, 0x2b,
+	0x26, 0x2c, 0x64, 0xf6, 0x5e, 0xd0, 0xa1, 0x7e, 0x7d, 0xba, 0x7d, 0xd1, 0xd6, 0x26, 0xc9, 0x41,
+	0x9b, 0x89, 0x4e, 0x82, 0xd5, 0xa0, 0x7d, 0x9b, 0xf6, 0x71, 0x3c, 0xee, 0xd2, 0x38, 0x5c, 0x4f,
+	0x18, 0xbf, 0xaf, 0xed, 0xef, 0x0b, 0x45, 0x43, 0x6d, 0x8d, 0x76, 0x60, 0x6d, 0xd6, 0x6b, 0x26,
+	0x1d, 0xc8, 0x56, 0xa7, 0x95, 0x33, 0x89, 0xae, 0xc2, 0xb9, 0x04, 0x1f, 0x45, 0x04, 0x2b, 0x1c,
+	0x49, 0xf6, 0x28, 0x0f, 0x5f, 0x36, 0xca, 0x6b, 0x09, 0x3e, 0xea, 0x62, 0x85, 0xf7, 0xd9, 0x23,
+	0xeb, 0xb8, 0x15, 0xc1, 0x8a, 0x2b, 0x62, 0x5f, 0x61, 0x45, 0xa7, 0x41, 0xec, 0xcd, 0x80, 0xf8,
+	0x0d, 0xb8, 0xe0, 0x3a, 0x32, 0x7b, 0x17, 0xa8, 0x4e, 0xa4, 0xbc, 0x53, 0x0f, 0xff, 0x67, 0xc5,
+	0x37, 0xa7, 0xef, 0x04, 0x95, 0xad, 0x9f, 0x4b, 0xb0, 0x6a, 0x79, 0x5d, 0x3a, 0xa4, 0x7d, 0x1d,
+	0xe3, 0x3c, 0x54, 0xac, 0x0f, 0x77, 0x8f, 0x1c, 0xa5, 0x6f, 0x39, 0x71, 0x3a, 0x6e, 0x00, 0x14,
+	0x34, 0xda, 0x84, 0x5a, 0x8c, 0xb9, 0x89, 0x6d, 0x6a, 0xa9, 0x85, 0xd5, 0x18, 0x73, 0xed, 0x18,
+	0x5d, 0x02, 0xd0, 0x22, 0x42, 0x8d, 0xd0, 0x37, 0xc2, 0x7a, 0x8c, 0x79, 0xd7, 0x30, 0xd0, 0xdb,
+	0x70, 0x49, 0x8b, 0x47, 0x29, 0xc1, 0x8a, 0x46, 0x27, 0x5c, 0x8e, 0x65, 0x63, 0xb1, 0x19, 0x63,
+	0xfe, 0xa1, 0xd1, 0xd9, 0x7d, 0x1e, 0xd3, 0x77, 0x60, 0x45, 0xa6, 0x94, 0x93, 0x68, 0xc8, 0x12,
+	0x66, 0xf0, 0xa5, 0x31, 0xfd, 0xd2, 0x7c, 0x7c, 0xd1, 0x6c, 0x5f, 0xeb, 0xdf, 0xd6, 0xea, 0x39,
+	0xba, 0x64, 0xc1, 0x91, 0xa8, 0x09, 0x40, 0x8f, 0x52, 0x66, 0x4e, 0x86, 0x3b, 0x6c, 0x4d, 0x71,
+	0xd0, 0xff, 0xa1, 0x3e, 0xe2, 0x26, 0x18, 0x25, 0x41, 0xcd, 0x16, 0x54, 0x30, 0x5a, 0x5f, 0x7a,
+	0xb0, 0xf6, 0x7c, 0x94, 0xf9, 0xe7, 0x36, 0x35, 0x7c, 0x4a, 0x0b, 0x1e, 0x3e, 0xad, 0xaf, 0x3c,
+	0x38, 0xab, 0xf9, 0x77, 0x33, 0x16, 0xd3, 0x90, 0xa6, 0x22, 0x53, 0x68, 0x03, 0x96, 0x09, 0xe5,
+	0x22, 0x71, 0x67, 0x6d, 0x09, 0x7d, 0xd4, 0x99, 0x91, 0x17, 0xb3, 0xbe, 0xa0, 0xd1, 0x9b, 0xb0,
+	0x9c, 0x6a, 0x07, 0x41, 0xf9, 0xf4, 0x57, 0xc6, 0x5a, 0xe8, 0xc6, 0x29, 0x96, 0x50, 0xa9, 0x70,
+	0x92, 0x1a, 0x24, 0xf8, 0xe1, 0x84, 0xd1, 0xfa, 0xcb, 0x83, 0xb5, 0x3b, 0x19, 0x8e, 0x87, 0x54,
+	0x27, 0xf9, 0x11, 0x65, 0xfd, 0xc1, 0xbc, 0xfc, 0xde, 0x82, 0xca, 0x43, 0x23, 0x0f, 0x4a, 0xa7,
+	0x4f, 0xc2, 0x99, 0x68, 0x40, 0x5a, 0xb4, 0x91, 0x08, 0x2b, 0x77, 0xf3, 0xea, 0x8e, 0xb3, 0xab,
+	0xd0, 0xbb, 0x70, 0x06, 0xf3, 0x78, 0x20, 0xb2, 0xc8, 0x85, 0xf0, 0x4f, 0x1f, 0x62, 0xc5, 0x5a,
+	0xba, 0xdc, 0xb7, 0xa1, 0xe1, 0x3c, 0xe9, 0x22, 0x0d, 0x90, 0xfd, 0x10, 0x2c, 0xeb, 0x1e, 0x4b,
+	0x68, 0xeb, 0x73, 0x1f, 0xd6, 0xde, 0xc7, 0x2a, 0x1e, 0xe8, 0x21, 0x85, 0x93, 0x14, 0xb3, 0x3e,
+	0x47, 0xab, 0x50, 0x2a, 0x50, 0x52, 0x62, 0x44, 0xaf, 0x49, 0x99, 0x0a, 0x2e, 0x45, 0xb1, 0x76,
+	0x1d, 0xa9, 0x2f, 0x9d, 0x03, 0x95, 0xdd, 0x5c, 0x7e, 0x58, 0xb5, 0xa8, 0x92, 0xa8, 0x0b, 0x8d,
+	0x44, 0x3b, 0x76, 0xe3, 0xe5, 0x5f, 0x94, 0x00, 0xc6, 0xce, 0x8e, 0xb5, 0x1e, 0x94, 0x63, 0x9c,
+	0x2e, 0x6c, 0x49, 0x68, 0xe7, 0x88, 0x43, 0x3d, 0xa3, 0x09, 0x66, 0x9c, 0xf1, 0x7e, 0x50, 0x59,
+	0x50, 0xa4, 0x49, 0x88, 0x39, 0x1b, 0xb8, 0xfa, 0x9f, 0x6d, 0xe0, 0x4d, 0xa8, 0xe9, 0x59, 0x65,
+	0x10, 0x51, 0x33, 0x87, 0x5c, 0xa5, 0x9c, 0x18, 0x38, 0xfc, 0xe9, 0xc1, 0xaa, 0x81, 0x03, 0x25,
+	0xf9, 0xdb, 0x66, 0x1b, 0x1a, 0xb1, 0x03, 0xc6, 0x64, 0x76, 0x40, 0xce, 0xba, 0x45, 0xe6, 0xbf,
+	0x6a, 0xa6, 0x60, 0x53, 0x9e, 0x85, 0xcd, 0x64, 0xbe, 0xfb, 0x33, 0xf3, 0x7d, 0x6a, 0x14, 0x2d,
+	0x2f, 0x7a, 0x14, 0x3d, 0xf5, 0xe0, 0xdc, 0x64, 0x3b, 0xab, 0x8c, 0xf5, 0x46, 0x66, 0xb4, 0xce,
+	0x9d, 0x93, 0x17, 0xa1, 0x6e, 0x8f, 0x6a, 0x52, 0x69, 0xcd, 0x32, 0x6e, 0x91, 0x13, 0x5e, 0x80,
+	0xe5, 0x93, 0x5e, 0x80, 0x53, 0x05, 0xfa, 0x8b, 0x2e, 0xf0, 0x1b, 0x0f, 0xd6, 0xdd, 0xae, 0x55,
+	0x4a, 0x0f, 0x38, 0x53, 0xde, 0x29, 0x9f, 0xaa, 0x5b, 0x50, 0xc3, 0xc6, 0xaa, 0xb8, 0xf3, 0x05,
+	0xad, 0x4f, 0x2f, 0xa3, 0x58, 0x0a, 0xee, 0x6a, 0x74, 0x94, 0xe6, 0x9b, 0x15, 0x35, 0x76, 0x83,
+	0xd5, 0x51, 0x66, 0xfd, 0x66, 0x34, 0x9f, 0x76, 0x76, 0x06, 0xd5, 0x1d, 0x67, 0x57, 0xb5, 0x7e,
+	0x2c, 0x41, 0xc3, 0x6d, 0x2b, 0x85, 0x95, 0x3c, 0x6d, 0x86, 0x63, 0x38, 0x3b, 0x64, 0x0f, 0xa8,
+	0x46, 0x71, 0xfe, 0x94, 0x5c, 0xd4, 0xfa, 0x5a, 0xcd, 0x03, 0xb9, 0xd7, 0xe4, 0x15, 0x58, 0xd1,
+	0x98, 0x90, 0x79, 0x5c, 0x3b, 0xf9, 0x1a, 0x86, 0xe7, 0x54, 0x5e, 0x83, 0xf3, 0x93, 0x57, 0x2e,
+	0xe3, 0xfd, 0x88, 0x8c, 0xdc, 0x32, 0xb7, 0xbd, 0xd9, 0x28, 0x5e, 0xa7, 0x8c, 0xf7, 0xbb, 0x4e,
+	0x86, 0xae, 0x02, 0x8a, 0x45, 0x92, 0x0e, 0xa9, 0xee, 0x95, 0xb3, 0x94, 0xae, 0x63, 0xeb, 0x85,
+	0xc4, 0x59, 0xc9, 0xbd, 0x9b, 0x8f, 0x9f, 0x35, 0xbd, 0x27, 0xcf, 0x9a, 0xde, 0xef, 0xcf, 0x9a,
+	0xde, 0x17, 0xc7, 0xcd, 0xa5, 0x27, 0xc7, 0xcd, 0xa5, 0x5f, 0x8e, 0x9b, 0x4b, 0x9f, 0xbc, 0x32,
+	0x55, 0xe0, 0x7b, 0x1f, 0xdf, 0x7f, 0xe7, 0x03, 0xaa, 0x1e, 0x8a, 0xec, 0xa0, 0x13, 0x0f, 0x30,
+	0xe3, 0x9d, 0xa3, 0xe2, 0xa7, 0x9e, 0x29, 0xb5, 0x57, 0x31, 0x3f, 0xc1, 0x5e, 0xfd, 0x7b, 0x00,
+	0x5f, 0x7a, 0xe5, 0x72, 0x07, 0x0e, 0x00, 0x00,
+}
+
+func (m *Funder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Funder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Funder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintFunders(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Contact) > 0 {
+		i -= len(m.Contact)
+		copy(dAtA[i:], m.Contact)
+		i = encodeVarintFunders(dAtA, i, uint64(len(m.Contact)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Website) > 0 {
+		i -= len(m.Website)
+		copy(dAtA[i:], m.Website)
+		i = encodeVarintFunders(dAtA, i, uint64(len(m.Website)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintFunders(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Moniker) > 0 {
+		i -= len(m.Moniker)
+		copy(dAtA[i:], m.Moniker)
+		i = encodeVarintFunders(dAtA, i, uint64(len(m.Moniker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintFunders(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Funding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Funding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Funding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActiveSince != 0 {
+		i = encodeVarintFunders(dAtA, i, uint64(m.ActiveSince))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.Constraints.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFunders(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.TotalFunded) > 0 {
+		for iNdEx := len(m.TotalFunded) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalFunded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFunders(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.AmountsPerBundle) > 0 {
+		for iNdEx := len(m.AmountsPerBundle) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AmountsPerBundle[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFunders(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Amounts) > 0 {
+		for iNdEx := len(m.Amounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFunders(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintFunders(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintFunders(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FundingConstraints) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FundingConstraints) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FundingConstraints) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxDataSizeRatio != 0 {
+		i = encodeVarintFunders(dAtA, i, uint64(m.MaxDataSizeRatio))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MinValidVoters != 0 {
+		i = encodeVarintFunders(dAtA, i, uint64(m.MinValidVoters))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.MinValidVoteRatio.Size()
+		i -= size
+		if _, err := m.MinValidVoteRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFunders(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FundingState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FundingState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FundingState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ActiveFunderAddresses) > 0 {
+		for iNdEx := len(m.ActiveFunderAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ActiveFunderAddresses[iNdEx])
+			copy(dAtA[i:], m.ActiveFunderAddresses[iNdEx])
+			i = encodeVarintFunders(dAtA, i, uint64(len(m.ActiveFunderAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintFunders(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FunderDelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FunderDelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FunderDelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Unlimited {
+		i--
+		if m.Unlimited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Expiration != 0 {
+		i = encodeVarintFunders(dAtA, i, uint64(m.Expiration))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.SpendLimits) > 0 {
+		for iNdEx := len(m.SpendLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFunders(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.CanUpdateAmountsPerBundle {
+		i--
+		if m.CanUpdateAmountsPerBundle {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CanDefund {
+		i--
+		if m.CanDefund {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CanFund {
+		i--
+		if m.CanFund {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintFunders(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Funder) > 0 {
+		i -= len(m.Funder)
+		copy(dAtA[i:], m.Funder)
+		i = encodeVarintFunders(dAtA, i, uint64(len(m.Funder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FunderSpendLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FunderSpendLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FunderSpendLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amounts) > 0 {
+		for iNdEx := len(m.Amounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFunders(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintFunders(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CoinPriceReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CoinPriceReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CoinPriceReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		i = encodeVarintFunders(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFunders(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintFunders(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFunders(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OracleCoinWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleCoinWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleCoinWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AnchorTime != 0 {
+		i = encodeVarintFunders(dAtA, i, uint64(m.AnchorTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.AnchorWeight.Size()
+		i -= size
+		if _, err := m.AnchorWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFunders(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.UpdatedAt != 0 {
+		i = encodeVarintFunders(dAtA, i, uint64(m.UpdatedAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFunders(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFunders(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MatchingCampaign) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MatchingCampaign) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MatchingCampaign) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndTime != 0 {
+		i = encodeVarintFunders(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.AmountsPerBundle) > 0 {
+		for iNdEx := len(m.AmountsPerBundle) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AmountsPerBundle[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFunders(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Remaining) > 0 {
+		for iNdEx := len(m.Remaining) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Remaining[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFunders(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Cap) > 0 {
+		for iNdEx := len(m.Cap) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Cap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFunders(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.MatchRatio.Size()
+		i -= size
+		if _, err := m.MatchRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFunders(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.PoolIds) > 0 {
+		dAtA3 := make([]byte, len(m.PoolIds)*10)
+		var j2 int
+		for _, num := range m.PoolIds {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintFunders(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintFunders(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintFunders(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MatchedFunding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MatchedFunding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MatchedFunding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amounts) > 0 {
+		for iNdEx := len(m.Amounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFunders(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Funder) > 0 {
+		i -= len(m.Funder)
+		copy(dAtA[i:], m.Funder)
+		i = encodeVarintFunders(dAtA, i, uint64(len(m.Funder)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintFunders(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintFunders(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CampaignId != 0 {
+		i = encodeVarintFunders(dAtA, i, uint64(m.CampaignId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FundingContribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FundingContribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FundingContribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amounts) > 0 {
+		for iNdEx := len(m.Amounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
 				size, err := m.Amounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
-				i -= size
-				i = encodeVarintFunders(dAtA, i, uint64(size))
+				i -= size
+				i = encodeVarintFunders(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintFunders(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BundleId != 0 {
+		i = encodeVarintFunders(dAtA, i, uint64(m.BundleId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintFunders(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FunderAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FunderAttestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FunderAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreatedAt != 0 {
+		i = encodeVarintFunders(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Expiry != 0 {
+		i = encodeVarintFunders(dAtA, i, uint64(m.Expiry))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintFunders(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Attestor) > 0 {
+		i -= len(m.Attestor)
+		copy(dAtA[i:], m.Attestor)
+		i = encodeVarintFunders(dAtA, i, uint64(len(m.Attestor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintFunders(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FunderStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FunderStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FunderStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CompletedFundings != 0 {
+		i = encodeVarintFunders(dAtA, i, uint64(m.CompletedFundings))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TotalFundingDuration != 0 {
+		i = encodeVarintFunders(dAtA, i, uint64(m.TotalFundingDuration))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PoolsFunded) > 0 {
+		dAtA5 := make([]byte, len(m.PoolsFunded)*10)
+		var j4 int
+		for _, num := range m.PoolsFunded {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintFunders(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.LifetimeFunded) > 0 {
+		for iNdEx := len(m.LifetimeFunded) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LifetimeFunded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFunders(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintFunders(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFunders(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFunders(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Funder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovFunders(uint64(l))
+	}
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovFunders(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovFunders(uint64(l))
+	}
+	l = len(m.Website)
+	if l > 0 {
+		n += 1 + l + sovFunders(uint64(l))
+	}
+	l = len(m.Contact)
+	if l > 0 {
+		n += 1 + l + sovFunders(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovFunders(uint64(l))
+	}
+	return n
+}
+
+func (m *Funding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovFunders(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovFunders(uint64(m.PoolId))
+	}
+	if len(m.Amounts) > 0 {
+		for _, e := range m.Amounts {
+			l = e.Size()
+			n += 1 + l + sovFunders(uint64(l))
+		}
+	}
+	if len(m.AmountsPerBundle) > 0 {
+		for _, e := range m.AmountsPerBundle {
+			l = e.Size()
+			n += 1 + l + sovFunders(uint64(l))
+		}
+	}
+	if len(m.TotalFunded) > 0 {
+		for _, e := range m.TotalFunded {
+			l = e.Size()
+			n += 1 + l + sovFunders(uint64(l))
+		}
+	}
+	l = m.Constraints.Size()
+	n += 1 + l + sovFunders(uint64(l))
+	if m.ActiveSince != 0 {
+		n += 1 + sovFunders(uint64(m.ActiveSince))
+	}
+	return n
+}
+
+func (m *FundingConstraints) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinValidVoteRatio.Size()
+	n += 1 + l + sovFunders(uint64(l))
+	if m.MinValidVoters != 0 {
+		n += 1 + sovFunders(uint64(m.MinValidVoters))
+	}
+	if m.MaxDataSizeRatio != 0 {
+		n += 1 + sovFunders(uint64(m.MaxDataSizeRatio))
+	}
+	return n
+}
+
+func (m *FundingState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovFunders(uint64(m.PoolId))
+	}
+	if len(m.ActiveFunderAddresses) > 0 {
+		for _, s := range m.ActiveFunderAddresses {
+			l = len(s)
+			n += 1 + l + sovFunders(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FunderDelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Funder)
+	if l > 0 {
+		n += 1 + l + sovFunders(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovFunders(uint64(l))
+	}
+	if m.CanFund {
+		n += 2
+	}
+	if m.CanDefund {
+		n += 2
+	}
+	if m.CanUpdateAmountsPerBundle {
+		n += 2
+	}
+	if len(m.SpendLimits) > 0 {
+		for _, e := range m.SpendLimits {
+			l = e.Size()
+			n += 1 + l + sovFunders(uint64(l))
+		}
+	}
+	if m.Expiration != 0 {
+		n += 1 + sovFunders(uint64(m.Expiration))
+	}
+	if m.Unlimited {
+		n += 2
+	}
+	return n
+}
+
+func (m *FunderSpendLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovFunders(uint64(m.PoolId))
+	}
+	if len(m.Amounts) > 0 {
+		for _, e := range m.Amounts {
+			l = e.Size()
+			n += 1 + l + sovFunders(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CoinPriceReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFunders(uint64(l))
+	}
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovFunders(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovFunders(uint64(l))
+	if m.Timestamp != 0 {
+		n += 1 + sovFunders(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *OracleCoinWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFunders(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovFunders(uint64(l))
+	if m.UpdatedAt != 0 {
+		n += 1 + sovFunders(uint64(m.UpdatedAt))
+	}
+	l = m.AnchorWeight.Size()
+	n += 1 + l + sovFunders(uint64(l))
+	if m.AnchorTime != 0 {
+		n += 1 + sovFunders(uint64(m.AnchorTime))
+	}
+	return n
+}
+
+func (m *MatchingCampaign) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovFunders(uint64(m.Id))
+	}
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovFunders(uint64(l))
+	}
+	if len(m.PoolIds) > 0 {
+		l = 0
+		for _, e := range m.PoolIds {
+			l += sovFunders(uint64(e))
+		}
+		n += 1 + sovFunders(uint64(l)) + l
+	}
+	l = m.MatchRatio.Size()
+	n += 1 + l + sovFunders(uint64(l))
+	if len(m.Cap) > 0 {
+		for _, e := range m.Cap {
+			l = e.Size()
+			n += 1 + l + sovFunders(uint64(l))
+		}
+	}
+	if len(m.Remaining) > 0 {
+		for _, e := range m.Remaining {
+			l = e.Size()
+			n += 1 + l + sovFunders(uint64(l))
+		}
+	}
+	if len(m.AmountsPerBundle) > 0 {
+		for _, e := range m.AmountsPerBundle {
+			l = e.Size()
+			n += 1 + l + sovFunders(uint64(l))
+		}
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovFunders(uint64(m.EndTime))
+	}
+	return n
+}
+
+func (m *MatchedFunding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CampaignId != 0 {
+		n += 1 + sovFunders(uint64(m.CampaignId))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovFunders(uint64(m.PoolId))
+	}
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovFunders(uint64(l))
+	}
+	l = len(m.Funder)
+	if l > 0 {
+		n += 1 + l + sovFunders(uint64(l))
+	}
+	if len(m.Amounts) > 0 {
+		for _, e := range m.Amounts {
+			l = e.Size()
+			n += 1 + l + sovFunders(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FundingContribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovFunders(uint64(m.PoolId))
+	}
+	if m.BundleId != 0 {
+		n += 1 + sovFunders(uint64(m.BundleId))
+	}
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovFunders(uint64(l))
+	}
+	if len(m.Amounts) > 0 {
+		for _, e := range m.Amounts {
+			l = e.Size()
+			n += 1 + l + sovFunders(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FunderAttestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovFunders(uint64(l))
+	}
+	l = len(m.Attestor)
+	if l > 0 {
+		n += 1 + l + sovFunders(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovFunders(uint64(l))
+	}
+	if m.Expiry != 0 {
+		n += 1 + sovFunders(uint64(m.Expiry))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovFunders(uint64(m.CreatedAt))
+	}
+	return n
+}
+
+func (m *FunderStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovFunders(uint64(l))
+	}
+	if len(m.LifetimeFunded) > 0 {
+		for _, e := range m.LifetimeFunded {
+			l = e.Size()
+			n += 1 + l + sovFunders(uint64(l))
+		}
+	}
+	if len(m.PoolsFunded) > 0 {
+		l = 0
+		for _, e := range m.PoolsFunded {
+			l += sovFunders(uint64(e))
+		}
+		n += 1 + sovFunders(uint64(l)) + l
+	}
+	if m.TotalFundingDuration != 0 {
+		n += 1 + sovFunders(uint64(m.TotalFundingDuration))
+	}
+	if m.CompletedFundings != 0 {
+		n += 1 + sovFunders(uint64(m.CompletedFundings))
+	}
+	return n
+}
+
+func sovFunders(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFunders(x uint64) (n int) {
+	return sovFunders(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Funder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFunders
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Funder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Funder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Website = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contact = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFunders(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Funding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFunders
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Funding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Funding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amounts = append(m.Amounts, types.Coin{})
+			if err := m.Amounts[len(m.Amounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountsPerBundle", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmountsPerBundle = append(m.AmountsPerBundle, types.Coin{})
+			if err := m.AmountsPerBundle[len(m.AmountsPerBundle)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFunded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalFunded = append(m.TotalFunded, types.Coin{})
+			if err := m.TotalFunded[len(m.TotalFunded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constraints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Constraints.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveSince", wireType)
+			}
+			m.ActiveSince = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActiveSince |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFunders(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FundingConstraints) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFunders
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FundingConstraints: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FundingConstraints: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinValidVoteRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinValidVoteRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinValidVoters", wireType)
+			}
+			m.MinValidVoters = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinValidVoters |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDataSizeRatio", wireType)
+			}
+			m.MaxDataSizeRatio = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDataSizeRatio |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFunders(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FundingState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFunders
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FundingState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FundingState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveFunderAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActiveFunderAddresses = append(m.ActiveFunderAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFunders(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FunderDelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFunders
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FunderDelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FunderDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanFund", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CanFund = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanDefund", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CanDefund = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanUpdateAmountsPerBundle", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CanUpdateAmountsPerBundle = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimits = append(m.SpendLimits, FunderSpendLimit{})
+			if err := m.SpendLimits[len(m.SpendLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			m.Expiration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expiration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unlimited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unlimited = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFunders(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FunderSpendLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFunders
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FunderSpendLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FunderSpendLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amounts = append(m.Amounts, types.Coin{})
+			if err := m.Amounts[len(m.Amounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFunders(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CoinPriceReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFunders
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CoinPriceReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CoinPriceReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reporter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFunders(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OracleCoinWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFunders
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleCoinWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleCoinWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			m.UpdatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnchorWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnchorWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnchorTime", wireType)
+			}
+			m.AnchorTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AnchorTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFunders(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MatchingCampaign) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFunders
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MatchingCampaign: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MatchingCampaign: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowFunders
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PoolIds = append(m.PoolIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowFunders
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthFunders
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthFunders
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PoolIds) == 0 {
+					m.PoolIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFunders
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PoolIds = append(m.PoolIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIds", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MatchRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cap = append(m.Cap, types.Coin{})
+			if err := m.Cap[len(m.Cap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Remaining = append(m.Remaining, types.Coin{})
+			if err := m.Remaining[len(m.Remaining)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountsPerBundle", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmountsPerBundle = append(m.AmountsPerBundle, types.Coin{})
+			if err := m.AmountsPerBundle[len(m.AmountsPerBundle)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFunders(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFunders
 			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintFunders(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.FunderAddress) > 0 {
-		i -= len(m.FunderAddress)
-		copy(dAtA[i:], m.FunderAddress)
-		i = encodeVarintFunders(dAtA, i, uint64(len(m.FunderAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *FundingState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *FundingState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *FundingState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ActiveFunderAddresses) > 0 {
-		for iNdEx := len(m.ActiveFunderAddresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ActiveFunderAddresses[iNdEx])
-			copy(dAtA[i:], m.ActiveFunderAddresses[iNdEx])
-			i = encodeVarintFunders(dAtA, i, uint64(len(m.ActiveFunderAddresses[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintFunders(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintFunders(dAtA []byte, offset int, v uint64) int {
-	offset -= sovFunders(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Funder) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovFunders(uint64(l))
-	}
-	l = len(m.Moniker)
-	if l > 0 {
-		n += 1 + l + sovFunders(uint64(l))
-	}
-	l = len(m.Identity)
-	if l > 0 {
-		n += 1 + l + sovFunders(uint64(l))
-	}
-	l = len(m.Website)
-	if l > 0 {
-		n += 1 + l + sovFunders(uint64(l))
-	}
-	l = len(m.Contact)
-	if l > 0 {
-		n += 1 + l + sovFunders(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovFunders(uint64(l))
-	}
-	return n
-}
-
-func (m *Funding) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FunderAddress)
-	if l > 0 {
-		n += 1 + l + sovFunders(uint64(l))
-	}
-	if m.PoolId != 0 {
-		n += 1 + sovFunders(uint64(m.PoolId))
-	}
-	if len(m.Amounts) > 0 {
-		for _, e := range m.Amounts {
-			l = e.Size()
-			n += 1 + l + sovFunders(uint64(l))
-		}
-	}
-	if len(m.AmountsPerBundle) > 0 {
-		for _, e := range m.AmountsPerBundle {
-			l = e.Size()
-			n += 1 + l + sovFunders(uint64(l))
-		}
-	}
-	if len(m.TotalFunded) > 0 {
-		for _, e := range m.TotalFunded {
-			l = e.Size()
-			n += 1 + l + sovFunders(uint64(l))
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *FundingState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovFunders(uint64(m.PoolId))
-	}
-	if len(m.ActiveFunderAddresses) > 0 {
-		for _, s := range m.ActiveFunderAddresses {
-			l = len(s)
-			n += 1 + l + sovFunders(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovFunders(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozFunders(x uint64) (n int) {
-	return sovFunders(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Funder) Unmarshal(dAtA []byte) error {
+func (m *MatchedFunding) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -607,15 +4187,53 @@ func (m *Funder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Funder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MatchedFunding: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Funder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MatchedFunding: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignId", wireType)
+			}
+			m.CampaignId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -643,11 +4261,11 @@ func (m *Funder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -672,16 +4290,100 @@ func (m *Funder) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthFunders
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amounts = append(m.Amounts, types.Coin{})
+			if err := m.Amounts[len(m.Amounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFunders(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FundingContribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFunders
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Moniker = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FundingContribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FundingContribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFunders
@@ -691,29 +4393,16 @@ func (m *Funder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthFunders
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthFunders
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Identity = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleId", wireType)
 			}
-			var stringLen uint64
+			m.BundleId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFunders
@@ -723,27 +4412,14 @@ func (m *Funder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BundleId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthFunders
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthFunders
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Website = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contact", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -771,13 +4447,13 @@ func (m *Funder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contact = string(dAtA[iNdEx:postIndex])
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFunders
@@ -787,23 +4463,25 @@ func (m *Funder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFunders
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFunders
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Amounts = append(m.Amounts, types.Coin{})
+			if err := m.Amounts[len(m.Amounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -826,7 +4504,7 @@ func (m *Funder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Funding) Unmarshal(dAtA []byte) error {
+func (m *FunderAttestation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -849,10 +4527,10 @@ func (m *Funding) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Funding: wiretype end group for non-group")
+			return fmt.Errorf("proto: FunderAttestation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Funding: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FunderAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -888,10 +4566,10 @@ func (m *Funding) Unmarshal(dAtA []byte) error {
 			m.FunderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestor", wireType)
 			}
-			m.PoolId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFunders
@@ -901,16 +4579,29 @@ func (m *Funding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFunders
@@ -920,31 +4611,29 @@ func (m *Funding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFunders
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFunders
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amounts = append(m.Amounts, types.Coin{})
-			if err := m.Amounts[len(m.Amounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountsPerBundle", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
 			}
-			var msglen int
+			m.Expiry = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFunders
@@ -954,31 +4643,16 @@ func (m *Funding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Expiry |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthFunders
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthFunders
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AmountsPerBundle = append(m.AmountsPerBundle, types.Coin{})
-			if err := m.AmountsPerBundle[len(m.AmountsPerBundle)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalFunded", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			var msglen int
+			m.CreatedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFunders
@@ -988,26 +4662,11 @@ func (m *Funding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.CreatedAt |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthFunders
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthFunders
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TotalFunded = append(m.TotalFunded, types.Coin{})
-			if err := m.TotalFunded[len(m.TotalFunded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFunders(dAtA[iNdEx:])
@@ -1029,7 +4688,7 @@ func (m *Funding) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FundingState) Unmarshal(dAtA []byte) error {
+func (m *FunderStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1052,17 +4711,17 @@ func (m *FundingState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FundingState: wiretype end group for non-group")
+			return fmt.Errorf("proto: FunderStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FundingState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FunderStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
 			}
-			m.PoolId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFunders
@@ -1072,16 +4731,29 @@ func (m *FundingState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveFunderAddresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LifetimeFunded", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFunders
@@ -1091,24 +4763,140 @@ func (m *FundingState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFunders
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFunders
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActiveFunderAddresses = append(m.ActiveFunderAddresses, string(dAtA[iNdEx:postIndex]))
+			m.LifetimeFunded = append(m.LifetimeFunded, types.Coin{})
+			if err := m.LifetimeFunded[len(m.LifetimeFunded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowFunders
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PoolsFunded = append(m.PoolsFunded, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowFunders
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthFunders
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthFunders
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PoolsFunded) == 0 {
+					m.PoolsFunded = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFunders
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PoolsFunded = append(m.PoolsFunded, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolsFunded", wireType)
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFundingDuration", wireType)
+			}
+			m.TotalFundingDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalFundingDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedFundings", wireType)
+			}
+			m.CompletedFundings = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletedFundings |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFunders(dAtA[iNdEx:])
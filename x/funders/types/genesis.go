@@ -42,5 +42,80 @@ func (gs GenesisState) Validate() error {
 			return fmt.Errorf("duplicated funding state id for %v", fundingState)
 		}
 	}
+
+	funderDelegateIndexMap := make(map[string]struct{})
+	for _, funderDelegate := range gs.FunderDelegateList {
+		index := FunderDelegateKey(funderDelegate.Funder, funderDelegate.Delegate)
+		if _, ok := funderDelegateIndexMap[string(index)]; ok {
+			return fmt.Errorf("duplicated funder delegate id for %v", funderDelegate)
+		}
+		funderDelegateIndexMap[string(index)] = struct{}{}
+	}
+
+	coinPriceReportIndexMap := make(map[string]struct{})
+	for _, coinPriceReport := range gs.CoinPriceReportList {
+		index := CoinPriceReportKey(coinPriceReport.Denom, coinPriceReport.Reporter)
+		if _, ok := coinPriceReportIndexMap[string(index)]; ok {
+			return fmt.Errorf("duplicated coin price report id for %v", coinPriceReport)
+		}
+		coinPriceReportIndexMap[string(index)] = struct{}{}
+	}
+
+	oracleCoinWeightIndexMap := make(map[string]struct{})
+	for _, oracleCoinWeight := range gs.OracleCoinWeightList {
+		index := OracleCoinWeightKey(oracleCoinWeight.Denom)
+		if _, ok := oracleCoinWeightIndexMap[string(index)]; ok {
+			return fmt.Errorf("duplicated oracle coin weight id for %v", oracleCoinWeight)
+		}
+		oracleCoinWeightIndexMap[string(index)] = struct{}{}
+	}
+
+	matchingCampaignIndexMap := make(map[string]struct{})
+	for _, matchingCampaign := range gs.MatchingCampaignList {
+		index := MatchingCampaignKey(matchingCampaign.Id)
+		if _, ok := matchingCampaignIndexMap[string(index)]; ok {
+			return fmt.Errorf("duplicated matching campaign id for %v", matchingCampaign)
+		}
+		if matchingCampaign.Id >= gs.MatchingCampaignCount {
+			return fmt.Errorf("matching campaign id %v is greater or equal than the matching campaign count", matchingCampaign.Id)
+		}
+		matchingCampaignIndexMap[string(index)] = struct{}{}
+	}
+
+	fundingContributionIndexMap := make(map[string]struct{})
+	for _, fundingContribution := range gs.FundingContributionList {
+		index := FundingContributionKeyByPool(fundingContribution.PoolId, fundingContribution.BundleId, fundingContribution.FunderAddress)
+		if _, ok := fundingContributionIndexMap[string(index)]; ok {
+			return fmt.Errorf("duplicated funding contribution id for %v", fundingContribution)
+		}
+		fundingContributionIndexMap[string(index)] = struct{}{}
+	}
+
+	funderAttestationIndexMap := make(map[string]struct{})
+	for _, funderAttestation := range gs.FunderAttestationList {
+		index := FunderAttestationKey(funderAttestation.FunderAddress)
+		if _, ok := funderAttestationIndexMap[string(index)]; ok {
+			return fmt.Errorf("duplicated funder attestation id for %v", funderAttestation)
+		}
+		funderAttestationIndexMap[string(index)] = struct{}{}
+	}
+
+	funderStatsIndexMap := make(map[string]struct{})
+	for _, funderStats := range gs.FunderStatsList {
+		index := FunderStatsKey(funderStats.FunderAddress)
+		if _, ok := funderStatsIndexMap[string(index)]; ok {
+			return fmt.Errorf("duplicated funder stats id for %v", funderStats)
+		}
+		funderStatsIndexMap[string(index)] = struct{}{}
+	}
+
+	matchedFundingIndexMap := make(map[string]struct{})
+	for _, matchedFunding := range gs.MatchedFundingList {
+		index := MatchedFundingKeyByFunder(matchedFunding.PoolId, matchedFunding.Funder, matchedFunding.CampaignId)
+		if _, ok := matchedFundingIndexMap[string(index)]; ok {
+			return fmt.Errorf("duplicated matched funding id for %v", matchedFunding)
+		}
+		matchedFundingIndexMap[string(index)] = struct{}{}
+	}
 	return gs.Params.Validate()
 }
@@ -33,6 +33,24 @@ type GenesisState struct {
 	FundingList []Funding `protobuf:"bytes,3,rep,name=funding_list,json=fundingList,proto3" json:"funding_list"`
 	// funding_state ...
 	FundingStateList []FundingState `protobuf:"bytes,4,rep,name=funding_state_list,json=fundingStateList,proto3" json:"funding_state_list"`
+	// funder_delegate_list ...
+	FunderDelegateList []FunderDelegate `protobuf:"bytes,5,rep,name=funder_delegate_list,json=funderDelegateList,proto3" json:"funder_delegate_list"`
+	// coin_price_report_list ...
+	CoinPriceReportList []CoinPriceReport `protobuf:"bytes,6,rep,name=coin_price_report_list,json=coinPriceReportList,proto3" json:"coin_price_report_list"`
+	// oracle_coin_weight_list ...
+	OracleCoinWeightList []OracleCoinWeight `protobuf:"bytes,7,rep,name=oracle_coin_weight_list,json=oracleCoinWeightList,proto3" json:"oracle_coin_weight_list"`
+	// matching_campaign_list ...
+	MatchingCampaignList []MatchingCampaign `protobuf:"bytes,8,rep,name=matching_campaign_list,json=matchingCampaignList,proto3" json:"matching_campaign_list"`
+	// matching_campaign_count ...
+	MatchingCampaignCount uint64 `protobuf:"varint,9,opt,name=matching_campaign_count,json=matchingCampaignCount,proto3" json:"matching_campaign_count,omitempty"`
+	// funding_contribution_list ...
+	FundingContributionList []FundingContribution `protobuf:"bytes,10,rep,name=funding_contribution_list,json=fundingContributionList,proto3" json:"funding_contribution_list"`
+	// funder_attestation_list ...
+	FunderAttestationList []FunderAttestation `protobuf:"bytes,11,rep,name=funder_attestation_list,json=funderAttestationList,proto3" json:"funder_attestation_list"`
+	// funder_stats_list ...
+	FunderStatsList []FunderStats `protobuf:"bytes,12,rep,name=funder_stats_list,json=funderStatsList,proto3" json:"funder_stats_list"`
+	// matched_funding_list ...
+	MatchedFundingList []MatchedFunding `protobuf:"bytes,13,rep,name=matched_funding_list,json=matchedFundingList,proto3" json:"matched_funding_list"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -96,6 +114,69 @@ func (m *GenesisState) GetFundingStateList() []FundingState {
 	return nil
 }
 
+func (m *GenesisState) GetFunderDelegateList() []FunderDelegate {
+	if m != nil {
+		return m.FunderDelegateList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCoinPriceReportList() []CoinPriceReport {
+	if m != nil {
+		return m.CoinPriceReportList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOracleCoinWeightList() []OracleCoinWeight {
+	if m != nil {
+		return m.OracleCoinWeightList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMatchingCampaignList() []MatchingCampaign {
+	if m != nil {
+		return m.MatchingCampaignList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMatchingCampaignCount() uint64 {
+	if m != nil {
+		return m.MatchingCampaignCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetFundingContributionList() []FundingContribution {
+	if m != nil {
+		return m.FundingContributionList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFunderAttestationList() []FunderAttestation {
+	if m != nil {
+		return m.FunderAttestationList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFunderStatsList() []FunderStats {
+	if m != nil {
+		return m.FunderStatsList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMatchedFundingList() []MatchedFunding {
+	if m != nil {
+		return m.MatchedFundingList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "kyve.funders.v1beta1.GenesisState")
 }
@@ -105,26 +186,43 @@ func init() {
 }
 
 var fileDescriptor_d339226ca8e2c929 = []byte{
-	// 301 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xca, 0xae, 0x2c, 0x4b,
-	0xd5, 0x4f, 0x2b, 0xcd, 0x4b, 0x49, 0x2d, 0x2a, 0xd6, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34,
-	0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12,
-	0x01, 0xa9, 0xd1, 0x83, 0xaa, 0xd1, 0x83, 0xaa, 0x91, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b,
-	0xd0, 0x07, 0xb1, 0x20, 0x6a, 0xa5, 0xb0, 0x9b, 0x07, 0xd3, 0x0b, 0x51, 0xa3, 0x88, 0x55, 0x4d,
-	0x41, 0x62, 0x51, 0x62, 0x2e, 0x54, 0x89, 0xd2, 0x7a, 0x26, 0x2e, 0x1e, 0x77, 0x88, 0x23, 0x82,
-	0x4b, 0x12, 0x4b, 0x52, 0x85, 0xac, 0xb8, 0xd8, 0x20, 0x0a, 0x24, 0x18, 0x15, 0x18, 0x35, 0xb8,
-	0x8d, 0x64, 0xf4, 0xb0, 0x39, 0x4a, 0x2f, 0x00, 0xac, 0xc6, 0x89, 0xe5, 0xc4, 0x3d, 0x79, 0x86,
-	0x20, 0xa8, 0x0e, 0x21, 0x67, 0x2e, 0x6e, 0x88, 0xba, 0xf8, 0x9c, 0xcc, 0xe2, 0x12, 0x09, 0x26,
-	0x05, 0x66, 0xdc, 0x06, 0xb8, 0x81, 0xf9, 0x50, 0x03, 0xb8, 0x20, 0xb2, 0x3e, 0x99, 0xc5, 0x25,
-	0x42, 0x6e, 0x5c, 0x3c, 0x20, 0x5e, 0x66, 0x5e, 0x3a, 0xc4, 0x14, 0x66, 0xb0, 0x29, 0xb2, 0xb8,
-	0x4d, 0xc9, 0xcc, 0x4b, 0x87, 0x1a, 0xc3, 0x0d, 0xd5, 0x08, 0x36, 0x27, 0x8c, 0x4b, 0x08, 0x66,
-	0x4e, 0x31, 0xc8, 0x67, 0x10, 0xd3, 0x58, 0xc0, 0xa6, 0x29, 0xe1, 0x35, 0x0d, 0x1c, 0x10, 0x50,
-	0x23, 0x05, 0xd2, 0x90, 0xc4, 0x40, 0xe6, 0x3a, 0xb9, 0x9d, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91,
-	0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3,
-	0xb1, 0x1c, 0x43, 0x94, 0x4e, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0xbe,
-	0x77, 0x64, 0x98, 0xab, 0x5f, 0x6a, 0x49, 0x79, 0x7e, 0x51, 0xb6, 0x7e, 0x72, 0x46, 0x62, 0x66,
-	0x9e, 0x7e, 0x05, 0x3c, 0x22, 0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x11, 0x60, 0x0c,
-	0x08, 0x00, 0x00, 0xff, 0xff, 0x69, 0x25, 0xe7, 0xb6, 0x19, 0x02, 0x00, 0x00,
+	// 564 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0x87, 0x63, 0x1a, 0x42, 0xd9, 0x04, 0x01, 0x26, 0x6d, 0x42, 0x05, 0x26, 0x8d, 0xf8, 0x53,
+	0x24, 0x64, 0xab, 0x20, 0x71, 0xe0, 0x46, 0x03, 0xe1, 0x00, 0x85, 0x2a, 0x95, 0x8a, 0x40, 0x48,
+	0xc1, 0xd9, 0xac, 0x9d, 0x55, 0xe2, 0x5d, 0xcb, 0xde, 0xb4, 0xf4, 0x2d, 0x78, 0xac, 0x1e, 0x7b,
+	0xe4, 0x84, 0x50, 0xf2, 0x10, 0x5c, 0xab, 0x9d, 0x1d, 0xa7, 0x4d, 0x9a, 0xfa, 0x96, 0xcc, 0xce,
+	0xef, 0xfb, 0xc6, 0xd6, 0xac, 0x49, 0x73, 0x78, 0x7c, 0xc8, 0xbc, 0x60, 0x2c, 0xfa, 0x2c, 0x49,
+	0xbd, 0xc3, 0xed, 0x1e, 0x53, 0xfe, 0xb6, 0x17, 0x32, 0xc1, 0x52, 0x9e, 0xba, 0x71, 0x22, 0x95,
+	0xb4, 0xab, 0xba, 0xc7, 0xc5, 0x1e, 0x17, 0x7b, 0x36, 0xaa, 0xa1, 0x0c, 0x25, 0x34, 0x78, 0xfa,
+	0x97, 0xe9, 0xdd, 0x58, 0xce, 0xcb, 0xb2, 0xa6, 0x67, 0x73, 0x69, 0x4f, 0xec, 0x27, 0x7e, 0x84,
+	0x2d, 0xcd, 0xff, 0xab, 0xa4, 0xf2, 0xc1, 0x0c, 0xb1, 0xaf, 0x7c, 0xc5, 0xec, 0x37, 0xa4, 0x64,
+	0x1a, 0xea, 0x56, 0xc3, 0xda, 0x2a, 0xbf, 0x7c, 0xe0, 0x2e, 0x1b, 0xca, 0xdd, 0x83, 0x9e, 0x9d,
+	0xe2, 0xc9, 0xdf, 0x47, 0x85, 0x0e, 0x26, 0xec, 0x16, 0x29, 0x9b, 0xbe, 0xee, 0x88, 0xa7, 0xaa,
+	0x7e, 0xad, 0xb1, 0x72, 0x35, 0xa0, 0x0d, 0xff, 0x11, 0x40, 0xcc, 0xe9, 0x27, 0x9e, 0x2a, 0xbb,
+	0x4d, 0x2a, 0xfa, 0x1f, 0x17, 0xa1, 0xa1, 0xac, 0x00, 0xe5, 0xe1, 0xd5, 0x14, 0x2e, 0x42, 0xc4,
+	0x94, 0x31, 0x08, 0x9c, 0x03, 0x62, 0x67, 0x9c, 0x54, 0x3f, 0x99, 0xa1, 0x15, 0x81, 0xd6, 0xcc,
+	0xa5, 0xc1, 0x8b, 0x40, 0xe4, 0x9d, 0xe0, 0x42, 0x0d, 0xb8, 0x3f, 0x48, 0x15, 0x1f, 0xb2, 0xcf,
+	0x46, 0x2c, 0x9c, 0x91, 0xaf, 0x03, 0xf9, 0x71, 0xde, 0xd3, 0xbe, 0xc3, 0x00, 0xb2, 0xed, 0x60,
+	0xae, 0x0a, 0xf4, 0x9f, 0x64, 0x9d, 0x4a, 0x2e, 0xba, 0x71, 0xc2, 0x29, 0xeb, 0x26, 0x2c, 0x96,
+	0x89, 0x32, 0xfc, 0x12, 0xf0, 0x9f, 0x2c, 0xe7, 0xb7, 0x24, 0x17, 0x7b, 0x3a, 0xd2, 0x81, 0x04,
+	0x0a, 0xee, 0xd1, 0xf9, 0x32, 0x18, 0x28, 0xa9, 0xc9, 0xc4, 0xa7, 0x23, 0xd6, 0x05, 0xd1, 0x11,
+	0xe3, 0xe1, 0x00, 0x15, 0x37, 0x40, 0xf1, 0x74, 0xb9, 0xe2, 0x0b, 0x84, 0xb4, 0xe8, 0x2b, 0x44,
+	0xd0, 0x51, 0x95, 0x0b, 0x75, 0x90, 0xf4, 0xc8, 0x7a, 0xe4, 0x2b, 0x3a, 0xd0, 0x6f, 0x9f, 0xfa,
+	0x51, 0xec, 0xf3, 0x50, 0x18, 0xc7, 0x6a, 0x9e, 0x63, 0x17, 0x33, 0x2d, 0x8c, 0x64, 0x8e, 0x68,
+	0xa1, 0x0e, 0x8e, 0xd7, 0xa4, 0x76, 0xd9, 0x41, 0xe5, 0x58, 0xa8, 0xfa, 0xcd, 0x86, 0xb5, 0x55,
+	0xec, 0xac, 0x2d, 0xc6, 0x5a, 0xfa, 0xd0, 0x1e, 0x92, 0xfb, 0xd9, 0x62, 0x50, 0x29, 0x54, 0xc2,
+	0x7b, 0x63, 0xc5, 0x25, 0x8e, 0x47, 0x60, 0xbc, 0xe7, 0xb9, 0xfb, 0xd1, 0xba, 0x90, 0xc2, 0x09,
+	0x6b, 0xc1, 0xe5, 0x23, 0x18, 0x92, 0x91, 0x1a, 0x6e, 0x8b, 0xaf, 0x14, 0xd3, 0x8b, 0x38, 0x53,
+	0x95, 0x41, 0xf5, 0x2c, 0x6f, 0x61, 0xde, 0x9e, 0x67, 0x50, 0xb4, 0x16, 0x2c, 0x1e, 0x80, 0x66,
+	0x9f, 0xdc, 0x45, 0x8d, 0xae, 0xa6, 0x46, 0x50, 0x01, 0xc1, 0x66, 0x9e, 0x40, 0xaf, 0x75, 0x76,
+	0x8b, 0x6f, 0x07, 0xe7, 0xa5, 0x6c, 0xd3, 0xe1, 0x0d, 0xb2, 0x7e, 0x77, 0xee, 0x46, 0xde, 0xca,
+	0xdb, 0xf4, 0x5d, 0x93, 0x98, 0xbf, 0x98, 0x76, 0x34, 0x57, 0xd5, 0xf4, 0x9d, 0xf6, 0xc9, 0xc4,
+	0xb1, 0x4e, 0x27, 0x8e, 0xf5, 0x6f, 0xe2, 0x58, 0xbf, 0xa7, 0x4e, 0xe1, 0x74, 0xea, 0x14, 0xfe,
+	0x4c, 0x9d, 0xc2, 0xf7, 0x17, 0x21, 0x57, 0x83, 0x71, 0xcf, 0xa5, 0x32, 0xf2, 0x3e, 0x7e, 0x3b,
+	0x78, 0xff, 0x99, 0xa9, 0x23, 0x99, 0x0c, 0x3d, 0x3a, 0xf0, 0xb9, 0xf0, 0x7e, 0xcd, 0x3e, 0x68,
+	0xea, 0x38, 0x66, 0x69, 0xaf, 0x04, 0x1f, 0xb2, 0x57, 0x67, 0x03, 0x00, 0xbf, 0x2c, 0x94, 0x77,
+	0x61, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -147,6 +245,123 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MatchedFundingList) > 0 {
+		for iNdEx := len(m.MatchedFundingList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MatchedFundingList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.FunderStatsList) > 0 {
+		for iNdEx := len(m.FunderStatsList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FunderStatsList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.FunderAttestationList) > 0 {
+		for iNdEx := len(m.FunderAttestationList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FunderAttestationList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.FundingContributionList) > 0 {
+		for iNdEx := len(m.FundingContributionList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FundingContributionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.MatchingCampaignCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MatchingCampaignCount))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.MatchingCampaignList) > 0 {
+		for iNdEx := len(m.MatchingCampaignList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MatchingCampaignList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.OracleCoinWeightList) > 0 {
+		for iNdEx := len(m.OracleCoinWeightList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OracleCoinWeightList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.CoinPriceReportList) > 0 {
+		for iNdEx := len(m.CoinPriceReportList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CoinPriceReportList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.FunderDelegateList) > 0 {
+		for iNdEx := len(m.FunderDelegateList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FunderDelegateList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.FundingStateList) > 0 {
 		for iNdEx := len(m.FundingStateList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -239,6 +454,57 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.FunderDelegateList) > 0 {
+		for _, e := range m.FunderDelegateList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CoinPriceReportList) > 0 {
+		for _, e := range m.CoinPriceReportList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OracleCoinWeightList) > 0 {
+		for _, e := range m.OracleCoinWeightList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MatchingCampaignList) > 0 {
+		for _, e := range m.MatchingCampaignList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MatchingCampaignCount != 0 {
+		n += 1 + sovGenesis(uint64(m.MatchingCampaignCount))
+	}
+	if len(m.FundingContributionList) > 0 {
+		for _, e := range m.FundingContributionList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FunderAttestationList) > 0 {
+		for _, e := range m.FunderAttestationList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FunderStatsList) > 0 {
+		for _, e := range m.FunderStatsList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MatchedFundingList) > 0 {
+		for _, e := range m.MatchedFundingList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -412,6 +678,297 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderDelegateList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderDelegateList = append(m.FunderDelegateList, FunderDelegate{})
+			if err := m.FunderDelegateList[len(m.FunderDelegateList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinPriceReportList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoinPriceReportList = append(m.CoinPriceReportList, CoinPriceReport{})
+			if err := m.CoinPriceReportList[len(m.CoinPriceReportList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleCoinWeightList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleCoinWeightList = append(m.OracleCoinWeightList, OracleCoinWeight{})
+			if err := m.OracleCoinWeightList[len(m.OracleCoinWeightList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchingCampaignList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MatchingCampaignList = append(m.MatchingCampaignList, MatchingCampaign{})
+			if err := m.MatchingCampaignList[len(m.MatchingCampaignList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchingCampaignCount", wireType)
+			}
+			m.MatchingCampaignCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MatchingCampaignCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingContributionList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundingContributionList = append(m.FundingContributionList, FundingContribution{})
+			if err := m.FundingContributionList[len(m.FundingContributionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAttestationList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAttestationList = append(m.FunderAttestationList, FunderAttestation{})
+			if err := m.FunderAttestationList[len(m.FunderAttestationList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderStatsList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderStatsList = append(m.FunderStatsList, FunderStats{})
+			if err := m.FunderStatsList[len(m.FunderStatsList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchedFundingList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MatchedFundingList = append(m.MatchedFundingList, MatchedFunding{})
+			if err := m.MatchedFundingList[len(m.MatchedFundingList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
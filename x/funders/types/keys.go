@@ -19,6 +19,12 @@ const (
 const (
 	// MaxFunders which are allowed per pool
 	MaxFunders = 50
+
+	// MaxFundingContributionPrunes is the maximum number of funding contributions
+	// which are removed per charged bundle. Since at most MaxFunders contributions
+	// are added per bundle, a backlog (e.g. after lowering the retention) is
+	// still removed over time.
+	MaxFundingContributionPrunes = 2 * MaxFunders
 )
 
 var (
@@ -41,6 +47,53 @@ var (
 	// FundingStateKeyPrefix stores funding state for each pool
 	// FundingStateKeyPrefix | <poolId> | <funder>
 	FundingStateKeyPrefix = []byte{3, 0}
+
+	// FunderDelegateKeyPrefix stores the permissions of every delegate of a funder
+	// FunderDelegateKeyPrefix | <funder> | <delegate>
+	FunderDelegateKeyPrefix = []byte{4, 0}
+
+	// CoinPriceReportKeyPrefix stores the latest price report of every price reporter for a coin
+	// CoinPriceReportKeyPrefix | <denom> | <reporter>
+	CoinPriceReportKeyPrefix = []byte{5, 0}
+
+	// OracleCoinWeightKeyPrefix stores the oracle coin weight of a coin
+	// OracleCoinWeightKeyPrefix | <denom>
+	OracleCoinWeightKeyPrefix = []byte{6, 0}
+
+	// MatchingCampaignKeyPrefix stores all matching campaigns
+	// MatchingCampaignKeyPrefix | <id>
+	MatchingCampaignKeyPrefix = []byte{7, 0}
+
+	// MatchingCampaignCountKey stores the total number of matching campaigns
+	MatchingCampaignCountKey = []byte{7, 1}
+
+	// MatchingCampaignKeyPrefixByPool stores the ids of all campaigns which can still match fundings in a pool
+	// MatchingCampaignKeyPrefixByPool | <poolId> | <id>
+	MatchingCampaignKeyPrefixByPool = []byte{7, 2}
+
+	// MatchedFundingKeyPrefixBySponsor stores the matched fundings of every campaign by sponsor
+	// MatchedFundingKeyPrefixBySponsor | <poolId> | <sponsor> | <campaignId> | <funder>
+	MatchedFundingKeyPrefixBySponsor = []byte{7, 3}
+
+	// MatchedFundingKeyPrefixByFunder stores the matched fundings of every campaign by funder
+	// MatchedFundingKeyPrefixByFunder | <poolId> | <funder> | <campaignId>
+	MatchedFundingKeyPrefixByFunder = []byte{7, 4}
+
+	// FundingContributionKeyPrefixByPool stores the contribution of every funder to a bundle by pool
+	// FundingContributionKeyPrefixByPool | <poolId> | <bundleId> | <funder>
+	FundingContributionKeyPrefixByPool = []byte{8, 0}
+
+	// FundingContributionKeyPrefixByFunder stores the contribution of every funder to a bundle by funder
+	// FundingContributionKeyPrefixByFunder | <funder> | <poolId> | <bundleId>
+	FundingContributionKeyPrefixByFunder = []byte{8, 1}
+
+	// FunderAttestationKeyPrefix stores the attestation of every verified funder
+	// FunderAttestationKeyPrefix | <funder>
+	FunderAttestationKeyPrefix = []byte{9, 0}
+
+	// FunderStatsKeyPrefix stores the lifetime stats of every funder
+	// FunderStatsKeyPrefix | <funder>
+	FunderStatsKeyPrefix = []byte{10, 0}
 )
 
 func FunderKey(funderAddress string) []byte {
@@ -68,3 +121,88 @@ func FundingKeyByPoolIter(poolId uint64) []byte {
 func FundingStateKey(poolId uint64) []byte {
 	return util.GetByteKey(poolId)
 }
+
+func FunderDelegateKey(funderAddress string, delegateAddress string) []byte {
+	return util.GetByteKey(funderAddress, delegateAddress)
+}
+
+// FunderDelegateKeyIter is used to query all delegates of a funder
+func FunderDelegateKeyIter(funderAddress string) []byte {
+	return util.GetByteKey(funderAddress)
+}
+
+func CoinPriceReportKey(denom string, reporterAddress string) []byte {
+	return util.GetByteKey(denom, reporterAddress)
+}
+
+// CoinPriceReportKeyIter is used to query all price reports of a coin.
+// Since denoms can be prefixes of other denoms the denom of every result has to be checked.
+func CoinPriceReportKeyIter(denom string) []byte {
+	return util.GetByteKey(denom)
+}
+
+func OracleCoinWeightKey(denom string) []byte {
+	return util.GetByteKey(denom)
+}
+
+func MatchingCampaignKey(id uint64) []byte {
+	return util.GetByteKey(id)
+}
+
+func MatchingCampaignKeyByPool(poolId uint64, id uint64) []byte {
+	return util.GetByteKey(poolId, id)
+}
+
+// MatchingCampaignKeyByPoolIter is used to query all campaigns which can match fundings in a pool
+func MatchingCampaignKeyByPoolIter(poolId uint64) []byte {
+	return util.GetByteKey(poolId)
+}
+
+func MatchedFundingKeyBySponsor(poolId uint64, sponsorAddress string, campaignId uint64, funderAddress string) []byte {
+	return util.GetByteKey(poolId, sponsorAddress, campaignId, funderAddress)
+}
+
+// MatchedFundingKeyBySponsorIter is used to query all matched fundings of a sponsor in a pool
+func MatchedFundingKeyBySponsorIter(poolId uint64, sponsorAddress string) []byte {
+	return util.GetByteKey(poolId, sponsorAddress)
+}
+
+func MatchedFundingKeyByFunder(poolId uint64, funderAddress string, campaignId uint64) []byte {
+	return util.GetByteKey(poolId, funderAddress, campaignId)
+}
+
+// MatchedFundingKeyByFunderIter is used to query all matched fundings of a funder in a pool
+func MatchedFundingKeyByFunderIter(poolId uint64, funderAddress string) []byte {
+	return util.GetByteKey(poolId, funderAddress)
+}
+
+func FundingContributionKeyByPool(poolId uint64, bundleId uint64, funderAddress string) []byte {
+	return util.GetByteKey(poolId, bundleId, funderAddress)
+}
+
+func FundingContributionKeyByFunder(funderAddress string, poolId uint64, bundleId uint64) []byte {
+	return util.GetByteKey(funderAddress, poolId, bundleId)
+}
+
+// FundingContributionKeyByPoolIter is used to query all contributions of a pool
+func FundingContributionKeyByPoolIter(poolId uint64) []byte {
+	return util.GetByteKey(poolId)
+}
+
+// FundingContributionKeyByBundleIter is used to query all contributions of a bundle
+func FundingContributionKeyByBundleIter(poolId uint64, bundleId uint64) []byte {
+	return util.GetByteKey(poolId, bundleId)
+}
+
+// FundingContributionKeyByFunderIter is used to query all contributions of a funder in a pool
+func FundingContributionKeyByFunderIter(funderAddress string, poolId uint64) []byte {
+	return util.GetByteKey(funderAddress, poolId)
+}
+
+func FunderAttestationKey(funderAddress string) []byte {
+	return util.GetByteKey(funderAddress)
+}
+
+func FunderStatsKey(funderAddress string) []byte {
+	return util.GetByteKey(funderAddress)
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgAttestFunder{}
+
+func (msg *MsgAttestFunder) GetSignBytes() []byte {
+	bz := Amino.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAttestFunder) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgAttestFunder) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAttestFunder) Type() string {
+	return "kyve/funders/MsgAttestFunder"
+}
+
+func (msg *MsgAttestFunder) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidAddress, "invalid creator address: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Funder); err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidAddress, "invalid funder address: %s", err)
+	}
+
+	if msg.Expiry == 0 {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, ErrInvalidExpiration.Error(), msg.Expiry)
+	}
+
+	return nil
+}
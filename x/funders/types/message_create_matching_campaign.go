@@ -0,0 +1,67 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgCreateMatchingCampaign{}
+
+func (msg *MsgCreateMatchingCampaign) GetSignBytes() []byte {
+	bz := Amino.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCreateMatchingCampaign) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCreateMatchingCampaign) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreateMatchingCampaign) Type() string {
+	return "kyve/funders/MsgCreateMatchingCampaign"
+}
+
+func (msg *MsgCreateMatchingCampaign) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidAddress, "invalid creator address: %s", err)
+	}
+
+	if len(msg.PoolIds) == 0 {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, "no pool ids provided")
+	}
+
+	poolIds := make(map[uint64]bool)
+	for _, poolId := range msg.PoolIds {
+		if poolIds[poolId] {
+			return errors.Wrapf(errorsTypes.ErrInvalidRequest, "duplicate pool id %d", poolId)
+		}
+		poolIds[poolId] = true
+	}
+
+	if msg.MatchRatio.IsNil() || !msg.MatchRatio.IsPositive() {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, "match ratio has to be positive")
+	}
+
+	if !msg.Amounts.IsValid() || msg.Amounts.IsZero() {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, "invalid amounts")
+	}
+
+	if !msg.AmountsPerBundle.IsValid() {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, "invalid amounts per bundle")
+	}
+
+	if !msg.Amounts.DenomsSubsetOf(msg.AmountsPerBundle) {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, "every coin in amounts needs an amount per bundle")
+	}
+
+	return nil
+}
@@ -36,6 +36,12 @@ func (msg *MsgDefundPool) ValidateBasic() error {
 		return errors.Wrapf(errorsTypes.ErrInvalidAddress, "invalid creator address: %s", err)
 	}
 
+	if msg.Funder != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.Funder); err != nil {
+			return errors.Wrapf(errorsTypes.ErrInvalidAddress, "invalid funder address: %s", err)
+		}
+	}
+
 	if util.ValidateNumber(msg.PoolId) != nil {
 		return errors.Wrapf(errorsTypes.ErrInvalidRequest, "invalid pool id")
 	}
@@ -50,3 +56,11 @@ func (msg *MsgDefundPool) ValidateBasic() error {
 
 	return nil
 }
+
+// GetFunderAddress returns the address of the funder the pool is defunded for
+func (msg *MsgDefundPool) GetFunderAddress() string {
+	if msg.Funder == "" {
+		return msg.Creator
+	}
+	return msg.Funder
+}
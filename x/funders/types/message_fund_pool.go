@@ -36,11 +36,17 @@ func (msg *MsgFundPool) ValidateBasic() error {
 		return errors.Wrapf(errorsTypes.ErrInvalidAddress, "invalid creator address: %s", err)
 	}
 
+	if msg.Funder != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.Funder); err != nil {
+			return errors.Wrapf(errorsTypes.ErrInvalidAddress, "invalid funder address: %s", err)
+		}
+	}
+
 	if util.ValidateNumber(msg.PoolId) != nil {
 		return errors.Wrapf(errorsTypes.ErrInvalidRequest, "invalid pool id")
 	}
 
-	if msg.Amounts.Empty() && msg.AmountsPerBundle.Empty() {
+	if msg.Amounts.Empty() && msg.AmountsPerBundle.Empty() && msg.Constraints == nil {
 		return errors.Wrapf(errorsTypes.ErrInvalidRequest, "empty request")
 	}
 
@@ -52,5 +58,19 @@ func (msg *MsgFundPool) ValidateBasic() error {
 		return errors.Wrapf(errorsTypes.ErrInvalidRequest, "invalid amount per bundle: %s", err)
 	}
 
+	if msg.Constraints != nil {
+		if err := msg.Constraints.Validate(); err != nil {
+			return errors.Wrapf(errorsTypes.ErrInvalidRequest, "invalid constraints: %s", err)
+		}
+	}
+
 	return nil
 }
+
+// GetFunderAddress returns the address of the funder the pool is funded for
+func (msg *MsgFundPool) GetFunderAddress() string {
+	if msg.Funder == "" {
+		return msg.Creator
+	}
+	return msg.Funder
+}
@@ -0,0 +1,67 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgGrantFunderDelegate{}
+
+func (msg *MsgGrantFunderDelegate) GetSignBytes() []byte {
+	bz := Amino.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgGrantFunderDelegate) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgGrantFunderDelegate) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgGrantFunderDelegate) Type() string {
+	return "kyve/funders/MsgGrantFunderDelegate"
+}
+
+func (msg *MsgGrantFunderDelegate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidAddress, "invalid creator address: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Delegate); err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidAddress, "invalid delegate address: %s", err)
+	}
+
+	if msg.Creator == msg.Delegate {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, "funder can not be its own delegate")
+	}
+
+	if !msg.CanFund && !msg.CanDefund && !msg.CanUpdateAmountsPerBundle {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, "no permission granted")
+	}
+
+	if msg.Unlimited && len(msg.SpendLimits) > 0 {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, "spend limits can not be set for an unlimited delegate")
+	}
+
+	poolIds := make(map[uint64]bool)
+	for _, spendLimit := range msg.SpendLimits {
+		if poolIds[spendLimit.PoolId] {
+			return errors.Wrapf(errorsTypes.ErrInvalidRequest, "duplicate spend limit for pool %d", spendLimit.PoolId)
+		}
+		poolIds[spendLimit.PoolId] = true
+
+		if err := spendLimit.Amounts.Validate(); err != nil {
+			return errors.Wrapf(errorsTypes.ErrInvalidRequest, "invalid spend limit: %s", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgReportCoinPrice{}
+
+func (msg *MsgReportCoinPrice) GetSignBytes() []byte {
+	bz := Amino.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgReportCoinPrice) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgReportCoinPrice) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgReportCoinPrice) Type() string {
+	return "kyve/funders/MsgReportCoinPrice"
+}
+
+func (msg *MsgReportCoinPrice) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidAddress, "invalid creator address: %s", err)
+	}
+
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, "invalid denom: %s", err)
+	}
+
+	if msg.Price.IsNil() || !msg.Price.IsPositive() {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, ErrInvalidPrice.Error(), msg.Price)
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgRevokeFunderAttestation{}
+
+func (msg *MsgRevokeFunderAttestation) GetSignBytes() []byte {
+	bz := Amino.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRevokeFunderAttestation) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRevokeFunderAttestation) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRevokeFunderAttestation) Type() string {
+	return "kyve/funders/MsgRevokeFunderAttestation"
+}
+
+func (msg *MsgRevokeFunderAttestation) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidAddress, "invalid creator address: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Funder); err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidAddress, "invalid funder address: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgRevokeFunderDelegate{}
+
+func (msg *MsgRevokeFunderDelegate) GetSignBytes() []byte {
+	bz := Amino.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRevokeFunderDelegate) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRevokeFunderDelegate) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRevokeFunderDelegate) Type() string {
+	return "kyve/funders/MsgRevokeFunderDelegate"
+}
+
+func (msg *MsgRevokeFunderDelegate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidAddress, "invalid creator address: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Delegate); err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidAddress, "invalid delegate address: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgWithdrawMatchingCampaign{}
+
+func (msg *MsgWithdrawMatchingCampaign) GetSignBytes() []byte {
+	bz := Amino.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgWithdrawMatchingCampaign) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgWithdrawMatchingCampaign) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgWithdrawMatchingCampaign) Type() string {
+	return "kyve/funders/MsgWithdrawMatchingCampaign"
+}
+
+func (msg *MsgWithdrawMatchingCampaign) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidAddress, "invalid creator address: %s", err)
+	}
+
+	return nil
+}
@@ -8,19 +8,39 @@ import (
 
 	"github.com/KYVENetwork/chain/util"
 	globalTypes "github.com/KYVENetwork/chain/x/global/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
 
 	// DefaultMinFundingMultiple 20
 	DefaultMinFundingMultiple = uint64(20)
+
+	// DefaultMaxPriceAge 1 hour
+	DefaultMaxPriceAge = uint64(60 * 60)
+
+	// DefaultMinPriceReports 1
+	DefaultMinPriceReports = uint64(1)
+
+	// DefaultFundingLedgerRetention 1000 bundles
+	DefaultFundingLedgerRetention = uint64(1000)
 )
 
-// NewParams creates a new Params instance
+// DefaultMaxCoinWeightChange 10%
+var DefaultMaxCoinWeightChange = math.LegacyMustNewDecFromStr("0.1")
+
+// NewParams creates a new Params instance without any price reporters and
+// funder attestors. All other params are set to their defaults.
 func NewParams(coinWhitelist []*WhitelistCoinEntry, minFundingMultiple uint64) Params {
 	return Params{
-		CoinWhitelist:      coinWhitelist,
-		MinFundingMultiple: minFundingMultiple,
+		CoinWhitelist:          coinWhitelist,
+		MinFundingMultiple:     minFundingMultiple,
+		PriceReporters:         []string{},
+		MaxPriceAge:            DefaultMaxPriceAge,
+		MinPriceReports:        DefaultMinPriceReports,
+		MaxCoinWeightChange:    DefaultMaxCoinWeightChange,
+		FundingLedgerRetention: DefaultFundingLedgerRetention,
+		FunderAttestors:        []string{},
 	}
 }
 
@@ -75,5 +95,33 @@ func (p *Params) Validate() error {
 		return fmt.Errorf("native KYVE coin \"%s\" not whitelisted", globalTypes.Denom)
 	}
 
+	for _, reporter := range p.PriceReporters {
+		if _, err := sdk.AccAddressFromBech32(reporter); err != nil {
+			return fmt.Errorf("invalid price reporter address: %s", err)
+		}
+	}
+
+	if err := util.ValidateNumber(p.MaxPriceAge); err != nil {
+		return err
+	}
+
+	if err := util.ValidateNumber(p.MinPriceReports); err != nil {
+		return err
+	}
+
+	if err := util.ValidatePercentage(p.MaxCoinWeightChange); err != nil {
+		return err
+	}
+
+	if err := util.ValidatePositiveNumber(p.FundingLedgerRetention); err != nil {
+		return err
+	}
+
+	for _, attestor := range p.FunderAttestors {
+		if _, err := sdk.AccAddressFromBech32(attestor); err != nil {
+			return fmt.Errorf("invalid funder attestor address: %s", err)
+		}
+	}
+
 	return nil
 }
@@ -100,6 +100,27 @@ type Params struct {
 	// In other words this param ensures, that a funder provides at least
 	// funding for `min_funding_multiple` bundles.
 	MinFundingMultiple uint64 `protobuf:"varint,2,opt,name=min_funding_multiple,json=minFundingMultiple,proto3" json:"min_funding_multiple,omitempty"`
+	// price_reporters is a list of addresses which are allowed to report
+	// coin prices. The reported prices are used as oracle coin weights.
+	PriceReporters []string `protobuf:"bytes,3,rep,name=price_reporters,json=priceReporters,proto3" json:"price_reporters,omitempty"`
+	// max_price_age is the time in seconds after which price reports and
+	// oracle coin weights are considered stale. If the oracle coin weight of a
+	// coin is stale the static coin weight of the whitelist is used instead.
+	MaxPriceAge uint64 `protobuf:"varint,4,opt,name=max_price_age,json=maxPriceAge,proto3" json:"max_price_age,omitempty"`
+	// min_price_reports is the minimum number of recent price reports which
+	// are required to update the oracle coin weight of a coin.
+	MinPriceReports uint64 `protobuf:"varint,5,opt,name=min_price_reports,json=minPriceReports,proto3" json:"min_price_reports,omitempty"`
+	// max_coin_weight_change is the maximum relative change of an oracle
+	// coin weight within a window of max_price_age seconds. The change is
+	// measured against the coin weight at the start of the window.
+	MaxCoinWeightChange cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=max_coin_weight_change,json=maxCoinWeightChange,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_coin_weight_change"`
+	// funding_ledger_retention is the number of most recent finalized bundles
+	// per pool for which the contributions of every funder are stored.
+	// It has to be positive.
+	FundingLedgerRetention uint64 `protobuf:"varint,7,opt,name=funding_ledger_retention,json=fundingLedgerRetention,proto3" json:"funding_ledger_retention,omitempty"`
+	// funder_attestors is a list of addresses which are allowed to
+	// verify the profiles of funders.
+	FunderAttestors []string `protobuf:"bytes,8,rep,name=funder_attestors,json=funderAttestors,proto3" json:"funder_attestors,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -149,6 +170,41 @@ func (m *Params) GetMinFundingMultiple() uint64 {
 	return 0
 }
 
+func (m *Params) GetPriceReporters() []string {
+	if m != nil {
+		return m.PriceReporters
+	}
+	return nil
+}
+
+func (m *Params) GetMaxPriceAge() uint64 {
+	if m != nil {
+		return m.MaxPriceAge
+	}
+	return 0
+}
+
+func (m *Params) GetMinPriceReports() uint64 {
+	if m != nil {
+		return m.MinPriceReports
+	}
+	return 0
+}
+
+func (m *Params) GetFundingLedgerRetention() uint64 {
+	if m != nil {
+		return m.FundingLedgerRetention
+	}
+	return 0
+}
+
+func (m *Params) GetFunderAttestors() []string {
+	if m != nil {
+		return m.FunderAttestors
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*WhitelistCoinEntry)(nil), "kyve.funders.v1beta1.WhitelistCoinEntry")
 	proto.RegisterType((*Params)(nil), "kyve.funders.v1beta1.Params")
@@ -157,33 +213,42 @@ func init() {
 func init() { proto.RegisterFile("kyve/funders/v1beta1/params.proto", fileDescriptor_906a9a55094dc984) }
 
 var fileDescriptor_906a9a55094dc984 = []byte{
-	// 413 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0xe3, 0x6d, 0x4c, 0x9a, 0xc7, 0x10, 0xb2, 0x8a, 0x14, 0x40, 0xcd, 0xca, 0x76, 0xc9,
-	0x01, 0xd9, 0x0c, 0x3e, 0x01, 0xa5, 0x9b, 0x84, 0xca, 0x9f, 0x2a, 0x07, 0x2a, 0xb8, 0x44, 0x4e,
-	0x62, 0x12, 0xab, 0xb1, 0x1d, 0xc5, 0x4e, 0x4b, 0x3e, 0x03, 0x17, 0x3e, 0x56, 0x0f, 0x1c, 0x7a,
-	0x44, 0x1c, 0x2a, 0xd4, 0x7e, 0x11, 0x14, 0x27, 0x14, 0x44, 0x39, 0x70, 0x8b, 0x1e, 0x3f, 0xcf,
-	0x4f, 0x79, 0x9f, 0xf7, 0x85, 0x8f, 0x66, 0xf5, 0x9c, 0x91, 0x8f, 0x95, 0x4c, 0x58, 0xa9, 0xc9,
-	0xfc, 0x2a, 0x62, 0x86, 0x5e, 0x91, 0x82, 0x96, 0x54, 0x68, 0x5c, 0x94, 0xca, 0x28, 0xd4, 0x6b,
-	0x2c, 0xb8, 0xb3, 0xe0, 0xce, 0xf2, 0xa0, 0x97, 0xaa, 0x54, 0x59, 0x03, 0x69, 0xbe, 0x5a, 0xef,
-	0xc5, 0xd7, 0x03, 0x88, 0xa6, 0x19, 0x37, 0x2c, 0xe7, 0xda, 0xbc, 0x50, 0x5c, 0x5e, 0x4b, 0x53,
-	0xd6, 0xa8, 0x0f, 0x61, 0xac, 0xb8, 0x0c, 0x13, 0x26, 0x95, 0x70, 0xc1, 0x00, 0xf8, 0x27, 0xc1,
-	0x49, 0xa3, 0x8c, 0x1a, 0x01, 0x5d, 0xc2, 0xb3, 0xee, 0x39, 0xe6, 0x82, 0xe6, 0xda, 0x3d, 0x18,
-	0x00, 0xff, 0x2c, 0xb8, 0xdd, 0x3a, 0x5a, 0x0d, 0x8d, 0x21, 0x12, 0x5c, 0x86, 0xcd, 0x7f, 0x70,
-	0x99, 0x86, 0x54, 0xa8, 0x4a, 0x1a, 0xf7, 0xb0, 0x61, 0x0d, 0xfb, 0xcb, 0xf5, 0xb9, 0xf3, 0x7d,
-	0x7d, 0x7e, 0x2f, 0x56, 0x5a, 0x28, 0xad, 0x93, 0x19, 0xe6, 0x8a, 0x08, 0x6a, 0x32, 0xfc, 0x52,
-	0x9a, 0xe0, 0xae, 0xe0, 0xf2, 0xa6, 0xcd, 0x3d, 0xb7, 0x31, 0x14, 0xc2, 0xfe, 0x3e, 0x2c, 0x2c,
-	0x58, 0x19, 0x46, 0x95, 0x4c, 0x72, 0xe6, 0x1e, 0xfd, 0x0f, 0xf7, 0xfe, 0xdf, 0xdc, 0x09, 0x2b,
-	0x87, 0x36, 0x8f, 0x46, 0xf0, 0xd4, 0x8e, 0xb4, 0x60, 0x3c, 0xcd, 0x8c, 0x7b, 0xcb, 0xe2, 0x2e,
-	0x3b, 0xdc, 0xc3, 0x7d, 0xdc, 0x2b, 0x96, 0xd2, 0xb8, 0x1e, 0xb1, 0x38, 0xb0, 0x4d, 0x4d, 0x6d,
-	0xec, 0xe2, 0x33, 0x80, 0xc7, 0x13, 0xbb, 0x0b, 0xf4, 0x16, 0xde, 0x69, 0x81, 0xbf, 0xda, 0x75,
-	0xc1, 0xe0, 0xd0, 0x3f, 0x7d, 0xea, 0xe3, 0x7f, 0xad, 0x07, 0xef, 0x2f, 0x21, 0xb0, 0x1d, 0xef,
-	0x74, 0xf4, 0x04, 0xf6, 0xfe, 0xac, 0x40, 0x54, 0xb9, 0xe1, 0x45, 0xce, 0x6c, 0xf7, 0x47, 0x01,
-	0xfa, 0x3d, 0xda, 0xeb, 0xee, 0x65, 0x78, 0xb3, 0xdc, 0x78, 0x60, 0xb5, 0xf1, 0xc0, 0x8f, 0x8d,
-	0x07, 0xbe, 0x6c, 0x3d, 0x67, 0xb5, 0xf5, 0x9c, 0x6f, 0x5b, 0xcf, 0xf9, 0xf0, 0x38, 0xe5, 0x26,
-	0xab, 0x22, 0x1c, 0x2b, 0x41, 0xc6, 0xef, 0xdf, 0x5d, 0xbf, 0x61, 0x66, 0xa1, 0xca, 0x19, 0x89,
-	0x33, 0xca, 0x25, 0xf9, 0xb4, 0xbb, 0x2f, 0x53, 0x17, 0x4c, 0x47, 0xc7, 0xf6, 0x56, 0x9e, 0xfd,
-	0x0c, 0x00, 0x00, 0xff, 0xff, 0xf8, 0xea, 0x4b, 0x0e, 0x7c, 0x02, 0x00, 0x00,
+	// 550 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcd, 0x6e, 0xd3, 0x4e,
+	0x14, 0xc5, 0xe3, 0x26, 0xff, 0xfe, 0xe9, 0x94, 0x7e, 0x30, 0x84, 0xca, 0x80, 0xe2, 0x86, 0x74,
+	0x41, 0x40, 0xc8, 0xa6, 0xb0, 0x61, 0x9b, 0x8f, 0x56, 0x42, 0x2d, 0x10, 0x79, 0x41, 0x81, 0x8d,
+	0x35, 0x71, 0x2e, 0xf6, 0x28, 0x9e, 0x19, 0x6b, 0x66, 0xd2, 0x26, 0x6f, 0xc1, 0x3b, 0xb1, 0xe9,
+	0x82, 0x45, 0x97, 0x88, 0x45, 0x85, 0x92, 0x17, 0x41, 0x1e, 0x3b, 0x69, 0x44, 0x58, 0x74, 0x67,
+	0x9d, 0x7b, 0xce, 0xf1, 0xcc, 0xef, 0x6a, 0xd0, 0x93, 0xe1, 0xe4, 0x1c, 0xbc, 0xaf, 0x23, 0x3e,
+	0x00, 0xa9, 0xbc, 0xf3, 0xc3, 0x3e, 0x68, 0x72, 0xe8, 0xa5, 0x44, 0x12, 0xa6, 0xdc, 0x54, 0x0a,
+	0x2d, 0x70, 0x35, 0xb3, 0xb8, 0x85, 0xc5, 0x2d, 0x2c, 0x8f, 0xaa, 0x91, 0x88, 0x84, 0x31, 0x78,
+	0xd9, 0x57, 0xee, 0x6d, 0xfc, 0x58, 0x43, 0xf8, 0x2c, 0xa6, 0x1a, 0x12, 0xaa, 0x74, 0x47, 0x50,
+	0x7e, 0xc4, 0xb5, 0x9c, 0xe0, 0x1a, 0x42, 0xa1, 0xa0, 0x3c, 0x18, 0x00, 0x17, 0xcc, 0xb6, 0xea,
+	0x56, 0x73, 0xc3, 0xdf, 0xc8, 0x94, 0x6e, 0x26, 0xe0, 0x03, 0xb4, 0x55, 0x8c, 0x43, 0xca, 0x48,
+	0xa2, 0xec, 0xb5, 0xba, 0xd5, 0xdc, 0xf2, 0xef, 0xe6, 0x8e, 0x5c, 0xc3, 0x27, 0x08, 0x33, 0xca,
+	0x83, 0xec, 0x1c, 0x94, 0x47, 0x01, 0x61, 0x62, 0xc4, 0xb5, 0x5d, 0xce, 0xba, 0xda, 0xb5, 0xcb,
+	0xeb, 0xfd, 0xd2, 0xaf, 0xeb, 0xfd, 0x07, 0xa1, 0x50, 0x4c, 0x28, 0x35, 0x18, 0xba, 0x54, 0x78,
+	0x8c, 0xe8, 0xd8, 0x7d, 0xcb, 0xb5, 0xbf, 0xcb, 0x28, 0x3f, 0xce, 0x73, 0x2d, 0x13, 0xc3, 0x01,
+	0xaa, 0xad, 0x96, 0x05, 0x29, 0xc8, 0xa0, 0x3f, 0xe2, 0x83, 0x04, 0xec, 0xca, 0x6d, 0x7a, 0x1f,
+	0xfe, 0xdd, 0xdb, 0x03, 0xd9, 0x36, 0x79, 0xdc, 0x45, 0x9b, 0xe6, 0x4a, 0x17, 0x40, 0xa3, 0x58,
+	0xdb, 0xff, 0x99, 0xba, 0x83, 0xa2, 0xee, 0xf1, 0x6a, 0xdd, 0x29, 0x44, 0x24, 0x9c, 0x74, 0x21,
+	0xf4, 0x0d, 0xa9, 0x33, 0x13, 0x6b, 0x7c, 0x2f, 0xa3, 0xf5, 0x9e, 0xd9, 0x05, 0xfe, 0x80, 0xb6,
+	0xf3, 0xc2, 0x39, 0x5d, 0xdb, 0xaa, 0x97, 0x9b, 0x9b, 0xaf, 0x9a, 0xee, 0xbf, 0xd6, 0xe3, 0xae,
+	0x2e, 0xc1, 0x37, 0x8c, 0x17, 0x3a, 0x7e, 0x89, 0xaa, 0xcb, 0x08, 0xd8, 0x28, 0xd1, 0x34, 0x4d,
+	0xc0, 0xb0, 0xaf, 0xf8, 0xf8, 0xe6, 0x6a, 0xef, 0x8a, 0x09, 0x7e, 0x8a, 0x76, 0x52, 0x49, 0x43,
+	0x08, 0x24, 0xa4, 0x42, 0x6a, 0x90, 0xca, 0x2e, 0xd7, 0xcb, 0xcd, 0x0d, 0x7f, 0xdb, 0xc8, 0xfe,
+	0x5c, 0xc5, 0x0d, 0xb4, 0xc5, 0xc8, 0x38, 0xc8, 0xcd, 0x24, 0xca, 0x69, 0x56, 0xfc, 0x4d, 0x46,
+	0xc6, 0xbd, 0x4c, 0x6b, 0x45, 0x80, 0x9f, 0xa3, 0x7b, 0xd9, 0xef, 0x97, 0x0b, 0x95, 0xc1, 0x54,
+	0xf1, 0x77, 0x18, 0xe5, 0xbd, 0x9b, 0x46, 0x85, 0x3f, 0xa1, 0xbd, 0xac, 0x6f, 0x09, 0x68, 0x10,
+	0xc6, 0x84, 0x47, 0x60, 0xaf, 0xdf, 0x9e, 0xeb, 0x7d, 0x46, 0xc6, 0x9d, 0x05, 0xda, 0x8e, 0xc9,
+	0xe3, 0x37, 0xc8, 0x9e, 0x03, 0x48, 0x60, 0x10, 0x81, 0x0c, 0x24, 0x68, 0xe0, 0x9a, 0x0a, 0x6e,
+	0xff, 0x6f, 0x0e, 0xb3, 0x57, 0xcc, 0x4f, 0xcd, 0xd8, 0x9f, 0x4f, 0xf1, 0x33, 0xb4, 0x9b, 0x33,
+	0x0f, 0x88, 0xd6, 0xa0, 0xb4, 0x90, 0xca, 0xbe, 0x63, 0x68, 0xec, 0xe4, 0x7a, 0x6b, 0x2e, 0xb7,
+	0x8f, 0x2f, 0xa7, 0x8e, 0x75, 0x35, 0x75, 0xac, 0xdf, 0x53, 0xc7, 0xfa, 0x36, 0x73, 0x4a, 0x57,
+	0x33, 0xa7, 0xf4, 0x73, 0xe6, 0x94, 0xbe, 0xbc, 0x88, 0xa8, 0x8e, 0x47, 0x7d, 0x37, 0x14, 0xcc,
+	0x3b, 0xf9, 0xfc, 0xf1, 0xe8, 0x3d, 0xe8, 0x0b, 0x21, 0x87, 0x5e, 0x18, 0x13, 0xca, 0xbd, 0xf1,
+	0xe2, 0x5d, 0xea, 0x49, 0x0a, 0xaa, 0xbf, 0x6e, 0xde, 0xd8, 0xeb, 0x3f, 0x03, 0x00, 0xe6, 0xe7,
+	0x93, 0xfd, 0xb4, 0x03, 0x00, 0x00,
 }
 
 func (m *WhitelistCoinEntry) Marshal() (dAtA []byte, err error) {
@@ -271,6 +336,49 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FunderAttestors) > 0 {
+		for iNdEx := len(m.FunderAttestors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FunderAttestors[iNdEx])
+			copy(dAtA[i:], m.FunderAttestors[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.FunderAttestors[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.FundingLedgerRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FundingLedgerRetention))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.MaxCoinWeightChange.Size()
+		i -= size
+		if _, err := m.MaxCoinWeightChange.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.MinPriceReports != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinPriceReports))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxPriceAge != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPriceAge))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PriceReporters) > 0 {
+		for iNdEx := len(m.PriceReporters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PriceReporters[iNdEx])
+			copy(dAtA[i:], m.PriceReporters[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.PriceReporters[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.MinFundingMultiple != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.MinFundingMultiple))
 		i--
@@ -341,6 +449,29 @@ func (m *Params) Size() (n int) {
 	if m.MinFundingMultiple != 0 {
 		n += 1 + sovParams(uint64(m.MinFundingMultiple))
 	}
+	if len(m.PriceReporters) > 0 {
+		for _, s := range m.PriceReporters {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxPriceAge != 0 {
+		n += 1 + sovParams(uint64(m.MaxPriceAge))
+	}
+	if m.MinPriceReports != 0 {
+		n += 1 + sovParams(uint64(m.MinPriceReports))
+	}
+	l = m.MaxCoinWeightChange.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.FundingLedgerRetention != 0 {
+		n += 1 + sovParams(uint64(m.FundingLedgerRetention))
+	}
+	if len(m.FunderAttestors) > 0 {
+		for _, s := range m.FunderAttestors {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -635,6 +766,161 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceReporters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceReporters = append(m.PriceReporters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceAge", wireType)
+			}
+			m.MaxPriceAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPriceAge |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPriceReports", wireType)
+			}
+			m.MinPriceReports = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinPriceReports |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCoinWeightChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxCoinWeightChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingLedgerRetention", wireType)
+			}
+			m.FundingLedgerRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FundingLedgerRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAttestors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAttestors = append(m.FunderAttestors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
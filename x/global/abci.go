@@ -1,6 +1,7 @@
 package global
 
 import (
+	"cosmossdk.io/math"
 	"github.com/KYVENetwork/chain/util"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -11,9 +12,12 @@ import (
 	bankKeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	// Global
 	"github.com/KYVENetwork/chain/x/global/keeper"
+	"github.com/KYVENetwork/chain/x/global/types"
 )
 
-// EndBlocker handles the fee burning if it is configured
+// EndBlocker handles the fee burning if it is configured, forwards the
+// non-native fees, updates the base fee of the fee market and prunes the
+// rate limits
 func EndBlocker(ctx sdk.Context, ak authKeeper.AccountKeeper, bk bankKeeper.Keeper, gk keeper.Keeper, uk util.UpgradeKeeper) {
 	// Since no fees are paid in the genesis block, skip.
 	// NOTE: This is Tendermint specific.
@@ -21,26 +25,67 @@ func EndBlocker(ctx sdk.Context, ak authKeeper.AccountKeeper, bk bankKeeper.Keep
 		return
 	}
 
+	// Remove the message counts of all rate limit windows which have ended.
+	gk.PruneRateLimitCounts(ctx, uint64(ctx.BlockHeight())+1)
+
+	// The base fees have to be obtained before the usage of the block is reset.
+	blockBaseFees := gk.GetBlockBaseFees(ctx)
+	feeMarketEnabled := gk.GetFeeMarket(ctx).Enabled
+
+	// The block gas meter contains the gas of all transactions of the block,
+	// also of the failed ones whose state changes were discarded.
+	blockGas := uint64(0)
+	if ctx.BlockGasMeter() != nil {
+		blockGas = ctx.BlockGasMeter().GasConsumed()
+	}
+
+	gk.UpdateBaseFee(ctx, blockGas)
+	gk.ResetBlockFeeUsage(ctx)
+
+	// Obtain all collected fees.
+	feeCoins := bk.GetAllBalances(ctx, ak.GetModuleAddress(authTypes.FeeCollectorName))
+	if feeCoins.IsZero() {
+		return
+	}
+
+	// Non-native fees are not burned but sent to the configured destination.
+	if destination := gk.GetNonNativeFeeDestination(ctx); destination != "" {
+		nonNativeFees := sdk.NewCoins()
+		for _, coin := range feeCoins {
+			if coin.Denom != types.Denom {
+				nonNativeFees = nonNativeFees.Add(coin)
+			}
+		}
+
+		if !nonNativeFees.IsZero() {
+			// The destination can be a blocked address, in that case the fees
+			// are distributed like native fees instead of halting the chain.
+			err := bk.SendCoinsFromModuleToAccount(ctx, authTypes.FeeCollectorName, sdk.MustAccAddressFromBech32(destination), nonNativeFees)
+			if err != nil {
+				gk.Logger().Error("failed to send non-native fees", "destination", destination, "err", err)
+			}
+		}
+	}
+
 	burnRatio := gk.GetBurnRatio(ctx)
 	if burnRatio.IsZero() {
 		return
 	}
 
-	// Obtain all collected fees.
-	feeCoinsInt := bk.GetAllBalances(ctx, ak.GetModuleAddress(authTypes.FeeCollectorName))
-	feeCoins := sdk.NewDecCoinsFromCoins(feeCoinsInt...)
-	if feeCoins.IsZero() {
+	nativeFees := feeCoins.AmountOf(types.Denom)
+	if nativeFees.IsZero() {
 		return
 	}
 
-	// Sum burn ratio amount.
-	burnCoins := sdk.NewCoins()
-	for _, coin := range feeCoins {
-		amount := coin.Amount.Mul(burnRatio)
-		burnCoins = burnCoins.Add(sdk.NewCoin(coin.Denom, amount.TruncateInt()))
+	// Sum burn ratio amount. Only native fees are burned.
+	amount := math.LegacyNewDecFromInt(nativeFees).Mul(burnRatio).TruncateInt()
+	if feeMarketEnabled {
+		// Only the base fee portion of the fees is burned, the fees paid on
+		// top of the base fee are kept for the validators.
+		amount = math.MinInt(math.LegacyNewDecFromInt(blockBaseFees).Mul(burnRatio).TruncateInt(), nativeFees)
 	}
 
-	err := bk.BurnCoins(ctx, authTypes.FeeCollectorName, burnCoins)
+	err := bk.BurnCoins(ctx, authTypes.FeeCollectorName, sdk.NewCoins(sdk.NewCoin(types.Denom, amount)))
 	if err != nil {
 		util.PanicHalt(uk, ctx, err.Error())
 	}
@@ -1,17 +1,26 @@
 package global
 
 import (
+	sdkErrors "cosmossdk.io/errors"
+	storeTypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
 
 	// Auth
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	authKeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authSigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	// Bank
 	bankKeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	// FeeGrant
 	feeGrantKeeper "cosmossdk.io/x/feegrant/keeper"
+	// Funders
+	fundersKeeper "github.com/KYVENetwork/chain/x/funders/keeper"
 	// Global
 	"github.com/KYVENetwork/chain/x/global/keeper"
+	"github.com/KYVENetwork/chain/x/global/types"
+	// Stakers
+	stakersKeeper "github.com/KYVENetwork/chain/x/stakers/keeper"
 	// Staking
 	stakingKeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 )
@@ -19,7 +28,7 @@ import (
 // DeductFeeDecorator
 
 // The DeductFeeDecorator is responsible for the
-// consensus minimum gas price.
+// consensus minimum gas price, which can also be paid in the fee denoms.
 // Validators can still choose their own (higher) gas prices.
 type DeductFeeDecorator struct {
 	accountKeeper  authKeeper.AccountKeeper
@@ -27,15 +36,17 @@ type DeductFeeDecorator struct {
 	feeGrantKeeper feeGrantKeeper.Keeper
 	globalKeeper   keeper.Keeper
 	stakingKeeper  *stakingKeeper.Keeper
+	fundersKeeper  fundersKeeper.Keeper
 }
 
-func NewDeductFeeDecorator(ak authKeeper.AccountKeeper, bk bankKeeper.Keeper, fk feeGrantKeeper.Keeper, gk keeper.Keeper, sk *stakingKeeper.Keeper) DeductFeeDecorator {
+func NewDeductFeeDecorator(ak authKeeper.AccountKeeper, bk bankKeeper.Keeper, fk feeGrantKeeper.Keeper, gk keeper.Keeper, sk *stakingKeeper.Keeper, fuk fundersKeeper.Keeper) DeductFeeDecorator {
 	return DeductFeeDecorator{
 		accountKeeper:  ak,
 		bankKeeper:     bk,
 		feeGrantKeeper: fk,
 		globalKeeper:   gk,
 		stakingKeeper:  sk,
+		fundersKeeper:  fuk,
 	}
 }
 
@@ -43,12 +54,40 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 	// NOTE: This is Tendermint specific.
 	var tfc ante.TxFeeChecker
 	if ctx.BlockHeight() > 1 {
-		tfc = BuildTxFeeChecker(ctx, dfd.globalKeeper, dfd.stakingKeeper)
+		tfc = BuildTxFeeChecker(ctx, dfd.globalKeeper, dfd.stakingKeeper, dfd.fundersKeeper)
 	}
 
 	internalDfd := ante.NewDeductFeeDecorator(dfd.accountKeeper, dfd.bankKeeper, dfd.feeGrantKeeper, tfc)
 
-	return internalDfd.AnteHandle(ctx, tx, simulate, next)
+	newCtx, err = internalDfd.AnteHandle(ctx, tx, simulate, next)
+	if err != nil {
+		return newCtx, err
+	}
+
+	// Track the base fees of the block for the fee market. The state changes of
+	// the ante handler are discarded if the transaction is rejected. The gas used
+	// is taken from the block gas meter at the end of the block.
+	if ctx.BlockHeight() > 1 && !ctx.IsCheckTx() && !simulate && dfd.globalKeeper.GetFeeMarket(ctx).Enabled {
+		if err := dfd.trackBlockBaseFees(ctx, tx); err != nil {
+			return newCtx, err
+		}
+	}
+
+	return newCtx, nil
+}
+
+// trackBlockBaseFees adds the base fee paid by the transaction to the base fees
+// of the current block, without consuming gas of the transaction.
+func (dfd DeductFeeDecorator) trackBlockBaseFees(ctx sdk.Context, tx sdk.Tx) error {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return sdkErrors.Wrap(errorsTypes.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	baseFees := dfd.globalKeeper.GetBaseFeeAmount(ctx, feeTx.GetGas(), feeTx.GetFee().AmountOf(types.Denom))
+
+	dfd.globalKeeper.AddBlockBaseFees(ctx.WithGasMeter(storeTypes.NewInfiniteGasMeter()), baseFees)
+	return nil
 }
 
 // GasAdjustmentDecorator
@@ -80,3 +119,85 @@ func (gad GasAdjustmentDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulat
 
 	return next(ctx, tx, simulate)
 }
+
+// RateLimitDecorator
+
+// The RateLimitDecorator limits how many messages of a certain type a single
+// signer can send within a window of blocks. Messages executed by an authz
+// MsgExec count towards the limits of the signers of the transaction. Protocol
+// messages which are sent by active pool accounts are exempt from the rate limits.
+type RateLimitDecorator struct {
+	globalKeeper  keeper.Keeper
+	stakersKeeper *stakersKeeper.Keeper
+}
+
+func NewRateLimitDecorator(gk keeper.Keeper, sk *stakersKeeper.Keeper) RateLimitDecorator {
+	return RateLimitDecorator{
+		globalKeeper:  gk,
+		stakersKeeper: sk,
+	}
+}
+
+// protocolMsg is implemented by all messages which are sent by protocol nodes
+// with their pool account on behalf of a staker.
+type protocolMsg interface {
+	GetCreator() string
+	GetStaker() string
+	GetPoolId() uint64
+}
+
+func (rld RateLimitDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	rateLimits := rld.globalKeeper.GetRateLimits(ctx)
+	if len(rateLimits) == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	sigTx, ok := tx.(authSigning.SigVerifiableTx)
+	if !ok {
+		return ctx, sdkErrors.Wrap(errorsTypes.ErrTxDecode, "Tx must be a SigVerifiableTx")
+	}
+
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return ctx, err
+	}
+
+	height := uint64(ctx.BlockHeight())
+
+	for _, msg := range getAllMsgs(tx.GetMsgs()) {
+		msgType := sdk.MsgTypeURL(msg)
+
+		for _, rateLimit := range rateLimits {
+			if msgType != rateLimit.Type {
+				continue
+			}
+
+			if pMsg, ok := msg.(protocolMsg); ok {
+				if rld.stakersKeeper.AssertPoolAccountAuthorized(ctx, pMsg.GetStaker(), pMsg.GetPoolId(), pMsg.GetCreator()) == nil {
+					break
+				}
+			}
+
+			windowEnd := height - height%rateLimit.Window + rateLimit.Window
+
+			for _, signer := range signers {
+				address := sdk.AccAddress(signer).String()
+
+				count := rld.globalKeeper.GetRateLimitCount(ctx, windowEnd, address, msgType)
+				if count >= rateLimit.MaxMessages {
+					return ctx, sdkErrors.Wrapf(errorsTypes.ErrInvalidRequest, types.ErrRateLimitExceeded.Error(), rateLimit.MaxMessages, rateLimit.Window, address)
+				}
+
+				// The messages were already counted when the transaction was checked
+				// for the first time.
+				if !ctx.IsReCheckTx() {
+					rld.globalKeeper.SetRateLimitCount(ctx, windowEnd, address, msgType, count+1)
+				}
+			}
+
+			break
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -4,6 +4,8 @@ import (
 	"cosmossdk.io/math"
 	"cosmossdk.io/x/tx/signing"
 	"github.com/KYVENetwork/chain/app"
+	"github.com/KYVENetwork/chain/x/global"
+	abci "github.com/cometbft/cometbft/abci/types"
 	amino "github.com/cosmos/cosmos-sdk/codec"
 	addressCodec "github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/codec/types"
@@ -78,3 +80,22 @@ func AnteNextFn(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
 func PostNextFn(ctx sdk.Context, _ sdk.Tx, _ bool, _ bool) (sdk.Context, error) {
 	return ctx, nil
 }
+
+// ExecuteMsgs records the gas of the given messages with the MsgGasTracker and
+// consumes the given gas for every message, as the message router would.
+func ExecuteMsgs(ctx sdk.Context, mgt global.MsgGasTracker, msgs []sdk.Msg, gas []uint64) {
+	for index, msg := range msgs {
+		_, _ = mgt.IsAllowed(ctx, sdk.MsgTypeURL(msg))
+		ctx.GasMeter().ConsumeGas(gas[index], "test")
+	}
+}
+
+// HasRefundEvent returns whether the events of a transaction contain a refund.
+func HasRefundEvent(events []abci.Event) bool {
+	for _, event := range events {
+		if event.Type == "kyve.global.v1beta1.EventGasRefund" {
+			return true
+		}
+	}
+	return false
+}
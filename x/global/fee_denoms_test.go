@@ -0,0 +1,191 @@
+package global_test
+
+import (
+	"cosmossdk.io/math"
+	i "github.com/KYVENetwork/chain/testutil/integration"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	// Auth
+	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	// Funders
+	fundersTypes "github.com/KYVENetwork/chain/x/funders/types"
+	// Global
+	"github.com/KYVENetwork/chain/x/global"
+	"github.com/KYVENetwork/chain/x/global/types"
+)
+
+/*
+
+TEST CASES - FeeDenoms
+
+* Pay fees in fee denom - deliverTX - not enough fees
+* Pay fees in fee denom - deliverTX - enough fees
+* Pay fees in denom which is not a fee denom
+* Pay fees in fee denom without coin weight
+* Priority of fees in fee denom is their native value
+* Forward non-native fees to destination
+* Keep non-native fees without destination
+
+*/
+
+var _ = Describe("FeeDenoms", Ordered, func() {
+	s := i.NewCleanChain()
+	encodingConfig := BuildEncodingConfig()
+	dfd := global.NewDeductFeeDecorator(s.App().AccountKeeper, s.App().BankKeeper, s.App().FeeGrantKeeper, s.App().GlobalKeeper, s.App().StakingKeeper, s.App().FundersKeeper)
+
+	BeforeEach(func() {
+		s = i.NewCleanChain()
+		encodingConfig = BuildEncodingConfig()
+		dfd = global.NewDeductFeeDecorator(s.App().AccountKeeper, s.App().BankKeeper, s.App().FeeGrantKeeper, s.App().GlobalKeeper, s.App().StakingKeeper, s.App().FundersKeeper)
+
+		// acoin is worth half a $KYVE, bcoin has no coin weight
+		fundersParams := s.App().FundersKeeper.GetParams(s.Ctx())
+		fundersParams.CoinWhitelist = []*fundersTypes.WhitelistCoinEntry{
+			{
+				CoinDenom:                 types.Denom,
+				CoinDecimals:              uint32(6),
+				MinFundingAmount:          math.NewIntFromUint64(10 * i.KYVE),
+				MinFundingAmountPerBundle: math.NewIntFromUint64(1 * i.KYVE),
+				CoinWeight:                math.LegacyNewDec(1),
+			},
+			{
+				CoinDenom:                 i.A_DENOM,
+				CoinDecimals:              uint32(6),
+				MinFundingAmount:          math.NewIntFromUint64(10 * i.KYVE),
+				MinFundingAmountPerBundle: math.NewIntFromUint64(1 * i.KYVE),
+				CoinWeight:                math.LegacyMustNewDecFromStr("0.5"),
+			},
+			{
+				CoinDenom:                 i.C_DENOM,
+				CoinDecimals:              uint32(6),
+				MinFundingAmount:          math.NewIntFromUint64(10 * i.KYVE),
+				MinFundingAmountPerBundle: math.NewIntFromUint64(1 * i.KYVE),
+				CoinWeight:                math.LegacyNewDec(1),
+			},
+		}
+		s.App().FundersKeeper.SetParams(s.Ctx(), fundersParams)
+
+		params := types.DefaultParams()
+		params.MinGasPrice = math.LegacyOneDec()
+		params.FeeDenoms = []string{i.A_DENOM, i.B_DENOM}
+		s.App().GlobalKeeper.SetParams(s.Ctx(), params)
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Pay fees in fee denom - deliverTX - not enough fees", func() {
+		// ARRANGE
+		collectorBalanceBefore := s.GetCoinsFromModule(authTypes.FeeCollectorName)
+		tx := BuildTestTx(math.NewInt(1), i.A_DENOM, i.DUMMY[0], encodingConfig)
+
+		// ACT
+		_, err := dfd.AnteHandle(s.Ctx().WithIsCheckTx(false), tx, false, AnteNextFn)
+
+		// ASSERT
+		Expect(err).Should(HaveOccurred())
+		Expect(s.GetCoinsFromModule(authTypes.FeeCollectorName)).To(Equal(collectorBalanceBefore))
+	})
+
+	It("Pay fees in fee denom - deliverTX - enough fees", func() {
+		// ARRANGE
+		collectorBalanceBefore := s.GetCoinsFromModule(authTypes.FeeCollectorName)
+		tx := BuildTestTx(math.NewInt(2), i.A_DENOM, i.DUMMY[0], encodingConfig)
+
+		// ACT
+		_, err := dfd.AnteHandle(s.Ctx().WithIsCheckTx(false), tx, false, AnteNextFn)
+
+		// ASSERT
+		Expect(err).Should(Not(HaveOccurred()))
+		Expect(s.GetCoinsFromModule(authTypes.FeeCollectorName).AmountOf(i.A_DENOM).Uint64()).To(Equal(collectorBalanceBefore.AmountOf(i.A_DENOM).Uint64() + 400_000))
+	})
+
+	It("Pay fees in denom which is not a fee denom", func() {
+		// ARRANGE
+		tx := BuildTestTx(math.NewInt(10), i.C_DENOM, i.DUMMY[0], encodingConfig)
+
+		// ACT
+		_, err := dfd.AnteHandle(s.Ctx().WithIsCheckTx(false), tx, false, AnteNextFn)
+
+		// ASSERT
+		Expect(err).Should(HaveOccurred())
+	})
+
+	It("Pay fees in fee denom without coin weight", func() {
+		// ARRANGE
+		tx := BuildTestTx(math.NewInt(10), i.B_DENOM, i.DUMMY[0], encodingConfig)
+
+		// ACT
+		_, err := dfd.AnteHandle(s.Ctx().WithIsCheckTx(false), tx, false, AnteNextFn)
+
+		// ASSERT
+		Expect(err).Should(HaveOccurred())
+	})
+
+	It("Priority of fees in fee denom is their native value", func() {
+		// ARRANGE
+		// a gas price of 4 acoin is worth a gas price of 2 $KYVE
+		nativeTx := BuildTestTx(math.NewInt(2), types.Denom, i.DUMMY[0], encodingConfig)
+		feeDenomTx := BuildTestTx(math.NewInt(4), i.A_DENOM, i.DUMMY[1], encodingConfig)
+
+		// ACT
+		nativeCtx, errNative := dfd.AnteHandle(s.Ctx().WithIsCheckTx(false), nativeTx, false, AnteNextFn)
+		feeDenomCtx, errFeeDenom := dfd.AnteHandle(s.Ctx().WithIsCheckTx(false), feeDenomTx, false, AnteNextFn)
+
+		// ASSERT
+		Expect(errNative).Should(Not(HaveOccurred()))
+		Expect(errFeeDenom).Should(Not(HaveOccurred()))
+
+		Expect(nativeCtx.Priority()).To(Equal(int64(2)))
+		Expect(feeDenomCtx.Priority()).To(Equal(int64(2)))
+	})
+
+	It("Forward non-native fees to destination", func() {
+		// ARRANGE
+		params := s.App().GlobalKeeper.GetParams(s.Ctx())
+		params.BurnRatio = math.LegacyOneDec()
+		params.NonNativeFeeDestination = i.ALICE
+		s.App().GlobalKeeper.SetParams(s.Ctx(), params)
+
+		aliceBalanceBefore := s.GetCoinsFromAddress(i.ALICE)
+
+		_, err := dfd.AnteHandle(s.Ctx().WithIsCheckTx(false), BuildTestTx(math.NewInt(2), i.A_DENOM, i.DUMMY[0], encodingConfig), false, AnteNextFn)
+		Expect(err).Should(Not(HaveOccurred()))
+		_, err = dfd.AnteHandle(s.Ctx().WithIsCheckTx(false), BuildTestTx(math.NewInt(1), types.Denom, i.DUMMY[1], encodingConfig), false, AnteNextFn)
+		Expect(err).Should(Not(HaveOccurred()))
+
+		// ACT
+		global.EndBlocker(s.Ctx(), s.App().AccountKeeper, s.App().BankKeeper, s.App().GlobalKeeper, s.App().UpgradeKeeper)
+
+		// ASSERT
+		collectorBalance := s.GetCoinsFromModule(authTypes.FeeCollectorName)
+		Expect(collectorBalance.AmountOf(i.A_DENOM).IsZero()).To(BeTrue())
+		Expect(collectorBalance.AmountOf(types.Denom).IsZero()).To(BeTrue())
+
+		aliceBalanceAfter := s.GetCoinsFromAddress(i.ALICE)
+		Expect(aliceBalanceAfter.AmountOf(i.A_DENOM).Uint64()).To(Equal(aliceBalanceBefore.AmountOf(i.A_DENOM).Uint64() + 400_000))
+		Expect(aliceBalanceAfter.AmountOf(types.Denom).Uint64()).To(Equal(aliceBalanceBefore.AmountOf(types.Denom).Uint64()))
+	})
+
+	It("Keep non-native fees without destination", func() {
+		// ARRANGE
+		params := s.App().GlobalKeeper.GetParams(s.Ctx())
+		params.BurnRatio = math.LegacyOneDec()
+		s.App().GlobalKeeper.SetParams(s.Ctx(), params)
+
+		collectorBalanceBefore := s.GetCoinsFromModule(authTypes.FeeCollectorName)
+
+		_, err := dfd.AnteHandle(s.Ctx().WithIsCheckTx(false), BuildTestTx(math.NewInt(2), i.A_DENOM, i.DUMMY[0], encodingConfig), false, AnteNextFn)
+		Expect(err).Should(Not(HaveOccurred()))
+
+		// ACT
+		global.EndBlocker(s.Ctx(), s.App().AccountKeeper, s.App().BankKeeper, s.App().GlobalKeeper, s.App().UpgradeKeeper)
+
+		// ASSERT
+		// non-native fees are not burned
+		Expect(s.GetCoinsFromModule(authTypes.FeeCollectorName).AmountOf(i.A_DENOM).Uint64()).To(Equal(collectorBalanceBefore.AmountOf(i.A_DENOM).Uint64() + 400_000))
+	})
+})
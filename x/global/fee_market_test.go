@@ -0,0 +1,273 @@
+package global_test
+
+import (
+	"cosmossdk.io/math"
+	storeTypes "cosmossdk.io/store/types"
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	// Auth
+	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	// Bank
+	bankTypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	// Global
+	"github.com/KYVENetwork/chain/x/global"
+	"github.com/KYVENetwork/chain/x/global/types"
+)
+
+/*
+
+TEST CASES - FeeMarket
+
+* Fee market disabled
+* Base fee defaults to min base fee
+* Increase base fee above target
+* Decrease base fee below target
+* Base fee is bounded by max base fee
+* Base fee is bounded by min base fee
+* Enforce base fee - deliverTX - not enough fees
+* Enforce base fee - deliverTX - enough fees
+* Track gas used instead of gas wanted
+* Track gas of failed transactions
+* Enforce min gas price above base fee
+* Burn base fee portion
+* Query base fee
+
+*/
+
+var _ = Describe("FeeMarket", Ordered, func() {
+	s := i.NewCleanChain()
+	encodingConfig := BuildEncodingConfig()
+	dfd := global.NewDeductFeeDecorator(s.App().AccountKeeper, s.App().BankKeeper, s.App().FeeGrantKeeper, s.App().GlobalKeeper, s.App().StakingKeeper, s.App().FundersKeeper)
+	denom, _ := s.App().StakingKeeper.BondDenom(s.Ctx())
+
+	enableFeeMarket := func() {
+		params := types.DefaultParams()
+		params.FeeMarket = types.FeeMarket{
+			Enabled:        true,
+			TargetBlockGas: 1_000_000,
+			MinBaseFee:     math.LegacyNewDec(2),
+			MaxBaseFee:     math.LegacyNewDec(100),
+			ChangeRate:     math.LegacyNewDecWithPrec(125, 3),
+		}
+		s.App().GlobalKeeper.SetParams(s.Ctx(), params)
+	}
+
+	BeforeEach(func() {
+		s = i.NewCleanChain()
+		encodingConfig = BuildEncodingConfig()
+		denom, _ = s.App().StakingKeeper.BondDenom(s.Ctx())
+		dfd = global.NewDeductFeeDecorator(s.App().AccountKeeper, s.App().BankKeeper, s.App().FeeGrantKeeper, s.App().GlobalKeeper, s.App().StakingKeeper, s.App().FundersKeeper)
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Fee market disabled", func() {
+		// ARRANGE
+		tx := BuildTestTx(math.ZeroInt(), denom, i.DUMMY[0], encodingConfig)
+
+		// ACT
+		_, err := dfd.AnteHandle(s.Ctx().WithIsCheckTx(false), tx, false, AnteNextFn)
+		s.App().GlobalKeeper.UpdateBaseFee(s.Ctx(), 1_500_000)
+
+		// ASSERT
+		Expect(err).Should(Not(HaveOccurred()))
+		Expect(s.App().GlobalKeeper.GetBaseFee(s.Ctx()).String()).To(Equal(math.LegacyZeroDec().String()))
+		Expect(s.App().GlobalKeeper.GetConsensusMinGasPrice(s.Ctx()).String()).To(Equal(types.DefaultMinGasPrice.String()))
+	})
+
+	It("Base fee defaults to min base fee", func() {
+		// ACT
+		enableFeeMarket()
+
+		// ASSERT
+		Expect(s.App().GlobalKeeper.GetBaseFee(s.Ctx()).String()).To(Equal(math.LegacyNewDec(2).String()))
+		Expect(s.App().GlobalKeeper.GetConsensusMinGasPrice(s.Ctx()).String()).To(Equal(math.LegacyNewDec(2).String()))
+	})
+
+	It("Increase base fee above target", func() {
+		// ARRANGE
+		enableFeeMarket()
+
+		// ACT
+		s.App().GlobalKeeper.UpdateBaseFee(s.Ctx(), 1_500_000)
+
+		// ASSERT
+		// 2 * (1 + 0.125 * 0.5)
+		Expect(s.App().GlobalKeeper.GetBaseFee(s.Ctx()).String()).To(Equal(math.LegacyMustNewDecFromStr("2.125").String()))
+	})
+
+	It("Decrease base fee below target", func() {
+		// ARRANGE
+		enableFeeMarket()
+		s.App().GlobalKeeper.SetBaseFee(s.Ctx(), math.LegacyNewDec(4))
+
+		// ACT
+		s.App().GlobalKeeper.UpdateBaseFee(s.Ctx(), 0)
+
+		// ASSERT
+		// 4 * (1 - 0.125)
+		Expect(s.App().GlobalKeeper.GetBaseFee(s.Ctx()).String()).To(Equal(math.LegacyMustNewDecFromStr("3.5").String()))
+	})
+
+	It("Base fee is bounded by max base fee", func() {
+		// ARRANGE
+		enableFeeMarket()
+		s.App().GlobalKeeper.SetBaseFee(s.Ctx(), math.LegacyNewDec(95))
+
+		// ACT
+		s.App().GlobalKeeper.UpdateBaseFee(s.Ctx(), 10_000_000)
+
+		// ASSERT
+		Expect(s.App().GlobalKeeper.GetBaseFee(s.Ctx()).String()).To(Equal(math.LegacyNewDec(100).String()))
+	})
+
+	It("Base fee is bounded by min base fee", func() {
+		// ARRANGE
+		enableFeeMarket()
+
+		// ACT
+		s.App().GlobalKeeper.UpdateBaseFee(s.Ctx(), 0)
+
+		// ASSERT
+		Expect(s.App().GlobalKeeper.GetBaseFee(s.Ctx()).String()).To(Equal(math.LegacyNewDec(2).String()))
+	})
+
+	It("Enforce base fee - deliverTX - not enough fees", func() {
+		// ARRANGE
+		enableFeeMarket()
+		tx := BuildTestTx(math.NewInt(1), denom, i.DUMMY[0], encodingConfig)
+
+		// ACT
+		_, err := dfd.AnteHandle(s.Ctx().WithIsCheckTx(false), tx, false, AnteNextFn)
+
+		// ASSERT
+		Expect(err).Should(HaveOccurred())
+		Expect(s.App().GlobalKeeper.GetBlockBaseFees(s.Ctx()).IsZero()).To(BeTrue())
+	})
+
+	It("Enforce base fee - deliverTX - enough fees", func() {
+		// ARRANGE
+		enableFeeMarket()
+		tx := BuildTestTx(math.NewInt(3), denom, i.DUMMY[0], encodingConfig)
+
+		// ACT
+		_, err := dfd.AnteHandle(s.Ctx().WithIsCheckTx(false), tx, false, AnteNextFn)
+
+		// ASSERT
+		Expect(err).Should(Not(HaveOccurred()))
+		Expect(s.App().GlobalKeeper.GetBlockBaseFees(s.Ctx()).Uint64()).To(Equal(uint64(400_000)))
+	})
+
+	It("Track gas used instead of gas wanted", func() {
+		// ARRANGE
+		enableFeeMarket()
+		s.Commit()
+
+		// the transactions of the block want 2m gas but only use 1.5m gas
+		ctx := s.Ctx().WithBlockGasMeter(storeTypes.NewGasMeter(2_000_000))
+		ctx.BlockGasMeter().ConsumeGas(1_500_000, "test")
+
+		// ACT
+		global.EndBlocker(ctx, s.App().AccountKeeper, s.App().BankKeeper, s.App().GlobalKeeper, s.App().UpgradeKeeper)
+
+		// ASSERT
+		// 2 * (1 + 0.125 * 0.5)
+		Expect(s.App().GlobalKeeper.GetBaseFee(s.Ctx()).String()).To(Equal(math.LegacyMustNewDecFromStr("2.125").String()))
+	})
+
+	It("Track gas of failed transactions", func() {
+		// ARRANGE
+		enableFeeMarket()
+		params := s.App().GlobalKeeper.GetParams(s.Ctx())
+		params.FeeMarket.TargetBlockGas = 10_000
+		s.App().GlobalKeeper.SetParams(s.Ctx(), params)
+
+		// the fee market is skipped in the first block
+		s.Commit()
+
+		privKey := secp256k1.GenPrivKeyFromSecret([]byte("sender"))
+		sender := sdk.AccAddress(privKey.PubKey().Address())
+		_ = s.MintBaseCoins(sender.String(), 1000*i.KYVE)
+
+		// the sender can pay the fees but not the amount
+		msg := bankTypes.NewMsgSend(sender, sdk.MustAccAddressFromBech32(i.ALICE), sdk.NewCoins(sdk.NewInt64Coin(denom, int64(2000*i.KYVE))))
+		fees := sdk.NewCoins(sdk.NewInt64Coin(denom, 600_000))
+
+		// ACT
+		res := s.DeliverTx(privKey, 200_000, fees, msg)
+
+		// ASSERT
+		Expect(res.Code).NotTo(BeZero())
+		Expect(res.GasUsed).To(BeNumerically(">", 10_000))
+		Expect(s.GetBalanceFromAddress(sender.String())).To(Equal(1000*i.KYVE - 600_000))
+
+		// the gas of the failed transaction is above the target
+		// 2 * (1 + 0.125)
+		Expect(s.App().GlobalKeeper.GetBaseFee(s.Ctx()).String()).To(Equal(math.LegacyMustNewDecFromStr("2.25").String()))
+	})
+
+	It("Enforce min gas price above base fee", func() {
+		// ARRANGE
+		enableFeeMarket()
+		params := s.App().GlobalKeeper.GetParams(s.Ctx())
+		params.MinGasPrice = math.LegacyNewDec(3)
+		s.App().GlobalKeeper.SetParams(s.Ctx(), params)
+
+		tx := BuildTestTx(math.NewInt(2), denom, i.DUMMY[0], encodingConfig)
+
+		// ACT
+		_, err := dfd.AnteHandle(s.Ctx().WithIsCheckTx(false), tx, false, AnteNextFn)
+
+		// ASSERT
+		Expect(err).Should(HaveOccurred())
+		Expect(s.App().GlobalKeeper.GetConsensusMinGasPrice(s.Ctx()).String()).To(Equal(math.LegacyNewDec(3).String()))
+	})
+
+	It("Burn base fee portion", func() {
+		// ARRANGE
+		enableFeeMarket()
+		params := s.App().GlobalKeeper.GetParams(s.Ctx())
+		params.BurnRatio = math.LegacyOneDec()
+		s.App().GlobalKeeper.SetParams(s.Ctx(), params)
+
+		collectorBalanceBefore := s.GetBalanceFromModule(authTypes.FeeCollectorName)
+
+		tx := BuildTestTx(math.NewInt(3), denom, i.DUMMY[0], encodingConfig)
+		_, err := dfd.AnteHandle(s.Ctx().WithIsCheckTx(false), tx, false, AnteNextFn)
+		Expect(err).Should(Not(HaveOccurred()))
+		Expect(s.GetBalanceFromModule(authTypes.FeeCollectorName)).To(Equal(collectorBalanceBefore + 600_000))
+
+		// ACT
+		global.EndBlocker(s.Ctx(), s.App().AccountKeeper, s.App().BankKeeper, s.App().GlobalKeeper, s.App().UpgradeKeeper)
+
+		// ASSERT
+		// only the base fee of 2 per gas unit is burned, the tip stays
+		Expect(s.GetBalanceFromModule(authTypes.FeeCollectorName)).To(Equal(collectorBalanceBefore + 200_000))
+		Expect(s.App().GlobalKeeper.GetBlockBaseFees(s.Ctx()).IsZero()).To(BeTrue())
+
+		// the block gas is below the target of 1m gas
+		Expect(s.App().GlobalKeeper.GetBaseFee(s.Ctx()).String()).To(Equal(math.LegacyNewDec(2).String()))
+	})
+
+	It("Query base fee", func() {
+		// ARRANGE
+		enableFeeMarket()
+		s.App().GlobalKeeper.SetBaseFee(s.Ctx(), math.LegacyNewDec(5))
+
+		// ACT
+		res, err := s.App().GlobalKeeper.BaseFee(s.Ctx(), &types.QueryBaseFeeRequest{})
+
+		// ASSERT
+		Expect(err).Should(Not(HaveOccurred()))
+		Expect(res.Enabled).To(BeTrue())
+		Expect(res.BaseFee.String()).To(Equal(math.LegacyNewDec(5).String()))
+		Expect(res.MinGasPrice.String()).To(Equal(math.LegacyNewDec(5).String()))
+	})
+})
@@ -9,10 +9,14 @@ import (
 // InitGenesis initializes the x/global module's state from a provided genesis state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
 	k.SetParams(ctx, genState.Params)
+
+	if !genState.BaseFee.IsNil() && genState.BaseFee.IsPositive() {
+		k.SetBaseFee(ctx, genState.BaseFee)
+	}
 }
 
 // ExportGenesis returns the x/global module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	params := k.GetParams(ctx)
-	return types.NewGenesisState(params)
+	return types.NewGenesisState(params, k.GetBaseFee(ctx))
 }
@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	"github.com/KYVENetwork/chain/x/global/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetBaseFee returns the current base fee per gas unit bounded by the fee market
+// params. If no base fee was stored yet the min base fee is returned.
+func (k Keeper) GetBaseFee(ctx sdk.Context) math.LegacyDec {
+	feeMarket := k.GetFeeMarket(ctx)
+	baseFee := k.getStoredBaseFee(ctx)
+
+	if feeMarket.MinBaseFee.IsNil() || feeMarket.MaxBaseFee.IsNil() {
+		return baseFee
+	}
+
+	if baseFee.LT(feeMarket.MinBaseFee) {
+		return feeMarket.MinBaseFee
+	}
+
+	if baseFee.GT(feeMarket.MaxBaseFee) {
+		return feeMarket.MaxBaseFee
+	}
+
+	return baseFee
+}
+
+// getStoredBaseFee returns the stored base fee without applying the bounds.
+func (k Keeper) getStoredBaseFee(ctx sdk.Context) math.LegacyDec {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+
+	bz := store.Get(types.BaseFeeKey)
+	if bz == nil {
+		return math.LegacyZeroDec()
+	}
+
+	var baseFee math.LegacyDec
+	if err := baseFee.Unmarshal(bz); err != nil {
+		return math.LegacyZeroDec()
+	}
+
+	return baseFee
+}
+
+// SetBaseFee stores the current base fee per gas unit.
+func (k Keeper) SetBaseFee(ctx sdk.Context, baseFee math.LegacyDec) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+
+	bz, err := baseFee.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	store.Set(types.BaseFeeKey, bz)
+}
+
+// GetBlockBaseFees returns the base fees paid by the transactions of the current block.
+func (k Keeper) GetBlockBaseFees(ctx sdk.Context) math.Int {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+
+	bz := store.Get(types.BlockBaseFeesKey)
+	if bz == nil {
+		return math.ZeroInt()
+	}
+
+	var amount math.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		return math.ZeroInt()
+	}
+
+	return amount
+}
+
+// AddBlockBaseFees adds the base fee paid by a transaction to the base fees of the current block.
+func (k Keeper) AddBlockBaseFees(ctx sdk.Context, baseFees math.Int) {
+	k.setBlockBaseFees(ctx, k.GetBlockBaseFees(ctx).Add(baseFees))
+}
+
+// SubBlockBaseFees removes refunded base fees from the base fees of the current block.
+func (k Keeper) SubBlockBaseFees(ctx sdk.Context, refund math.Int) {
+	baseFees := k.GetBlockBaseFees(ctx)
+
+	if refund.GTE(baseFees) {
+		k.setBlockBaseFees(ctx, math.ZeroInt())
+		return
+	}
+
+	k.setBlockBaseFees(ctx, baseFees.Sub(refund))
+}
+
+func (k Keeper) setBlockBaseFees(ctx sdk.Context, baseFees math.Int) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+
+	bz, err := baseFees.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	store.Set(types.BlockBaseFeesKey, bz)
+}
+
+// ResetBlockFeeUsage removes the usage of the current block.
+func (k Keeper) ResetBlockFeeUsage(ctx sdk.Context) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+
+	store.Delete(types.BlockBaseFeesKey)
+}
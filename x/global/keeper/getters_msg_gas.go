@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	storeTypes "cosmossdk.io/store/types"
+	"github.com/KYVENetwork/chain/util"
+	"github.com/KYVENetwork/chain/x/global/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AddMsgGas records the gas the current transaction consumed before a message
+// of the given type was executed. The records are kept in the transient store.
+func (k Keeper) AddMsgGas(ctx sdk.Context, msgType string, gasConsumed uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.transientStoreService.OpenTransientStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.MsgGasKeyPrefix)
+
+	index := uint64(0)
+	iterator := store.ReverseIterator(nil, nil)
+	if iterator.Valid() {
+		index = sdk.BigEndianToUint64(iterator.Key()) + 1
+	}
+	iterator.Close()
+
+	store.Set(util.GetByteKey(index), append(sdk.Uint64ToBigEndian(gasConsumed), []byte(msgType)...))
+}
+
+// GetMsgGas returns the gas records of all messages of the current transaction
+// in the order they were executed.
+func (k Keeper) GetMsgGas(ctx sdk.Context) (list []types.MsgGas) {
+	storeAdapter := runtime.KVStoreAdapter(k.transientStoreService.OpenTransientStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.MsgGasKeyPrefix)
+	iterator := storeTypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		value := iterator.Value()
+		list = append(list, types.MsgGas{
+			Type:        string(value[8:]),
+			GasConsumed: sdk.BigEndianToUint64(value[:8]),
+		})
+	}
+
+	return
+}
+
+// ResetMsgGas removes the gas records of the current transaction.
+func (k Keeper) ResetMsgGas(ctx sdk.Context) {
+	storeAdapter := runtime.KVStoreAdapter(k.transientStoreService.OpenTransientStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.MsgGasKeyPrefix)
+	iterator := storeTypes.KVStorePrefixIterator(store, []byte{})
+
+	keys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
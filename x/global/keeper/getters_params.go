@@ -40,6 +40,26 @@ func (k Keeper) GetGasRefunds(ctx sdk.Context) (res []types.GasRefund) {
 	return k.GetParams(ctx).GasRefunds
 }
 
+// GetFeeMarket returns the FeeMarket param.
+func (k Keeper) GetFeeMarket(ctx sdk.Context) (res types.FeeMarket) {
+	return k.GetParams(ctx).FeeMarket
+}
+
+// GetFeeDenoms returns the FeeDenoms param.
+func (k Keeper) GetFeeDenoms(ctx sdk.Context) (res []string) {
+	return k.GetParams(ctx).FeeDenoms
+}
+
+// GetNonNativeFeeDestination returns the NonNativeFeeDestination param.
+func (k Keeper) GetNonNativeFeeDestination(ctx sdk.Context) (res string) {
+	return k.GetParams(ctx).NonNativeFeeDestination
+}
+
+// GetRateLimits returns the RateLimits param.
+func (k Keeper) GetRateLimits(ctx sdk.Context) (res []types.RateLimit) {
+	return k.GetParams(ctx).RateLimits
+}
+
 // SetParams sets the x/global module parameters.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	"github.com/KYVENetwork/chain/util"
+	"github.com/KYVENetwork/chain/x/global/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetRateLimitCount returns how many messages of the given type the signer
+// has sent in the window ending at `windowEnd`.
+func (k Keeper) GetRateLimitCount(ctx sdk.Context, windowEnd uint64, signer string, msgType string) uint64 {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.RateLimitKeyPrefix)
+
+	bz := store.Get(util.GetByteKey(windowEnd, signer, msgType))
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetRateLimitCount stores how many messages of the given type the signer
+// has sent in the window ending at `windowEnd`.
+func (k Keeper) SetRateLimitCount(ctx sdk.Context, windowEnd uint64, signer string, msgType string, count uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.RateLimitKeyPrefix)
+
+	store.Set(util.GetByteKey(windowEnd, signer, msgType), sdk.Uint64ToBigEndian(count))
+}
+
+// PruneRateLimitCounts removes the message counts of all windows which
+// ended at or before the given height.
+func (k Keeper) PruneRateLimitCounts(ctx sdk.Context, height uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.RateLimitKeyPrefix)
+
+	iterator := store.Iterator(nil, util.GetByteKey(height+1))
+	defer iterator.Close()
+
+	keys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/KYVENetwork/chain/x/global/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) BaseFee(c context.Context, req *types.QueryBaseFeeRequest) (*types.QueryBaseFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryBaseFeeResponse{
+		BaseFee:     k.GetBaseFee(ctx),
+		MinGasPrice: k.GetConsensusMinGasPrice(ctx),
+		Enabled:     k.GetFeeMarket(ctx).Enabled,
+	}, nil
+}
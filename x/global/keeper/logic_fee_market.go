@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetConsensusMinGasPrice returns the gas price every transaction has to pay at
+// least. If the fee market is enabled this is the maximum of the min gas price
+// and the base fee.
+func (k Keeper) GetConsensusMinGasPrice(ctx sdk.Context) math.LegacyDec {
+	minGasPrice := k.GetMinGasPrice(ctx)
+
+	if !k.GetFeeMarket(ctx).Enabled {
+		return minGasPrice
+	}
+
+	return math.LegacyMaxDec(minGasPrice, k.GetBaseFee(ctx))
+}
+
+// GetBaseFeeAmount returns the part of the given fee amount which pays the base
+// fee for the given gas. It is zero if the fee market is disabled.
+func (k Keeper) GetBaseFeeAmount(ctx sdk.Context, gas uint64, fee math.Int) math.Int {
+	if !k.GetFeeMarket(ctx).Enabled {
+		return math.ZeroInt()
+	}
+
+	baseFee := k.GetBaseFee(ctx).MulInt64(int64(gas)).Ceil().TruncateInt()
+	return math.MinInt(baseFee, fee)
+}
+
+// UpdateBaseFee adjusts the base fee depending on the gas used by the
+// transactions of the current block, including the failed ones. If the gas is above the target the base
+// fee increases, otherwise it decreases. The change is at most the change rate
+// of the fee market and the base fee stays within the min and max base fee.
+func (k Keeper) UpdateBaseFee(ctx sdk.Context, blockGas uint64) {
+	feeMarket := k.GetFeeMarket(ctx)
+	if !feeMarket.Enabled {
+		return
+	}
+
+	baseFee := k.GetBaseFee(ctx)
+	target := math.LegacyNewDec(int64(feeMarket.TargetBlockGas))
+	gas := math.LegacyNewDec(int64(blockGas))
+
+	// utilization is the relative deviation from the target, bounded to [-1, 1]
+	utilization := gas.Sub(target).Quo(target)
+	if utilization.GT(math.LegacyOneDec()) {
+		utilization = math.LegacyOneDec()
+	}
+
+	baseFee = baseFee.Add(baseFee.Mul(feeMarket.ChangeRate).Mul(utilization))
+
+	if baseFee.LT(feeMarket.MinBaseFee) {
+		baseFee = feeMarket.MinBaseFee
+	}
+
+	if baseFee.GT(feeMarket.MaxBaseFee) {
+		baseFee = feeMarket.MaxBaseFee
+	}
+
+	k.SetBaseFee(ctx, baseFee)
+}
@@ -40,6 +40,12 @@ TEST CASES - msg_server_update_params.go
 * Update gas refunds
 * Update gas refunds with invalid value
 
+* Update fee market
+* Update fee market with invalid value
+
+* Update rate limits
+* Update rate limits with invalid value
+
 */
 
 var _ = Describe("msg_server_update_params.go", Ordered, func() {
@@ -542,4 +548,170 @@ var _ = Describe("msg_server_update_params.go", Ordered, func() {
 		Expect(updatedParams.GasAdjustments).To(BeNil())
 		Expect(updatedParams.GasRefunds).To(BeNil())
 	})
+
+	It("Update fee market", func() {
+		// ARRANGE
+		payload := `{
+			"fee_market": {
+				"enabled": true,
+				"target_block_gas": 10000000,
+				"min_base_fee": "0.01",
+				"max_base_fee": "10",
+				"change_rate": "0.125"
+			}
+		}`
+
+		msg := &types.MsgUpdateParams{
+			Authority: gov,
+			Payload:   payload,
+		}
+
+		proposal, _ := govV1Types.NewMsgSubmitProposal(
+			[]sdk.Msg{msg}, minDeposit, i.DUMMY[0], "", "title", "summary", false,
+		)
+
+		vote := govV1Types.NewMsgVote(
+			voter, 1, govV1Types.VoteOption_VOTE_OPTION_YES, "",
+		)
+
+		// ACT
+		_, submitErr := s.RunTx(proposal)
+		_, voteErr := s.RunTx(vote)
+
+		s.CommitAfter(*votingPeriod)
+		s.Commit()
+
+		// ASSERT
+		updatedParams := s.App().GlobalKeeper.GetParams(s.Ctx())
+
+		Expect(submitErr).NotTo(HaveOccurred())
+		Expect(voteErr).NotTo(HaveOccurred())
+
+		Expect(updatedParams.MinGasPrice).To(Equal(types.DefaultMinGasPrice))
+		Expect(updatedParams.BurnRatio).To(Equal(types.DefaultBurnRatio))
+		Expect(updatedParams.FeeMarket.Enabled).To(BeTrue())
+		Expect(updatedParams.FeeMarket.TargetBlockGas).To(Equal(uint64(10_000_000)))
+		Expect(updatedParams.FeeMarket.MinBaseFee).To(Equal(math.LegacyMustNewDecFromStr("0.01")))
+		Expect(updatedParams.FeeMarket.MaxBaseFee).To(Equal(math.LegacyMustNewDecFromStr("10")))
+		Expect(updatedParams.FeeMarket.ChangeRate).To(Equal(math.LegacyMustNewDecFromStr("0.125")))
+	})
+
+	It("Update fee market with invalid value", func() {
+		// ARRANGE
+		payload := `{
+			"fee_market": {
+				"enabled": true,
+				"target_block_gas": 10000000,
+				"min_base_fee": "10",
+				"max_base_fee": "0.01",
+				"change_rate": "0.125"
+			}
+		}`
+
+		msg := &types.MsgUpdateParams{
+			Authority: gov,
+			Payload:   payload,
+		}
+
+		proposal, _ := govV1Types.NewMsgSubmitProposal(
+			[]sdk.Msg{msg}, minDeposit, i.DUMMY[0], "", "title", "summary", false,
+		)
+
+		// ACT
+		_, submitErr := s.RunTx(proposal)
+
+		s.CommitAfter(*votingPeriod)
+		s.Commit()
+
+		// ASSERT
+		updatedParams := s.App().GlobalKeeper.GetParams(s.Ctx())
+
+		Expect(submitErr).To(HaveOccurred())
+
+		Expect(updatedParams.MinGasPrice).To(Equal(types.DefaultMinGasPrice))
+		Expect(updatedParams.BurnRatio).To(Equal(types.DefaultBurnRatio))
+		Expect(updatedParams.FeeMarket.Enabled).To(BeFalse())
+	})
+
+	It("Update rate limits", func() {
+		// ARRANGE
+		payload := `{
+			"rate_limits": [{
+				"type": "/kyve.funders.v1beta1.MsgFundPool",
+				"max_messages": 5,
+				"window": 100
+			}]
+		}`
+
+		msg := &types.MsgUpdateParams{
+			Authority: gov,
+			Payload:   payload,
+		}
+
+		proposal, _ := govV1Types.NewMsgSubmitProposal(
+			[]sdk.Msg{msg}, minDeposit, i.DUMMY[0], "", "title", "summary", false,
+		)
+
+		vote := govV1Types.NewMsgVote(
+			voter, 1, govV1Types.VoteOption_VOTE_OPTION_YES, "",
+		)
+
+		// ACT
+		_, submitErr := s.RunTx(proposal)
+		_, voteErr := s.RunTx(vote)
+
+		s.CommitAfter(*votingPeriod)
+		s.Commit()
+
+		// ASSERT
+		updatedParams := s.App().GlobalKeeper.GetParams(s.Ctx())
+
+		Expect(submitErr).NotTo(HaveOccurred())
+		Expect(voteErr).NotTo(HaveOccurred())
+
+		Expect(updatedParams.MinGasPrice).To(Equal(types.DefaultMinGasPrice))
+		Expect(updatedParams.BurnRatio).To(Equal(types.DefaultBurnRatio))
+		Expect(updatedParams.RateLimits).To(Equal([]types.RateLimit{
+			{
+				Type:        "/kyve.funders.v1beta1.MsgFundPool",
+				MaxMessages: 5,
+				Window:      100,
+			},
+		}))
+	})
+
+	It("Update rate limits with invalid value", func() {
+		// ARRANGE
+		payload := `{
+			"rate_limits": [{
+				"type": "/kyve.funders.v1beta1.MsgFundPool",
+				"max_messages": 5,
+				"window": 0
+			}]
+		}`
+
+		msg := &types.MsgUpdateParams{
+			Authority: gov,
+			Payload:   payload,
+		}
+
+		proposal, _ := govV1Types.NewMsgSubmitProposal(
+			[]sdk.Msg{msg}, minDeposit, i.DUMMY[0], "", "title", "summary", false,
+		)
+
+		// ACT
+		_, submitErr := s.RunTx(proposal)
+
+		s.CommitAfter(*votingPeriod)
+		s.Commit()
+
+		// ASSERT
+		updatedParams := s.App().GlobalKeeper.GetParams(s.Ctx())
+
+		Expect(submitErr).To(HaveOccurred())
+
+		Expect(updatedParams.MinGasPrice).To(Equal(types.DefaultMinGasPrice))
+		Expect(updatedParams.BurnRatio).To(Equal(types.DefaultBurnRatio))
+		Expect(updatedParams.RateLimits).To(BeNil())
+	})
 })
@@ -186,10 +186,11 @@ func init() {
 type ModuleInputs struct {
 	depinject.In
 
-	Cdc          codec.Codec
-	Config       *modulev1.Module
-	StoreService store.KVStoreService
-	Logger       log.Logger
+	Cdc                   codec.Codec
+	Config                *modulev1.Module
+	StoreService          store.KVStoreService
+	TransientStoreService store.TransientStoreService
+	Logger                log.Logger
 
 	AccountKeeper authKeeper.AccountKeeper
 	BankKeeper    bankKeeper.Keeper
@@ -212,6 +213,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 	k := keeper.NewKeeper(
 		in.Cdc,
 		in.StoreService,
+		in.TransientStoreService,
 		in.Logger,
 		authority.String(),
 	)
@@ -1,8 +1,11 @@
 package global
 
 import (
+	"context"
+
 	sdkErrors "cosmossdk.io/errors"
 	"cosmossdk.io/math"
+	storeTypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -14,6 +17,7 @@ import (
 	feeGrantKeeper "cosmossdk.io/x/feegrant/keeper"
 	// Global
 	"github.com/KYVENetwork/chain/x/global/keeper"
+	"github.com/KYVENetwork/chain/x/global/types"
 )
 
 // RefundFeeDecorator
@@ -32,41 +36,77 @@ func NewRefundFeeDecorator(bk bankKeeper.Keeper, fk feeGrantKeeper.Keeper, gk ke
 	}
 }
 
+// PostHandle refunds a part of the fee of the transaction. The post handler
+// is only executed for successful transactions, so failed transactions are
+// never refunded.
 func (rfd RefundFeeDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, success bool, next sdk.PostHandler) (newCtx sdk.Context, err error) {
+	// Take the gas records of the messages before any gas is consumed here, the
+	// records are removed so they are not used by the next transaction.
+	gasConsumed := ctx.GasMeter().GasConsumed()
+	noGasCtx := ctx.WithGasMeter(storeTypes.NewInfiniteGasMeter())
+	msgGas := rfd.globalKeeper.GetMsgGas(noGasCtx)
+	rfd.globalKeeper.ResetMsgGas(noGasCtx)
+
 	// Ensure that this is a fee transaction.
 	feeTx, ok := tx.(sdk.FeeTx)
 	if !ok {
 		return ctx, sdkErrors.Wrap(errorsTypes.ErrTxDecode, "Tx must be a FeeTx")
 	}
 
-	// Return early if the transaction fee is zero (nothing to refund)
-	// or there are more than one message (can't refund).
+	// Return early if the transaction fee is zero (nothing to refund).
 	fee := feeTx.GetFee()
 	msgs := feeTx.GetMsgs()
-	if fee.IsZero() || len(msgs) != 1 {
+	if fee.IsZero() || len(msgs) == 0 {
 		return next(ctx, tx, simulate, success)
 	}
 
-	// Find the refund percentage based on the transaction message type.
-	refundPercentage := math.LegacyZeroDec()
+	// Calculate the refund of every message based on its share of the
+	// consumed gas and the refund percentage of its message type.
 	gasRefunds := rfd.globalKeeper.GetGasRefunds(ctx)
-	for _, refund := range gasRefunds {
-		if sdk.MsgTypeURL(msgs[0]) == refund.Type {
-			refundPercentage = refund.Fraction
-			break
-		}
-	}
-
-	// Return early if the refund percentage is zero.
-	if refundPercentage.IsZero() {
+	gasShares := getGasShares(gasConsumed, msgs, msgGas, rfd.globalKeeper.GetGasAdjustments(ctx))
+	if gasShares == nil {
 		return next(ctx, tx, simulate, success)
 	}
 
-	// Calculate the refund amount.
 	refund := sdk.NewCoins()
-	for _, coin := range fee {
-		amount := math.LegacyNewDecFromInt(coin.Amount).Mul(refundPercentage)
-		refund = refund.Add(sdk.NewCoin(coin.Denom, amount.TruncateInt()))
+	refundPercentage := math.LegacyZeroDec()
+	breakdown := make([]types.MessageGasRefund, 0)
+
+	for index, msg := range msgs {
+		msgType := sdk.MsgTypeURL(msg)
+
+		fraction := math.LegacyZeroDec()
+		for _, gasRefund := range gasRefunds {
+			if msgType == gasRefund.Type {
+				fraction = gasRefund.Fraction
+				break
+			}
+		}
+
+		if fraction.IsZero() {
+			continue
+		}
+
+		msgRefundPercentage := gasShares[index].Mul(fraction)
+		msgRefund := sdk.NewCoins()
+		for _, coin := range fee {
+			amount := math.LegacyNewDecFromInt(coin.Amount).Mul(msgRefundPercentage)
+			msgRefund = msgRefund.Add(sdk.NewCoin(coin.Denom, amount.TruncateInt()))
+		}
+
+		refund = refund.Add(msgRefund...)
+		refundPercentage = refundPercentage.Add(msgRefundPercentage)
+		breakdown = append(breakdown, types.MessageGasRefund{
+			Type:     msgType,
+			GasShare: gasShares[index],
+			Fraction: fraction,
+			Amount:   msgRefund.String(),
+		})
+	}
+
+	// Return early if there is nothing to refund.
+	if refund.IsZero() {
+		return next(ctx, tx, simulate, success)
 	}
 
 	// Send the refund back to this transaction's fee payer.
@@ -79,5 +119,110 @@ func (rfd RefundFeeDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 		return ctx, err
 	}
 
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventGasRefund{
+		Address: account.String(),
+		Amount:  refund.String(),
+		Refunds: breakdown,
+	})
+
+	// The refunded part of the base fee can not be burned anymore.
+	if !ctx.IsCheckTx() && !simulate && rfd.globalKeeper.GetFeeMarket(ctx).Enabled {
+		baseFees := rfd.globalKeeper.GetBaseFeeAmount(ctx, feeTx.GetGas(), fee.AmountOf(types.Denom))
+		refundedBaseFees := math.LegacyNewDecFromInt(baseFees).Mul(refundPercentage).TruncateInt()
+		rfd.globalKeeper.SubBlockBaseFees(noGasCtx, refundedBaseFees)
+	}
+
 	return next(ctx, tx, simulate, success)
 }
+
+// MsgGasTracker
+
+// The MsgGasTracker records the gas a transaction consumed before each of its
+// messages is executed, so the gas of every message can be determined once the
+// transaction was executed. It is set as the circuit breaker of the message
+// router, which is called before every message, and never blocks a message.
+type MsgGasTracker struct {
+	globalKeeper keeper.Keeper
+}
+
+func NewMsgGasTracker(gk keeper.Keeper) MsgGasTracker {
+	return MsgGasTracker{
+		globalKeeper: gk,
+	}
+}
+
+func (mgt MsgGasTracker) IsAllowed(ctx context.Context, typeURL string) (bool, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	gasConsumed := sdkCtx.GasMeter().GasConsumed()
+
+	mgt.globalKeeper.AddMsgGas(sdkCtx.WithGasMeter(storeTypes.NewInfiniteGasMeter()), typeURL, gasConsumed)
+	return true, nil
+}
+
+// getGasShares returns the share of the consumed gas for every message of a
+// transaction. The gas of a message is the gas consumed while it was executed,
+// including all messages it executed itself, plus the gas added by its gas
+// adjustment. If the gas of the messages was not recorded or the records do not
+// match the messages of the transaction the shares of a transaction with multiple
+// messages can not be determined and nil is returned.
+func getGasShares(gasConsumed uint64, msgs []sdk.Msg, msgGas []types.MsgGas, gasAdjustments []types.GasAdjustment) []math.LegacyDec {
+	if len(msgs) == 1 {
+		return []math.LegacyDec{math.LegacyOneDec()}
+	}
+
+	// Find the record of every message, records of nested messages are skipped.
+	msgTypes := make([]string, 0)
+	positions := make([]int, len(msgs))
+	for index, msg := range msgs {
+		positions[index] = len(msgTypes)
+		msgTypes = append(msgTypes, getMsgTypes(msg)...)
+	}
+
+	if len(msgGas) != len(msgTypes) {
+		return nil
+	}
+	for index, record := range msgGas {
+		if record.Type != msgTypes[index] || record.GasConsumed > gasConsumed {
+			return nil
+		}
+		if index > 0 && record.GasConsumed < msgGas[index-1].GasConsumed {
+			return nil
+		}
+	}
+
+	gasUsed := make([]uint64, len(msgs))
+	totalGasUsed := uint64(0)
+
+	for index, msg := range msgs {
+		end := gasConsumed
+		if index+1 < len(msgs) {
+			end = msgGas[positions[index+1]].GasConsumed
+		}
+		gasUsed[index] = end - msgGas[positions[index]].GasConsumed
+
+		for _, adjustment := range gasAdjustments {
+			if sdk.MsgTypeURL(msg) == adjustment.Type {
+				gasUsed[index] += adjustment.Amount
+				break
+			}
+		}
+
+		totalGasUsed += gasUsed[index]
+	}
+
+	shares := make([]math.LegacyDec, len(msgs))
+
+	// Split evenly if the messages did not use any gas at all.
+	if totalGasUsed == 0 {
+		for index := range msgs {
+			shares[index] = math.LegacyOneDec().QuoInt64(int64(len(msgs)))
+		}
+		return shares
+	}
+
+	for index := range msgs {
+		shares[index] = math.LegacyNewDec(int64(gasUsed[index])).QuoInt64(int64(totalGasUsed))
+	}
+
+	return shares
+}
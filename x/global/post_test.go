@@ -2,12 +2,16 @@ package global_test
 
 import (
 	"cosmossdk.io/math"
+	storeTypes "cosmossdk.io/store/types"
 	i "github.com/KYVENetwork/chain/testutil/integration"
 	bundlesTypes "github.com/KYVENetwork/chain/x/bundles/types"
 	fundersTypes "github.com/KYVENetwork/chain/x/funders/types"
 	stakersTypes "github.com/KYVENetwork/chain/x/stakers/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	bankTypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
@@ -25,7 +29,14 @@ TEST CASES - RefundFeeDecorator
 * Refund 10%
 * Refund 2/3 %
 * Refund 100%
-* Don't refund multiple
+* Refund multiple
+* Refund multiple weighted by gas adjustment
+* Refund multiple weighted by gas used
+* Refund multiple with nested messages
+* Refund multiple without gas records
+* Refund successful transaction - deliverTX
+* No refund for failed transaction - deliverTX
+* Emit refund event
 
 */
 
@@ -33,7 +44,8 @@ var _ = Describe("RefundFeeDecorator", Ordered, func() {
 	s := i.NewCleanChain()
 	encodingConfig := BuildEncodingConfig()
 	rfd := global.NewRefundFeeDecorator(s.App().BankKeeper, s.App().FeeGrantKeeper, s.App().GlobalKeeper)
-	dfd := global.NewDeductFeeDecorator(s.App().AccountKeeper, s.App().BankKeeper, s.App().FeeGrantKeeper, s.App().GlobalKeeper, s.App().StakingKeeper)
+	mgt := global.NewMsgGasTracker(s.App().GlobalKeeper)
+	dfd := global.NewDeductFeeDecorator(s.App().AccountKeeper, s.App().BankKeeper, s.App().FeeGrantKeeper, s.App().GlobalKeeper, s.App().StakingKeeper, s.App().FundersKeeper)
 	denom, _ := s.App().StakingKeeper.BondDenom(s.Ctx())
 
 	accountBalanceBefore := s.GetBalanceFromAddress(i.DUMMY[0])
@@ -43,7 +55,8 @@ var _ = Describe("RefundFeeDecorator", Ordered, func() {
 
 		accountBalanceBefore = s.GetBalanceFromAddress(i.DUMMY[0])
 		rfd = global.NewRefundFeeDecorator(s.App().BankKeeper, s.App().FeeGrantKeeper, s.App().GlobalKeeper)
-		dfd = global.NewDeductFeeDecorator(s.App().AccountKeeper, s.App().BankKeeper, s.App().FeeGrantKeeper, s.App().GlobalKeeper, s.App().StakingKeeper)
+		mgt = global.NewMsgGasTracker(s.App().GlobalKeeper)
+		dfd = global.NewDeductFeeDecorator(s.App().AccountKeeper, s.App().BankKeeper, s.App().FeeGrantKeeper, s.App().GlobalKeeper, s.App().StakingKeeper, s.App().FundersKeeper)
 
 		denom, _ = s.App().StakingKeeper.BondDenom(s.Ctx())
 
@@ -198,7 +211,7 @@ var _ = Describe("RefundFeeDecorator", Ordered, func() {
 		Expect(collectorBalanceAfter).To(Equal(uint64(0)))
 	})
 
-	It("Don't refund multiple", func() {
+	It("Refund multiple", func() {
 		// ARRANGE
 		msg1 := bundlesTypes.MsgVoteBundleProposal{Creator: i.ALICE}
 		msg2 := bundlesTypes.MsgSkipUploaderRole{Creator: i.ALICE}
@@ -211,6 +224,150 @@ var _ = Describe("RefundFeeDecorator", Ordered, func() {
 		_ = txBuilder.SetMsgs(&msg1, &msg2, &msg3)
 		tx := txBuilder.GetTx()
 
+		_, errAnte := dfd.AnteHandle(s.Ctx(), tx, false, AnteNextFn)
+
+		postCtx := s.Ctx().WithGasMeter(storeTypes.NewInfiniteGasMeter())
+		ExecuteMsgs(postCtx, mgt, tx.GetMsgs(), []uint64{30_000, 30_000, 30_000})
+
+		// ACT
+		_, errPost := rfd.PostHandle(postCtx, tx, false, true, PostNextFn)
+
+		// ASSERT
+		accountBalanceAfter := s.GetBalanceFromAddress(i.ALICE)
+		collectorBalanceAfter := s.GetBalanceFromModule(authTypes.FeeCollectorName)
+
+		Expect(errAnte).Should(Not(HaveOccurred()))
+		Expect(errPost).Should(Not(HaveOccurred()))
+		// only the vote (1/3 of the gas) is refunded
+		Expect(accountBalanceBefore).To(Equal(accountBalanceAfter + 200_000 - 66_666))
+		Expect(collectorBalanceAfter).To(Equal(uint64(133_334)))
+	})
+
+	It("Refund multiple weighted by gas adjustment", func() {
+		// ARRANGE
+		params := s.App().GlobalKeeper.GetParams(s.Ctx())
+		params.GasAdjustments = []types.GasAdjustment{
+			{
+				Type:   "/kyve.bundles.v1beta1.MsgSkipUploaderRole",
+				Amount: 60_000,
+			},
+		}
+		s.App().GlobalKeeper.SetParams(s.Ctx(), params)
+
+		msg1 := bundlesTypes.MsgVoteBundleProposal{Creator: i.ALICE}
+		msg2 := bundlesTypes.MsgSkipUploaderRole{Creator: i.ALICE}
+		txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+		gasLimit := uint64(200_000)
+		txBuilder.SetGasLimit(gasLimit)
+		fees := sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(1).MulRaw(int64(gasLimit))))
+		txBuilder.SetFeeAmount(fees)
+		_ = txBuilder.SetMsgs(&msg1, &msg2)
+		tx := txBuilder.GetTx()
+
+		_, errAnte := dfd.AnteHandle(s.Ctx(), tx, false, AnteNextFn)
+
+		// the gas adjustment is consumed by the ante handler
+		postCtx := s.Ctx().WithGasMeter(storeTypes.NewInfiniteGasMeter())
+		postCtx.GasMeter().ConsumeGas(60_000, "test")
+		ExecuteMsgs(postCtx, mgt, tx.GetMsgs(), []uint64{20_000, 20_000})
+
+		// ACT
+		_, errPost := rfd.PostHandle(postCtx, tx, false, true, PostNextFn)
+
+		// ASSERT
+		accountBalanceAfter := s.GetBalanceFromAddress(i.ALICE)
+		collectorBalanceAfter := s.GetBalanceFromModule(authTypes.FeeCollectorName)
+
+		Expect(errAnte).Should(Not(HaveOccurred()))
+		Expect(errPost).Should(Not(HaveOccurred()))
+		// the vote consumed 20k of the 100k gas
+		Expect(accountBalanceBefore).To(Equal(accountBalanceAfter + 200_000 - 40_000))
+		Expect(collectorBalanceAfter).To(Equal(uint64(160_000)))
+	})
+
+	It("Refund multiple weighted by gas used", func() {
+		// ARRANGE
+		// an expensive message is padded with cheap refundable messages
+		msgs := []sdk.Msg{&stakersTypes.MsgJoinPool{Creator: i.ALICE}}
+		gas := []uint64{190_000}
+		for t := 0; t < 10; t++ {
+			msgs = append(msgs, &bundlesTypes.MsgVoteBundleProposal{Creator: i.ALICE})
+			gas = append(gas, 1_000)
+		}
+
+		txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+		gasLimit := uint64(200_000)
+		txBuilder.SetGasLimit(gasLimit)
+		fees := sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(1).MulRaw(int64(gasLimit))))
+		txBuilder.SetFeeAmount(fees)
+		_ = txBuilder.SetMsgs(msgs...)
+		tx := txBuilder.GetTx()
+
+		_, errAnte := dfd.AnteHandle(s.Ctx(), tx, false, AnteNextFn)
+
+		postCtx := s.Ctx().WithGasMeter(storeTypes.NewInfiniteGasMeter())
+		ExecuteMsgs(postCtx, mgt, tx.GetMsgs(), gas)
+
+		// ACT
+		_, errPost := rfd.PostHandle(postCtx, tx, false, true, PostNextFn)
+
+		// ASSERT
+		accountBalanceAfter := s.GetBalanceFromAddress(i.ALICE)
+		collectorBalanceAfter := s.GetBalanceFromModule(authTypes.FeeCollectorName)
+
+		Expect(errAnte).Should(Not(HaveOccurred()))
+		Expect(errPost).Should(Not(HaveOccurred()))
+		// only the 10k gas of the votes are refunded instead of 10/11 of the fee
+		Expect(accountBalanceBefore).To(Equal(accountBalanceAfter + 200_000 - 10_000))
+		Expect(collectorBalanceAfter).To(Equal(uint64(190_000)))
+		Expect(s.App().GlobalKeeper.GetMsgGas(postCtx)).To(BeEmpty())
+	})
+
+	It("Refund multiple with nested messages", func() {
+		// ARRANGE
+		joinMsg := stakersTypes.MsgJoinPool{Creator: i.BOB}
+		execMsg := authz.NewMsgExec(sdk.MustAccAddressFromBech32(i.ALICE), []sdk.Msg{&joinMsg})
+		msg := bundlesTypes.MsgVoteBundleProposal{Creator: i.ALICE}
+		txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+		gasLimit := uint64(200_000)
+		txBuilder.SetGasLimit(gasLimit)
+		fees := sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(1).MulRaw(int64(gasLimit))))
+		txBuilder.SetFeeAmount(fees)
+		_ = txBuilder.SetMsgs(&execMsg, &msg)
+		tx := txBuilder.GetTx()
+
+		_, errAnte := dfd.AnteHandle(s.Ctx(), tx, false, AnteNextFn)
+
+		// the nested message is executed by the message router as well
+		postCtx := s.Ctx().WithGasMeter(storeTypes.NewInfiniteGasMeter())
+		ExecuteMsgs(postCtx, mgt, []sdk.Msg{&execMsg, &joinMsg, &msg}, []uint64{10_000, 80_000, 10_000})
+
+		// ACT
+		_, errPost := rfd.PostHandle(postCtx, tx, false, true, PostNextFn)
+
+		// ASSERT
+		accountBalanceAfter := s.GetBalanceFromAddress(i.ALICE)
+		collectorBalanceAfter := s.GetBalanceFromModule(authTypes.FeeCollectorName)
+
+		Expect(errAnte).Should(Not(HaveOccurred()))
+		Expect(errPost).Should(Not(HaveOccurred()))
+		// the vote used 10k of the 100k gas
+		Expect(accountBalanceBefore).To(Equal(accountBalanceAfter + 200_000 - 20_000))
+		Expect(collectorBalanceAfter).To(Equal(uint64(180_000)))
+	})
+
+	It("Refund multiple without gas records", func() {
+		// ARRANGE
+		msg1 := bundlesTypes.MsgVoteBundleProposal{Creator: i.ALICE}
+		msg2 := bundlesTypes.MsgVoteBundleProposal{Creator: i.ALICE}
+		txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+		gasLimit := uint64(200_000)
+		txBuilder.SetGasLimit(gasLimit)
+		fees := sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(1).MulRaw(int64(gasLimit))))
+		txBuilder.SetFeeAmount(fees)
+		_ = txBuilder.SetMsgs(&msg1, &msg2)
+		tx := txBuilder.GetTx()
+
 		// ACT
 		_, errAnte := dfd.AnteHandle(s.Ctx(), tx, false, AnteNextFn)
 		_, errPost := rfd.PostHandle(s.Ctx(), tx, false, true, PostNextFn)
@@ -221,7 +378,102 @@ var _ = Describe("RefundFeeDecorator", Ordered, func() {
 
 		Expect(errAnte).Should(Not(HaveOccurred()))
 		Expect(errPost).Should(Not(HaveOccurred()))
+		// the gas of the messages is unknown, so nothing is refunded
 		Expect(accountBalanceBefore).To(Equal(accountBalanceAfter + 200_000))
 		Expect(collectorBalanceAfter).To(Equal(uint64(200_000)))
 	})
+
+	It("Refund successful transaction - deliverTX", func() {
+		// ARRANGE
+		params := s.App().GlobalKeeper.GetParams(s.Ctx())
+		params.GasRefunds = append(params.GasRefunds, types.GasRefund{
+			Type:     "/cosmos.bank.v1beta1.MsgSend",
+			Fraction: math.LegacyOneDec(),
+		})
+		s.App().GlobalKeeper.SetParams(s.Ctx(), params)
+		s.Commit()
+
+		privKey := secp256k1.GenPrivKeyFromSecret([]byte("sender"))
+		sender := sdk.AccAddress(privKey.PubKey().Address())
+		_ = s.MintBaseCoins(sender.String(), 1000*i.KYVE)
+
+		msg := bankTypes.NewMsgSend(sender, sdk.MustAccAddressFromBech32(i.ALICE), sdk.NewCoins(sdk.NewInt64Coin(denom, int64(100*i.KYVE))))
+		fees := sdk.NewCoins(sdk.NewInt64Coin(denom, 200_000))
+
+		// ACT
+		res := s.DeliverTx(privKey, 200_000, fees, msg)
+
+		// ASSERT
+		Expect(res.Code).To(BeZero())
+		Expect(HasRefundEvent(res.Events)).To(BeTrue())
+
+		// the whole fee is refunded
+		Expect(s.GetBalanceFromAddress(sender.String())).To(Equal(900 * i.KYVE))
+	})
+
+	It("No refund for failed transaction - deliverTX", func() {
+		// ARRANGE
+		params := s.App().GlobalKeeper.GetParams(s.Ctx())
+		params.GasRefunds = append(params.GasRefunds, types.GasRefund{
+			Type:     "/cosmos.bank.v1beta1.MsgSend",
+			Fraction: math.LegacyOneDec(),
+		})
+		s.App().GlobalKeeper.SetParams(s.Ctx(), params)
+		s.Commit()
+
+		privKey := secp256k1.GenPrivKeyFromSecret([]byte("sender"))
+		sender := sdk.AccAddress(privKey.PubKey().Address())
+		_ = s.MintBaseCoins(sender.String(), 1000*i.KYVE)
+
+		// the sender can pay the fees but not the amount
+		msg := bankTypes.NewMsgSend(sender, sdk.MustAccAddressFromBech32(i.ALICE), sdk.NewCoins(sdk.NewInt64Coin(denom, int64(2000*i.KYVE))))
+		fees := sdk.NewCoins(sdk.NewInt64Coin(denom, 200_000))
+
+		// ACT
+		res := s.DeliverTx(privKey, 200_000, fees, msg)
+
+		// ASSERT
+		Expect(res.Code).NotTo(BeZero())
+		Expect(HasRefundEvent(res.Events)).To(BeFalse())
+
+		// the fee is paid by the ante handler and not refunded
+		Expect(s.GetBalanceFromAddress(sender.String())).To(Equal(1000*i.KYVE - 200_000))
+	})
+
+	It("Emit refund event", func() {
+		// ARRANGE
+		msg1 := bundlesTypes.MsgVoteBundleProposal{Creator: i.ALICE}
+		msg2 := bundlesTypes.MsgSubmitBundleProposal{Creator: i.ALICE}
+		txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+		gasLimit := uint64(200_000)
+		txBuilder.SetGasLimit(gasLimit)
+		fees := sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(1).MulRaw(int64(gasLimit))))
+		txBuilder.SetFeeAmount(fees)
+		_ = txBuilder.SetMsgs(&msg1, &msg2)
+		tx := txBuilder.GetTx()
+
+		_, errAnte := dfd.AnteHandle(s.Ctx(), tx, false, AnteNextFn)
+
+		postCtx := s.Ctx().WithEventManager(sdk.NewEventManager()).WithGasMeter(storeTypes.NewInfiniteGasMeter())
+		ExecuteMsgs(postCtx, mgt, tx.GetMsgs(), []uint64{50_000, 50_000})
+
+		// ACT
+		_, errPost := rfd.PostHandle(postCtx, tx, false, true, PostNextFn)
+
+		// ASSERT
+		Expect(errAnte).Should(Not(HaveOccurred()))
+		Expect(errPost).Should(Not(HaveOccurred()))
+
+		refundEvents := 0
+		for _, event := range postCtx.EventManager().Events() {
+			if event.Type == "kyve.global.v1beta1.EventGasRefund" {
+				refundEvents++
+			}
+		}
+		Expect(refundEvents).To(Equal(1))
+
+		// 1/2 * 100% + 1/2 * 10% of the fee is refunded
+		accountBalanceAfter := s.GetBalanceFromAddress(i.ALICE)
+		Expect(accountBalanceBefore).To(Equal(accountBalanceAfter + 200_000 - 110_000))
+	})
 })
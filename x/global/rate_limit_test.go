@@ -0,0 +1,304 @@
+package global_test
+
+import (
+	"cosmossdk.io/math"
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	// Bundles
+	bundlesTypes "github.com/KYVENetwork/chain/x/bundles/types"
+	// Funders
+	fundersTypes "github.com/KYVENetwork/chain/x/funders/types"
+	// Global
+	"github.com/KYVENetwork/chain/x/global"
+	"github.com/KYVENetwork/chain/x/global/types"
+	// Pool
+	poolTypes "github.com/KYVENetwork/chain/x/pool/types"
+	// Stakers
+	stakersTypes "github.com/KYVENetwork/chain/x/stakers/types"
+)
+
+/*
+
+TEST CASES - RateLimitDecorator
+
+* No rate limits
+* Allow messages up to the limit
+* Reject messages above the limit
+* Count multiple messages of one transaction
+* Count messages of an authz MsgExec
+* Do not count messages again in ReCheckTx
+* Allow messages again in the next window
+* Limit signers separately
+* Ignore message types without rate limit
+* Exempt protocol messages of active pool accounts
+* Limit protocol messages of unknown pool accounts
+* Prune ended windows
+
+*/
+
+var _ = Describe("RateLimitDecorator", Ordered, func() {
+	s := i.NewCleanChain()
+	encodingConfig := BuildEncodingConfig()
+	rld := global.NewRateLimitDecorator(s.App().GlobalKeeper, s.App().StakersKeeper)
+
+	fundPoolType := sdk.MsgTypeURL(&fundersTypes.MsgFundPool{})
+	voteType := sdk.MsgTypeURL(&bundlesTypes.MsgVoteBundleProposal{})
+
+	buildTx := func(msgs ...sdk.Msg) sdk.Tx {
+		txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+		_ = txBuilder.SetMsgs(msgs...)
+		return txBuilder.GetTx()
+	}
+
+	BeforeEach(func() {
+		s = i.NewCleanChain()
+		encodingConfig = BuildEncodingConfig()
+		rld = global.NewRateLimitDecorator(s.App().GlobalKeeper, s.App().StakersKeeper)
+
+		params := types.DefaultParams()
+		params.RateLimits = []types.RateLimit{
+			{
+				Type:        fundPoolType,
+				MaxMessages: 2,
+				Window:      10,
+			},
+			{
+				Type:        voteType,
+				MaxMessages: 1,
+				Window:      10,
+			},
+		}
+		s.App().GlobalKeeper.SetParams(s.Ctx(), params)
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("No rate limits", func() {
+		// ARRANGE
+		s.App().GlobalKeeper.SetParams(s.Ctx(), types.DefaultParams())
+		tx := buildTx(&fundersTypes.MsgFundPool{Creator: i.ALICE})
+
+		// ACT
+		for t := 0; t < 5; t++ {
+			_, err := rld.AnteHandle(s.Ctx(), tx, false, AnteNextFn)
+
+			// ASSERT
+			Expect(err).Should(Not(HaveOccurred()))
+		}
+	})
+
+	It("Allow messages up to the limit", func() {
+		// ARRANGE
+		tx := buildTx(&fundersTypes.MsgFundPool{Creator: i.ALICE})
+
+		// ACT
+		_, err1 := rld.AnteHandle(s.Ctx(), tx, false, AnteNextFn)
+		_, err2 := rld.AnteHandle(s.Ctx(), tx, false, AnteNextFn)
+
+		// ASSERT
+		Expect(err1).Should(Not(HaveOccurred()))
+		Expect(err2).Should(Not(HaveOccurred()))
+	})
+
+	It("Reject messages above the limit", func() {
+		// ARRANGE
+		tx := buildTx(&fundersTypes.MsgFundPool{Creator: i.ALICE})
+		_, _ = rld.AnteHandle(s.Ctx(), tx, false, AnteNextFn)
+		_, _ = rld.AnteHandle(s.Ctx(), tx, false, AnteNextFn)
+
+		// ACT
+		_, err := rld.AnteHandle(s.Ctx(), tx, false, AnteNextFn)
+
+		// ASSERT
+		Expect(err).Should(HaveOccurred())
+	})
+
+	It("Count multiple messages of one transaction", func() {
+		// ARRANGE
+		tx := buildTx(
+			&fundersTypes.MsgFundPool{Creator: i.ALICE},
+			&fundersTypes.MsgFundPool{Creator: i.ALICE},
+			&fundersTypes.MsgFundPool{Creator: i.ALICE},
+		)
+
+		// ACT
+		_, err := rld.AnteHandle(s.Ctx(), tx, false, AnteNextFn)
+
+		// ASSERT
+		Expect(err).Should(HaveOccurred())
+	})
+
+	It("Count messages of an authz MsgExec", func() {
+		// ARRANGE
+		execMsg := authz.NewMsgExec(sdk.MustAccAddressFromBech32(i.ALICE), []sdk.Msg{
+			&fundersTypes.MsgFundPool{Creator: i.BOB},
+			&fundersTypes.MsgFundPool{Creator: i.BOB},
+		})
+		tx := buildTx(&execMsg)
+
+		_, err1 := rld.AnteHandle(s.Ctx(), tx, false, AnteNextFn)
+
+		// ACT
+		_, err2 := rld.AnteHandle(s.Ctx(), tx, false, AnteNextFn)
+
+		// ASSERT
+		height := uint64(s.Ctx().BlockHeight())
+		windowEnd := height - height%10 + 10
+
+		Expect(err1).Should(Not(HaveOccurred()))
+		Expect(err2).Should(HaveOccurred())
+		Expect(s.App().GlobalKeeper.GetRateLimitCount(s.Ctx(), windowEnd, i.ALICE, fundPoolType)).To(Equal(uint64(2)))
+	})
+
+	It("Do not count messages again in ReCheckTx", func() {
+		// ARRANGE
+		tx := buildTx(&fundersTypes.MsgFundPool{Creator: i.ALICE})
+		_, _ = rld.AnteHandle(s.Ctx().WithIsCheckTx(true), tx, false, AnteNextFn)
+
+		// ACT
+		_, errRecheck := rld.AnteHandle(s.Ctx().WithIsReCheckTx(true), tx, false, AnteNextFn)
+		_, errNext := rld.AnteHandle(s.Ctx().WithIsCheckTx(true), tx, false, AnteNextFn)
+
+		// ASSERT
+		height := uint64(s.Ctx().BlockHeight())
+		windowEnd := height - height%10 + 10
+
+		Expect(errRecheck).Should(Not(HaveOccurred()))
+		Expect(errNext).Should(Not(HaveOccurred()))
+		Expect(s.App().GlobalKeeper.GetRateLimitCount(s.Ctx(), windowEnd, i.ALICE, fundPoolType)).To(Equal(uint64(2)))
+	})
+
+	It("Allow messages again in the next window", func() {
+		// ARRANGE
+		tx := buildTx(&fundersTypes.MsgFundPool{Creator: i.ALICE})
+		_, _ = rld.AnteHandle(s.Ctx(), tx, false, AnteNextFn)
+		_, _ = rld.AnteHandle(s.Ctx(), tx, false, AnteNextFn)
+
+		nextWindowCtx := s.Ctx().WithBlockHeight(s.Ctx().BlockHeight() + 10)
+
+		// ACT
+		_, err := rld.AnteHandle(nextWindowCtx, tx, false, AnteNextFn)
+
+		// ASSERT
+		Expect(err).Should(Not(HaveOccurred()))
+	})
+
+	It("Limit signers separately", func() {
+		// ARRANGE
+		txAlice := buildTx(&fundersTypes.MsgFundPool{Creator: i.ALICE})
+		txBob := buildTx(&fundersTypes.MsgFundPool{Creator: i.BOB})
+		_, _ = rld.AnteHandle(s.Ctx(), txAlice, false, AnteNextFn)
+		_, _ = rld.AnteHandle(s.Ctx(), txAlice, false, AnteNextFn)
+
+		// ACT
+		_, errAlice := rld.AnteHandle(s.Ctx(), txAlice, false, AnteNextFn)
+		_, errBob := rld.AnteHandle(s.Ctx(), txBob, false, AnteNextFn)
+
+		// ASSERT
+		Expect(errAlice).Should(HaveOccurred())
+		Expect(errBob).Should(Not(HaveOccurred()))
+	})
+
+	It("Ignore message types without rate limit", func() {
+		// ARRANGE
+		tx := buildTx(&fundersTypes.MsgDefundPool{Creator: i.ALICE})
+
+		// ACT
+		for t := 0; t < 5; t++ {
+			_, err := rld.AnteHandle(s.Ctx(), tx, false, AnteNextFn)
+
+			// ASSERT
+			Expect(err).Should(Not(HaveOccurred()))
+		}
+	})
+
+	It("Exempt protocol messages of active pool accounts", func() {
+		// ARRANGE
+		gov := s.App().GovKeeper.GetGovernanceAccount(s.Ctx()).GetAddress().String()
+		s.RunTxPoolSuccess(&poolTypes.MsgCreatePool{
+			Authority:            gov,
+			Name:                 "PoolTest",
+			Runtime:              "@kyve/test",
+			Logo:                 "ar://Tewyv2P5VEG8EJ6AUQORdqNTectY9hlOrWPK8wwo-aU",
+			Config:               "ar://DgdB-2hLrxjhyEEbCML__dgZN5_uS7T6Z5XDkaFh3P0",
+			StartKey:             "0",
+			UploadInterval:       60,
+			InflationShareWeight: math.LegacyNewDec(10_000),
+			MinDelegation:        0 * i.KYVE,
+			MaxBundleSize:        100,
+			Version:              "0.0.0",
+			Binaries:             "{}",
+			StorageProviderId:    2,
+			CompressionId:        1,
+		})
+
+		s.CreateValidator(i.STAKER_0, "Staker-0", int64(100*i.KYVE))
+
+		s.RunTxStakersSuccess(&stakersTypes.MsgJoinPool{
+			Creator:       i.STAKER_0,
+			PoolId:        0,
+			PoolAddress:   i.POOL_ADDRESS_0_A,
+			Commission:    math.LegacyMustNewDecFromStr("0.1"),
+			StakeFraction: math.LegacyMustNewDecFromStr("1"),
+		})
+
+		tx := buildTx(&bundlesTypes.MsgVoteBundleProposal{
+			Creator: i.POOL_ADDRESS_0_A,
+			Staker:  i.STAKER_0,
+			PoolId:  0,
+		})
+
+		// ACT
+		for t := 0; t < 3; t++ {
+			_, err := rld.AnteHandle(s.Ctx(), tx, false, AnteNextFn)
+
+			// ASSERT
+			Expect(err).Should(Not(HaveOccurred()))
+		}
+	})
+
+	It("Limit protocol messages of unknown pool accounts", func() {
+		// ARRANGE
+		tx := buildTx(&bundlesTypes.MsgVoteBundleProposal{
+			Creator: i.POOL_ADDRESS_0_A,
+			Staker:  i.STAKER_0,
+			PoolId:  0,
+		})
+
+		// ACT
+		_, err1 := rld.AnteHandle(s.Ctx(), tx, false, AnteNextFn)
+		_, err2 := rld.AnteHandle(s.Ctx(), tx, false, AnteNextFn)
+
+		// ASSERT
+		Expect(err1).Should(Not(HaveOccurred()))
+		Expect(err2).Should(HaveOccurred())
+	})
+
+	It("Prune ended windows", func() {
+		// ARRANGE
+		tx := buildTx(&fundersTypes.MsgFundPool{Creator: i.ALICE})
+		_, _ = rld.AnteHandle(s.Ctx(), tx, false, AnteNextFn)
+
+		height := uint64(s.Ctx().BlockHeight())
+		windowEnd := height - height%10 + 10
+		Expect(s.App().GlobalKeeper.GetRateLimitCount(s.Ctx(), windowEnd, i.ALICE, fundPoolType)).To(Equal(uint64(1)))
+
+		// ACT
+		s.App().GlobalKeeper.PruneRateLimitCounts(s.Ctx(), windowEnd-1)
+		countBefore := s.App().GlobalKeeper.GetRateLimitCount(s.Ctx(), windowEnd, i.ALICE, fundPoolType)
+
+		s.App().GlobalKeeper.PruneRateLimitCounts(s.Ctx(), windowEnd)
+		countAfter := s.App().GlobalKeeper.GetRateLimitCount(s.Ctx(), windowEnd, i.ALICE, fundPoolType)
+
+		// ASSERT
+		Expect(countBefore).To(Equal(uint64(1)))
+		Expect(countAfter).To(BeZero())
+	})
+})
@@ -0,0 +1,8 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+)
+
+// x/global module sentinel errors
+var ErrRateLimitExceeded = errors.Register(ModuleName, 1100, "rate limit of %v messages per %v blocks exceeded for %v")
@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -88,30 +89,167 @@ func (m *EventUpdateParams) GetPayload() string {
 	return ""
 }
 
+// EventGasRefund is an event emitted when a part of the transaction fee
+// is refunded to the fee payer.
+// emitted_by: RefundFeeDecorator
+type EventGasRefund struct {
+	// address is the account which received the refund.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// amount is the total amount of coins refunded.
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// refunds is the breakdown of the refund per message.
+	Refunds []MessageGasRefund `protobuf:"bytes,3,rep,name=refunds,proto3" json:"refunds"`
+}
+
+func (m *EventGasRefund) Reset()         { *m = EventGasRefund{} }
+func (m *EventGasRefund) String() string { return proto.CompactTextString(m) }
+func (*EventGasRefund) ProtoMessage()    {}
+func (*EventGasRefund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e23fcddbe36854a4, []int{1}
+}
+func (m *EventGasRefund) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventGasRefund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventGasRefund.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventGasRefund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventGasRefund.Merge(m, src)
+}
+func (m *EventGasRefund) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventGasRefund) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventGasRefund.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventGasRefund proto.InternalMessageInfo
+
+func (m *EventGasRefund) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EventGasRefund) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *EventGasRefund) GetRefunds() []MessageGasRefund {
+	if m != nil {
+		return m.Refunds
+	}
+	return nil
+}
+
+// MessageGasRefund is the refund of a single message of a transaction.
+type MessageGasRefund struct {
+	// type of the sdk-message
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// gas_share is the share of the transaction gas attributed to the message.
+	GasShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=gas_share,json=gasShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"gas_share"`
+	// fraction is the refund fraction applied to the message.
+	Fraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=fraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fraction"`
+	// amount is the amount of coins refunded for the message.
+	Amount string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *MessageGasRefund) Reset()         { *m = MessageGasRefund{} }
+func (m *MessageGasRefund) String() string { return proto.CompactTextString(m) }
+func (*MessageGasRefund) ProtoMessage()    {}
+func (*MessageGasRefund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e23fcddbe36854a4, []int{2}
+}
+func (m *MessageGasRefund) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageGasRefund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageGasRefund.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MessageGasRefund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageGasRefund.Merge(m, src)
+}
+func (m *MessageGasRefund) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageGasRefund) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageGasRefund.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageGasRefund proto.InternalMessageInfo
+
+func (m *MessageGasRefund) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *MessageGasRefund) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventUpdateParams)(nil), "kyve.global.v1beta1.EventUpdateParams")
+	proto.RegisterType((*EventGasRefund)(nil), "kyve.global.v1beta1.EventGasRefund")
+	proto.RegisterType((*MessageGasRefund)(nil), "kyve.global.v1beta1.MessageGasRefund")
 }
 
 func init() { proto.RegisterFile("kyve/global/v1beta1/events.proto", fileDescriptor_e23fcddbe36854a4) }
 
 var fileDescriptor_e23fcddbe36854a4 = []byte{
-	// 252 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc8, 0xae, 0x2c, 0x4b,
-	0xd5, 0x4f, 0xcf, 0xc9, 0x4f, 0x4a, 0xcc, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x4f, 0x2d, 0x4b, 0xcd, 0x2b, 0x29, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x06, 0xa9,
-	0xd0, 0x83, 0xa8, 0xd0, 0x83, 0xaa, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xeb, 0x83,
-	0x58, 0x10, 0xa5, 0x52, 0x58, 0x0d, 0x83, 0xea, 0x04, 0xab, 0x50, 0xda, 0xc8, 0xc8, 0x25, 0xe8,
-	0x0a, 0x32, 0x3d, 0xb4, 0x20, 0x25, 0xb1, 0x24, 0x35, 0x20, 0xb1, 0x28, 0x31, 0xb7, 0x58, 0xc8,
-	0x81, 0x8b, 0x2b, 0x3f, 0x27, 0x25, 0xbe, 0x00, 0xcc, 0x93, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x36,
-	0x92, 0xd6, 0xc3, 0x62, 0xaf, 0x1e, 0x44, 0x83, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41, 0x9c,
-	0xf9, 0x39, 0x29, 0x08, 0x13, 0xf2, 0x52, 0xcb, 0x61, 0x26, 0x30, 0x11, 0x6d, 0x42, 0x5e, 0x6a,
-	0x39, 0xd4, 0x04, 0x09, 0x2e, 0xf6, 0x82, 0xc4, 0xca, 0x9c, 0xfc, 0xc4, 0x14, 0x09, 0x66, 0x05,
-	0x46, 0x0d, 0xce, 0x20, 0x18, 0xd7, 0xc9, 0xf5, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18,
-	0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5,
-	0x18, 0xa2, 0xb4, 0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0xbd, 0x23,
-	0xc3, 0x5c, 0xfd, 0x52, 0x4b, 0xca, 0xf3, 0x8b, 0xb2, 0xf5, 0x93, 0x33, 0x12, 0x33, 0xf3, 0xf4,
-	0x2b, 0x60, 0x21, 0x51, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x0e, 0x01, 0x63, 0x40, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xe5, 0x68, 0x7b, 0x75, 0x72, 0x01, 0x00, 0x00,
+	// 416 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xdf, 0x8a, 0xd3, 0x40,
+	0x14, 0xc6, 0x3b, 0xb6, 0xec, 0x6e, 0x66, 0x41, 0x74, 0x14, 0x09, 0xbb, 0x90, 0x2d, 0x15, 0xa1,
+	0x20, 0xcc, 0xb0, 0xeb, 0x03, 0xb8, 0x14, 0x8b, 0x17, 0xfe, 0x41, 0x22, 0x0a, 0x7a, 0xb3, 0x9c,
+	0x66, 0xce, 0x4e, 0x42, 0x93, 0x4c, 0xc8, 0x4c, 0x5b, 0xf3, 0x08, 0xde, 0xf9, 0x2a, 0x3e, 0x82,
+	0x77, 0x7b, 0xb9, 0x97, 0xe2, 0xc5, 0x22, 0xed, 0x8b, 0x48, 0x26, 0x89, 0x16, 0xe9, 0x85, 0xde,
+	0xcd, 0x47, 0xbe, 0xef, 0x77, 0xbe, 0x1c, 0x0e, 0x1d, 0xce, 0xab, 0x25, 0x0a, 0x95, 0xea, 0x19,
+	0xa4, 0x62, 0x79, 0x3a, 0x43, 0x0b, 0xa7, 0x02, 0x97, 0x98, 0x5b, 0xc3, 0x8b, 0x52, 0x5b, 0xcd,
+	0xee, 0xd5, 0x0e, 0xde, 0x38, 0x78, 0xeb, 0x38, 0xba, 0xaf, 0xb4, 0xd2, 0xee, 0xbb, 0xa8, 0x5f,
+	0x8d, 0xf5, 0x68, 0x27, 0xac, 0x4d, 0x3a, 0xc7, 0xe8, 0x2b, 0xa1, 0x77, 0xa7, 0x35, 0xfd, 0x5d,
+	0x21, 0xc1, 0xe2, 0x1b, 0x28, 0x21, 0x33, 0xec, 0x9c, 0x52, 0x9d, 0xca, 0x8b, 0xc2, 0x29, 0x9f,
+	0x0c, 0xc9, 0xf8, 0xf0, 0xec, 0x98, 0xef, 0x98, 0xcb, 0x9b, 0xc0, 0x64, 0x70, 0x75, 0x73, 0xd2,
+	0x0b, 0x3d, 0x9d, 0xca, 0x3f, 0x84, 0x1c, 0x57, 0x1d, 0xe1, 0xd6, 0x3f, 0x13, 0x72, 0x5c, 0xb5,
+	0x04, 0x9f, 0xee, 0x17, 0x50, 0xa5, 0x1a, 0xa4, 0xdf, 0x1f, 0x92, 0xb1, 0x17, 0x76, 0x72, 0xf4,
+	0x99, 0xd0, 0xdb, 0xae, 0xf3, 0x73, 0x30, 0x21, 0x5e, 0x2e, 0x72, 0x59, 0x9b, 0x41, 0xca, 0x12,
+	0x4d, 0xd3, 0xd6, 0x0b, 0x3b, 0xc9, 0x1e, 0xd0, 0x3d, 0xc8, 0xf4, 0x22, 0xb7, 0xae, 0x84, 0x17,
+	0xb6, 0x8a, 0x4d, 0xe9, 0x7e, 0xe9, 0xb2, 0xc6, 0xef, 0x0f, 0xfb, 0xe3, 0xc3, 0xb3, 0x47, 0x3b,
+	0xdb, 0xbd, 0x42, 0x63, 0x40, 0xe1, 0xef, 0x49, 0x6d, 0xcf, 0x2e, 0x3b, 0xfa, 0x46, 0xe8, 0x9d,
+	0xbf, 0x3d, 0x8c, 0xd1, 0x81, 0xad, 0x0a, 0x6c, 0xab, 0xb8, 0x37, 0x3b, 0xa7, 0x9e, 0x02, 0x73,
+	0x61, 0x62, 0x28, 0xb1, 0xa9, 0x32, 0x79, 0x58, 0xa3, 0x7e, 0xdc, 0x9c, 0x1c, 0x47, 0xda, 0x64,
+	0xda, 0x18, 0x39, 0xe7, 0x89, 0x16, 0x19, 0xd8, 0x98, 0xbf, 0x44, 0x05, 0x51, 0xf5, 0x0c, 0xa3,
+	0xf0, 0x40, 0x81, 0x79, 0x5b, 0x87, 0xd8, 0x53, 0x7a, 0x70, 0x59, 0x42, 0x64, 0x13, 0x9d, 0xfb,
+	0xfd, 0xff, 0x00, 0x74, 0xa1, 0xad, 0x55, 0x0c, 0xb6, 0x57, 0x31, 0x99, 0x5e, 0xad, 0x03, 0x72,
+	0xbd, 0x0e, 0xc8, 0xcf, 0x75, 0x40, 0xbe, 0x6c, 0x82, 0xde, 0xf5, 0x26, 0xe8, 0x7d, 0xdf, 0x04,
+	0xbd, 0x8f, 0x8f, 0x55, 0x62, 0xe3, 0xc5, 0x8c, 0x47, 0x3a, 0x13, 0x2f, 0x3e, 0xbc, 0x9f, 0xbe,
+	0x46, 0xbb, 0xd2, 0xe5, 0x5c, 0x44, 0x31, 0x24, 0xb9, 0xf8, 0xd4, 0x5d, 0x56, 0xfd, 0x83, 0x66,
+	0xb6, 0xe7, 0x2e, 0xea, 0xc9, 0xaf, 0x01, 0x00, 0x9c, 0x04, 0x20, 0xf9, 0xc2, 0x02, 0x00, 0x00,
 }
 
 func (m *EventUpdateParams) Marshal() (dAtA []byte, err error) {
@@ -164,6 +302,114 @@ func (m *EventUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventGasRefund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventGasRefund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventGasRefund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Refunds) > 0 {
+		for iNdEx := len(m.Refunds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Refunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MessageGasRefund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageGasRefund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageGasRefund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Fraction.Size()
+		i -= size
+		if _, err := m.Fraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.GasShare.Size()
+		i -= size
+		if _, err := m.GasShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -192,6 +438,50 @@ func (m *EventUpdateParams) Size() (n int) {
 	return n
 }
 
+func (m *EventGasRefund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Refunds) > 0 {
+		for _, e := range m.Refunds {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MessageGasRefund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.GasShare.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Fraction.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -346,6 +636,336 @@ func (m *EventUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventGasRefund) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventGasRefund: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventGasRefund: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Refunds = append(m.Refunds, MessageGasRefund{})
+			if err := m.Refunds[len(m.Refunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageGasRefund) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageGasRefund: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageGasRefund: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,20 +1,32 @@
 package types
 
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
 // NewGenesisState creates a new GenesisState object
-func NewGenesisState(params Params) *GenesisState {
+func NewGenesisState(params Params, baseFee math.LegacyDec) *GenesisState {
 	return &GenesisState{
-		Params: params,
+		Params:  params,
+		BaseFee: baseFee,
 	}
 }
 
 // DefaultGenesisState creates a default GenesisState object
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params: DefaultParams(),
+		Params:  DefaultParams(),
+		BaseFee: math.LegacyNewDec(0),
 	}
 }
 
 // ValidateGenesis validates the provided genesis state to ensure the expected invariants holds.
 func ValidateGenesis(data GenesisState) error {
+	if !data.BaseFee.IsNil() && data.BaseFee.IsNegative() {
+		return fmt.Errorf("base fee cannot be negative: %s", data.BaseFee)
+	}
+
 	return data.Params.Validate()
 }
@@ -37,8 +37,24 @@ type Params struct {
 	// gas_refunds lets the governance specify a fraction of how much gas
 	// a user gets refunded for a certain type of transaction.
 	// This could be used to make transactions which support to network cheaper.
-	// Gas refunds only work if the transaction only included one message.
+	// If a transaction includes multiple messages, each message is refunded
+	// according to its share of the consumed gas.
 	GasRefunds []GasRefund `protobuf:"bytes,4,rep,name=gas_refunds,json=gasRefunds,proto3" json:"gas_refunds"`
+	// fee_market configures the optional base fee, which is adjusted every block
+	// based on the block utilization and acts as a dynamic minimum gas price.
+	FeeMarket FeeMarket `protobuf:"bytes,5,opt,name=fee_market,json=feeMarket,proto3" json:"fee_market"`
+	// fee_denoms are the non-native denoms which can be used to pay transaction
+	// fees. The required amount is converted from the native min gas price with
+	// the coin weights of the x/funders coin whitelist.
+	FeeDenoms []string `protobuf:"bytes,6,rep,name=fee_denoms,json=feeDenoms,proto3" json:"fee_denoms,omitempty"`
+	// non_native_fee_destination is the address which receives all collected
+	// non-native fees. Those fees are not burned. If it is empty the fees are
+	// distributed like native fees.
+	NonNativeFeeDestination string `protobuf:"bytes,7,opt,name=non_native_fee_destination,json=nonNativeFeeDestination,proto3" json:"non_native_fee_destination,omitempty"`
+	// rate_limits lets the governance limit how many messages of a certain
+	// type a single signer can send within a window of blocks. Protocol
+	// messages sent by active pool accounts are exempt.
+	RateLimits []RateLimit `protobuf:"bytes,8,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -88,6 +104,34 @@ func (m *Params) GetGasRefunds() []GasRefund {
 	return nil
 }
 
+func (m *Params) GetFeeMarket() FeeMarket {
+	if m != nil {
+		return m.FeeMarket
+	}
+	return FeeMarket{}
+}
+
+func (m *Params) GetFeeDenoms() []string {
+	if m != nil {
+		return m.FeeDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetNonNativeFeeDestination() string {
+	if m != nil {
+		return m.NonNativeFeeDestination
+	}
+	return ""
+}
+
+func (m *Params) GetRateLimits() []RateLimit {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
 // GasAdjustment stores for every message type a fixed amount
 // of gas which is added to the message
 type GasAdjustment struct {
@@ -145,8 +189,10 @@ func (m *GasAdjustment) GetAmount() uint64 {
 }
 
 // GasRefund stores the fraction of gas which will be refunded for a given
-// type of message.
-// This only works if the transaction only includes one message.
+// type of message. If a transaction includes multiple messages, the fee is
+// split between the messages based on their share of the consumed gas.
+// Only successful transactions are refunded, the refund is paid by the post
+// handler which is not executed for failed transactions.
 type GasRefund struct {
 	// type of the sdk-message
 	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
@@ -194,40 +240,186 @@ func (m *GasRefund) GetType() string {
 	return ""
 }
 
+// RateLimit stores the maximum amount of messages of a given type which
+// can be sent by a single signer within a window of blocks.
+type RateLimit struct {
+	// type of the sdk-message
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// max_messages is the maximum amount of messages per signer and window
+	MaxMessages uint64 `protobuf:"varint,2,opt,name=max_messages,json=maxMessages,proto3" json:"max_messages,omitempty"`
+	// window is the length of the window in blocks
+	Window uint64 `protobuf:"varint,3,opt,name=window,proto3" json:"window,omitempty"`
+}
+
+func (m *RateLimit) Reset()         { *m = RateLimit{} }
+func (m *RateLimit) String() string { return proto.CompactTextString(m) }
+func (*RateLimit) ProtoMessage()    {}
+func (*RateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1b5d4c0bbdf8bfb, []int{3}
+}
+func (m *RateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimit.Merge(m, src)
+}
+func (m *RateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimit proto.InternalMessageInfo
+
+func (m *RateLimit) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *RateLimit) GetMaxMessages() uint64 {
+	if m != nil {
+		return m.MaxMessages
+	}
+	return 0
+}
+
+func (m *RateLimit) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+// FeeMarket stores the parameters of the base fee which is adjusted every
+// block depending on the gas consumed by the transactions of the block.
+type FeeMarket struct {
+	// enabled defines if the base fee is enforced.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// target_block_gas is the gas per block at which the base fee stays constant.
+	// If more gas is consumed the base fee increases, otherwise it decreases.
+	TargetBlockGas uint64 `protobuf:"varint,2,opt,name=target_block_gas,json=targetBlockGas,proto3" json:"target_block_gas,omitempty"`
+	// min_base_fee is the lower bound of the base fee per gas unit.
+	MinBaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=min_base_fee,json=minBaseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_base_fee"`
+	// max_base_fee is the upper bound of the base fee per gas unit.
+	MaxBaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=max_base_fee,json=maxBaseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_base_fee"`
+	// change_rate is the maximum fraction by which the base fee can change
+	// from one block to the next.
+	ChangeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=change_rate,json=changeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"change_rate"`
+}
+
+func (m *FeeMarket) Reset()         { *m = FeeMarket{} }
+func (m *FeeMarket) String() string { return proto.CompactTextString(m) }
+func (*FeeMarket) ProtoMessage()    {}
+func (*FeeMarket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1b5d4c0bbdf8bfb, []int{4}
+}
+func (m *FeeMarket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeMarket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeMarket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeMarket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeMarket.Merge(m, src)
+}
+func (m *FeeMarket) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeMarket) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeMarket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeMarket proto.InternalMessageInfo
+
+func (m *FeeMarket) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *FeeMarket) GetTargetBlockGas() uint64 {
+	if m != nil {
+		return m.TargetBlockGas
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "kyve.global.v1beta1.Params")
 	proto.RegisterType((*GasAdjustment)(nil), "kyve.global.v1beta1.GasAdjustment")
 	proto.RegisterType((*GasRefund)(nil), "kyve.global.v1beta1.GasRefund")
+	proto.RegisterType((*RateLimit)(nil), "kyve.global.v1beta1.RateLimit")
+	proto.RegisterType((*FeeMarket)(nil), "kyve.global.v1beta1.FeeMarket")
 }
 
 func init() { proto.RegisterFile("kyve/global/v1beta1/global.proto", fileDescriptor_d1b5d4c0bbdf8bfb) }
 
 var fileDescriptor_d1b5d4c0bbdf8bfb = []byte{
-	// 382 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x4f, 0x6b, 0xe2, 0x40,
-	0x18, 0xc6, 0x13, 0x0d, 0xb2, 0x8e, 0xb8, 0x0b, 0xb3, 0xcb, 0x12, 0x76, 0x21, 0x4a, 0xf6, 0x22,
-	0x2c, 0x24, 0xb8, 0x7b, 0xdc, 0xc3, 0xd2, 0x50, 0xf1, 0xd0, 0x52, 0x6c, 0x0e, 0x85, 0xf6, 0x62,
-	0x27, 0x71, 0x1c, 0xa7, 0x3a, 0x33, 0x32, 0x33, 0xb1, 0xf5, 0x5b, 0xf4, 0x9b, 0xf4, 0x6b, 0x78,
-	0xf4, 0x58, 0x7a, 0x90, 0xa2, 0x5f, 0xa4, 0x4c, 0x1a, 0xa5, 0x05, 0x0b, 0xed, 0x6d, 0xde, 0x77,
-	0x9e, 0xe7, 0xf7, 0xfe, 0xe1, 0x05, 0xcd, 0xf1, 0x7c, 0x86, 0x43, 0x32, 0x11, 0x09, 0x9a, 0x84,
-	0xb3, 0x76, 0x82, 0x35, 0x6a, 0x17, 0x61, 0x30, 0x95, 0x42, 0x0b, 0xf8, 0xd5, 0x28, 0x82, 0x22,
-	0x55, 0x28, 0x7e, 0x7c, 0x23, 0x82, 0x88, 0xfc, 0x3f, 0x34, 0xaf, 0x67, 0xa9, 0x7f, 0x57, 0x02,
-	0x95, 0x1e, 0x92, 0x88, 0x29, 0xd8, 0x05, 0x75, 0x46, 0x79, 0x9f, 0x20, 0xd5, 0x9f, 0x4a, 0x9a,
-	0x62, 0xd7, 0x6e, 0xda, 0xad, 0x6a, 0xf4, 0x6b, 0xb1, 0x6a, 0x58, 0x0f, 0xab, 0xc6, 0xcf, 0x54,
-	0x28, 0x26, 0x94, 0x1a, 0x8c, 0x03, 0x2a, 0x42, 0x86, 0xf4, 0x28, 0x38, 0xc6, 0x04, 0xa5, 0xf3,
-	0x43, 0x9c, 0xc6, 0x35, 0x46, 0x79, 0x17, 0xa9, 0x9e, 0xf1, 0xc1, 0x08, 0x80, 0x24, 0x93, 0xbc,
-	0x2f, 0x91, 0xa6, 0xc2, 0x2d, 0xbd, 0x9f, 0x52, 0x35, 0xb6, 0xd8, 0xb8, 0xe0, 0x29, 0xf8, 0x62,
-	0x1a, 0x41, 0x83, 0xab, 0x4c, 0x69, 0x86, 0xb9, 0x56, 0x6e, 0xb9, 0x59, 0x6e, 0xd5, 0xfe, 0xf8,
-	0xc1, 0x9e, 0xe1, 0x82, 0x2e, 0x52, 0x07, 0x3b, 0x69, 0xe4, 0x98, 0x62, 0xf1, 0x67, 0xf2, 0x32,
-	0xa9, 0x60, 0x07, 0xd4, 0x0c, 0x52, 0xe2, 0x61, 0xc6, 0x07, 0xca, 0x75, 0x72, 0x9c, 0xf7, 0x16,
-	0x2e, 0xce, 0x65, 0x05, 0x0a, 0x90, 0x6d, 0x42, 0xf9, 0xff, 0x40, 0xfd, 0x55, 0x35, 0x08, 0x81,
-	0xa3, 0xe7, 0xd3, 0x62, 0x5d, 0x71, 0xfe, 0x86, 0xdf, 0x41, 0x05, 0x31, 0x91, 0x71, 0x9d, 0x8f,
-	0xef, 0xc4, 0x45, 0xe4, 0x5f, 0x82, 0xea, 0x8e, 0xbd, 0xd7, 0xf8, 0x1f, 0x7c, 0x1a, 0x4a, 0x94,
-	0x6a, 0x2a, 0xf8, 0x47, 0x36, 0xb7, 0x33, 0x45, 0x9d, 0xc5, 0xda, 0xb3, 0x97, 0x6b, 0xcf, 0x7e,
-	0x5c, 0x7b, 0xf6, 0xed, 0xc6, 0xb3, 0x96, 0x1b, 0xcf, 0xba, 0xdf, 0x78, 0xd6, 0xc5, 0x6f, 0x42,
-	0xf5, 0x28, 0x4b, 0x82, 0x54, 0xb0, 0xf0, 0xe8, 0xfc, 0xac, 0x73, 0x82, 0xf5, 0xb5, 0x90, 0xe3,
-	0x30, 0x1d, 0x21, 0xca, 0xc3, 0x9b, 0xed, 0x45, 0x99, 0x36, 0x54, 0x52, 0xc9, 0xcf, 0xe3, 0xef,
-	0x53, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1b, 0x5d, 0xec, 0x6f, 0x6d, 0x02, 0x00, 0x00,
+	// 610 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x4e, 0x1b, 0x3d,
+	0x14, 0x4d, 0x48, 0x08, 0x8c, 0x03, 0x7c, 0x9f, 0xdc, 0xaa, 0x1d, 0x51, 0x75, 0x48, 0xd3, 0x4d,
+	0xa4, 0x4a, 0x33, 0xa2, 0x5d, 0xb2, 0xa8, 0x9a, 0x02, 0x59, 0x14, 0x10, 0x9d, 0x45, 0xa5, 0xb2,
+	0x99, 0xde, 0x99, 0xdc, 0x4c, 0xdc, 0xc4, 0x36, 0x1a, 0x3b, 0x10, 0xde, 0xa2, 0xbb, 0x3e, 0x40,
+	0x5f, 0x86, 0x25, 0xcb, 0xaa, 0x0b, 0x54, 0xc1, 0x8b, 0x54, 0xf6, 0xfc, 0xb4, 0x95, 0xa0, 0x82,
+	0x9d, 0xef, 0xf1, 0x39, 0xc7, 0xf7, 0xc7, 0x36, 0xe9, 0x4c, 0xce, 0x4e, 0x30, 0x48, 0xa7, 0x32,
+	0x86, 0x69, 0x70, 0xb2, 0x19, 0xa3, 0x86, 0xcd, 0x22, 0xf4, 0x8f, 0x33, 0xa9, 0x25, 0x7d, 0x60,
+	0x18, 0x7e, 0x01, 0x15, 0x8c, 0xf5, 0x87, 0xa9, 0x4c, 0xa5, 0xdd, 0x0f, 0xcc, 0x2a, 0xa7, 0x76,
+	0xbf, 0x36, 0x49, 0xeb, 0x10, 0x32, 0xe0, 0x8a, 0x0e, 0xc8, 0x2a, 0x67, 0x22, 0x4a, 0x41, 0x45,
+	0xc7, 0x19, 0x4b, 0xd0, 0xad, 0x77, 0xea, 0x3d, 0xa7, 0xff, 0xfc, 0xfc, 0x72, 0xa3, 0xf6, 0xe3,
+	0x72, 0xe3, 0x49, 0x22, 0x15, 0x97, 0x4a, 0x0d, 0x27, 0x3e, 0x93, 0x01, 0x07, 0x3d, 0xf6, 0xf7,
+	0x30, 0x85, 0xe4, 0x6c, 0x1b, 0x93, 0xb0, 0xcd, 0x99, 0x18, 0x80, 0x3a, 0x34, 0x3a, 0xda, 0x27,
+	0x24, 0x9e, 0x65, 0x22, 0xca, 0x40, 0x33, 0xe9, 0x2e, 0xdc, 0xdd, 0xc5, 0x31, 0xb2, 0xd0, 0xa8,
+	0xe8, 0x7b, 0xf2, 0x9f, 0x49, 0x04, 0x86, 0x9f, 0x67, 0x4a, 0x73, 0x14, 0x5a, 0xb9, 0x8d, 0x4e,
+	0xa3, 0xd7, 0x7e, 0xd9, 0xf5, 0x6f, 0x28, 0xce, 0x1f, 0x80, 0x7a, 0x53, 0x51, 0xfb, 0x4d, 0x73,
+	0x58, 0xb8, 0x96, 0xfe, 0x09, 0x2a, 0xba, 0x43, 0xda, 0xc6, 0x32, 0xc3, 0xd1, 0x4c, 0x0c, 0x95,
+	0xdb, 0xb4, 0x76, 0xde, 0x6d, 0x76, 0xa1, 0xa5, 0x15, 0x56, 0x24, 0x2d, 0x01, 0x45, 0xdf, 0x12,
+	0x32, 0x42, 0x8c, 0x38, 0x64, 0x13, 0xd4, 0xee, 0x62, 0xa7, 0x7e, 0xab, 0xcb, 0x2e, 0xe2, 0xbe,
+	0x65, 0x15, 0x2e, 0xce, 0xa8, 0x04, 0xe8, 0xd3, 0xdc, 0x64, 0x88, 0x42, 0x72, 0xe5, 0xb6, 0x3a,
+	0x8d, 0x9e, 0x63, 0xb7, 0xb7, 0x2d, 0x40, 0xb7, 0xc8, 0xba, 0x90, 0x22, 0x12, 0xa0, 0xd9, 0x09,
+	0x46, 0x39, 0x53, 0x69, 0x66, 0x62, 0x29, 0xdc, 0x25, 0xd3, 0xd1, 0xf0, 0xb1, 0x90, 0xe2, 0xc0,
+	0x12, 0x76, 0x8d, 0xae, 0xda, 0x36, 0x75, 0x66, 0xa0, 0x31, 0x9a, 0x32, 0xce, 0xb4, 0x72, 0x97,
+	0xff, 0x51, 0x67, 0x08, 0x1a, 0xf7, 0x0c, 0xad, 0xac, 0x33, 0x2b, 0x01, 0xd5, 0xdd, 0x22, 0xab,
+	0x7f, 0x75, 0x95, 0x52, 0xd2, 0xd4, 0x67, 0xc7, 0xc5, 0xb5, 0x08, 0xed, 0x9a, 0x3e, 0x22, 0x2d,
+	0xe0, 0x72, 0x26, 0xb4, 0x1d, 0x73, 0x33, 0x2c, 0xa2, 0xee, 0x27, 0xe2, 0x54, 0x3d, 0xbc, 0x51,
+	0xf8, 0x9a, 0x2c, 0x8f, 0x32, 0x48, 0x6c, 0x3d, 0xf7, 0xb8, 0x21, 0x95, 0xa8, 0x7b, 0x44, 0x9c,
+	0x2a, 0xfb, 0x1b, 0x4f, 0x78, 0x46, 0x56, 0x38, 0xcc, 0x23, 0x8e, 0x4a, 0x41, 0x8a, 0xaa, 0x48,
+	0xb0, 0xcd, 0x61, 0xbe, 0x5f, 0x40, 0x26, 0xfb, 0x53, 0x26, 0x86, 0xf2, 0xd4, 0x6d, 0xe4, 0xd9,
+	0xe7, 0x51, 0xf7, 0xdb, 0x02, 0x71, 0xaa, 0xe1, 0x51, 0x97, 0x2c, 0xa1, 0x80, 0x78, 0x8a, 0x43,
+	0xeb, 0xbf, 0x1c, 0x96, 0x21, 0xed, 0x91, 0xff, 0x35, 0x64, 0x29, 0xea, 0x28, 0x9e, 0xca, 0x64,
+	0x62, 0x9e, 0x4e, 0x71, 0xcc, 0x5a, 0x8e, 0xf7, 0x0d, 0x3c, 0x00, 0x73, 0xf7, 0x56, 0xcc, 0xdb,
+	0x8a, 0x41, 0xd9, 0x71, 0xba, 0x8d, 0xbb, 0x97, 0x4c, 0x38, 0x13, 0x7d, 0x50, 0x66, 0xca, 0xd6,
+	0x06, 0xe6, 0xbf, 0x6d, 0x9a, 0xf7, 0xb1, 0x81, 0x79, 0x69, 0xb3, 0x4d, 0xda, 0xc9, 0x18, 0x44,
+	0x8a, 0xe6, 0x89, 0xa2, 0xbb, 0x78, 0x0f, 0x97, 0x5c, 0x67, 0x3a, 0xdf, 0xdf, 0x39, 0xbf, 0xf2,
+	0xea, 0x17, 0x57, 0x5e, 0xfd, 0xe7, 0x95, 0x57, 0xff, 0x72, 0xed, 0xd5, 0x2e, 0xae, 0xbd, 0xda,
+	0xf7, 0x6b, 0xaf, 0x76, 0xf4, 0x22, 0x65, 0x7a, 0x3c, 0x8b, 0xfd, 0x44, 0xf2, 0xe0, 0xdd, 0xc7,
+	0x0f, 0x3b, 0x07, 0xa8, 0x4f, 0x65, 0x36, 0x09, 0x92, 0x31, 0x30, 0x11, 0xcc, 0xcb, 0xbf, 0xcb,
+	0x8c, 0x49, 0xc5, 0x2d, 0xfb, 0x11, 0xbd, 0xfa, 0x35, 0x00, 0x0c, 0xae, 0xe0, 0x66, 0xd7, 0x04,
+	0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -250,6 +442,46 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGlobal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.NonNativeFeeDestination) > 0 {
+		i -= len(m.NonNativeFeeDestination)
+		copy(dAtA[i:], m.NonNativeFeeDestination)
+		i = encodeVarintGlobal(dAtA, i, uint64(len(m.NonNativeFeeDestination)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.FeeDenoms) > 0 {
+		for iNdEx := len(m.FeeDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FeeDenoms[iNdEx])
+			copy(dAtA[i:], m.FeeDenoms[iNdEx])
+			i = encodeVarintGlobal(dAtA, i, uint64(len(m.FeeDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size, err := m.FeeMarket.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGlobal(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	if len(m.GasRefunds) > 0 {
 		for iNdEx := len(m.GasRefunds) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -376,6 +608,114 @@ func (m *GasRefund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Window != 0 {
+		i = encodeVarintGlobal(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxMessages != 0 {
+		i = encodeVarintGlobal(dAtA, i, uint64(m.MaxMessages))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintGlobal(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeMarket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeMarket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ChangeRate.Size()
+		i -= size
+		if _, err := m.ChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGlobal(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxBaseFee.Size()
+		i -= size
+		if _, err := m.MaxBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGlobal(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MinBaseFee.Size()
+		i -= size
+		if _, err := m.MinBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGlobal(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.TargetBlockGas != 0 {
+		i = encodeVarintGlobal(dAtA, i, uint64(m.TargetBlockGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGlobal(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGlobal(v)
 	base := offset
@@ -409,6 +749,24 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovGlobal(uint64(l))
 		}
 	}
+	l = m.FeeMarket.Size()
+	n += 1 + l + sovGlobal(uint64(l))
+	if len(m.FeeDenoms) > 0 {
+		for _, s := range m.FeeDenoms {
+			l = len(s)
+			n += 1 + l + sovGlobal(uint64(l))
+		}
+	}
+	l = len(m.NonNativeFeeDestination)
+	if l > 0 {
+		n += 1 + l + sovGlobal(uint64(l))
+	}
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovGlobal(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -443,6 +801,46 @@ func (m *GasRefund) Size() (n int) {
 	return n
 }
 
+func (m *RateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovGlobal(uint64(l))
+	}
+	if m.MaxMessages != 0 {
+		n += 1 + sovGlobal(uint64(m.MaxMessages))
+	}
+	if m.Window != 0 {
+		n += 1 + sovGlobal(uint64(m.Window))
+	}
+	return n
+}
+
+func (m *FeeMarket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if m.TargetBlockGas != 0 {
+		n += 1 + sovGlobal(uint64(m.TargetBlockGas))
+	}
+	l = m.MinBaseFee.Size()
+	n += 1 + l + sovGlobal(uint64(l))
+	l = m.MaxBaseFee.Size()
+	n += 1 + l + sovGlobal(uint64(l))
+	l = m.ChangeRate.Size()
+	n += 1 + l + sovGlobal(uint64(l))
+	return n
+}
+
 func sovGlobal(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -614,17 +1012,148 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGlobal(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeMarket", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGlobal
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGlobal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthGlobal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGlobal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeMarket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGlobal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGlobal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGlobal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenoms = append(m.FeeDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonNativeFeeDestination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGlobal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGlobal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGlobal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NonNativeFeeDestination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGlobal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGlobal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGlobal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGlobal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGlobal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -852,6 +1381,317 @@ func (m *GasRefund) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGlobal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGlobal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGlobal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGlobal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMessages", wireType)
+			}
+			m.MaxMessages = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGlobal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMessages |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGlobal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGlobal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGlobal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeMarket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGlobal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeMarket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeMarket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGlobal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockGas", wireType)
+			}
+			m.TargetBlockGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGlobal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetBlockGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGlobal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGlobal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGlobal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGlobal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGlobal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGlobal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGlobal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGlobal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGlobal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGlobal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGlobal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGlobal(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
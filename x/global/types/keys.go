@@ -12,6 +12,28 @@ const (
 
 	// MemStoreKey defines the in-memory store key
 	MemStoreKey = "mem_global"
+
+	// TStoreKey defines the transient store key
+	TStoreKey = "transient_global"
+)
+
+var (
+	ParamsKey = []byte{0x00}
+
+	// BaseFeeKey stores the current base fee per gas unit of the fee market.
+	BaseFeeKey = []byte{0x01}
+	// BlockBaseFeesKey stores the base fees paid by the transactions of the current block.
+	BlockBaseFeesKey = []byte{0x03}
+	// RateLimitKeyPrefix stores the message counts of the rate limits.
+	// key: RateLimitKeyPrefix | windowEnd | signer | type
+	RateLimitKeyPrefix = []byte{0x04}
 )
 
-var ParamsKey = []byte{0x00}
+// Transient store
+
+var (
+	// MsgGasKeyPrefix stores the gas consumed by the current transaction before
+	// each of its messages was executed.
+	// key: MsgGasKeyPrefix | index
+	MsgGasKeyPrefix = []byte{0x00}
+)
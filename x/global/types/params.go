@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // DefaultMinGasPrice is 0 (i.e. disabled)
@@ -12,19 +13,32 @@ var DefaultMinGasPrice = math.LegacyNewDec(0)
 // DefaultBurnRatio is 0% (i.e. disabled)
 var DefaultBurnRatio = math.LegacyNewDec(0)
 
+// DefaultFeeMarket is disabled
+var DefaultFeeMarket = FeeMarket{
+	Enabled:        false,
+	TargetBlockGas: 0,
+	MinBaseFee:     math.LegacyNewDec(0),
+	MaxBaseFee:     math.LegacyNewDec(0),
+	ChangeRate:     math.LegacyNewDecWithPrec(125, 3),
+}
+
 // NewParams creates a new Params instance
-func NewParams(minGasPrice math.LegacyDec, burnRatio math.LegacyDec, gasAdjustments []GasAdjustment, gasRefunds []GasRefund) Params {
+func NewParams(minGasPrice math.LegacyDec, burnRatio math.LegacyDec, gasAdjustments []GasAdjustment, gasRefunds []GasRefund, feeMarket FeeMarket, feeDenoms []string, nonNativeFeeDestination string, rateLimits []RateLimit) Params {
 	return Params{
-		MinGasPrice:    minGasPrice,
-		BurnRatio:      burnRatio,
-		GasAdjustments: gasAdjustments,
-		GasRefunds:     gasRefunds,
+		MinGasPrice:             minGasPrice,
+		BurnRatio:               burnRatio,
+		GasAdjustments:          gasAdjustments,
+		GasRefunds:              gasRefunds,
+		FeeMarket:               feeMarket,
+		FeeDenoms:               feeDenoms,
+		NonNativeFeeDestination: nonNativeFeeDestination,
+		RateLimits:              rateLimits,
 	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams(DefaultMinGasPrice, DefaultBurnRatio, []GasAdjustment{}, []GasRefund{})
+	return NewParams(DefaultMinGasPrice, DefaultBurnRatio, []GasAdjustment{}, []GasRefund{}, DefaultFeeMarket, []string{}, "", []RateLimit{})
 }
 
 // Validate validates the set of params
@@ -49,6 +63,22 @@ func (p Params) Validate() error {
 		}
 	}
 
+	if err := validateFeeMarket(p.FeeMarket); err != nil {
+		return err
+	}
+
+	if err := validateFeeDenoms(p.FeeDenoms); err != nil {
+		return err
+	}
+
+	if err := validateNonNativeFeeDestination(p.NonNativeFeeDestination); err != nil {
+		return err
+	}
+
+	if err := validateRateLimits(p.RateLimits); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -137,3 +167,122 @@ func validateGasRefund(i interface{}) error {
 
 	return nil
 }
+
+// validateFeeMarket ...
+func validateFeeMarket(i interface{}) error {
+	v, ok := i.(FeeMarket)
+
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// the fee market is only validated if it is enabled, since the parameters
+	// are not set in chains which never configured a fee market
+	if !v.Enabled {
+		return nil
+	}
+
+	if v.TargetBlockGas == 0 {
+		return fmt.Errorf("target block gas cannot be zero")
+	}
+
+	if v.MinBaseFee.IsNil() || v.MaxBaseFee.IsNil() || v.ChangeRate.IsNil() {
+		return fmt.Errorf("invalid parameter: nil")
+	}
+
+	// the base fee changes by a fraction of itself, so it can never grow again once it is zero
+	if !v.MinBaseFee.IsPositive() {
+		return fmt.Errorf("min base fee has to be positive: %s", v.MinBaseFee)
+	}
+
+	if v.MaxBaseFee.LT(v.MinBaseFee) {
+		return fmt.Errorf("max base fee cannot be lower than min base fee: %s", v.MaxBaseFee)
+	}
+
+	if !v.ChangeRate.IsPositive() {
+		return fmt.Errorf("change rate has to be positive: %s", v.ChangeRate)
+	}
+
+	if v.ChangeRate.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("value cannot be greater than 1: %s", v.ChangeRate)
+	}
+
+	return nil
+}
+
+// validateFeeDenoms ...
+func validateFeeDenoms(i interface{}) error {
+	v, ok := i.([]string)
+
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	denoms := make(map[string]bool)
+	for _, denom := range v {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+
+		if denom == Denom {
+			return fmt.Errorf("native denom cannot be a fee denom: %s", denom)
+		}
+
+		if denoms[denom] {
+			return fmt.Errorf("duplicate fee denom: %s", denom)
+		}
+		denoms[denom] = true
+	}
+
+	return nil
+}
+
+// validateNonNativeFeeDestination ...
+func validateNonNativeFeeDestination(i interface{}) error {
+	v, ok := i.(string)
+
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == "" {
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(v); err != nil {
+		return fmt.Errorf("invalid non-native fee destination: %w", err)
+	}
+
+	return nil
+}
+
+// validateRateLimits ...
+func validateRateLimits(i interface{}) error {
+	v, ok := i.([]RateLimit)
+
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	types := make(map[string]bool)
+	for _, rateLimit := range v {
+		if rateLimit.Type == "" {
+			return fmt.Errorf("rate limit type cannot be empty")
+		}
+
+		if rateLimit.MaxMessages == 0 {
+			return fmt.Errorf("max messages of rate limit cannot be zero: %s", rateLimit.Type)
+		}
+
+		if rateLimit.Window == 0 {
+			return fmt.Errorf("window of rate limit cannot be zero: %s", rateLimit.Type)
+		}
+
+		if types[rateLimit.Type] {
+			return fmt.Errorf("duplicate rate limit: %s", rateLimit.Type)
+		}
+		types[rateLimit.Type] = true
+	}
+
+	return nil
+}
@@ -1,3 +1,9 @@
 package types
 
 var Denom = "tkyve"
+
+// MsgGas is the gas a transaction consumed before a message was executed.
+type MsgGas struct {
+	Type        string
+	GasConsumed uint64
+}
@@ -12,8 +12,12 @@ import (
 
 	// Auth
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	// Authz
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	// FeeGrant
 	feeGrantKeeper "cosmossdk.io/x/feegrant/keeper"
+	// Funders
+	fundersKeeper "github.com/KYVENetwork/chain/x/funders/keeper"
 	// Global
 	"github.com/KYVENetwork/chain/x/global/keeper"
 	// Staking
@@ -38,17 +42,160 @@ func GetFeeAccount(ctx sdk.Context, tx sdk.FeeTx, feeGrantKeeper feeGrantKeeper.
 	return account, nil
 }
 
+// getNestedMsgs returns the messages which are executed by the given message,
+// which are the messages of an authz MsgExec.
+func getNestedMsgs(msg sdk.Msg) []sdk.Msg {
+	execMsg, ok := msg.(*authz.MsgExec)
+	if !ok {
+		return nil
+	}
+
+	msgs, err := execMsg.GetMessages()
+	if err != nil {
+		return nil
+	}
+
+	return msgs
+}
+
+// getAllMsgs returns the given messages and all messages they execute in the
+// order the message router executes them.
+func getAllMsgs(msgs []sdk.Msg) []sdk.Msg {
+	allMsgs := make([]sdk.Msg, 0, len(msgs))
+	for _, msg := range msgs {
+		allMsgs = append(allMsgs, msg)
+		allMsgs = append(allMsgs, getAllMsgs(getNestedMsgs(msg))...)
+	}
+
+	return allMsgs
+}
+
+// getMsgTypes returns the types of the given message and of all messages it
+// executes in the order the message router executes them.
+func getMsgTypes(msg sdk.Msg) []string {
+	msgTypes := make([]string, 0)
+	for _, nestedMsg := range getAllMsgs([]sdk.Msg{msg}) {
+		msgTypes = append(msgTypes, sdk.MsgTypeURL(nestedMsg))
+	}
+
+	return msgTypes
+}
+
+// feeDenomWeight is the coin weight and the decimals of a denom in the x/funders
+// coin whitelist, which determine the value of the denom in the native denom.
+type feeDenomWeight struct {
+	weight   sdkmath.LegacyDec
+	decimals uint32
+}
+
+// getFeeDenomWeights returns the weight of the native denom and of every fee denom
+// which is whitelisted in x/funders with a positive coin weight. Fee denoms can
+// only be converted if the native denom has a weight as well.
+func getFeeDenomWeights(ctx sdk.Context, fk keeper.Keeper, fuk fundersKeeper.Keeper, bondDenom string) map[string]feeDenomWeight {
+	weights := make(map[string]feeDenomWeight)
+	whitelist := fuk.GetCoinWhitelistMap(ctx)
+
+	for _, denom := range append([]string{bondDenom}, fk.GetFeeDenoms(ctx)...) {
+		entry, found := whitelist[denom]
+		if !found {
+			continue
+		}
+
+		weight := fuk.GetCoinWeight(ctx, entry)
+		if !weight.IsPositive() {
+			continue
+		}
+
+		weights[denom] = feeDenomWeight{weight: weight, decimals: entry.CoinDecimals}
+	}
+
+	return weights
+}
+
+// GetConsensusMinGasPrices returns the min gas price of the native denom and of
+// every fee denom. The min gas price of a fee denom is converted from the native
+// min gas price with the coin weights and decimals of the x/funders coin whitelist.
+// Fee denoms which are not whitelisted in x/funders can not be used to pay fees.
+func GetConsensusMinGasPrices(ctx sdk.Context, fk keeper.Keeper, fuk fundersKeeper.Keeper, bondDenom string) sdk.DecCoins {
+	minGasPrice := fk.GetConsensusMinGasPrice(ctx)
+	minGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec(bondDenom, minGasPrice))
+
+	feeDenoms := fk.GetFeeDenoms(ctx)
+	if len(feeDenoms) == 0 || !minGasPrice.IsPositive() {
+		return minGasPrices
+	}
+
+	weights := getFeeDenomWeights(ctx, fk, fuk, bondDenom)
+
+	native, found := weights[bondDenom]
+	if !found {
+		return minGasPrices
+	}
+
+	for _, denom := range feeDenoms {
+		fee, found := weights[denom]
+		if !found || denom == bondDenom {
+			continue
+		}
+
+		// convert the value of the native min gas price into the fee denom
+		price := minGasPrice.
+			Mul(native.weight).
+			Mul(sdkmath.LegacyNewDec(10).Power(uint64(fee.decimals))).
+			Quo(fee.weight).
+			Quo(sdkmath.LegacyNewDec(10).Power(uint64(native.decimals)))
+
+		minGasPrices = minGasPrices.Add(sdk.NewDecCoinFromDec(denom, price))
+	}
+
+	return minGasPrices
+}
+
+// getNativeFeeValue returns the value of the fee in the native denom. Fee denoms
+// are converted with the same coin weights and decimals as their min gas prices,
+// coins which can not be used to pay fees have no value.
+func getNativeFeeValue(ctx sdk.Context, fk keeper.Keeper, fuk fundersKeeper.Keeper, bondDenom string, fee sdk.Coins) sdkmath.LegacyDec {
+	value := sdkmath.LegacyNewDecFromInt(fee.AmountOf(bondDenom))
+
+	weights := getFeeDenomWeights(ctx, fk, fuk, bondDenom)
+
+	native, found := weights[bondDenom]
+	if !found {
+		return value
+	}
+
+	for _, coin := range fee {
+		weight, found := weights[coin.Denom]
+		if !found || coin.Denom == bondDenom {
+			continue
+		}
+
+		// convert the value of the fee coin into the native denom
+		coinValue := sdkmath.LegacyNewDecFromInt(coin.Amount).
+			Mul(weight.weight).
+			Mul(sdkmath.LegacyNewDec(10).Power(uint64(native.decimals))).
+			Quo(native.weight).
+			Quo(sdkmath.LegacyNewDec(10).Power(uint64(weight.decimals)))
+
+		value = value.Add(coinValue)
+	}
+
+	return value
+}
+
 // BuildTxFeeChecker ensures that the configured minimum gas price is met.
+// If the fee market is enabled the base fee has to be met as well. Fees can be
+// paid in the native denom or in any of the fee denoms.
 // In contrast to
 // https://github.com/cosmos/cosmos-sdk/blob/release/v0.46.x/x/auth/ante/validator_tx_fee.go#L12
 // this code runs within the consensus layer.
-func BuildTxFeeChecker(ctx sdk.Context, fk keeper.Keeper, sk *stakingKeeper.Keeper) ante.TxFeeChecker {
+func BuildTxFeeChecker(ctx sdk.Context, fk keeper.Keeper, sk *stakingKeeper.Keeper, fuk fundersKeeper.Keeper) ante.TxFeeChecker {
 	return func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
 		bondDenom, err := sk.BondDenom(ctx)
 		if err != nil {
 			return nil, 0, sdkErrors.Wrap(errorsTypes.ErrNotFound, "failed to get bond denom")
 		}
-		consensusMinGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec(bondDenom, fk.GetMinGasPrice(ctx)))
+		consensusMinGasPrices := GetConsensusMinGasPrices(ctx, fk, fuk, bondDenom)
 
 		feeTx, ok := tx.(sdk.FeeTx)
 		if !ok {
@@ -84,7 +231,7 @@ func BuildTxFeeChecker(ctx sdk.Context, fk keeper.Keeper, sk *stakingKeeper.Keep
 			return nil, 0, sdkErrors.Wrapf(errorsTypes.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, requiredFees)
 		}
 
-		priority := getTxPriority(feeCoins, int64(gas))
+		priority := getTxPriority(getNativeFeeValue(ctx, fk, fuk, bondDenom, feeCoins), int64(gas))
 		return feeCoins, priority, nil
 	}
 }
@@ -92,19 +239,17 @@ func BuildTxFeeChecker(ctx sdk.Context, fk keeper.Keeper, sk *stakingKeeper.Keep
 // https://github.com/cosmos/cosmos-sdk/blob/release/v0.46.x/x/auth/ante/validator_tx_fee.go#L51
 // As the default DeductFeeDecorator is overwritten, this is the place to add a custom priority.
 // Although this is calculated within "consensus-code" the priority itself gets only used
-// for mem-pool ordering.
-func getTxPriority(fee sdk.Coins, gas int64) int64 {
-	var priority int64
-	for _, c := range fee {
-		p := int64(math.MaxInt64)
-		gasPrice := c.Amount.QuoRaw(gas)
-		if gasPrice.IsInt64() {
-			p = gasPrice.Int64()
-		}
-		if priority == 0 || p < priority {
-			priority = p
-		}
+// for mem-pool ordering. The priority is the gas price of the fee in the native denom, so
+// fees paid in different denoms are ordered by their value.
+func getTxPriority(nativeFeeValue sdkmath.LegacyDec, gas int64) int64 {
+	if gas <= 0 {
+		return 0
+	}
+
+	gasPrice := nativeFeeValue.QuoInt64(gas).TruncateInt()
+	if !gasPrice.IsInt64() {
+		return math.MaxInt64
 	}
 
-	return priority
+	return gasPrice.Int64()
 }
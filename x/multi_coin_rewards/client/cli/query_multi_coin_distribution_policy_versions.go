@@ -0,0 +1,35 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/KYVENetwork/chain/x/multi_coin_rewards/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdQueryMultiCoinDistributionPolicyVersions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multi-coin-distribution-policy-versions",
+		Short: "shows all versions of the distribution policy together with their activation time",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MultiCoinDistributionPolicyVersions(context.Background(), &types.QueryMultiCoinDistributionPolicyVersionsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
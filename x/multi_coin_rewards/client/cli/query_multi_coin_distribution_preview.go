@@ -0,0 +1,51 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+
+	"github.com/KYVENetwork/chain/x/multi_coin_rewards/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdQueryMultiCoinDistributionPreview() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multi-coin-distribution-preview [path-to-json-file]",
+		Short: "shows which pools would receive which coins of the current redistribution balance under the given or the active policy",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			request := &types.QueryMultiCoinDistributionPreviewRequest{}
+			if len(args) == 1 {
+				file, err := os.ReadFile(args[0])
+				if err != nil {
+					return err
+				}
+
+				policy := &types.MultiCoinDistributionPolicy{}
+				if err := json.Unmarshal(file, policy); err != nil {
+					return err
+				}
+				request.Policy = policy
+			}
+
+			res, err := queryClient.MultiCoinDistributionPreview(context.Background(), request)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,37 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/KYVENetwork/chain/x/multi_coin_rewards/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdQueryPendingMultiCoinRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-multi-coin-rewards [address]",
+		Short: "shows all pending multi-coin rewards of an address together with their expiry time",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PendingMultiCoinRewards(context.Background(), &types.QueryPendingMultiCoinRewardsRequest{
+				Address: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -22,6 +22,9 @@ func GetTxCmd() *cobra.Command {
 
 	cmd.AddCommand(CmdToggleMultiCoinRewards())
 	cmd.AddCommand(CmdSetMultiCoinDistributionPolicy())
+	cmd.AddCommand(CmdClaimPendingMultiCoinRewards())
+	cmd.AddCommand(CmdToggleMultiCoinRewardsConvert())
+	cmd.AddCommand(CmdCancelMultiCoinDistributionPolicyVersion())
 
 	return cmd
 }
@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"github.com/KYVENetwork/chain/x/multi_coin_rewards/types"
+	"github.com/spf13/cast"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+)
+
+func CmdCancelMultiCoinDistributionPolicyVersion() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-multi-coin-distribution-policy-version [version]",
+		Short: "Broadcast message to cancel a scheduled version of the multi-coin distribution policy",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			version, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgCancelMultiCoinDistributionPolicyVersion{
+				Creator: clientCtx.GetFromAddress().String(),
+				Version: version,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
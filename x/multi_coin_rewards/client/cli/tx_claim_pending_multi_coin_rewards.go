@@ -0,0 +1,49 @@
+package cli
+
+import (
+	"github.com/KYVENetwork/chain/x/multi_coin_rewards/types"
+	"github.com/spf13/cast"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+)
+
+func CmdClaimPendingMultiCoinRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-pending-multi-coin-rewards [indices...]",
+		Short: "Broadcast message to claim pending multi-coin rewards, claims all entries if no indices are provided",
+		Args:  cobra.ArbitraryArgs,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			indices := make([]uint64, 0, len(args))
+			for _, arg := range args {
+				index, err := cast.ToUint64E(arg)
+				if err != nil {
+					return err
+				}
+				indices = append(indices, index)
+			}
+
+			msg := types.MsgClaimPendingMultiCoinRewards{
+				Creator: clientCtx.GetFromAddress().String(),
+				Indices: indices,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
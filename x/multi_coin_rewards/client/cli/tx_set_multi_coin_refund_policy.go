@@ -12,10 +12,13 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const FlagActivationTime = "activation-time"
+
 func CmdSetMultiCoinDistributionPolicy() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "set-multi-coin-distribution-policy [path-to-json-file]",
 		Short: "Broadcast message to update the distribution policy",
+		Long:  "Broadcast message to update the distribution policy. With --activation-time the policy is scheduled and becomes active at the given UNIX timestamp.",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -34,9 +37,15 @@ func CmdSetMultiCoinDistributionPolicy() *cobra.Command {
 				return err
 			}
 
+			activationTime, err := cmd.Flags().GetInt64(FlagActivationTime)
+			if err != nil {
+				return err
+			}
+
 			msg := types.MsgSetMultiCoinRewardsDistributionPolicy{
-				Creator: clientCtx.GetFromAddress().String(),
-				Policy:  policy,
+				Creator:        clientCtx.GetFromAddress().String(),
+				Policy:         policy,
+				ActivationTime: activationTime,
 			}
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -47,6 +56,7 @@ func CmdSetMultiCoinDistributionPolicy() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Int64(FlagActivationTime, 0, "UNIX timestamp at which the policy becomes active, activates immediately if not set")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
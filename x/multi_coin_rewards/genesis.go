@@ -22,12 +22,30 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		}
 	}
 
+	for _, entry := range genState.MultiCoinConvert {
+		err := k.MultiCoinRewardsConvert.Set(ctx, sdk.MustAccAddressFromBech32(entry))
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	for _, entry := range genState.MultiCoinConvertEntries {
+		err := k.MultiCoinConvertEntries.Set(ctx, sdk.MustAccAddressFromBech32(entry.Address), entry)
+		if err != nil {
+			panic(err)
+		}
+	}
+
 	k.SetQueueState(ctx, types.QUEUE_IDENTIFIER_MULTI_COIN_REWARDS, genState.QueueStatePendingRewards)
 
 	err := k.MultiCoinDistributionPolicy.Set(ctx, *genState.MultiCoinDistributionPolicy)
 	if err != nil {
 		panic(err)
 	}
+
+	if err := k.InitMultiCoinDistributionPolicyVersions(ctx, genState.MultiCoinDistributionPolicyVersions); err != nil {
+		panic(err)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -47,5 +65,11 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 
 	genesis.MultiCoinEnabled = k.GetAllEnabledMultiCoinAddresses(ctx)
 
+	genesis.MultiCoinDistributionPolicyVersions = k.GetAllMultiCoinDistributionPolicyVersions(ctx)
+
+	genesis.MultiCoinConvert = k.GetAllMultiCoinConvertAddresses(ctx)
+
+	genesis.MultiCoinConvertEntries = k.GetAllMultiCoinConvertEntries(ctx)
+
 	return genesis
 }
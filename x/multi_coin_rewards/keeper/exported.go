@@ -14,6 +14,7 @@ import (
 // If a user has not enabled multi-coin rewards, all tokens (except the native denom) are added to a queue.
 // The user then has `MultiCoinDistributionPendingTime` seconds to enable Multi-Coin rewards and claim the pending rewards.
 // Otherwise, these tokens will get redistributed to the pools.
+// If a user has enabled the convert mode, all tokens (except the native denom) are swapped to the native denom instead.
 func (k Keeper) HandleMultiCoinRewards(goCtx context.Context, withdrawAddress sdk.AccAddress, coins sdk.Coins) (sdk.Coins, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -37,7 +38,20 @@ func (k Keeper) HandleMultiCoinRewards(goCtx context.Context, withdrawAddress sd
 		if err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, distributionTypes.ModuleName, types.ModuleName, disabledRewards); err != nil {
 			return nil, err
 		}
-		k.addPendingRewards(ctx, withdrawAddress.String(), disabledRewards)
+
+		convert, err := k.MultiCoinRewardsConvert.Has(ctx, withdrawAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		if convert {
+			// User has enabled the convert mode, the rewards get swapped to the native denom in the next batch
+			if err := k.addConvertRewards(ctx, withdrawAddress, disabledRewards); err != nil {
+				return nil, err
+			}
+		} else {
+			k.addPendingRewards(ctx, withdrawAddress.String(), disabledRewards)
+		}
 	}
 
 	return enabledRewards, nil
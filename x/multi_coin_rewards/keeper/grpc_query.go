@@ -40,6 +40,11 @@ func (k Keeper) MultiCoinStatus(ctx context.Context, request *types.QueryMultiCo
 		return nil, err
 	}
 
+	convert, err := k.MultiCoinRewardsConvert.Has(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+
 	entries, _ := k.GetMultiCoinPendingRewardsEntriesByIndex2(sdk.UnwrapSDKContext(ctx), request.Address)
 
 	pendingRewards := sdk.NewCoins()
@@ -51,5 +56,85 @@ func (k Keeper) MultiCoinStatus(ctx context.Context, request *types.QueryMultiCo
 	return &types.QueryMultiCoinStatusResponse{
 		Enabled:                 has,
 		PendingMultiCoinRewards: pendingRewards,
+		ConvertEnabled:          convert,
+	}, nil
+}
+
+func (k Keeper) PendingMultiCoinRewards(ctx context.Context, request *types.QueryPendingMultiCoinRewardsRequest) (*types.QueryPendingMultiCoinRewardsResponse, error) {
+	if _, err := sdk.AccAddressFromBech32(request.Address); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	entries, _ := k.GetMultiCoinPendingRewardsEntriesByIndex2(sdkCtx, request.Address)
+
+	pendingEntries := make([]types.PendingMultiCoinRewardsEntry, 0)
+	for _, entry := range entries {
+		pendingEntries = append(pendingEntries, types.PendingMultiCoinRewardsEntry{
+			Index:        entry.Index,
+			Rewards:      entry.Rewards,
+			CreationDate: entry.CreationDate,
+			ExpiryDate:   k.getPendingRewardsExpiryDate(sdkCtx, entry),
+		})
+	}
+
+	return &types.QueryPendingMultiCoinRewardsResponse{Entries: pendingEntries}, nil
+}
+
+func (k Keeper) MultiCoinDistributionPolicyVersions(ctx context.Context, request *types.QueryMultiCoinDistributionPolicyVersionsRequest) (*types.QueryMultiCoinDistributionPolicyVersionsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	response := &types.QueryMultiCoinDistributionPolicyVersionsResponse{
+		Versions: k.GetAllMultiCoinDistributionPolicyVersions(sdkCtx),
+	}
+
+	has, err := k.MultiCoinDistributionPolicyActiveVersion.Has(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if has {
+		activeVersion, err := k.MultiCoinDistributionPolicyActiveVersion.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		response.ActiveVersion = activeVersion
+		response.HasActiveVersion = true
+	}
+
+	return response, nil
+}
+
+func (k Keeper) MultiCoinDistributionPreview(ctx context.Context, request *types.QueryMultiCoinDistributionPreviewRequest) (*types.QueryMultiCoinDistributionPreviewResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	var policy types.MultiCoinDistributionPolicy
+	if request.Policy != nil {
+		policy = *request.Policy
+	} else {
+		activePolicy, err := k.MultiCoinDistributionPolicy.Get(ctx)
+		if err != nil {
+			return nil, status.Error(codes.NotFound, "no distribution policy set")
+		}
+		policy = activePolicy
+	}
+
+	rewards := k.bankKeeper.GetAllBalances(sdkCtx, k.accountKeeper.GetModuleAddress(types.MultiCoinRewardsRedistributionAccountName))
+
+	poolRewards, skippedPoolIds, remaining, err := k.computeMultiCoinDistribution(sdkCtx, policy, rewards)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryMultiCoinDistributionPreviewResponse{
+		PoolRewards:    poolRewards,
+		SkippedPoolIds: skippedPoolIds,
+		Remaining:      remaining,
 	}, nil
 }
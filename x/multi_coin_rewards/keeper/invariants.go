@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/KYVENetwork/chain/x/multi_coin_rewards/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterInvariants registers all multi_coin_rewards invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "module-balance", ModuleBalanceInvariant(k))
+}
+
+// AllInvariants runs all invariants of the multi_coin_rewards module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return ModuleBalanceInvariant(k)(ctx)
+	}
+}
+
+// ModuleBalanceInvariant checks that the module balance covers all pending
+// rewards queue entries and all rewards waiting for the next convert batch.
+func ModuleBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		required := sdk.NewCoins()
+		for _, entry := range k.GetAllMultiCoinPendingRewardsEntries(ctx) {
+			required = required.Add(entry.Rewards...)
+		}
+
+		for _, entry := range k.GetAllMultiCoinConvertEntries(ctx) {
+			required = required.Add(entry.Rewards...)
+		}
+
+		balance := k.bankKeeper.GetAllBalances(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName))
+
+		broken := !balance.IsAllGTE(required)
+
+		return sdk.FormatInvariant(types.ModuleName, "module-balance", fmt.Sprintf(
+			"\tmulti_coin_rewards module balance: %v\n\trequired balance: %v\n",
+			balance, required,
+		)), broken
+	}
+}
@@ -0,0 +1,60 @@
+package keeper_test
+
+import (
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	multicoinrewardskeeper "github.com/KYVENetwork/chain/x/multi_coin_rewards/keeper"
+	multicoinrewardstypes "github.com/KYVENetwork/chain/x/multi_coin_rewards/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+/*
+
+TEST CASES - invariants.go
+
+* Module balance covers the pending rewards queue entries
+* Module balance invariant breaks if pending rewards are not covered
+
+*/
+
+var _ = Describe("invariants.go", Ordered, func() {
+	var s *i.KeeperTestSuite
+
+	BeforeEach(func() {
+		// init new clean chain
+		s = i.NewCleanChain()
+
+		// create a pending rewards entry which is held by the module
+		Expect(s.MintDenomToModule(multicoinrewardstypes.ModuleName, 100*i.KYVE, i.A_DENOM)).To(Succeed())
+		s.App().MultiCoinRewardsKeeper.SetMultiCoinPendingRewardsEntry(s.Ctx(), multicoinrewardstypes.MultiCoinPendingRewardsEntry{
+			Index:        0,
+			Address:      i.ALICE,
+			Rewards:      i.ACoins(100 * i.T_KYVE),
+			CreationDate: s.Ctx().BlockTime().Unix(),
+		})
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Module balance covers the pending rewards queue entries", func() {
+		// ASSERT
+		_, broken := multicoinrewardskeeper.AllInvariants(s.App().MultiCoinRewardsKeeper)(s.Ctx())
+		Expect(broken).To(BeFalse())
+	})
+
+	It("Module balance invariant breaks if pending rewards are not covered", func() {
+		// ACT
+		s.App().MultiCoinRewardsKeeper.SetMultiCoinPendingRewardsEntry(s.Ctx(), multicoinrewardstypes.MultiCoinPendingRewardsEntry{
+			Index:        1,
+			Address:      i.BOB,
+			Rewards:      i.ACoins(1),
+			CreationDate: s.Ctx().BlockTime().Unix(),
+		})
+
+		// ASSERT
+		_, broken := multicoinrewardskeeper.ModuleBalanceInvariant(s.App().MultiCoinRewardsKeeper)(s.Ctx())
+		Expect(broken).To(BeTrue())
+	})
+})
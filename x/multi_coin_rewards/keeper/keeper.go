@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
 
 	"github.com/KYVENetwork/chain/x/multi_coin_rewards/types"
 
@@ -28,10 +29,24 @@ type (
 		accountKeeper util.AccountKeeper
 		bankKeeper    util.BankKeeper
 		poolKeeper    types.PoolKeeper
+		fundersKeeper types.FundersKeeper
+
+		// swapVenues is shared between all copies of the keeper, so that venues
+		// can be registered after the app was wired.
+		swapVenues map[string]types.SwapVenue
 
 		MultiCoinRewardsEnabled     collections.KeySet[sdk.AccAddress]
 		MultiCoinDistributionPolicy collections.Item[types.MultiCoinDistributionPolicy]
 
+		MultiCoinDistributionPolicyVersions        collections.Map[uint64, types.MultiCoinDistributionPolicyVersion]
+		MultiCoinDistributionPolicyVersionSequence collections.Sequence
+		MultiCoinDistributionPolicySchedule        collections.KeySet[collections.Pair[int64, uint64]]
+		MultiCoinDistributionPolicyActiveVersion   collections.Item[uint64]
+
+		MultiCoinRewardsConvert collections.KeySet[sdk.AccAddress]
+		MultiCoinConvertEntries collections.Map[sdk.AccAddress, types.MultiCoinConvertEntry]
+		MultiCoinConvertCursor  collections.Item[sdk.AccAddress]
+
 		Schema collections.Schema
 	}
 )
@@ -47,6 +62,7 @@ func NewKeeper(
 	accountKeeper util.AccountKeeper,
 	bankKeeper util.BankKeeper,
 	poolKeeper types.PoolKeeper,
+	fundersKeeper types.FundersKeeper,
 ) Keeper {
 	sb := collections.NewSchemaBuilder(storeService)
 
@@ -61,11 +77,28 @@ func NewKeeper(
 		accountKeeper: accountKeeper,
 		bankKeeper:    bankKeeper,
 		poolKeeper:    poolKeeper,
+		fundersKeeper: fundersKeeper,
+
+		swapVenues: make(map[string]types.SwapVenue),
 
 		MultiCoinRewardsEnabled: collections.NewKeySet(sb, types.MultiCoinRewardsEnabledKey,
 			"multi_coin_rewards_enabled", sdk.AccAddressKey),
 		MultiCoinDistributionPolicy: collections.NewItem(sb, types.MultiCoinDistributionPolicyKey,
 			"multi_coin_rewards_policy", codec.CollValue[types.MultiCoinDistributionPolicy](cdc)),
+		MultiCoinDistributionPolicyVersions: collections.NewMap(sb, types.MultiCoinDistributionPolicyVersionsKey,
+			"multi_coin_rewards_policy_versions", collections.Uint64Key, codec.CollValue[types.MultiCoinDistributionPolicyVersion](cdc)),
+		MultiCoinDistributionPolicyVersionSequence: collections.NewSequence(sb, types.MultiCoinDistributionPolicyVersionSequenceKey,
+			"multi_coin_rewards_policy_version_sequence"),
+		MultiCoinDistributionPolicySchedule: collections.NewKeySet(sb, types.MultiCoinDistributionPolicyScheduleKey,
+			"multi_coin_rewards_policy_schedule", collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key)),
+		MultiCoinDistributionPolicyActiveVersion: collections.NewItem(sb, types.MultiCoinDistributionPolicyActiveVersionKey,
+			"multi_coin_rewards_policy_active_version", collections.Uint64Value),
+		MultiCoinRewardsConvert: collections.NewKeySet(sb, types.MultiCoinRewardsConvertKey,
+			"multi_coin_rewards_convert", sdk.AccAddressKey),
+		MultiCoinConvertEntries: collections.NewMap(sb, types.MultiCoinConvertEntriesKey,
+			"multi_coin_rewards_convert_entries", sdk.AccAddressKey, codec.CollValue[types.MultiCoinConvertEntry](cdc)),
+		MultiCoinConvertCursor: collections.NewItem(sb, types.MultiCoinConvertCursorKey,
+			"multi_coin_rewards_convert_cursor", collcodec.KeyToValueCodec(sdk.AccAddressKey)),
 	}
 
 	schema, err := sb.Build()
@@ -78,6 +111,19 @@ func NewKeeper(
 	return k
 }
 
+// RegisterSwapVenue registers a venue which can be used to convert non-native rewards
+// to the native denom. The venue is only used once governance selects it with the
+// multi_coin_convert_venue param.
+func (k Keeper) RegisterSwapVenue(name string, venue types.SwapVenue) {
+	if name == "" {
+		panic("swap venue name can not be empty")
+	}
+	if _, found := k.swapVenues[name]; found {
+		panic(fmt.Sprintf("swap venue %s is already registered", name))
+	}
+	k.swapVenues[name] = venue
+}
+
 func (k Keeper) Logger() log.Logger {
 	return k.logger.With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
@@ -24,7 +24,7 @@ func payoutRewards(s *i.KeeperTestSuite, staker string, coins sdk.Coins) {
 	if err != nil {
 		panic(err)
 	}
-	err = s.App().StakersKeeper.PayoutRewards(s.Ctx(), staker, coins, mintTypes.ModuleName)
+	err = s.App().StakersKeeper.PayoutRewards(s.Ctx(), staker, 0, coins, mintTypes.ModuleName)
 	if err != nil {
 		panic(err)
 	}
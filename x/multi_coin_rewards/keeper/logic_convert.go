@@ -0,0 +1,311 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	globalTypes "github.com/KYVENetwork/chain/x/global/types"
+	"github.com/KYVENetwork/chain/x/multi_coin_rewards/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// addConvertRewards adds the non-native rewards of an address to the next convert batch.
+// The rewards must already be held by the module account.
+func (k Keeper) addConvertRewards(ctx sdk.Context, address sdk.AccAddress, rewards sdk.Coins) error {
+	entry, err := k.MultiCoinConvertEntries.Get(ctx, address)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+		entry = types.MultiCoinConvertEntry{
+			Address: address.String(),
+			Rewards: sdk.NewCoins(),
+		}
+	}
+
+	entry.Rewards = entry.Rewards.Add(rewards...)
+	return k.MultiCoinConvertEntries.Set(ctx, address, entry)
+}
+
+// disableConvert disables the convert mode of an address. Rewards which are still
+// waiting in the convert batch are added to the pending rewards of the address.
+func (k Keeper) disableConvert(ctx sdk.Context, address sdk.AccAddress) error {
+	if err := k.MultiCoinRewardsConvert.Remove(ctx, address); err != nil {
+		return err
+	}
+
+	entry, err := k.MultiCoinConvertEntries.Get(ctx, address)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	k.addPendingRewards(ctx, entry.Address, entry.Rewards)
+	return k.MultiCoinConvertEntries.Remove(ctx, address)
+}
+
+// getSwapVenue returns the registered swap venue which was selected by governance.
+func (k Keeper) getSwapVenue(params types.Params) (types.SwapVenue, bool) {
+	if params.MultiCoinConvertVenue == "" {
+		return nil, false
+	}
+
+	venue, found := k.swapVenues[params.MultiCoinConvertVenue]
+	return venue, found
+}
+
+// isConvertAvailable returns whether a swap venue is selected and at least one
+// denom can be converted, otherwise the convert mode can not be enabled.
+func (k Keeper) isConvertAvailable(ctx sdk.Context) bool {
+	params := k.GetParams(ctx)
+	_, found := k.getSwapVenue(params)
+	return found && len(params.MultiCoinConvertDenoms) > 0
+}
+
+// getReferencePrice returns the amount of the native denom one unit of the given denom
+// is worth according to the coin weights of the funders module. The coin weights follow
+// the price oracle and are independent of the swap venue, so the venue can not be used
+// to manipulate the minimum proceeds of a swap.
+func (k Keeper) getReferencePrice(ctx sdk.Context, denom string) (math.LegacyDec, error) {
+	whitelist := k.fundersKeeper.GetCoinWhitelistMap(ctx)
+
+	entry, found := whitelist[denom]
+	if !found || !entry.CoinWeight.IsPositive() {
+		return math.LegacyZeroDec(), fmt.Errorf("no reference price for denom %s", denom)
+	}
+
+	nativeEntry, found := whitelist[globalTypes.Denom]
+	if !found || !nativeEntry.CoinWeight.IsPositive() {
+		return math.LegacyZeroDec(), fmt.Errorf("no reference price for denom %s", globalTypes.Denom)
+	}
+
+	// coin weights are denominated in USD per coin, so the decimals of both coins
+	// have to be applied to get the price per unit
+	return entry.CoinWeight.Mul(math.LegacyNewDec(10).Power(uint64(nativeEntry.CoinDecimals))).
+		Quo(nativeEntry.CoinWeight.Mul(math.LegacyNewDec(10).Power(uint64(entry.CoinDecimals)))), nil
+}
+
+// GetAllMultiCoinConvertEntries returns all entries of the next convert batch ordered by their address
+func (k Keeper) GetAllMultiCoinConvertEntries(ctx sdk.Context) []types.MultiCoinConvertEntry {
+	entries := make([]types.MultiCoinConvertEntry, 0)
+	if iter, err := k.MultiCoinConvertEntries.Iterate(ctx, nil); err == nil {
+		if values, err := iter.Values(); err == nil {
+			entries = append(entries, values...)
+		}
+	}
+	return entries
+}
+
+// GetAllMultiCoinConvertAddresses returns all addresses which have enabled the convert mode
+func (k Keeper) GetAllMultiCoinConvertAddresses(ctx sdk.Context) []string {
+	addresses := make([]string, 0)
+	if iter, err := k.MultiCoinRewardsConvert.Iterate(ctx, nil); err == nil {
+		if accounts, err := iter.Keys(); err == nil {
+			for _, account := range accounts {
+				addresses = append(addresses, account.String())
+			}
+		}
+	}
+	return addresses
+}
+
+// convertCoin swaps the coin held by the module account to the native denom using the
+// selected swap venue. The swap is only performed if the proceeds are within the
+// maximum slippage of the denom relative to the reference price. The returned amount
+// was sent to the module account.
+func (k Keeper) convertCoin(ctx sdk.Context, params types.Params, coin sdk.Coin) (math.Int, error) {
+	venue, found := k.getSwapVenue(params)
+	if !found {
+		return math.ZeroInt(), fmt.Errorf("no swap venue selected")
+	}
+
+	convertEntry, found := params.GetMultiCoinConvertDenomEntry(coin.Denom)
+	if !found {
+		return math.ZeroInt(), fmt.Errorf("denom %s can not be converted", coin.Denom)
+	}
+
+	referencePrice, err := k.getReferencePrice(ctx, coin.Denom)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	minAmountOut := referencePrice.MulInt(coin.Amount).Mul(math.LegacyOneDec().Sub(convertEntry.MaxSlippage)).TruncateInt()
+
+	// Only apply the swap if it succeeded
+	cacheCtx, write := ctx.CacheContext()
+	amountOut, err := venue.Swap(cacheCtx, k.accountKeeper.GetModuleAddress(types.ModuleName), coin, minAmountOut)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	if amountOut.LT(minAmountOut) {
+		return math.ZeroInt(), fmt.Errorf("swap proceeds %s below minimum %s", amountOut, minAmountOut)
+	}
+
+	write()
+	return amountOut, nil
+}
+
+// getConvertBatch returns at most limit entries of the convert batch. The entries are
+// taken in address order starting after the address which was processed last, so every
+// address is processed eventually even if there are more entries than the limit.
+func (k Keeper) getConvertBatch(ctx sdk.Context, limit uint64) ([]types.MultiCoinConvertEntry, error) {
+	cursor, err := k.MultiCoinConvertCursor.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+
+	entries := make([]types.MultiCoinConvertEntry, 0)
+	collect := func(ranger collections.Ranger[sdk.AccAddress]) error {
+		return k.MultiCoinConvertEntries.Walk(ctx, ranger, func(_ sdk.AccAddress, entry types.MultiCoinConvertEntry) (bool, error) {
+			entries = append(entries, entry)
+			return uint64(len(entries)) >= limit, nil
+		})
+	}
+
+	if len(cursor) == 0 {
+		if err := collect(nil); err != nil {
+			return nil, err
+		}
+		return entries, nil
+	}
+
+	if err := collect(new(collections.Range[sdk.AccAddress]).StartExclusive(cursor)); err != nil {
+		return nil, err
+	}
+
+	// Continue with the first addresses once the end was reached
+	if uint64(len(entries)) < limit {
+		if err := collect(new(collections.Range[sdk.AccAddress]).EndInclusive(cursor)); err != nil {
+			return nil, err
+		}
+	}
+
+	return entries, nil
+}
+
+// ProcessConvertBatch converts the batched non-native rewards of the users in convert mode.
+// At most MultiCoinConvertMaxEntriesPerBlock addresses are processed per block. Every denom
+// is swapped once for the entire batch and the proceeds are paid out pro-rata. If a denom
+// can not be converted, it stays in the convert batch and is retried in the next batch. Once
+// the rewards of an address failed to convert MultiCoinConvertMaxAttempts times, they are
+// added to the pending rewards of the address.
+func (k Keeper) ProcessConvertBatch(ctx sdk.Context) error {
+	params := k.GetParams(ctx)
+
+	entries, err := k.getConvertBatch(ctx, params.MultiCoinConvertMaxEntriesPerBlock)
+	if err != nil {
+		return err
+	}
+	if len(entries) == 0 {
+		return nil
+	}
+
+	lastAddress := sdk.MustAccAddressFromBech32(entries[len(entries)-1].Address)
+	if err := k.MultiCoinConvertCursor.Set(ctx, lastAddress); err != nil {
+		return err
+	}
+
+	totalRewards := sdk.NewCoins()
+	for _, entry := range entries {
+		totalRewards = totalRewards.Add(entry.Rewards...)
+
+		if err := k.MultiCoinConvertEntries.Remove(ctx, sdk.MustAccAddressFromBech32(entry.Address)); err != nil {
+			return err
+		}
+	}
+
+	proceeds := make([]math.Int, len(entries))
+	converted := make([]sdk.Coins, len(entries))
+	failed := make([]sdk.Coins, len(entries))
+	for index := range entries {
+		proceeds[index] = math.ZeroInt()
+		converted[index] = sdk.NewCoins()
+		failed[index] = sdk.NewCoins()
+	}
+
+	for _, coin := range totalRewards {
+		amountOut, err := k.convertCoin(ctx, params, coin)
+		if err != nil {
+			_ = ctx.EventManager().EmitTypedEvent(&types.EventMultiCoinRewardsConvertFailed{
+				Denom:  coin.Denom,
+				Amount: coin.Amount.String(),
+				Reason: err.Error(),
+			})
+
+			for index, entry := range entries {
+				if amount := entry.Rewards.AmountOf(coin.Denom); amount.IsPositive() {
+					failed[index] = failed[index].Add(sdk.NewCoin(coin.Denom, amount))
+				}
+			}
+			continue
+		}
+
+		// Distribute the proceeds pro-rata, the last entry receives the remainder
+		// to ensure that the entire proceeds are paid out.
+		lastIndex := 0
+		for index, entry := range entries {
+			if entry.Rewards.AmountOf(coin.Denom).IsPositive() {
+				lastIndex = index
+			}
+		}
+
+		distributed := math.ZeroInt()
+		for index, entry := range entries {
+			amount := entry.Rewards.AmountOf(coin.Denom)
+			if !amount.IsPositive() {
+				continue
+			}
+
+			share := amountOut.Mul(amount).Quo(coin.Amount)
+			if index == lastIndex {
+				share = amountOut.Sub(distributed)
+			}
+
+			distributed = distributed.Add(share)
+			proceeds[index] = proceeds[index].Add(share)
+			converted[index] = converted[index].Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+
+	for index, entry := range entries {
+		address := sdk.MustAccAddressFromBech32(entry.Address)
+
+		if !failed[index].IsZero() {
+			if entry.FailedAttempts+1 >= params.MultiCoinConvertMaxAttempts {
+				k.addPendingRewards(ctx, entry.Address, failed[index])
+
+				_ = ctx.EventManager().EmitTypedEvent(&types.EventMultiCoinRewardsConvertAborted{
+					Address: entry.Address,
+					Rewards: failed[index].String(),
+				})
+			} else if err := k.MultiCoinConvertEntries.Set(ctx, address, types.MultiCoinConvertEntry{
+				Address:        entry.Address,
+				Rewards:        failed[index],
+				FailedAttempts: entry.FailedAttempts + 1,
+			}); err != nil {
+				return err
+			}
+		}
+
+		if converted[index].IsZero() {
+			continue
+		}
+
+		nativeProceeds := sdk.NewCoins(sdk.NewCoin(globalTypes.Denom, proceeds[index]))
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, address, nativeProceeds); err != nil {
+			return err
+		}
+
+		_ = ctx.EventManager().EmitTypedEvent(&types.EventMultiCoinRewardsConverted{
+			Address:  entry.Address,
+			Rewards:  converted[index].String(),
+			Proceeds: nativeProceeds.String(),
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,584 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	"github.com/KYVENetwork/chain/app"
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	funderstypes "github.com/KYVENetwork/chain/x/funders/types"
+	globaltypes "github.com/KYVENetwork/chain/x/global/types"
+	multicoinrewardstypes "github.com/KYVENetwork/chain/x/multi_coin_rewards/types"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distributionTypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	mintTypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+/*
+
+TEST CASES - logic_convert.go
+
+* Convert foreign rewards to the native denom
+* Keep rewards in the convert batch if the slippage is exceeded
+* Keep rewards in the convert batch if the denom can not be converted
+* Use the reference price instead of the price of the swap venue
+* Try to enable the convert mode without a swap venue
+* Add rewards to the pending rewards after the maximum number of attempts
+* Convert at most the maximum number of entries per block
+* Process every entry although the first entries keep failing
+* Convert rewards with the community pool venue
+* Keep rewards in the convert batch if the community pool has insufficient funds
+* Try to enable the convert mode without convert denoms
+* Add unconverted rewards to the pending rewards when disabling the convert mode
+* Convert pending rewards when enabling the convert mode
+* Enabling multi-coin rewards disables the convert mode
+* Disable convert mode
+
+*/
+
+var _ = Describe("logic_convert.go", Ordered, func() {
+	var s *i.KeeperTestSuite
+	var gov string
+	var validator1 i.TestValidatorAddress
+	var venue *i.MockSwapVenue
+
+	// setReferencePrice sets the coin weight of acoin relative to a coin weight of 1 of tkyve
+	setReferencePrice := func(coinWeight int64) {
+		s.App().FundersKeeper.SetParams(s.Ctx(), funderstypes.NewParams([]*funderstypes.WhitelistCoinEntry{
+			{
+				CoinDenom:                 globaltypes.Denom,
+				CoinDecimals:              6,
+				MinFundingAmount:          math.NewIntFromUint64(10 * i.KYVE),
+				MinFundingAmountPerBundle: math.NewIntFromUint64(1 * i.KYVE),
+				CoinWeight:                math.LegacyNewDec(1),
+			},
+			{
+				CoinDenom:                 i.A_DENOM,
+				CoinDecimals:              6,
+				MinFundingAmount:          math.NewIntFromUint64(10 * i.KYVE),
+				MinFundingAmountPerBundle: math.NewIntFromUint64(1 * i.KYVE),
+				CoinWeight:                math.LegacyNewDec(coinWeight),
+			},
+		}, 20))
+	}
+
+	BeforeEach(func() {
+		// init new clean chain
+		s = i.NewCleanChain()
+		gov = s.App().GovKeeper.GetGovernanceAccount(s.Ctx()).GetAddress().String()
+
+		// create pool
+		s.RunTxPoolSuccess(&pooltypes.MsgCreatePool{
+			Authority:            gov,
+			UploadInterval:       60,
+			MaxBundleSize:        100,
+			InflationShareWeight: math.LegacyZeroDec(),
+			Binaries:             "{}",
+		})
+
+		// create staker
+		validator1 = s.CreateNewValidator("MyValidator-1", 1000*i.KYVE)
+
+		// set the reference price to 2 tkyve per acoin
+		setReferencePrice(2)
+
+		// create swap venue with a price of 2 tkyve per acoin
+		venue = i.NewMockSwapVenue(s.App().BankKeeper, sdk.AccAddress("swap_venue_reserve__"))
+		reserves := sdk.NewCoins(sdk.NewInt64Coin(i.A_DENOM, 1_000_000_000), sdk.NewInt64Coin(globaltypes.Denom, 2_000_000_000))
+		Expect(s.App().BankKeeper.MintCoins(s.Ctx(), mintTypes.ModuleName, reserves)).To(Succeed())
+		Expect(s.App().BankKeeper.SendCoinsFromModuleToAccount(s.Ctx(), mintTypes.ModuleName, venue.Reserve, reserves)).To(Succeed())
+		s.App().MultiCoinRewardsKeeper.RegisterSwapVenue("mock", venue)
+
+		params := s.App().MultiCoinRewardsKeeper.GetParams(s.Ctx())
+		params.MultiCoinConvertVenue = "mock"
+		params.MultiCoinConvertDenoms = []multicoinrewardstypes.MultiCoinConvertDenomEntry{
+			{Denom: i.A_DENOM, MaxSlippage: math.LegacyMustNewDecFromStr("0.01")},
+		}
+		s.App().MultiCoinRewardsKeeper.SetParams(s.Ctx(), params)
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Convert foreign rewards to the native denom", func() {
+		// Arrange
+		s.RunTxSuccess(&multicoinrewardstypes.MsgToggleMultiCoinRewardsConvert{
+			Creator: validator1.Address,
+			Enabled: true,
+		})
+
+		payoutRewards(s, validator1.Address, i.ACoins(1000))
+
+		// ACT
+		s.RunTxSuccess(&distributionTypes.MsgWithdrawDelegatorReward{
+			DelegatorAddress: validator1.Address,
+			ValidatorAddress: validator1.ValAddress,
+		})
+
+		// ASSERT
+		entry, err := s.App().MultiCoinRewardsKeeper.MultiCoinConvertEntries.Get(s.Ctx(), validator1.AccAddress)
+		Expect(err).To(BeNil())
+		Expect(entry.Rewards.String()).To(Equal("1000acoin"))
+		Expect(s.GetCoinsFromModule(multicoinrewardstypes.ModuleName).String()).To(Equal("1000acoin"))
+
+		// ACT
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		// 2_000_000_000 * 1000 / (1_000_000_000 + 1000) = 1999
+		Expect(s.App().BankKeeper.GetAllBalances(s.Ctx(), validator1.AccAddress).String()).To(Equal("10000000000acoin,10000000000bcoin,10000000000ccoin,9000001999tkyve"))
+		Expect(s.GetCoinsFromModule(multicoinrewardstypes.ModuleName)).To(BeEmpty())
+		Expect(s.App().MultiCoinRewardsKeeper.GetAllMultiCoinConvertEntries(s.Ctx())).To(BeEmpty())
+		Expect(s.App().MultiCoinRewardsKeeper.GetAllMultiCoinPendingRewardsEntries(s.Ctx())).To(BeEmpty())
+		Expect(s.App().BankKeeper.GetAllBalances(s.Ctx(), venue.Reserve).String()).To(Equal("1000001000acoin,1999998001tkyve"))
+	})
+
+	It("Keep rewards in the convert batch if the slippage is exceeded", func() {
+		// Arrange
+		params := s.App().MultiCoinRewardsKeeper.GetParams(s.Ctx())
+		params.MultiCoinConvertDenoms[0].MaxSlippage = math.LegacyZeroDec()
+		s.App().MultiCoinRewardsKeeper.SetParams(s.Ctx(), params)
+
+		s.RunTxSuccess(&multicoinrewardstypes.MsgToggleMultiCoinRewardsConvert{
+			Creator: validator1.Address,
+			Enabled: true,
+		})
+
+		payoutRewards(s, validator1.Address, i.ACoins(1000))
+
+		// ACT
+		s.RunTxSuccess(&distributionTypes.MsgWithdrawDelegatorReward{
+			DelegatorAddress: validator1.Address,
+			ValidatorAddress: validator1.ValAddress,
+		})
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		Expect(s.App().BankKeeper.GetAllBalances(s.Ctx(), validator1.AccAddress).String()).To(Equal("10000000000acoin,10000000000bcoin,10000000000ccoin,9000000000tkyve"))
+		Expect(s.GetCoinsFromModule(multicoinrewardstypes.ModuleName).String()).To(Equal("1000acoin"))
+		Expect(s.App().MultiCoinRewardsKeeper.GetAllMultiCoinPendingRewardsEntries(s.Ctx())).To(BeEmpty())
+
+		convertEntries := s.App().MultiCoinRewardsKeeper.GetAllMultiCoinConvertEntries(s.Ctx())
+		Expect(convertEntries).To(HaveLen(1))
+		Expect(convertEntries[0].Rewards.String()).To(Equal("1000acoin"))
+		Expect(s.App().BankKeeper.GetAllBalances(s.Ctx(), venue.Reserve).String()).To(Equal("1000000000acoin,2000000000tkyve"))
+
+		// ACT
+		params.MultiCoinConvertDenoms[0].MaxSlippage = math.LegacyMustNewDecFromStr("0.01")
+		s.App().MultiCoinRewardsKeeper.SetParams(s.Ctx(), params)
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		Expect(s.App().BankKeeper.GetAllBalances(s.Ctx(), validator1.AccAddress).String()).To(Equal("10000000000acoin,10000000000bcoin,10000000000ccoin,9000001999tkyve"))
+		Expect(s.GetCoinsFromModule(multicoinrewardstypes.ModuleName)).To(BeEmpty())
+		Expect(s.App().MultiCoinRewardsKeeper.GetAllMultiCoinConvertEntries(s.Ctx())).To(BeEmpty())
+	})
+
+	It("Keep rewards in the convert batch if the denom can not be converted", func() {
+		// Arrange
+		s.RunTxSuccess(&multicoinrewardstypes.MsgToggleMultiCoinRewardsConvert{
+			Creator: validator1.Address,
+			Enabled: true,
+		})
+
+		payoutRewards(s, validator1.Address, i.ACoins(1000))
+		payoutRewards(s, validator1.Address, i.BCoins(50))
+
+		// ACT
+		s.RunTxSuccess(&distributionTypes.MsgWithdrawDelegatorReward{
+			DelegatorAddress: validator1.Address,
+			ValidatorAddress: validator1.ValAddress,
+		})
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		Expect(s.App().BankKeeper.GetAllBalances(s.Ctx(), validator1.AccAddress).String()).To(Equal("10000000000acoin,10000000000bcoin,10000000000ccoin,9000001999tkyve"))
+		Expect(s.GetCoinsFromModule(multicoinrewardstypes.ModuleName).String()).To(Equal("50bcoin"))
+		Expect(s.App().MultiCoinRewardsKeeper.GetAllMultiCoinPendingRewardsEntries(s.Ctx())).To(BeEmpty())
+
+		convertEntries := s.App().MultiCoinRewardsKeeper.GetAllMultiCoinConvertEntries(s.Ctx())
+		Expect(convertEntries).To(HaveLen(1))
+		Expect(convertEntries[0].Rewards.String()).To(Equal("50bcoin"))
+	})
+
+	It("Use the reference price instead of the price of the swap venue", func() {
+		// Arrange
+		// the venue still offers 2 tkyve per acoin, but acoin is worth 4 tkyve
+		setReferencePrice(4)
+
+		s.RunTxSuccess(&multicoinrewardstypes.MsgToggleMultiCoinRewardsConvert{
+			Creator: validator1.Address,
+			Enabled: true,
+		})
+
+		payoutRewards(s, validator1.Address, i.ACoins(1000))
+
+		// ACT
+		s.RunTxSuccess(&distributionTypes.MsgWithdrawDelegatorReward{
+			DelegatorAddress: validator1.Address,
+			ValidatorAddress: validator1.ValAddress,
+		})
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		Expect(s.App().BankKeeper.GetAllBalances(s.Ctx(), validator1.AccAddress).String()).To(Equal("10000000000acoin,10000000000bcoin,10000000000ccoin,9000000000tkyve"))
+		Expect(s.App().BankKeeper.GetAllBalances(s.Ctx(), venue.Reserve).String()).To(Equal("1000000000acoin,2000000000tkyve"))
+
+		convertEntries := s.App().MultiCoinRewardsKeeper.GetAllMultiCoinConvertEntries(s.Ctx())
+		Expect(convertEntries).To(HaveLen(1))
+		Expect(convertEntries[0].Rewards.String()).To(Equal("1000acoin"))
+	})
+
+	It("Try to enable the convert mode without a swap venue", func() {
+		// Arrange
+		params := s.App().MultiCoinRewardsKeeper.GetParams(s.Ctx())
+		params.MultiCoinConvertVenue = ""
+		s.App().MultiCoinRewardsKeeper.SetParams(s.Ctx(), params)
+
+		// ACT
+		err := s.RunTxError(&multicoinrewardstypes.MsgToggleMultiCoinRewardsConvert{
+			Creator: validator1.Address,
+			Enabled: true,
+		})
+
+		// ASSERT
+		Expect(err.Error()).To(ContainSubstring(multicoinrewardstypes.ErrMultiCoinRewardsConvertUnavailable.Error()))
+
+		status, err := s.App().MultiCoinRewardsKeeper.MultiCoinStatus(s.Ctx(), &multicoinrewardstypes.QueryMultiCoinStatusRequest{Address: validator1.Address})
+		Expect(err).To(BeNil())
+		Expect(status.ConvertEnabled).To(BeFalse())
+	})
+
+	It("Add rewards to the pending rewards after the maximum number of attempts", func() {
+		// Arrange
+		params := s.App().MultiCoinRewardsKeeper.GetParams(s.Ctx())
+		params.MultiCoinConvertMaxAttempts = 2
+		s.App().MultiCoinRewardsKeeper.SetParams(s.Ctx(), params)
+
+		s.RunTxSuccess(&multicoinrewardstypes.MsgToggleMultiCoinRewardsConvert{
+			Creator: validator1.Address,
+			Enabled: true,
+		})
+
+		payoutRewards(s, validator1.Address, i.BCoins(50))
+		s.RunTxSuccess(&distributionTypes.MsgWithdrawDelegatorReward{
+			DelegatorAddress: validator1.Address,
+			ValidatorAddress: validator1.ValAddress,
+		})
+
+		// ACT
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		convertEntries := s.App().MultiCoinRewardsKeeper.GetAllMultiCoinConvertEntries(s.Ctx())
+		Expect(convertEntries).To(HaveLen(1))
+		Expect(convertEntries[0].Rewards.String()).To(Equal("50bcoin"))
+		Expect(convertEntries[0].FailedAttempts).To(Equal(uint64(1)))
+		Expect(s.App().MultiCoinRewardsKeeper.GetAllMultiCoinPendingRewardsEntries(s.Ctx())).To(BeEmpty())
+
+		// ACT
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		Expect(s.App().MultiCoinRewardsKeeper.GetAllMultiCoinConvertEntries(s.Ctx())).To(BeEmpty())
+
+		pendingEntries := s.App().MultiCoinRewardsKeeper.GetAllMultiCoinPendingRewardsEntries(s.Ctx())
+		Expect(pendingEntries).To(HaveLen(1))
+		Expect(pendingEntries[0].Address).To(Equal(validator1.Address))
+		Expect(pendingEntries[0].Rewards.String()).To(Equal("50bcoin"))
+		Expect(s.GetCoinsFromModule(multicoinrewardstypes.ModuleName).String()).To(Equal("50bcoin"))
+
+		status, err := s.App().MultiCoinRewardsKeeper.MultiCoinStatus(s.Ctx(), &multicoinrewardstypes.QueryMultiCoinStatusRequest{Address: validator1.Address})
+		Expect(err).To(BeNil())
+		Expect(status.ConvertEnabled).To(BeTrue())
+	})
+
+	It("Convert at most the maximum number of entries per block", func() {
+		// Arrange
+		validator2 := s.CreateNewValidator("MyValidator-2", 1000*i.KYVE)
+
+		params := s.App().MultiCoinRewardsKeeper.GetParams(s.Ctx())
+		params.MultiCoinConvertMaxEntriesPerBlock = 1
+		s.App().MultiCoinRewardsKeeper.SetParams(s.Ctx(), params)
+
+		for _, validator := range []i.TestValidatorAddress{validator1, validator2} {
+			s.RunTxSuccess(&multicoinrewardstypes.MsgToggleMultiCoinRewardsConvert{
+				Creator: validator.Address,
+				Enabled: true,
+			})
+
+			payoutRewards(s, validator.Address, i.ACoins(1000))
+			s.RunTxSuccess(&distributionTypes.MsgWithdrawDelegatorReward{
+				DelegatorAddress: validator.Address,
+				ValidatorAddress: validator.ValAddress,
+			})
+		}
+
+		Expect(s.App().MultiCoinRewardsKeeper.GetAllMultiCoinConvertEntries(s.Ctx())).To(HaveLen(2))
+
+		// ACT
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		Expect(s.App().MultiCoinRewardsKeeper.GetAllMultiCoinConvertEntries(s.Ctx())).To(HaveLen(1))
+		Expect(s.GetCoinsFromModule(multicoinrewardstypes.ModuleName).String()).To(Equal("1000acoin"))
+
+		// ACT
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		Expect(s.App().MultiCoinRewardsKeeper.GetAllMultiCoinConvertEntries(s.Ctx())).To(BeEmpty())
+		Expect(s.GetCoinsFromModule(multicoinrewardstypes.ModuleName)).To(BeEmpty())
+	})
+
+	It("Process every entry although the first entries keep failing", func() {
+		// Arrange
+		validator2 := s.CreateNewValidator("MyValidator-2", 1000*i.KYVE)
+
+		params := s.App().MultiCoinRewardsKeeper.GetParams(s.Ctx())
+		params.MultiCoinConvertMaxEntriesPerBlock = 1
+		s.App().MultiCoinRewardsKeeper.SetParams(s.Ctx(), params)
+
+		for _, validator := range []i.TestValidatorAddress{validator1, validator2} {
+			s.RunTxSuccess(&multicoinrewardstypes.MsgToggleMultiCoinRewardsConvert{
+				Creator: validator.Address,
+				Enabled: true,
+			})
+		}
+
+		// the rewards of validator 1 can not be converted
+		payoutRewards(s, validator1.Address, i.BCoins(50))
+		payoutRewards(s, validator2.Address, i.ACoins(1000))
+
+		for _, validator := range []i.TestValidatorAddress{validator1, validator2} {
+			s.RunTxSuccess(&distributionTypes.MsgWithdrawDelegatorReward{
+				DelegatorAddress: validator.Address,
+				ValidatorAddress: validator.ValAddress,
+			})
+		}
+
+		// ACT
+		s.CommitAfterSeconds(1)
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		convertEntries := s.App().MultiCoinRewardsKeeper.GetAllMultiCoinConvertEntries(s.Ctx())
+		Expect(convertEntries).To(HaveLen(1))
+		Expect(convertEntries[0].Address).To(Equal(validator1.Address))
+		Expect(convertEntries[0].Rewards.String()).To(Equal("50bcoin"))
+		Expect(convertEntries[0].FailedAttempts).To(Equal(uint64(1)))
+		Expect(s.GetCoinsFromModule(multicoinrewardstypes.ModuleName).String()).To(Equal("50bcoin"))
+	})
+
+	It("Convert rewards with the community pool venue", func() {
+		// Arrange
+		params := s.App().MultiCoinRewardsKeeper.GetParams(s.Ctx())
+		params.MultiCoinConvertVenue = app.CommunityPoolSwapVenueName
+		s.App().MultiCoinRewardsKeeper.SetParams(s.Ctx(), params)
+
+		s.RunTxSuccess(&distributionTypes.MsgFundCommunityPool{
+			Amount:    sdk.NewCoins(sdk.NewInt64Coin(globaltypes.Denom, 1_000_000)),
+			Depositor: i.ALICE,
+		})
+
+		s.RunTxSuccess(&multicoinrewardstypes.MsgToggleMultiCoinRewardsConvert{
+			Creator: validator1.Address,
+			Enabled: true,
+		})
+
+		payoutRewards(s, validator1.Address, i.ACoins(1000))
+		s.RunTxSuccess(&distributionTypes.MsgWithdrawDelegatorReward{
+			DelegatorAddress: validator1.Address,
+			ValidatorAddress: validator1.ValAddress,
+		})
+
+		// ACT
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		// the community pool pays the reference price minus the max slippage: 1000 * 2 * 0.99 = 1980
+		Expect(s.App().BankKeeper.GetAllBalances(s.Ctx(), validator1.AccAddress).String()).To(Equal("10000000000acoin,10000000000bcoin,10000000000ccoin,9000001980tkyve"))
+		Expect(s.GetCoinsFromModule(multicoinrewardstypes.ModuleName)).To(BeEmpty())
+		Expect(s.App().MultiCoinRewardsKeeper.GetAllMultiCoinConvertEntries(s.Ctx())).To(BeEmpty())
+
+		feePool, err := s.App().DistributionKeeper.FeePool.Get(s.Ctx())
+		Expect(err).To(BeNil())
+		Expect(feePool.CommunityPool.AmountOf(i.A_DENOM)).To(Equal(math.LegacyNewDec(1000)))
+	})
+
+	It("Keep rewards in the convert batch if the community pool has insufficient funds", func() {
+		// Arrange
+		params := s.App().MultiCoinRewardsKeeper.GetParams(s.Ctx())
+		params.MultiCoinConvertVenue = app.CommunityPoolSwapVenueName
+		s.App().MultiCoinRewardsKeeper.SetParams(s.Ctx(), params)
+
+		s.RunTxSuccess(&multicoinrewardstypes.MsgToggleMultiCoinRewardsConvert{
+			Creator: validator1.Address,
+			Enabled: true,
+		})
+
+		// the rewards are worth more than the entire supply of the community pool
+		payoutRewards(s, validator1.Address, i.ACoins(1_000_000_000_000))
+		s.RunTxSuccess(&distributionTypes.MsgWithdrawDelegatorReward{
+			DelegatorAddress: validator1.Address,
+			ValidatorAddress: validator1.ValAddress,
+		})
+
+		// ACT
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		Expect(s.App().BankKeeper.GetAllBalances(s.Ctx(), validator1.AccAddress).String()).To(Equal("10000000000acoin,10000000000bcoin,10000000000ccoin,9000000000tkyve"))
+		Expect(s.GetCoinsFromModule(multicoinrewardstypes.ModuleName).String()).To(Equal("1000000000000acoin"))
+
+		convertEntries := s.App().MultiCoinRewardsKeeper.GetAllMultiCoinConvertEntries(s.Ctx())
+		Expect(convertEntries).To(HaveLen(1))
+		Expect(convertEntries[0].FailedAttempts).To(Equal(uint64(1)))
+
+		feePool, err := s.App().DistributionKeeper.FeePool.Get(s.Ctx())
+		Expect(err).To(BeNil())
+		Expect(feePool.CommunityPool.AmountOf(i.A_DENOM).IsZero()).To(BeTrue())
+	})
+
+	It("Try to enable the convert mode without convert denoms", func() {
+		// Arrange
+		params := s.App().MultiCoinRewardsKeeper.GetParams(s.Ctx())
+		params.MultiCoinConvertDenoms = []multicoinrewardstypes.MultiCoinConvertDenomEntry{}
+		s.App().MultiCoinRewardsKeeper.SetParams(s.Ctx(), params)
+
+		// ACT
+		err := s.RunTxError(&multicoinrewardstypes.MsgToggleMultiCoinRewardsConvert{
+			Creator: validator1.Address,
+			Enabled: true,
+		})
+
+		// ASSERT
+		Expect(err.Error()).To(ContainSubstring(multicoinrewardstypes.ErrMultiCoinRewardsConvertUnavailable.Error()))
+
+		status, err := s.App().MultiCoinRewardsKeeper.MultiCoinStatus(s.Ctx(), &multicoinrewardstypes.QueryMultiCoinStatusRequest{Address: validator1.Address})
+		Expect(err).To(BeNil())
+		Expect(status.ConvertEnabled).To(BeFalse())
+	})
+
+	It("Add unconverted rewards to the pending rewards when disabling the convert mode", func() {
+		// Arrange
+		s.RunTxSuccess(&multicoinrewardstypes.MsgToggleMultiCoinRewardsConvert{
+			Creator: validator1.Address,
+			Enabled: true,
+		})
+
+		payoutRewards(s, validator1.Address, i.BCoins(50))
+		s.RunTxSuccess(&distributionTypes.MsgWithdrawDelegatorReward{
+			DelegatorAddress: validator1.Address,
+			ValidatorAddress: validator1.ValAddress,
+		})
+		s.CommitAfterSeconds(1)
+		Expect(s.App().MultiCoinRewardsKeeper.GetAllMultiCoinConvertEntries(s.Ctx())).To(HaveLen(1))
+
+		// ACT
+		s.RunTxSuccess(&multicoinrewardstypes.MsgToggleMultiCoinRewardsConvert{
+			Creator: validator1.Address,
+			Enabled: false,
+		})
+
+		// ASSERT
+		Expect(s.App().MultiCoinRewardsKeeper.GetAllMultiCoinConvertEntries(s.Ctx())).To(BeEmpty())
+
+		pendingEntries := s.App().MultiCoinRewardsKeeper.GetAllMultiCoinPendingRewardsEntries(s.Ctx())
+		Expect(pendingEntries).To(HaveLen(1))
+		Expect(pendingEntries[0].Rewards.String()).To(Equal("50bcoin"))
+		Expect(s.GetCoinsFromModule(multicoinrewardstypes.ModuleName).String()).To(Equal("50bcoin"))
+	})
+
+	It("Convert pending rewards when enabling the convert mode", func() {
+		// Arrange
+		payoutRewards(s, validator1.Address, i.ACoins(1000))
+
+		s.RunTxSuccess(&distributionTypes.MsgWithdrawDelegatorReward{
+			DelegatorAddress: validator1.Address,
+			ValidatorAddress: validator1.ValAddress,
+		})
+		Expect(s.App().MultiCoinRewardsKeeper.GetAllMultiCoinPendingRewardsEntries(s.Ctx())).To(HaveLen(1))
+
+		// ACT
+		s.RunTxSuccess(&multicoinrewardstypes.MsgToggleMultiCoinRewardsConvert{
+			Creator: validator1.Address,
+			Enabled: true,
+		})
+
+		// ASSERT
+		Expect(s.App().MultiCoinRewardsKeeper.GetAllMultiCoinPendingRewardsEntries(s.Ctx())).To(BeEmpty())
+		Expect(s.App().MultiCoinRewardsKeeper.GetAllMultiCoinConvertEntries(s.Ctx())).To(HaveLen(1))
+
+		// ACT
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		Expect(s.App().BankKeeper.GetAllBalances(s.Ctx(), validator1.AccAddress).String()).To(Equal("10000000000acoin,10000000000bcoin,10000000000ccoin,9000001999tkyve"))
+		Expect(s.GetCoinsFromModule(multicoinrewardstypes.ModuleName)).To(BeEmpty())
+	})
+
+	It("Enabling multi-coin rewards disables the convert mode", func() {
+		// Arrange
+		s.RunTxSuccess(&multicoinrewardstypes.MsgToggleMultiCoinRewardsConvert{
+			Creator: validator1.Address,
+			Enabled: true,
+		})
+
+		// ACT
+		s.RunTxSuccess(&multicoinrewardstypes.MsgToggleMultiCoinRewards{
+			Creator: validator1.Address,
+			Enabled: true,
+		})
+
+		// ASSERT
+		status, err := s.App().MultiCoinRewardsKeeper.MultiCoinStatus(s.Ctx(), &multicoinrewardstypes.QueryMultiCoinStatusRequest{Address: validator1.Address})
+		Expect(err).To(BeNil())
+		Expect(status.Enabled).To(BeTrue())
+		Expect(status.ConvertEnabled).To(BeFalse())
+
+		// ACT
+		s.RunTxSuccess(&multicoinrewardstypes.MsgToggleMultiCoinRewardsConvert{
+			Creator: validator1.Address,
+			Enabled: true,
+		})
+
+		// ASSERT
+		status, err = s.App().MultiCoinRewardsKeeper.MultiCoinStatus(s.Ctx(), &multicoinrewardstypes.QueryMultiCoinStatusRequest{Address: validator1.Address})
+		Expect(err).To(BeNil())
+		Expect(status.Enabled).To(BeFalse())
+		Expect(status.ConvertEnabled).To(BeTrue())
+	})
+
+	It("Disable convert mode", func() {
+		// Arrange
+		err := s.RunTxError(&multicoinrewardstypes.MsgToggleMultiCoinRewardsConvert{
+			Creator: validator1.Address,
+			Enabled: false,
+		})
+		Expect(err.Error()).To(ContainSubstring(multicoinrewardstypes.ErrMultiCoinRewardsConvertAlreadyDisabled.Error()))
+
+		s.RunTxSuccess(&multicoinrewardstypes.MsgToggleMultiCoinRewardsConvert{
+			Creator: validator1.Address,
+			Enabled: true,
+		})
+
+		// ACT
+		s.RunTxSuccess(&multicoinrewardstypes.MsgToggleMultiCoinRewardsConvert{
+			Creator: validator1.Address,
+			Enabled: false,
+		})
+
+		payoutRewards(s, validator1.Address, i.ACoins(1000))
+		s.RunTxSuccess(&distributionTypes.MsgWithdrawDelegatorReward{
+			DelegatorAddress: validator1.Address,
+			ValidatorAddress: validator1.ValAddress,
+		})
+
+		// ASSERT
+		Expect(s.App().MultiCoinRewardsKeeper.GetAllMultiCoinConvertEntries(s.Ctx())).To(BeEmpty())
+		Expect(s.App().MultiCoinRewardsKeeper.GetAllMultiCoinPendingRewardsEntries(s.Ctx())).To(HaveLen(1))
+	})
+})
@@ -3,6 +3,8 @@ package keeper
 import (
 	"sort"
 
+	"cosmossdk.io/math"
+
 	"github.com/KYVENetwork/chain/x/multi_coin_rewards/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -21,6 +23,26 @@ func (k Keeper) addPendingRewards(ctx sdk.Context, address string, rewards sdk.C
 	k.SetMultiCoinPendingRewardsEntry(ctx, pendingEntry)
 }
 
+// claimPendingRewards removes the given pending rewards entries and sends their rewards to the address.
+func (k Keeper) claimPendingRewards(ctx sdk.Context, address sdk.AccAddress, entries []types.MultiCoinPendingRewardsEntry) (sdk.Coins, error) {
+	totalRewards := sdk.NewCoins()
+	for _, entry := range entries {
+		totalRewards = totalRewards.Add(entry.Rewards...)
+		k.RemoveMultiCoinPendingRewardsEntry(ctx, &entry)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, address, totalRewards); err != nil {
+		return nil, err
+	}
+
+	return totalRewards, nil
+}
+
+// getPendingRewardsExpiryDate returns the UNIX-timestamp after which the entry gets redistributed
+func (k Keeper) getPendingRewardsExpiryDate(ctx sdk.Context, entry types.MultiCoinPendingRewardsEntry) int64 {
+	return entry.CreationDate + int64(k.GetMultiCoinDistributionPendingTime(ctx))
+}
+
 // ProcessPendingRewardsQueue ...
 func (k Keeper) ProcessPendingRewardsQueue(ctx sdk.Context) error {
 	collectedRewards := sdk.NewCoins()
@@ -33,9 +55,16 @@ func (k Keeper) ProcessPendingRewardsQueue(ctx sdk.Context) error {
 			return true
 		}
 
-		if queueEntry.CreationDate+int64(k.GetMultiCoinDistributionPendingTime(ctx)) <= ctx.BlockTime().Unix() {
+		if k.getPendingRewardsExpiryDate(ctx, queueEntry) <= ctx.BlockTime().Unix() {
 			k.RemoveMultiCoinPendingRewardsEntry(ctx, &queueEntry)
 			collectedRewards = collectedRewards.Add(queueEntry.Rewards...)
+
+			_ = ctx.EventManager().EmitTypedEvent(&types.EventPendingMultiCoinRewardsExpired{
+				Index:        queueEntry.Index,
+				Address:      queueEntry.Address,
+				Rewards:      queueEntry.Rewards.String(),
+				CreationDate: queueEntry.CreationDate,
+			})
 			return true
 		}
 
@@ -49,29 +78,20 @@ func (k Keeper) ProcessPendingRewardsQueue(ctx sdk.Context) error {
 	return nil
 }
 
-// DistributeNonClaimedRewards takes all non-claimed rewards which have exceeding the claim time
-// and re-distribute them to the pools according to the redistribution-policy.
-func (k Keeper) DistributeNonClaimedRewards(ctx sdk.Context) error {
-	policy, err := k.MultiCoinDistributionPolicy.Get(ctx)
-	if err != nil {
-		return err
-	}
-
+// computeMultiCoinDistribution calculates which pool accounts receive which coins of the given rewards
+// under the given policy. Pools which do not exist or are disabled are skipped and their share is
+// distributed among the remaining pools of the same denom. Coins which can not be distributed are returned
+// as remaining.
+func (k Keeper) computeMultiCoinDistribution(ctx sdk.Context, policy types.MultiCoinDistributionPolicy, rewards sdk.Coins) (poolRewards []types.MultiCoinDistributionPoolRewards, skippedPoolIds []uint64, remaining sdk.Coins, err error) {
 	distributionMap, err := types.ParseAndNormalizeMultiCoinDistributionMap(policy)
 	if err != nil {
-		return err
+		return nil, nil, nil, err
 	}
 
 	// Store rewards for all pools. There could be multiple rules which re-direct coins to the same pool
-	type PoolRewardsBasket struct {
-		account sdk.AccAddress
-		rewards sdk.Coins
-		poolId  uint64
-	}
-	poolRewardBaskets := make(map[uint64]PoolRewardsBasket)
-
-	// Get all rewards
-	rewards := k.bankKeeper.GetAllBalances(ctx, k.accountKeeper.GetModuleAddress(types.MultiCoinRewardsRedistributionAccountName))
+	poolRewardBaskets := make(map[uint64]types.MultiCoinDistributionPoolRewards)
+	skippedPools := make(map[uint64]struct{})
+	distributed := sdk.NewCoins()
 
 	// Iterate every coin denom and re-distribute accordingly
 	for _, coin := range rewards {
@@ -81,30 +101,45 @@ func (k Keeper) DistributeNonClaimedRewards(ctx sdk.Context) error {
 			continue
 		}
 
-		// weight-map contains for every denom the destination pools together with a weight.
+		// Only consider pools which exist and are not disabled
+		availableWeights := make([]types.MultiCoinDistributionPoolNormalizedEntry, 0)
+		totalAvailableWeight := math.LegacyZeroDec()
 		for _, weight := range weightMap {
-			// Check if pool is already in temporary map
-			poolBasket, ok := poolRewardBaskets[weight.PoolId]
+			pool, err := k.poolKeeper.GetPoolWithError(ctx, weight.PoolId)
+			if err != nil || pool.Disabled {
+				skippedPools[weight.PoolId] = struct{}{}
+				continue
+			}
+
 			// If pool is not registered in map yet, initialize new pool basket.
-			if !ok {
-				// if not, get pool from id
-				pool, err := k.poolKeeper.GetPoolWithError(ctx, weight.PoolId)
-				if err != nil {
-					// if pool does not exist, the distribution map is incorrect, cancel the process
-					return err
+			if _, ok := poolRewardBaskets[weight.PoolId]; !ok {
+				poolRewardBaskets[weight.PoolId] = types.MultiCoinDistributionPoolRewards{
+					PoolId:      pool.Id,
+					PoolAccount: pool.GetPoolAccount().String(),
+					Rewards:     sdk.NewCoins(),
 				}
+			}
 
-				poolBasket.poolId = pool.Id
-				poolBasket.account = pool.GetPoolAccount()
-				poolBasket.rewards = sdk.NewCoins()
-				poolRewardBaskets[weight.PoolId] = poolBasket
+			availableWeights = append(availableWeights, weight)
+			totalAvailableWeight = totalAvailableWeight.Add(weight.NormalizedWeight)
+		}
+
+		// weight-map contains for every denom the destination pools together with a weight.
+		for _, weight := range availableWeights {
+			poolBasket := poolRewardBaskets[weight.PoolId]
+
+			// If pools were skipped, their share is distributed among the remaining pools
+			share := weight.NormalizedWeight.MulInt(coin.Amount)
+			if len(availableWeights) < len(weightMap) {
+				share = share.Quo(totalAvailableWeight)
 			}
 
 			// Truncate int ensures that there are never more tokens distributed than available
-			poolReward := sdk.NewCoin(coin.Denom, weight.NormalizedWeight.MulInt(rewards.AmountOf(coin.Denom)).TruncateInt())
+			poolReward := sdk.NewCoin(coin.Denom, share.TruncateInt())
 
 			// Add reward to pool
-			poolBasket.rewards = poolBasket.rewards.Add(poolReward)
+			poolBasket.Rewards = poolBasket.Rewards.Add(poolReward)
+			distributed = distributed.Add(poolReward)
 
 			// Update map
 			poolRewardBaskets[weight.PoolId] = poolBasket
@@ -112,15 +147,48 @@ func (k Keeper) DistributeNonClaimedRewards(ctx sdk.Context) error {
 	}
 
 	// Sort PoolRewards for determinism
-	accountList := make([]PoolRewardsBasket, 0)
-	for _, account := range poolRewardBaskets {
-		accountList = append(accountList, account)
+	poolRewards = make([]types.MultiCoinDistributionPoolRewards, 0)
+	for _, basket := range poolRewardBaskets {
+		if !basket.Rewards.IsZero() {
+			poolRewards = append(poolRewards, basket)
+		}
+	}
+	sort.Slice(poolRewards, func(i, j int) bool { return poolRewards[i].PoolId < poolRewards[j].PoolId })
+
+	skippedPoolIds = make([]uint64, 0)
+	for poolId := range skippedPools {
+		skippedPoolIds = append(skippedPoolIds, poolId)
+	}
+	sort.Slice(skippedPoolIds, func(i, j int) bool { return skippedPoolIds[i] < skippedPoolIds[j] })
+
+	return poolRewards, skippedPoolIds, rewards.Sub(distributed...), nil
+}
+
+// DistributeNonClaimedRewards takes all non-claimed rewards which have exceeding the claim time
+// and re-distribute them to the pools according to the redistribution-policy.
+// Pools which do not exist or are disabled are skipped.
+func (k Keeper) DistributeNonClaimedRewards(ctx sdk.Context) error {
+	policy, err := k.MultiCoinDistributionPolicy.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Get all rewards
+	rewards := k.bankKeeper.GetAllBalances(ctx, k.accountKeeper.GetModuleAddress(types.MultiCoinRewardsRedistributionAccountName))
+
+	poolRewards, _, _, err := k.computeMultiCoinDistribution(ctx, policy, rewards)
+	if err != nil {
+		return err
 	}
-	sort.Slice(accountList, func(i, j int) bool { return accountList[i].poolId < accountList[j].poolId })
 
 	// Redistribute all tokens
-	for _, account := range accountList {
-		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.MultiCoinRewardsRedistributionAccountName, account.account, account.rewards); err != nil {
+	for _, poolReward := range poolRewards {
+		account, err := sdk.AccAddressFromBech32(poolReward.PoolAccount)
+		if err != nil {
+			return err
+		}
+
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.MultiCoinRewardsRedistributionAccountName, account, poolReward.Rewards); err != nil {
 			return err
 		}
 	}
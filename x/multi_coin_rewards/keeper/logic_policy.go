@@ -0,0 +1,184 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	"github.com/KYVENetwork/chain/x/multi_coin_rewards/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// addMultiCoinDistributionPolicyVersion stores a new version of the distribution policy.
+// If the activation time is not in the future the policy becomes active immediately,
+// otherwise it gets scheduled and is activated in the BeginBlock.
+func (k Keeper) addMultiCoinDistributionPolicyVersion(ctx sdk.Context, policy types.MultiCoinDistributionPolicy, activationTime int64) (types.MultiCoinDistributionPolicyVersion, error) {
+	version, err := k.MultiCoinDistributionPolicyVersionSequence.Next(ctx)
+	if err != nil {
+		return types.MultiCoinDistributionPolicyVersion{}, err
+	}
+
+	now := ctx.BlockTime().Unix()
+	if activationTime < now {
+		activationTime = now
+	}
+
+	policyVersion := types.MultiCoinDistributionPolicyVersion{
+		Version:        version,
+		ActivationTime: activationTime,
+		Policy:         policy,
+		CreationDate:   now,
+	}
+
+	if err := k.MultiCoinDistributionPolicyVersions.Set(ctx, version, policyVersion); err != nil {
+		return policyVersion, err
+	}
+
+	if activationTime > now {
+		return policyVersion, k.MultiCoinDistributionPolicySchedule.Set(ctx, collections.Join(activationTime, version))
+	}
+
+	return policyVersion, k.activateMultiCoinDistributionPolicyVersion(ctx, policyVersion)
+}
+
+// activateMultiCoinDistributionPolicyVersion makes the given version the active distribution policy
+func (k Keeper) activateMultiCoinDistributionPolicyVersion(ctx sdk.Context, policyVersion types.MultiCoinDistributionPolicyVersion) error {
+	if err := k.MultiCoinDistributionPolicy.Set(ctx, policyVersion.Policy); err != nil {
+		return err
+	}
+
+	if err := k.MultiCoinDistributionPolicyActiveVersion.Set(ctx, policyVersion.Version); err != nil {
+		return err
+	}
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventActivateMultiCoinDistributionPolicy{
+		Version: policyVersion.Version,
+	})
+
+	return nil
+}
+
+// cancelMultiCoinDistributionPolicyVersion removes a scheduled version of the distribution
+// policy, so it never becomes active.
+func (k Keeper) cancelMultiCoinDistributionPolicyVersion(ctx sdk.Context, policyVersion types.MultiCoinDistributionPolicyVersion) error {
+	if err := k.MultiCoinDistributionPolicySchedule.Remove(ctx, collections.Join(policyVersion.ActivationTime, policyVersion.Version)); err != nil {
+		return err
+	}
+
+	return k.MultiCoinDistributionPolicyVersions.Remove(ctx, policyVersion.Version)
+}
+
+// MigrateMultiCoinDistributionPolicy creates the first version of the distribution policy
+// from the policy which was set before policy versions existed. It does nothing if
+// versions already exist or no policy is set.
+func (k Keeper) MigrateMultiCoinDistributionPolicy(ctx sdk.Context) error {
+	if len(k.GetAllMultiCoinDistributionPolicyVersions(ctx)) > 0 {
+		return nil
+	}
+
+	policy, err := k.MultiCoinDistributionPolicy.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	_, err = k.addMultiCoinDistributionPolicyVersion(ctx, policy, ctx.BlockTime().Unix())
+	return err
+}
+
+// ActivateScheduledPolicies activates all scheduled policy versions whose activation time
+// has been reached. Versions are ordered by their version number, so a scheduled version
+// never replaces a newer version which was already activated.
+func (k Keeper) ActivateScheduledPolicies(ctx sdk.Context) error {
+	iterator, err := k.MultiCoinDistributionPolicySchedule.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	due := make([]collections.Pair[int64, uint64], 0)
+	for ; iterator.Valid(); iterator.Next() {
+		key, err := iterator.Key()
+		if err != nil {
+			iterator.Close()
+			return err
+		}
+
+		if key.K1() > ctx.BlockTime().Unix() {
+			break
+		}
+
+		due = append(due, key)
+	}
+	iterator.Close()
+
+	for _, key := range due {
+		if err := k.MultiCoinDistributionPolicySchedule.Remove(ctx, key); err != nil {
+			return err
+		}
+
+		policyVersion, err := k.MultiCoinDistributionPolicyVersions.Get(ctx, key.K2())
+		if err != nil {
+			return err
+		}
+
+		// skip versions which were superseded by a newer version
+		activeVersion, err := k.MultiCoinDistributionPolicyActiveVersion.Get(ctx)
+		if err == nil && activeVersion > policyVersion.Version {
+			continue
+		} else if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+
+		if err := k.activateMultiCoinDistributionPolicyVersion(ctx, policyVersion); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetAllMultiCoinDistributionPolicyVersions returns all policy versions ordered by their version
+func (k Keeper) GetAllMultiCoinDistributionPolicyVersions(ctx sdk.Context) []types.MultiCoinDistributionPolicyVersion {
+	versions := make([]types.MultiCoinDistributionPolicyVersion, 0)
+	if iter, err := k.MultiCoinDistributionPolicyVersions.Iterate(ctx, nil); err == nil {
+		if values, err := iter.Values(); err == nil {
+			versions = append(versions, values...)
+		}
+	}
+	return versions
+}
+
+// InitMultiCoinDistributionPolicyVersions restores the policy versions from the genesis. All versions
+// with an activation time in the future are scheduled, the highest of the other versions is active.
+func (k Keeper) InitMultiCoinDistributionPolicyVersions(ctx sdk.Context, versions []types.MultiCoinDistributionPolicyVersion) error {
+	now := ctx.BlockTime().Unix()
+
+	var active *types.MultiCoinDistributionPolicyVersion
+	nextVersion := uint64(0)
+
+	for i, policyVersion := range versions {
+		if err := k.MultiCoinDistributionPolicyVersions.Set(ctx, policyVersion.Version, policyVersion); err != nil {
+			return err
+		}
+
+		if policyVersion.ActivationTime > now {
+			if err := k.MultiCoinDistributionPolicySchedule.Set(ctx, collections.Join(policyVersion.ActivationTime, policyVersion.Version)); err != nil {
+				return err
+			}
+		} else if active == nil || policyVersion.Version > active.Version {
+			active = &versions[i]
+		}
+
+		if policyVersion.Version >= nextVersion {
+			nextVersion = policyVersion.Version + 1
+		}
+	}
+
+	if active != nil {
+		if err := k.MultiCoinDistributionPolicyActiveVersion.Set(ctx, active.Version); err != nil {
+			return err
+		}
+	}
+
+	return k.MultiCoinDistributionPolicyVersionSequence.Set(ctx, nextVersion)
+}
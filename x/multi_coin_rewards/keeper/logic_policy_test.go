@@ -0,0 +1,418 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	multicoinrewardstypes "github.com/KYVENetwork/chain/x/multi_coin_rewards/types"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	mintTypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+/*
+
+TEST CASES - logic_policy.go
+
+* Activate a policy immediately
+* Schedule a policy and activate it once the activation time is reached
+* Activate multiple scheduled policies in order
+* Do not replace a newer policy with an older scheduled policy
+* Cancel a scheduled policy
+* Try to cancel an active policy
+* Try to cancel a scheduled policy as non-admin
+* Migrate the policy which was set before policy versions existed
+* Preview the distribution of the active policy
+* Preview the distribution of a given policy
+* Preview an invalid policy
+* Skip missing and disabled pools during the distribution
+
+*/
+
+var _ = Describe("logic_policy.go", Ordered, func() {
+	var s *i.KeeperTestSuite
+	var gov string
+	var validator1 i.TestValidatorAddress
+
+	fundRedistributionAccount := func(coins sdk.Coins) {
+		Expect(s.App().BankKeeper.MintCoins(s.Ctx(), mintTypes.ModuleName, coins)).To(Succeed())
+		Expect(s.App().BankKeeper.SendCoinsFromModuleToModule(s.Ctx(), mintTypes.ModuleName, multicoinrewardstypes.MultiCoinRewardsRedistributionAccountName, coins)).To(Succeed())
+	}
+
+	policyForPools := func(poolIds ...uint64) *multicoinrewardstypes.MultiCoinDistributionPolicy {
+		weights := make([]*multicoinrewardstypes.MultiCoinDistributionPoolWeightEntry, 0)
+		for _, poolId := range poolIds {
+			weights = append(weights, &multicoinrewardstypes.MultiCoinDistributionPoolWeightEntry{
+				PoolId: poolId,
+				Weight: math.LegacyMustNewDecFromStr("1"),
+			})
+		}
+
+		return &multicoinrewardstypes.MultiCoinDistributionPolicy{
+			Entries: []*multicoinrewardstypes.MultiCoinDistributionDenomEntry{
+				{Denom: "acoin", PoolWeights: weights},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		// init new clean chain
+		s = i.NewCleanChain()
+		gov = s.App().GovKeeper.GetGovernanceAccount(s.Ctx()).GetAddress().String()
+
+		// create pool
+		msg := &pooltypes.MsgCreatePool{
+			Authority:            gov,
+			UploadInterval:       60,
+			MaxBundleSize:        100,
+			InflationShareWeight: math.LegacyZeroDec(),
+			Binaries:             "{}",
+		}
+		s.RunTxPoolSuccess(msg)
+
+		// Create second pool
+		s.RunTxPoolSuccess(msg)
+
+		// create staker
+		validator1 = s.CreateNewValidator("MyValidator-1", 1000*i.KYVE)
+
+		params := s.App().MultiCoinRewardsKeeper.GetParams(s.Ctx())
+		params.MultiCoinDistributionPolicyAdminAddress = validator1.Address
+		s.App().MultiCoinRewardsKeeper.SetParams(s.Ctx(), params)
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Activate a policy immediately", func() {
+		// ACT
+		s.RunTxSuccess(&multicoinrewardstypes.MsgSetMultiCoinRewardsDistributionPolicy{
+			Creator: validator1.Address,
+			Policy:  policyForPools(0, 1),
+		})
+
+		// ASSERT
+		policy, err := s.App().MultiCoinRewardsKeeper.MultiCoinDistributionPolicy.Get(s.Ctx())
+		Expect(err).To(BeNil())
+		Expect(policy.Entries[0].PoolWeights).To(HaveLen(2))
+
+		res, err := s.App().MultiCoinRewardsKeeper.MultiCoinDistributionPolicyVersions(s.Ctx(), &multicoinrewardstypes.QueryMultiCoinDistributionPolicyVersionsRequest{})
+		Expect(err).To(BeNil())
+		Expect(res.Versions).To(HaveLen(1))
+		Expect(res.Versions[0].Version).To(Equal(uint64(0)))
+		Expect(res.Versions[0].ActivationTime).To(Equal(s.Ctx().BlockTime().Unix()))
+		Expect(res.HasActiveVersion).To(BeTrue())
+		Expect(res.ActiveVersion).To(Equal(uint64(0)))
+	})
+
+	It("Schedule a policy and activate it once the activation time is reached", func() {
+		// ARRANGE
+		s.RunTxSuccess(&multicoinrewardstypes.MsgSetMultiCoinRewardsDistributionPolicy{
+			Creator: validator1.Address,
+			Policy:  policyForPools(0),
+		})
+
+		activationTime := s.Ctx().BlockTime().Unix() + 100
+
+		// ACT
+		s.RunTxSuccess(&multicoinrewardstypes.MsgSetMultiCoinRewardsDistributionPolicy{
+			Creator:        validator1.Address,
+			Policy:         policyForPools(0, 1),
+			ActivationTime: activationTime,
+		})
+
+		// ASSERT
+		policy, _ := s.App().MultiCoinRewardsKeeper.MultiCoinDistributionPolicy.Get(s.Ctx())
+		Expect(policy.Entries[0].PoolWeights).To(HaveLen(1))
+
+		res, err := s.App().MultiCoinRewardsKeeper.MultiCoinDistributionPolicyVersions(s.Ctx(), &multicoinrewardstypes.QueryMultiCoinDistributionPolicyVersionsRequest{})
+		Expect(err).To(BeNil())
+		Expect(res.Versions).To(HaveLen(2))
+		Expect(res.Versions[1].ActivationTime).To(Equal(activationTime))
+		Expect(res.ActiveVersion).To(Equal(uint64(0)))
+
+		// ACT
+		s.CommitAfterSeconds(50)
+
+		// ASSERT
+		policy, _ = s.App().MultiCoinRewardsKeeper.MultiCoinDistributionPolicy.Get(s.Ctx())
+		Expect(policy.Entries[0].PoolWeights).To(HaveLen(1))
+
+		// ACT
+		s.CommitAfterSeconds(50)
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		policy, _ = s.App().MultiCoinRewardsKeeper.MultiCoinDistributionPolicy.Get(s.Ctx())
+		Expect(policy.Entries[0].PoolWeights).To(HaveLen(2))
+
+		res, err = s.App().MultiCoinRewardsKeeper.MultiCoinDistributionPolicyVersions(s.Ctx(), &multicoinrewardstypes.QueryMultiCoinDistributionPolicyVersionsRequest{})
+		Expect(err).To(BeNil())
+		Expect(res.ActiveVersion).To(Equal(uint64(1)))
+	})
+
+	It("Activate multiple scheduled policies in order", func() {
+		// ARRANGE
+		now := s.Ctx().BlockTime().Unix()
+
+		s.RunTxSuccess(&multicoinrewardstypes.MsgSetMultiCoinRewardsDistributionPolicy{
+			Creator:        validator1.Address,
+			Policy:         policyForPools(0),
+			ActivationTime: now + 20,
+		})
+		s.RunTxSuccess(&multicoinrewardstypes.MsgSetMultiCoinRewardsDistributionPolicy{
+			Creator:        validator1.Address,
+			Policy:         policyForPools(0, 1),
+			ActivationTime: now + 10,
+		})
+
+		// ACT
+		s.CommitAfterSeconds(30)
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		// version 0 was superseded by version 1 and gets skipped
+		policy, _ := s.App().MultiCoinRewardsKeeper.MultiCoinDistributionPolicy.Get(s.Ctx())
+		Expect(policy.Entries[0].PoolWeights).To(HaveLen(2))
+
+		res, err := s.App().MultiCoinRewardsKeeper.MultiCoinDistributionPolicyVersions(s.Ctx(), &multicoinrewardstypes.QueryMultiCoinDistributionPolicyVersionsRequest{})
+		Expect(err).To(BeNil())
+		Expect(res.Versions).To(HaveLen(2))
+		Expect(res.ActiveVersion).To(Equal(uint64(1)))
+	})
+
+	It("Do not replace a newer policy with an older scheduled policy", func() {
+		// ARRANGE
+		s.RunTxSuccess(&multicoinrewardstypes.MsgSetMultiCoinRewardsDistributionPolicy{
+			Creator:        validator1.Address,
+			Policy:         policyForPools(0),
+			ActivationTime: s.Ctx().BlockTime().Unix() + 100,
+		})
+
+		// ACT
+		s.RunTxSuccess(&multicoinrewardstypes.MsgSetMultiCoinRewardsDistributionPolicy{
+			Creator: validator1.Address,
+			Policy:  policyForPools(0, 1),
+		})
+
+		s.CommitAfterSeconds(100)
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		policy, _ := s.App().MultiCoinRewardsKeeper.MultiCoinDistributionPolicy.Get(s.Ctx())
+		Expect(policy.Entries[0].PoolWeights).To(HaveLen(2))
+
+		res, err := s.App().MultiCoinRewardsKeeper.MultiCoinDistributionPolicyVersions(s.Ctx(), &multicoinrewardstypes.QueryMultiCoinDistributionPolicyVersionsRequest{})
+		Expect(err).To(BeNil())
+		Expect(res.Versions).To(HaveLen(2))
+		Expect(res.ActiveVersion).To(Equal(uint64(1)))
+	})
+
+	It("Cancel a scheduled policy", func() {
+		// ARRANGE
+		s.RunTxSuccess(&multicoinrewardstypes.MsgSetMultiCoinRewardsDistributionPolicy{
+			Creator: validator1.Address,
+			Policy:  policyForPools(0),
+		})
+		s.RunTxSuccess(&multicoinrewardstypes.MsgSetMultiCoinRewardsDistributionPolicy{
+			Creator:        validator1.Address,
+			Policy:         policyForPools(0, 1),
+			ActivationTime: s.Ctx().BlockTime().Unix() + 100,
+		})
+
+		// ACT
+		s.RunTxSuccess(&multicoinrewardstypes.MsgCancelMultiCoinDistributionPolicyVersion{
+			Creator: validator1.Address,
+			Version: 1,
+		})
+
+		s.CommitAfterSeconds(100)
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		policy, _ := s.App().MultiCoinRewardsKeeper.MultiCoinDistributionPolicy.Get(s.Ctx())
+		Expect(policy.Entries[0].PoolWeights).To(HaveLen(1))
+
+		res, err := s.App().MultiCoinRewardsKeeper.MultiCoinDistributionPolicyVersions(s.Ctx(), &multicoinrewardstypes.QueryMultiCoinDistributionPolicyVersionsRequest{})
+		Expect(err).To(BeNil())
+		Expect(res.Versions).To(HaveLen(1))
+		Expect(res.ActiveVersion).To(Equal(uint64(0)))
+
+		// a new version does not reuse the cancelled version number
+		s.RunTxSuccess(&multicoinrewardstypes.MsgSetMultiCoinRewardsDistributionPolicy{
+			Creator: validator1.Address,
+			Policy:  policyForPools(0, 1),
+		})
+
+		res, err = s.App().MultiCoinRewardsKeeper.MultiCoinDistributionPolicyVersions(s.Ctx(), &multicoinrewardstypes.QueryMultiCoinDistributionPolicyVersionsRequest{})
+		Expect(err).To(BeNil())
+		Expect(res.ActiveVersion).To(Equal(uint64(2)))
+	})
+
+	It("Try to cancel an active policy", func() {
+		// ARRANGE
+		s.RunTxSuccess(&multicoinrewardstypes.MsgSetMultiCoinRewardsDistributionPolicy{
+			Creator: validator1.Address,
+			Policy:  policyForPools(0),
+		})
+
+		// ACT
+		err := s.RunTxError(&multicoinrewardstypes.MsgCancelMultiCoinDistributionPolicyVersion{
+			Creator: validator1.Address,
+			Version: 0,
+		})
+
+		// ASSERT
+		Expect(err).To(HaveOccurred())
+
+		// ACT
+		err = s.RunTxError(&multicoinrewardstypes.MsgCancelMultiCoinDistributionPolicyVersion{
+			Creator: validator1.Address,
+			Version: 5,
+		})
+
+		// ASSERT
+		Expect(err).To(HaveOccurred())
+
+		res, err := s.App().MultiCoinRewardsKeeper.MultiCoinDistributionPolicyVersions(s.Ctx(), &multicoinrewardstypes.QueryMultiCoinDistributionPolicyVersionsRequest{})
+		Expect(err).To(BeNil())
+		Expect(res.Versions).To(HaveLen(1))
+		Expect(res.ActiveVersion).To(Equal(uint64(0)))
+	})
+
+	It("Try to cancel a scheduled policy as non-admin", func() {
+		// ARRANGE
+		s.RunTxSuccess(&multicoinrewardstypes.MsgSetMultiCoinRewardsDistributionPolicy{
+			Creator:        validator1.Address,
+			Policy:         policyForPools(0),
+			ActivationTime: s.Ctx().BlockTime().Unix() + 100,
+		})
+
+		// ACT
+		err := s.RunTxError(&multicoinrewardstypes.MsgCancelMultiCoinDistributionPolicyVersion{
+			Creator: i.ALICE,
+			Version: 0,
+		})
+
+		// ASSERT
+		Expect(err).To(HaveOccurred())
+
+		res, err := s.App().MultiCoinRewardsKeeper.MultiCoinDistributionPolicyVersions(s.Ctx(), &multicoinrewardstypes.QueryMultiCoinDistributionPolicyVersionsRequest{})
+		Expect(err).To(BeNil())
+		Expect(res.Versions).To(HaveLen(1))
+	})
+
+	It("Migrate the policy which was set before policy versions existed", func() {
+		// ARRANGE
+		Expect(s.App().MultiCoinRewardsKeeper.MultiCoinDistributionPolicy.Set(s.Ctx(), *policyForPools(0, 1))).To(Succeed())
+
+		// ACT
+		Expect(s.App().MultiCoinRewardsKeeper.MigrateMultiCoinDistributionPolicy(s.Ctx())).To(Succeed())
+		Expect(s.App().MultiCoinRewardsKeeper.MigrateMultiCoinDistributionPolicy(s.Ctx())).To(Succeed())
+
+		// ASSERT
+		res, err := s.App().MultiCoinRewardsKeeper.MultiCoinDistributionPolicyVersions(s.Ctx(), &multicoinrewardstypes.QueryMultiCoinDistributionPolicyVersionsRequest{})
+		Expect(err).To(BeNil())
+		Expect(res.Versions).To(HaveLen(1))
+		Expect(res.Versions[0].Policy.Entries[0].PoolWeights).To(HaveLen(2))
+		Expect(res.HasActiveVersion).To(BeTrue())
+		Expect(res.ActiveVersion).To(Equal(uint64(0)))
+	})
+
+	It("Preview the distribution of the active policy", func() {
+		// ARRANGE
+		fundRedistributionAccount(append(i.ACoins(100), i.BCoins(30)...))
+
+		s.RunTxSuccess(&multicoinrewardstypes.MsgSetMultiCoinRewardsDistributionPolicy{
+			Creator: validator1.Address,
+			Policy:  policyForPools(0, 1),
+		})
+
+		// ACT
+		res, err := s.App().MultiCoinRewardsKeeper.MultiCoinDistributionPreview(s.Ctx(), &multicoinrewardstypes.QueryMultiCoinDistributionPreviewRequest{})
+
+		// ASSERT
+		Expect(err).To(BeNil())
+		Expect(res.PoolRewards).To(HaveLen(2))
+
+		p0, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		p1, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 1)
+
+		Expect(res.PoolRewards[0].PoolId).To(Equal(uint64(0)))
+		Expect(res.PoolRewards[0].PoolAccount).To(Equal(p0.GetPoolAccount().String()))
+		Expect(res.PoolRewards[0].Rewards.String()).To(Equal("50acoin"))
+		Expect(res.PoolRewards[1].PoolId).To(Equal(uint64(1)))
+		Expect(res.PoolRewards[1].PoolAccount).To(Equal(p1.GetPoolAccount().String()))
+		Expect(res.PoolRewards[1].Rewards.String()).To(Equal("50acoin"))
+		Expect(res.SkippedPoolIds).To(BeEmpty())
+		Expect(res.Remaining.String()).To(Equal("30bcoin"))
+
+		// preview must not move any coins
+		Expect(s.GetCoinsFromModule(multicoinrewardstypes.MultiCoinRewardsRedistributionAccountName).String()).To(Equal("100acoin,30bcoin"))
+	})
+
+	It("Preview the distribution of a given policy", func() {
+		// ARRANGE
+		fundRedistributionAccount(i.ACoins(100))
+
+		// ACT
+		res, err := s.App().MultiCoinRewardsKeeper.MultiCoinDistributionPreview(s.Ctx(), &multicoinrewardstypes.QueryMultiCoinDistributionPreviewRequest{
+			Policy: policyForPools(1, 5),
+		})
+
+		// ASSERT
+		Expect(err).To(BeNil())
+		Expect(res.PoolRewards).To(HaveLen(1))
+		Expect(res.PoolRewards[0].PoolId).To(Equal(uint64(1)))
+		Expect(res.PoolRewards[0].Rewards.String()).To(Equal("100acoin"))
+		Expect(res.SkippedPoolIds).To(Equal([]uint64{5}))
+		Expect(res.Remaining).To(BeEmpty())
+	})
+
+	It("Preview an invalid policy", func() {
+		// ACT
+		_, err := s.App().MultiCoinRewardsKeeper.MultiCoinDistributionPreview(s.Ctx(), &multicoinrewardstypes.QueryMultiCoinDistributionPreviewRequest{
+			Policy: policyForPools(0, 0),
+		})
+
+		// ASSERT
+		Expect(err).NotTo(BeNil())
+	})
+
+	It("Skip missing and disabled pools during the distribution", func() {
+		// ARRANGE
+		fundRedistributionAccount(i.ACoins(90))
+
+		s.RunTxPoolSuccess(&pooltypes.MsgCreatePool{
+			Authority:            gov,
+			UploadInterval:       60,
+			MaxBundleSize:        100,
+			InflationShareWeight: math.LegacyZeroDec(),
+			Binaries:             "{}",
+		})
+		s.RunTxPoolSuccess(&pooltypes.MsgDisablePool{
+			Authority: gov,
+			Id:        2,
+		})
+
+		s.RunTxSuccess(&multicoinrewardstypes.MsgSetMultiCoinRewardsDistributionPolicy{
+			Creator: validator1.Address,
+			Policy:  policyForPools(0, 1, 2, 5),
+		})
+
+		// ACT
+		Expect(s.App().MultiCoinRewardsKeeper.DistributeNonClaimedRewards(s.Ctx())).To(Succeed())
+
+		// ASSERT
+		p0, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		p1, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 1)
+		p2, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 2)
+
+		Expect(s.GetCoinsFromAddress(p0.GetPoolAccount().String()).String()).To(Equal("45acoin"))
+		Expect(s.GetCoinsFromAddress(p1.GetPoolAccount().String()).String()).To(Equal("45acoin"))
+		Expect(s.GetCoinsFromAddress(p2.GetPoolAccount().String()).String()).To(BeEmpty())
+		Expect(s.GetCoinsFromModule(multicoinrewardstypes.MultiCoinRewardsRedistributionAccountName).String()).To(BeEmpty())
+	})
+})
@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"github.com/KYVENetwork/chain/x/multi_coin_rewards/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ClaimPendingMultiCoinRewards claims the pending rewards entries of the creator. If the
+// creator has not enabled multi-coin rewards yet, claiming opts in to multi-coin rewards.
+// In contrast to MsgToggleMultiCoinRewards single entries can be claimed.
+func (k msgServer) ClaimPendingMultiCoinRewards(goCtx context.Context, msg *types.MsgClaimPendingMultiCoinRewards) (*types.MsgClaimPendingMultiCoinRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	accountAddress, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	// Every entry can only be claimed once, also if the message was not validated
+	indices := make(map[uint64]struct{})
+	for _, index := range msg.Indices {
+		if _, ok := indices[index]; ok {
+			return nil, errors.Wrapf(errorsTypes.ErrInvalidRequest, types.ErrDuplicatePendingRewardsEntry.Error(), index)
+		}
+		indices[index] = struct{}{}
+	}
+
+	enabled, err := k.MultiCoinRewardsEnabled.Has(ctx, accountAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// Claiming pending rewards opts in to multi-coin rewards
+	if !enabled {
+		if err := k.MultiCoinRewardsEnabled.Set(ctx, accountAddress); err != nil {
+			return nil, err
+		}
+
+		// Multi-coin rewards and the convert mode are mutually exclusive
+		if err := k.disableConvert(ctx, accountAddress); err != nil {
+			return nil, err
+		}
+
+		_ = ctx.EventManager().EmitTypedEvent(&types.EventToggleMultiCoinRewards{
+			Address:               msg.Creator,
+			Enabled:               true,
+			PendingRewardsClaimed: sdk.NewCoins().String(),
+		})
+	}
+
+	var entries []types.MultiCoinPendingRewardsEntry
+	if len(msg.Indices) == 0 {
+		entries, _ = k.GetMultiCoinPendingRewardsEntriesByIndex2(ctx, msg.Creator)
+	} else {
+		for _, index := range msg.Indices {
+			entry, found := k.GetMultiCoinPendingRewardsEntry(ctx, index)
+			if !found || entry.Address != msg.Creator {
+				return nil, errors.Wrapf(errorsTypes.ErrNotFound, types.ErrPendingRewardsEntryNotFound.Error(), index, msg.Creator)
+			}
+			entries = append(entries, entry)
+		}
+	}
+
+	claimedRewards, err := k.claimPendingRewards(ctx, accountAddress, entries)
+	if err != nil {
+		return nil, err
+	}
+
+	claimedIndices := make([]uint64, 0, len(entries))
+	for _, entry := range entries {
+		claimedIndices = append(claimedIndices, entry.Index)
+	}
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventClaimPendingMultiCoinRewards{
+		Address:        msg.Creator,
+		Indices:        claimedIndices,
+		RewardsClaimed: claimedRewards.String(),
+	})
+
+	return &types.MsgClaimPendingMultiCoinRewardsResponse{}, nil
+}
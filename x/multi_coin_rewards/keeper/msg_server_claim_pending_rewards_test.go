@@ -0,0 +1,250 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/KYVENetwork/chain/x/multi_coin_rewards/keeper"
+	multicoinrewardstypes "github.com/KYVENetwork/chain/x/multi_coin_rewards/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distributionTypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+)
+
+/*
+
+TEST CASES - msg_server_claim_pending_rewards.go
+
+* Opt in and claim all pending rewards
+* Opt in and claim a single pending rewards entry
+* Claim pending rewards received after disabling multi-coin rewards
+* Try to claim a pending rewards entry of another address
+* Try to claim a pending rewards entry twice without message validation
+* Query pending rewards with their expiry date
+* Emit an event for every expired pending rewards entry
+
+*/
+
+var _ = Describe("msg_server_claim_pending_rewards.go", Ordered, func() {
+	var s *i.KeeperTestSuite
+	var validator1 i.TestValidatorAddress
+	var validator2 i.TestValidatorAddress
+
+	withdrawRewards := func(validator i.TestValidatorAddress) {
+		s.RunTxSuccess(&distributionTypes.MsgWithdrawDelegatorReward{
+			DelegatorAddress: validator.Address,
+			ValidatorAddress: validator.ValAddress,
+		})
+	}
+
+	BeforeEach(func() {
+		// init new clean chain
+		s = i.NewCleanChain()
+		gov := s.App().GovKeeper.GetGovernanceAccount(s.Ctx()).GetAddress().String()
+
+		// create pool
+		s.RunTxPoolSuccess(&pooltypes.MsgCreatePool{
+			Authority:            gov,
+			UploadInterval:       60,
+			MaxBundleSize:        100,
+			InflationShareWeight: math.LegacyZeroDec(),
+			Binaries:             "{}",
+		})
+
+		// create stakers
+		validator1 = s.CreateNewValidator("MyValidator-1", 1000*i.KYVE)
+		validator2 = s.CreateNewValidator("MyValidator-2", 1000*i.KYVE)
+
+		// create two pending rewards entries for validator 1
+		payoutRewards(s, validator1.Address, i.ACoins(100))
+		withdrawRewards(validator1)
+
+		s.CommitAfterSeconds(10)
+
+		payoutRewards(s, validator1.Address, i.BCoins(50))
+		withdrawRewards(validator1)
+
+		Expect(s.GetCoinsFromModule(multicoinrewardstypes.ModuleName).String()).To(Equal("100acoin,50bcoin"))
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Opt in and claim all pending rewards", func() {
+		// ACT
+		s.RunTxSuccess(&multicoinrewardstypes.MsgClaimPendingMultiCoinRewards{
+			Creator: validator1.Address,
+		})
+
+		// ASSERT
+		Expect(s.App().BankKeeper.GetAllBalances(s.Ctx(), validator1.AccAddress).String()).To(Equal("10000000100acoin,10000000050bcoin,10000000000ccoin,9000000000tkyve"))
+		Expect(s.GetCoinsFromModule(multicoinrewardstypes.ModuleName).String()).To(BeEmpty())
+
+		entries, _ := s.App().MultiCoinRewardsKeeper.GetMultiCoinPendingRewardsEntriesByIndex2(s.Ctx(), validator1.Address)
+		Expect(entries).To(BeEmpty())
+
+		enabled, _ := s.App().MultiCoinRewardsKeeper.MultiCoinRewardsEnabled.Has(s.Ctx(), validator1.AccAddress)
+		Expect(enabled).To(BeTrue())
+
+		// ACT
+		payoutRewards(s, validator1.Address, i.ACoins(30))
+		withdrawRewards(validator1)
+
+		// ASSERT
+		Expect(s.App().BankKeeper.GetAllBalances(s.Ctx(), validator1.AccAddress).String()).To(Equal("10000000130acoin,10000000050bcoin,10000000000ccoin,9000000000tkyve"))
+		Expect(s.GetCoinsFromModule(multicoinrewardstypes.ModuleName).String()).To(BeEmpty())
+	})
+
+	It("Opt in and claim a single pending rewards entry", func() {
+		// ACT
+		s.RunTxSuccess(&multicoinrewardstypes.MsgClaimPendingMultiCoinRewards{
+			Creator: validator1.Address,
+			Indices: []uint64{2},
+		})
+
+		// ASSERT
+		Expect(s.App().BankKeeper.GetAllBalances(s.Ctx(), validator1.AccAddress).String()).To(Equal("10000000000acoin,10000000050bcoin,10000000000ccoin,9000000000tkyve"))
+		Expect(s.GetCoinsFromModule(multicoinrewardstypes.ModuleName).String()).To(Equal("100acoin"))
+
+		entries, _ := s.App().MultiCoinRewardsKeeper.GetMultiCoinPendingRewardsEntriesByIndex2(s.Ctx(), validator1.Address)
+		Expect(entries).To(HaveLen(1))
+		Expect(entries[0].Index).To(Equal(uint64(1)))
+
+		enabled, _ := s.App().MultiCoinRewardsKeeper.MultiCoinRewardsEnabled.Has(s.Ctx(), validator1.AccAddress)
+		Expect(enabled).To(BeTrue())
+
+		// ACT
+		s.RunTxSuccess(&multicoinrewardstypes.MsgClaimPendingMultiCoinRewards{
+			Creator: validator1.Address,
+		})
+
+		// ASSERT
+		Expect(s.App().BankKeeper.GetAllBalances(s.Ctx(), validator1.AccAddress).String()).To(Equal("10000000100acoin,10000000050bcoin,10000000000ccoin,9000000000tkyve"))
+		Expect(s.GetCoinsFromModule(multicoinrewardstypes.ModuleName).String()).To(BeEmpty())
+	})
+
+	It("Claim pending rewards received after disabling multi-coin rewards", func() {
+		// ARRANGE
+		s.RunTxSuccess(&multicoinrewardstypes.MsgToggleMultiCoinRewards{
+			Creator: validator1.Address,
+			Enabled: true,
+		})
+		s.RunTxSuccess(&multicoinrewardstypes.MsgToggleMultiCoinRewards{
+			Creator: validator1.Address,
+			Enabled: false,
+		})
+
+		payoutRewards(s, validator1.Address, i.ACoins(30))
+		withdrawRewards(validator1)
+
+		Expect(s.GetCoinsFromModule(multicoinrewardstypes.ModuleName).String()).To(Equal("30acoin"))
+
+		entries, _ := s.App().MultiCoinRewardsKeeper.GetMultiCoinPendingRewardsEntriesByIndex2(s.Ctx(), validator1.Address)
+		Expect(entries).To(HaveLen(1))
+		Expect(entries[0].Index).To(Equal(uint64(3)))
+
+		// ACT
+		s.RunTxSuccess(&multicoinrewardstypes.MsgClaimPendingMultiCoinRewards{
+			Creator: validator1.Address,
+			Indices: []uint64{3},
+		})
+
+		// ASSERT
+		Expect(s.App().BankKeeper.GetAllBalances(s.Ctx(), validator1.AccAddress).String()).To(Equal("10000000130acoin,10000000050bcoin,10000000000ccoin,9000000000tkyve"))
+		Expect(s.GetCoinsFromModule(multicoinrewardstypes.ModuleName).String()).To(BeEmpty())
+
+		enabled, _ := s.App().MultiCoinRewardsKeeper.MultiCoinRewardsEnabled.Has(s.Ctx(), validator1.AccAddress)
+		Expect(enabled).To(BeTrue())
+	})
+
+	It("Try to claim a pending rewards entry of another address", func() {
+		// ACT
+		s.RunTxError(&multicoinrewardstypes.MsgClaimPendingMultiCoinRewards{
+			Creator: validator2.Address,
+			Indices: []uint64{1},
+		})
+		s.RunTxError(&multicoinrewardstypes.MsgClaimPendingMultiCoinRewards{
+			Creator: validator2.Address,
+			Indices: []uint64{3},
+		})
+
+		// ASSERT
+		Expect(s.GetCoinsFromModule(multicoinrewardstypes.ModuleName).String()).To(Equal("100acoin,50bcoin"))
+
+		enabled, _ := s.App().MultiCoinRewardsKeeper.MultiCoinRewardsEnabled.Has(s.Ctx(), validator2.AccAddress)
+		Expect(enabled).To(BeFalse())
+	})
+
+	It("Try to claim a pending rewards entry twice without message validation", func() {
+		// ARRANGE
+		msgServer := keeper.NewMsgServerImpl(s.App().MultiCoinRewardsKeeper)
+
+		// ACT
+		_, err := msgServer.ClaimPendingMultiCoinRewards(s.Ctx(), &multicoinrewardstypes.MsgClaimPendingMultiCoinRewards{
+			Creator: validator1.Address,
+			Indices: []uint64{2, 2},
+		})
+
+		// ASSERT
+		Expect(err).To(HaveOccurred())
+		Expect(s.GetCoinsFromModule(multicoinrewardstypes.ModuleName).String()).To(Equal("100acoin,50bcoin"))
+
+		entries, _ := s.App().MultiCoinRewardsKeeper.GetMultiCoinPendingRewardsEntriesByIndex2(s.Ctx(), validator1.Address)
+		Expect(entries).To(HaveLen(2))
+	})
+
+	It("Query pending rewards with their expiry date", func() {
+		// ACT
+		res, err := s.App().MultiCoinRewardsKeeper.PendingMultiCoinRewards(s.Ctx(), &multicoinrewardstypes.QueryPendingMultiCoinRewardsRequest{
+			Address: validator1.Address,
+		})
+
+		// ASSERT
+		Expect(err).To(BeNil())
+		Expect(res.Entries).To(HaveLen(2))
+
+		pendingTime := int64(s.App().MultiCoinRewardsKeeper.GetParams(s.Ctx()).MultiCoinDistributionPendingTime)
+		for _, entry := range res.Entries {
+			Expect(entry.ExpiryDate).To(Equal(entry.CreationDate + pendingTime))
+		}
+		Expect(res.Entries[0].Rewards.String()).To(Equal("100acoin"))
+		Expect(res.Entries[1].Rewards.String()).To(Equal("50bcoin"))
+		Expect(res.Entries[1].CreationDate - res.Entries[0].CreationDate).To(BeNumerically(">=", 10))
+
+		res, err = s.App().MultiCoinRewardsKeeper.PendingMultiCoinRewards(s.Ctx(), &multicoinrewardstypes.QueryPendingMultiCoinRewardsRequest{
+			Address: validator2.Address,
+		})
+		Expect(err).To(BeNil())
+		Expect(res.Entries).To(BeEmpty())
+	})
+
+	It("Emit an event for every expired pending rewards entry", func() {
+		// ARRANGE
+		entries, _ := s.App().MultiCoinRewardsKeeper.GetMultiCoinPendingRewardsEntriesByIndex2(s.Ctx(), validator1.Address)
+		pendingTime := int64(s.App().MultiCoinRewardsKeeper.GetParams(s.Ctx()).MultiCoinDistributionPendingTime)
+		ctx := s.Ctx().
+			WithBlockTime(time.Unix(entries[1].CreationDate+pendingTime, 0)).
+			WithEventManager(sdk.NewEventManager())
+
+		// ACT
+		err := s.App().MultiCoinRewardsKeeper.ProcessPendingRewardsQueue(ctx)
+		Expect(err).To(BeNil())
+
+		// ASSERT
+		expiredEvents := 0
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == "kyve.multi_coin_rewards.v1beta1.EventPendingMultiCoinRewardsExpired" {
+				expiredEvents++
+			}
+		}
+		Expect(expiredEvents).To(Equal(2))
+
+		entries, _ = s.App().MultiCoinRewardsKeeper.GetMultiCoinPendingRewardsEntriesByIndex2(ctx, validator1.Address)
+		Expect(entries).To(BeEmpty())
+	})
+})
@@ -3,9 +3,11 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/errors"
 	"github.com/KYVENetwork/chain/x/multi_coin_rewards/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 func (k msgServer) SetMultiCoinRewardDistributionPolicy(goCtx context.Context, policy *types.MsgSetMultiCoinRewardsDistributionPolicy) (*types.MsgSetMultiCoinRewardsDistributionPolicyResponse, error) {
@@ -16,9 +18,47 @@ func (k msgServer) SetMultiCoinRewardDistributionPolicy(goCtx context.Context, p
 		return nil, types.ErrMultiCoinDistributionPolicyInvalidAdminAddress
 	}
 
-	if err := k.MultiCoinDistributionPolicy.Set(ctx, *policy.Policy); err != nil {
+	policyVersion, err := k.addMultiCoinDistributionPolicyVersion(ctx, *policy.Policy, policy.ActivationTime)
+	if err != nil {
 		return nil, errors.Wrap(err, types.ErrMultiCoinDistributionPolicyInvalid.Error())
 	}
 
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventSetMultiCoinDistributionPolicy{
+		Creator:        policy.Creator,
+		Version:        policyVersion.Version,
+		ActivationTime: policyVersion.ActivationTime,
+	})
+
 	return &types.MsgSetMultiCoinRewardsDistributionPolicyResponse{}, nil
 }
+
+func (k msgServer) CancelMultiCoinDistributionPolicyVersion(goCtx context.Context, msg *types.MsgCancelMultiCoinDistributionPolicyVersion) (*types.MsgCancelMultiCoinDistributionPolicyVersionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	if params.MultiCoinDistributionPolicyAdminAddress != msg.Creator {
+		return nil, types.ErrMultiCoinDistributionPolicyInvalidAdminAddress
+	}
+
+	policyVersion, err := k.MultiCoinDistributionPolicyVersions.Get(ctx, msg.Version)
+	if err != nil {
+		return nil, errors.Wrapf(errorsTypes.ErrNotFound, types.ErrMultiCoinDistributionPolicyNotScheduled.Error(), msg.Version)
+	}
+
+	if scheduled, err := k.MultiCoinDistributionPolicySchedule.Has(ctx, collections.Join(policyVersion.ActivationTime, policyVersion.Version)); err != nil {
+		return nil, err
+	} else if !scheduled {
+		return nil, errors.Wrapf(errorsTypes.ErrNotFound, types.ErrMultiCoinDistributionPolicyNotScheduled.Error(), msg.Version)
+	}
+
+	if err := k.cancelMultiCoinDistributionPolicyVersion(ctx, policyVersion); err != nil {
+		return nil, err
+	}
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventCancelMultiCoinDistributionPolicyVersion{
+		Creator: msg.Creator,
+		Version: msg.Version,
+	})
+
+	return &types.MsgCancelMultiCoinDistributionPolicyVersionResponse{}, nil
+}
@@ -28,13 +28,16 @@ func (k msgServer) ToggleMultiCoinRewards(ctx context.Context, toggle *types.Msg
 		}
 
 		goCtx := sdk.UnwrapSDKContext(ctx)
-		rewards, _ := k.GetMultiCoinPendingRewardsEntriesByIndex2(goCtx, accountAddress.String())
-		for _, reward := range rewards {
-			totalRewards = totalRewards.Add(reward.Rewards...)
-			k.RemoveMultiCoinPendingRewardsEntry(goCtx, &reward)
+
+		// Multi-coin rewards and the convert mode are mutually exclusive, rewards
+		// which were not converted yet are claimed together with the pending rewards
+		if err := k.disableConvert(goCtx, accountAddress); err != nil {
+			return nil, err
 		}
 
-		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, accountAddress, totalRewards); err != nil {
+		rewards, _ := k.GetMultiCoinPendingRewardsEntriesByIndex2(goCtx, accountAddress.String())
+		totalRewards, err = k.claimPendingRewards(goCtx, accountAddress, rewards)
+		if err != nil {
 			return nil, err
 		}
 
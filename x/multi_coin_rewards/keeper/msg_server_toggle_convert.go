@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/KYVENetwork/chain/x/multi_coin_rewards/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) ToggleMultiCoinRewardsConvert(goCtx context.Context, toggle *types.MsgToggleMultiCoinRewardsConvert) (*types.MsgToggleMultiCoinRewardsConvertResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	accountAddress, err := sdk.AccAddressFromBech32(toggle.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	// If entry exists, it means that the user has currently the convert mode enabled
+	convertCurrentlyEnabled, err := k.MultiCoinRewardsConvert.Has(ctx, accountAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	totalRewards := sdk.NewCoins()
+	if toggle.Enabled {
+		// User wants to enable the convert mode
+
+		if convertCurrentlyEnabled {
+			return nil, types.ErrMultiCoinRewardsConvertAlreadyEnabled
+		}
+
+		if !k.isConvertAvailable(ctx) {
+			return nil, types.ErrMultiCoinRewardsConvertUnavailable
+		}
+
+		// Multi-coin rewards and the convert mode are mutually exclusive
+		if err := k.MultiCoinRewardsEnabled.Remove(ctx, accountAddress); err != nil {
+			return nil, err
+		}
+
+		// All current pending rewards get converted in the next batch
+		entries, _ := k.GetMultiCoinPendingRewardsEntriesByIndex2(ctx, accountAddress.String())
+		for _, entry := range entries {
+			totalRewards = totalRewards.Add(entry.Rewards...)
+			k.RemoveMultiCoinPendingRewardsEntry(ctx, &entry)
+		}
+
+		if !totalRewards.IsZero() {
+			if err := k.addConvertRewards(ctx, accountAddress, totalRewards); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := k.MultiCoinRewardsConvert.Set(ctx, accountAddress); err != nil {
+			return nil, err
+		}
+	} else {
+		// User wants to disable the convert mode
+
+		if !convertCurrentlyEnabled {
+			return nil, types.ErrMultiCoinRewardsConvertAlreadyDisabled
+		}
+
+		// Rewards which were not converted yet become pending rewards
+		if err := k.disableConvert(ctx, accountAddress); err != nil {
+			return nil, err
+		}
+	}
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventToggleMultiCoinRewardsConvert{
+		Address:                 toggle.Creator,
+		Enabled:                 toggle.Enabled,
+		PendingRewardsConverted: totalRewards.String(),
+	})
+
+	return &types.MsgToggleMultiCoinRewardsConvertResponse{}, nil
+}
@@ -21,6 +21,13 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 
 	newParams := oldParams
 	_ = json.Unmarshal([]byte(msg.Payload), &newParams)
+
+	if newParams.MultiCoinConvertVenue != "" {
+		if _, found := k.swapVenues[newParams.MultiCoinConvertVenue]; !found {
+			return nil, errors.Wrapf(govTypes.ErrInvalidProposalMsg, types.ErrSwapVenueNotRegistered.Error(), newParams.MultiCoinConvertVenue)
+		}
+	}
+
 	k.SetParams(ctx, newParams)
 
 	_ = ctx.EventManager().EmitTypedEvent(&types.EventUpdateParams{
@@ -29,6 +29,9 @@ TEST CASES - msg_server_update_params.go
 * Update policy admin address
 * Update policy admin address with invalid value
 
+* Update convert venue
+* Update convert venue with unregistered venue
+
 */
 
 var _ = Describe("msg_server_update_params.go", Ordered, func() {
@@ -60,6 +63,9 @@ var _ = Describe("msg_server_update_params.go", Ordered, func() {
 
 		Expect(params.MultiCoinDistributionPendingTime).To(Equal(types.DefaultMultiCoinDistributionPendingTime))
 		Expect(params.MultiCoinDistributionPolicyAdminAddress).To(BeEmpty())
+		Expect(params.MultiCoinConvertVenue).To(BeEmpty())
+		Expect(params.MultiCoinConvertMaxAttempts).To(Equal(types.DefaultMultiCoinConvertMaxAttempts))
+		Expect(params.MultiCoinConvertMaxEntriesPerBlock).To(Equal(types.DefaultMultiCoinConvertMaxEntriesPerBlock))
 	})
 
 	It("Invalid authority (transaction)", func() {
@@ -326,4 +332,74 @@ var _ = Describe("msg_server_update_params.go", Ordered, func() {
 		Expect(updatedParams.MultiCoinDistributionPendingTime).To(Equal(types.DefaultMultiCoinDistributionPendingTime))
 		Expect(updatedParams.MultiCoinDistributionPolicyAdminAddress).To(BeEmpty())
 	})
+
+	It("Update convert venue", func() {
+		// ARRANGE
+		payload := `{
+			"multi_coin_convert_venue": "community_pool"
+		}`
+
+		msg := &types.MsgUpdateParams{
+			Authority: gov,
+			Payload:   payload,
+		}
+
+		proposal, _ := govV1Types.NewMsgSubmitProposal(
+			[]sdk.Msg{msg}, minDeposit, i.DUMMY[0], "", "title", "summary", false,
+		)
+
+		vote := govV1Types.NewMsgVote(
+			voter, 1, govV1Types.VoteOption_VOTE_OPTION_YES, "",
+		)
+
+		// ACT
+		_, submitErr := s.RunTx(proposal)
+		_, voteErr := s.RunTx(vote)
+
+		s.CommitAfter(*votingPeriod)
+		s.Commit()
+
+		// ASSERT
+		updatedParams := s.App().MultiCoinRewardsKeeper.GetParams(s.Ctx())
+
+		Expect(submitErr).NotTo(HaveOccurred())
+		Expect(voteErr).NotTo(HaveOccurred())
+
+		Expect(updatedParams.MultiCoinConvertVenue).To(Equal("community_pool"))
+	})
+
+	It("Update convert venue with unregistered venue", func() {
+		// ARRANGE
+		payload := `{
+			"multi_coin_convert_venue": "unknown"
+		}`
+
+		msg := &types.MsgUpdateParams{
+			Authority: gov,
+			Payload:   payload,
+		}
+
+		proposal, _ := govV1Types.NewMsgSubmitProposal(
+			[]sdk.Msg{msg}, minDeposit, i.DUMMY[0], "", "title", "summary", false,
+		)
+
+		vote := govV1Types.NewMsgVote(
+			voter, 1, govV1Types.VoteOption_VOTE_OPTION_YES, "",
+		)
+
+		// ACT
+		_, submitErr := s.RunTx(proposal)
+		_, voteErr := s.RunTx(vote)
+
+		s.CommitAfter(*votingPeriod)
+		s.Commit()
+
+		// ASSERT
+		updatedParams := s.App().MultiCoinRewardsKeeper.GetParams(s.Ctx())
+
+		Expect(submitErr).NotTo(HaveOccurred())
+		Expect(voteErr).NotTo(HaveOccurred())
+
+		Expect(updatedParams.MultiCoinConvertVenue).To(BeEmpty())
+	})
 })
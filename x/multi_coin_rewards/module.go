@@ -127,7 +127,9 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 }
 
 // RegisterInvariants registers the invariants of the module. If an invariant deviates from its predicted value, the InvariantRegistry triggers appropriate logic (most often the chain will be halted)
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
 
 // InitGenesis performs the module's genesis initialization. It returns no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, gs json.RawMessage) {
@@ -150,6 +152,19 @@ func (AppModule) ConsensusVersion() uint64 { return 1 }
 // BeginBlock contains the logic that is automatically triggered at the beginning of each block
 func (am AppModule) BeginBlock(ctx context.Context) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := am.keeper.ActivateScheduledPolicies(sdkCtx); err != nil {
+		return err
+	}
+
+	// Convert the batched rewards of all users in convert mode. If the batch fails,
+	// the entries remain and are retried in the next block.
+	cachedCtx, commit := sdkCtx.CacheContext()
+	if err := am.keeper.ProcessConvertBatch(cachedCtx); err == nil {
+		commit()
+	} else {
+		am.keeper.Logger().Error(err.Error())
+	}
+
 	if err := am.keeper.ProcessPendingRewardsQueue(sdkCtx); err != nil {
 		return err
 	}
@@ -197,6 +212,7 @@ type ModuleInputs struct {
 	AccountKeeper util.AccountKeeper
 	BankKeeper    util.BankKeeper
 	PoolKeeper    types.PoolKeeper
+	FundersKeeper types.FundersKeeper
 }
 
 type ModuleOutputs struct {
@@ -221,6 +237,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.AccountKeeper,
 		in.BankKeeper,
 		in.PoolKeeper,
+		in.FundersKeeper,
 	)
 	m := NewAppModule(
 		in.Cdc,
@@ -11,12 +11,18 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "kyve/multi_coin_rewards/MsgUpdateParams", nil)
 	cdc.RegisterConcrete(&MsgToggleMultiCoinRewards{}, "kyve/multi_coin_rewards/MsgToggleMultiCoinRewards", nil)
 	cdc.RegisterConcrete(&MsgSetMultiCoinRewardsDistributionPolicy{}, "kyve/multi_coin_rewards/MsgSetMultiCoinRewardsDistributionPolicy", nil)
+	cdc.RegisterConcrete(&MsgClaimPendingMultiCoinRewards{}, "kyve/multi_coin_rewards/MsgClaimPendingMultiCoinRewards", nil)
+	cdc.RegisterConcrete(&MsgToggleMultiCoinRewardsConvert{}, "kyve/multi_coin_rewards/MsgToggleMultiCoinRewardsConvert", nil)
+	cdc.RegisterConcrete(&MsgCancelMultiCoinDistributionPolicyVersion{}, "kyve/multi_coin_rewards/MsgCancelMultiCoinDistributionPolicyVersion", nil)
 }
 
 func RegisterInterfaces(registry codecTypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUpdateParams{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgToggleMultiCoinRewards{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetMultiCoinRewardsDistributionPolicy{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgClaimPendingMultiCoinRewards{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgToggleMultiCoinRewardsConvert{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgCancelMultiCoinDistributionPolicyVersion{})
 }
 
 var Amino = codec.NewLegacyAmino()
@@ -10,4 +10,11 @@ var (
 	ErrMultiCoinDistributionPolicyInvalid             = errors.Register(ModuleName, 1123, "multi coin distribution policy invalid")
 	ErrMultiCoinRewardsAlreadyEnabled                 = errors.Register(ModuleName, 1124, "multi coin rewards already enabled")
 	ErrMultiCoinRewardsAlreadyDisabled                = errors.Register(ModuleName, 1125, "multi coin rewards already disabled")
+	ErrPendingRewardsEntryNotFound                    = errors.Register(ModuleName, 1127, "pending rewards entry %v of %v not found")
+	ErrMultiCoinRewardsConvertAlreadyEnabled          = errors.Register(ModuleName, 1128, "multi coin rewards convert already enabled")
+	ErrMultiCoinRewardsConvertAlreadyDisabled         = errors.Register(ModuleName, 1129, "multi coin rewards convert already disabled")
+	ErrMultiCoinDistributionPolicyNotScheduled        = errors.Register(ModuleName, 1130, "multi coin distribution policy version %v is not scheduled")
+	ErrMultiCoinRewardsConvertUnavailable             = errors.Register(ModuleName, 1131, "multi coin rewards convert is not available")
+	ErrDuplicatePendingRewardsEntry                   = errors.Register(ModuleName, 1132, "pending rewards entry %v can only be claimed once")
+	ErrSwapVenueNotRegistered                         = errors.Register(ModuleName, 1133, "swap venue %v is not registered")
 )
@@ -152,193 +152,2391 @@ func (m *EventToggleMultiCoinRewards) GetPendingRewardsClaimed() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*EventUpdateParams)(nil), "kyve.multi_coin_rewards.v1beta1.EventUpdateParams")
-	proto.RegisterType((*EventToggleMultiCoinRewards)(nil), "kyve.multi_coin_rewards.v1beta1.EventToggleMultiCoinRewards")
+// EventClaimPendingMultiCoinRewards is an event emitted when pending multi-coin rewards are claimed.
+// emitted_by: MsgClaimPendingMultiCoinRewards
+type EventClaimPendingMultiCoinRewards struct {
+	// address ...
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// indices of the claimed pending rewards entries
+	Indices []uint64 `protobuf:"varint,2,rep,packed,name=indices,proto3" json:"indices,omitempty"`
+	// rewards_claimed ...
+	RewardsClaimed string `protobuf:"bytes,3,opt,name=rewards_claimed,json=rewardsClaimed,proto3" json:"rewards_claimed,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("kyve/multi_coin_rewards/v1beta1/events.proto", fileDescriptor_c8bb6f2da3c22458)
+func (m *EventClaimPendingMultiCoinRewards) Reset()         { *m = EventClaimPendingMultiCoinRewards{} }
+func (m *EventClaimPendingMultiCoinRewards) String() string { return proto.CompactTextString(m) }
+func (*EventClaimPendingMultiCoinRewards) ProtoMessage()    {}
+func (*EventClaimPendingMultiCoinRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8bb6f2da3c22458, []int{2}
+}
+func (m *EventClaimPendingMultiCoinRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventClaimPendingMultiCoinRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventClaimPendingMultiCoinRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventClaimPendingMultiCoinRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventClaimPendingMultiCoinRewards.Merge(m, src)
+}
+func (m *EventClaimPendingMultiCoinRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventClaimPendingMultiCoinRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventClaimPendingMultiCoinRewards.DiscardUnknown(m)
 }
 
-var fileDescriptor_c8bb6f2da3c22458 = []byte{
-	// 340 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x91, 0xcd, 0x4a, 0xfb, 0x40,
-	0x14, 0xc5, 0x33, 0xff, 0xbf, 0xa8, 0x1d, 0x57, 0x16, 0xc5, 0x52, 0x21, 0x2d, 0xdd, 0xd8, 0x85,
-	0x64, 0xa8, 0x82, 0xee, 0x5b, 0xba, 0xf2, 0x03, 0x0d, 0x2a, 0xe8, 0x26, 0x4c, 0x32, 0x97, 0x74,
-	0xe8, 0x64, 0x26, 0x24, 0xd3, 0xc6, 0x3e, 0x82, 0x3b, 0x1f, 0xab, 0xee, 0xba, 0x74, 0x25, 0xd2,
-	0xbe, 0x88, 0xe4, 0x63, 0x70, 0xa1, 0x50, 0x70, 0x37, 0x97, 0x73, 0xee, 0xef, 0x9c, 0xe1, 0xe2,
-	0xe3, 0xf1, 0x6c, 0x0a, 0x24, 0x9a, 0x08, 0xcd, 0xbd, 0x40, 0x71, 0xe9, 0x25, 0x90, 0xd1, 0x84,
-	0xa5, 0x64, 0xda, 0xf3, 0x41, 0xd3, 0x1e, 0x81, 0x29, 0x48, 0x9d, 0x3a, 0x71, 0xa2, 0xb4, 0xaa,
-	0xb7, 0x72, 0xb7, 0xf3, 0xd3, 0xed, 0x54, 0xee, 0xe6, 0x5e, 0xa8, 0x42, 0x55, 0x78, 0x49, 0xfe,
-	0x2a, 0xd7, 0x9a, 0x6b, 0x43, 0x62, 0x9a, 0xd0, 0xa8, 0x0a, 0xe9, 0xbc, 0x21, 0xbc, 0x3b, 0xcc,
-	0x53, 0xef, 0x63, 0x46, 0x35, 0xdc, 0x14, 0x5a, 0xfd, 0x12, 0x63, 0x25, 0x98, 0x57, 0x3a, 0x1b,
-	0xa8, 0x8d, 0xba, 0x3b, 0x27, 0x47, 0xce, 0x9a, 0x3e, 0x4e, 0xb9, 0xdc, 0xdf, 0x98, 0x7f, 0xb4,
-	0x2c, 0xb7, 0xa6, 0x04, 0xfb, 0xa6, 0x49, 0xc8, 0x0c, 0xed, 0xdf, 0x9f, 0x68, 0x12, 0xb2, 0x8a,
-	0xd6, 0xc0, 0x5b, 0x31, 0x9d, 0x09, 0x45, 0x59, 0xe3, 0x7f, 0x1b, 0x75, 0x6b, 0xae, 0x19, 0x3b,
-	0x2f, 0x08, 0x1f, 0x16, 0x7f, 0xb9, 0x53, 0x61, 0x28, 0xe0, 0x2a, 0x67, 0x0f, 0x14, 0x97, 0x6e,
-	0x49, 0xce, 0x37, 0x29, 0x63, 0x09, 0xa4, 0xe5, 0x97, 0x6a, 0xae, 0x19, 0x73, 0x05, 0x24, 0xf5,
-	0x05, 0xb0, 0xa2, 0xde, 0xb6, 0x6b, 0xc6, 0xfa, 0x19, 0x3e, 0x88, 0x41, 0x32, 0x2e, 0x43, 0x53,
-	0xd0, 0x0b, 0x04, 0xe5, 0x11, 0x98, 0xf4, 0xfd, 0x4a, 0xae, 0x42, 0x06, 0xa5, 0xd8, 0xbf, 0x9d,
-	0x2f, 0x6d, 0xb4, 0x58, 0xda, 0xe8, 0x73, 0x69, 0xa3, 0xd7, 0x95, 0x6d, 0x2d, 0x56, 0xb6, 0xf5,
-	0xbe, 0xb2, 0xad, 0xa7, 0xf3, 0x90, 0xeb, 0xd1, 0xc4, 0x77, 0x02, 0x15, 0x91, 0x8b, 0xc7, 0x87,
-	0xe1, 0x35, 0xe8, 0x4c, 0x25, 0x63, 0x12, 0x8c, 0x28, 0x97, 0xe4, 0xf9, 0xb7, 0xcb, 0xe9, 0x59,
-	0x0c, 0xa9, 0xbf, 0x59, 0x5c, 0xec, 0xf4, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x20, 0x1c, 0xe3, 0x77,
-	0x46, 0x02, 0x00, 0x00,
+var xxx_messageInfo_EventClaimPendingMultiCoinRewards proto.InternalMessageInfo
+
+func (m *EventClaimPendingMultiCoinRewards) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func (m *EventUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventClaimPendingMultiCoinRewards) GetIndices() []uint64 {
+	if m != nil {
+		return m.Indices
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EventUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventClaimPendingMultiCoinRewards) GetRewardsClaimed() string {
+	if m != nil {
+		return m.RewardsClaimed
+	}
+	return ""
 }
 
-func (m *EventUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Payload) > 0 {
-		i -= len(m.Payload)
-		copy(dAtA[i:], m.Payload)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Payload)))
-		i--
-		dAtA[i] = 0x1a
+// EventPendingMultiCoinRewardsExpired is an event emitted when a pending rewards entry
+// was not claimed in time and its rewards get redistributed to the pools.
+// emitted_by: BeginBlock
+type EventPendingMultiCoinRewardsExpired struct {
+	// index of the pending rewards entry
+	Index uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// address ...
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// rewards ...
+	Rewards string `protobuf:"bytes,3,opt,name=rewards,proto3" json:"rewards,omitempty"`
+	// creation_date is the UNIX-timestamp (in seconds) when the entry was created
+	CreationDate int64 `protobuf:"varint,4,opt,name=creation_date,json=creationDate,proto3" json:"creation_date,omitempty"`
+}
+
+func (m *EventPendingMultiCoinRewardsExpired) Reset()         { *m = EventPendingMultiCoinRewardsExpired{} }
+func (m *EventPendingMultiCoinRewardsExpired) String() string { return proto.CompactTextString(m) }
+func (*EventPendingMultiCoinRewardsExpired) ProtoMessage()    {}
+func (*EventPendingMultiCoinRewardsExpired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8bb6f2da3c22458, []int{3}
+}
+func (m *EventPendingMultiCoinRewardsExpired) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPendingMultiCoinRewardsExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPendingMultiCoinRewardsExpired.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	{
-		size, err := m.NewParams.MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *EventPendingMultiCoinRewardsExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPendingMultiCoinRewardsExpired.Merge(m, src)
+}
+func (m *EventPendingMultiCoinRewardsExpired) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPendingMultiCoinRewardsExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPendingMultiCoinRewardsExpired.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPendingMultiCoinRewardsExpired proto.InternalMessageInfo
+
+func (m *EventPendingMultiCoinRewardsExpired) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *EventPendingMultiCoinRewardsExpired) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EventPendingMultiCoinRewardsExpired) GetRewards() string {
+	if m != nil {
+		return m.Rewards
+	}
+	return ""
+}
+
+func (m *EventPendingMultiCoinRewardsExpired) GetCreationDate() int64 {
+	if m != nil {
+		return m.CreationDate
+	}
+	return 0
+}
+
+// EventSetMultiCoinDistributionPolicy is an event emitted when a new version of the
+// distribution policy is created.
+// emitted_by: MsgSetMultiCoinRewardsDistributionPolicy
+type EventSetMultiCoinDistributionPolicy struct {
+	// creator ...
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// version of the policy
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// activation_time is the UNIX-timestamp (in seconds) at which the policy becomes active
+	ActivationTime int64 `protobuf:"varint,3,opt,name=activation_time,json=activationTime,proto3" json:"activation_time,omitempty"`
+}
+
+func (m *EventSetMultiCoinDistributionPolicy) Reset()         { *m = EventSetMultiCoinDistributionPolicy{} }
+func (m *EventSetMultiCoinDistributionPolicy) String() string { return proto.CompactTextString(m) }
+func (*EventSetMultiCoinDistributionPolicy) ProtoMessage()    {}
+func (*EventSetMultiCoinDistributionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8bb6f2da3c22458, []int{4}
+}
+func (m *EventSetMultiCoinDistributionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSetMultiCoinDistributionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSetMultiCoinDistributionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.OldParams.MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *EventSetMultiCoinDistributionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSetMultiCoinDistributionPolicy.Merge(m, src)
+}
+func (m *EventSetMultiCoinDistributionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSetMultiCoinDistributionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSetMultiCoinDistributionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSetMultiCoinDistributionPolicy proto.InternalMessageInfo
+
+func (m *EventSetMultiCoinDistributionPolicy) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *EventSetMultiCoinDistributionPolicy) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *EventSetMultiCoinDistributionPolicy) GetActivationTime() int64 {
+	if m != nil {
+		return m.ActivationTime
+	}
+	return 0
+}
+
+// EventActivateMultiCoinDistributionPolicy is an event emitted when a version of
+// the distribution policy becomes the active policy.
+// emitted_by: MsgSetMultiCoinRewardsDistributionPolicy, BeginBlock
+type EventActivateMultiCoinDistributionPolicy struct {
+	// version of the policy
+	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *EventActivateMultiCoinDistributionPolicy) Reset() {
+	*m = EventActivateMultiCoinDistributionPolicy{}
+}
+func (m *EventActivateMultiCoinDistributionPolicy) String() string { return proto.CompactTextString(m) }
+func (*EventActivateMultiCoinDistributionPolicy) ProtoMessage()    {}
+func (*EventActivateMultiCoinDistributionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8bb6f2da3c22458, []int{5}
+}
+func (m *EventActivateMultiCoinDistributionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventActivateMultiCoinDistributionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventActivateMultiCoinDistributionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *EventActivateMultiCoinDistributionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventActivateMultiCoinDistributionPolicy.Merge(m, src)
+}
+func (m *EventActivateMultiCoinDistributionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventActivateMultiCoinDistributionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventActivateMultiCoinDistributionPolicy.DiscardUnknown(m)
 }
 
-func (m *EventToggleMultiCoinRewards) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventActivateMultiCoinDistributionPolicy proto.InternalMessageInfo
+
+func (m *EventActivateMultiCoinDistributionPolicy) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventToggleMultiCoinRewards) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventCancelMultiCoinDistributionPolicyVersion is an event emitted when a scheduled
+// version of the distribution policy is cancelled.
+// emitted_by: MsgCancelMultiCoinDistributionPolicyVersion
+type EventCancelMultiCoinDistributionPolicyVersion struct {
+	// creator ...
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// version of the policy
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (m *EventToggleMultiCoinRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PendingRewardsClaimed) > 0 {
-		i -= len(m.PendingRewardsClaimed)
-		copy(dAtA[i:], m.PendingRewardsClaimed)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.PendingRewardsClaimed)))
-		i--
-		dAtA[i] = 0x1a
+func (m *EventCancelMultiCoinDistributionPolicyVersion) Reset() {
+	*m = EventCancelMultiCoinDistributionPolicyVersion{}
+}
+func (m *EventCancelMultiCoinDistributionPolicyVersion) String() string {
+	return proto.CompactTextString(m)
+}
+func (*EventCancelMultiCoinDistributionPolicyVersion) ProtoMessage() {}
+func (*EventCancelMultiCoinDistributionPolicyVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8bb6f2da3c22458, []int{6}
+}
+func (m *EventCancelMultiCoinDistributionPolicyVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCancelMultiCoinDistributionPolicyVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCancelMultiCoinDistributionPolicyVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Enabled {
-		i--
-		if m.Enabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+}
+func (m *EventCancelMultiCoinDistributionPolicyVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCancelMultiCoinDistributionPolicyVersion.Merge(m, src)
+}
+func (m *EventCancelMultiCoinDistributionPolicyVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCancelMultiCoinDistributionPolicyVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCancelMultiCoinDistributionPolicyVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCancelMultiCoinDistributionPolicyVersion proto.InternalMessageInfo
+
+func (m *EventCancelMultiCoinDistributionPolicyVersion) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *EventCancelMultiCoinDistributionPolicyVersion) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+// EventToggleMultiCoinRewardsConvert is an event emitted when the convert mode is toggled.
+// emitted_by: MsgToggleMultiCoinRewardsConvert
+type EventToggleMultiCoinRewardsConvert struct {
+	// address ...
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// enabled ...
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// pending_rewards_converted are the pending rewards which were added to the convert batch
+	PendingRewardsConverted string `protobuf:"bytes,3,opt,name=pending_rewards_converted,json=pendingRewardsConverted,proto3" json:"pending_rewards_converted,omitempty"`
+}
+
+func (m *EventToggleMultiCoinRewardsConvert) Reset()         { *m = EventToggleMultiCoinRewardsConvert{} }
+func (m *EventToggleMultiCoinRewardsConvert) String() string { return proto.CompactTextString(m) }
+func (*EventToggleMultiCoinRewardsConvert) ProtoMessage()    {}
+func (*EventToggleMultiCoinRewardsConvert) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8bb6f2da3c22458, []int{7}
+}
+func (m *EventToggleMultiCoinRewardsConvert) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventToggleMultiCoinRewardsConvert) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventToggleMultiCoinRewardsConvert.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventToggleMultiCoinRewardsConvert) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventToggleMultiCoinRewardsConvert.Merge(m, src)
+}
+func (m *EventToggleMultiCoinRewardsConvert) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventToggleMultiCoinRewardsConvert) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventToggleMultiCoinRewardsConvert.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventToggleMultiCoinRewardsConvert proto.InternalMessageInfo
+
+func (m *EventToggleMultiCoinRewardsConvert) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EventToggleMultiCoinRewardsConvert) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *EventToggleMultiCoinRewardsConvert) GetPendingRewardsConverted() string {
+	if m != nil {
+		return m.PendingRewardsConverted
+	}
+	return ""
+}
+
+// EventMultiCoinRewardsConverted is an event emitted when the non-native rewards
+// of an address were converted to the native denom.
+// emitted_by: BeginBlock
+type EventMultiCoinRewardsConverted struct {
+	// address ...
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// rewards are the non-native rewards which were converted
+	Rewards string `protobuf:"bytes,2,opt,name=rewards,proto3" json:"rewards,omitempty"`
+	// proceeds are the native coins the address received
+	Proceeds string `protobuf:"bytes,3,opt,name=proceeds,proto3" json:"proceeds,omitempty"`
+}
+
+func (m *EventMultiCoinRewardsConverted) Reset()         { *m = EventMultiCoinRewardsConverted{} }
+func (m *EventMultiCoinRewardsConverted) String() string { return proto.CompactTextString(m) }
+func (*EventMultiCoinRewardsConverted) ProtoMessage()    {}
+func (*EventMultiCoinRewardsConverted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8bb6f2da3c22458, []int{8}
+}
+func (m *EventMultiCoinRewardsConverted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMultiCoinRewardsConverted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMultiCoinRewardsConverted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMultiCoinRewardsConverted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMultiCoinRewardsConverted.Merge(m, src)
+}
+func (m *EventMultiCoinRewardsConverted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMultiCoinRewardsConverted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMultiCoinRewardsConverted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMultiCoinRewardsConverted proto.InternalMessageInfo
+
+func (m *EventMultiCoinRewardsConverted) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EventMultiCoinRewardsConverted) GetRewards() string {
+	if m != nil {
+		return m.Rewards
+	}
+	return ""
+}
+
+func (m *EventMultiCoinRewardsConverted) GetProceeds() string {
+	if m != nil {
+		return m.Proceeds
+	}
+	return ""
+}
+
+// EventMultiCoinRewardsConvertFailed is an event emitted when a denom of the
+// convert batch could not be converted. The rewards stay in the batch and are retried in the next batch
+// until the maximum number of attempts is reached.
+// emitted_by: BeginBlock
+type EventMultiCoinRewardsConvertFailed struct {
+	// denom ...
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// amount is the total amount of the batch which could not be converted
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// reason ...
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *EventMultiCoinRewardsConvertFailed) Reset()         { *m = EventMultiCoinRewardsConvertFailed{} }
+func (m *EventMultiCoinRewardsConvertFailed) String() string { return proto.CompactTextString(m) }
+func (*EventMultiCoinRewardsConvertFailed) ProtoMessage()    {}
+func (*EventMultiCoinRewardsConvertFailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8bb6f2da3c22458, []int{9}
+}
+func (m *EventMultiCoinRewardsConvertFailed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMultiCoinRewardsConvertFailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMultiCoinRewardsConvertFailed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMultiCoinRewardsConvertFailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMultiCoinRewardsConvertFailed.Merge(m, src)
+}
+func (m *EventMultiCoinRewardsConvertFailed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMultiCoinRewardsConvertFailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMultiCoinRewardsConvertFailed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMultiCoinRewardsConvertFailed proto.InternalMessageInfo
+
+func (m *EventMultiCoinRewardsConvertFailed) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventMultiCoinRewardsConvertFailed) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *EventMultiCoinRewardsConvertFailed) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// EventMultiCoinRewardsConvertAborted is an event emitted when the rewards of an address
+// could not be converted within the maximum number of attempts. The rewards were added to the
+// pending rewards of the address.
+// emitted_by: BeginBlock
+type EventMultiCoinRewardsConvertAborted struct {
+	// address ...
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// rewards are the non-native rewards which were added to the pending rewards
+	Rewards string `protobuf:"bytes,2,opt,name=rewards,proto3" json:"rewards,omitempty"`
+}
+
+func (m *EventMultiCoinRewardsConvertAborted) Reset()         { *m = EventMultiCoinRewardsConvertAborted{} }
+func (m *EventMultiCoinRewardsConvertAborted) String() string { return proto.CompactTextString(m) }
+func (*EventMultiCoinRewardsConvertAborted) ProtoMessage()    {}
+func (*EventMultiCoinRewardsConvertAborted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8bb6f2da3c22458, []int{10}
+}
+func (m *EventMultiCoinRewardsConvertAborted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMultiCoinRewardsConvertAborted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMultiCoinRewardsConvertAborted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMultiCoinRewardsConvertAborted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMultiCoinRewardsConvertAborted.Merge(m, src)
+}
+func (m *EventMultiCoinRewardsConvertAborted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMultiCoinRewardsConvertAborted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMultiCoinRewardsConvertAborted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMultiCoinRewardsConvertAborted proto.InternalMessageInfo
+
+func (m *EventMultiCoinRewardsConvertAborted) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EventMultiCoinRewardsConvertAborted) GetRewards() string {
+	if m != nil {
+		return m.Rewards
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*EventUpdateParams)(nil), "kyve.multi_coin_rewards.v1beta1.EventUpdateParams")
+	proto.RegisterType((*EventToggleMultiCoinRewards)(nil), "kyve.multi_coin_rewards.v1beta1.EventToggleMultiCoinRewards")
+	proto.RegisterType((*EventClaimPendingMultiCoinRewards)(nil), "kyve.multi_coin_rewards.v1beta1.EventClaimPendingMultiCoinRewards")
+	proto.RegisterType((*EventPendingMultiCoinRewardsExpired)(nil), "kyve.multi_coin_rewards.v1beta1.EventPendingMultiCoinRewardsExpired")
+	proto.RegisterType((*EventSetMultiCoinDistributionPolicy)(nil), "kyve.multi_coin_rewards.v1beta1.EventSetMultiCoinDistributionPolicy")
+	proto.RegisterType((*EventActivateMultiCoinDistributionPolicy)(nil), "kyve.multi_coin_rewards.v1beta1.EventActivateMultiCoinDistributionPolicy")
+	proto.RegisterType((*EventCancelMultiCoinDistributionPolicyVersion)(nil), "kyve.multi_coin_rewards.v1beta1.EventCancelMultiCoinDistributionPolicyVersion")
+	proto.RegisterType((*EventToggleMultiCoinRewardsConvert)(nil), "kyve.multi_coin_rewards.v1beta1.EventToggleMultiCoinRewardsConvert")
+	proto.RegisterType((*EventMultiCoinRewardsConverted)(nil), "kyve.multi_coin_rewards.v1beta1.EventMultiCoinRewardsConverted")
+	proto.RegisterType((*EventMultiCoinRewardsConvertFailed)(nil), "kyve.multi_coin_rewards.v1beta1.EventMultiCoinRewardsConvertFailed")
+	proto.RegisterType((*EventMultiCoinRewardsConvertAborted)(nil), "kyve.multi_coin_rewards.v1beta1.EventMultiCoinRewardsConvertAborted")
+}
+
+func init() {
+	proto.RegisterFile("kyve/multi_coin_rewards/v1beta1/events.proto", fileDescriptor_c8bb6f2da3c22458)
+}
+
+var fileDescriptor_c8bb6f2da3c22458 = []byte{
+	// 633 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xcd, 0x4f, 0x13, 0x41,
+	0x18, 0xc6, 0x3b, 0xb4, 0x22, 0x8c, 0x8a, 0x71, 0x83, 0x52, 0x31, 0x59, 0x70, 0x39, 0xd0, 0x83,
+	0x76, 0x83, 0x26, 0x9a, 0x78, 0xe3, 0xcb, 0x8b, 0x1f, 0xc1, 0x15, 0x49, 0xf0, 0xd2, 0x4c, 0x77,
+	0xde, 0x94, 0x91, 0xdd, 0x99, 0xcd, 0xec, 0xb4, 0xa5, 0x37, 0xe3, 0xcd, 0x9b, 0x07, 0xff, 0x28,
+	0xbc, 0x71, 0xf4, 0x64, 0x0c, 0xfc, 0x23, 0x66, 0xbe, 0x8a, 0x08, 0x94, 0xd8, 0xdb, 0x3e, 0xf3,
+	0xbe, 0xf3, 0x3e, 0xbf, 0x99, 0x79, 0xb2, 0xf8, 0xd1, 0xfe, 0xa0, 0x07, 0x71, 0xde, 0xcd, 0x14,
+	0x6b, 0xa5, 0x82, 0xf1, 0x96, 0x84, 0x3e, 0x91, 0xb4, 0x8c, 0x7b, 0x2b, 0x6d, 0x50, 0x64, 0x25,
+	0x86, 0x1e, 0x70, 0x55, 0x36, 0x0b, 0x29, 0x94, 0x08, 0x16, 0x74, 0x77, 0xf3, 0x7c, 0x77, 0xd3,
+	0x75, 0xcf, 0xcf, 0x76, 0x44, 0x47, 0x98, 0xde, 0x58, 0x7f, 0xd9, 0x6d, 0xf3, 0x57, 0x9a, 0x14,
+	0x44, 0x92, 0xdc, 0x99, 0x44, 0x3f, 0x10, 0xbe, 0xb3, 0xa9, 0x5d, 0x3f, 0x14, 0x94, 0x28, 0xd8,
+	0x32, 0xb5, 0xe0, 0x35, 0xc6, 0x22, 0xa3, 0x2d, 0xdb, 0x59, 0x47, 0x8b, 0xa8, 0x71, 0xe3, 0xc9,
+	0x72, 0xf3, 0x0a, 0x9e, 0xa6, 0xdd, 0xbc, 0x56, 0x3b, 0xfc, 0xb5, 0x50, 0x49, 0xa6, 0x45, 0x46,
+	0x4f, 0xa7, 0x71, 0xe8, 0xfb, 0x69, 0x13, 0x63, 0x4d, 0xe3, 0xd0, 0x77, 0xd3, 0xea, 0xf8, 0x7a,
+	0x41, 0x06, 0x99, 0x20, 0xb4, 0x5e, 0x5d, 0x44, 0x8d, 0xe9, 0xc4, 0xcb, 0xe8, 0x2b, 0xc2, 0x0f,
+	0xcc, 0x59, 0xb6, 0x45, 0xa7, 0x93, 0xc1, 0x1b, 0x3d, 0x7b, 0x5d, 0x30, 0x9e, 0xd8, 0xc9, 0x7a,
+	0x27, 0xa1, 0x54, 0x42, 0x69, 0x8f, 0x34, 0x9d, 0x78, 0xa9, 0x2b, 0xc0, 0x49, 0x3b, 0x03, 0x6a,
+	0xf0, 0xa6, 0x12, 0x2f, 0x83, 0x67, 0x78, 0xae, 0x00, 0x4e, 0x19, 0xef, 0x78, 0xc0, 0x56, 0x9a,
+	0x11, 0x96, 0x83, 0x77, 0xbf, 0xeb, 0xca, 0xce, 0x64, 0xdd, 0x16, 0xa3, 0xcf, 0x08, 0x3f, 0x34,
+	0x2c, 0x66, 0x61, 0xcb, 0xf6, 0xfc, 0x1f, 0x11, 0xe3, 0x94, 0xa5, 0xa0, 0x2f, 0xac, 0xda, 0xa8,
+	0x25, 0x5e, 0x06, 0xcb, 0xf8, 0xf6, 0xc5, 0x24, 0x33, 0xf2, 0x2c, 0xc2, 0x77, 0x84, 0x97, 0x0c,
+	0xc2, 0x25, 0xee, 0x9b, 0x07, 0x05, 0x93, 0x40, 0x83, 0x59, 0x7c, 0x8d, 0x71, 0x0a, 0x07, 0x06,
+	0xa1, 0x96, 0x58, 0xf1, 0x37, 0xda, 0xc4, 0x39, 0x34, 0xe7, 0xe4, 0x1f, 0xc0, 0xc9, 0x60, 0x09,
+	0xdf, 0x4a, 0x25, 0x10, 0xc5, 0x04, 0x6f, 0xe9, 0x34, 0xd5, 0x6b, 0x8b, 0xa8, 0x51, 0x4d, 0x6e,
+	0xfa, 0xc5, 0x0d, 0xa2, 0x20, 0xfa, 0xe2, 0xb1, 0xde, 0x83, 0x1a, 0x22, 0x6d, 0xb0, 0x52, 0x49,
+	0xd6, 0xee, 0xea, 0xae, 0x2d, 0x91, 0xb1, 0x74, 0xa0, 0x6d, 0xcc, 0x3e, 0x21, 0xfd, 0xdd, 0x38,
+	0xa9, 0x2b, 0x3d, 0x90, 0x25, 0x13, 0xdc, 0xa0, 0xd5, 0x12, 0x2f, 0xf5, 0xdd, 0x90, 0x54, 0xb1,
+	0x9e, 0x45, 0x50, 0x2c, 0x07, 0x83, 0x58, 0x4d, 0x66, 0x4e, 0x97, 0xb7, 0x59, 0x0e, 0xd1, 0x06,
+	0x6e, 0x18, 0x86, 0x55, 0xbb, 0x0c, 0x57, 0x80, 0x78, 0x3b, 0x74, 0xc6, 0x2e, 0x4a, 0xf1, 0x63,
+	0xfb, 0xc6, 0x84, 0xa7, 0x90, 0x8d, 0x98, 0xb1, 0xe3, 0xf8, 0xc6, 0x38, 0x93, 0x7e, 0xc6, 0x68,
+	0x44, 0xaa, 0xd7, 0x05, 0xef, 0x81, 0x54, 0x63, 0x85, 0xfb, 0x05, 0xbe, 0x7f, 0x2e, 0xdc, 0x76,
+	0xdc, 0x30, 0x54, 0x73, 0xff, 0xc4, 0xdb, 0x97, 0xa3, 0x02, 0x87, 0x86, 0xea, 0x12, 0x1e, 0xa0,
+	0xa3, 0x89, 0x7c, 0x82, 0x26, 0xce, 0x26, 0x68, 0x1e, 0x4f, 0x15, 0x52, 0xa4, 0x00, 0xc3, 0x70,
+	0x0d, 0x75, 0xf4, 0x09, 0x47, 0xa3, 0x1c, 0x5f, 0x12, 0x96, 0xd9, 0x34, 0x53, 0xe0, 0x22, 0x77,
+	0x9e, 0x56, 0x04, 0xf7, 0xf0, 0x24, 0xc9, 0x45, 0x97, 0x2b, 0x67, 0xe8, 0x94, 0x5e, 0x97, 0x40,
+	0x4a, 0xc1, 0x9d, 0x9b, 0x53, 0xd1, 0x2e, 0x5e, 0x1a, 0xe5, 0xb5, 0xda, 0x16, 0xe3, 0x1e, 0x71,
+	0xed, 0xdd, 0xe1, 0x71, 0x88, 0x8e, 0x8e, 0x43, 0xf4, 0xfb, 0x38, 0x44, 0xdf, 0x4e, 0xc2, 0xca,
+	0xd1, 0x49, 0x58, 0xf9, 0x79, 0x12, 0x56, 0x3e, 0x3e, 0xef, 0x30, 0xb5, 0xd7, 0x6d, 0x37, 0x53,
+	0x91, 0xc7, 0xaf, 0x76, 0x77, 0x36, 0xdf, 0x82, 0xea, 0x0b, 0xb9, 0x1f, 0xa7, 0x7b, 0x84, 0xf1,
+	0xf8, 0xe0, 0xa2, 0x7f, 0xba, 0x1a, 0x14, 0x50, 0xb6, 0x27, 0xcd, 0xbf, 0xfc, 0xe9, 0x9f, 0x01,
+	0x00, 0xe4, 0x62, 0x76, 0x89, 0x60, 0x06, 0x00, 0x00,
+}
+
+func (m *EventUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.NewParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.OldParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventToggleMultiCoinRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventToggleMultiCoinRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventToggleMultiCoinRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingRewardsClaimed) > 0 {
+		i -= len(m.PendingRewardsClaimed)
+		copy(dAtA[i:], m.PendingRewardsClaimed)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PendingRewardsClaimed)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventClaimPendingMultiCoinRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventClaimPendingMultiCoinRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventClaimPendingMultiCoinRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardsClaimed) > 0 {
+		i -= len(m.RewardsClaimed)
+		copy(dAtA[i:], m.RewardsClaimed)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RewardsClaimed)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Indices) > 0 {
+		dAtA4 := make([]byte, len(m.Indices)*10)
+		var j3 int
+		for _, num := range m.Indices {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintEvents(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPendingMultiCoinRewardsExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPendingMultiCoinRewardsExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPendingMultiCoinRewardsExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreationDate != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.CreationDate))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Rewards) > 0 {
+		i -= len(m.Rewards)
+		copy(dAtA[i:], m.Rewards)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Rewards)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSetMultiCoinDistributionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSetMultiCoinDistributionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetMultiCoinDistributionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActivationTime != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ActivationTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Version != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventActivateMultiCoinDistributionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventActivateMultiCoinDistributionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventActivateMultiCoinDistributionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCancelMultiCoinDistributionPolicyVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCancelMultiCoinDistributionPolicyVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCancelMultiCoinDistributionPolicyVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventToggleMultiCoinRewardsConvert) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventToggleMultiCoinRewardsConvert) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventToggleMultiCoinRewardsConvert) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingRewardsConverted) > 0 {
+		i -= len(m.PendingRewardsConverted)
+		copy(dAtA[i:], m.PendingRewardsConverted)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PendingRewardsConverted)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMultiCoinRewardsConverted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMultiCoinRewardsConverted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMultiCoinRewardsConverted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proceeds) > 0 {
+		i -= len(m.Proceeds)
+		copy(dAtA[i:], m.Proceeds)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Proceeds)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Rewards) > 0 {
+		i -= len(m.Rewards)
+		copy(dAtA[i:], m.Rewards)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Rewards)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMultiCoinRewardsConvertFailed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMultiCoinRewardsConvertFailed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMultiCoinRewardsConvertFailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMultiCoinRewardsConvertAborted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMultiCoinRewardsConvertAborted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMultiCoinRewardsConvertAborted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		i -= len(m.Rewards)
+		copy(dAtA[i:], m.Rewards)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Rewards)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.OldParams.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.NewParams.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventToggleMultiCoinRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.PendingRewardsClaimed)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventClaimPendingMultiCoinRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Indices) > 0 {
+		l = 0
+		for _, e := range m.Indices {
+			l += sovEvents(uint64(e))
+		}
+		n += 1 + sovEvents(uint64(l)) + l
+	}
+	l = len(m.RewardsClaimed)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventPendingMultiCoinRewardsExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovEvents(uint64(m.Index))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Rewards)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.CreationDate != 0 {
+		n += 1 + sovEvents(uint64(m.CreationDate))
+	}
+	return n
+}
+
+func (m *EventSetMultiCoinDistributionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovEvents(uint64(m.Version))
+	}
+	if m.ActivationTime != 0 {
+		n += 1 + sovEvents(uint64(m.ActivationTime))
+	}
+	return n
+}
+
+func (m *EventActivateMultiCoinDistributionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovEvents(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *EventCancelMultiCoinDistributionPolicyVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovEvents(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *EventToggleMultiCoinRewardsConvert) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.PendingRewardsConverted)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMultiCoinRewardsConverted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Rewards)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Proceeds)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMultiCoinRewardsConvertFailed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMultiCoinRewardsConvertAborted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Rewards)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OldParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NewParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventToggleMultiCoinRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventToggleMultiCoinRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventToggleMultiCoinRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRewardsClaimed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingRewardsClaimed = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventClaimPendingMultiCoinRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventClaimPendingMultiCoinRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventClaimPendingMultiCoinRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Indices = append(m.Indices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvents
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvents
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Indices) == 0 {
+					m.Indices = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Indices = append(m.Indices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indices", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsClaimed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsClaimed = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPendingMultiCoinRewardsExpired) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPendingMultiCoinRewardsExpired: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPendingMultiCoinRewardsExpired: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationDate", wireType)
+			}
+			m.CreationDate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationDate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSetMultiCoinDistributionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSetMultiCoinDistributionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSetMultiCoinDistributionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationTime", wireType)
+			}
+			m.ActivationTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventActivateMultiCoinDistributionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventActivateMultiCoinDistributionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventActivateMultiCoinDistributionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCancelMultiCoinDistributionPolicyVersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCancelMultiCoinDistributionPolicyVersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCancelMultiCoinDistributionPolicyVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventToggleMultiCoinRewardsConvert) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventToggleMultiCoinRewardsConvert: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventToggleMultiCoinRewardsConvert: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRewardsConverted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingRewardsConverted = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *EventUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
 	}
-	var l int
-	_ = l
-	l = m.OldParams.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	l = m.NewParams.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	l = len(m.Payload)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	return n
-}
 
-func (m *EventToggleMultiCoinRewards) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.Enabled {
-		n += 2
-	}
-	l = len(m.PendingRewardsClaimed)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EventUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *EventMultiCoinRewardsConverted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -361,17 +2559,17 @@ func (m *EventUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMultiCoinRewardsConverted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMultiCoinRewardsConverted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OldParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -381,30 +2579,29 @@ func (m *EventUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OldParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -414,28 +2611,27 @@ func (m *EventUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NewParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Rewards = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proceeds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -463,7 +2659,7 @@ func (m *EventUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payload = string(dAtA[iNdEx:postIndex])
+			m.Proceeds = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -486,7 +2682,7 @@ func (m *EventUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventToggleMultiCoinRewards) Unmarshal(dAtA []byte) error {
+func (m *EventMultiCoinRewardsConvertFailed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -509,15 +2705,15 @@ func (m *EventToggleMultiCoinRewards) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventToggleMultiCoinRewards: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMultiCoinRewardsConvertFailed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventToggleMultiCoinRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMultiCoinRewardsConvertFailed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -545,13 +2741,13 @@ func (m *EventToggleMultiCoinRewards) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -561,15 +2757,27 @@ func (m *EventToggleMultiCoinRewards) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Enabled = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingRewardsClaimed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -597,7 +2805,121 @@ func (m *EventToggleMultiCoinRewards) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PendingRewardsClaimed = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMultiCoinRewardsConvertAborted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMultiCoinRewardsConvertAborted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMultiCoinRewardsConvertAborted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
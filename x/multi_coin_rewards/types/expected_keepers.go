@@ -2,6 +2,7 @@ package types
 
 import (
 	"cosmossdk.io/math"
+	fundersTypes "github.com/KYVENetwork/chain/x/funders/types"
 	poolTypes "github.com/KYVENetwork/chain/x/pool/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -12,3 +13,15 @@ type PoolKeeper interface {
 	GetPoolWithError(ctx sdk.Context, poolId uint64) (poolTypes.Pool, error)
 	EnsurePoolAccount(ctx sdk.Context, id uint64)
 }
+
+type FundersKeeper interface {
+	GetCoinWhitelistMap(ctx sdk.Context) (whitelist map[string]fundersTypes.WhitelistCoinEntry)
+}
+
+// SwapVenue converts non-native coins to the native denom, e.g. through an
+// in-chain constant-product pool or an IBC-routed swap.
+type SwapVenue interface {
+	// Swap swaps the coin of the sender to the native denom and sends the proceeds back to the sender.
+	// It must fail if the proceeds are lower than minAmountOut.
+	Swap(ctx sdk.Context, sender sdk.AccAddress, coin sdk.Coin, minAmountOut math.Int) (math.Int, error)
+}
@@ -36,5 +36,38 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	// Multi Coin Distribution Policy Versions
+	policyVersionsMap := make(map[uint64]struct{})
+
+	for _, elem := range gs.MultiCoinDistributionPolicyVersions {
+		if _, ok := policyVersionsMap[elem.Version]; ok {
+			return fmt.Errorf("duplicated version for multi coin distribution policy %v", elem.Version)
+		}
+		if _, err := ParseAndNormalizeMultiCoinDistributionMap(elem.Policy); err != nil {
+			return fmt.Errorf("invalid multi coin distribution policy version %v: %w", elem.Version, err)
+		}
+
+		policyVersionsMap[elem.Version] = struct{}{}
+	}
+
+	// Multi Coin Convert
+	multiCoinConvertMap := make(map[string]struct{})
+
+	for _, elem := range gs.MultiCoinConvert {
+		if _, ok := multiCoinConvertMap[elem]; ok {
+			return fmt.Errorf("duplicated address for multi coin convert %v", elem)
+		}
+		multiCoinConvertMap[elem] = struct{}{}
+	}
+
+	multiCoinConvertEntriesMap := make(map[string]struct{})
+
+	for _, elem := range gs.MultiCoinConvertEntries {
+		if _, ok := multiCoinConvertEntriesMap[elem.Address]; ok {
+			return fmt.Errorf("duplicated address for multi coin convert entry %v", elem)
+		}
+		multiCoinConvertEntriesMap[elem.Address] = struct{}{}
+	}
+
 	return gs.Params.Validate()
 }
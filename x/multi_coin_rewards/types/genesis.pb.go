@@ -35,6 +35,12 @@ type GenesisState struct {
 	MultiCoinEnabled []string `protobuf:"bytes,4,rep,name=multi_coin_enabled,json=multiCoinEnabled,proto3" json:"multi_coin_enabled,omitempty"`
 	// multi_coin_distribution_policy ...
 	MultiCoinDistributionPolicy *MultiCoinDistributionPolicy `protobuf:"bytes,5,opt,name=multi_coin_distribution_policy,json=multiCoinDistributionPolicy,proto3" json:"multi_coin_distribution_policy,omitempty"`
+	// multi_coin_distribution_policy_versions ...
+	MultiCoinDistributionPolicyVersions []MultiCoinDistributionPolicyVersion `protobuf:"bytes,6,rep,name=multi_coin_distribution_policy_versions,json=multiCoinDistributionPolicyVersions,proto3" json:"multi_coin_distribution_policy_versions"`
+	// multi_coin_convert contains all addresses which have enabled the convert mode
+	MultiCoinConvert []string `protobuf:"bytes,7,rep,name=multi_coin_convert,json=multiCoinConvert,proto3" json:"multi_coin_convert,omitempty"`
+	// multi_coin_convert_entries ...
+	MultiCoinConvertEntries []MultiCoinConvertEntry `protobuf:"bytes,8,rep,name=multi_coin_convert_entries,json=multiCoinConvertEntries,proto3" json:"multi_coin_convert_entries"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -105,6 +111,27 @@ func (m *GenesisState) GetMultiCoinDistributionPolicy() *MultiCoinDistributionPo
 	return nil
 }
 
+func (m *GenesisState) GetMultiCoinDistributionPolicyVersions() []MultiCoinDistributionPolicyVersion {
+	if m != nil {
+		return m.MultiCoinDistributionPolicyVersions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMultiCoinConvert() []string {
+	if m != nil {
+		return m.MultiCoinConvert
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMultiCoinConvertEntries() []MultiCoinConvertEntry {
+	if m != nil {
+		return m.MultiCoinConvertEntries
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "kyve.multi_coin_rewards.v1beta1.GenesisState")
 }
@@ -114,32 +141,37 @@ func init() {
 }
 
 var fileDescriptor_e2671b833fc40e12 = []byte{
-	// 395 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x4d, 0x8b, 0xda, 0x40,
-	0x18, 0xc7, 0x93, 0x6a, 0x85, 0xc6, 0x1e, 0x4a, 0xe8, 0x21, 0x28, 0x8c, 0xe2, 0xa5, 0x82, 0x36,
-	0x41, 0x7b, 0xe8, 0xa5, 0xbd, 0xd8, 0x86, 0x1e, 0x4a, 0x8b, 0xa6, 0x50, 0x68, 0x2f, 0x21, 0x2f,
-	0x0f, 0x71, 0xd0, 0xcc, 0xc4, 0xc9, 0x44, 0x37, 0xc7, 0x3d, 0xef, 0x61, 0xf7, 0x63, 0x79, 0xf4,
-	0xb8, 0xa7, 0x65, 0xd1, 0x2f, 0xb2, 0x64, 0x92, 0x95, 0xec, 0x8b, 0x64, 0x6f, 0xc3, 0x3c, 0xbf,
-	0xff, 0xcb, 0x0c, 0x8f, 0xf2, 0x71, 0x91, 0xae, 0xc1, 0x08, 0x93, 0x25, 0xc7, 0xb6, 0x47, 0x31,
-	0xb1, 0x19, 0x6c, 0x1c, 0xe6, 0xc7, 0xc6, 0x7a, 0xe4, 0x02, 0x77, 0x46, 0x46, 0x00, 0x04, 0x62,
-	0x1c, 0xeb, 0x11, 0xa3, 0x9c, 0xaa, 0x9d, 0x0c, 0xd7, 0x9f, 0xe2, 0x7a, 0x81, 0xb7, 0xde, 0x07,
-	0x34, 0xa0, 0x82, 0x35, 0xb2, 0x53, 0x2e, 0x6b, 0x0d, 0xab, 0x52, 0x22, 0x87, 0x39, 0x61, 0x11,
-	0xd2, 0x1a, 0x54, 0xd1, 0x3c, 0x8d, 0xa0, 0x80, 0x7b, 0x17, 0x75, 0xe5, 0xed, 0x8f, 0xbc, 0xe3,
-	0x1f, 0xee, 0x70, 0x50, 0x4d, 0xa5, 0x91, 0xbb, 0x69, 0x72, 0x57, 0xee, 0x37, 0xc7, 0x1f, 0xf4,
-	0x8a, 0xce, 0xfa, 0x54, 0xe0, 0x93, 0xfa, 0xf6, 0xa6, 0x23, 0x59, 0x85, 0x58, 0xbd, 0x94, 0x95,
-	0x5e, 0x49, 0x13, 0x01, 0xf1, 0x31, 0x09, 0xee, 0xb5, 0x36, 0x10, 0xce, 0x30, 0xc4, 0xda, 0xab,
-	0x6e, 0xad, 0xdf, 0x1c, 0x7f, 0xad, 0xcc, 0xf8, 0x95, 0x8d, 0xbe, 0x51, 0x4c, 0xa6, 0xb9, 0x91,
-	0x95, 0xcf, 0x4d, 0xc2, 0x59, 0x5a, 0x24, 0xa3, 0xf0, 0x34, 0x83, 0x21, 0x56, 0x23, 0xa5, 0xbd,
-	0x4a, 0x20, 0x01, 0x3b, 0xce, 0xde, 0xf9, 0xb8, 0x91, 0x56, 0x13, 0xaf, 0x1d, 0x54, 0x36, 0x99,
-	0x65, 0x1e, 0xe2, 0xab, 0x8a, 0x5c, 0x6d, 0x75, 0xbc, 0x79, 0x18, 0xac, 0x0e, 0x15, 0xb5, 0x64,
-	0x04, 0xc4, 0x71, 0x97, 0xe0, 0x6b, 0xf5, 0x6e, 0xad, 0xff, 0xc6, 0x7a, 0x77, 0x6c, 0x6b, 0xe6,
-	0xf7, 0xea, 0xb9, 0xac, 0xa0, 0x12, 0xee, 0xe3, 0x98, 0x33, 0xec, 0x26, 0x1c, 0x53, 0x62, 0x47,
-	0x74, 0x89, 0xbd, 0x54, 0x7b, 0x2d, 0x3a, 0x7e, 0x79, 0xf9, 0x6f, 0x7d, 0x2f, 0x99, 0x4c, 0x85,
-	0x87, 0xd5, 0x0e, 0x4f, 0x0f, 0x27, 0xb3, 0xed, 0x1e, 0xc9, 0xbb, 0x3d, 0x92, 0x6f, 0xf7, 0x48,
-	0xbe, 0x3a, 0x20, 0x69, 0x77, 0x40, 0xd2, 0xf5, 0x01, 0x49, 0xff, 0x3f, 0x07, 0x98, 0xcf, 0x13,
-	0x57, 0xf7, 0x68, 0x68, 0xfc, 0xfc, 0xf7, 0xd7, 0xfc, 0x0d, 0x7c, 0x43, 0xd9, 0xc2, 0xf0, 0xe6,
-	0x0e, 0x26, 0xc6, 0xd9, 0x73, 0xeb, 0x26, 0xd6, 0xcc, 0x6d, 0x88, 0x3d, 0xfb, 0x74, 0x17, 0x00,
-	0x00, 0xff, 0xff, 0xff, 0x89, 0xea, 0xef, 0x2a, 0x03, 0x00, 0x00,
+	// 466 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x1b, 0x3a, 0x0a, 0x78, 0x1c, 0x90, 0x85, 0x84, 0xd5, 0x49, 0x5e, 0x35, 0x0e, 0xab,
+	0xb4, 0x91, 0x68, 0x43, 0x82, 0x0b, 0x5c, 0x56, 0x2a, 0x0e, 0x08, 0xd4, 0x15, 0x69, 0x12, 0x5c,
+	0xa2, 0x24, 0x7d, 0xca, 0xac, 0x35, 0x76, 0x66, 0x3b, 0x1d, 0x39, 0xf2, 0x09, 0xe0, 0x1b, 0xf0,
+	0x75, 0x76, 0xdc, 0x09, 0x71, 0x42, 0xa8, 0xfd, 0x22, 0x28, 0xb6, 0xa9, 0x42, 0xb7, 0x91, 0x49,
+	0xdc, 0x22, 0xbf, 0xdf, 0xfb, 0xff, 0xff, 0xef, 0xc5, 0x46, 0x4f, 0x4e, 0xca, 0x19, 0x04, 0x59,
+	0x31, 0xd5, 0x2c, 0x4c, 0x04, 0xe3, 0xa1, 0x84, 0xb3, 0x48, 0x4e, 0x54, 0x30, 0xdb, 0x8b, 0x41,
+	0x47, 0x7b, 0x41, 0x0a, 0x1c, 0x14, 0x53, 0x7e, 0x2e, 0x85, 0x16, 0x78, 0xb3, 0xc2, 0xfd, 0xcb,
+	0xb8, 0xef, 0xf0, 0xee, 0xc3, 0x54, 0xa4, 0xc2, 0xb0, 0x41, 0xf5, 0x65, 0xdb, 0xba, 0xbb, 0x4d,
+	0x2e, 0x79, 0x24, 0xa3, 0xcc, 0x99, 0x74, 0x77, 0x9a, 0x68, 0x5d, 0xe6, 0xe0, 0xe0, 0xad, 0xef,
+	0x1d, 0x74, 0xff, 0xb5, 0xcd, 0xf8, 0x5e, 0x47, 0x1a, 0xf0, 0x10, 0x75, 0xac, 0x1a, 0xf1, 0x7a,
+	0x5e, 0x7f, 0x7d, 0x7f, 0xdb, 0x6f, 0xc8, 0xec, 0x8f, 0x0c, 0x7e, 0xb0, 0x76, 0xfe, 0x73, 0xb3,
+	0x35, 0x76, 0xcd, 0xf8, 0x8b, 0x87, 0xb6, 0x6a, 0x3d, 0x39, 0xf0, 0x09, 0xe3, 0xe9, 0x9f, 0xde,
+	0x10, 0xb8, 0x96, 0x0c, 0x14, 0xb9, 0xd5, 0x6b, 0xf7, 0xd7, 0xf7, 0x5f, 0x36, 0x7a, 0xbc, 0xad,
+	0x4a, 0x03, 0xc1, 0xf8, 0xc8, 0x0a, 0x8d, 0x6d, 0x7d, 0xc8, 0xb5, 0x2c, 0x9d, 0x33, 0xcd, 0xae,
+	0x67, 0x18, 0x28, 0x9c, 0xa3, 0x8d, 0xd3, 0x02, 0x0a, 0x08, 0x55, 0x35, 0xe7, 0x6a, 0x22, 0xd2,
+	0x36, 0xd3, 0xee, 0x34, 0x26, 0x39, 0xac, 0x34, 0xcc, 0xaa, 0x9c, 0x2f, 0x39, 0x5d, 0x9e, 0xfc,
+	0x6d, 0x8c, 0x77, 0x11, 0xae, 0x09, 0x01, 0x8f, 0xe2, 0x29, 0x4c, 0xc8, 0x5a, 0xaf, 0xdd, 0xbf,
+	0x37, 0x7e, 0xb0, 0x4c, 0x3b, 0xb4, 0xe7, 0xf8, 0xb3, 0x87, 0x68, 0x0d, 0x9f, 0x30, 0xa5, 0x25,
+	0x8b, 0x0b, 0xcd, 0x04, 0x0f, 0x73, 0x31, 0x65, 0x49, 0x49, 0x6e, 0x9b, 0x8c, 0x2f, 0x6e, 0xbe,
+	0xad, 0x57, 0x35, 0x91, 0x91, 0xd1, 0x18, 0x6f, 0x64, 0xd7, 0x17, 0xf1, 0x37, 0x0f, 0x6d, 0xff,
+	0x3b, 0x43, 0x38, 0x03, 0xa9, 0x98, 0xe0, 0x8a, 0x74, 0xcc, 0xaf, 0x1b, 0xfc, 0x4f, 0x98, 0x23,
+	0xab, 0xe5, 0x16, 0xf9, 0x38, 0x6b, 0x24, 0x57, 0x77, 0x9a, 0x08, 0x3e, 0x03, 0xa9, 0xc9, 0x9d,
+	0x95, 0x9d, 0x0e, 0xec, 0x39, 0x2e, 0x51, 0xf7, 0x32, 0xbd, 0xbc, 0x7c, 0x77, 0xcd, 0x04, 0xcf,
+	0x6e, 0x3e, 0x81, 0x93, 0xad, 0xdf, 0xba, 0x47, 0xd9, 0x15, 0x45, 0x06, 0xea, 0xe0, 0xf0, 0x7c,
+	0x4e, 0xbd, 0x8b, 0x39, 0xf5, 0x7e, 0xcd, 0xa9, 0xf7, 0x75, 0x41, 0x5b, 0x17, 0x0b, 0xda, 0xfa,
+	0xb1, 0xa0, 0xad, 0x8f, 0xcf, 0x53, 0xa6, 0x8f, 0x8b, 0xd8, 0x4f, 0x44, 0x16, 0xbc, 0xf9, 0x70,
+	0x34, 0x7c, 0x07, 0xfa, 0x4c, 0xc8, 0x93, 0x20, 0x39, 0x8e, 0x18, 0x0f, 0x3e, 0x5d, 0xf5, 0x72,
+	0xcd, 0x8b, 0x8d, 0x3b, 0xe6, 0xc9, 0x3e, 0xfd, 0x3d, 0x00, 0xd4, 0xd3, 0x85, 0x57, 0x75, 0x04,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -162,6 +194,43 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MultiCoinConvertEntries) > 0 {
+		for iNdEx := len(m.MultiCoinConvertEntries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MultiCoinConvertEntries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.MultiCoinConvert) > 0 {
+		for iNdEx := len(m.MultiCoinConvert) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MultiCoinConvert[iNdEx])
+			copy(dAtA[i:], m.MultiCoinConvert[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.MultiCoinConvert[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.MultiCoinDistributionPolicyVersions) > 0 {
+		for iNdEx := len(m.MultiCoinDistributionPolicyVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MultiCoinDistributionPolicyVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.MultiCoinDistributionPolicy != nil {
 		{
 			size, err := m.MultiCoinDistributionPolicy.MarshalToSizedBuffer(dAtA[:i])
@@ -257,6 +326,24 @@ func (m *GenesisState) Size() (n int) {
 		l = m.MultiCoinDistributionPolicy.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.MultiCoinDistributionPolicyVersions) > 0 {
+		for _, e := range m.MultiCoinDistributionPolicyVersions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MultiCoinConvert) > 0 {
+		for _, s := range m.MultiCoinConvert {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MultiCoinConvertEntries) > 0 {
+		for _, e := range m.MultiCoinConvertEntries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -463,6 +550,106 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiCoinDistributionPolicyVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MultiCoinDistributionPolicyVersions = append(m.MultiCoinDistributionPolicyVersions, MultiCoinDistributionPolicyVersion{})
+			if err := m.MultiCoinDistributionPolicyVersions[len(m.MultiCoinDistributionPolicyVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiCoinConvert", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MultiCoinConvert = append(m.MultiCoinConvert, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiCoinConvertEntries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MultiCoinConvertEntries = append(m.MultiCoinConvertEntries, MultiCoinConvertEntry{})
+			if err := m.MultiCoinConvertEntries[len(m.MultiCoinConvertEntries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
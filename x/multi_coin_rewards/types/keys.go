@@ -28,6 +28,29 @@ var (
 	// MultiCoinDistributionPolicyKey
 	MultiCoinDistributionPolicyKey = collections.NewPrefix(2)
 
+	// MultiCoinDistributionPolicyVersionsKey | <version>
+	MultiCoinDistributionPolicyVersionsKey = collections.NewPrefix(4)
+
+	// MultiCoinDistributionPolicyVersionSequenceKey stores the next policy version
+	MultiCoinDistributionPolicyVersionSequenceKey = collections.NewPrefix(5)
+
+	// MultiCoinDistributionPolicyScheduleKey | <activation_time> | <version>
+	// contains all policy versions which are not active yet
+	MultiCoinDistributionPolicyScheduleKey = collections.NewPrefix(6)
+
+	// MultiCoinDistributionPolicyActiveVersionKey stores the version of the active policy
+	MultiCoinDistributionPolicyActiveVersionKey = collections.NewPrefix(7)
+
+	// MultiCoinRewardsConvertKey is the key prefix for storing all users who have enabled the convert mode.
+	MultiCoinRewardsConvertKey = collections.NewPrefix(8)
+
+	// MultiCoinConvertEntriesKey | <address>
+	// contains the non-native rewards which get converted in the next batch
+	MultiCoinConvertEntriesKey = collections.NewPrefix(9)
+
+	// MultiCoinConvertCursorKey stores the address of the convert entry which was processed last
+	MultiCoinConvertCursorKey = collections.NewPrefix(10)
+
 	// MultiCoinPendingRewardsEntryKeyPrefix | <index>
 	MultiCoinPendingRewardsEntryKeyPrefix = []byte{3, 0}
 	// MultiCoinPendingRewardsEntryKeyPrefixIndex2 | <address> | <poolId>
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var (
+	_ legacytx.LegacyMsg = &MsgCancelMultiCoinDistributionPolicyVersion{}
+	_ sdk.Msg            = &MsgCancelMultiCoinDistributionPolicyVersion{}
+)
+
+func (msg *MsgCancelMultiCoinDistributionPolicyVersion) GetSignBytes() []byte {
+	bz := Amino.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCancelMultiCoinDistributionPolicyVersion) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCancelMultiCoinDistributionPolicyVersion) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCancelMultiCoinDistributionPolicyVersion) Type() string {
+	return "kyve/multi_coin_rewards/MsgCancelMultiCoinDistributionPolicyVersion"
+}
+
+func (msg *MsgCancelMultiCoinDistributionPolicyVersion) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidAddress, "invalid creator address: %s", err)
+	}
+
+	return nil
+}
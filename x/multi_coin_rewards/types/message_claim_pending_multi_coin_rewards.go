@@ -0,0 +1,51 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var (
+	_ legacytx.LegacyMsg = &MsgClaimPendingMultiCoinRewards{}
+	_ sdk.Msg            = &MsgClaimPendingMultiCoinRewards{}
+)
+
+func (msg *MsgClaimPendingMultiCoinRewards) GetSignBytes() []byte {
+	bz := Amino.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgClaimPendingMultiCoinRewards) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgClaimPendingMultiCoinRewards) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgClaimPendingMultiCoinRewards) Type() string {
+	return "kyve/multi_coin_rewards/MsgClaimPendingMultiCoinRewards"
+}
+
+func (msg *MsgClaimPendingMultiCoinRewards) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidAddress, "invalid creator address: %s", err)
+	}
+
+	indices := make(map[uint64]struct{})
+	for _, index := range msg.Indices {
+		if _, ok := indices[index]; ok {
+			return errors.Wrapf(errorsTypes.ErrInvalidRequest, "duplicate index %d", index)
+		}
+		indices[index] = struct{}{}
+	}
+
+	return nil
+}
@@ -47,5 +47,9 @@ func (msg *MsgSetMultiCoinRewardsDistributionPolicy) ValidateBasic() error {
 		return err
 	}
 
+	if msg.ActivationTime < 0 {
+		return errors.Wrap(errorsTypes.ErrInvalidRequest, "activation time cannot be negative")
+	}
+
 	return nil
 }
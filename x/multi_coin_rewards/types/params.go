@@ -1,11 +1,24 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	globalTypes "github.com/KYVENetwork/chain/x/global/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // DefaultMultiCoinDistributionPendingTime ...
 var DefaultMultiCoinDistributionPendingTime = uint64(60 * 60 * 24 * 14)
 
-// NewParams creates a new Params instance
+// DefaultMultiCoinConvertMaxAttempts ...
+var DefaultMultiCoinConvertMaxAttempts = uint64(100)
+
+// DefaultMultiCoinConvertMaxEntriesPerBlock ...
+var DefaultMultiCoinConvertMaxEntriesPerBlock = uint64(100)
+
+// NewParams creates a new Params instance with the convert mode disabled.
+// The convert limits are set to their defaults.
 func NewParams(
 	multiCoinDistributionPendingTime uint64,
 	multiCoinDistributionPolicyAdminAddress string,
@@ -13,6 +26,8 @@ func NewParams(
 	return Params{
 		MultiCoinDistributionPendingTime:        multiCoinDistributionPendingTime,
 		MultiCoinDistributionPolicyAdminAddress: multiCoinDistributionPolicyAdminAddress,
+		MultiCoinConvertMaxAttempts:             DefaultMultiCoinConvertMaxAttempts,
+		MultiCoinConvertMaxEntriesPerBlock:      DefaultMultiCoinConvertMaxEntriesPerBlock,
 	}
 }
 
@@ -33,5 +48,40 @@ func (p Params) Validate() error {
 		}
 	}
 
+	convertDenoms := make(map[string]struct{})
+	for _, entry := range p.MultiCoinConvertDenoms {
+		if err := sdk.ValidateDenom(entry.Denom); err != nil {
+			return err
+		}
+		if entry.Denom == globalTypes.Denom {
+			return fmt.Errorf("native denom %s can not be converted", entry.Denom)
+		}
+		if _, ok := convertDenoms[entry.Denom]; ok {
+			return fmt.Errorf("duplicate convert entry for denom %s", entry.Denom)
+		}
+		if entry.MaxSlippage.IsNil() || entry.MaxSlippage.IsNegative() || entry.MaxSlippage.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("invalid max slippage for denom %s", entry.Denom)
+		}
+		convertDenoms[entry.Denom] = struct{}{}
+	}
+
+	if p.MultiCoinConvertMaxAttempts == 0 {
+		return fmt.Errorf("multi coin convert max attempts must be positive")
+	}
+
+	if p.MultiCoinConvertMaxEntriesPerBlock == 0 {
+		return fmt.Errorf("multi coin convert max entries per block must be positive")
+	}
+
 	return nil
 }
+
+// GetMultiCoinConvertDenomEntry returns the convert entry of the given denom
+func (p Params) GetMultiCoinConvertDenomEntry(denom string) (MultiCoinConvertDenomEntry, bool) {
+	for _, entry := range p.MultiCoinConvertDenoms {
+		if entry.Denom == denom {
+			return entry, true
+		}
+	}
+	return MultiCoinConvertDenomEntry{}, false
+}
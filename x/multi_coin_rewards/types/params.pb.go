@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -30,6 +32,18 @@ type Params struct {
 	MultiCoinDistributionPolicyAdminAddress string `protobuf:"bytes,1,opt,name=multi_coin_distribution_policy_admin_address,json=multiCoinDistributionPolicyAdminAddress,proto3" json:"multi_coin_distribution_policy_admin_address,omitempty"`
 	// multi_coin_distribution_pending_time ...
 	MultiCoinDistributionPendingTime uint64 `protobuf:"varint,2,opt,name=multi_coin_distribution_pending_time,json=multiCoinDistributionPendingTime,proto3" json:"multi_coin_distribution_pending_time,omitempty"`
+	// multi_coin_convert_denoms are the denoms which can be converted to the native denom
+	// for users who have enabled the convert mode.
+	MultiCoinConvertDenoms []MultiCoinConvertDenomEntry `protobuf:"bytes,3,rep,name=multi_coin_convert_denoms,json=multiCoinConvertDenoms,proto3" json:"multi_coin_convert_denoms"`
+	// multi_coin_convert_venue is the name of the swap venue which is used to convert the rewards.
+	// It has to be one of the venues registered by the chain. If it is empty, the convert mode is disabled.
+	MultiCoinConvertVenue string `protobuf:"bytes,4,opt,name=multi_coin_convert_venue,json=multiCoinConvertVenue,proto3" json:"multi_coin_convert_venue,omitempty"`
+	// multi_coin_convert_max_attempts is the number of batches in which the rewards of an address
+	// can fail to convert. Afterwards, the remaining rewards are added to the pending rewards of the address.
+	MultiCoinConvertMaxAttempts uint64 `protobuf:"varint,5,opt,name=multi_coin_convert_max_attempts,json=multiCoinConvertMaxAttempts,proto3" json:"multi_coin_convert_max_attempts,omitempty"`
+	// multi_coin_convert_max_entries_per_block is the maximum number of addresses whose rewards
+	// are converted in a single block.
+	MultiCoinConvertMaxEntriesPerBlock uint64 `protobuf:"varint,6,opt,name=multi_coin_convert_max_entries_per_block,json=multiCoinConvertMaxEntriesPerBlock,proto3" json:"multi_coin_convert_max_entries_per_block,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -79,8 +93,87 @@ func (m *Params) GetMultiCoinDistributionPendingTime() uint64 {
 	return 0
 }
 
+func (m *Params) GetMultiCoinConvertDenoms() []MultiCoinConvertDenomEntry {
+	if m != nil {
+		return m.MultiCoinConvertDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetMultiCoinConvertVenue() string {
+	if m != nil {
+		return m.MultiCoinConvertVenue
+	}
+	return ""
+}
+
+func (m *Params) GetMultiCoinConvertMaxAttempts() uint64 {
+	if m != nil {
+		return m.MultiCoinConvertMaxAttempts
+	}
+	return 0
+}
+
+func (m *Params) GetMultiCoinConvertMaxEntriesPerBlock() uint64 {
+	if m != nil {
+		return m.MultiCoinConvertMaxEntriesPerBlock
+	}
+	return 0
+}
+
+// MultiCoinConvertDenomEntry specifies a denom which can be converted to the native denom.
+type MultiCoinConvertDenomEntry struct {
+	// denom ...
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// max_slippage is the maximum accepted deviation of the swap proceeds from the
+	// reference price derived from the coin weights of the funders module.
+	// If the slippage is higher, the conversion is not performed.
+	MaxSlippage cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=max_slippage,json=maxSlippage,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_slippage"`
+}
+
+func (m *MultiCoinConvertDenomEntry) Reset()         { *m = MultiCoinConvertDenomEntry{} }
+func (m *MultiCoinConvertDenomEntry) String() string { return proto.CompactTextString(m) }
+func (*MultiCoinConvertDenomEntry) ProtoMessage()    {}
+func (*MultiCoinConvertDenomEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8e1a6cc59e13782, []int{1}
+}
+func (m *MultiCoinConvertDenomEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiCoinConvertDenomEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultiCoinConvertDenomEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultiCoinConvertDenomEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiCoinConvertDenomEntry.Merge(m, src)
+}
+func (m *MultiCoinConvertDenomEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiCoinConvertDenomEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiCoinConvertDenomEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiCoinConvertDenomEntry proto.InternalMessageInfo
+
+func (m *MultiCoinConvertDenomEntry) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "kyve.multi_coin_rewards.v1beta1.Params")
+	proto.RegisterType((*MultiCoinConvertDenomEntry)(nil), "kyve.multi_coin_rewards.v1beta1.MultiCoinConvertDenomEntry")
 }
 
 func init() {
@@ -88,24 +181,37 @@ func init() {
 }
 
 var fileDescriptor_b8e1a6cc59e13782 = []byte{
-	// 259 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0xd0, 0x3f, 0x4b, 0xc3, 0x40,
-	0x18, 0xc7, 0xf1, 0x9e, 0x48, 0xc1, 0x8c, 0x99, 0x3a, 0x9d, 0x41, 0x04, 0x3b, 0x94, 0x1c, 0xc5,
-	0xc1, 0xb9, 0xfe, 0x99, 0x84, 0x52, 0x8b, 0x08, 0x0a, 0x72, 0x5c, 0x72, 0x0f, 0xed, 0x43, 0x7b,
-	0x77, 0xe1, 0xee, 0x49, 0x6b, 0xde, 0x85, 0xef, 0xc6, 0xb7, 0xe0, 0xd8, 0xd1, 0x51, 0x92, 0x37,
-	0x22, 0x4d, 0x1d, 0x0a, 0xd6, 0xfd, 0xf3, 0xfd, 0x0d, 0xbf, 0x68, 0xb0, 0xa8, 0x56, 0x20, 0x4c,
-	0xb9, 0x24, 0x94, 0xb9, 0x43, 0x2b, 0x3d, 0xac, 0x95, 0xd7, 0x41, 0xac, 0x86, 0x19, 0x90, 0x1a,
-	0x8a, 0x42, 0x79, 0x65, 0x42, 0x5a, 0x78, 0x47, 0x2e, 0x3e, 0xdd, 0xea, 0xf4, 0xaf, 0x4e, 0x7f,
-	0xf5, 0xd9, 0x07, 0x8b, 0xba, 0x93, 0xb6, 0x88, 0x5f, 0xa3, 0xc1, 0x1e, 0xd4, 0x18, 0xc8, 0x63,
-	0x56, 0x12, 0x3a, 0x2b, 0x0b, 0xb7, 0xc4, 0xbc, 0x92, 0x4a, 0x1b, 0xb4, 0x52, 0x69, 0xed, 0x21,
-	0x84, 0x1e, 0x4b, 0x58, 0xff, 0x64, 0x7a, 0xd1, 0x36, 0x37, 0x0e, 0xed, 0xed, 0x5e, 0x31, 0x69,
-	0x83, 0xd1, 0xd6, 0x8f, 0x76, 0x3c, 0x1e, 0x47, 0xe7, 0xff, 0xce, 0x83, 0xd5, 0x68, 0x67, 0x92,
-	0xd0, 0x40, 0xef, 0x28, 0x61, 0xfd, 0xe3, 0x69, 0x72, 0x78, 0x76, 0x07, 0x1f, 0xd1, 0xc0, 0xf5,
-	0xc3, 0x67, 0xcd, 0xd9, 0xa6, 0xe6, 0xec, 0xbb, 0xe6, 0xec, 0xbd, 0xe1, 0x9d, 0x4d, 0xc3, 0x3b,
-	0x5f, 0x0d, 0xef, 0xbc, 0x5c, 0xcd, 0x90, 0xe6, 0x65, 0x96, 0xe6, 0xce, 0x88, 0xfb, 0xe7, 0xa7,
-	0xbb, 0x31, 0xd0, 0xda, 0xf9, 0x85, 0xc8, 0xe7, 0x0a, 0xad, 0x78, 0x3b, 0x74, 0x1e, 0x55, 0x05,
-	0x84, 0xac, 0xdb, 0x9e, 0x76, 0xf9, 0x13, 0x00, 0x00, 0xff, 0xff, 0x67, 0x26, 0x66, 0x2a, 0x64,
-	0x01, 0x00, 0x00,
+	// 467 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x41, 0x6b, 0xdb, 0x30,
+	0x18, 0x86, 0xe3, 0x35, 0x0d, 0x54, 0xdd, 0x49, 0x74, 0xc3, 0x6b, 0xc1, 0x09, 0xd9, 0x60, 0x39,
+	0x14, 0x9b, 0x6e, 0x87, 0x1e, 0x76, 0x4a, 0x9a, 0xec, 0xb2, 0xb5, 0x64, 0x59, 0x29, 0x6c, 0x30,
+	0x84, 0x2c, 0x7f, 0x38, 0x22, 0x91, 0x64, 0x24, 0x25, 0x8d, 0xc7, 0x7e, 0xc1, 0x4e, 0xfb, 0x59,
+	0x3d, 0xf6, 0x38, 0x76, 0x28, 0x23, 0xf9, 0x23, 0xc3, 0x72, 0x06, 0x21, 0x73, 0xd8, 0xcd, 0x46,
+	0xcf, 0xf3, 0x7e, 0xf0, 0x7e, 0x12, 0x3a, 0x9d, 0xe4, 0x73, 0x88, 0xc4, 0x6c, 0x6a, 0x39, 0x61,
+	0x8a, 0x4b, 0xa2, 0xe1, 0x96, 0xea, 0xc4, 0x44, 0xf3, 0xb3, 0x18, 0x2c, 0x3d, 0x8b, 0x32, 0xaa,
+	0xa9, 0x30, 0x61, 0xa6, 0x95, 0x55, 0xb8, 0x59, 0xd0, 0xe1, 0xbf, 0x74, 0xb8, 0xa6, 0x8f, 0x8f,
+	0x52, 0x95, 0x2a, 0xc7, 0x46, 0xc5, 0x57, 0xa9, 0xb5, 0xbf, 0xd7, 0x51, 0x63, 0xe8, 0x72, 0xf0,
+	0x17, 0x74, 0xba, 0xa1, 0x27, 0xdc, 0x58, 0xcd, 0xe3, 0x99, 0xe5, 0x4a, 0x92, 0x4c, 0x4d, 0x39,
+	0xcb, 0x09, 0x4d, 0x04, 0x97, 0x84, 0x26, 0x89, 0x06, 0x63, 0x7c, 0xaf, 0xe5, 0x75, 0x0e, 0x46,
+	0x2f, 0x9d, 0x73, 0xa1, 0xb8, 0xec, 0x6f, 0x18, 0x43, 0x27, 0x74, 0x0b, 0xbe, 0x5b, 0xe2, 0xf8,
+	0x0a, 0xbd, 0xd8, 0x19, 0x0f, 0x32, 0xe1, 0x32, 0x25, 0x96, 0x0b, 0xf0, 0x1f, 0xb5, 0xbc, 0x4e,
+	0x7d, 0xd4, 0xaa, 0x8e, 0x2d, 0xc1, 0x6b, 0x2e, 0x00, 0x7f, 0x43, 0xcf, 0x36, 0xf2, 0x98, 0x92,
+	0x73, 0xd0, 0x96, 0x24, 0x20, 0x95, 0x30, 0xfe, 0x5e, 0x6b, 0xaf, 0x73, 0xf8, 0xea, 0x4d, 0xf8,
+	0x9f, 0x52, 0xc2, 0xcb, 0xbf, 0x53, 0x2e, 0x4a, 0xbf, 0x5f, 0xe8, 0x03, 0x69, 0x75, 0xde, 0xab,
+	0xdf, 0x3d, 0x34, 0x6b, 0xa3, 0xa7, 0xa2, 0x8a, 0x30, 0xf8, 0x1c, 0xf9, 0x15, 0xd3, 0xe7, 0x20,
+	0x67, 0xe0, 0xd7, 0x5d, 0x31, 0x4f, 0xb6, 0xcd, 0x9b, 0xe2, 0x10, 0xf7, 0x51, 0xb3, 0x42, 0x14,
+	0x74, 0x41, 0xa8, 0xb5, 0x20, 0x32, 0x6b, 0xfc, 0x7d, 0xd7, 0xc0, 0xc9, 0xb6, 0x7f, 0x49, 0x17,
+	0xdd, 0x35, 0x82, 0xaf, 0x51, 0x67, 0x47, 0x0a, 0x48, 0xab, 0x39, 0x18, 0x92, 0x81, 0x26, 0xf1,
+	0x54, 0xb1, 0x89, 0xdf, 0x70, 0x71, 0xed, 0x8a, 0xb8, 0x41, 0xc9, 0x0e, 0x41, 0xf7, 0x0a, 0xb2,
+	0xfd, 0x15, 0x1d, 0xef, 0x2e, 0x04, 0x1f, 0xa1, 0x7d, 0xd7, 0xee, 0x7a, 0xf1, 0xe5, 0x0f, 0x7e,
+	0x8b, 0x1e, 0x17, 0x63, 0xcd, 0x94, 0x67, 0x19, 0x4d, 0xcb, 0xf5, 0x1d, 0xf4, 0x9e, 0x17, 0xe5,
+	0xfd, 0x7a, 0x68, 0x9e, 0x30, 0x65, 0x84, 0x32, 0x26, 0x99, 0x84, 0x5c, 0x45, 0x82, 0xda, 0x71,
+	0xf8, 0x1e, 0x52, 0xca, 0xf2, 0x3e, 0xb0, 0xd1, 0xa1, 0xa0, 0x8b, 0x8f, 0x6b, 0xaf, 0xf7, 0xe1,
+	0x6e, 0x19, 0x78, 0xf7, 0xcb, 0xc0, 0xfb, 0xbd, 0x0c, 0xbc, 0x1f, 0xab, 0xa0, 0x76, 0xbf, 0x0a,
+	0x6a, 0x3f, 0x57, 0x41, 0xed, 0xf3, 0x79, 0xca, 0xed, 0x78, 0x16, 0x87, 0x4c, 0x89, 0xe8, 0xdd,
+	0xa7, 0x9b, 0xc1, 0x15, 0xd8, 0x5b, 0xa5, 0x27, 0x11, 0x1b, 0x53, 0x2e, 0xa3, 0x45, 0xd5, 0x0b,
+	0xb1, 0x79, 0x06, 0x26, 0x6e, 0xb8, 0x2b, 0xfe, 0xfa, 0xcf, 0x00, 0x46, 0x9d, 0x20, 0x09, 0x49,
+	0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -128,6 +234,37 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MultiCoinConvertMaxEntriesPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MultiCoinConvertMaxEntriesPerBlock))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MultiCoinConvertMaxAttempts != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MultiCoinConvertMaxAttempts))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.MultiCoinConvertVenue) > 0 {
+		i -= len(m.MultiCoinConvertVenue)
+		copy(dAtA[i:], m.MultiCoinConvertVenue)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MultiCoinConvertVenue)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MultiCoinConvertDenoms) > 0 {
+		for iNdEx := len(m.MultiCoinConvertDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MultiCoinConvertDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.MultiCoinDistributionPendingTime != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.MultiCoinDistributionPendingTime))
 		i--
@@ -143,6 +280,46 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MultiCoinConvertDenomEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiCoinConvertDenomEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiCoinConvertDenomEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxSlippage.Size()
+		i -= size
+		if _, err := m.MaxSlippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -167,6 +344,37 @@ func (m *Params) Size() (n int) {
 	if m.MultiCoinDistributionPendingTime != 0 {
 		n += 1 + sovParams(uint64(m.MultiCoinDistributionPendingTime))
 	}
+	if len(m.MultiCoinConvertDenoms) > 0 {
+		for _, e := range m.MultiCoinConvertDenoms {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = len(m.MultiCoinConvertVenue)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.MultiCoinConvertMaxAttempts != 0 {
+		n += 1 + sovParams(uint64(m.MultiCoinConvertMaxAttempts))
+	}
+	if m.MultiCoinConvertMaxEntriesPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MultiCoinConvertMaxEntriesPerBlock))
+	}
+	return n
+}
+
+func (m *MultiCoinConvertDenomEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.MaxSlippage.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -256,6 +464,226 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiCoinConvertDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MultiCoinConvertDenoms = append(m.MultiCoinConvertDenoms, MultiCoinConvertDenomEntry{})
+			if err := m.MultiCoinConvertDenoms[len(m.MultiCoinConvertDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiCoinConvertVenue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MultiCoinConvertVenue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiCoinConvertMaxAttempts", wireType)
+			}
+			m.MultiCoinConvertMaxAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MultiCoinConvertMaxAttempts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiCoinConvertMaxEntriesPerBlock", wireType)
+			}
+			m.MultiCoinConvertMaxEntriesPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MultiCoinConvertMaxEntriesPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultiCoinConvertDenomEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiCoinConvertDenomEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiCoinConvertDenomEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlippage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSlippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
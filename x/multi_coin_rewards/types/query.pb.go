@@ -254,6 +254,8 @@ type QueryMultiCoinStatusResponse struct {
 	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
 	// pending_multi_coin_rewards ...
 	PendingMultiCoinRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=pending_multi_coin_rewards,json=pendingMultiCoinRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pending_multi_coin_rewards"`
+	// convert_enabled is true if the address has enabled the convert mode
+	ConvertEnabled bool `protobuf:"varint,3,opt,name=convert_enabled,json=convertEnabled,proto3" json:"convert_enabled,omitempty"`
 }
 
 func (m *QueryMultiCoinStatusResponse) Reset()         { *m = QueryMultiCoinStatusResponse{} }
@@ -303,498 +305,2396 @@ func (m *QueryMultiCoinStatusResponse) GetPendingMultiCoinRewards() github_com_c
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "kyve.multi_coin_rewards.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "kyve.multi_coin_rewards.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*QueryMultiCoinDistributionPolicyRequest)(nil), "kyve.multi_coin_rewards.v1beta1.QueryMultiCoinDistributionPolicyRequest")
-	proto.RegisterType((*QueryMultiCoinDistributionPolicyResponse)(nil), "kyve.multi_coin_rewards.v1beta1.QueryMultiCoinDistributionPolicyResponse")
-	proto.RegisterType((*QueryMultiCoinStatusRequest)(nil), "kyve.multi_coin_rewards.v1beta1.QueryMultiCoinStatusRequest")
-	proto.RegisterType((*QueryMultiCoinStatusResponse)(nil), "kyve.multi_coin_rewards.v1beta1.QueryMultiCoinStatusResponse")
+func (m *QueryMultiCoinStatusResponse) GetConvertEnabled() bool {
+	if m != nil {
+		return m.ConvertEnabled
+	}
+	return false
 }
 
-func init() {
-	proto.RegisterFile("kyve/multi_coin_rewards/v1beta1/query.proto", fileDescriptor_dad565ef32a36a32)
+// QueryPendingMultiCoinRewardsRequest ...
+type QueryPendingMultiCoinRewardsRequest struct {
+	// address ...
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-var fileDescriptor_dad565ef32a36a32 = []byte{
-	// 598 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xc1, 0x6b, 0x13, 0x4f,
-	0x14, 0xce, 0xf4, 0xd7, 0x5f, 0xaa, 0xd3, 0x83, 0x38, 0x16, 0x8c, 0x6b, 0xd9, 0x86, 0x45, 0x68,
-	0xac, 0xba, 0x43, 0x53, 0x25, 0x28, 0x11, 0x21, 0x5a, 0x50, 0x44, 0x69, 0x57, 0x10, 0x2c, 0x42,
-	0x98, 0xcd, 0x0e, 0xdb, 0x21, 0xc9, 0xce, 0x76, 0x67, 0xb6, 0x35, 0x88, 0x17, 0x0f, 0xde, 0x04,
-	0xc1, 0x3f, 0xc0, 0xab, 0x78, 0xf2, 0xcf, 0xc8, 0xcd, 0x82, 0x17, 0x4f, 0x2a, 0x89, 0xe0, 0xdf,
-	0x20, 0x5e, 0x64, 0x67, 0x27, 0x21, 0x31, 0x35, 0xdb, 0x8a, 0x97, 0x64, 0xe6, 0xcd, 0xfb, 0xde,
-	0xfb, 0xbe, 0x37, 0xdf, 0x2c, 0xbc, 0xd0, 0xec, 0xec, 0x52, 0xdc, 0x8e, 0x5b, 0x92, 0xd5, 0x1b,
-	0x9c, 0x05, 0xf5, 0x88, 0xee, 0x91, 0xc8, 0x13, 0x78, 0x77, 0xd5, 0xa5, 0x92, 0xac, 0xe2, 0x9d,
-	0x98, 0x46, 0x1d, 0x3b, 0x8c, 0xb8, 0xe4, 0x68, 0x29, 0x49, 0xb6, 0x27, 0x93, 0x6d, 0x9d, 0x6c,
-	0x9c, 0x24, 0x6d, 0x16, 0x70, 0xac, 0x7e, 0x53, 0x8c, 0x61, 0x36, 0xb8, 0x68, 0x73, 0x81, 0x5d,
-	0x22, 0xe8, 0xb0, 0x68, 0x02, 0xd6, 0xe7, 0x0b, 0x3e, 0xf7, 0xb9, 0x5a, 0xe2, 0x64, 0xa5, 0xa3,
-	0x8b, 0x3e, 0xe7, 0x7e, 0x8b, 0x62, 0x12, 0x32, 0x4c, 0x82, 0x80, 0x4b, 0x22, 0x19, 0x0f, 0x84,
-	0x3e, 0xbd, 0x98, 0x45, 0x3a, 0x24, 0x11, 0x69, 0x0f, 0xb2, 0x33, 0x25, 0xca, 0x4e, 0x48, 0x75,
-	0xb2, 0xb5, 0x00, 0xd1, 0x66, 0xa2, 0x78, 0x43, 0x55, 0x70, 0xe8, 0x4e, 0x4c, 0x85, 0xb4, 0x1e,
-	0xc3, 0x53, 0x63, 0x51, 0x11, 0xf2, 0x40, 0x50, 0xb4, 0x0e, 0xf3, 0x69, 0xa7, 0x02, 0x28, 0x82,
-	0xd2, 0x7c, 0x79, 0xd9, 0xce, 0x18, 0x90, 0x9d, 0x16, 0xa8, 0xcd, 0x76, 0x3f, 0x2f, 0xe5, 0x1c,
-	0x0d, 0xb6, 0xce, 0xc3, 0x65, 0x55, 0xfd, 0x5e, 0x82, 0xbb, 0xc9, 0x59, 0x70, 0x8b, 0x09, 0x19,
-	0x31, 0x37, 0x4e, 0x34, 0x6f, 0xf0, 0x16, 0x6b, 0x74, 0x06, 0x44, 0x5e, 0x00, 0x58, 0xca, 0xce,
-	0xd5, 0xf4, 0xb6, 0x60, 0x3e, 0x54, 0x11, 0x4d, 0xaf, 0x9a, 0x49, 0x6f, 0x4a, 0xd5, 0x21, 0x67,
-	0xb5, 0xb3, 0x2a, 0xf0, 0xec, 0x38, 0x8f, 0x07, 0x92, 0xc8, 0x78, 0x30, 0x30, 0x54, 0x80, 0x73,
-	0xc4, 0xf3, 0x22, 0x2a, 0xd2, 0xd1, 0x1c, 0x77, 0x06, 0x5b, 0xab, 0x0b, 0xe0, 0xe2, 0xc1, 0x48,
-	0xcd, 0xba, 0x00, 0xe7, 0x68, 0x40, 0xdc, 0x16, 0xf5, 0x14, 0xf4, 0x98, 0x33, 0xd8, 0xa2, 0x97,
-	0x00, 0x1a, 0x21, 0x0d, 0x3c, 0x16, 0xf8, 0xf5, 0x49, 0x11, 0x85, 0x99, 0xe2, 0x7f, 0xa5, 0xf9,
-	0xf2, 0x19, 0x3b, 0x35, 0x9c, 0x9d, 0x18, 0x6e, 0x28, 0x2c, 0xe9, 0x53, 0xbb, 0x92, 0x28, 0x78,
-	0xf7, 0x65, 0xa9, 0xe4, 0x33, 0xb9, 0x1d, 0xbb, 0x76, 0x83, 0xb7, 0xb1, 0x76, 0x67, 0xfa, 0x77,
-	0x49, 0x78, 0x4d, 0xed, 0x86, 0x04, 0x20, 0xde, 0x7e, 0x7f, 0xbf, 0x02, 0x9c, 0xd3, 0xba, 0xe7,
-	0x90, 0xb0, 0x93, 0x36, 0x2c, 0xff, 0x98, 0x85, 0xff, 0x2b, 0x29, 0xe8, 0x0d, 0x80, 0xf9, 0xf4,
-	0x6a, 0xd1, 0x5a, 0xe6, 0x90, 0x27, 0xfd, 0x65, 0x5c, 0x3e, 0x1a, 0x28, 0x9d, 0x94, 0xb5, 0xf2,
-	0xfc, 0xe3, 0xb7, 0xd7, 0x33, 0xe7, 0x90, 0x85, 0xff, 0xec, 0x70, 0xfd, 0x14, 0xd0, 0x4f, 0x00,
-	0x8b, 0x53, 0x6e, 0x37, 0x95, 0x71, 0xfb, 0x70, 0x34, 0xb2, 0x7d, 0x6a, 0xdc, 0xf9, 0x07, 0x95,
-	0xb4, 0xca, 0x9a, 0x52, 0x59, 0x45, 0xd7, 0xa6, 0xa9, 0x1c, 0x89, 0x7a, 0x23, 0xa5, 0xea, 0xa9,
-	0x5b, 0xd1, 0x07, 0x00, 0x4f, 0xfc, 0xe6, 0x37, 0x54, 0x3d, 0x22, 0xc5, 0x31, 0x83, 0x1b, 0xd7,
-	0xff, 0x12, 0xad, 0x45, 0xdd, 0x50, 0xa2, 0xae, 0xa2, 0xca, 0x21, 0x45, 0x09, 0x05, 0xc7, 0x4f,
-	0xf5, 0x2b, 0x7a, 0x56, 0xdb, 0xec, 0xf6, 0x4c, 0xb0, 0xdf, 0x33, 0xc1, 0xd7, 0x9e, 0x09, 0x5e,
-	0xf5, 0xcd, 0xdc, 0x7e, 0xdf, 0xcc, 0x7d, 0xea, 0x9b, 0xb9, 0xad, 0xca, 0x88, 0xbb, 0xef, 0x3e,
-	0x7a, 0xb8, 0x7e, 0x9f, 0xca, 0x3d, 0x1e, 0x35, 0x71, 0x63, 0x9b, 0xb0, 0x00, 0x3f, 0x39, 0xa8,
-	0x97, 0xb2, 0xbc, 0x9b, 0x57, 0x5f, 0xc0, 0xb5, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xda, 0xae,
-	0x11, 0xd3, 0x13, 0x06, 0x00, 0x00,
+func (m *QueryPendingMultiCoinRewardsRequest) Reset()         { *m = QueryPendingMultiCoinRewardsRequest{} }
+func (m *QueryPendingMultiCoinRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingMultiCoinRewardsRequest) ProtoMessage()    {}
+func (*QueryPendingMultiCoinRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dad565ef32a36a32, []int{6}
+}
+func (m *QueryPendingMultiCoinRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingMultiCoinRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingMultiCoinRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingMultiCoinRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingMultiCoinRewardsRequest.Merge(m, src)
+}
+func (m *QueryPendingMultiCoinRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingMultiCoinRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingMultiCoinRewardsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryPendingMultiCoinRewardsRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// policy, multi-coin-enabled, multi-coin-status/address: {enabled true false, pending rewards}
-	MultiCoinDistributionPolicyQuery(ctx context.Context, in *QueryMultiCoinDistributionPolicyRequest, opts ...grpc.CallOption) (*QueryMultiCoinDistributionPolicyResponse, error)
-	// MultiCoinStatus ...
-	MultiCoinStatus(ctx context.Context, in *QueryMultiCoinStatusRequest, opts ...grpc.CallOption) (*QueryMultiCoinStatusResponse, error)
+func (m *QueryPendingMultiCoinRewardsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryPendingMultiCoinRewardsResponse ...
+type QueryPendingMultiCoinRewardsResponse struct {
+	// entries ...
+	Entries []PendingMultiCoinRewardsEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryPendingMultiCoinRewardsResponse) Reset()         { *m = QueryPendingMultiCoinRewardsResponse{} }
+func (m *QueryPendingMultiCoinRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingMultiCoinRewardsResponse) ProtoMessage()    {}
+func (*QueryPendingMultiCoinRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dad565ef32a36a32, []int{7}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/kyve.multi_coin_rewards.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryPendingMultiCoinRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) MultiCoinDistributionPolicyQuery(ctx context.Context, in *QueryMultiCoinDistributionPolicyRequest, opts ...grpc.CallOption) (*QueryMultiCoinDistributionPolicyResponse, error) {
-	out := new(QueryMultiCoinDistributionPolicyResponse)
-	err := c.cc.Invoke(ctx, "/kyve.multi_coin_rewards.v1beta1.Query/MultiCoinDistributionPolicyQuery", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPendingMultiCoinRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingMultiCoinRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryPendingMultiCoinRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingMultiCoinRewardsResponse.Merge(m, src)
+}
+func (m *QueryPendingMultiCoinRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingMultiCoinRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingMultiCoinRewardsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) MultiCoinStatus(ctx context.Context, in *QueryMultiCoinStatusRequest, opts ...grpc.CallOption) (*QueryMultiCoinStatusResponse, error) {
-	out := new(QueryMultiCoinStatusResponse)
-	err := c.cc.Invoke(ctx, "/kyve.multi_coin_rewards.v1beta1.Query/MultiCoinStatus", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPendingMultiCoinRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingMultiCoinRewardsResponse) GetEntries() []PendingMultiCoinRewardsEntry {
+	if m != nil {
+		return m.Entries
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// policy, multi-coin-enabled, multi-coin-status/address: {enabled true false, pending rewards}
-	MultiCoinDistributionPolicyQuery(context.Context, *QueryMultiCoinDistributionPolicyRequest) (*QueryMultiCoinDistributionPolicyResponse, error)
-	// MultiCoinStatus ...
-	MultiCoinStatus(context.Context, *QueryMultiCoinStatusRequest) (*QueryMultiCoinStatusResponse, error)
+// PendingMultiCoinRewardsEntry ...
+type PendingMultiCoinRewardsEntry struct {
+	// index of the pending rewards entry, used to claim it
+	Index uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// rewards ...
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+	// creation_date is the UNIX-timestamp (in seconds) when the entry was created
+	CreationDate int64 `protobuf:"varint,3,opt,name=creation_date,json=creationDate,proto3" json:"creation_date,omitempty"`
+	// expiry_date is the UNIX-timestamp (in seconds) after which the rewards
+	// get redistributed to the pools if they were not claimed
+	ExpiryDate int64 `protobuf:"varint,4,opt,name=expiry_date,json=expiryDate,proto3" json:"expiry_date,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *PendingMultiCoinRewardsEntry) Reset()         { *m = PendingMultiCoinRewardsEntry{} }
+func (m *PendingMultiCoinRewardsEntry) String() string { return proto.CompactTextString(m) }
+func (*PendingMultiCoinRewardsEntry) ProtoMessage()    {}
+func (*PendingMultiCoinRewardsEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dad565ef32a36a32, []int{8}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *PendingMultiCoinRewardsEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) MultiCoinDistributionPolicyQuery(ctx context.Context, req *QueryMultiCoinDistributionPolicyRequest) (*QueryMultiCoinDistributionPolicyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MultiCoinDistributionPolicyQuery not implemented")
+func (m *PendingMultiCoinRewardsEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingMultiCoinRewardsEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) MultiCoinStatus(ctx context.Context, req *QueryMultiCoinStatusRequest) (*QueryMultiCoinStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MultiCoinStatus not implemented")
+func (m *PendingMultiCoinRewardsEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingMultiCoinRewardsEntry.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *PendingMultiCoinRewardsEntry) XXX_Size() int {
+	return m.Size()
 }
-
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.multi_coin_rewards.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *PendingMultiCoinRewardsEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingMultiCoinRewardsEntry.DiscardUnknown(m)
 }
 
-func _Query_MultiCoinDistributionPolicyQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMultiCoinDistributionPolicyRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).MultiCoinDistributionPolicyQuery(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.multi_coin_rewards.v1beta1.Query/MultiCoinDistributionPolicyQuery",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).MultiCoinDistributionPolicyQuery(ctx, req.(*QueryMultiCoinDistributionPolicyRequest))
+var xxx_messageInfo_PendingMultiCoinRewardsEntry proto.InternalMessageInfo
+
+func (m *PendingMultiCoinRewardsEntry) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_MultiCoinStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMultiCoinStatusRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).MultiCoinStatus(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.multi_coin_rewards.v1beta1.Query/MultiCoinStatus",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).MultiCoinStatus(ctx, req.(*QueryMultiCoinStatusRequest))
+func (m *PendingMultiCoinRewardsEntry) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "kyve.multi_coin_rewards.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "MultiCoinDistributionPolicyQuery",
-			Handler:    _Query_MultiCoinDistributionPolicyQuery_Handler,
-		},
-		{
-			MethodName: "MultiCoinStatus",
-			Handler:    _Query_MultiCoinStatus_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "kyve/multi_coin_rewards/v1beta1/query.proto",
+func (m *PendingMultiCoinRewardsEntry) GetCreationDate() int64 {
+	if m != nil {
+		return m.CreationDate
+	}
+	return 0
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PendingMultiCoinRewardsEntry) GetExpiryDate() int64 {
+	if m != nil {
+		return m.ExpiryDate
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryMultiCoinDistributionPolicyVersionsRequest ...
+type QueryMultiCoinDistributionPolicyVersionsRequest struct {
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryMultiCoinDistributionPolicyVersionsRequest) Reset() {
+	*m = QueryMultiCoinDistributionPolicyVersionsRequest{}
 }
-
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryMultiCoinDistributionPolicyVersionsRequest) String() string {
+	return proto.CompactTextString(m)
 }
-
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*QueryMultiCoinDistributionPolicyVersionsRequest) ProtoMessage() {}
+func (*QueryMultiCoinDistributionPolicyVersionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dad565ef32a36a32, []int{9}
 }
-
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryMultiCoinDistributionPolicyVersionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMultiCoinDistributionPolicyVersionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMultiCoinDistributionPolicyVersionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryMultiCoinDistributionPolicyVersionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMultiCoinDistributionPolicyVersionsRequest.Merge(m, src)
+}
+func (m *QueryMultiCoinDistributionPolicyVersionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMultiCoinDistributionPolicyVersionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMultiCoinDistributionPolicyVersionsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryMultiCoinDistributionPolicyRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryMultiCoinDistributionPolicyVersionsRequest proto.InternalMessageInfo
+
+// QueryMultiCoinDistributionPolicyVersionsResponse ...
+type QueryMultiCoinDistributionPolicyVersionsResponse struct {
+	// versions are all versions of the distribution policy ordered by their version
+	Versions []MultiCoinDistributionPolicyVersion `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions"`
+	// active_version is the version of the currently active policy
+	ActiveVersion uint64 `protobuf:"varint,2,opt,name=active_version,json=activeVersion,proto3" json:"active_version,omitempty"`
+	// has_active_version is false if no versioned policy has been activated yet
+	HasActiveVersion bool `protobuf:"varint,3,opt,name=has_active_version,json=hasActiveVersion,proto3" json:"has_active_version,omitempty"`
+}
+
+func (m *QueryMultiCoinDistributionPolicyVersionsResponse) Reset() {
+	*m = QueryMultiCoinDistributionPolicyVersionsResponse{}
+}
+func (m *QueryMultiCoinDistributionPolicyVersionsResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryMultiCoinDistributionPolicyVersionsResponse) ProtoMessage() {}
+func (*QueryMultiCoinDistributionPolicyVersionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dad565ef32a36a32, []int{10}
+}
+func (m *QueryMultiCoinDistributionPolicyVersionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMultiCoinDistributionPolicyVersionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMultiCoinDistributionPolicyVersionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryMultiCoinDistributionPolicyVersionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMultiCoinDistributionPolicyVersionsResponse.Merge(m, src)
+}
+func (m *QueryMultiCoinDistributionPolicyVersionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMultiCoinDistributionPolicyVersionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMultiCoinDistributionPolicyVersionsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryMultiCoinDistributionPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryMultiCoinDistributionPolicyVersionsResponse proto.InternalMessageInfo
+
+func (m *QueryMultiCoinDistributionPolicyVersionsResponse) GetVersions() []MultiCoinDistributionPolicyVersion {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
 }
 
-func (m *QueryMultiCoinDistributionPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryMultiCoinDistributionPolicyVersionsResponse) GetActiveVersion() uint64 {
+	if m != nil {
+		return m.ActiveVersion
+	}
+	return 0
 }
 
-func (m *QueryMultiCoinDistributionPolicyResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryMultiCoinDistributionPolicyVersionsResponse) GetHasActiveVersion() bool {
+	if m != nil {
+		return m.HasActiveVersion
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryMultiCoinDistributionPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryMultiCoinDistributionPreviewRequest ...
+type QueryMultiCoinDistributionPreviewRequest struct {
+	// policy is the distribution policy which should be previewed.
+	// If not set, the active distribution policy is used.
+	Policy *MultiCoinDistributionPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
 }
 
-func (m *QueryMultiCoinDistributionPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryMultiCoinDistributionPreviewRequest) Reset() {
+	*m = QueryMultiCoinDistributionPreviewRequest{}
+}
+func (m *QueryMultiCoinDistributionPreviewRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMultiCoinDistributionPreviewRequest) ProtoMessage()    {}
+func (*QueryMultiCoinDistributionPreviewRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dad565ef32a36a32, []int{11}
+}
+func (m *QueryMultiCoinDistributionPreviewRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMultiCoinDistributionPreviewRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMultiCoinDistributionPreviewRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryMultiCoinDistributionPreviewRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMultiCoinDistributionPreviewRequest.Merge(m, src)
+}
+func (m *QueryMultiCoinDistributionPreviewRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMultiCoinDistributionPreviewRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMultiCoinDistributionPreviewRequest.DiscardUnknown(m)
 }
 
-func (m *QueryMultiCoinStatusRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryMultiCoinDistributionPreviewRequest proto.InternalMessageInfo
+
+func (m *QueryMultiCoinDistributionPreviewRequest) GetPolicy() *MultiCoinDistributionPolicy {
+	if m != nil {
+		return m.Policy
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryMultiCoinStatusRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryMultiCoinDistributionPreviewResponse ...
+type QueryMultiCoinDistributionPreviewResponse struct {
+	// pool_rewards are the coins every pool account would receive
+	PoolRewards []MultiCoinDistributionPoolRewards `protobuf:"bytes,1,rep,name=pool_rewards,json=poolRewards,proto3" json:"pool_rewards"`
+	// skipped_pool_ids are the pools of the policy which do not exist or are disabled
+	SkippedPoolIds []uint64 `protobuf:"varint,2,rep,packed,name=skipped_pool_ids,json=skippedPoolIds,proto3" json:"skipped_pool_ids,omitempty"`
+	// remaining are the coins which would stay in the redistribution account
+	Remaining github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=remaining,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"remaining"`
 }
 
-func (m *QueryMultiCoinStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryMultiCoinDistributionPreviewResponse) Reset() {
+	*m = QueryMultiCoinDistributionPreviewResponse{}
+}
+func (m *QueryMultiCoinDistributionPreviewResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryMultiCoinDistributionPreviewResponse) ProtoMessage() {}
+func (*QueryMultiCoinDistributionPreviewResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dad565ef32a36a32, []int{12}
+}
+func (m *QueryMultiCoinDistributionPreviewResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMultiCoinDistributionPreviewResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMultiCoinDistributionPreviewResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryMultiCoinDistributionPreviewResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMultiCoinDistributionPreviewResponse.Merge(m, src)
+}
+func (m *QueryMultiCoinDistributionPreviewResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMultiCoinDistributionPreviewResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMultiCoinDistributionPreviewResponse.DiscardUnknown(m)
 }
 
-func (m *QueryMultiCoinStatusResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryMultiCoinDistributionPreviewResponse proto.InternalMessageInfo
+
+func (m *QueryMultiCoinDistributionPreviewResponse) GetPoolRewards() []MultiCoinDistributionPoolRewards {
+	if m != nil {
+		return m.PoolRewards
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryMultiCoinStatusResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryMultiCoinDistributionPreviewResponse) GetSkippedPoolIds() []uint64 {
+	if m != nil {
+		return m.SkippedPoolIds
+	}
+	return nil
 }
 
-func (m *QueryMultiCoinStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PendingMultiCoinRewards) > 0 {
-		for iNdEx := len(m.PendingMultiCoinRewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PendingMultiCoinRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+func (m *QueryMultiCoinDistributionPreviewResponse) GetRemaining() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Remaining
 	}
-	if m.Enabled {
-		i--
-		if m.Enabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return nil
+}
+
+// MultiCoinDistributionPoolRewards ...
+type MultiCoinDistributionPoolRewards struct {
+	// pool_id ...
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// pool_account is the address which receives the rewards
+	PoolAccount string `protobuf:"bytes,2,opt,name=pool_account,json=poolAccount,proto3" json:"pool_account,omitempty"`
+	// rewards ...
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+}
+
+func (m *MultiCoinDistributionPoolRewards) Reset()         { *m = MultiCoinDistributionPoolRewards{} }
+func (m *MultiCoinDistributionPoolRewards) String() string { return proto.CompactTextString(m) }
+func (*MultiCoinDistributionPoolRewards) ProtoMessage()    {}
+func (*MultiCoinDistributionPoolRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dad565ef32a36a32, []int{13}
+}
+func (m *MultiCoinDistributionPoolRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiCoinDistributionPoolRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultiCoinDistributionPoolRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MultiCoinDistributionPoolRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiCoinDistributionPoolRewards.Merge(m, src)
+}
+func (m *MultiCoinDistributionPoolRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiCoinDistributionPoolRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiCoinDistributionPoolRewards.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_MultiCoinDistributionPoolRewards proto.InternalMessageInfo
+
+func (m *MultiCoinDistributionPoolRewards) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MultiCoinDistributionPoolRewards) GetPoolAccount() string {
+	if m != nil {
+		return m.PoolAccount
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MultiCoinDistributionPoolRewards) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryMultiCoinDistributionPolicyRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "kyve.multi_coin_rewards.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "kyve.multi_coin_rewards.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryMultiCoinDistributionPolicyRequest)(nil), "kyve.multi_coin_rewards.v1beta1.QueryMultiCoinDistributionPolicyRequest")
+	proto.RegisterType((*QueryMultiCoinDistributionPolicyResponse)(nil), "kyve.multi_coin_rewards.v1beta1.QueryMultiCoinDistributionPolicyResponse")
+	proto.RegisterType((*QueryMultiCoinStatusRequest)(nil), "kyve.multi_coin_rewards.v1beta1.QueryMultiCoinStatusRequest")
+	proto.RegisterType((*QueryMultiCoinStatusResponse)(nil), "kyve.multi_coin_rewards.v1beta1.QueryMultiCoinStatusResponse")
+	proto.RegisterType((*QueryPendingMultiCoinRewardsRequest)(nil), "kyve.multi_coin_rewards.v1beta1.QueryPendingMultiCoinRewardsRequest")
+	proto.RegisterType((*QueryPendingMultiCoinRewardsResponse)(nil), "kyve.multi_coin_rewards.v1beta1.QueryPendingMultiCoinRewardsResponse")
+	proto.RegisterType((*PendingMultiCoinRewardsEntry)(nil), "kyve.multi_coin_rewards.v1beta1.PendingMultiCoinRewardsEntry")
+	proto.RegisterType((*QueryMultiCoinDistributionPolicyVersionsRequest)(nil), "kyve.multi_coin_rewards.v1beta1.QueryMultiCoinDistributionPolicyVersionsRequest")
+	proto.RegisterType((*QueryMultiCoinDistributionPolicyVersionsResponse)(nil), "kyve.multi_coin_rewards.v1beta1.QueryMultiCoinDistributionPolicyVersionsResponse")
+	proto.RegisterType((*QueryMultiCoinDistributionPreviewRequest)(nil), "kyve.multi_coin_rewards.v1beta1.QueryMultiCoinDistributionPreviewRequest")
+	proto.RegisterType((*QueryMultiCoinDistributionPreviewResponse)(nil), "kyve.multi_coin_rewards.v1beta1.QueryMultiCoinDistributionPreviewResponse")
+	proto.RegisterType((*MultiCoinDistributionPoolRewards)(nil), "kyve.multi_coin_rewards.v1beta1.MultiCoinDistributionPoolRewards")
 }
 
-func (m *QueryMultiCoinDistributionPolicyResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Policy.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func init() {
+	proto.RegisterFile("kyve/multi_coin_rewards/v1beta1/query.proto", fileDescriptor_dad565ef32a36a32)
 }
 
-func (m *QueryMultiCoinStatusRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+var fileDescriptor_dad565ef32a36a32 = []byte{
+	// 1039 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xda, 0xa9, 0xd3, 0x3c, 0xb7, 0x69, 0xbf, 0xf3, 0x8d, 0x14, 0x63, 0x22, 0x27, 0x6c,
+	0x8a, 0xe2, 0x96, 0xe2, 0x25, 0x29, 0x28, 0x02, 0x12, 0x95, 0x38, 0xb1, 0xd4, 0x52, 0x81, 0x12,
+	0x83, 0x2a, 0x51, 0x81, 0x56, 0xe3, 0xdd, 0x91, 0x33, 0x8d, 0xbd, 0xb3, 0xdd, 0x1d, 0x3b, 0xb1,
+	0x10, 0x12, 0x02, 0xc1, 0x0d, 0x09, 0x89, 0x03, 0x47, 0xae, 0x88, 0x13, 0xfc, 0x17, 0x15, 0x17,
+	0x2a, 0x71, 0xe1, 0x04, 0x28, 0x41, 0x70, 0x43, 0x70, 0x85, 0x0b, 0xda, 0x99, 0xb7, 0xae, 0xf3,
+	0xcb, 0xeb, 0xe6, 0xc7, 0x25, 0xf1, 0x3c, 0xbf, 0xf7, 0x99, 0xf7, 0x79, 0xf3, 0x79, 0xf3, 0xc6,
+	0xf0, 0xdc, 0x66, 0xa7, 0xcd, 0xac, 0x66, 0xab, 0x21, 0xb9, 0xed, 0x08, 0xee, 0xd9, 0x01, 0xdb,
+	0xa2, 0x81, 0x1b, 0x5a, 0xed, 0xb9, 0x1a, 0x93, 0x74, 0xce, 0x7a, 0xd0, 0x62, 0x41, 0xa7, 0xe4,
+	0x07, 0x42, 0x0a, 0x32, 0x15, 0x39, 0x97, 0x0e, 0x3a, 0x97, 0xd0, 0x39, 0xff, 0x3f, 0xda, 0xe4,
+	0x9e, 0xb0, 0xd4, 0x5f, 0x1d, 0x93, 0x2f, 0x38, 0x22, 0x6c, 0x8a, 0xd0, 0xaa, 0xd1, 0x90, 0x75,
+	0x41, 0xa3, 0x60, 0xfc, 0x7e, 0xbc, 0x2e, 0xea, 0x42, 0x7d, 0xb4, 0xa2, 0x4f, 0x68, 0x9d, 0xac,
+	0x0b, 0x51, 0x6f, 0x30, 0x8b, 0xfa, 0xdc, 0xa2, 0x9e, 0x27, 0x24, 0x95, 0x5c, 0x78, 0x21, 0x7e,
+	0x7b, 0x3d, 0x29, 0x69, 0x9f, 0x06, 0xb4, 0x19, 0x7b, 0x27, 0x52, 0x94, 0x1d, 0x9f, 0xa1, 0xb3,
+	0x39, 0x0e, 0x64, 0x3d, 0x62, 0xbc, 0xa6, 0x10, 0xaa, 0xec, 0x41, 0x8b, 0x85, 0xd2, 0x7c, 0x17,
+	0xfe, 0xbf, 0xc7, 0x1a, 0xfa, 0xc2, 0x0b, 0x19, 0xa9, 0x40, 0x46, 0xef, 0x94, 0x33, 0xa6, 0x8d,
+	0x62, 0x76, 0x7e, 0xb6, 0x94, 0x50, 0xa0, 0x92, 0x06, 0x28, 0x0f, 0x3f, 0xfc, 0x79, 0x6a, 0xa8,
+	0x8a, 0xc1, 0xe6, 0x55, 0x98, 0x55, 0xe8, 0x6f, 0x44, 0x71, 0x2b, 0x82, 0x7b, 0xab, 0x3c, 0x94,
+	0x01, 0xaf, 0xb5, 0x22, 0xce, 0x6b, 0xa2, 0xc1, 0x9d, 0x4e, 0x9c, 0xc8, 0xa7, 0x06, 0x14, 0x93,
+	0x7d, 0x31, 0xbd, 0x7b, 0x90, 0xf1, 0x95, 0x05, 0xd3, 0x5b, 0x4c, 0x4c, 0xaf, 0x0f, 0x6a, 0x37,
+	0x67, 0xb5, 0x32, 0x17, 0xe0, 0xe9, 0xbd, 0x79, 0xbc, 0x25, 0xa9, 0x6c, 0xc5, 0x05, 0x23, 0x39,
+	0x18, 0xa1, 0xae, 0x1b, 0xb0, 0x50, 0x97, 0x66, 0xb4, 0x1a, 0x2f, 0xcd, 0xbf, 0x0d, 0x98, 0x3c,
+	0x3c, 0x12, 0xb3, 0xce, 0xc1, 0x08, 0xf3, 0x68, 0xad, 0xc1, 0x5c, 0x15, 0x7a, 0xbe, 0x1a, 0x2f,
+	0xc9, 0x67, 0x06, 0xe4, 0x7d, 0xe6, 0xb9, 0xdc, 0xab, 0xdb, 0x07, 0x49, 0xe4, 0x52, 0xd3, 0xe9,
+	0x62, 0x76, 0xfe, 0xa9, 0x92, 0x16, 0x5c, 0x29, 0x12, 0x5c, 0x97, 0x58, 0xb4, 0x4f, 0xf9, 0xa5,
+	0x88, 0xc1, 0x37, 0xbf, 0x4c, 0x15, 0xeb, 0x5c, 0x6e, 0xb4, 0x6a, 0x25, 0x47, 0x34, 0x2d, 0x54,
+	0xa7, 0xfe, 0xf7, 0x7c, 0xe8, 0x6e, 0xa2, 0x1a, 0xa2, 0x80, 0xf0, 0xeb, 0x3f, 0xbe, 0xbd, 0x66,
+	0x54, 0x27, 0x70, 0xcf, 0x6e, 0xc2, 0x55, 0xbd, 0x21, 0x99, 0x85, 0x4b, 0x8e, 0xf0, 0xda, 0x2c,
+	0x90, 0x76, 0x9c, 0x71, 0x5a, 0x65, 0x3c, 0x86, 0xe6, 0x8a, 0xb6, 0x9a, 0x37, 0x61, 0x46, 0xcb,
+	0xe7, 0x70, 0xa0, 0xe4, 0xa2, 0x7d, 0x62, 0xc0, 0x95, 0xfe, 0x08, 0x58, 0xbc, 0xf7, 0xa2, 0xe2,
+	0xc9, 0x80, 0xb3, 0x08, 0x22, 0x2a, 0xc7, 0x52, 0xb2, 0x24, 0x0f, 0x87, 0xac, 0x78, 0x32, 0x88,
+	0x0f, 0x3d, 0xc6, 0x34, 0x7f, 0x37, 0x60, 0xb2, 0x9f, 0x3f, 0x19, 0x87, 0x73, 0xdc, 0x73, 0xd9,
+	0xb6, 0x22, 0x30, 0x5c, 0xd5, 0x0b, 0x72, 0x1f, 0x46, 0xce, 0xfa, 0x90, 0xe2, 0x0d, 0xc8, 0x0c,
+	0x5c, 0x74, 0x02, 0xa6, 0xae, 0x0b, 0xdb, 0xa5, 0x92, 0xa9, 0x23, 0x49, 0x57, 0x2f, 0xc4, 0xc6,
+	0x55, 0x2a, 0x19, 0x99, 0x82, 0x2c, 0xdb, 0xf6, 0x79, 0xd0, 0xd1, 0x2e, 0xc3, 0xca, 0x05, 0xb4,
+	0x29, 0x72, 0x30, 0xe7, 0xc0, 0x4a, 0x6a, 0xb3, 0xbb, 0x2c, 0x08, 0xa3, 0x3b, 0x29, 0x6e, 0xcd,
+	0x3f, 0x0d, 0x78, 0x61, 0xf0, 0x18, 0x3c, 0x2f, 0x06, 0xe7, 0xdb, 0x68, 0xc3, 0x03, 0x5b, 0x39,
+	0x49, 0x93, 0x22, 0x3e, 0x1e, 0x5b, 0x17, 0x9a, 0x3c, 0x0b, 0x63, 0xd4, 0x91, 0xbc, 0xcd, 0x6c,
+	0x34, 0xe5, 0x52, 0xea, 0x7c, 0x2e, 0x6a, 0x2b, 0x86, 0x91, 0xeb, 0x40, 0x36, 0x68, 0x68, 0xef,
+	0x73, 0xd5, 0x9a, 0xbe, 0xbc, 0x41, 0xc3, 0xe5, 0x5e, 0x6f, 0xf3, 0xc3, 0xfe, 0x77, 0x51, 0xc0,
+	0xda, 0x9c, 0x6d, 0xc5, 0xda, 0x7e, 0xfb, 0x34, 0xef, 0xa2, 0xee, 0x2d, 0xf4, 0x5d, 0x0a, 0xae,
+	0x0e, 0x90, 0x02, 0x16, 0xfb, 0x3e, 0x5c, 0xf0, 0x85, 0x68, 0x74, 0x2f, 0x0c, 0x5d, 0xf0, 0xe5,
+	0xe3, 0x66, 0x22, 0x1a, 0x28, 0x7d, 0x2c, 0x77, 0xd6, 0x7f, 0x6c, 0x22, 0x45, 0xb8, 0x1c, 0x6e,
+	0x72, 0xdf, 0x67, 0xae, 0xad, 0xf6, 0xe4, 0xa8, 0xfd, 0xe1, 0xea, 0x18, 0xda, 0x23, 0x80, 0xdb,
+	0x6e, 0x48, 0x3c, 0x18, 0x0d, 0x58, 0x93, 0x72, 0x8f, 0x7b, 0xf5, 0x5c, 0xfa, 0x8c, 0xda, 0xe3,
+	0xf1, 0x16, 0xe6, 0xf7, 0x06, 0x4c, 0x27, 0x31, 0x22, 0x13, 0x30, 0x82, 0x69, 0x63, 0x27, 0x67,
+	0x7c, 0x95, 0x2e, 0x79, 0x06, 0x6b, 0x48, 0x1d, 0x47, 0xb4, 0x3c, 0xa9, 0x74, 0x34, 0xaa, 0xa9,
+	0x2f, 0x6b, 0x53, 0x6f, 0xb7, 0xa7, 0xcf, 0xb8, 0xdb, 0xe7, 0x3f, 0xce, 0xc2, 0x39, 0x25, 0x00,
+	0xf2, 0x95, 0x01, 0x19, 0x3d, 0x5d, 0xc9, 0x8d, 0xc4, 0x13, 0x3d, 0x38, 0xe2, 0xf3, 0x2f, 0x3e,
+	0x59, 0x90, 0x96, 0x94, 0x79, 0xed, 0xa3, 0x1f, 0x7f, 0xfb, 0x22, 0x75, 0x85, 0x98, 0xd6, 0xd1,
+	0x8f, 0x0c, 0x7c, 0x8d, 0x90, 0x7f, 0x8f, 0x2e, 0x7c, 0x24, 0x66, 0x4d, 0xe3, 0xd6, 0x60, 0x69,
+	0x24, 0x3f, 0x15, 0xf2, 0xb7, 0x4f, 0x01, 0x09, 0x59, 0x96, 0x15, 0xcb, 0x45, 0xf2, 0x4a, 0x3f,
+	0x96, 0x3d, 0x56, 0xb7, 0x07, 0xca, 0xd6, 0xad, 0x4a, 0x7e, 0x30, 0xe0, 0xd2, 0xbe, 0x91, 0x4f,
+	0x16, 0x9f, 0x30, 0xc5, 0x3d, 0x6f, 0x8c, 0xfc, 0xd2, 0x31, 0xa3, 0x91, 0xd4, 0x4d, 0x45, 0xea,
+	0x65, 0xb2, 0x30, 0x20, 0xa9, 0x50, 0x85, 0x5b, 0xef, 0xe3, 0x4c, 0xfe, 0x80, 0xfc, 0x65, 0xc0,
+	0xc4, 0x11, 0xc3, 0x90, 0xac, 0x0e, 0xa8, 0xa6, 0xbe, 0x0f, 0x82, 0x7c, 0xe5, 0x84, 0x28, 0xc8,
+	0xf4, 0x96, 0x62, 0x5a, 0x26, 0xaf, 0xf5, 0x15, 0xe9, 0x91, 0x0f, 0xab, 0x1e, 0xca, 0x5f, 0xa6,
+	0x60, 0x66, 0x80, 0xf1, 0x46, 0xd6, 0x4e, 0xac, 0xbd, 0x7d, 0xd3, 0x35, 0xbf, 0x7e, 0x8a, 0x88,
+	0x58, 0x96, 0x3b, 0xaa, 0x2c, 0x15, 0xb2, 0x72, 0x7c, 0x55, 0xdb, 0xdd, 0x09, 0xfb, 0x8f, 0x01,
+	0x93, 0xfd, 0x86, 0x10, 0x39, 0x51, 0x3b, 0xee, 0x99, 0xa5, 0xf9, 0xd7, 0x4f, 0x03, 0x0a, 0x8b,
+	0xb0, 0xa2, 0x8a, 0xb0, 0x44, 0x5e, 0x3d, 0x56, 0x11, 0x34, 0x58, 0x79, 0xfd, 0xe1, 0x4e, 0xc1,
+	0x78, 0xb4, 0x53, 0x30, 0x7e, 0xdd, 0x29, 0x18, 0x9f, 0xef, 0x16, 0x86, 0x1e, 0xed, 0x16, 0x86,
+	0x7e, 0xda, 0x2d, 0x0c, 0xdd, 0x5b, 0xe8, 0xb9, 0xd7, 0xef, 0xbc, 0x73, 0xb7, 0xf2, 0x26, 0x93,
+	0x5b, 0x22, 0xd8, 0xb4, 0x9c, 0x0d, 0xca, 0x3d, 0x6b, 0xfb, 0xb0, 0xfd, 0xd4, 0x65, 0x5f, 0xcb,
+	0xa8, 0x9f, 0x63, 0x37, 0xfe, 0x1b, 0x00, 0xd9, 0xc6, 0x33, 0x14, 0xa0, 0x0e, 0x00, 0x00,
 }
 
-func (m *QueryMultiCoinStatusResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// policy, multi-coin-enabled, multi-coin-status/address: {enabled true false, pending rewards}
+	MultiCoinDistributionPolicyQuery(ctx context.Context, in *QueryMultiCoinDistributionPolicyRequest, opts ...grpc.CallOption) (*QueryMultiCoinDistributionPolicyResponse, error)
+	// MultiCoinStatus ...
+	MultiCoinStatus(ctx context.Context, in *QueryMultiCoinStatusRequest, opts ...grpc.CallOption) (*QueryMultiCoinStatusResponse, error)
+	// PendingMultiCoinRewards lists all pending multi-coin rewards entries of an address together with their expiry time.
+	PendingMultiCoinRewards(ctx context.Context, in *QueryPendingMultiCoinRewardsRequest, opts ...grpc.CallOption) (*QueryPendingMultiCoinRewardsResponse, error)
+	// MultiCoinDistributionPolicyVersions lists all active, past and scheduled versions of the distribution policy.
+	MultiCoinDistributionPolicyVersions(ctx context.Context, in *QueryMultiCoinDistributionPolicyVersionsRequest, opts ...grpc.CallOption) (*QueryMultiCoinDistributionPolicyVersionsResponse, error)
+	// MultiCoinDistributionPreview returns which pool accounts would receive which coins if the current
+	// redistribution balance was redistributed with the given policy, or the active policy if none is given.
+	MultiCoinDistributionPreview(ctx context.Context, in *QueryMultiCoinDistributionPreviewRequest, opts ...grpc.CallOption) (*QueryMultiCoinDistributionPreviewResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/kyve.multi_coin_rewards.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MultiCoinDistributionPolicyQuery(ctx context.Context, in *QueryMultiCoinDistributionPolicyRequest, opts ...grpc.CallOption) (*QueryMultiCoinDistributionPolicyResponse, error) {
+	out := new(QueryMultiCoinDistributionPolicyResponse)
+	err := c.cc.Invoke(ctx, "/kyve.multi_coin_rewards.v1beta1.Query/MultiCoinDistributionPolicyQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MultiCoinStatus(ctx context.Context, in *QueryMultiCoinStatusRequest, opts ...grpc.CallOption) (*QueryMultiCoinStatusResponse, error) {
+	out := new(QueryMultiCoinStatusResponse)
+	err := c.cc.Invoke(ctx, "/kyve.multi_coin_rewards.v1beta1.Query/MultiCoinStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingMultiCoinRewards(ctx context.Context, in *QueryPendingMultiCoinRewardsRequest, opts ...grpc.CallOption) (*QueryPendingMultiCoinRewardsResponse, error) {
+	out := new(QueryPendingMultiCoinRewardsResponse)
+	err := c.cc.Invoke(ctx, "/kyve.multi_coin_rewards.v1beta1.Query/PendingMultiCoinRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MultiCoinDistributionPolicyVersions(ctx context.Context, in *QueryMultiCoinDistributionPolicyVersionsRequest, opts ...grpc.CallOption) (*QueryMultiCoinDistributionPolicyVersionsResponse, error) {
+	out := new(QueryMultiCoinDistributionPolicyVersionsResponse)
+	err := c.cc.Invoke(ctx, "/kyve.multi_coin_rewards.v1beta1.Query/MultiCoinDistributionPolicyVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MultiCoinDistributionPreview(ctx context.Context, in *QueryMultiCoinDistributionPreviewRequest, opts ...grpc.CallOption) (*QueryMultiCoinDistributionPreviewResponse, error) {
+	out := new(QueryMultiCoinDistributionPreviewResponse)
+	err := c.cc.Invoke(ctx, "/kyve.multi_coin_rewards.v1beta1.Query/MultiCoinDistributionPreview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// policy, multi-coin-enabled, multi-coin-status/address: {enabled true false, pending rewards}
+	MultiCoinDistributionPolicyQuery(context.Context, *QueryMultiCoinDistributionPolicyRequest) (*QueryMultiCoinDistributionPolicyResponse, error)
+	// MultiCoinStatus ...
+	MultiCoinStatus(context.Context, *QueryMultiCoinStatusRequest) (*QueryMultiCoinStatusResponse, error)
+	// PendingMultiCoinRewards lists all pending multi-coin rewards entries of an address together with their expiry time.
+	PendingMultiCoinRewards(context.Context, *QueryPendingMultiCoinRewardsRequest) (*QueryPendingMultiCoinRewardsResponse, error)
+	// MultiCoinDistributionPolicyVersions lists all active, past and scheduled versions of the distribution policy.
+	MultiCoinDistributionPolicyVersions(context.Context, *QueryMultiCoinDistributionPolicyVersionsRequest) (*QueryMultiCoinDistributionPolicyVersionsResponse, error)
+	// MultiCoinDistributionPreview returns which pool accounts would receive which coins if the current
+	// redistribution balance was redistributed with the given policy, or the active policy if none is given.
+	MultiCoinDistributionPreview(context.Context, *QueryMultiCoinDistributionPreviewRequest) (*QueryMultiCoinDistributionPreviewResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) MultiCoinDistributionPolicyQuery(ctx context.Context, req *QueryMultiCoinDistributionPolicyRequest) (*QueryMultiCoinDistributionPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiCoinDistributionPolicyQuery not implemented")
+}
+func (*UnimplementedQueryServer) MultiCoinStatus(ctx context.Context, req *QueryMultiCoinStatusRequest) (*QueryMultiCoinStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiCoinStatus not implemented")
+}
+func (*UnimplementedQueryServer) PendingMultiCoinRewards(ctx context.Context, req *QueryPendingMultiCoinRewardsRequest) (*QueryPendingMultiCoinRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingMultiCoinRewards not implemented")
+}
+func (*UnimplementedQueryServer) MultiCoinDistributionPolicyVersions(ctx context.Context, req *QueryMultiCoinDistributionPolicyVersionsRequest) (*QueryMultiCoinDistributionPolicyVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiCoinDistributionPolicyVersions not implemented")
+}
+func (*UnimplementedQueryServer) MultiCoinDistributionPreview(ctx context.Context, req *QueryMultiCoinDistributionPreviewRequest) (*QueryMultiCoinDistributionPreviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiCoinDistributionPreview not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.multi_coin_rewards.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MultiCoinDistributionPolicyQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMultiCoinDistributionPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MultiCoinDistributionPolicyQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.multi_coin_rewards.v1beta1.Query/MultiCoinDistributionPolicyQuery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MultiCoinDistributionPolicyQuery(ctx, req.(*QueryMultiCoinDistributionPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MultiCoinStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMultiCoinStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MultiCoinStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.multi_coin_rewards.v1beta1.Query/MultiCoinStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MultiCoinStatus(ctx, req.(*QueryMultiCoinStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingMultiCoinRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingMultiCoinRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingMultiCoinRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.multi_coin_rewards.v1beta1.Query/PendingMultiCoinRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingMultiCoinRewards(ctx, req.(*QueryPendingMultiCoinRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MultiCoinDistributionPolicyVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMultiCoinDistributionPolicyVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MultiCoinDistributionPolicyVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.multi_coin_rewards.v1beta1.Query/MultiCoinDistributionPolicyVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MultiCoinDistributionPolicyVersions(ctx, req.(*QueryMultiCoinDistributionPolicyVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MultiCoinDistributionPreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMultiCoinDistributionPreviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MultiCoinDistributionPreview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.multi_coin_rewards.v1beta1.Query/MultiCoinDistributionPreview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MultiCoinDistributionPreview(ctx, req.(*QueryMultiCoinDistributionPreviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kyve.multi_coin_rewards.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "MultiCoinDistributionPolicyQuery",
+			Handler:    _Query_MultiCoinDistributionPolicyQuery_Handler,
+		},
+		{
+			MethodName: "MultiCoinStatus",
+			Handler:    _Query_MultiCoinStatus_Handler,
+		},
+		{
+			MethodName: "PendingMultiCoinRewards",
+			Handler:    _Query_PendingMultiCoinRewards_Handler,
+		},
+		{
+			MethodName: "MultiCoinDistributionPolicyVersions",
+			Handler:    _Query_MultiCoinDistributionPolicyVersions_Handler,
+		},
+		{
+			MethodName: "MultiCoinDistributionPreview",
+			Handler:    _Query_MultiCoinDistributionPreview_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "kyve/multi_coin_rewards/v1beta1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
 	_ = l
-	if m.Enabled {
-		n += 2
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.PendingMultiCoinRewards) > 0 {
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMultiCoinDistributionPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMultiCoinDistributionPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMultiCoinDistributionPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMultiCoinDistributionPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMultiCoinDistributionPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMultiCoinDistributionPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMultiCoinStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMultiCoinStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMultiCoinStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMultiCoinStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMultiCoinStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMultiCoinStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConvertEnabled {
+		i--
+		if m.ConvertEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PendingMultiCoinRewards) > 0 {
+		for iNdEx := len(m.PendingMultiCoinRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingMultiCoinRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingMultiCoinRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingMultiCoinRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingMultiCoinRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingMultiCoinRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingMultiCoinRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingMultiCoinRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingMultiCoinRewardsEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingMultiCoinRewardsEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingMultiCoinRewardsEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryDate != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExpiryDate))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CreationDate != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CreationDate))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Index != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMultiCoinDistributionPolicyVersionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMultiCoinDistributionPolicyVersionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMultiCoinDistributionPolicyVersionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMultiCoinDistributionPolicyVersionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMultiCoinDistributionPolicyVersionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMultiCoinDistributionPolicyVersionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HasActiveVersion {
+		i--
+		if m.HasActiveVersion {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ActiveVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ActiveVersion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Versions) > 0 {
+		for iNdEx := len(m.Versions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Versions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMultiCoinDistributionPreviewRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMultiCoinDistributionPreviewRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMultiCoinDistributionPreviewRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Policy != nil {
+		{
+			size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMultiCoinDistributionPreviewResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMultiCoinDistributionPreviewResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMultiCoinDistributionPreviewResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Remaining) > 0 {
+		for iNdEx := len(m.Remaining) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Remaining[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SkippedPoolIds) > 0 {
+		dAtA5 := make([]byte, len(m.SkippedPoolIds)*10)
+		var j4 int
+		for _, num := range m.SkippedPoolIds {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintQuery(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PoolRewards) > 0 {
+		for iNdEx := len(m.PoolRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MultiCoinDistributionPoolRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiCoinDistributionPoolRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiCoinDistributionPoolRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PoolAccount) > 0 {
+		i -= len(m.PoolAccount)
+		copy(dAtA[i:], m.PoolAccount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PoolAccount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMultiCoinDistributionPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMultiCoinDistributionPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Policy.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMultiCoinStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMultiCoinStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if len(m.PendingMultiCoinRewards) > 0 {
 		for _, e := range m.PendingMultiCoinRewards {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	return n
-}
+	if m.ConvertEnabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryPendingMultiCoinRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingMultiCoinRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PendingMultiCoinRewardsEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovQuery(uint64(m.Index))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.CreationDate != 0 {
+		n += 1 + sovQuery(uint64(m.CreationDate))
+	}
+	if m.ExpiryDate != 0 {
+		n += 1 + sovQuery(uint64(m.ExpiryDate))
+	}
+	return n
+}
+
+func (m *QueryMultiCoinDistributionPolicyVersionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMultiCoinDistributionPolicyVersionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Versions) > 0 {
+		for _, e := range m.Versions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.ActiveVersion != 0 {
+		n += 1 + sovQuery(uint64(m.ActiveVersion))
+	}
+	if m.HasActiveVersion {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryMultiCoinDistributionPreviewRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Policy != nil {
+		l = m.Policy.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMultiCoinDistributionPreviewResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PoolRewards) > 0 {
+		for _, e := range m.PoolRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.SkippedPoolIds) > 0 {
+		l = 0
+		for _, e := range m.SkippedPoolIds {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if len(m.Remaining) > 0 {
+		for _, e := range m.Remaining {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MultiCoinDistributionPoolRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.PoolAccount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMultiCoinDistributionPolicyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMultiCoinDistributionPolicyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMultiCoinDistributionPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMultiCoinDistributionPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMultiCoinDistributionPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMultiCoinDistributionPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMultiCoinStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMultiCoinStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMultiCoinStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMultiCoinStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMultiCoinStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMultiCoinStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingMultiCoinRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingMultiCoinRewards = append(m.PendingMultiCoinRewards, types.Coin{})
+			if err := m.PendingMultiCoinRewards[len(m.PendingMultiCoinRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConvertEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ConvertEnabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingMultiCoinRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingMultiCoinRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingMultiCoinRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingMultiCoinRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingMultiCoinRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingMultiCoinRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, PendingMultiCoinRewardsEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingMultiCoinRewardsEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingMultiCoinRewardsEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingMultiCoinRewardsEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationDate", wireType)
+			}
+			m.CreationDate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationDate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryDate", wireType)
+			}
+			m.ExpiryDate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryDate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMultiCoinDistributionPolicyVersionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -817,10 +2717,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMultiCoinDistributionPolicyVersionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMultiCoinDistributionPolicyVersionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -844,7 +2744,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMultiCoinDistributionPolicyVersionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -867,15 +2767,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMultiCoinDistributionPolicyVersionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMultiCoinDistributionPolicyVersionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -902,60 +2802,50 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Versions = append(m.Versions, MultiCoinDistributionPolicyVersion{})
+			if err := m.Versions[len(m.Versions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveVersion", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryMultiCoinDistributionPolicyRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.ActiveVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActiveVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasActiveVersion", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMultiCoinDistributionPolicyRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMultiCoinDistributionPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.HasActiveVersion = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -977,7 +2867,7 @@ func (m *QueryMultiCoinDistributionPolicyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMultiCoinDistributionPolicyResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMultiCoinDistributionPreviewRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1000,10 +2890,10 @@ func (m *QueryMultiCoinDistributionPolicyResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMultiCoinDistributionPolicyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMultiCoinDistributionPreviewRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMultiCoinDistributionPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMultiCoinDistributionPreviewRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1035,6 +2925,9 @@ func (m *QueryMultiCoinDistributionPolicyResponse) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
+			if m.Policy == nil {
+				m.Policy = &MultiCoinDistributionPolicy{}
+			}
 			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -1060,7 +2953,7 @@ func (m *QueryMultiCoinDistributionPolicyResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryMultiCoinStatusRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMultiCoinDistributionPreviewResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1083,17 +2976,17 @@ func (m *QueryMultiCoinStatusRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMultiCoinStatusRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMultiCoinDistributionPreviewResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMultiCoinStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMultiCoinDistributionPreviewResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolRewards", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1103,23 +2996,135 @@ func (m *QueryMultiCoinStatusRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.PoolRewards = append(m.PoolRewards, MultiCoinDistributionPoolRewards{})
+			if err := m.PoolRewards[len(m.PoolRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SkippedPoolIds = append(m.SkippedPoolIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SkippedPoolIds) == 0 {
+					m.SkippedPoolIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SkippedPoolIds = append(m.SkippedPoolIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkippedPoolIds", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Remaining = append(m.Remaining, types.Coin{})
+			if err := m.Remaining[len(m.Remaining)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1142,7 +3147,7 @@ func (m *QueryMultiCoinStatusRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMultiCoinStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *MultiCoinDistributionPoolRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1165,17 +3170,17 @@ func (m *QueryMultiCoinStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMultiCoinStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MultiCoinDistributionPoolRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMultiCoinStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MultiCoinDistributionPoolRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var v int
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1185,15 +3190,46 @@ func (m *QueryMultiCoinStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Enabled = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingMultiCoinRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1220,8 +3256,8 @@ func (m *QueryMultiCoinStatusResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PendingMultiCoinRewards = append(m.PendingMultiCoinRewards, types.Coin{})
-			if err := m.PendingMultiCoinRewards[len(m.PendingMultiCoinRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
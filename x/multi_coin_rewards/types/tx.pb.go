@@ -221,6 +221,9 @@ type MsgSetMultiCoinRewardsDistributionPolicy struct {
 	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	// policy ...
 	Policy *MultiCoinDistributionPolicy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+	// activation_time is the UNIX-timestamp (in seconds) at which the policy
+	// becomes active. If it is zero or in the past, the policy becomes active immediately.
+	ActivationTime int64 `protobuf:"varint,3,opt,name=activation_time,json=activationTime,proto3" json:"activation_time,omitempty"`
 }
 
 func (m *MsgSetMultiCoinRewardsDistributionPolicy) Reset() {
@@ -272,6 +275,13 @@ func (m *MsgSetMultiCoinRewardsDistributionPolicy) GetPolicy() *MultiCoinDistrib
 	return nil
 }
 
+func (m *MsgSetMultiCoinRewardsDistributionPolicy) GetActivationTime() int64 {
+	if m != nil {
+		return m.ActivationTime
+	}
+	return 0
+}
+
 // MsgEnableMultiCoinRewardResponse ...
 type MsgSetMultiCoinRewardsDistributionPolicyResponse struct {
 }
@@ -313,6 +323,300 @@ func (m *MsgSetMultiCoinRewardsDistributionPolicyResponse) XXX_DiscardUnknown()
 
 var xxx_messageInfo_MsgSetMultiCoinRewardsDistributionPolicyResponse proto.InternalMessageInfo
 
+// MsgClaimPendingMultiCoinRewards claims pending multi-coin rewards of the sender
+// address. If the sender has not enabled multi-coin rewards yet, they get enabled.
+type MsgClaimPendingMultiCoinRewards struct {
+	// creator ...
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// indices of the pending rewards entries which should be claimed.
+	// If empty, all pending rewards entries are claimed.
+	Indices []uint64 `protobuf:"varint,2,rep,packed,name=indices,proto3" json:"indices,omitempty"`
+}
+
+func (m *MsgClaimPendingMultiCoinRewards) Reset()         { *m = MsgClaimPendingMultiCoinRewards{} }
+func (m *MsgClaimPendingMultiCoinRewards) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimPendingMultiCoinRewards) ProtoMessage()    {}
+func (*MsgClaimPendingMultiCoinRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_702f1149b462214b, []int{6}
+}
+func (m *MsgClaimPendingMultiCoinRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimPendingMultiCoinRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimPendingMultiCoinRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimPendingMultiCoinRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimPendingMultiCoinRewards.Merge(m, src)
+}
+func (m *MsgClaimPendingMultiCoinRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimPendingMultiCoinRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimPendingMultiCoinRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimPendingMultiCoinRewards proto.InternalMessageInfo
+
+func (m *MsgClaimPendingMultiCoinRewards) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgClaimPendingMultiCoinRewards) GetIndices() []uint64 {
+	if m != nil {
+		return m.Indices
+	}
+	return nil
+}
+
+// MsgClaimPendingMultiCoinRewardsResponse ...
+type MsgClaimPendingMultiCoinRewardsResponse struct {
+}
+
+func (m *MsgClaimPendingMultiCoinRewardsResponse) Reset() {
+	*m = MsgClaimPendingMultiCoinRewardsResponse{}
+}
+func (m *MsgClaimPendingMultiCoinRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimPendingMultiCoinRewardsResponse) ProtoMessage()    {}
+func (*MsgClaimPendingMultiCoinRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_702f1149b462214b, []int{7}
+}
+func (m *MsgClaimPendingMultiCoinRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimPendingMultiCoinRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimPendingMultiCoinRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimPendingMultiCoinRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimPendingMultiCoinRewardsResponse.Merge(m, src)
+}
+func (m *MsgClaimPendingMultiCoinRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimPendingMultiCoinRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimPendingMultiCoinRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimPendingMultiCoinRewardsResponse proto.InternalMessageInfo
+
+// MsgToggleMultiCoinRewardsConvert enables or disables the convert mode for the sender
+// address. In convert mode all non-native rewards are swapped to the native denom.
+// Enabling the convert mode disables multi-coin rewards and converts all current
+// pending rewards.
+type MsgToggleMultiCoinRewardsConvert struct {
+	// creator ...
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// enabled ...
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *MsgToggleMultiCoinRewardsConvert) Reset()         { *m = MsgToggleMultiCoinRewardsConvert{} }
+func (m *MsgToggleMultiCoinRewardsConvert) String() string { return proto.CompactTextString(m) }
+func (*MsgToggleMultiCoinRewardsConvert) ProtoMessage()    {}
+func (*MsgToggleMultiCoinRewardsConvert) Descriptor() ([]byte, []int) {
+	return fileDescriptor_702f1149b462214b, []int{8}
+}
+func (m *MsgToggleMultiCoinRewardsConvert) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgToggleMultiCoinRewardsConvert) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgToggleMultiCoinRewardsConvert.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgToggleMultiCoinRewardsConvert) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgToggleMultiCoinRewardsConvert.Merge(m, src)
+}
+func (m *MsgToggleMultiCoinRewardsConvert) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgToggleMultiCoinRewardsConvert) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgToggleMultiCoinRewardsConvert.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgToggleMultiCoinRewardsConvert proto.InternalMessageInfo
+
+func (m *MsgToggleMultiCoinRewardsConvert) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgToggleMultiCoinRewardsConvert) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+// MsgToggleMultiCoinRewardsConvertResponse ...
+type MsgToggleMultiCoinRewardsConvertResponse struct {
+}
+
+func (m *MsgToggleMultiCoinRewardsConvertResponse) Reset() {
+	*m = MsgToggleMultiCoinRewardsConvertResponse{}
+}
+func (m *MsgToggleMultiCoinRewardsConvertResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgToggleMultiCoinRewardsConvertResponse) ProtoMessage()    {}
+func (*MsgToggleMultiCoinRewardsConvertResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_702f1149b462214b, []int{9}
+}
+func (m *MsgToggleMultiCoinRewardsConvertResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgToggleMultiCoinRewardsConvertResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgToggleMultiCoinRewardsConvertResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgToggleMultiCoinRewardsConvertResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgToggleMultiCoinRewardsConvertResponse.Merge(m, src)
+}
+func (m *MsgToggleMultiCoinRewardsConvertResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgToggleMultiCoinRewardsConvertResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgToggleMultiCoinRewardsConvertResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgToggleMultiCoinRewardsConvertResponse proto.InternalMessageInfo
+
+// MsgCancelMultiCoinDistributionPolicyVersion removes a scheduled version of the
+// distribution policy before it becomes active.
+type MsgCancelMultiCoinDistributionPolicyVersion struct {
+	// creator ...
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// version of the policy which should be cancelled
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *MsgCancelMultiCoinDistributionPolicyVersion) Reset() {
+	*m = MsgCancelMultiCoinDistributionPolicyVersion{}
+}
+func (m *MsgCancelMultiCoinDistributionPolicyVersion) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgCancelMultiCoinDistributionPolicyVersion) ProtoMessage() {}
+func (*MsgCancelMultiCoinDistributionPolicyVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_702f1149b462214b, []int{10}
+}
+func (m *MsgCancelMultiCoinDistributionPolicyVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelMultiCoinDistributionPolicyVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelMultiCoinDistributionPolicyVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelMultiCoinDistributionPolicyVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelMultiCoinDistributionPolicyVersion.Merge(m, src)
+}
+func (m *MsgCancelMultiCoinDistributionPolicyVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelMultiCoinDistributionPolicyVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelMultiCoinDistributionPolicyVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelMultiCoinDistributionPolicyVersion proto.InternalMessageInfo
+
+func (m *MsgCancelMultiCoinDistributionPolicyVersion) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgCancelMultiCoinDistributionPolicyVersion) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+// MsgCancelMultiCoinDistributionPolicyVersionResponse ...
+type MsgCancelMultiCoinDistributionPolicyVersionResponse struct {
+}
+
+func (m *MsgCancelMultiCoinDistributionPolicyVersionResponse) Reset() {
+	*m = MsgCancelMultiCoinDistributionPolicyVersionResponse{}
+}
+func (m *MsgCancelMultiCoinDistributionPolicyVersionResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgCancelMultiCoinDistributionPolicyVersionResponse) ProtoMessage() {}
+func (*MsgCancelMultiCoinDistributionPolicyVersionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_702f1149b462214b, []int{11}
+}
+func (m *MsgCancelMultiCoinDistributionPolicyVersionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelMultiCoinDistributionPolicyVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelMultiCoinDistributionPolicyVersionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelMultiCoinDistributionPolicyVersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelMultiCoinDistributionPolicyVersionResponse.Merge(m, src)
+}
+func (m *MsgCancelMultiCoinDistributionPolicyVersionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelMultiCoinDistributionPolicyVersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelMultiCoinDistributionPolicyVersionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelMultiCoinDistributionPolicyVersionResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "kyve.multi_coin_rewards.v1beta1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "kyve.multi_coin_rewards.v1beta1.MsgUpdateParamsResponse")
@@ -320,6 +624,12 @@ func init() {
 	proto.RegisterType((*MsgToggleMultiCoinRewardsResponse)(nil), "kyve.multi_coin_rewards.v1beta1.MsgToggleMultiCoinRewardsResponse")
 	proto.RegisterType((*MsgSetMultiCoinRewardsDistributionPolicy)(nil), "kyve.multi_coin_rewards.v1beta1.MsgSetMultiCoinRewardsDistributionPolicy")
 	proto.RegisterType((*MsgSetMultiCoinRewardsDistributionPolicyResponse)(nil), "kyve.multi_coin_rewards.v1beta1.MsgSetMultiCoinRewardsDistributionPolicyResponse")
+	proto.RegisterType((*MsgClaimPendingMultiCoinRewards)(nil), "kyve.multi_coin_rewards.v1beta1.MsgClaimPendingMultiCoinRewards")
+	proto.RegisterType((*MsgClaimPendingMultiCoinRewardsResponse)(nil), "kyve.multi_coin_rewards.v1beta1.MsgClaimPendingMultiCoinRewardsResponse")
+	proto.RegisterType((*MsgToggleMultiCoinRewardsConvert)(nil), "kyve.multi_coin_rewards.v1beta1.MsgToggleMultiCoinRewardsConvert")
+	proto.RegisterType((*MsgToggleMultiCoinRewardsConvertResponse)(nil), "kyve.multi_coin_rewards.v1beta1.MsgToggleMultiCoinRewardsConvertResponse")
+	proto.RegisterType((*MsgCancelMultiCoinDistributionPolicyVersion)(nil), "kyve.multi_coin_rewards.v1beta1.MsgCancelMultiCoinDistributionPolicyVersion")
+	proto.RegisterType((*MsgCancelMultiCoinDistributionPolicyVersionResponse)(nil), "kyve.multi_coin_rewards.v1beta1.MsgCancelMultiCoinDistributionPolicyVersionResponse")
 }
 
 func init() {
@@ -327,38 +637,50 @@ func init() {
 }
 
 var fileDescriptor_702f1149b462214b = []byte{
-	// 496 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4f, 0x6b, 0x13, 0x41,
-	0x1c, 0xcd, 0x58, 0xac, 0x76, 0x2c, 0x0a, 0x8b, 0xd8, 0x64, 0x0f, 0xab, 0x46, 0x0f, 0xa1, 0xe2,
-	0x4e, 0x13, 0x41, 0x25, 0x78, 0x31, 0xea, 0x41, 0x64, 0xa5, 0xdd, 0x56, 0x41, 0x41, 0xc2, 0xec,
-	0xee, 0x30, 0x19, 0xba, 0xbb, 0xb3, 0xcc, 0xcc, 0xa6, 0x5d, 0x4f, 0xe2, 0x27, 0xf0, 0xe8, 0x47,
-	0xf0, 0xd8, 0x83, 0x1f, 0xc1, 0x83, 0xc7, 0xe2, 0xc9, 0xa3, 0x24, 0x87, 0x82, 0x9f, 0x42, 0xf6,
-	0x5f, 0x4a, 0x93, 0xd4, 0xac, 0xd2, 0xd3, 0xf2, 0x63, 0xde, 0xfb, 0xbd, 0xf7, 0xf6, 0xc1, 0x0f,
-	0xb6, 0x76, 0x93, 0x21, 0x41, 0x41, 0xec, 0x2b, 0xd6, 0x77, 0x39, 0x0b, 0xfb, 0x82, 0xec, 0x61,
-	0xe1, 0x49, 0x34, 0x6c, 0x3b, 0x44, 0xe1, 0x36, 0x52, 0xfb, 0x66, 0x24, 0xb8, 0xe2, 0xda, 0xf5,
-	0x14, 0x69, 0xce, 0x22, 0xcd, 0x02, 0xa9, 0xaf, 0xb9, 0x5c, 0x06, 0x5c, 0xa2, 0x40, 0x52, 0x34,
-	0x6c, 0xa7, 0x9f, 0x9c, 0xa9, 0x37, 0xf2, 0x87, 0x7e, 0x36, 0xa1, 0x7c, 0x28, 0x9e, 0xee, 0x2c,
-	0x94, 0x4f, 0x22, 0x52, 0x80, 0x9b, 0x12, 0x5e, 0xb1, 0x24, 0x7d, 0x15, 0x79, 0x58, 0x91, 0x4d,
-	0x2c, 0x70, 0x20, 0xb5, 0xfb, 0x70, 0x05, 0xc7, 0x6a, 0xc0, 0x05, 0x53, 0x49, 0x1d, 0xdc, 0x00,
-	0xad, 0x95, 0x5e, 0xfd, 0xc7, 0xd7, 0xbb, 0x57, 0x0b, 0x91, 0xc7, 0x9e, 0x27, 0x88, 0x94, 0xdb,
-	0x4a, 0xb0, 0x90, 0xda, 0xc7, 0x50, 0xad, 0x0e, 0x2f, 0x44, 0x38, 0xf1, 0x39, 0xf6, 0xea, 0xe7,
-	0x52, 0x96, 0x5d, 0x8e, 0xdd, 0xcb, 0x1f, 0x8f, 0x0e, 0xd6, 0x8f, 0x91, 0xcd, 0x06, 0x5c, 0x9b,
-	0x12, 0xb5, 0x89, 0x8c, 0x78, 0x28, 0x49, 0xf3, 0x1d, 0x6c, 0x58, 0x92, 0xee, 0x70, 0x4a, 0x7d,
-	0x62, 0xa5, 0x11, 0x9e, 0x70, 0x16, 0xda, 0x79, 0x80, 0x54, 0xc1, 0x15, 0x04, 0x2b, 0x2e, 0x72,
-	0x5f, 0x76, 0x39, 0xa6, 0x2f, 0x24, 0xc4, 0x8e, 0x4f, 0x72, 0xed, 0x8b, 0x76, 0x39, 0x76, 0x57,
-	0x53, 0xed, 0x12, 0xd7, 0xbc, 0x05, 0x6f, 0x9e, 0xba, 0x7e, 0xe2, 0xe1, 0x0b, 0x80, 0x2d, 0x4b,
-	0xd2, 0x6d, 0xa2, 0xa6, 0x21, 0x4f, 0x99, 0x54, 0x82, 0x39, 0xb1, 0x62, 0x3c, 0xdc, 0xe4, 0x3e,
-	0x73, 0x93, 0xbf, 0x78, 0xda, 0x81, 0xcb, 0x51, 0x86, 0xc9, 0x2c, 0x5d, 0xea, 0x3c, 0x32, 0x17,
-	0xb4, 0x6d, 0x4e, 0xe4, 0x66, 0x75, 0xec, 0x62, 0xd7, 0x54, 0x9e, 0x0e, 0xdc, 0xa8, 0xea, 0xb4,
-	0x8c, 0xd7, 0xf9, 0xbd, 0x04, 0x97, 0x2c, 0x49, 0xb5, 0xf7, 0x70, 0xf5, 0x44, 0xef, 0x1b, 0x8b,
-	0xfd, 0x9d, 0x2c, 0x4d, 0x7f, 0xf8, 0xaf, 0x8c, 0xd2, 0x83, 0xf6, 0x19, 0xc0, 0x6b, 0xa7, 0x94,
-	0xdc, 0xad, 0xb2, 0x74, 0x3e, 0x57, 0xef, 0xfd, 0x3f, 0x77, 0x62, 0xed, 0x1b, 0x80, 0xb7, 0x67,
-	0x7f, 0xe8, 0x9c, 0xe6, 0x9f, 0x57, 0x11, 0xab, 0x54, 0x8d, 0xbe, 0x75, 0x66, 0xab, 0xca, 0x18,
-	0xfa, 0xf9, 0x0f, 0x47, 0x07, 0xeb, 0xa0, 0xb7, 0xf5, 0x7d, 0x64, 0x80, 0xc3, 0x91, 0x01, 0x7e,
-	0x8d, 0x0c, 0xf0, 0x69, 0x6c, 0xd4, 0x0e, 0xc7, 0x46, 0xed, 0xe7, 0xd8, 0xa8, 0xbd, 0x7d, 0x40,
-	0x99, 0x1a, 0xc4, 0x8e, 0xe9, 0xf2, 0x00, 0xbd, 0x78, 0xf3, 0xfa, 0xd9, 0x4b, 0xa2, 0xf6, 0xb8,
-	0xd8, 0x45, 0xee, 0x00, 0xb3, 0x10, 0xed, 0xcf, 0x3b, 0x20, 0xd9, 0xe1, 0x70, 0x96, 0xb3, 0xcb,
-	0x71, 0xef, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x73, 0x23, 0x38, 0x46, 0xe7, 0x04, 0x00, 0x00,
+	// 673 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x5d, 0x4f, 0x13, 0x4d,
+	0x14, 0x66, 0x28, 0x2f, 0x2f, 0x1c, 0x09, 0x24, 0x8d, 0x91, 0xb2, 0xd1, 0x52, 0xab, 0x09, 0x2b,
+	0xc4, 0x2e, 0x1f, 0xf1, 0x23, 0xc4, 0x0b, 0x01, 0x4d, 0x24, 0x5a, 0x03, 0x4b, 0x25, 0xd1, 0xc4,
+	0x34, 0xd3, 0xdd, 0xc9, 0x32, 0x61, 0x77, 0xa6, 0x99, 0x99, 0x16, 0xea, 0x95, 0xf1, 0x17, 0x78,
+	0xc9, 0x6f, 0x30, 0x31, 0xe1, 0xc2, 0x9f, 0xe0, 0x85, 0x57, 0x06, 0xbd, 0xf2, 0xd2, 0xc0, 0x05,
+	0x7f, 0xc3, 0x6c, 0xf7, 0x83, 0x50, 0x60, 0xbb, 0x7c, 0x5c, 0x35, 0xa7, 0xf3, 0x9c, 0xe7, 0x79,
+	0xce, 0x9e, 0x79, 0x92, 0x01, 0x7d, 0xb3, 0xd5, 0x24, 0x86, 0xd7, 0x70, 0x15, 0xad, 0x5a, 0x9c,
+	0xb2, 0xaa, 0x20, 0x5b, 0x58, 0xd8, 0xd2, 0x68, 0xce, 0xd4, 0x88, 0xc2, 0x33, 0x86, 0xda, 0x2e,
+	0xd5, 0x05, 0x57, 0x3c, 0x3b, 0xee, 0x23, 0x4b, 0x27, 0x91, 0xa5, 0x10, 0xa9, 0x8d, 0x5a, 0x5c,
+	0x7a, 0x5c, 0x1a, 0x9e, 0x74, 0x8c, 0xe6, 0x8c, 0xff, 0x13, 0x74, 0x6a, 0x63, 0xc1, 0x41, 0xb5,
+	0x5d, 0x19, 0x41, 0x11, 0x1e, 0x4d, 0x75, 0x95, 0x6f, 0xd5, 0x49, 0x08, 0x2e, 0x4a, 0x18, 0x29,
+	0x4b, 0xe7, 0x4d, 0xdd, 0xc6, 0x8a, 0xac, 0x60, 0x81, 0x3d, 0x99, 0x7d, 0x08, 0x83, 0xb8, 0xa1,
+	0x36, 0xb8, 0xa0, 0xaa, 0x95, 0x43, 0x05, 0xa4, 0x0f, 0x2e, 0xe6, 0x7e, 0x7f, 0xbb, 0x7f, 0x3d,
+	0x14, 0x59, 0xb0, 0x6d, 0x41, 0xa4, 0x5c, 0x53, 0x82, 0x32, 0xc7, 0x3c, 0x82, 0x66, 0x73, 0xf0,
+	0x7f, 0x1d, 0xb7, 0x5c, 0x8e, 0xed, 0x5c, 0xaf, 0xdf, 0x65, 0x46, 0xe5, 0xfc, 0xf0, 0xa7, 0xc3,
+	0xdd, 0xc9, 0x23, 0x64, 0x71, 0x0c, 0x46, 0x3b, 0x44, 0x4d, 0x22, 0xeb, 0x9c, 0x49, 0x52, 0x7c,
+	0x0f, 0x63, 0x65, 0xe9, 0x54, 0xb8, 0xe3, 0xb8, 0xa4, 0xec, 0x8f, 0xb0, 0xc4, 0x29, 0x33, 0x83,
+	0x01, 0x7c, 0x05, 0x4b, 0x10, 0xac, 0xb8, 0x08, 0x7c, 0x99, 0x51, 0xe9, 0x9f, 0x10, 0x86, 0x6b,
+	0x2e, 0x09, 0xb4, 0x07, 0xcc, 0xa8, 0x9c, 0x1f, 0xf2, 0xb5, 0x23, 0x5c, 0xf1, 0x0e, 0xdc, 0x3e,
+	0x93, 0x3e, 0xf6, 0xf0, 0x0b, 0x81, 0x5e, 0x96, 0xce, 0x1a, 0x51, 0x9d, 0x90, 0x67, 0x54, 0x2a,
+	0x41, 0x6b, 0x0d, 0x45, 0x39, 0x5b, 0xe1, 0x2e, 0xb5, 0x5a, 0x09, 0x9e, 0x2a, 0xd0, 0x5f, 0x6f,
+	0x63, 0xda, 0x96, 0xae, 0xcd, 0x3e, 0x29, 0x75, 0xd9, 0x76, 0x29, 0x96, 0x3b, 0xa9, 0x63, 0x86,
+	0x5c, 0xd9, 0x09, 0x18, 0xc1, 0x96, 0xa2, 0x4d, 0xec, 0x9f, 0x55, 0x15, 0xf5, 0x48, 0x2e, 0x53,
+	0x40, 0x7a, 0xc6, 0x1c, 0x3e, 0xfa, 0xbb, 0x42, 0x3d, 0xd2, 0x31, 0xf8, 0x2c, 0x4c, 0xa7, 0x1d,
+	0x29, 0xfe, 0x0e, 0x16, 0x8c, 0x97, 0xa5, 0xb3, 0xe4, 0x62, 0xea, 0xad, 0x10, 0x66, 0x53, 0xe6,
+	0x9c, 0x6f, 0x23, 0x94, 0xd9, 0xd4, 0x22, 0x32, 0xd7, 0x5b, 0xc8, 0xe8, 0x7d, 0x66, 0x54, 0x76,
+	0x18, 0xbb, 0x07, 0x13, 0x5d, 0x44, 0x62, 0x3f, 0x36, 0x14, 0xce, 0x5c, 0xde, 0x12, 0x67, 0x4d,
+	0x22, 0xd4, 0x15, 0x5c, 0x91, 0x49, 0xd0, 0xbb, 0xa9, 0xc4, 0x8e, 0x38, 0x4c, 0xf9, 0xe6, 0x31,
+	0xb3, 0x88, 0x9b, 0xb0, 0xbc, 0x75, 0x22, 0x24, 0xe5, 0x2c, 0xd9, 0x5c, 0x33, 0x00, 0xb5, 0xcd,
+	0xf5, 0x99, 0x51, 0xd9, 0x61, 0xee, 0x01, 0xcc, 0x9d, 0x43, 0x30, 0xf2, 0x39, 0xbb, 0x33, 0x00,
+	0x99, 0xb2, 0x74, 0xb2, 0x1f, 0x60, 0xe8, 0x58, 0xd4, 0xa7, 0xbb, 0x5f, 0xc9, 0xe3, 0x39, 0xd5,
+	0x1e, 0x9f, 0xb7, 0x23, 0xf2, 0x90, 0xdd, 0x41, 0x70, 0xe3, 0x8c, 0x5c, 0xcf, 0xa7, 0x21, 0x3d,
+	0xbd, 0x57, 0x5b, 0xbc, 0x78, 0x6f, 0x6c, 0xed, 0x3b, 0x82, 0xbb, 0x27, 0xa3, 0x71, 0x4a, 0xd8,
+	0x97, 0xd3, 0x88, 0xa5, 0x0a, 0x99, 0xb6, 0x7a, 0x65, 0x54, 0xf1, 0x18, 0x5f, 0x10, 0xdc, 0x4c,
+	0x4c, 0xeb, 0xd3, 0x34, 0x9a, 0x49, 0x0c, 0xda, 0x8b, 0xcb, 0x32, 0xc4, 0x66, 0xbf, 0x22, 0xb8,
+	0x95, 0x1c, 0xe5, 0x85, 0x8b, 0x6f, 0x36, 0xa4, 0xd0, 0x96, 0x2f, 0x4d, 0x11, 0xfb, 0xfd, 0x89,
+	0x40, 0x4f, 0x1d, 0xf4, 0x57, 0xa9, 0x3e, 0x53, 0x4a, 0x36, 0xad, 0x72, 0x95, 0x6c, 0xd1, 0x40,
+	0xda, 0x7f, 0x1f, 0x0f, 0x77, 0x27, 0xd1, 0xe2, 0xea, 0x8f, 0xfd, 0x3c, 0xda, 0xdb, 0xcf, 0xa3,
+	0xbf, 0xfb, 0x79, 0xf4, 0xf9, 0x20, 0xdf, 0xb3, 0x77, 0x90, 0xef, 0xf9, 0x73, 0x90, 0xef, 0x79,
+	0xf7, 0xc8, 0xa1, 0x6a, 0xa3, 0x51, 0x2b, 0x59, 0xdc, 0x33, 0x5e, 0xbe, 0x5d, 0x7f, 0xfe, 0x9a,
+	0xa8, 0x2d, 0x2e, 0x36, 0x0d, 0x6b, 0x03, 0x53, 0x66, 0x6c, 0x9f, 0xf6, 0xc2, 0x68, 0xbf, 0x2c,
+	0x6a, 0xfd, 0xed, 0xa7, 0xc5, 0xdc, 0xbf, 0x01, 0x00, 0xf1, 0xcc, 0xc8, 0xd2, 0x08, 0x09, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -380,6 +702,12 @@ type MsgClient interface {
 	ToggleMultiCoinRewards(ctx context.Context, in *MsgToggleMultiCoinRewards, opts ...grpc.CallOption) (*MsgToggleMultiCoinRewardsResponse, error)
 	// SetMultiCoinRewardDistributionPolicy ...
 	SetMultiCoinRewardDistributionPolicy(ctx context.Context, in *MsgSetMultiCoinRewardsDistributionPolicy, opts ...grpc.CallOption) (*MsgSetMultiCoinRewardsDistributionPolicyResponse, error)
+	// ClaimPendingMultiCoinRewards ...
+	ClaimPendingMultiCoinRewards(ctx context.Context, in *MsgClaimPendingMultiCoinRewards, opts ...grpc.CallOption) (*MsgClaimPendingMultiCoinRewardsResponse, error)
+	// ToggleMultiCoinRewardsConvert ...
+	ToggleMultiCoinRewardsConvert(ctx context.Context, in *MsgToggleMultiCoinRewardsConvert, opts ...grpc.CallOption) (*MsgToggleMultiCoinRewardsConvertResponse, error)
+	// CancelMultiCoinDistributionPolicyVersion ...
+	CancelMultiCoinDistributionPolicyVersion(ctx context.Context, in *MsgCancelMultiCoinDistributionPolicyVersion, opts ...grpc.CallOption) (*MsgCancelMultiCoinDistributionPolicyVersionResponse, error)
 }
 
 type msgClient struct {
@@ -417,6 +745,33 @@ func (c *msgClient) SetMultiCoinRewardDistributionPolicy(ctx context.Context, in
 	return out, nil
 }
 
+func (c *msgClient) ClaimPendingMultiCoinRewards(ctx context.Context, in *MsgClaimPendingMultiCoinRewards, opts ...grpc.CallOption) (*MsgClaimPendingMultiCoinRewardsResponse, error) {
+	out := new(MsgClaimPendingMultiCoinRewardsResponse)
+	err := c.cc.Invoke(ctx, "/kyve.multi_coin_rewards.v1beta1.Msg/ClaimPendingMultiCoinRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ToggleMultiCoinRewardsConvert(ctx context.Context, in *MsgToggleMultiCoinRewardsConvert, opts ...grpc.CallOption) (*MsgToggleMultiCoinRewardsConvertResponse, error) {
+	out := new(MsgToggleMultiCoinRewardsConvertResponse)
+	err := c.cc.Invoke(ctx, "/kyve.multi_coin_rewards.v1beta1.Msg/ToggleMultiCoinRewardsConvert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelMultiCoinDistributionPolicyVersion(ctx context.Context, in *MsgCancelMultiCoinDistributionPolicyVersion, opts ...grpc.CallOption) (*MsgCancelMultiCoinDistributionPolicyVersionResponse, error) {
+	out := new(MsgCancelMultiCoinDistributionPolicyVersionResponse)
+	err := c.cc.Invoke(ctx, "/kyve.multi_coin_rewards.v1beta1.Msg/CancelMultiCoinDistributionPolicyVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a governance operation for updating the x/multi_coin_rewards module
@@ -426,6 +781,12 @@ type MsgServer interface {
 	ToggleMultiCoinRewards(context.Context, *MsgToggleMultiCoinRewards) (*MsgToggleMultiCoinRewardsResponse, error)
 	// SetMultiCoinRewardDistributionPolicy ...
 	SetMultiCoinRewardDistributionPolicy(context.Context, *MsgSetMultiCoinRewardsDistributionPolicy) (*MsgSetMultiCoinRewardsDistributionPolicyResponse, error)
+	// ClaimPendingMultiCoinRewards ...
+	ClaimPendingMultiCoinRewards(context.Context, *MsgClaimPendingMultiCoinRewards) (*MsgClaimPendingMultiCoinRewardsResponse, error)
+	// ToggleMultiCoinRewardsConvert ...
+	ToggleMultiCoinRewardsConvert(context.Context, *MsgToggleMultiCoinRewardsConvert) (*MsgToggleMultiCoinRewardsConvertResponse, error)
+	// CancelMultiCoinDistributionPolicyVersion ...
+	CancelMultiCoinDistributionPolicyVersion(context.Context, *MsgCancelMultiCoinDistributionPolicyVersion) (*MsgCancelMultiCoinDistributionPolicyVersionResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -441,6 +802,15 @@ func (*UnimplementedMsgServer) ToggleMultiCoinRewards(ctx context.Context, req *
 func (*UnimplementedMsgServer) SetMultiCoinRewardDistributionPolicy(ctx context.Context, req *MsgSetMultiCoinRewardsDistributionPolicy) (*MsgSetMultiCoinRewardsDistributionPolicyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetMultiCoinRewardDistributionPolicy not implemented")
 }
+func (*UnimplementedMsgServer) ClaimPendingMultiCoinRewards(ctx context.Context, req *MsgClaimPendingMultiCoinRewards) (*MsgClaimPendingMultiCoinRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimPendingMultiCoinRewards not implemented")
+}
+func (*UnimplementedMsgServer) ToggleMultiCoinRewardsConvert(ctx context.Context, req *MsgToggleMultiCoinRewardsConvert) (*MsgToggleMultiCoinRewardsConvertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ToggleMultiCoinRewardsConvert not implemented")
+}
+func (*UnimplementedMsgServer) CancelMultiCoinDistributionPolicyVersion(ctx context.Context, req *MsgCancelMultiCoinDistributionPolicyVersion) (*MsgCancelMultiCoinDistributionPolicyVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelMultiCoinDistributionPolicyVersion not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -500,6 +870,60 @@ func _Msg_SetMultiCoinRewardDistributionPolicy_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ClaimPendingMultiCoinRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimPendingMultiCoinRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimPendingMultiCoinRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.multi_coin_rewards.v1beta1.Msg/ClaimPendingMultiCoinRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimPendingMultiCoinRewards(ctx, req.(*MsgClaimPendingMultiCoinRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ToggleMultiCoinRewardsConvert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgToggleMultiCoinRewardsConvert)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ToggleMultiCoinRewardsConvert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.multi_coin_rewards.v1beta1.Msg/ToggleMultiCoinRewardsConvert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ToggleMultiCoinRewardsConvert(ctx, req.(*MsgToggleMultiCoinRewardsConvert))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelMultiCoinDistributionPolicyVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelMultiCoinDistributionPolicyVersion)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelMultiCoinDistributionPolicyVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.multi_coin_rewards.v1beta1.Msg/CancelMultiCoinDistributionPolicyVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelMultiCoinDistributionPolicyVersion(ctx, req.(*MsgCancelMultiCoinDistributionPolicyVersion))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kyve.multi_coin_rewards.v1beta1.Msg",
@@ -517,6 +941,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetMultiCoinRewardDistributionPolicy",
 			Handler:    _Msg_SetMultiCoinRewardDistributionPolicy_Handler,
 		},
+		{
+			MethodName: "ClaimPendingMultiCoinRewards",
+			Handler:    _Msg_ClaimPendingMultiCoinRewards_Handler,
+		},
+		{
+			MethodName: "ToggleMultiCoinRewardsConvert",
+			Handler:    _Msg_ToggleMultiCoinRewardsConvert_Handler,
+		},
+		{
+			MethodName: "CancelMultiCoinDistributionPolicyVersion",
+			Handler:    _Msg_CancelMultiCoinDistributionPolicyVersion_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kyve/multi_coin_rewards/v1beta1/tx.proto",
@@ -665,6 +1101,11 @@ func (m *MsgSetMultiCoinRewardsDistributionPolicy) MarshalToSizedBuffer(dAtA []b
 	_ = i
 	var l int
 	_ = l
+	if m.ActivationTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ActivationTime))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.Policy != nil {
 		{
 			size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
@@ -710,15 +1151,207 @@ func (m *MsgSetMultiCoinRewardsDistributionPolicyResponse) MarshalToSizedBuffer(
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
+func (m *MsgClaimPendingMultiCoinRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimPendingMultiCoinRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimPendingMultiCoinRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Indices) > 0 {
+		dAtA3 := make([]byte, len(m.Indices)*10)
+		var j2 int
+		for _, num := range m.Indices {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintTx(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimPendingMultiCoinRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimPendingMultiCoinRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimPendingMultiCoinRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgToggleMultiCoinRewardsConvert) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgToggleMultiCoinRewardsConvert) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgToggleMultiCoinRewardsConvert) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgToggleMultiCoinRewardsConvertResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgToggleMultiCoinRewardsConvertResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgToggleMultiCoinRewardsConvertResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelMultiCoinDistributionPolicyVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelMultiCoinDistributionPolicyVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelMultiCoinDistributionPolicyVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelMultiCoinDistributionPolicyVersionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelMultiCoinDistributionPolicyVersionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelMultiCoinDistributionPolicyVersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
 	return base
 }
 func (m *MsgUpdateParams) Size() (n int) {
@@ -786,25 +1419,423 @@ func (m *MsgSetMultiCoinRewardsDistributionPolicy) Size() (n int) {
 		l = m.Policy.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
+	if m.ActivationTime != 0 {
+		n += 1 + sovTx(uint64(m.ActivationTime))
+	}
+	return n
+}
+
+func (m *MsgSetMultiCoinRewardsDistributionPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimPendingMultiCoinRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Indices) > 0 {
+		l = 0
+		for _, e := range m.Indices {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgClaimPendingMultiCoinRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgToggleMultiCoinRewardsConvert) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgToggleMultiCoinRewardsConvertResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelMultiCoinDistributionPolicyVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovTx(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *MsgCancelMultiCoinDistributionPolicyVersionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgToggleMultiCoinRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgToggleMultiCoinRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgToggleMultiCoinRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgToggleMultiCoinRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgToggleMultiCoinRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgToggleMultiCoinRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgSetMultiCoinRewardsDistributionPolicyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgSetMultiCoinRewardsDistributionPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -827,15 +1858,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetMultiCoinRewardsDistributionPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetMultiCoinRewardsDistributionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -863,11 +1894,166 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Policy == nil {
+				m.Policy = &MultiCoinDistributionPolicy{}
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationTime", wireType)
+			}
+			m.ActivationTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetMultiCoinRewardsDistributionPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMultiCoinRewardsDistributionPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMultiCoinRewardsDistributionPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimPendingMultiCoinRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimPendingMultiCoinRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimPendingMultiCoinRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -895,8 +2081,84 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payload = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Indices = append(m.Indices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Indices) == 0 {
+					m.Indices = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Indices = append(m.Indices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indices", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -918,7 +2180,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimPendingMultiCoinRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -941,10 +2203,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimPendingMultiCoinRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimPendingMultiCoinRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -968,7 +2230,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgToggleMultiCoinRewards) Unmarshal(dAtA []byte) error {
+func (m *MsgToggleMultiCoinRewardsConvert) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -991,10 +2253,10 @@ func (m *MsgToggleMultiCoinRewards) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgToggleMultiCoinRewards: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgToggleMultiCoinRewardsConvert: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgToggleMultiCoinRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgToggleMultiCoinRewardsConvert: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1070,7 +2332,7 @@ func (m *MsgToggleMultiCoinRewards) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgToggleMultiCoinRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgToggleMultiCoinRewardsConvertResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1093,10 +2355,10 @@ func (m *MsgToggleMultiCoinRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgToggleMultiCoinRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgToggleMultiCoinRewardsConvertResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgToggleMultiCoinRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgToggleMultiCoinRewardsConvertResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1120,7 +2382,7 @@ func (m *MsgToggleMultiCoinRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetMultiCoinRewardsDistributionPolicy) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelMultiCoinDistributionPolicyVersion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1143,10 +2405,10 @@ func (m *MsgSetMultiCoinRewardsDistributionPolicy) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetMultiCoinRewardsDistributionPolicy: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelMultiCoinDistributionPolicyVersion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetMultiCoinRewardsDistributionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelMultiCoinDistributionPolicyVersion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1182,10 +2444,10 @@ func (m *MsgSetMultiCoinRewardsDistributionPolicy) Unmarshal(dAtA []byte) error
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var msglen int
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1195,28 +2457,11 @@ func (m *MsgSetMultiCoinRewardsDistributionPolicy) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Version |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Policy == nil {
-				m.Policy = &MultiCoinDistributionPolicy{}
-			}
-			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1238,7 +2483,7 @@ func (m *MsgSetMultiCoinRewardsDistributionPolicy) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *MsgSetMultiCoinRewardsDistributionPolicyResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelMultiCoinDistributionPolicyVersionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1261,10 +2506,10 @@ func (m *MsgSetMultiCoinRewardsDistributionPolicyResponse) Unmarshal(dAtA []byte
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetMultiCoinRewardsDistributionPolicyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelMultiCoinDistributionPolicyVersionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetMultiCoinRewardsDistributionPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelMultiCoinDistributionPolicyVersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -302,12 +302,154 @@ func (m *MultiCoinDistributionPoolWeightEntry) GetPoolId() uint64 {
 	return 0
 }
 
+// MultiCoinDistributionPolicyVersion is a version of the distribution policy
+// which becomes active at the activation time.
+type MultiCoinDistributionPolicyVersion struct {
+	// version is the incrementing id of the policy version
+	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// activation_time is the UNIX-timestamp (in seconds) at which the policy
+	// becomes the active distribution policy
+	ActivationTime int64 `protobuf:"varint,2,opt,name=activation_time,json=activationTime,proto3" json:"activation_time,omitempty"`
+	// policy ...
+	Policy MultiCoinDistributionPolicy `protobuf:"bytes,3,opt,name=policy,proto3" json:"policy"`
+	// creation_date is the UNIX-timestamp (in seconds) when the version was created
+	CreationDate int64 `protobuf:"varint,4,opt,name=creation_date,json=creationDate,proto3" json:"creation_date,omitempty"`
+}
+
+func (m *MultiCoinDistributionPolicyVersion) Reset()         { *m = MultiCoinDistributionPolicyVersion{} }
+func (m *MultiCoinDistributionPolicyVersion) String() string { return proto.CompactTextString(m) }
+func (*MultiCoinDistributionPolicyVersion) ProtoMessage()    {}
+func (*MultiCoinDistributionPolicyVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f0d9743633e1637, []int{5}
+}
+func (m *MultiCoinDistributionPolicyVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiCoinDistributionPolicyVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultiCoinDistributionPolicyVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultiCoinDistributionPolicyVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiCoinDistributionPolicyVersion.Merge(m, src)
+}
+func (m *MultiCoinDistributionPolicyVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiCoinDistributionPolicyVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiCoinDistributionPolicyVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiCoinDistributionPolicyVersion proto.InternalMessageInfo
+
+func (m *MultiCoinDistributionPolicyVersion) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *MultiCoinDistributionPolicyVersion) GetActivationTime() int64 {
+	if m != nil {
+		return m.ActivationTime
+	}
+	return 0
+}
+
+func (m *MultiCoinDistributionPolicyVersion) GetPolicy() MultiCoinDistributionPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return MultiCoinDistributionPolicy{}
+}
+
+func (m *MultiCoinDistributionPolicyVersion) GetCreationDate() int64 {
+	if m != nil {
+		return m.CreationDate
+	}
+	return 0
+}
+
+// MultiCoinConvertEntry contains the non-native rewards of an address which
+// get converted to the native denom in the next batch.
+type MultiCoinConvertEntry struct {
+	// address ...
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// rewards ...
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+	// failed_attempts is the number of consecutive batches in which the rewards could not be converted
+	FailedAttempts uint64 `protobuf:"varint,3,opt,name=failed_attempts,json=failedAttempts,proto3" json:"failed_attempts,omitempty"`
+}
+
+func (m *MultiCoinConvertEntry) Reset()         { *m = MultiCoinConvertEntry{} }
+func (m *MultiCoinConvertEntry) String() string { return proto.CompactTextString(m) }
+func (*MultiCoinConvertEntry) ProtoMessage()    {}
+func (*MultiCoinConvertEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f0d9743633e1637, []int{6}
+}
+func (m *MultiCoinConvertEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiCoinConvertEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultiCoinConvertEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultiCoinConvertEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiCoinConvertEntry.Merge(m, src)
+}
+func (m *MultiCoinConvertEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiCoinConvertEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiCoinConvertEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiCoinConvertEntry proto.InternalMessageInfo
+
+func (m *MultiCoinConvertEntry) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MultiCoinConvertEntry) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+func (m *MultiCoinConvertEntry) GetFailedAttempts() uint64 {
+	if m != nil {
+		return m.FailedAttempts
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueueState)(nil), "kyve.multi_coin_rewards.v1beta1.QueueState")
 	proto.RegisterType((*MultiCoinPendingRewardsEntry)(nil), "kyve.multi_coin_rewards.v1beta1.MultiCoinPendingRewardsEntry")
 	proto.RegisterType((*MultiCoinDistributionPolicy)(nil), "kyve.multi_coin_rewards.v1beta1.MultiCoinDistributionPolicy")
 	proto.RegisterType((*MultiCoinDistributionDenomEntry)(nil), "kyve.multi_coin_rewards.v1beta1.MultiCoinDistributionDenomEntry")
 	proto.RegisterType((*MultiCoinDistributionPoolWeightEntry)(nil), "kyve.multi_coin_rewards.v1beta1.MultiCoinDistributionPoolWeightEntry")
+	proto.RegisterType((*MultiCoinDistributionPolicyVersion)(nil), "kyve.multi_coin_rewards.v1beta1.MultiCoinDistributionPolicyVersion")
+	proto.RegisterType((*MultiCoinConvertEntry)(nil), "kyve.multi_coin_rewards.v1beta1.MultiCoinConvertEntry")
 }
 
 func init() {
@@ -315,41 +457,49 @@ func init() {
 }
 
 var fileDescriptor_3f0d9743633e1637 = []byte{
-	// 543 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0x4f, 0x6b, 0x13, 0x41,
-	0x14, 0xcf, 0xda, 0xda, 0x98, 0x69, 0x3d, 0x38, 0x14, 0x8c, 0xad, 0x6e, 0x42, 0xea, 0x21, 0x28,
-	0xce, 0x52, 0x45, 0x3c, 0x78, 0x91, 0x98, 0x80, 0xc5, 0x3f, 0xa4, 0x2b, 0x28, 0xf6, 0x12, 0x26,
-	0x3b, 0xc3, 0xee, 0x98, 0xdd, 0x79, 0x61, 0x67, 0xd2, 0x74, 0xc1, 0x0f, 0xe1, 0x57, 0xf0, 0x26,
-	0x9e, 0xfc, 0x18, 0x3d, 0xf6, 0x28, 0x1e, 0x5a, 0x49, 0x0e, 0x7e, 0x0d, 0x99, 0x99, 0x4d, 0x28,
-	0x58, 0xa8, 0x78, 0xd9, 0x9d, 0xf7, 0xef, 0x37, 0xbf, 0xf7, 0x7b, 0x6f, 0xd0, 0xfd, 0x51, 0x71,
-	0xc8, 0x83, 0x6c, 0x92, 0x6a, 0x31, 0x88, 0x40, 0xc8, 0x41, 0xce, 0xa7, 0x34, 0x67, 0x2a, 0x38,
-	0xdc, 0x1d, 0x72, 0x4d, 0x77, 0x03, 0x5d, 0x8c, 0xb9, 0x22, 0xe3, 0x1c, 0x34, 0xe0, 0x86, 0x49,
-	0x26, 0x7f, 0x27, 0x93, 0x32, 0x79, 0xeb, 0x06, 0xcd, 0x84, 0x84, 0xc0, 0x7e, 0x5d, 0xcd, 0x96,
-	0x1f, 0x81, 0xca, 0x40, 0x05, 0x43, 0xaa, 0xf8, 0x12, 0xd4, 0x14, 0x97, 0xf1, 0xcd, 0x18, 0x62,
-	0xb0, 0xc7, 0xc0, 0x9c, 0x9c, 0xb7, 0xf5, 0x02, 0xa1, 0xfd, 0x09, 0x9f, 0xf0, 0xb7, 0x9a, 0x6a,
-	0x8e, 0xb7, 0x51, 0x2d, 0x85, 0xe9, 0x40, 0x48, 0xc6, 0x8f, 0xea, 0x5e, 0xd3, 0x6b, 0xaf, 0x86,
-	0xd7, 0x52, 0x98, 0xee, 0x19, 0x1b, 0xdf, 0x41, 0x28, 0x11, 0x71, 0x52, 0x46, 0xaf, 0xd8, 0x68,
-	0xcd, 0x78, 0x6c, 0xb8, 0x75, 0xe6, 0xa1, 0xdb, 0xaf, 0x0d, 0xe3, 0xe7, 0x20, 0x64, 0x9f, 0x4b,
-	0x26, 0x64, 0x1c, 0x3a, 0xda, 0x3d, 0xa9, 0xf3, 0x02, 0x6f, 0xa2, 0xab, 0xe7, 0x81, 0x9d, 0x81,
-	0xeb, 0xa8, 0x4a, 0x19, 0xcb, 0xb9, 0x52, 0x16, 0xb2, 0x16, 0x2e, 0x4c, 0xfc, 0x11, 0x55, 0xcb,
-	0xb6, 0xeb, 0x2b, 0xcd, 0x95, 0xf6, 0xfa, 0xc3, 0x5b, 0xc4, 0xb5, 0x48, 0x4c, 0x8b, 0x0b, 0x29,
-	0x88, 0xb9, 0xae, 0xf3, 0xf8, 0xf8, 0xb4, 0x51, 0xf9, 0x76, 0xd6, 0x68, 0xc7, 0x42, 0x27, 0x93,
-	0x21, 0x89, 0x20, 0x0b, 0x4a, 0x3d, 0xdc, 0xef, 0x81, 0x62, 0xa3, 0x52, 0x62, 0x53, 0xa0, 0xbe,
-	0xfe, 0xfe, 0x7e, 0xcf, 0x0b, 0x17, 0x17, 0xe0, 0x1d, 0x74, 0x3d, 0xca, 0x39, 0xd5, 0x02, 0xe4,
-	0x80, 0x51, 0xcd, 0xeb, 0xab, 0x4d, 0xaf, 0xbd, 0x12, 0x6e, 0x2c, 0x9c, 0x5d, 0xaa, 0x79, 0xab,
-	0x40, 0xdb, 0xcb, 0x06, 0xbb, 0x42, 0xe9, 0x5c, 0x0c, 0x27, 0x26, 0xd8, 0x87, 0x54, 0x44, 0x05,
-	0x3e, 0x40, 0x55, 0x2e, 0x75, 0x2e, 0xb8, 0xaa, 0x7b, 0x96, 0xef, 0x33, 0x72, 0xc9, 0x18, 0xc9,
-	0x85, 0x70, 0x5d, 0x2e, 0x21, 0xb3, 0x92, 0x85, 0x0b, 0xc0, 0xd6, 0x17, 0x0f, 0x35, 0x2e, 0x49,
-	0x36, 0xfa, 0x32, 0x63, 0x59, 0x7d, 0x6b, 0xa1, 0x33, 0x70, 0x82, 0x36, 0xc6, 0x00, 0xe9, 0x60,
-	0xca, 0x45, 0x9c, 0x68, 0x23, 0xb2, 0xa1, 0xd6, 0xfb, 0x3f, 0x6a, 0x7d, 0x80, 0xf4, 0xbd, 0x05,
-	0x72, 0xfc, 0xd6, 0xc7, 0x4b, 0x87, 0x6a, 0x7d, 0x42, 0x77, 0xff, 0xa5, 0x08, 0xdf, 0x44, 0x55,
-	0xcb, 0x48, 0xb0, 0x72, 0x13, 0xd6, 0x8c, 0xb9, 0xc7, 0xf0, 0x53, 0xb4, 0xe6, 0x58, 0xba, 0x4d,
-	0xe8, 0xec, 0x98, 0xa1, 0xfe, 0x3c, 0x6d, 0x6c, 0xbb, 0x11, 0x2a, 0x36, 0x22, 0x02, 0x82, 0x8c,
-	0xea, 0x84, 0xbc, 0xe2, 0x31, 0x8d, 0x8a, 0x2e, 0x8f, 0xc2, 0xb2, 0xa4, 0xb3, 0x7f, 0x3c, 0xf3,
-	0xbd, 0x93, 0x99, 0xef, 0xfd, 0x9a, 0xf9, 0xde, 0xe7, 0xb9, 0x5f, 0x39, 0x99, 0xfb, 0x95, 0x1f,
-	0x73, 0xbf, 0x72, 0xf0, 0xe4, 0xdc, 0x4e, 0xbc, 0xfc, 0xf0, 0xae, 0xf7, 0x86, 0xeb, 0x29, 0xe4,
-	0xa3, 0x20, 0x4a, 0xa8, 0x90, 0xc1, 0xd1, 0x45, 0x6f, 0xd2, 0x2e, 0xca, 0x70, 0xcd, 0x3e, 0x91,
-	0x47, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x06, 0xf2, 0x6f, 0x51, 0xbb, 0x03, 0x00, 0x00,
+	// 660 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0xce, 0x36, 0xf9, 0x25, 0xbf, 0x6c, 0x4b, 0x2b, 0xac, 0x22, 0x42, 0x0b, 0x4e, 0xe4, 0x22,
+	0x35, 0x02, 0x61, 0xab, 0x45, 0x88, 0x03, 0x1c, 0x20, 0x4d, 0x25, 0x2a, 0xfe, 0xa8, 0x35, 0xa8,
+	0x88, 0x5e, 0xa2, 0x8d, 0x3d, 0xd8, 0x4b, 0x6c, 0x6f, 0xe4, 0xdd, 0x24, 0x8d, 0xc4, 0x43, 0xf0,
+	0x0a, 0xdc, 0x10, 0x27, 0x9e, 0x02, 0xf5, 0xd8, 0x23, 0xe2, 0xd0, 0xa2, 0xf6, 0x00, 0x8f, 0x81,
+	0x76, 0xd7, 0x09, 0x91, 0x28, 0xb4, 0xaa, 0xc4, 0x25, 0xd9, 0x99, 0xd9, 0xf9, 0xe6, 0x9b, 0x6f,
+	0x66, 0x8d, 0x6f, 0x76, 0x86, 0x7d, 0x70, 0xe2, 0x5e, 0x24, 0x68, 0xcb, 0x63, 0x34, 0x69, 0xa5,
+	0x30, 0x20, 0xa9, 0xcf, 0x9d, 0xfe, 0x4a, 0x1b, 0x04, 0x59, 0x71, 0xc4, 0xb0, 0x0b, 0xdc, 0xee,
+	0xa6, 0x4c, 0x30, 0xa3, 0x2a, 0x2f, 0xdb, 0xbf, 0x5f, 0xb6, 0xb3, 0xcb, 0x0b, 0x17, 0x49, 0x4c,
+	0x13, 0xe6, 0xa8, 0x5f, 0x9d, 0xb3, 0x60, 0x7a, 0x8c, 0xc7, 0x8c, 0x3b, 0x6d, 0xc2, 0x61, 0x0c,
+	0x2a, 0x93, 0xb3, 0xf8, 0x7c, 0xc0, 0x02, 0xa6, 0x8e, 0x8e, 0x3c, 0x69, 0xaf, 0xf5, 0x08, 0xe3,
+	0xad, 0x1e, 0xf4, 0xe0, 0xb9, 0x20, 0x02, 0x8c, 0x45, 0x5c, 0x8e, 0xd8, 0xa0, 0x45, 0x13, 0x1f,
+	0x76, 0x2b, 0xa8, 0x86, 0xea, 0x05, 0xf7, 0xff, 0x88, 0x0d, 0x36, 0xa4, 0x6d, 0x5c, 0xc3, 0x38,
+	0xa4, 0x41, 0x98, 0x45, 0xa7, 0x54, 0xb4, 0x2c, 0x3d, 0x2a, 0x6c, 0x1d, 0x22, 0x7c, 0xf5, 0xa9,
+	0x64, 0xbc, 0xc6, 0x68, 0xb2, 0x09, 0x89, 0x4f, 0x93, 0xc0, 0xd5, 0xb4, 0xd7, 0x13, 0x91, 0x0e,
+	0x8d, 0x79, 0xfc, 0xdf, 0x24, 0xb0, 0x36, 0x8c, 0x0a, 0x2e, 0x11, 0xdf, 0x4f, 0x81, 0x73, 0x05,
+	0x59, 0x76, 0x47, 0xa6, 0xf1, 0x06, 0x97, 0xb2, 0xb6, 0x2b, 0xf9, 0x5a, 0xbe, 0x3e, 0xbd, 0x7a,
+	0xc5, 0xd6, 0x2d, 0xda, 0xb2, 0xc5, 0x91, 0x14, 0xb6, 0x2c, 0xd7, 0xb8, 0xb3, 0x77, 0x50, 0xcd,
+	0x7d, 0x3c, 0xac, 0xd6, 0x03, 0x2a, 0xc2, 0x5e, 0xdb, 0xf6, 0x58, 0xec, 0x64, 0x7a, 0xe8, 0xbf,
+	0x5b, 0xdc, 0xef, 0x64, 0x12, 0xcb, 0x04, 0xfe, 0xe1, 0xfb, 0xa7, 0x1b, 0xc8, 0x1d, 0x15, 0x30,
+	0x96, 0xf0, 0x05, 0x2f, 0x05, 0x22, 0x28, 0x4b, 0x5a, 0x3e, 0x11, 0x50, 0x29, 0xd4, 0x50, 0x3d,
+	0xef, 0xce, 0x8c, 0x9c, 0x4d, 0x22, 0xc0, 0x1a, 0xe2, 0xc5, 0x71, 0x83, 0x4d, 0xca, 0x45, 0x4a,
+	0xdb, 0x3d, 0x19, 0xdc, 0x64, 0x11, 0xf5, 0x86, 0xc6, 0x0e, 0x2e, 0x41, 0x22, 0x52, 0x0a, 0xbc,
+	0x82, 0x14, 0xdf, 0x07, 0xf6, 0x29, 0x63, 0xb4, 0x4f, 0x84, 0x6b, 0x42, 0xc2, 0x62, 0x25, 0x99,
+	0x3b, 0x02, 0xb4, 0xde, 0x23, 0x5c, 0x3d, 0xe5, 0xb2, 0xd4, 0xd7, 0x97, 0x96, 0xd2, 0xb7, 0xec,
+	0x6a, 0xc3, 0x08, 0xf1, 0x4c, 0x97, 0xb1, 0xa8, 0x35, 0x00, 0x1a, 0x84, 0x42, 0x8a, 0x2c, 0xa9,
+	0xad, 0x9f, 0x8f, 0xda, 0x26, 0x63, 0xd1, 0x4b, 0x05, 0xa4, 0xf9, 0x4d, 0x77, 0xc7, 0x0e, 0x6e,
+	0xbd, 0xc5, 0xd7, 0xcf, 0x92, 0x64, 0x5c, 0xc6, 0x25, 0xc5, 0x88, 0xfa, 0xd9, 0x26, 0x14, 0xa5,
+	0xb9, 0xe1, 0x1b, 0xf7, 0x70, 0x51, 0xb3, 0xd4, 0x9b, 0xd0, 0x58, 0x92, 0x43, 0xfd, 0x7a, 0x50,
+	0x5d, 0xd4, 0x23, 0xe4, 0x7e, 0xc7, 0xa6, 0xcc, 0x89, 0x89, 0x08, 0xed, 0x27, 0x10, 0x10, 0x6f,
+	0xd8, 0x04, 0xcf, 0xcd, 0x52, 0xac, 0x1f, 0x08, 0x5b, 0x7f, 0x99, 0xce, 0x36, 0xa4, 0x9c, 0xb2,
+	0x44, 0xae, 0x5b, 0x5f, 0x1f, 0xb3, 0xe2, 0x23, 0xd3, 0x58, 0xc6, 0x73, 0xc4, 0x13, 0xb4, 0xaf,
+	0x97, 0x40, 0xd0, 0x18, 0x14, 0x8d, 0xbc, 0x3b, 0xfb, 0xcb, 0xfd, 0x82, 0xc6, 0x60, 0xec, 0xe0,
+	0x62, 0x57, 0x61, 0x56, 0xf2, 0x35, 0x54, 0x9f, 0x5e, 0xbd, 0x7f, 0x5e, 0x2d, 0x25, 0x46, 0xa3,
+	0x20, 0x9b, 0x74, 0x33, 0xc4, 0xb3, 0xed, 0xe1, 0x67, 0x84, 0x2f, 0x8d, 0x21, 0xd7, 0x58, 0xd2,
+	0x87, 0x34, 0x93, 0x76, 0xe2, 0x31, 0xa1, 0x3f, 0x3e, 0xa6, 0xa9, 0x7f, 0xfd, 0x98, 0x96, 0xf1,
+	0xdc, 0x6b, 0x42, 0x23, 0xf0, 0x5b, 0x44, 0x08, 0x88, 0xbb, 0x82, 0x2b, 0xa5, 0x0a, 0xee, 0xac,
+	0x76, 0x3f, 0xcc, 0xbc, 0x8d, 0xad, 0xbd, 0x23, 0x13, 0xed, 0x1f, 0x99, 0xe8, 0xdb, 0x91, 0x89,
+	0xde, 0x1d, 0x9b, 0xb9, 0xfd, 0x63, 0x33, 0xf7, 0xe5, 0xd8, 0xcc, 0xed, 0xdc, 0x9d, 0x28, 0xfd,
+	0xf8, 0xd5, 0xf6, 0xfa, 0x33, 0x10, 0x03, 0x96, 0x76, 0x1c, 0x2f, 0x24, 0x34, 0x71, 0x76, 0x4f,
+	0xfa, 0x8e, 0x2a, 0x3e, 0xed, 0xa2, 0xfa, 0xac, 0xdd, 0xfe, 0x39, 0x00, 0xa7, 0x62, 0xd9, 0xc3,
+	0x6f, 0x05, 0x00, 0x00,
 }
 
 func (m *QueueState) Marshal() (dAtA []byte, err error) {
@@ -558,6 +708,103 @@ func (m *MultiCoinDistributionPoolWeightEntry) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
+func (m *MultiCoinDistributionPolicyVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiCoinDistributionPolicyVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiCoinDistributionPolicyVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreationDate != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CreationDate))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ActivationTime != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ActivationTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Version != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MultiCoinConvertEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiCoinConvertEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiCoinConvertEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FailedAttempts != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.FailedAttempts))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -657,6 +904,48 @@ func (m *MultiCoinDistributionPoolWeightEntry) Size() (n int) {
 	return n
 }
 
+func (m *MultiCoinDistributionPolicyVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovTypes(uint64(m.Version))
+	}
+	if m.ActivationTime != 0 {
+		n += 1 + sovTypes(uint64(m.ActivationTime))
+	}
+	l = m.Policy.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.CreationDate != 0 {
+		n += 1 + sovTypes(uint64(m.CreationDate))
+	}
+	return n
+}
+
+func (m *MultiCoinConvertEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.FailedAttempts != 0 {
+		n += 1 + sovTypes(uint64(m.FailedAttempts))
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1208,6 +1497,281 @@ func (m *MultiCoinDistributionPoolWeightEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MultiCoinDistributionPolicyVersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiCoinDistributionPolicyVersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiCoinDistributionPolicyVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationTime", wireType)
+			}
+			m.ActivationTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationDate", wireType)
+			}
+			m.CreationDate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationDate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultiCoinConvertEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiCoinConvertEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiCoinConvertEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedAttempts", wireType)
+			}
+			m.FailedAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedAttempts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
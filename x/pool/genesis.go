@@ -11,6 +11,10 @@ func InitGenesis(ctx sdk.Context, k *keeper.Keeper, genState types.GenesisState)
 	k.SetParams(ctx, genState.Params)
 
 	for _, elem := range genState.PoolList {
+		// Pools exported before the commission limits were introduced
+		// are not bounded.
+		elem.MaxCommission = elem.GetMaxCommissionLimit()
+		elem.MaxCommissionChangeRate = elem.GetMaxCommissionChangeRateLimit()
 		k.SetPool(ctx, elem)
 	}
 
@@ -45,6 +45,8 @@ func (k msgServer) CreatePool(goCtx context.Context, req *types.MsgCreatePool) (
 		UpgradePlan:              &types.UpgradePlan{},
 		CurrentStorageProviderId: req.StorageProviderId,
 		CurrentCompressionId:     req.CompressionId,
+		MaxCommission:            types.DefaultMaxCommission,
+		MaxCommissionChangeRate:  types.DefaultMaxCommissionChangeRate,
 	})
 
 	k.EnsurePoolAccount(ctx, id)
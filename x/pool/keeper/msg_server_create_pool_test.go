@@ -162,6 +162,8 @@ var _ = Describe("msg_server_create_pool.go", Ordered, func() {
 			},
 			CurrentStorageProviderId: 2,
 			CurrentCompressionId:     1,
+			MaxCommission:            types.DefaultMaxCommission,
+			MaxCommissionChangeRate:  types.DefaultMaxCommissionChangeRate,
 		}))
 
 		fundingState, _ := s.App().FundersKeeper.GetFundingState(s.Ctx(), 0)
@@ -270,6 +272,8 @@ var _ = Describe("msg_server_create_pool.go", Ordered, func() {
 			},
 			CurrentStorageProviderId: 2,
 			CurrentCompressionId:     1,
+			MaxCommission:            types.DefaultMaxCommission,
+			MaxCommissionChangeRate:  types.DefaultMaxCommissionChangeRate,
 		}))
 
 		fundingState, _ := s.App().FundersKeeper.GetFundingState(s.Ctx(), 1)
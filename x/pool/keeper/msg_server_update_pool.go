@@ -62,22 +62,30 @@ func (k msgServer) UpdatePool(goCtx context.Context, req *types.MsgUpdatePool) (
 	if update.EndKey != nil {
 		pool.EndKey = *update.EndKey
 	}
+	if update.MaxCommission != nil {
+		pool.MaxCommission = *update.MaxCommission
+	}
+	if update.MaxCommissionChangeRate != nil {
+		pool.MaxCommissionChangeRate = *update.MaxCommissionChangeRate
+	}
 
 	k.SetPool(ctx, pool)
 
 	_ = ctx.EventManager().EmitTypedEvent(&types.EventPoolUpdated{
-		Id:                   pool.Id,
-		RawUpdateString:      req.Payload,
-		Name:                 pool.Name,
-		Runtime:              pool.Runtime,
-		Logo:                 pool.Logo,
-		Config:               pool.Config,
-		UploadInterval:       pool.UploadInterval,
-		InflationShareWeight: pool.InflationShareWeight,
-		MinDelegation:        pool.MinDelegation,
-		MaxBundleSize:        pool.MaxBundleSize,
-		StorageProviderId:    pool.CurrentStorageProviderId,
-		CompressionId:        pool.CurrentCompressionId,
+		Id:                      pool.Id,
+		RawUpdateString:         req.Payload,
+		Name:                    pool.Name,
+		Runtime:                 pool.Runtime,
+		Logo:                    pool.Logo,
+		Config:                  pool.Config,
+		UploadInterval:          pool.UploadInterval,
+		InflationShareWeight:    pool.InflationShareWeight,
+		MinDelegation:           pool.MinDelegation,
+		MaxBundleSize:           pool.MaxBundleSize,
+		StorageProviderId:       pool.CurrentStorageProviderId,
+		CompressionId:           pool.CurrentCompressionId,
+		MaxCommission:           pool.MaxCommission,
+		MaxCommissionChangeRate: pool.MaxCommissionChangeRate,
 	})
 
 	return &types.MsgUpdatePoolResponse{}, nil
@@ -130,6 +130,8 @@ var _ = Describe("msg_server_update_pool.go", Ordered, func() {
 			CurrentStorageProviderId: 2,
 			CurrentCompressionId:     1,
 			EndKey:                   "1",
+			MaxCommission:            types.DefaultMaxCommission,
+			MaxCommissionChangeRate:  types.DefaultMaxCommissionChangeRate,
 		}))
 	})
 
@@ -189,6 +191,8 @@ var _ = Describe("msg_server_update_pool.go", Ordered, func() {
 			CurrentStorageProviderId: 0,
 			CurrentCompressionId:     0,
 			EndKey:                   "",
+			MaxCommission:            types.DefaultMaxCommission,
+			MaxCommissionChangeRate:  types.DefaultMaxCommissionChangeRate,
 		}))
 	})
 
@@ -250,6 +254,8 @@ var _ = Describe("msg_server_update_pool.go", Ordered, func() {
 			CurrentStorageProviderId: 2,
 			CurrentCompressionId:     1,
 			EndKey:                   "1",
+			MaxCommission:            types.DefaultMaxCommission,
+			MaxCommissionChangeRate:  types.DefaultMaxCommissionChangeRate,
 		}))
 	})
 
@@ -336,4 +342,51 @@ var _ = Describe("msg_server_update_pool.go", Ordered, func() {
 		Expect(found).To(BeTrue())
 		Expect(pool.Name).To(BeEmpty())
 	})
+
+	It("Update pool commission limits", func() {
+		// ARRANGE
+		msg := &types.MsgUpdatePool{
+			Authority: gov,
+			Id:        0,
+			Payload:   "{\"MaxCommission\":\"0.2\",\"MaxCommissionChangeRate\":\"0.01\"}",
+		}
+
+		p, v := BuildGovernanceTxs(s, []sdk.Msg{msg})
+
+		// ACT
+		_, submitErr := s.RunTx(&p)
+		_, voteErr := s.RunTx(&v)
+
+		s.CommitAfter(*votingPeriod)
+		s.Commit()
+
+		// ASSERT
+		Expect(submitErr).To(Not(HaveOccurred()))
+		Expect(voteErr).To(Not(HaveOccurred()))
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+
+		Expect(pool.MaxCommission).To(Equal(math.LegacyMustNewDecFromStr("0.2")))
+		Expect(pool.MaxCommissionChangeRate).To(Equal(math.LegacyMustNewDecFromStr("0.01")))
+	})
+
+	It("Update pool with invalid MaxCommission", func() {
+		// ARRANGE
+		msg := &types.MsgUpdatePool{
+			Authority: gov,
+			Id:        0,
+			Payload:   "{\"MaxCommission\":\"1.5\"}",
+		}
+
+		p, _ := BuildGovernanceTxs(s, []sdk.Msg{msg})
+
+		// ACT
+		_ = s.RunTxError(&p)
+		s.Commit()
+
+		// ASSERT
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+
+		Expect(pool.MaxCommission).To(Equal(types.DefaultMaxCommission))
+	})
 })
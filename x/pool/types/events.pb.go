@@ -543,6 +543,12 @@ type EventPoolUpdated struct {
 	// compression_id is the unique id of the compression type the bundles
 	// get compressed with
 	CompressionId uint32 `protobuf:"varint,12,opt,name=compression_id,json=compressionId,proto3" json:"compression_id,omitempty"`
+	// max_commission is the upper bound for the max commission
+	// of validators joining the pool
+	MaxCommission cosmossdk_io_math.LegacyDec `protobuf:"bytes,13,opt,name=max_commission,json=maxCommission,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_commission"`
+	// max_commission_change_rate is the upper bound for the max
+	// commission change rate of validators joining the pool
+	MaxCommissionChangeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,14,opt,name=max_commission_change_rate,json=maxCommissionChangeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_commission_change_rate"`
 }
 
 func (m *EventPoolUpdated) Reset()         { *m = EventPoolUpdated{} }
@@ -734,58 +740,61 @@ func init() {
 func init() { proto.RegisterFile("kyve/pool/v1beta1/events.proto", fileDescriptor_c1828a100d789238) }
 
 var fileDescriptor_c1828a100d789238 = []byte{
-	// 809 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x55, 0xdd, 0x6e, 0xe3, 0x44,
-	0x14, 0xae, 0x53, 0x37, 0x6d, 0xa6, 0x6d, 0x42, 0xcd, 0xb2, 0x98, 0x2e, 0xca, 0x96, 0xac, 0x16,
-	0x0a, 0x17, 0xb6, 0x16, 0xee, 0x91, 0xe8, 0x0f, 0x52, 0x54, 0x84, 0x56, 0x8e, 0x16, 0xb4, 0xdc,
-	0x8c, 0x26, 0x9e, 0x13, 0x67, 0x14, 0x7b, 0xc6, 0x9a, 0x19, 0x27, 0xcd, 0x3e, 0x05, 0x3c, 0x08,
-	0xef, 0xb1, 0x97, 0x2b, 0xc4, 0x05, 0xe2, 0x62, 0x85, 0xda, 0x17, 0x41, 0x33, 0xb6, 0x43, 0xb2,
-	0x35, 0xa8, 0xd7, 0xdc, 0xcd, 0xf9, 0xfb, 0xe6, 0xcc, 0x77, 0xce, 0x67, 0xa3, 0xfe, 0x6c, 0x39,
-	0x87, 0x30, 0x17, 0x22, 0x0d, 0xe7, 0xcf, 0xc6, 0xa0, 0xc9, 0xb3, 0x10, 0xe6, 0xc0, 0xb5, 0x0a,
-	0x72, 0x29, 0xb4, 0xf0, 0x8e, 0x4c, 0x3c, 0x30, 0xf1, 0xa0, 0x8a, 0x1f, 0x3f, 0x48, 0x44, 0x22,
-	0x6c, 0x34, 0x34, 0xa7, 0x32, 0xf1, 0xb8, 0x01, 0x28, 0x27, 0x92, 0x64, 0x15, 0xd0, 0xe0, 0x57,
-	0x07, 0x1d, 0x5d, 0x1a, 0xe4, 0x17, 0x39, 0x25, 0x1a, 0x9e, 0xdb, 0x98, 0xf7, 0x35, 0x42, 0x22,
-	0xa5, 0xb8, 0xcc, 0xf4, 0x9d, 0x13, 0xe7, 0x74, 0xff, 0xcb, 0x8f, 0x82, 0x3b, 0x77, 0x06, 0x65,
-	0xfa, 0x99, 0xfb, 0xfa, 0xed, 0xe3, 0xad, 0xa8, 0x23, 0x52, 0xfa, 0x4f, 0x3d, 0x87, 0x45, 0x5d,
-	0xdf, 0xba, 0x67, 0x3d, 0x87, 0x45, 0x55, 0xef, 0xa3, 0xdd, 0x9c, 0x2c, 0x53, 0x41, 0xa8, 0xbf,
-	0x7d, 0xe2, 0x9c, 0x76, 0xa2, 0xda, 0x1c, 0xfc, 0xe2, 0xa2, 0x9e, 0xed, 0xf7, 0x5c, 0x82, 0xe9,
-	0x57, 0x88, 0xd4, 0xeb, 0xa2, 0x16, 0xa3, 0xb6, 0x4b, 0x37, 0x6a, 0x31, 0xea, 0x79, 0xc8, 0xe5,
-	0x24, 0x03, 0x7b, 0x6f, 0x27, 0xb2, 0x67, 0x83, 0x28, 0x0b, 0xae, 0x59, 0x06, 0x35, 0x62, 0x65,
-	0x9a, 0xec, 0x54, 0x24, 0xc2, 0x77, 0xcb, 0x6c, 0x73, 0xf6, 0x1e, 0xa2, 0x76, 0x2c, 0xf8, 0x84,
-	0x25, 0xfe, 0x8e, 0xf5, 0x56, 0x96, 0xf7, 0x08, 0x75, 0x94, 0x26, 0x52, 0xe3, 0x19, 0x2c, 0xfd,
-	0xb6, 0x0d, 0xed, 0x59, 0xc7, 0x15, 0x2c, 0xbd, 0xcf, 0x50, 0xaf, 0xc8, 0x4d, 0x93, 0x98, 0x71,
-	0x0d, 0x72, 0x4e, 0x52, 0x7f, 0xd7, 0xf6, 0xd4, 0x2d, 0xdd, 0xc3, 0xca, 0xeb, 0xbd, 0x44, 0x0f,
-	0x19, 0x9f, 0xa4, 0x44, 0x33, 0xc1, 0xb1, 0x9a, 0x12, 0x09, 0x78, 0x01, 0x2c, 0x99, 0x6a, 0x7f,
-	0xcf, 0x40, 0x9e, 0x3d, 0x31, 0x74, 0xfc, 0xf9, 0xf6, 0xf1, 0xa3, 0x58, 0xa8, 0x4c, 0x28, 0x45,
-	0x67, 0x01, 0x13, 0x61, 0x46, 0xf4, 0x34, 0xf8, 0x0e, 0x12, 0x12, 0x2f, 0x2f, 0x20, 0x8e, 0x1e,
-	0xac, 0x20, 0x46, 0x06, 0xe1, 0x47, 0x0b, 0xe0, 0x3d, 0x45, 0xdd, 0x8c, 0x71, 0x4c, 0x21, 0x85,
-	0xc4, 0x06, 0xfd, 0x8e, 0x6d, 0xe1, 0x30, 0x63, 0xfc, 0x62, 0xe5, 0xf4, 0x3e, 0x45, 0xbd, 0x8c,
-	0x5c, 0xe3, 0x71, 0xc1, 0x69, 0x0a, 0x58, 0xb1, 0x57, 0xe0, 0xa3, 0x2a, 0x8f, 0x5c, 0x9f, 0x59,
-	0xef, 0x88, 0xbd, 0xb2, 0xac, 0xcd, 0x41, 0x2a, 0x83, 0xb3, 0x5f, 0xb2, 0x56, 0x99, 0xde, 0x31,
-	0xda, 0x1b, 0x33, 0x4e, 0x24, 0x03, 0xe5, 0x1f, 0x94, 0x44, 0xd4, 0xb6, 0x17, 0xa0, 0xf7, 0x95,
-	0x16, 0x92, 0x24, 0x80, 0x73, 0x29, 0xe6, 0x8c, 0x82, 0xc4, 0x8c, 0xfa, 0x87, 0x27, 0xce, 0xe9,
-	0x61, 0x74, 0x54, 0x85, 0x9e, 0x57, 0x91, 0x21, 0x35, 0x4d, 0xc7, 0x22, 0xcb, 0x25, 0x28, 0x03,
-	0x6d, 0x52, 0xbb, 0x36, 0xf5, 0x70, 0xcd, 0x3b, 0xa4, 0xde, 0x87, 0x68, 0x17, 0x38, 0xb5, 0xd4,
-	0xf7, 0xca, 0xa9, 0x00, 0xa7, 0x57, 0xb0, 0x1c, 0x0c, 0xd0, 0x7b, 0x76, 0x25, 0xcc, 0x32, 0x5c,
-	0x72, 0x32, 0x4e, 0x81, 0xbe, 0xbb, 0x13, 0x83, 0x27, 0xd5, 0x9a, 0x9b, 0x9c, 0x0b, 0xa6, 0x9a,
-	0x93, 0x7e, 0x77, 0xd0, 0xc7, 0x36, 0x2b, 0x2a, 0x77, 0xe3, 0x45, 0x9e, 0x48, 0x42, 0x61, 0x14,
-	0x4f, 0x81, 0x16, 0xa6, 0x60, 0x6d, 0x8b, 0x9c, 0xcd, 0x2d, 0x5a, 0x63, 0xaa, 0xb5, 0xc9, 0xd4,
-	0x27, 0xe8, 0x40, 0xd5, 0x00, 0x98, 0x68, 0xbb, 0x7e, 0x6e, 0xb4, 0xbf, 0xf2, 0x7d, 0xa3, 0x0d,
-	0x99, 0xb4, 0x90, 0xe5, 0xbc, 0x5c, 0x1b, 0x5e, 0xd9, 0x1b, 0x44, 0xef, 0xbc, 0x43, 0xf4, 0x53,
-	0xd4, 0x25, 0x93, 0x09, 0xc4, 0x1a, 0x28, 0x36, 0xba, 0x52, 0x7e, 0xfb, 0x64, 0xdb, 0x4c, 0xb1,
-	0xf6, 0x9a, 0xd7, 0xaa, 0x01, 0x6e, 0x7c, 0xd5, 0x39, 0xe1, 0x31, 0xa4, 0xff, 0xfd, 0xaa, 0xbb,
-	0x17, 0xb4, 0x9a, 0x2e, 0xf8, 0x6d, 0x7b, 0x6d, 0x02, 0xe5, 0x87, 0xe4, 0x0e, 0xb9, 0xde, 0x17,
-	0xe8, 0x48, 0x92, 0x05, 0x2e, 0x6c, 0x18, 0x2b, 0x2d, 0x19, 0x4f, 0x2a, 0xae, 0x7a, 0x92, 0x2c,
-	0xca, 0xb2, 0x91, 0x75, 0xaf, 0x14, 0xbc, 0xdd, 0xac, 0x60, 0xb7, 0x59, 0xc1, 0x3b, 0x8d, 0x0a,
-	0x6e, 0x6f, 0x28, 0xf8, 0x7f, 0x28, 0xd2, 0x7f, 0x91, 0xdb, 0xfe, 0xfd, 0xe5, 0x76, 0xd0, 0x20,
-	0xb7, 0xc1, 0x18, 0x7d, 0xb0, 0x9a, 0xe9, 0xb7, 0x05, 0xa7, 0x6a, 0x94, 0x12, 0x35, 0x05, 0xab,
-	0x43, 0xb3, 0x0b, 0x78, 0x35, 0xdd, 0xb6, 0x31, 0x87, 0x76, 0x8f, 0x08, 0xa5, 0x06, 0xa1, 0xd6,
-	0x40, 0x65, 0x9a, 0x69, 0x90, 0x4c, 0x14, 0xbc, 0xde, 0xfe, 0xca, 0x3a, 0x3b, 0x7f, 0x7d, 0xd3,
-	0x77, 0xde, 0xdc, 0xf4, 0x9d, 0xbf, 0x6e, 0xfa, 0xce, 0xcf, 0xb7, 0xfd, 0xad, 0x37, 0xb7, 0xfd,
-	0xad, 0x3f, 0x6e, 0xfb, 0x5b, 0x3f, 0x7d, 0x9e, 0x30, 0x3d, 0x2d, 0xc6, 0x41, 0x2c, 0xb2, 0xf0,
-	0xea, 0xe5, 0x0f, 0x97, 0xdf, 0x83, 0x5e, 0x08, 0x39, 0x0b, 0xe3, 0x29, 0x61, 0x3c, 0xbc, 0x2e,
-	0xff, 0x68, 0x7a, 0x99, 0x83, 0x1a, 0xb7, 0xed, 0x9f, 0xec, 0xab, 0xbf, 0x03, 0x00, 0x00, 0xff,
-	0xff, 0x29, 0x41, 0xad, 0xe9, 0x34, 0x07, 0x00, 0x00,
+	// 852 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x55, 0xcf, 0x6e, 0x1b, 0x45,
+	0x1c, 0xce, 0x3a, 0x8e, 0x13, 0x4f, 0x62, 0x1b, 0x0f, 0xa5, 0x5d, 0x52, 0xe4, 0x06, 0x57, 0x85,
+	0xc0, 0x61, 0xad, 0xc2, 0x1d, 0x89, 0x38, 0x41, 0x0a, 0x41, 0xa8, 0x5a, 0xab, 0xa0, 0x72, 0x19,
+	0xc6, 0x3b, 0xbf, 0xac, 0x47, 0xd9, 0x9d, 0x59, 0xcd, 0xcc, 0xda, 0x71, 0x4f, 0x3c, 0x02, 0x3c,
+	0x08, 0xef, 0xd1, 0x63, 0x0f, 0x1c, 0x10, 0x87, 0x0a, 0x25, 0x2f, 0x82, 0x66, 0x76, 0xbd, 0xc4,
+	0xcd, 0x82, 0x72, 0xee, 0x6d, 0x7f, 0xff, 0xbe, 0xf9, 0xcd, 0x37, 0xdf, 0x67, 0xa3, 0xc1, 0xc5,
+	0x72, 0x0e, 0xa3, 0x4c, 0xca, 0x64, 0x34, 0x7f, 0x3a, 0x05, 0x43, 0x9f, 0x8e, 0x60, 0x0e, 0xc2,
+	0xe8, 0x20, 0x53, 0xd2, 0x48, 0xdc, 0xb7, 0xf5, 0xc0, 0xd6, 0x83, 0xb2, 0xbe, 0x7f, 0x2f, 0x96,
+	0xb1, 0x74, 0xd5, 0x91, 0xfd, 0x2a, 0x1a, 0xf7, 0x6b, 0x80, 0x32, 0xaa, 0x68, 0x5a, 0x02, 0x0d,
+	0x7f, 0xf7, 0x50, 0xff, 0xc4, 0x22, 0x3f, 0xcf, 0x18, 0x35, 0xf0, 0xcc, 0xd5, 0xf0, 0x57, 0x08,
+	0xc9, 0x84, 0x91, 0xa2, 0xd3, 0xf7, 0x0e, 0xbc, 0xc3, 0xdd, 0x2f, 0x3e, 0x0c, 0x6e, 0x9d, 0x19,
+	0x14, 0xed, 0x47, 0xcd, 0x57, 0x6f, 0x1e, 0x6d, 0x84, 0x6d, 0x99, 0xb0, 0x7f, 0xe7, 0x05, 0x2c,
+	0x56, 0xf3, 0x8d, 0x3b, 0xce, 0x0b, 0x58, 0x94, 0xf3, 0x3e, 0xda, 0xce, 0xe8, 0x32, 0x91, 0x94,
+	0xf9, 0x9b, 0x07, 0xde, 0x61, 0x3b, 0x5c, 0x85, 0xc3, 0xdf, 0x9a, 0xa8, 0xe7, 0xf6, 0x1d, 0x2b,
+	0xb0, 0xfb, 0x4a, 0x99, 0xe0, 0x2e, 0x6a, 0x70, 0xe6, 0xb6, 0x6c, 0x86, 0x0d, 0xce, 0x30, 0x46,
+	0x4d, 0x41, 0x53, 0x70, 0xe7, 0xb6, 0x43, 0xf7, 0x6d, 0x11, 0x55, 0x2e, 0x0c, 0x4f, 0x61, 0x85,
+	0x58, 0x86, 0xb6, 0x3b, 0x91, 0xb1, 0xf4, 0x9b, 0x45, 0xb7, 0xfd, 0xc6, 0xf7, 0x51, 0x2b, 0x92,
+	0xe2, 0x9c, 0xc7, 0xfe, 0x96, 0xcb, 0x96, 0x11, 0x7e, 0x88, 0xda, 0xda, 0x50, 0x65, 0xc8, 0x05,
+	0x2c, 0xfd, 0x96, 0x2b, 0xed, 0xb8, 0xc4, 0x19, 0x2c, 0xf1, 0xa7, 0xa8, 0x97, 0x67, 0x76, 0x49,
+	0xc2, 0x85, 0x01, 0x35, 0xa7, 0x89, 0xbf, 0xed, 0x76, 0xea, 0x16, 0xe9, 0xd3, 0x32, 0x8b, 0x5f,
+	0xa0, 0xfb, 0x5c, 0x9c, 0x27, 0xd4, 0x70, 0x29, 0x88, 0x9e, 0x51, 0x05, 0x64, 0x01, 0x3c, 0x9e,
+	0x19, 0x7f, 0xc7, 0x42, 0x1e, 0x3d, 0xb6, 0x74, 0xfc, 0xf5, 0xe6, 0xd1, 0xc3, 0x48, 0xea, 0x54,
+	0x6a, 0xcd, 0x2e, 0x02, 0x2e, 0x47, 0x29, 0x35, 0xb3, 0xe0, 0x3b, 0x88, 0x69, 0xb4, 0x3c, 0x86,
+	0x28, 0xbc, 0x57, 0x41, 0x4c, 0x2c, 0xc2, 0x8f, 0x0e, 0x00, 0x3f, 0x41, 0xdd, 0x94, 0x0b, 0xc2,
+	0x20, 0x81, 0xd8, 0x15, 0xfd, 0xb6, 0x5b, 0xa1, 0x93, 0x72, 0x71, 0x5c, 0x25, 0xf1, 0x27, 0xa8,
+	0x97, 0xd2, 0x4b, 0x32, 0xcd, 0x05, 0x4b, 0x80, 0x68, 0xfe, 0x12, 0x7c, 0x54, 0xf6, 0xd1, 0xcb,
+	0x23, 0x97, 0x9d, 0xf0, 0x97, 0x8e, 0xb5, 0x39, 0x28, 0x6d, 0x71, 0x76, 0x0b, 0xd6, 0xca, 0x10,
+	0xef, 0xa3, 0x9d, 0x29, 0x17, 0x54, 0x71, 0xd0, 0xfe, 0x5e, 0x41, 0xc4, 0x2a, 0xc6, 0x01, 0x7a,
+	0x5f, 0x1b, 0xa9, 0x68, 0x0c, 0x24, 0x53, 0x72, 0xce, 0x19, 0x28, 0xc2, 0x99, 0xdf, 0x39, 0xf0,
+	0x0e, 0x3b, 0x61, 0xbf, 0x2c, 0x3d, 0x2b, 0x2b, 0xa7, 0xcc, 0x2e, 0x1d, 0xc9, 0x34, 0x53, 0xa0,
+	0x2d, 0xb4, 0x6d, 0xed, 0xba, 0xd6, 0xce, 0x8d, 0xec, 0x29, 0xc3, 0x0f, 0xd0, 0x36, 0x08, 0xe6,
+	0xa8, 0xef, 0x15, 0xaf, 0x02, 0x82, 0x9d, 0xc1, 0x72, 0x38, 0x44, 0xef, 0x39, 0x49, 0x58, 0x31,
+	0x9c, 0x08, 0x3a, 0x4d, 0x80, 0xbd, 0xad, 0x89, 0xe1, 0x63, 0xd4, 0xaf, 0x7a, 0x8e, 0xb9, 0xae,
+	0x6f, 0xfa, 0xc3, 0x43, 0x1f, 0xb9, 0xae, 0xb0, 0xd0, 0xc6, 0xf3, 0x2c, 0x56, 0x94, 0xc1, 0x24,
+	0x9a, 0x01, 0xcb, 0xed, 0xc0, 0x0d, 0x15, 0x79, 0xeb, 0x2a, 0xba, 0xc1, 0x54, 0x63, 0x9d, 0xa9,
+	0x8f, 0xd1, 0x9e, 0x5e, 0x01, 0x10, 0x6a, 0x9c, 0xfc, 0x9a, 0xe1, 0x6e, 0x95, 0xfb, 0xda, 0x58,
+	0x32, 0x59, 0xae, 0x8a, 0xf7, 0x6a, 0xba, 0x72, 0x15, 0xaf, 0x11, 0xbd, 0xf5, 0x16, 0xd1, 0x4f,
+	0x50, 0x97, 0x9e, 0x9f, 0x43, 0x64, 0x80, 0x11, 0xeb, 0x2b, 0xed, 0xb7, 0x0e, 0x36, 0xed, 0x2b,
+	0xae, 0xb2, 0xf6, 0xb6, 0x7a, 0x48, 0x6a, 0x6f, 0x35, 0xa6, 0x22, 0x82, 0xe4, 0xff, 0x6f, 0x75,
+	0xfb, 0x80, 0x46, 0xdd, 0x01, 0xbf, 0x6c, 0xdd, 0x78, 0x81, 0xe2, 0x87, 0xe4, 0x16, 0xb9, 0xf8,
+	0x73, 0xd4, 0x57, 0x74, 0x41, 0x72, 0x57, 0x26, 0xda, 0x28, 0x2e, 0xe2, 0x92, 0xab, 0x9e, 0xa2,
+	0x8b, 0x62, 0x6c, 0xe2, 0xd2, 0x95, 0x83, 0x37, 0xeb, 0x1d, 0xdc, 0xac, 0x77, 0xf0, 0x56, 0xad,
+	0x83, 0x5b, 0x6b, 0x0e, 0x7e, 0x07, 0x4d, 0xfa, 0x1f, 0x76, 0xdb, 0xbd, 0xbb, 0xdd, 0xf6, 0xea,
+	0xec, 0xf6, 0x2d, 0xea, 0xda, 0xe3, 0x23, 0x99, 0xa6, 0xdc, 0xe5, 0xfc, 0xce, 0xdd, 0x2f, 0x6e,
+	0x57, 0x1c, 0x57, 0x93, 0xf8, 0x67, 0xb4, 0xbf, 0x8e, 0x45, 0xa2, 0x19, 0x15, 0x31, 0x10, 0x45,
+	0x0d, 0xf8, 0xdd, 0xbb, 0xe3, 0x3e, 0x58, 0xc3, 0x1d, 0x3b, 0x90, 0x90, 0x1a, 0x18, 0x4e, 0xd1,
+	0x07, 0x95, 0x02, 0xbf, 0xc9, 0x05, 0xd3, 0x93, 0x84, 0xea, 0x19, 0xb8, 0x5f, 0x0d, 0xab, 0x5c,
+	0x52, 0x69, 0xb1, 0x65, 0xc3, 0x53, 0xa7, 0x7a, 0xca, 0x98, 0xbd, 0xef, 0xca, 0xb1, 0x65, 0x68,
+	0xb5, 0x43, 0x53, 0x99, 0x8b, 0x95, 0x57, 0xcb, 0xe8, 0x68, 0xfc, 0xea, 0x6a, 0xe0, 0xbd, 0xbe,
+	0x1a, 0x78, 0x7f, 0x5f, 0x0d, 0xbc, 0x5f, 0xaf, 0x07, 0x1b, 0xaf, 0xaf, 0x07, 0x1b, 0x7f, 0x5e,
+	0x0f, 0x36, 0x7e, 0xfa, 0x2c, 0xe6, 0x66, 0x96, 0x4f, 0x83, 0x48, 0xa6, 0xa3, 0xb3, 0x17, 0x3f,
+	0x9c, 0x7c, 0x0f, 0x66, 0x21, 0xd5, 0xc5, 0x28, 0x9a, 0x51, 0x2e, 0x46, 0x97, 0xc5, 0xff, 0xaf,
+	0x59, 0x66, 0xa0, 0xa7, 0x2d, 0xf7, 0xbf, 0xfb, 0xe5, 0x3f, 0x03, 0x00, 0x04, 0x7b, 0x73, 0x01,
+	0xe2, 0x07, 0x00, 0x00,
 }
 
 func (m *EventUpdateParams) Marshal() (dAtA []byte, err error) {
@@ -1153,6 +1162,26 @@ func (m *EventPoolUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxCommissionChangeRate.Size()
+		i -= size
+		if _, err := m.MaxCommissionChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.MaxCommission.Size()
+		i -= size
+		if _, err := m.MaxCommission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
 	if m.CompressionId != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.CompressionId))
 		i--
@@ -1484,6 +1513,10 @@ func (m *EventPoolUpdated) Size() (n int) {
 	if m.CompressionId != 0 {
 		n += 1 + sovEvents(uint64(m.CompressionId))
 	}
+	l = m.MaxCommission.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.MaxCommissionChangeRate.Size()
+	n += 1 + l + sovEvents(uint64(l))
 	return n
 }
 
@@ -3007,6 +3040,74 @@ func (m *EventPoolUpdated) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxCommission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommissionChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxCommissionChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
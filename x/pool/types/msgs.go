@@ -61,17 +61,19 @@ func (msg *MsgUpdatePool) GetSigners() []sdk.AccAddress {
 
 // PoolUpdate ...
 type PoolUpdate struct {
-	Name                 *string
-	Runtime              *string
-	Logo                 *string
-	Config               *string
-	UploadInterval       *uint64
-	InflationShareWeight *math.LegacyDec
-	MinDelegation        *uint64
-	MaxBundleSize        *uint64
-	StorageProviderId    *uint32
-	CompressionId        *uint32
-	EndKey               *string
+	Name                    *string
+	Runtime                 *string
+	Logo                    *string
+	Config                  *string
+	UploadInterval          *uint64
+	InflationShareWeight    *math.LegacyDec
+	MinDelegation           *uint64
+	MaxBundleSize           *uint64
+	StorageProviderId       *uint32
+	CompressionId           *uint32
+	EndKey                  *string
+	MaxCommission           *math.LegacyDec
+	MaxCommissionChangeRate *math.LegacyDec
 }
 
 // ValidateBasic does a sanity check on the provided data.
@@ -109,6 +111,18 @@ func (msg *MsgUpdatePool) ValidateBasic() error {
 		}
 	}
 
+	if payload.MaxCommission != nil {
+		if err := util.ValidatePercentage(*payload.MaxCommission); err != nil {
+			return errors.Wrapf(errorsTypes.ErrInvalidRequest, "invalid max commission")
+		}
+	}
+
+	if payload.MaxCommissionChangeRate != nil {
+		if err := util.ValidatePercentage(*payload.MaxCommissionChangeRate); err != nil {
+			return errors.Wrapf(errorsTypes.ErrInvalidRequest, "invalid max commission change rate")
+		}
+	}
+
 	return nil
 }
 
@@ -3,10 +3,38 @@ package types
 import (
 	"fmt"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
+// DefaultMaxCommission is the max commission limit a new pool is created with
+var DefaultMaxCommission = math.LegacyOneDec()
+
+// DefaultMaxCommissionChangeRate is the max commission change rate limit
+// a new pool is created with
+var DefaultMaxCommissionChangeRate = math.LegacyOneDec()
+
+// GetMaxCommissionLimit returns the max commission limit of the pool. Pools
+// without a limit (e.g. imported via genesis) are not bounded.
+func (m *Pool) GetMaxCommissionLimit() math.LegacyDec {
+	if m.MaxCommission.IsNil() {
+		return DefaultMaxCommission
+	}
+
+	return m.MaxCommission
+}
+
+// GetMaxCommissionChangeRateLimit returns the max commission change rate limit
+// of the pool. Pools without a limit (e.g. imported via genesis) are not bounded.
+func (m *Pool) GetMaxCommissionChangeRateLimit() math.LegacyDec {
+	if m.MaxCommissionChangeRate.IsNil() {
+		return DefaultMaxCommissionChangeRate
+	}
+
+	return m.MaxCommissionChangeRate
+}
+
 func (m *Pool) GetPoolAccount() sdk.AccAddress {
 	name := fmt.Sprintf("%s/%d", ModuleName, m.Id)
 
@@ -272,6 +272,12 @@ type Pool struct {
 	// end_key is the last key before the pool should stop indexing, it is
 	// inclusive
 	EndKey string `protobuf:"bytes,20,opt,name=end_key,json=endKey,proto3" json:"end_key,omitempty"`
+	// max_commission is the upper bound for the max commission
+	// validators can choose when joining this pool
+	MaxCommission cosmossdk_io_math.LegacyDec `protobuf:"bytes,21,opt,name=max_commission,json=maxCommission,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_commission"`
+	// max_commission_change_rate is the upper bound for the max
+	// commission change rate validators can choose when joining this pool
+	MaxCommissionChangeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,22,opt,name=max_commission_change_rate,json=maxCommissionChangeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_commission_change_rate"`
 }
 
 func (m *Pool) Reset()         { *m = Pool{} }
@@ -450,61 +456,64 @@ func init() {
 func init() { proto.RegisterFile("kyve/pool/v1beta1/pool.proto", fileDescriptor_40c1730f47ff2ef8) }
 
 var fileDescriptor_40c1730f47ff2ef8 = []byte{
-	// 855 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcf, 0x8f, 0xda, 0x46,
-	0x14, 0xc6, 0x84, 0xb0, 0x30, 0xec, 0xb2, 0x64, 0x4a, 0x37, 0xd3, 0xa5, 0x62, 0xc9, 0x46, 0x69,
-	0x69, 0x0f, 0xa0, 0xb4, 0x95, 0x7a, 0xea, 0x81, 0xc5, 0x0e, 0x6b, 0xed, 0x0a, 0x90, 0x0d, 0x1b,
-	0x6d, 0x2f, 0xa3, 0xc1, 0x33, 0x31, 0xa3, 0xb5, 0x3d, 0x96, 0x3d, 0x26, 0x4b, 0x8e, 0x95, 0x2a,
-	0xf5, 0xd8, 0x73, 0xaf, 0xfd, 0x67, 0x72, 0xcc, 0xb1, 0xea, 0x21, 0xaa, 0x76, 0xff, 0x91, 0xca,
-	0x63, 0x43, 0x49, 0xda, 0x53, 0x6f, 0xef, 0x7d, 0xdf, 0xf7, 0x7e, 0x79, 0xde, 0x33, 0xf8, 0xfc,
-	0x66, 0xbd, 0x62, 0xfd, 0x50, 0x08, 0xaf, 0xbf, 0x7a, 0xbe, 0x60, 0x92, 0x3c, 0x57, 0x4e, 0x2f,
-	0x8c, 0x84, 0x14, 0xf0, 0x51, 0xca, 0xf6, 0x14, 0x90, 0xb3, 0xc7, 0x4d, 0x57, 0xb8, 0x42, 0xb1,
-	0xfd, 0xd4, 0xca, 0x84, 0xa7, 0x0e, 0xa8, 0x4c, 0x53, 0xc3, 0x11, 0x1e, 0x44, 0x60, 0x6f, 0xc5,
-	0xa2, 0x98, 0x8b, 0x00, 0x69, 0x1d, 0xad, 0x5b, 0xb5, 0x36, 0x2e, 0x3c, 0x06, 0x95, 0x05, 0x0f,
-	0x48, 0xc4, 0x59, 0x8c, 0x8a, 0x8a, 0xda, 0xfa, 0xf0, 0x09, 0xd8, 0xf7, 0x48, 0x2c, 0x71, 0x12,
-	0xba, 0x11, 0xa1, 0x0c, 0x3d, 0xe8, 0x68, 0xdd, 0x92, 0x55, 0x4b, 0xb1, 0x79, 0x06, 0x9d, 0xfe,
-	0xa4, 0x81, 0x5a, 0x6e, 0x4f, 0x3d, 0x12, 0xfc, 0xff, 0x42, 0xb1, 0xb3, 0x64, 0x34, 0xf1, 0x18,
-	0xc5, 0x44, 0x6e, 0x0a, 0x6d, 0xb1, 0x81, 0x4c, 0xc3, 0x69, 0x12, 0x11, 0x99, 0x66, 0x2e, 0x29,
-	0x7a, 0xeb, 0x9f, 0xfe, 0x56, 0x06, 0xa5, 0xa9, 0x10, 0x1e, 0xac, 0x83, 0x22, 0xa7, 0xaa, 0x70,
-	0xc9, 0x2a, 0x72, 0x0a, 0x21, 0x28, 0x05, 0xc4, 0x67, 0x79, 0x3d, 0x65, 0xa7, 0x1d, 0x46, 0x49,
-	0x20, 0xb9, 0x9f, 0xcd, 0x53, 0xb5, 0x36, 0x6e, 0xaa, 0xf6, 0x84, 0x2b, 0x54, 0xfa, 0xaa, 0xa5,
-	0x6c, 0x78, 0x04, 0xca, 0x8e, 0x08, 0x5e, 0x71, 0x17, 0x3d, 0x54, 0x68, 0xee, 0xc1, 0x16, 0xa8,
-	0xc6, 0x92, 0x44, 0x12, 0xdf, 0xb0, 0x35, 0x2a, 0x67, 0xe3, 0x28, 0xe0, 0x82, 0xad, 0xe1, 0x09,
-	0xa8, 0x39, 0x49, 0x14, 0xb1, 0x20, 0xa3, 0xf7, 0x14, 0x0d, 0x72, 0x28, 0x15, 0x7c, 0x09, 0x0e,
-	0x37, 0x82, 0x38, 0xf1, 0x7d, 0x12, 0xad, 0x51, 0x45, 0x89, 0xea, 0x39, 0x6c, 0x67, 0x28, 0x7c,
-	0x0a, 0x0e, 0x36, 0x42, 0x1e, 0x50, 0x76, 0x8b, 0xaa, 0x6a, 0xb6, 0xfd, 0x1c, 0x34, 0x53, 0x2c,
-	0x15, 0x49, 0x21, 0x89, 0x87, 0x17, 0x49, 0x40, 0x3d, 0x16, 0x23, 0x90, 0x89, 0x14, 0x78, 0x96,
-	0x61, 0x69, 0xc9, 0x24, 0xf4, 0x04, 0xa1, 0x98, 0x07, 0x92, 0x45, 0x2b, 0xe2, 0xa1, 0x9a, 0x92,
-	0xd5, 0x33, 0xd8, 0xcc, 0x51, 0x78, 0x0d, 0x8e, 0x78, 0xf0, 0xca, 0x53, 0x5f, 0x16, 0xc7, 0x4b,
-	0x12, 0x31, 0xfc, 0x9a, 0x71, 0x77, 0x29, 0xd1, 0x7e, 0xda, 0xe2, 0xd9, 0xd3, 0xb7, 0xef, 0x4f,
-	0x0a, 0x7f, 0xbe, 0x3f, 0x69, 0x39, 0x22, 0xf6, 0x45, 0x1c, 0xd3, 0x9b, 0x1e, 0x17, 0x7d, 0x9f,
-	0xc8, 0x65, 0xef, 0x92, 0xb9, 0xc4, 0x59, 0xeb, 0xcc, 0xb1, 0x9a, 0xdb, 0x14, 0x76, 0x9a, 0xe1,
-	0xa5, 0x4a, 0x00, 0x9f, 0x81, 0xba, 0xcf, 0x03, 0x4c, 0x99, 0xc7, 0xdc, 0xec, 0x25, 0x0f, 0x54,
-	0x0b, 0x07, 0x3e, 0x0f, 0xf4, 0x2d, 0x08, 0xbf, 0x00, 0x87, 0x3e, 0xb9, 0xcd, 0xa7, 0xc1, 0x31,
-	0x7f, 0xc3, 0x50, 0x3d, 0xd7, 0x91, 0xdb, 0x6c, 0x1e, 0x9b, 0xbf, 0x61, 0x6a, 0x25, 0x78, 0x4c,
-	0x16, 0x1e, 0xa3, 0xe8, 0xb0, 0xa3, 0x75, 0x2b, 0xd6, 0xd6, 0x87, 0xdf, 0x83, 0x4a, 0x98, 0x2f,
-	0x3f, 0x6a, 0x74, 0xb4, 0x6e, 0xed, 0x9b, 0x56, 0xef, 0x5f, 0x87, 0xd3, 0xdb, 0xdc, 0x87, 0xb5,
-	0x15, 0xc3, 0x01, 0xd8, 0xcf, 0xd7, 0x1d, 0x87, 0x1e, 0x09, 0xd0, 0x23, 0x15, 0xdc, 0xfe, 0x8f,
-	0xe0, 0x9d, 0xb5, 0xb7, 0x6a, 0xc9, 0xce, 0x0d, 0xfc, 0x00, 0x5a, 0xdb, 0xd7, 0x95, 0x22, 0x22,
-	0x2e, 0xc3, 0x61, 0x24, 0x56, 0x9c, 0xb2, 0x08, 0x73, 0x8a, 0x60, 0x47, 0xeb, 0x1e, 0x58, 0x68,
-	0xf3, 0xd2, 0x99, 0x62, 0x9a, 0x0b, 0x4c, 0x0a, 0xbf, 0x03, 0x47, 0x9b, 0x70, 0x47, 0xf8, 0x61,
-	0xc4, 0xe2, 0xf4, 0x7e, 0xd2, 0xc8, 0x4f, 0x54, 0x64, 0x33, 0x67, 0x87, 0xff, 0x90, 0x26, 0x85,
-	0x8f, 0xc1, 0x1e, 0x0b, 0xa8, 0xda, 0xb7, 0x66, 0xb6, 0xa9, 0x2c, 0xa0, 0x17, 0x6c, 0xfd, 0xf5,
-	0xcf, 0x45, 0x00, 0xd2, 0xe3, 0xb0, 0x25, 0x91, 0x49, 0x0c, 0x5b, 0xe0, 0xf1, 0x74, 0x32, 0xb9,
-	0xc4, 0xf6, 0x6c, 0x30, 0x9b, 0xdb, 0x78, 0x3e, 0xb6, 0xa7, 0xc6, 0xd0, 0x7c, 0x61, 0x1a, 0x7a,
-	0xa3, 0x00, 0x8f, 0x00, 0xdc, 0x25, 0x07, 0xc3, 0x99, 0x79, 0x65, 0x34, 0x34, 0x88, 0x40, 0x73,
-	0x17, 0xd7, 0x4d, 0x7b, 0x70, 0x76, 0x69, 0xe8, 0x8d, 0xe2, 0xc7, 0xcc, 0x78, 0x82, 0x5f, 0xcc,
-	0xc7, 0xba, 0xdd, 0x78, 0x00, 0x9f, 0x81, 0x27, 0x1f, 0x32, 0x33, 0x6c, 0x8c, 0x27, 0xf3, 0xd1,
-	0x39, 0xd6, 0x8d, 0x4b, 0x63, 0x34, 0x98, 0x99, 0x93, 0x71, 0xa3, 0x04, 0x3f, 0x03, 0x9f, 0x7e,
-	0xd0, 0xcf, 0x74, 0x64, 0x0d, 0x74, 0x73, 0x3c, 0x6a, 0x3c, 0xfc, 0x38, 0xc3, 0xd5, 0x64, 0x66,
-	0x8e, 0x47, 0x78, 0x3a, 0x79, 0x69, 0x58, 0x78, 0x36, 0x99, 0xe0, 0x73, 0x73, 0x74, 0xde, 0x28,
-	0xc3, 0x13, 0xd0, 0xda, 0x95, 0x19, 0x63, 0x1d, 0x5f, 0x18, 0xd7, 0xd8, 0x32, 0x06, 0xc3, 0x73,
-	0x43, 0x6f, 0xec, 0x1d, 0x97, 0x7e, 0xf9, 0xbd, 0x5d, 0x38, 0x1b, 0xbe, 0xbd, 0x6b, 0x6b, 0xef,
-	0xee, 0xda, 0xda, 0x5f, 0x77, 0x6d, 0xed, 0xd7, 0xfb, 0x76, 0xe1, 0xdd, 0x7d, 0xbb, 0xf0, 0xc7,
-	0x7d, 0xbb, 0xf0, 0xe3, 0x57, 0x2e, 0x97, 0xcb, 0x64, 0xd1, 0x73, 0x84, 0xdf, 0xbf, 0xb8, 0xbe,
-	0x32, 0xc6, 0x4c, 0xbe, 0x16, 0xd1, 0x4d, 0xdf, 0x59, 0x12, 0x1e, 0xf4, 0x6f, 0xb3, 0x3f, 0xb1,
-	0x5c, 0x87, 0x2c, 0x5e, 0x94, 0xd5, 0x9e, 0x7c, 0xfb, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf5,
-	0x7e, 0x8c, 0x21, 0xa3, 0x05, 0x00, 0x00,
+	// 899 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x41, 0x6f, 0xdb, 0x36,
+	0x14, 0xb6, 0x52, 0x37, 0xb6, 0xe9, 0xc4, 0x71, 0x39, 0xd7, 0xe1, 0xe2, 0xc1, 0x71, 0x53, 0x74,
+	0xf3, 0x76, 0xb0, 0xd1, 0x6d, 0xc0, 0x4e, 0x3b, 0x38, 0x96, 0xea, 0x68, 0x09, 0x6c, 0x43, 0xb6,
+	0x53, 0x64, 0x17, 0x8e, 0x96, 0x58, 0x99, 0x88, 0x24, 0x1a, 0x12, 0xe5, 0xc6, 0x3d, 0x0e, 0x18,
+	0xb0, 0xe3, 0xfe, 0xc3, 0xfe, 0x4c, 0x8e, 0x3d, 0x0e, 0x3b, 0x14, 0x43, 0xf2, 0x47, 0x06, 0x52,
+	0xb2, 0x9a, 0x74, 0x3b, 0x14, 0xbd, 0xbd, 0xf7, 0x7d, 0xdf, 0xfb, 0x1e, 0x9f, 0xf8, 0x08, 0x81,
+	0x2f, 0x2e, 0xd7, 0x2b, 0xda, 0x5d, 0x72, 0xee, 0x75, 0x57, 0xcf, 0xe7, 0x54, 0x90, 0xe7, 0x2a,
+	0xe9, 0x2c, 0x43, 0x2e, 0x38, 0x7c, 0x24, 0xd9, 0x8e, 0x02, 0x52, 0xf6, 0xa0, 0xe6, 0x72, 0x97,
+	0x2b, 0xb6, 0x2b, 0xa3, 0x44, 0x78, 0x64, 0x83, 0xe2, 0x58, 0x06, 0x36, 0xf7, 0x20, 0x02, 0x85,
+	0x15, 0x0d, 0x23, 0xc6, 0x03, 0xa4, 0xb5, 0xb4, 0x76, 0xc9, 0xda, 0xa4, 0xf0, 0x00, 0x14, 0xe7,
+	0x2c, 0x20, 0x21, 0xa3, 0x11, 0xda, 0x52, 0x54, 0x96, 0xc3, 0x27, 0x60, 0xc7, 0x23, 0x91, 0xc0,
+	0xf1, 0xd2, 0x0d, 0x89, 0x43, 0xd1, 0x83, 0x96, 0xd6, 0xce, 0x5b, 0x65, 0x89, 0xcd, 0x12, 0xe8,
+	0xe8, 0x57, 0x0d, 0x94, 0xd3, 0x78, 0xec, 0x91, 0xe0, 0xd3, 0x1b, 0x45, 0xf6, 0x82, 0x3a, 0xb1,
+	0x47, 0x1d, 0x4c, 0xc4, 0xa6, 0x51, 0x86, 0xf5, 0x84, 0x2c, 0x77, 0xe2, 0x90, 0x08, 0xe9, 0x9c,
+	0x57, 0x74, 0x96, 0x1f, 0x5d, 0x17, 0x40, 0x7e, 0xcc, 0xb9, 0x07, 0x2b, 0x60, 0x8b, 0x39, 0xaa,
+	0x71, 0xde, 0xda, 0x62, 0x0e, 0x84, 0x20, 0x1f, 0x10, 0x9f, 0xa6, 0xfd, 0x54, 0x2c, 0x4f, 0x18,
+	0xc6, 0x81, 0x60, 0x7e, 0x32, 0x4f, 0xc9, 0xda, 0xa4, 0x52, 0xed, 0x71, 0x97, 0x2b, 0xfb, 0x92,
+	0xa5, 0x62, 0x58, 0x07, 0xdb, 0x36, 0x0f, 0x5e, 0x31, 0x17, 0x3d, 0x54, 0x68, 0x9a, 0xc1, 0x06,
+	0x28, 0x45, 0x82, 0x84, 0x02, 0x5f, 0xd2, 0x35, 0xda, 0x4e, 0xc6, 0x51, 0xc0, 0x29, 0x5d, 0xc3,
+	0x43, 0x50, 0xb6, 0xe3, 0x30, 0xa4, 0x41, 0x42, 0x17, 0x14, 0x0d, 0x52, 0x48, 0x0a, 0xbe, 0x02,
+	0x7b, 0x1b, 0x41, 0x14, 0xfb, 0x3e, 0x09, 0xd7, 0xa8, 0xa8, 0x44, 0x95, 0x14, 0x9e, 0x24, 0x28,
+	0x7c, 0x0a, 0x76, 0x37, 0x42, 0x16, 0x38, 0xf4, 0x0a, 0x95, 0xd4, 0x6c, 0x3b, 0x29, 0x68, 0x4a,
+	0x4c, 0x8a, 0x04, 0x17, 0xc4, 0xc3, 0xf3, 0x38, 0x70, 0x3c, 0x1a, 0x21, 0x90, 0x88, 0x14, 0x78,
+	0x9c, 0x60, 0xb2, 0x65, 0xbc, 0xf4, 0x38, 0x71, 0x30, 0x0b, 0x04, 0x0d, 0x57, 0xc4, 0x43, 0x65,
+	0x25, 0xab, 0x24, 0xb0, 0x99, 0xa2, 0xf0, 0x02, 0xd4, 0x59, 0xf0, 0xca, 0x53, 0x5f, 0x16, 0x47,
+	0x0b, 0x12, 0x52, 0xfc, 0x9a, 0x32, 0x77, 0x21, 0xd0, 0x8e, 0x3c, 0xe2, 0xf1, 0xd3, 0xeb, 0x77,
+	0x87, 0xb9, 0xbf, 0xdf, 0x1d, 0x36, 0x6c, 0x1e, 0xf9, 0x3c, 0x8a, 0x9c, 0xcb, 0x0e, 0xe3, 0x5d,
+	0x9f, 0x88, 0x45, 0xe7, 0x8c, 0xba, 0xc4, 0x5e, 0xeb, 0xd4, 0xb6, 0x6a, 0x99, 0xc5, 0x44, 0x3a,
+	0xbc, 0x54, 0x06, 0xf0, 0x19, 0xa8, 0xf8, 0x2c, 0xc0, 0x0e, 0xf5, 0xa8, 0x9b, 0xdc, 0xe4, 0xae,
+	0x3a, 0xc2, 0xae, 0xcf, 0x02, 0x3d, 0x03, 0xe1, 0x97, 0x60, 0xcf, 0x27, 0x57, 0xe9, 0x34, 0x38,
+	0x62, 0x6f, 0x28, 0xaa, 0xa4, 0x3a, 0x72, 0x95, 0xcc, 0x33, 0x61, 0x6f, 0xa8, 0x5a, 0x09, 0x16,
+	0x91, 0xb9, 0x47, 0x1d, 0xb4, 0xd7, 0xd2, 0xda, 0x45, 0x2b, 0xcb, 0xe1, 0x0f, 0xa0, 0xb8, 0x4c,
+	0x97, 0x1f, 0x55, 0x5b, 0x5a, 0xbb, 0xfc, 0x6d, 0xa3, 0xf3, 0x9f, 0x87, 0xd3, 0xd9, 0xbc, 0x0f,
+	0x2b, 0x13, 0xc3, 0x1e, 0xd8, 0x49, 0xd7, 0x1d, 0x2f, 0x3d, 0x12, 0xa0, 0x47, 0xaa, 0xb8, 0xf9,
+	0x3f, 0xc5, 0x77, 0xd6, 0xde, 0x2a, 0xc7, 0xef, 0x13, 0xf8, 0x23, 0x68, 0x64, 0xb7, 0x2b, 0x78,
+	0x48, 0x5c, 0x8a, 0x97, 0x21, 0x5f, 0x31, 0x87, 0x86, 0x98, 0x39, 0x08, 0xb6, 0xb4, 0xf6, 0xae,
+	0x85, 0x36, 0x37, 0x9d, 0x28, 0xc6, 0xa9, 0xc0, 0x74, 0xe0, 0xf7, 0xa0, 0xbe, 0x29, 0xb7, 0xb9,
+	0xbf, 0x0c, 0x69, 0x24, 0xdf, 0x8f, 0xac, 0xfc, 0x4c, 0x55, 0xd6, 0x52, 0xb6, 0xff, 0x9e, 0x34,
+	0x1d, 0xb8, 0x0f, 0x0a, 0x34, 0x70, 0xd4, 0xbe, 0xd5, 0x92, 0x4d, 0xa5, 0x81, 0x23, 0x77, 0xed,
+	0x27, 0x50, 0x91, 0x5f, 0xd3, 0xe6, 0xbe, 0xcf, 0x94, 0x18, 0x3d, 0xfe, 0xf8, 0x7b, 0x94, 0x5f,
+	0xbc, 0x9f, 0x55, 0xc2, 0x5f, 0xc0, 0xc1, 0x7d, 0x2f, 0x6c, 0x2f, 0x48, 0xe0, 0x52, 0x1c, 0x12,
+	0x41, 0x51, 0xfd, 0xe3, 0x7d, 0xf7, 0xef, 0xf9, 0xf6, 0x95, 0x89, 0x45, 0x04, 0xfd, 0xe6, 0xb7,
+	0x2d, 0x00, 0xe4, 0x53, 0x9e, 0x08, 0x22, 0xe2, 0x08, 0x36, 0xc0, 0xfe, 0x78, 0x34, 0x3a, 0xc3,
+	0x93, 0x69, 0x6f, 0x3a, 0x9b, 0xe0, 0xd9, 0x70, 0x32, 0x36, 0xfa, 0xe6, 0x0b, 0xd3, 0xd0, 0xab,
+	0x39, 0x58, 0x07, 0xf0, 0x2e, 0xd9, 0xeb, 0x4f, 0xcd, 0x73, 0xa3, 0xaa, 0x41, 0x04, 0x6a, 0x77,
+	0x71, 0xdd, 0x9c, 0xf4, 0x8e, 0xcf, 0x0c, 0xbd, 0xba, 0xf5, 0x21, 0x33, 0x1c, 0xe1, 0x17, 0xb3,
+	0xa1, 0x3e, 0xa9, 0x3e, 0x80, 0xcf, 0xc0, 0x93, 0xfb, 0xcc, 0x14, 0x1b, 0xc3, 0xd1, 0x6c, 0x70,
+	0x82, 0x75, 0xe3, 0xcc, 0x18, 0xf4, 0xa6, 0xe6, 0x68, 0x58, 0xcd, 0xc3, 0xcf, 0xc1, 0xe3, 0x7b,
+	0xe7, 0x19, 0x0f, 0xac, 0x9e, 0x6e, 0x0e, 0x07, 0xd5, 0x87, 0x1f, 0x3a, 0x9c, 0x8f, 0xa6, 0xe6,
+	0x70, 0x80, 0xc7, 0xa3, 0x97, 0x86, 0x85, 0xa7, 0xa3, 0x11, 0x3e, 0x31, 0x07, 0x27, 0xd5, 0x6d,
+	0x78, 0x08, 0x1a, 0x77, 0x65, 0xc6, 0x50, 0xc7, 0xa7, 0xc6, 0x05, 0xb6, 0x8c, 0x5e, 0xff, 0xc4,
+	0xd0, 0xab, 0x85, 0x83, 0xfc, 0xef, 0x7f, 0x36, 0x73, 0xc7, 0xfd, 0xeb, 0x9b, 0xa6, 0xf6, 0xf6,
+	0xa6, 0xa9, 0xfd, 0x73, 0xd3, 0xd4, 0xfe, 0xb8, 0x6d, 0xe6, 0xde, 0xde, 0x36, 0x73, 0x7f, 0xdd,
+	0x36, 0x73, 0x3f, 0x7f, 0xed, 0x32, 0xb1, 0x88, 0xe7, 0x1d, 0x9b, 0xfb, 0xdd, 0xd3, 0x8b, 0x73,
+	0x63, 0x48, 0xc5, 0x6b, 0x1e, 0x5e, 0x76, 0xed, 0x05, 0x61, 0x41, 0xf7, 0x2a, 0xf9, 0x6f, 0x88,
+	0xf5, 0x92, 0x46, 0xf3, 0x6d, 0xb5, 0xd5, 0xdf, 0xfd, 0x3b, 0x00, 0xb5, 0xca, 0xe1, 0xde, 0x51,
+	0x06, 0x00, 0x00,
 }
 
 func (m *Protocol) Marshal() (dAtA []byte, err error) {
@@ -616,6 +625,30 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxCommissionChangeRate.Size()
+		i -= size
+		if _, err := m.MaxCommissionChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb2
+	{
+		size := m.MaxCommission.Size()
+		i -= size
+		if _, err := m.MaxCommission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
 	if len(m.EndKey) > 0 {
 		i -= len(m.EndKey)
 		copy(dAtA[i:], m.EndKey)
@@ -898,6 +931,10 @@ func (m *Pool) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovPool(uint64(l))
 	}
+	l = m.MaxCommission.Size()
+	n += 2 + l + sovPool(uint64(l))
+	l = m.MaxCommissionChangeRate.Size()
+	n += 2 + l + sovPool(uint64(l))
 	return n
 }
 
@@ -1755,6 +1792,74 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			}
 			m.EndKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxCommission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommissionChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxCommissionChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPool(dAtA[iNdEx:])
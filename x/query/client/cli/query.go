@@ -26,6 +26,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 
 	// Account
 	cmd.AddCommand(CmdAccountAssets())
+	cmd.AddCommand(CmdAccountPoolRewards())
 	cmd.AddCommand(CmdAccountFundedList())
 
 	// Pool
@@ -36,6 +37,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdShowStaker())
 	cmd.AddCommand(CmdListStakers())
 	cmd.AddCommand(CmdListStakersByPool())
+	cmd.AddCommand(CmdShowStakeAllocation())
 
 	// Bundles
 	cmd.AddCommand(CmdShowFinalizedBundle())
@@ -48,7 +50,13 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	// Funders
 	cmd.AddCommand(CmdShowFunder())
 	cmd.AddCommand(CmdListFunders())
+	cmd.AddCommand(CmdFunderDelegates())
+	cmd.AddCommand(CmdCoinWeights())
+	cmd.AddCommand(CmdListMatchingCampaigns())
+	cmd.AddCommand(CmdShowMatchingCampaign())
 	cmd.AddCommand(CmdListFundings())
+	cmd.AddCommand(CmdFundedBundles())
+	cmd.AddCommand(CmdBundleFunders())
 
 	return cmd
 }
@@ -0,0 +1,41 @@
+package cli
+
+import (
+	"github.com/KYVENetwork/chain/x/query/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdAccountPoolRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-pool-rewards [address]",
+		Short: "Query the protocol rewards of a delegator per pool",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			reqAddress := args[0]
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryAccountClient(clientCtx)
+
+			params := &types.QueryAccountPoolRewardsRequest{
+				Address: reqAddress,
+			}
+
+			res, err := queryClient.AccountPoolRewards(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,35 @@
+package cli
+
+import (
+	"github.com/KYVENetwork/chain/x/query/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdCoinWeights() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "coin-weights",
+		Short: "Query the static and oracle coin weights of all whitelisted coins",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryFundersClient(clientCtx)
+
+			res, err := queryClient.CoinWeights(cmd.Context(), &types.QueryCoinWeightsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,41 @@
+package cli
+
+import (
+	"github.com/KYVENetwork/chain/x/query/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdFunderDelegates() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "funder-delegates [address]",
+		Short: "Query all delegates of the given funder",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			reqAddress := args[0]
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryFundersClient(clientCtx)
+
+			params := &types.QueryFunderDelegatesRequest{
+				Address: reqAddress,
+			}
+
+			res, err := queryClient.FunderDelegates(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,97 @@
+package cli
+
+import (
+	"github.com/KYVENetwork/chain/x/query/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdFundedBundles() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "funded-bundles [address] [pool_id]",
+		Short: "Query the recent bundles of a pool the given funder paid for",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			reqAddress := args[0]
+
+			reqPoolId, err := cast.ToUint64E(args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryFundersClient(clientCtx)
+
+			params := &types.QueryFundedBundlesRequest{
+				Address:    reqAddress,
+				PoolId:     reqPoolId,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.FundedBundles(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdBundleFunders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bundle-funders [pool_id] [bundle_id]",
+		Short: "Query the funders who paid for the given finalized bundle",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			reqPoolId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			reqBundleId, err := cast.ToUint64E(args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryFundersClient(clientCtx)
+
+			params := &types.QueryBundleFundersRequest{
+				PoolId:   reqPoolId,
+				BundleId: reqBundleId,
+			}
+
+			res, err := queryClient.BundleFunders(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,77 @@
+package cli
+
+import (
+	"github.com/KYVENetwork/chain/x/query/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdListMatchingCampaigns() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "matching-campaigns [sponsor]",
+		Short: "list all matching campaigns, optionally filtered by sponsor",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			reqSponsor := ""
+			if len(args) >= 1 {
+				reqSponsor = args[0]
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryFundersClient(clientCtx)
+
+			res, err := queryClient.MatchingCampaigns(cmd.Context(), &types.QueryMatchingCampaignsRequest{
+				Sponsor: reqSponsor,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowMatchingCampaign() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "matching-campaign [id]",
+		Short: "show a matching campaign by id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			reqId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryFundersClient(clientCtx)
+
+			res, err := queryClient.MatchingCampaign(cmd.Context(), &types.QueryMatchingCampaignRequest{
+				Id: reqId,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -76,3 +76,34 @@ func CmdShowStaker() *cobra.Command {
 
 	return cmd
 }
+
+func CmdShowStakeAllocation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stake-allocation [address]",
+		Short: "shows the stake allocation of a staker across all pools",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryStakersClient(clientCtx)
+
+			params := &types.QueryStakeAllocationRequest{
+				Address: args[0],
+			}
+
+			res, err := queryClient.StakeAllocation(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"context"
+	"sort"
+
+	"github.com/KYVENetwork/chain/util"
+	"github.com/KYVENetwork/chain/x/query/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AccountPoolRewards returns the estimated protocol rewards the given user has accrued as a delegator
+// in every pool since delegating. The rewards are grouped by pool and by validator.
+func (k Keeper) AccountPoolRewards(goCtx context.Context, req *types.QueryAccountPoolRewardsRequest) (*types.QueryAccountPoolRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegatorAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	validators, err := k.stakingKeeper.GetDelegatorValidators(ctx, delegatorAddr, 1000)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	pools := make(map[uint64]*types.AccountPoolRewards)
+
+	for _, validator := range validators.Validators {
+		valAddr, err := sdk.ValAddressFromBech32(validator.OperatorAddress)
+		if err != nil {
+			continue
+		}
+
+		delegation, err := k.stakingKeeper.GetDelegation(ctx, delegatorAddr, valAddr)
+		if err != nil {
+			continue
+		}
+
+		staker := util.MustAccountAddressFromValAddress(validator.OperatorAddress)
+
+		for poolId, rewards := range k.stakerKeeper.GetDelegatorPoolRewardsOfDelegation(ctx, staker, req.Address, delegation.Shares) {
+			if _, found := pools[poolId]; !found {
+				pools[poolId] = &types.AccountPoolRewards{PoolId: poolId}
+			}
+
+			pools[poolId].Rewards = pools[poolId].Rewards.Add(rewards...)
+			pools[poolId].Validators = append(pools[poolId].Validators, types.AccountPoolValidatorRewards{
+				Staker:  staker,
+				Rewards: rewards,
+			})
+		}
+	}
+
+	response := types.QueryAccountPoolRewardsResponse{Pools: make([]types.AccountPoolRewards, 0)}
+	for _, pool := range pools {
+		response.Pools = append(response.Pools, *pool)
+	}
+
+	sort.Slice(response.Pools, func(i, j int) bool {
+		return response.Pools[i].PoolId < response.Pools[j].PoolId
+	})
+
+	return &response, nil
+}
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/KYVENetwork/chain/x/query/types"
+)
+
+func (k Keeper) CoinWeights(c context.Context, req *types.QueryCoinWeightsRequest) (*types.QueryCoinWeightsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	coinWeights := make([]types.CoinWeight, 0)
+	for _, entry := range k.fundersKeeper.GetParams(ctx).CoinWhitelist {
+		coinWeight := types.CoinWeight{
+			Denom:        entry.CoinDenom,
+			StaticWeight: entry.CoinWeight,
+			OracleWeight: math.LegacyZeroDec(),
+			Weight:       k.fundersKeeper.GetCoinWeight(ctx, *entry),
+		}
+
+		if oracleCoinWeight, found := k.fundersKeeper.GetOracleCoinWeight(ctx, entry.CoinDenom); found {
+			coinWeight.OracleWeight = oracleCoinWeight.Weight
+			coinWeight.OracleUpdatedAt = oracleCoinWeight.UpdatedAt
+		}
+
+		coinWeights = append(coinWeights, coinWeight)
+	}
+
+	return &types.QueryCoinWeightsResponse{CoinWeights: coinWeights}, nil
+}
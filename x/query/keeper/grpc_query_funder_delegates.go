@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	fundersTypes "github.com/KYVENetwork/chain/x/funders/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/KYVENetwork/chain/x/query/types"
+)
+
+func (k Keeper) FunderDelegates(c context.Context, req *types.QueryFunderDelegatesRequest) (*types.QueryFunderDelegatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !k.fundersKeeper.DoesFunderExist(ctx, req.Address) {
+		return nil, status.Error(codes.NotFound, "funder not found")
+	}
+
+	delegates := k.fundersKeeper.GetFunderDelegatesOfFunder(ctx, req.Address)
+	if delegates == nil {
+		delegates = make([]fundersTypes.FunderDelegate, 0)
+	}
+
+	return &types.QueryFunderDelegatesResponse{Delegates: delegates}, nil
+}
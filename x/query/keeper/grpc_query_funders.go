@@ -28,7 +28,7 @@ func (k Keeper) Funders(c context.Context, req *types.QueryFundersRequest) (*typ
 	data := make([]types.Funder, 0)
 	for _, funder := range funders {
 		fundings := k.fundersKeeper.GetFundingsOfFunder(ctx, funder.Address)
-		data = append(data, k.parseFunder(&funder, fundings, whitelist))
+		data = append(data, k.parseFunder(ctx, &funder, fundings, whitelist))
 	}
 
 	return &types.QueryFundersResponse{Funders: data, Pagination: pageRes}, nil
@@ -49,7 +49,7 @@ func (k Keeper) Funder(c context.Context, req *types.QueryFunderRequest) (*types
 	fundings := k.filterFundingsOnStatus(allFundings, req.Status)
 
 	whitelist := k.fundersKeeper.GetCoinWhitelistMap(ctx)
-	funderData := k.parseFunder(&funder, allFundings, whitelist)
+	funderData := k.parseFunder(ctx, &funder, allFundings, whitelist)
 	fundingsData := k.parseFundings(fundings, whitelist)
 
 	return &types.QueryFunderResponse{
@@ -75,7 +75,7 @@ func (k Keeper) filterFundingsOnStatus(fundings []fundersTypes.Funding, fundingS
 	return filtered
 }
 
-func (k Keeper) parseFunder(funder *fundersTypes.Funder, fundings []fundersTypes.Funding, whitelist map[string]fundersTypes.WhitelistCoinEntry) types.Funder {
+func (k Keeper) parseFunder(ctx sdk.Context, funder *fundersTypes.Funder, fundings []fundersTypes.Funding, whitelist map[string]fundersTypes.WhitelistCoinEntry) types.Funder {
 	stats := types.FundingStats{
 		TotalUsedFunds:       sdk.NewCoins(),
 		TotalAllocatedFunds:  sdk.NewCoins(),
@@ -97,13 +97,30 @@ func (k Keeper) parseFunder(funder *fundersTypes.Funder, fundings []fundersTypes
 		stats.PoolsFunded = append(stats.PoolsFunded, funding.PoolId)
 	}
 
+	funderStats := k.fundersKeeper.GetFunderStats(ctx, funder.Address)
+	lifetimeStats := types.FunderLifetimeStats{
+		LifetimeFunded: funderStats.LifetimeFunded,
+		PoolsFunded:    funderStats.PoolsFunded,
+	}
+	if funderStats.CompletedFundings > 0 {
+		lifetimeStats.AverageFundingDuration = funderStats.TotalFundingDuration / funderStats.CompletedFundings
+	}
+
+	var attestation *fundersTypes.FunderAttestation
+	if a, found := k.fundersKeeper.GetFunderAttestation(ctx, funder.Address); found {
+		attestation = &a
+	}
+
 	return types.Funder{
-		Address:     funder.Address,
-		Moniker:     funder.Moniker,
-		Identity:    funder.Identity,
-		Website:     funder.Website,
-		Contact:     funder.Contact,
-		Description: funder.Description,
-		Stats:       &stats,
+		Address:       funder.Address,
+		Moniker:       funder.Moniker,
+		Identity:      funder.Identity,
+		Website:       funder.Website,
+		Contact:       funder.Contact,
+		Description:   funder.Description,
+		Stats:         &stats,
+		Verified:      k.fundersKeeper.IsFunderVerified(ctx, funder.Address),
+		Attestation:   attestation,
+		LifetimeStats: &lifetimeStats,
 	}
 }
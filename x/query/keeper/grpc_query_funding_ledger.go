@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	fundersTypes "github.com/KYVENetwork/chain/x/funders/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/KYVENetwork/chain/x/query/types"
+)
+
+func (k Keeper) FundedBundles(c context.Context, req *types.QueryFundedBundlesRequest) (*types.QueryFundedBundlesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	contributions, pageRes, err := k.fundersKeeper.GetPaginatedFundingContributionsOfFunder(ctx, req.Pagination, req.Address, req.PoolId)
+	if err != nil {
+		return nil, err
+	}
+	if contributions == nil {
+		contributions = make([]fundersTypes.FundingContribution, 0)
+	}
+
+	return &types.QueryFundedBundlesResponse{Contributions: contributions, Pagination: pageRes}, nil
+}
+
+func (k Keeper) BundleFunders(c context.Context, req *types.QueryBundleFundersRequest) (*types.QueryBundleFundersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	contributions := k.fundersKeeper.GetFundingContributionsOfBundle(ctx, req.PoolId, req.BundleId)
+	if contributions == nil {
+		contributions = make([]fundersTypes.FundingContribution, 0)
+	}
+
+	return &types.QueryBundleFundersResponse{Contributions: contributions}, nil
+}
@@ -54,6 +54,7 @@ func (k Keeper) parseFundings(fundings []fundersTypes.Funding, whitelist map[str
 			AmountsPerBundle: funding.AmountsPerBundle,
 			TotalFunded:      funding.TotalFunded,
 			Score:            funding.GetScore(whitelist),
+			Constraints:      funding.Constraints,
 		})
 	}
 	return fundingsData
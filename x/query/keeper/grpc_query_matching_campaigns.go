@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	fundersTypes "github.com/KYVENetwork/chain/x/funders/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/KYVENetwork/chain/x/query/types"
+)
+
+func (k Keeper) MatchingCampaigns(c context.Context, req *types.QueryMatchingCampaignsRequest) (*types.QueryMatchingCampaignsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	campaigns := make([]fundersTypes.MatchingCampaign, 0)
+	for _, campaign := range k.fundersKeeper.GetAllMatchingCampaigns(ctx) {
+		if req.Sponsor != "" && campaign.Sponsor != req.Sponsor {
+			continue
+		}
+		campaigns = append(campaigns, campaign)
+	}
+
+	return &types.QueryMatchingCampaignsResponse{Campaigns: campaigns}, nil
+}
+
+func (k Keeper) MatchingCampaign(c context.Context, req *types.QueryMatchingCampaignRequest) (*types.QueryMatchingCampaignResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	campaign, found := k.fundersKeeper.GetMatchingCampaign(ctx, req.Id)
+	if !found {
+		return nil, status.Error(codes.NotFound, "matching campaign not found")
+	}
+
+	return &types.QueryMatchingCampaignResponse{Campaign: campaign}, nil
+}
@@ -16,6 +16,9 @@ import (
 	"github.com/KYVENetwork/chain/x/query/types"
 	// Funders
 	fundersTypes "github.com/KYVENetwork/chain/x/funders/types"
+	// Stakers
+	stakersKeeper "github.com/KYVENetwork/chain/x/stakers/keeper"
+	stakersTypes "github.com/KYVENetwork/chain/x/stakers/types"
 )
 
 func (k Keeper) Pools(c context.Context, req *types.QueryPoolsRequest) (*types.QueryPoolsResponse, error) {
@@ -51,6 +54,34 @@ func (k Keeper) Pool(c context.Context, req *types.QueryPoolRequest) (*types.Que
 	return &types.QueryPoolResponse{Pool: k.parsePoolResponse(ctx, &pool)}, nil
 }
 
+// PoolRewardHistory returns the delegation rewards which were paid out in the given pool
+// during the recent epochs together with the resulting APR.
+func (k Keeper) PoolRewardHistory(c context.Context, req *types.QueryPoolRewardHistoryRequest) (*types.QueryPoolRewardHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.poolKeeper.GetPool(ctx, req.Id); !found {
+		return nil, errorsTypes.ErrKeyNotFound
+	}
+
+	epochs := make([]types.PoolRewardEpoch, 0)
+	for _, entry := range k.stakerKeeper.GetPoolRewardHistory(ctx, req.Id) {
+		startDate := int64(entry.Epoch * stakersTypes.RewardHistoryEpochDuration)
+
+		epochs = append(epochs, types.PoolRewardEpoch{
+			StartDate:         startDate,
+			EndDate:           startDate + stakersTypes.RewardHistoryEpochDuration,
+			DelegationRewards: entry.DelegationRewards,
+			PoolStake:         entry.PoolStake,
+			Apr:               stakersKeeper.GetPoolRewardHistoryAPR(entry),
+		})
+	}
+
+	return &types.QueryPoolRewardHistoryResponse{Epochs: epochs}, nil
+}
+
 func (k Keeper) parsePoolResponse(ctx sdk.Context, pool *poolTypes.Pool) types.PoolResponse {
 	bundleProposal, _ := k.bundleKeeper.GetBundleProposal(ctx, pool.Id)
 	stakers := k.stakerKeeper.GetAllStakerAddressesOfPool(ctx, pool.Id)
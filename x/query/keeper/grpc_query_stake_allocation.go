@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	"github.com/KYVENetwork/chain/x/query/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) StakeAllocation(c context.Context, req *types.QueryStakeAllocationRequest) (*types.QueryStakeAllocationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	validator, exists := k.stakerKeeper.GetValidator(ctx, req.Address)
+	if !exists {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	// the highest slash a validator can receive in a pool is the maximum
+	// of all slash types
+	maxSlashFraction := k.stakerKeeper.GetMaxSlashFraction(ctx)
+
+	pools := make([]types.PoolStakeAllocation, 0)
+	totalPoolStake := uint64(0)
+	maxSlashAmount := uint64(0)
+
+	for _, poolAccount := range k.stakerKeeper.GetPoolAccountsFromStaker(ctx, req.Address) {
+		poolStake := k.stakerKeeper.GetValidatorPoolStake(ctx, req.Address, poolAccount.PoolId)
+		poolMaxSlashAmount := uint64(maxSlashFraction.MulInt64(int64(poolStake)).TruncateInt64())
+
+		pools = append(pools, types.PoolStakeAllocation{
+			PoolId:         poolAccount.PoolId,
+			StakeFraction:  poolAccount.StakeFraction,
+			PoolStake:      poolStake,
+			MaxSlashAmount: poolMaxSlashAmount,
+		})
+
+		totalPoolStake += poolStake
+		maxSlashAmount += poolMaxSlashAmount
+	}
+
+	// since every slash reduces the bonded tokens of the validator the combined
+	// slash can never be higher than the bonded tokens
+	bondedTokens := validator.GetBondedTokens()
+	if math.NewIntFromUint64(maxSlashAmount).GT(bondedTokens) {
+		maxSlashAmount = bondedTokens.Uint64()
+	}
+
+	maxSlash := math.LegacyZeroDec()
+	if !bondedTokens.IsZero() {
+		maxSlash = math.LegacyNewDecFromInt(math.NewIntFromUint64(maxSlashAmount)).QuoInt(bondedTokens)
+	}
+
+	return &types.QueryStakeAllocationResponse{
+		TotalStakeFraction:  k.stakerKeeper.GetValidatorTotalStakeFraction(ctx, req.Address),
+		MaxStakeFractionSum: k.stakerKeeper.GetMaxStakeFractionSum(ctx),
+		TotalPoolStake:      totalPoolStake,
+		MaxSlashFraction:    maxSlash,
+		MaxSlashAmount:      maxSlashAmount,
+		Pools:               pools,
+	}, nil
+}
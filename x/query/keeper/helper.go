@@ -7,6 +7,7 @@ import (
 	globalTypes "github.com/KYVENetwork/chain/x/global/types"
 	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
 	"github.com/KYVENetwork/chain/x/query/types"
+	stakertypes "github.com/KYVENetwork/chain/x/stakers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -81,6 +82,8 @@ func (k Keeper) GetFullStaker(ctx sdk.Context, stakerAddress string) (*types.Ful
 		poolStake := k.stakerKeeper.GetValidatorPoolStake(ctx, stakerAddress, pool.Id)
 		validatorTotalPoolStake += poolStake
 
+		stats, _ := k.stakerKeeper.GetPoolAccountStats(ctx, stakerAddress, pool.Id)
+
 		poolMemberships = append(
 			poolMemberships, &types.PoolMembership{
 				Pool: &types.BasicPool{
@@ -103,10 +106,27 @@ func (k Keeper) GetFullStaker(ctx sdk.Context, stakerAddress string) (*types.Ful
 				StakeFraction:              poolAccount.StakeFraction,
 				PendingStakeFractionChange: stakeFractionChangeEntry,
 				PoolStake:                  poolStake,
+				MaxCommission:              poolAccount.MaxCommission,
+				MaxCommissionChangeRate:    poolAccount.MaxCommissionChangeRate,
+				Stats:                      stats,
+				ReliabilityScore:           stats.ReliabilityScore(),
 			},
 		)
 	}
 
+	// validators which are not in the active set are removed from all pools
+	// once the inactive validator grace period is over
+	autoLeaveDate := int64(0)
+	if inactiveValidatorEntry, found := k.stakerKeeper.GetInactiveValidatorEntryByIndex2(ctx, stakerAddress); found {
+		autoLeaveDate = inactiveValidatorEntry.CreationDate + int64(k.stakerKeeper.GetInactiveValidatorGracePeriod(ctx))
+	}
+
+	// validators without commission rewards settings accept all denoms
+	var commissionRewardsSettings *stakertypes.CommissionRewardsSettings
+	if settings, found := k.stakerKeeper.GetCommissionRewardsSettings(ctx, stakerAddress); found {
+		commissionRewardsSettings = &settings
+	}
+
 	return &types.FullStaker{
 		Address:                    stakerAddress,
 		Validator:                  &validator,
@@ -116,6 +136,9 @@ func (k Keeper) GetFullStaker(ctx sdk.Context, stakerAddress string) (*types.Ful
 		ValidatorTotalPoolStake:    validatorTotalPoolStake,
 		ValidatorCommissionRewards: util.TruncateDecCoins(validatorCommissionRewards.Commission),
 		Pools:                      poolMemberships,
+		ValidatorInactive:          !validator.IsBonded() || validator.IsJailed(),
+		AutoLeaveDate:              autoLeaveDate,
+		CommissionRewardsSettings:  commissionRewardsSettings,
 	}, nil
 }
 
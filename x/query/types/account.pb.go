@@ -651,6 +651,219 @@ func (m *RedelegationEntry) GetFinishDate() uint64 {
 	return 0
 }
 
+// QueryAccountPoolRewardsRequest is the request type for the Query/AccountPoolRewards RPC method.
+type QueryAccountPoolRewardsRequest struct {
+	// address of the delegator
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryAccountPoolRewardsRequest) Reset()         { *m = QueryAccountPoolRewardsRequest{} }
+func (m *QueryAccountPoolRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountPoolRewardsRequest) ProtoMessage()    {}
+func (*QueryAccountPoolRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51ca316755261aec, []int{11}
+}
+func (m *QueryAccountPoolRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountPoolRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountPoolRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountPoolRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountPoolRewardsRequest.Merge(m, src)
+}
+func (m *QueryAccountPoolRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountPoolRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountPoolRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountPoolRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryAccountPoolRewardsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryAccountPoolRewardsResponse is the response type for the Query/AccountPoolRewards RPC method.
+type QueryAccountPoolRewardsResponse struct {
+	// pools contains the accrued rewards of the delegator for each pool
+	Pools []AccountPoolRewards `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools"`
+}
+
+func (m *QueryAccountPoolRewardsResponse) Reset()         { *m = QueryAccountPoolRewardsResponse{} }
+func (m *QueryAccountPoolRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountPoolRewardsResponse) ProtoMessage()    {}
+func (*QueryAccountPoolRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51ca316755261aec, []int{12}
+}
+func (m *QueryAccountPoolRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountPoolRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountPoolRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountPoolRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountPoolRewardsResponse.Merge(m, src)
+}
+func (m *QueryAccountPoolRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountPoolRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountPoolRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountPoolRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryAccountPoolRewardsResponse) GetPools() []AccountPoolRewards {
+	if m != nil {
+		return m.Pools
+	}
+	return nil
+}
+
+// AccountPoolRewards contains the estimated protocol rewards a delegator
+// has accrued in a single pool.
+type AccountPoolRewards struct {
+	// pool_id ...
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// rewards is the sum of the accrued rewards over all validators
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+	// validators contains the accrued rewards for each validator
+	Validators []AccountPoolValidatorRewards `protobuf:"bytes,3,rep,name=validators,proto3" json:"validators"`
+}
+
+func (m *AccountPoolRewards) Reset()         { *m = AccountPoolRewards{} }
+func (m *AccountPoolRewards) String() string { return proto.CompactTextString(m) }
+func (*AccountPoolRewards) ProtoMessage()    {}
+func (*AccountPoolRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51ca316755261aec, []int{13}
+}
+func (m *AccountPoolRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountPoolRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountPoolRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountPoolRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountPoolRewards.Merge(m, src)
+}
+func (m *AccountPoolRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountPoolRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountPoolRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountPoolRewards proto.InternalMessageInfo
+
+func (m *AccountPoolRewards) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *AccountPoolRewards) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+func (m *AccountPoolRewards) GetValidators() []AccountPoolValidatorRewards {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+// AccountPoolValidatorRewards contains the estimated protocol rewards a delegator
+// has accrued in a single pool through a single validator.
+type AccountPoolValidatorRewards struct {
+	// staker is the address of the validator
+	Staker string `protobuf:"bytes,1,opt,name=staker,proto3" json:"staker,omitempty"`
+	// rewards ...
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+}
+
+func (m *AccountPoolValidatorRewards) Reset()         { *m = AccountPoolValidatorRewards{} }
+func (m *AccountPoolValidatorRewards) String() string { return proto.CompactTextString(m) }
+func (*AccountPoolValidatorRewards) ProtoMessage()    {}
+func (*AccountPoolValidatorRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51ca316755261aec, []int{14}
+}
+func (m *AccountPoolValidatorRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountPoolValidatorRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountPoolValidatorRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountPoolValidatorRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountPoolValidatorRewards.Merge(m, src)
+}
+func (m *AccountPoolValidatorRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountPoolValidatorRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountPoolValidatorRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountPoolValidatorRewards proto.InternalMessageInfo
+
+func (m *AccountPoolValidatorRewards) GetStaker() string {
+	if m != nil {
+		return m.Staker
+	}
+	return ""
+}
+
+func (m *AccountPoolValidatorRewards) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryAccountAssetsRequest)(nil), "kyve.query.v1beta1.QueryAccountAssetsRequest")
 	proto.RegisterType((*QueryAccountAssetsResponse)(nil), "kyve.query.v1beta1.QueryAccountAssetsResponse")
@@ -663,68 +876,80 @@ func init() {
 	proto.RegisterType((*QueryAccountRedelegationRequest)(nil), "kyve.query.v1beta1.QueryAccountRedelegationRequest")
 	proto.RegisterType((*QueryAccountRedelegationResponse)(nil), "kyve.query.v1beta1.QueryAccountRedelegationResponse")
 	proto.RegisterType((*RedelegationEntry)(nil), "kyve.query.v1beta1.RedelegationEntry")
+	proto.RegisterType((*QueryAccountPoolRewardsRequest)(nil), "kyve.query.v1beta1.QueryAccountPoolRewardsRequest")
+	proto.RegisterType((*QueryAccountPoolRewardsResponse)(nil), "kyve.query.v1beta1.QueryAccountPoolRewardsResponse")
+	proto.RegisterType((*AccountPoolRewards)(nil), "kyve.query.v1beta1.AccountPoolRewards")
+	proto.RegisterType((*AccountPoolValidatorRewards)(nil), "kyve.query.v1beta1.AccountPoolValidatorRewards")
 }
 
 func init() { proto.RegisterFile("kyve/query/v1beta1/account.proto", fileDescriptor_51ca316755261aec) }
 
 var fileDescriptor_51ca316755261aec = []byte{
-	// 893 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0x3a, 0x91, 0x03, 0x2f, 0x2d, 0x25, 0xd3, 0x0a, 0xb9, 0x86, 0x6c, 0xa2, 0x45, 0x90,
-	0x28, 0xa2, 0xbb, 0xb2, 0x4b, 0x11, 0x15, 0xa7, 0xba, 0x49, 0x38, 0xf0, 0x43, 0x65, 0x0b, 0x48,
-	0xed, 0xc5, 0x1a, 0xef, 0x4e, 0x36, 0x83, 0x77, 0x67, 0xdc, 0x9d, 0x71, 0x82, 0x55, 0x21, 0x24,
-	0x4e, 0x08, 0x2e, 0x48, 0xfc, 0x0b, 0x48, 0x20, 0x4e, 0x5c, 0xb8, 0x71, 0xe2, 0xd4, 0x63, 0x25,
-	0x2e, 0x9c, 0x00, 0x25, 0x48, 0x9c, 0xf8, 0x1f, 0xd0, 0xce, 0xcc, 0xda, 0x63, 0xbc, 0xc6, 0xf4,
-	0x90, 0x4b, 0xe2, 0x79, 0xf3, 0xbd, 0xf7, 0xbe, 0xf7, 0xde, 0xf7, 0x3c, 0x86, 0xad, 0xfe, 0xe8,
-	0x98, 0x04, 0x0f, 0x86, 0x24, 0x1f, 0x05, 0xc7, 0xad, 0x1e, 0x91, 0xb8, 0x15, 0xe0, 0x28, 0xe2,
-	0x43, 0x26, 0xfd, 0x41, 0xce, 0x25, 0x47, 0xa8, 0x40, 0xf8, 0x0a, 0xe1, 0x1b, 0x44, 0x73, 0x1d,
-	0x67, 0x94, 0xf1, 0x40, 0xfd, 0xd5, 0xb0, 0xe6, 0x6e, 0xc4, 0x45, 0xc6, 0x45, 0xd0, 0xc3, 0xe2,
-	0xdf, 0xf1, 0x06, 0x38, 0xa1, 0x0c, 0x4b, 0xca, 0x99, 0xc1, 0xba, 0x36, 0xb6, 0x44, 0x45, 0x9c,
-	0x96, 0xf7, 0x57, 0x12, 0x9e, 0x70, 0xf5, 0x31, 0x28, 0x3e, 0x19, 0xeb, 0x0b, 0x09, 0xe7, 0x49,
-	0x4a, 0x02, 0x3c, 0xa0, 0x01, 0x66, 0x8c, 0x4b, 0x15, 0x52, 0x94, 0x31, 0x2b, 0x0a, 0xd1, 0xa4,
-	0xd5, 0xbd, 0x77, 0x03, 0xae, 0xbe, 0x57, 0x1c, 0x6f, 0xe9, 0xe2, 0x6e, 0x09, 0x41, 0xa4, 0x08,
-	0xc9, 0x83, 0x21, 0x11, 0x12, 0x35, 0x60, 0x15, 0xc7, 0x71, 0x4e, 0x84, 0x68, 0x38, 0x5b, 0xce,
-	0xce, 0xd3, 0x61, 0x79, 0xf4, 0x3e, 0x5f, 0x81, 0x66, 0x95, 0x9f, 0x18, 0x70, 0x26, 0x48, 0xe1,
-	0xd8, 0xc3, 0x29, 0x66, 0x11, 0x51, 0x8e, 0x2b, 0x61, 0x79, 0x44, 0x2e, 0x40, 0x4c, 0x52, 0x92,
-	0x28, 0x92, 0x8d, 0x9a, 0xba, 0xb4, 0x2c, 0xa8, 0x05, 0x57, 0x26, 0xa7, 0xee, 0x90, 0xf5, 0x38,
-	0x8b, 0x29, 0x4b, 0x1a, 0xcb, 0x0a, 0x79, 0x79, 0x72, 0xf7, 0x41, 0x79, 0x85, 0x3e, 0x05, 0x64,
-	0xb9, 0xe4, 0xe4, 0x04, 0xe7, 0xb1, 0x68, 0xd4, 0xb7, 0x96, 0x77, 0xd6, 0xda, 0x57, 0x7d, 0xdd,
-	0x53, 0xbf, 0xe8, 0x69, 0x39, 0x27, 0xff, 0x36, 0xa7, 0xac, 0x73, 0xe3, 0xd1, 0x6f, 0x9b, 0x4b,
-	0xdf, 0xff, 0xbe, 0xb9, 0x93, 0x50, 0x79, 0x34, 0xec, 0xf9, 0x11, 0xcf, 0x02, 0x33, 0x00, 0xfd,
-	0xef, 0x9a, 0x88, 0xfb, 0x81, 0x1c, 0x0d, 0x88, 0x50, 0x0e, 0xe2, 0xbb, 0xbf, 0x7e, 0xd8, 0x75,
-	0xc2, 0xf5, 0x49, 0xae, 0x50, 0xa7, 0x2a, 0x08, 0x44, 0x3c, 0xcb, 0xa8, 0x10, 0x36, 0x81, 0xd5,
-	0xf3, 0x22, 0x30, 0xc9, 0x55, 0x12, 0x78, 0x08, 0xcf, 0xaa, 0x69, 0x46, 0x3c, 0xed, 0x1e, 0x0e,
-	0x75, 0xc3, 0x9e, 0x3a, 0xa7, 0xf4, 0x97, 0xca, 0x4c, 0x07, 0x3a, 0x91, 0xf7, 0xa5, 0x03, 0xdb,
-	0xb6, 0x14, 0xf6, 0x66, 0x47, 0x34, 0x16, 0xd4, 0x01, 0xc0, 0x44, 0xf5, 0x4a, 0x1a, 0x6b, 0xed,
-	0x97, 0xa7, 0x28, 0x4e, 0x2d, 0x94, 0x7f, 0x07, 0x27, 0xc4, 0xf8, 0x86, 0x96, 0xa7, 0x2d, 0xcc,
-	0xda, 0xb4, 0x30, 0x7f, 0x76, 0x60, 0x67, 0x31, 0x1b, 0x23, 0xd3, 0x77, 0x00, 0xc6, 0x0a, 0x2b,
-	0x24, 0x5e, 0x74, 0x6c, 0xdb, 0x9f, 0x5d, 0x6c, 0xbf, 0x22, 0x4a, 0x67, 0xa5, 0xe8, 0x5f, 0x68,
-	0x05, 0x40, 0x6f, 0x4e, 0x55, 0x57, 0x53, 0xd5, 0x6d, 0x2f, 0xac, 0x4e, 0x73, 0xb1, 0xcb, 0xf3,
-	0xbe, 0x70, 0xe0, 0x72, 0x45, 0x4a, 0xf4, 0x1c, 0xd4, 0x71, 0x56, 0x54, 0x65, 0xb6, 0xca, 0x9c,
-	0xd0, 0x8b, 0x70, 0x31, 0xca, 0x89, 0xd6, 0xbf, 0xa4, 0x19, 0x31, 0x7b, 0x75, 0xa1, 0x34, 0xbe,
-	0x4f, 0x33, 0x82, 0x5e, 0x83, 0xba, 0x90, 0xb8, 0x4f, 0x72, 0xb5, 0x4b, 0x6b, 0x6d, 0xb7, 0xaa,
-	0xd0, 0x83, 0x61, 0x9a, 0xde, 0x55, 0xa8, 0xd0, 0xa0, 0xbd, 0x9b, 0xb0, 0x61, 0x37, 0xb4, 0x18,
-	0x3b, 0x89, 0xdf, 0xa6, 0x42, 0x2e, 0xfe, 0x96, 0xb8, 0x0f, 0xee, 0x3c, 0x57, 0x33, 0x81, 0xd7,
-	0xa1, 0x7e, 0xa8, 0xac, 0xa6, 0xfb, 0xcd, 0x6a, 0x52, 0x05, 0xc2, 0x34, 0xdc, 0xe0, 0xbd, 0x6f,
-	0x1d, 0xa8, 0xeb, 0x0b, 0xf4, 0x11, 0xac, 0xea, 0x46, 0x88, 0xc6, 0xf2, 0x39, 0xa9, 0xbe, 0x4c,
-	0x80, 0x5a, 0xb0, 0x32, 0xe0, 0x3c, 0x35, 0xd3, 0xdd, 0xa8, 0xa2, 0xdb, 0xc1, 0x82, 0x46, 0x77,
-	0x38, 0x4f, 0x43, 0x05, 0xf5, 0xde, 0x80, 0x4d, 0xbb, 0x0b, 0x21, 0xb1, 0xbf, 0x41, 0x16, 0xb5,
-	0xf0, 0x27, 0x07, 0xb6, 0xe6, 0x7b, 0x9b, 0x2e, 0x72, 0xd8, 0xc8, 0x2d, 0x7b, 0x37, 0xe2, 0x3c,
-	0x8d, 0xf9, 0x09, 0xeb, 0x12, 0x26, 0x73, 0x4a, 0x4a, 0x69, 0xbf, 0x54, 0xc5, 0xd6, 0x0e, 0xb8,
-	0xcf, 0x64, 0x3e, 0x32, 0x7d, 0x7e, 0xde, 0x8e, 0x78, 0xdb, 0x04, 0xdc, 0xd7, 0xf1, 0xd0, 0x36,
-	0x5c, 0xc2, 0xc7, 0x98, 0xa6, 0xb8, 0x97, 0x92, 0xae, 0x48, 0xb9, 0x14, 0x46, 0x72, 0xcf, 0x8c,
-	0xcd, 0x77, 0x0b, 0xab, 0x77, 0x0f, 0xd6, 0x67, 0x12, 0x4c, 0xc9, 0x35, 0xc6, 0xb2, 0x7c, 0x23,
-	0xc6, 0x72, 0xdd, 0xc3, 0x92, 0xa0, 0x4d, 0x58, 0x3b, 0xa4, 0x8c, 0x8a, 0x23, 0x0d, 0x31, 0x2f,
-	0x85, 0x36, 0x15, 0x80, 0xf6, 0xdf, 0x35, 0xb8, 0x60, 0x77, 0x06, 0x7d, 0xe3, 0xc0, 0xc5, 0xa9,
-	0xe7, 0x08, 0x5d, 0xab, 0x2a, 0x78, 0xee, 0x73, 0xd7, 0xf4, 0xff, 0x2f, 0x5c, 0xb7, 0xdd, 0x7b,
-	0xf5, 0xb3, 0x5f, 0xfe, 0xfc, 0xba, 0xe6, 0xa3, 0x57, 0x82, 0xf9, 0xbf, 0x16, 0xba, 0x58, 0xf9,
-	0x04, 0x0f, 0xcd, 0x40, 0x3f, 0x41, 0x3f, 0x3a, 0xb0, 0x3e, 0xb3, 0x10, 0xa8, 0xb5, 0x28, 0xf7,
-	0xcc, 0xde, 0x35, 0xdb, 0x4f, 0xe2, 0x62, 0x28, 0xdf, 0x54, 0x94, 0xaf, 0xa3, 0xd6, 0x7f, 0x51,
-	0xd6, 0x1b, 0xd6, 0x4d, 0xa9, 0x90, 0x13, 0xde, 0x9d, 0xbd, 0x47, 0xa7, 0xae, 0xf3, 0xf8, 0xd4,
-	0x75, 0xfe, 0x38, 0x75, 0x9d, 0xaf, 0xce, 0xdc, 0xa5, 0xc7, 0x67, 0xee, 0xd2, 0xaf, 0x67, 0xee,
-	0xd2, 0xfd, 0x5d, 0x6b, 0x97, 0xde, 0xba, 0xf7, 0xe1, 0xfe, 0xbb, 0x44, 0x9e, 0xf0, 0xbc, 0x1f,
-	0x44, 0x47, 0x98, 0xb2, 0xe0, 0x63, 0x93, 0x45, 0xed, 0x54, 0xaf, 0xae, 0x9e, 0x8f, 0xeb, 0xff,
-	0x04, 0x00, 0x00, 0xff, 0xff, 0xa3, 0x94, 0x42, 0xa6, 0x61, 0x09, 0x00, 0x00,
+	// 1024 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0x3a, 0xc6, 0x81, 0x97, 0x96, 0x92, 0x69, 0x05, 0xae, 0x4b, 0xd6, 0xd1, 0x22, 0x9a,
+	0x28, 0xa2, 0xbb, 0xb2, 0xd3, 0x22, 0x5a, 0x4e, 0x75, 0x93, 0x20, 0xc4, 0x87, 0xca, 0x96, 0x56,
+	0x6a, 0x2f, 0xd6, 0x78, 0x77, 0xe2, 0x0c, 0x59, 0xef, 0xb8, 0x3b, 0xe3, 0x84, 0xa8, 0x42, 0x48,
+	0x9c, 0x10, 0x5c, 0x90, 0xf8, 0x0b, 0x90, 0x90, 0xf8, 0x38, 0x71, 0xe1, 0x80, 0xc4, 0x89, 0x53,
+	0x8f, 0x95, 0xb8, 0x70, 0x02, 0x94, 0x20, 0x71, 0xe5, 0x4f, 0x40, 0x3b, 0x1f, 0xf1, 0x18, 0xaf,
+	0x63, 0x7a, 0x48, 0x2f, 0x89, 0x67, 0xe6, 0x7d, 0xfc, 0xde, 0xef, 0xfd, 0xde, 0xec, 0xc0, 0xd2,
+	0xce, 0xfe, 0x2e, 0x09, 0xee, 0x0f, 0x48, 0xb6, 0x1f, 0xec, 0x36, 0x3a, 0x44, 0xe0, 0x46, 0x80,
+	0xa3, 0x88, 0x0d, 0x52, 0xe1, 0xf7, 0x33, 0x26, 0x18, 0x42, 0xb9, 0x85, 0x2f, 0x2d, 0x7c, 0x6d,
+	0x51, 0x5b, 0xc0, 0x3d, 0x9a, 0xb2, 0x40, 0xfe, 0x55, 0x66, 0xb5, 0xd5, 0x88, 0xf1, 0x1e, 0xe3,
+	0x41, 0x07, 0xf3, 0xff, 0xc6, 0xeb, 0xe3, 0x2e, 0x4d, 0xb1, 0xa0, 0x2c, 0xd5, 0xb6, 0xae, 0x6d,
+	0x6b, 0xac, 0x22, 0x46, 0xcd, 0xf9, 0xb9, 0x2e, 0xeb, 0x32, 0xf9, 0x33, 0xc8, 0x7f, 0xe9, 0xdd,
+	0x17, 0xbb, 0x8c, 0x75, 0x13, 0x12, 0xe0, 0x3e, 0x0d, 0x70, 0x9a, 0x32, 0x21, 0x43, 0x72, 0x13,
+	0xb3, 0xa0, 0x10, 0x05, 0x5a, 0x9e, 0x7b, 0x57, 0xe0, 0xfc, 0x7b, 0xf9, 0xf2, 0xba, 0x2a, 0xee,
+	0x3a, 0xe7, 0x44, 0xf0, 0x90, 0xdc, 0x1f, 0x10, 0x2e, 0x50, 0x15, 0xe6, 0x70, 0x1c, 0x67, 0x84,
+	0xf3, 0xaa, 0xb3, 0xe4, 0xac, 0x3c, 0x13, 0x9a, 0xa5, 0xf7, 0x69, 0x19, 0x6a, 0x45, 0x7e, 0xbc,
+	0xcf, 0x52, 0x4e, 0x72, 0xc7, 0x0e, 0x4e, 0x70, 0x1a, 0x11, 0xe9, 0x58, 0x0e, 0xcd, 0x12, 0xb9,
+	0x00, 0x31, 0x49, 0x48, 0x57, 0x82, 0xac, 0x96, 0xe4, 0xa1, 0xb5, 0x83, 0x1a, 0x70, 0x6e, 0xb8,
+	0x6a, 0x0f, 0xd2, 0x0e, 0x4b, 0x63, 0x9a, 0x76, 0xab, 0xb3, 0xd2, 0xf2, 0xec, 0xf0, 0xec, 0xb6,
+	0x39, 0x42, 0x1f, 0x03, 0xb2, 0x5c, 0x32, 0xb2, 0x87, 0xb3, 0x98, 0x57, 0x2b, 0x4b, 0xb3, 0x2b,
+	0xf3, 0xcd, 0xf3, 0xbe, 0xe2, 0xd4, 0xcf, 0x39, 0x35, 0x7d, 0xf2, 0x6f, 0x30, 0x9a, 0xb6, 0xae,
+	0x3c, 0xfc, 0xbd, 0x3e, 0xf3, 0xfd, 0x1f, 0xf5, 0x95, 0x2e, 0x15, 0xdb, 0x83, 0x8e, 0x1f, 0xb1,
+	0x5e, 0xa0, 0x1b, 0xa0, 0xfe, 0x5d, 0xe2, 0xf1, 0x4e, 0x20, 0xf6, 0xfb, 0x84, 0x4b, 0x07, 0xfe,
+	0xed, 0xdf, 0x3f, 0xac, 0x3a, 0xe1, 0xc2, 0x30, 0x57, 0xa8, 0x52, 0xe5, 0x00, 0x22, 0xd6, 0xeb,
+	0x51, 0xce, 0x6d, 0x00, 0x73, 0x27, 0x05, 0x60, 0x98, 0xcb, 0x00, 0x78, 0x00, 0xcf, 0xc9, 0x6e,
+	0x46, 0x2c, 0x69, 0x6f, 0x0d, 0x14, 0x61, 0x4f, 0x9f, 0x50, 0xfa, 0x33, 0x26, 0xd3, 0xa6, 0x4a,
+	0xe4, 0x7d, 0xee, 0xc0, 0xb2, 0x2d, 0x85, 0xf5, 0xf1, 0x16, 0x1d, 0x09, 0x6a, 0x13, 0x60, 0xa8,
+	0x7a, 0x29, 0x8d, 0xf9, 0xe6, 0xc5, 0x11, 0x88, 0x23, 0x03, 0xe5, 0xdf, 0xc4, 0x5d, 0xa2, 0x7d,
+	0x43, 0xcb, 0xd3, 0x16, 0x66, 0x69, 0x54, 0x98, 0xbf, 0x38, 0xb0, 0x32, 0x1d, 0x8d, 0x96, 0xe9,
+	0x3b, 0x00, 0x47, 0x0a, 0xcb, 0x25, 0x9e, 0x33, 0xb6, 0xec, 0x8f, 0x0f, 0xb6, 0x5f, 0x10, 0xa5,
+	0x55, 0xce, 0xf9, 0x0b, 0xad, 0x00, 0xe8, 0x8d, 0x91, 0xea, 0x4a, 0xb2, 0xba, 0xe5, 0xa9, 0xd5,
+	0x29, 0x2c, 0x76, 0x79, 0xde, 0x67, 0x0e, 0x9c, 0x2d, 0x48, 0x89, 0x9e, 0x87, 0x0a, 0xee, 0xe5,
+	0x55, 0xe9, 0xa9, 0xd2, 0x2b, 0xf4, 0x12, 0x9c, 0x8e, 0x32, 0xa2, 0xf4, 0x2f, 0x68, 0x8f, 0xe8,
+	0xb9, 0x3a, 0x65, 0x36, 0xdf, 0xa7, 0x3d, 0x82, 0x5e, 0x85, 0x0a, 0x17, 0x78, 0x87, 0x64, 0x72,
+	0x96, 0xe6, 0x9b, 0x6e, 0x51, 0xa1, 0x9b, 0x83, 0x24, 0xb9, 0x25, 0xad, 0x42, 0x6d, 0xed, 0x5d,
+	0x85, 0x45, 0x9b, 0xd0, 0xbc, 0xed, 0x24, 0x7e, 0x9b, 0x72, 0x31, 0xfd, 0x96, 0xb8, 0x07, 0xee,
+	0x24, 0x57, 0xdd, 0x81, 0xd7, 0xa0, 0xb2, 0x25, 0x77, 0x35, 0xfb, 0xb5, 0x62, 0x50, 0xb9, 0x85,
+	0x26, 0x5c, 0xdb, 0x7b, 0xdf, 0x38, 0x50, 0x51, 0x07, 0xe8, 0x03, 0x98, 0x53, 0x44, 0xf0, 0xea,
+	0xec, 0x09, 0xa9, 0xde, 0x24, 0x40, 0x0d, 0x28, 0xf7, 0x19, 0x4b, 0x74, 0x77, 0x17, 0x8b, 0xe0,
+	0xb6, 0x30, 0xa7, 0xd1, 0x4d, 0xc6, 0x92, 0x50, 0x9a, 0x7a, 0xaf, 0x43, 0xdd, 0x66, 0x21, 0x24,
+	0xf6, 0x0d, 0x32, 0x8d, 0xc2, 0x9f, 0x1d, 0x58, 0x9a, 0xec, 0xad, 0x59, 0x64, 0xb0, 0x98, 0x59,
+	0xfb, 0xed, 0x88, 0xb1, 0x24, 0x66, 0x7b, 0x69, 0x9b, 0xa4, 0x22, 0xa3, 0xc4, 0x48, 0xfb, 0xe5,
+	0x22, 0xb4, 0x76, 0xc0, 0x8d, 0x54, 0x64, 0xfb, 0x9a, 0xe7, 0x0b, 0x76, 0xc4, 0x1b, 0x3a, 0xe0,
+	0x86, 0x8a, 0x87, 0x96, 0xe1, 0x0c, 0xde, 0xc5, 0x34, 0xc1, 0x9d, 0x84, 0xb4, 0x79, 0xc2, 0x04,
+	0xd7, 0x92, 0x7b, 0xf6, 0x68, 0xfb, 0x56, 0xbe, 0xeb, 0xdd, 0x85, 0x85, 0xb1, 0x04, 0x23, 0x72,
+	0x8d, 0xb1, 0x30, 0xdf, 0x88, 0x23, 0xb9, 0xae, 0x63, 0x41, 0x50, 0x1d, 0xe6, 0xb7, 0x68, 0x4a,
+	0xf9, 0xb6, 0x32, 0xd1, 0x5f, 0x0a, 0xb5, 0x95, 0x1b, 0x78, 0xd7, 0x46, 0xc5, 0x25, 0x09, 0x57,
+	0xf7, 0xe1, 0x74, 0x56, 0x09, 0xd4, 0x27, 0xfa, 0x6a, 0x4e, 0x5b, 0xf0, 0x54, 0xde, 0x3d, 0xc3,
+	0xdd, 0xc5, 0x22, 0xee, 0xc6, 0xdd, 0x35, 0x79, 0xca, 0xd5, 0xfb, 0xc7, 0x01, 0x34, 0x6e, 0x83,
+	0x5e, 0x80, 0xb9, 0xfc, 0xbc, 0x4d, 0x63, 0x33, 0xc7, 0xf9, 0xf2, 0x4d, 0x29, 0x64, 0xf3, 0xf5,
+	0x28, 0x9d, 0x94, 0x90, 0x75, 0x02, 0x74, 0x1b, 0x60, 0x17, 0x27, 0x34, 0xc6, 0x82, 0x65, 0x66,
+	0x6e, 0x82, 0x29, 0x45, 0xde, 0x31, 0x0e, 0xa3, 0xd5, 0x5a, 0x81, 0xbc, 0xaf, 0x1c, 0xb8, 0x70,
+	0x8c, 0x47, 0x7e, 0x85, 0xe9, 0x5b, 0x48, 0xb5, 0x44, 0xaf, 0x9e, 0x64, 0xe9, 0xcd, 0xef, 0xca,
+	0x70, 0xca, 0x6e, 0x3f, 0xfa, 0xda, 0x81, 0xd3, 0x23, 0x0f, 0x19, 0x74, 0xa9, 0x88, 0x89, 0x89,
+	0x0f, 0xa5, 0x9a, 0xff, 0x7f, 0xcd, 0x95, 0xb8, 0xbc, 0xcb, 0x9f, 0xfc, 0xfa, 0xd7, 0x97, 0x25,
+	0x1f, 0xbd, 0x12, 0x4c, 0x7e, 0x67, 0xb6, 0xb1, 0xf4, 0x09, 0x1e, 0x68, 0xd1, 0x7e, 0x84, 0x7e,
+	0x74, 0x60, 0x61, 0xec, 0x2a, 0x45, 0x8d, 0x69, 0xb9, 0xc7, 0x6e, 0xec, 0x5a, 0xf3, 0x71, 0x5c,
+	0x34, 0xe4, 0xab, 0x12, 0xf2, 0x1a, 0x6a, 0x1c, 0x07, 0x59, 0xdd, 0xcd, 0xed, 0x84, 0x72, 0x61,
+	0xe1, 0xfe, 0xa9, 0x78, 0x0c, 0xa6, 0xa2, 0x18, 0x1f, 0xe9, 0xda, 0xda, 0x63, 0xf9, 0x68, 0xe8,
+	0xd7, 0x24, 0xf4, 0xcb, 0xa8, 0x79, 0x1c, 0x74, 0x39, 0x91, 0x5a, 0x21, 0x43, 0xec, 0xad, 0xf5,
+	0x87, 0x07, 0xae, 0xf3, 0xe8, 0xc0, 0x75, 0xfe, 0x3c, 0x70, 0x9d, 0x2f, 0x0e, 0xdd, 0x99, 0x47,
+	0x87, 0xee, 0xcc, 0x6f, 0x87, 0xee, 0xcc, 0xbd, 0x55, 0x4b, 0x7d, 0x6f, 0xdd, 0xbd, 0xb3, 0xf1,
+	0x2e, 0x11, 0x7b, 0x2c, 0xdb, 0x09, 0xa2, 0x6d, 0x4c, 0xd3, 0xe0, 0x43, 0x9d, 0x46, 0xaa, 0xb0,
+	0x53, 0x91, 0x8f, 0xa6, 0xb5, 0x7f, 0x07, 0x00, 0x4e, 0x08, 0xf8, 0xfa, 0x57, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -743,6 +968,9 @@ type QueryAccountClient interface {
 	AccountAssets(ctx context.Context, in *QueryAccountAssetsRequest, opts ...grpc.CallOption) (*QueryAccountAssetsResponse, error)
 	// AccountFundedList returns all pools the given user has funded into.
 	AccountFundedList(ctx context.Context, in *QueryAccountFundedListRequest, opts ...grpc.CallOption) (*QueryAccountFundedListResponse, error)
+	// AccountPoolRewards returns the estimated protocol rewards the given user has accrued
+	// as a delegator in each pool.
+	AccountPoolRewards(ctx context.Context, in *QueryAccountPoolRewardsRequest, opts ...grpc.CallOption) (*QueryAccountPoolRewardsResponse, error)
 }
 
 type queryAccountClient struct {
@@ -771,12 +999,24 @@ func (c *queryAccountClient) AccountFundedList(ctx context.Context, in *QueryAcc
 	return out, nil
 }
 
+func (c *queryAccountClient) AccountPoolRewards(ctx context.Context, in *QueryAccountPoolRewardsRequest, opts ...grpc.CallOption) (*QueryAccountPoolRewardsResponse, error) {
+	out := new(QueryAccountPoolRewardsResponse)
+	err := c.cc.Invoke(ctx, "/kyve.query.v1beta1.QueryAccount/AccountPoolRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryAccountServer is the server API for QueryAccount service.
 type QueryAccountServer interface {
 	// AccountAssets returns an overview of the sum of all balances for a given user. e.g. balance, staking, funding, etc.
 	AccountAssets(context.Context, *QueryAccountAssetsRequest) (*QueryAccountAssetsResponse, error)
 	// AccountFundedList returns all pools the given user has funded into.
 	AccountFundedList(context.Context, *QueryAccountFundedListRequest) (*QueryAccountFundedListResponse, error)
+	// AccountPoolRewards returns the estimated protocol rewards the given user has accrued
+	// as a delegator in each pool.
+	AccountPoolRewards(context.Context, *QueryAccountPoolRewardsRequest) (*QueryAccountPoolRewardsResponse, error)
 }
 
 // UnimplementedQueryAccountServer can be embedded to have forward compatible implementations.
@@ -789,6 +1029,9 @@ func (*UnimplementedQueryAccountServer) AccountAssets(ctx context.Context, req *
 func (*UnimplementedQueryAccountServer) AccountFundedList(ctx context.Context, req *QueryAccountFundedListRequest) (*QueryAccountFundedListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AccountFundedList not implemented")
 }
+func (*UnimplementedQueryAccountServer) AccountPoolRewards(ctx context.Context, req *QueryAccountPoolRewardsRequest) (*QueryAccountPoolRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountPoolRewards not implemented")
+}
 
 func RegisterQueryAccountServer(s grpc1.Server, srv QueryAccountServer) {
 	s.RegisterService(&_QueryAccount_serviceDesc, srv)
@@ -830,6 +1073,24 @@ func _QueryAccount_AccountFundedList_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryAccount_AccountPoolRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountPoolRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryAccountServer).AccountPoolRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.query.v1beta1.QueryAccount/AccountPoolRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryAccountServer).AccountPoolRewards(ctx, req.(*QueryAccountPoolRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var QueryAccount_serviceDesc = _QueryAccount_serviceDesc
 var _QueryAccount_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kyve.query.v1beta1.QueryAccount",
@@ -843,6 +1104,10 @@ var _QueryAccount_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AccountFundedList",
 			Handler:    _QueryAccount_AccountFundedList_Handler,
 		},
+		{
+			MethodName: "AccountPoolRewards",
+			Handler:    _QueryAccount_AccountPoolRewards_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kyve/query/v1beta1/account.proto",
@@ -1315,85 +1580,252 @@ func (m *RedelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintAccount(dAtA []byte, offset int, v uint64) int {
-	offset -= sovAccount(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryAccountPoolRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryAccountAssetsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryAccountPoolRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountPoolRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovAccount(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAccountAssetsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAccountPoolRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountPoolRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountPoolRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Balance != 0 {
-		n += 1 + sovAccount(uint64(m.Balance))
-	}
-	if m.Delegation != 0 {
-		n += 1 + sovAccount(uint64(m.Delegation))
-	}
-	if m.DelegationUnbonding != 0 {
-		n += 1 + sovAccount(uint64(m.DelegationUnbonding))
-	}
-	if len(m.DelegationRewards) > 0 {
-		for _, e := range m.DelegationRewards {
-			l = e.Size()
-			n += 1 + l + sovAccount(uint64(l))
-		}
-	}
-	if len(m.CommissionRewards) > 0 {
-		for _, e := range m.CommissionRewards {
-			l = e.Size()
-			n += 1 + l + sovAccount(uint64(l))
-		}
-	}
-	if len(m.ProtocolFunding) > 0 {
-		for _, e := range m.ProtocolFunding {
-			l = e.Size()
-			n += 1 + l + sovAccount(uint64(l))
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAccount(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAccountDelegationUnbondingsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovAccount(uint64(l))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovAccount(uint64(l))
+func (m *AccountPoolRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAccountDelegationUnbondingsResponse) Size() (n int) {
-	if m == nil {
+func (m *AccountPoolRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountPoolRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAccount(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAccount(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintAccount(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountPoolValidatorRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountPoolValidatorRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountPoolValidatorRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAccount(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAccount(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAccount(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAccountAssetsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountAssetsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Balance != 0 {
+		n += 1 + sovAccount(uint64(m.Balance))
+	}
+	if m.Delegation != 0 {
+		n += 1 + sovAccount(uint64(m.Delegation))
+	}
+	if m.DelegationUnbonding != 0 {
+		n += 1 + sovAccount(uint64(m.DelegationUnbonding))
+	}
+	if len(m.DelegationRewards) > 0 {
+		for _, e := range m.DelegationRewards {
+			l = e.Size()
+			n += 1 + l + sovAccount(uint64(l))
+		}
+	}
+	if len(m.CommissionRewards) > 0 {
+		for _, e := range m.CommissionRewards {
+			l = e.Size()
+			n += 1 + l + sovAccount(uint64(l))
+		}
+	}
+	if len(m.ProtocolFunding) > 0 {
+		for _, e := range m.ProtocolFunding {
+			l = e.Size()
+			n += 1 + l + sovAccount(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAccountDelegationUnbondingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountDelegationUnbondingsResponse) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -1523,6 +1955,77 @@ func (m *RedelegationEntry) Size() (n int) {
 	return n
 }
 
+func (m *QueryAccountPoolRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountPoolRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovAccount(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AccountPoolRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovAccount(uint64(m.PoolId))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovAccount(uint64(l))
+		}
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovAccount(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AccountPoolValidatorRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovAccount(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovAccount(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2741,6 +3244,425 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryAccountPoolRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountPoolRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountPoolRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountPoolRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountPoolRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountPoolRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, AccountPoolRewards{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountPoolRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountPoolRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountPoolRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, AccountPoolValidatorRewards{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountPoolValidatorRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountPoolValidatorRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountPoolValidatorRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipAccount(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5,9 +5,11 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	types "github.com/KYVENetwork/chain/x/funders/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
-	types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -81,6 +83,12 @@ type Funder struct {
 	Description string `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
 	// statistics about all the fundings of the funder.
 	Stats *FundingStats `protobuf:"bytes,7,opt,name=stats,proto3" json:"stats,omitempty"`
+	// verified is true if the funder has a valid attestation of a funder attestor.
+	Verified bool `protobuf:"varint,8,opt,name=verified,proto3" json:"verified,omitempty"`
+	// attestation is the latest attestation of the funder, nil if there is none.
+	Attestation *types.FunderAttestation `protobuf:"bytes,9,opt,name=attestation,proto3" json:"attestation,omitempty"`
+	// lifetime_stats are the aggregated statistics of all past and current fundings.
+	LifetimeStats *FunderLifetimeStats `protobuf:"bytes,10,opt,name=lifetime_stats,json=lifetimeStats,proto3" json:"lifetime_stats,omitempty"`
 }
 
 func (m *Funder) Reset()         { *m = Funder{} }
@@ -165,6 +173,92 @@ func (m *Funder) GetStats() *FundingStats {
 	return nil
 }
 
+func (m *Funder) GetVerified() bool {
+	if m != nil {
+		return m.Verified
+	}
+	return false
+}
+
+func (m *Funder) GetAttestation() *types.FunderAttestation {
+	if m != nil {
+		return m.Attestation
+	}
+	return nil
+}
+
+func (m *Funder) GetLifetimeStats() *FunderLifetimeStats {
+	if m != nil {
+		return m.LifetimeStats
+	}
+	return nil
+}
+
+// FunderLifetimeStats ...
+type FunderLifetimeStats struct {
+	// lifetime_funded is the total amount the funder ever funded
+	LifetimeFunded github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=lifetime_funded,json=lifetimeFunded,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"lifetime_funded"`
+	// pools_funded are the ids of all pools the funder ever funded
+	PoolsFunded []uint64 `protobuf:"varint,2,rep,packed,name=pools_funded,json=poolsFunded,proto3" json:"pools_funded,omitempty"`
+	// average_funding_duration is the average duration (in seconds) of all
+	// fundings which ran out or got defunded
+	AverageFundingDuration uint64 `protobuf:"varint,3,opt,name=average_funding_duration,json=averageFundingDuration,proto3" json:"average_funding_duration,omitempty"`
+}
+
+func (m *FunderLifetimeStats) Reset()         { *m = FunderLifetimeStats{} }
+func (m *FunderLifetimeStats) String() string { return proto.CompactTextString(m) }
+func (*FunderLifetimeStats) ProtoMessage()    {}
+func (*FunderLifetimeStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a182f068d9f0dba9, []int{1}
+}
+func (m *FunderLifetimeStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FunderLifetimeStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FunderLifetimeStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FunderLifetimeStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FunderLifetimeStats.Merge(m, src)
+}
+func (m *FunderLifetimeStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *FunderLifetimeStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_FunderLifetimeStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FunderLifetimeStats proto.InternalMessageInfo
+
+func (m *FunderLifetimeStats) GetLifetimeFunded() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.LifetimeFunded
+	}
+	return nil
+}
+
+func (m *FunderLifetimeStats) GetPoolsFunded() []uint64 {
+	if m != nil {
+		return m.PoolsFunded
+	}
+	return nil
+}
+
+func (m *FunderLifetimeStats) GetAverageFundingDuration() uint64 {
+	if m != nil {
+		return m.AverageFundingDuration
+	}
+	return 0
+}
+
 // FundingStats ...
 type FundingStats struct {
 	// total_used_funds are the total funds that have been distributed by the funder.
@@ -185,7 +279,7 @@ func (m *FundingStats) Reset()         { *m = FundingStats{} }
 func (m *FundingStats) String() string { return proto.CompactTextString(m) }
 func (*FundingStats) ProtoMessage()    {}
 func (*FundingStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a182f068d9f0dba9, []int{1}
+	return fileDescriptor_a182f068d9f0dba9, []int{2}
 }
 func (m *FundingStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -265,13 +359,16 @@ type Funding struct {
 	// score is the result of all coins allocated to this pool times the coin weight specified
 	// by the params
 	Score uint64 `protobuf:"varint,6,opt,name=score,proto3" json:"score,omitempty"`
+	// constraints are the requirements a bundle has to fulfill so that
+	// this funding pays for it
+	Constraints types.FundingConstraints `protobuf:"bytes,7,opt,name=constraints,proto3" json:"constraints"`
 }
 
 func (m *Funding) Reset()         { *m = Funding{} }
 func (m *Funding) String() string { return proto.CompactTextString(m) }
 func (*Funding) ProtoMessage()    {}
 func (*Funding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a182f068d9f0dba9, []int{2}
+	return fileDescriptor_a182f068d9f0dba9, []int{3}
 }
 func (m *Funding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -342,6 +439,13 @@ func (m *Funding) GetScore() uint64 {
 	return 0
 }
 
+func (m *Funding) GetConstraints() types.FundingConstraints {
+	if m != nil {
+		return m.Constraints
+	}
+	return types.FundingConstraints{}
+}
+
 // QueryFundersRequest is the request type for the Query/Funders RPC method.
 type QueryFundersRequest struct {
 	// pagination defines an optional pagination for the request.
@@ -354,7 +458,7 @@ func (m *QueryFundersRequest) Reset()         { *m = QueryFundersRequest{} }
 func (m *QueryFundersRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFundersRequest) ProtoMessage()    {}
 func (*QueryFundersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a182f068d9f0dba9, []int{3}
+	return fileDescriptor_a182f068d9f0dba9, []int{4}
 }
 func (m *QueryFundersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -409,7 +513,7 @@ func (m *QueryFundersResponse) Reset()         { *m = QueryFundersResponse{} }
 func (m *QueryFundersResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFundersResponse) ProtoMessage()    {}
 func (*QueryFundersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a182f068d9f0dba9, []int{4}
+	return fileDescriptor_a182f068d9f0dba9, []int{5}
 }
 func (m *QueryFundersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -464,7 +568,7 @@ func (m *QueryFunderRequest) Reset()         { *m = QueryFunderRequest{} }
 func (m *QueryFunderRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFunderRequest) ProtoMessage()    {}
 func (*QueryFunderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a182f068d9f0dba9, []int{5}
+	return fileDescriptor_a182f068d9f0dba9, []int{6}
 }
 func (m *QueryFunderRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -519,7 +623,7 @@ func (m *QueryFunderResponse) Reset()         { *m = QueryFunderResponse{} }
 func (m *QueryFunderResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFunderResponse) ProtoMessage()    {}
 func (*QueryFunderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a182f068d9f0dba9, []int{6}
+	return fileDescriptor_a182f068d9f0dba9, []int{7}
 }
 func (m *QueryFunderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -576,7 +680,7 @@ func (m *QueryFundingsByFunderRequest) Reset()         { *m = QueryFundingsByFun
 func (m *QueryFundingsByFunderRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFundingsByFunderRequest) ProtoMessage()    {}
 func (*QueryFundingsByFunderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a182f068d9f0dba9, []int{7}
+	return fileDescriptor_a182f068d9f0dba9, []int{8}
 }
 func (m *QueryFundingsByFunderRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -638,7 +742,7 @@ func (m *QueryFundingsByFunderResponse) Reset()         { *m = QueryFundingsByFu
 func (m *QueryFundingsByFunderResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFundingsByFunderResponse) ProtoMessage()    {}
 func (*QueryFundingsByFunderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a182f068d9f0dba9, []int{8}
+	return fileDescriptor_a182f068d9f0dba9, []int{9}
 }
 func (m *QueryFundingsByFunderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -695,7 +799,7 @@ func (m *QueryFundingsByPoolRequest) Reset()         { *m = QueryFundingsByPoolR
 func (m *QueryFundingsByPoolRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFundingsByPoolRequest) ProtoMessage()    {}
 func (*QueryFundingsByPoolRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a182f068d9f0dba9, []int{9}
+	return fileDescriptor_a182f068d9f0dba9, []int{10}
 }
 func (m *QueryFundingsByPoolRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -757,7 +861,7 @@ func (m *QueryFundingsByPoolResponse) Reset()         { *m = QueryFundingsByPool
 func (m *QueryFundingsByPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFundingsByPoolResponse) ProtoMessage()    {}
 func (*QueryFundingsByPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a182f068d9f0dba9, []int{10}
+	return fileDescriptor_a182f068d9f0dba9, []int{11}
 }
 func (m *QueryFundingsByPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -800,1179 +904,4439 @@ func (m *QueryFundingsByPoolResponse) GetFundings() []Funding {
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("kyve.query.v1beta1.FundingStatus", FundingStatus_name, FundingStatus_value)
-	proto.RegisterType((*Funder)(nil), "kyve.query.v1beta1.Funder")
-	proto.RegisterType((*FundingStats)(nil), "kyve.query.v1beta1.FundingStats")
-	proto.RegisterType((*Funding)(nil), "kyve.query.v1beta1.Funding")
-	proto.RegisterType((*QueryFundersRequest)(nil), "kyve.query.v1beta1.QueryFundersRequest")
-	proto.RegisterType((*QueryFundersResponse)(nil), "kyve.query.v1beta1.QueryFundersResponse")
-	proto.RegisterType((*QueryFunderRequest)(nil), "kyve.query.v1beta1.QueryFunderRequest")
-	proto.RegisterType((*QueryFunderResponse)(nil), "kyve.query.v1beta1.QueryFunderResponse")
-	proto.RegisterType((*QueryFundingsByFunderRequest)(nil), "kyve.query.v1beta1.QueryFundingsByFunderRequest")
-	proto.RegisterType((*QueryFundingsByFunderResponse)(nil), "kyve.query.v1beta1.QueryFundingsByFunderResponse")
-	proto.RegisterType((*QueryFundingsByPoolRequest)(nil), "kyve.query.v1beta1.QueryFundingsByPoolRequest")
-	proto.RegisterType((*QueryFundingsByPoolResponse)(nil), "kyve.query.v1beta1.QueryFundingsByPoolResponse")
+// QueryFunderDelegatesRequest is the request type for the Query/FunderDelegates RPC method.
+type QueryFunderDelegatesRequest struct {
+	// address of the funder
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func init() { proto.RegisterFile("kyve/query/v1beta1/funders.proto", fileDescriptor_a182f068d9f0dba9) }
-
-var fileDescriptor_a182f068d9f0dba9 = []byte{
-	// 1050 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0xc4, 0x8e, 0x5d, 0x9e, 0xd3, 0x28, 0x4c, 0x53, 0xb2, 0xdd, 0xb4, 0xae, 0x6b, 0x20,
-	0xb1, 0x22, 0xd8, 0x4d, 0x8c, 0xa8, 0x00, 0x89, 0x43, 0x3e, 0x2b, 0x0b, 0x29, 0x0a, 0x9b, 0xa4,
-	0x12, 0x5c, 0x56, 0xeb, 0xdd, 0xa9, 0xb3, 0xc4, 0xde, 0x71, 0x77, 0x76, 0x5b, 0x42, 0x55, 0x24,
-	0x10, 0x12, 0x3d, 0x22, 0x71, 0xe4, 0x88, 0x10, 0x1f, 0x27, 0xb8, 0x21, 0x71, 0xe1, 0xd8, 0x63,
-	0x25, 0x38, 0x70, 0x02, 0x94, 0x20, 0xf8, 0x27, 0x38, 0xa0, 0xf9, 0x58, 0x77, 0xed, 0xc6, 0x71,
-	0x51, 0x63, 0xa9, 0x97, 0x64, 0x66, 0xde, 0x7b, 0xf3, 0xfb, 0xcd, 0x6f, 0xde, 0x7b, 0x3b, 0x86,
-	0xf2, 0xfe, 0xc1, 0x2d, 0x62, 0xde, 0x8c, 0x49, 0x78, 0x60, 0xde, 0x5a, 0x6a, 0x90, 0xc8, 0x59,
-	0x32, 0x6f, 0xc4, 0x81, 0x47, 0x42, 0x66, 0x74, 0x42, 0x1a, 0x51, 0x8c, 0xb9, 0x87, 0x21, 0x3c,
-	0x0c, 0xe5, 0xa1, 0x3f, 0xeb, 0xb4, 0xfd, 0x80, 0x9a, 0xe2, 0xaf, 0x74, 0xd3, 0x17, 0x5c, 0xca,
-	0xda, 0x94, 0x99, 0x0d, 0x87, 0xf5, 0xef, 0xd7, 0x71, 0x9a, 0x7e, 0xe0, 0x44, 0x3e, 0x0d, 0x94,
-	0x6f, 0x29, 0xed, 0x9b, 0x78, 0xb9, 0xd4, 0x4f, 0xec, 0xd3, 0x4d, 0xda, 0xa4, 0x62, 0x68, 0xf2,
-	0x91, 0x5a, 0xbd, 0xd8, 0xa4, 0xb4, 0xd9, 0x22, 0xa6, 0xd3, 0xf1, 0x4d, 0x27, 0x08, 0x68, 0x24,
-	0xb6, 0x54, 0x34, 0x2b, 0x7f, 0x23, 0xc8, 0x6f, 0x08, 0xe2, 0x58, 0x83, 0x82, 0xe3, 0x79, 0x21,
-	0x61, 0x4c, 0x43, 0x65, 0x54, 0x7d, 0xc6, 0x4a, 0xa6, 0xdc, 0xd2, 0xa6, 0x81, 0xbf, 0x4f, 0x42,
-	0x6d, 0x4c, 0x5a, 0xd4, 0x14, 0xeb, 0x70, 0xc6, 0xf7, 0x48, 0x10, 0xf9, 0xd1, 0x81, 0x96, 0x15,
-	0xa6, 0xee, 0x9c, 0x47, 0xdd, 0x26, 0x0d, 0xe6, 0x47, 0x44, 0xcb, 0xc9, 0x28, 0x35, 0xe5, 0x16,
-	0x97, 0x06, 0x91, 0xe3, 0x46, 0xda, 0xb8, 0xb4, 0xa8, 0x29, 0x2e, 0x43, 0xd1, 0x23, 0xcc, 0x0d,
-	0xfd, 0x0e, 0x27, 0xa9, 0xe5, 0x85, 0x35, 0xbd, 0x84, 0xaf, 0xc2, 0x38, 0x8b, 0x9c, 0x88, 0x69,
-	0x85, 0x32, 0xaa, 0x16, 0x6b, 0x65, 0xe3, 0x51, 0x9d, 0x0d, 0x7e, 0x20, 0x3f, 0x68, 0x6e, 0x73,
-	0x3f, 0x4b, 0xba, 0x57, 0x7e, 0xcd, 0xc2, 0x44, 0x7a, 0x1d, 0x7f, 0x00, 0x53, 0x11, 0x8d, 0x9c,
-	0x96, 0x1d, 0x33, 0xe2, 0xd9, 0xfc, 0xf2, 0xf8, 0xb9, 0xb3, 0xd5, 0x62, 0xed, 0x82, 0x21, 0x85,
-	0x36, 0xb8, 0xd0, 0xdd, 0x4d, 0x57, 0xa9, 0x1f, 0xac, 0xbc, 0x7a, 0xff, 0xf7, 0xcb, 0x99, 0xef,
-	0xfe, 0xb8, 0x5c, 0x6d, 0xfa, 0xd1, 0x5e, 0xdc, 0x30, 0x5c, 0xda, 0x36, 0xd5, 0xad, 0xc8, 0x7f,
-	0x2f, 0x33, 0x6f, 0xdf, 0x8c, 0x0e, 0x3a, 0x84, 0x89, 0x00, 0xf6, 0xcd, 0x3f, 0xdf, 0x2f, 0x20,
-	0x6b, 0x52, 0x20, 0xed, 0x32, 0xe2, 0x71, 0x0a, 0x0c, 0x7f, 0x82, 0xe0, 0xbc, 0x04, 0x77, 0x5a,
-	0x2d, 0xea, 0x3a, 0x51, 0x97, 0xc1, 0xd8, 0x88, 0x18, 0x9c, 0x13, 0x70, 0xcb, 0x09, 0x9a, 0xa4,
-	0xf1, 0x29, 0x82, 0x19, 0x45, 0xa3, 0x4d, 0xe3, 0x20, 0xb2, 0x3b, 0x24, 0xb4, 0x1b, 0x71, 0xe0,
-	0xb5, 0x88, 0x96, 0x1d, 0x11, 0x91, 0x69, 0x49, 0x44, 0xe0, 0x6d, 0x91, 0x70, 0x45, 0xa0, 0xe1,
-	0x2b, 0x30, 0xd1, 0xa1, 0xb4, 0xc5, 0x84, 0x0a, 0xc4, 0xd3, 0x72, 0xe5, 0x6c, 0x35, 0x67, 0x15,
-	0xc5, 0x9a, 0x48, 0x4f, 0x0f, 0x4f, 0xc3, 0x38, 0x73, 0x69, 0x48, 0x44, 0xca, 0xe4, 0x2c, 0x39,
-	0xa9, 0xfc, 0x9c, 0x85, 0x82, 0xba, 0x56, 0xfc, 0x22, 0x4c, 0xca, 0x1a, 0xb4, 0x7b, 0xf3, 0xf8,
-	0xac, 0x5c, 0x5d, 0x56, 0xd9, 0x3c, 0x03, 0x05, 0xbe, 0xaf, 0xed, 0x7b, 0x22, 0x9b, 0x73, 0x56,
-	0x9e, 0x4f, 0xeb, 0x1e, 0x7e, 0x0f, 0x0a, 0x52, 0x07, 0x36, 0xb2, 0xd3, 0x27, 0x00, 0xf8, 0x43,
-	0xc0, 0x6a, 0x98, 0x16, 0x3d, 0x37, 0x22, 0xd8, 0x29, 0x85, 0xf5, 0x50, 0x70, 0x06, 0x13, 0xf2,
-	0xe6, 0x95, 0xe0, 0xe3, 0x23, 0x42, 0x2e, 0x0a, 0x94, 0xfe, 0x2b, 0xcc, 0xa7, 0xaf, 0x30, 0x86,
-	0x73, 0x6f, 0xf3, 0xf2, 0x95, 0x6d, 0x88, 0x59, 0xe4, 0x66, 0x4c, 0x58, 0x84, 0x37, 0x00, 0x1e,
-	0x76, 0x40, 0x71, 0x93, 0xc5, 0xda, 0x5c, 0x0f, 0xbf, 0xde, 0xa2, 0xdf, 0x72, 0x9a, 0x44, 0xc5,
-	0x5a, 0xa9, 0x48, 0xfc, 0x1c, 0xe4, 0x19, 0x71, 0x42, 0x77, 0x4f, 0xf5, 0x2e, 0x35, 0xab, 0x7c,
-	0x81, 0x60, 0xba, 0x17, 0x97, 0x75, 0x68, 0xc0, 0x08, 0xbe, 0x76, 0x0c, 0xf0, 0xfc, 0x50, 0x60,
-	0x19, 0xdc, 0x83, 0xfc, 0x06, 0x14, 0xd4, 0x37, 0x41, 0x95, 0xb5, 0x3e, 0xa8, 0x59, 0x91, 0x70,
-	0x25, 0xc7, 0xf5, 0xb5, 0x92, 0x80, 0x8a, 0x0f, 0x38, 0x45, 0x2e, 0xd1, 0x64, 0x70, 0x8b, 0x7e,
-	0x1d, 0xf2, 0xbc, 0xcf, 0xc5, 0x4c, 0xb4, 0xe1, 0xc9, 0xda, 0x95, 0x21, 0x7d, 0x31, 0x66, 0x96,
-	0x0a, 0xa8, 0xdc, 0x43, 0x3d, 0x17, 0xd0, 0xd5, 0xa1, 0x06, 0x79, 0xc9, 0x46, 0x69, 0x70, 0x02,
-	0x7b, 0x4b, 0x79, 0xe2, 0x37, 0xe1, 0xcc, 0x0d, 0x09, 0x92, 0x9c, 0x79, 0xf6, 0x04, 0x22, 0xea,
-	0xd0, 0xdd, 0x90, 0xca, 0x4f, 0x08, 0x2e, 0x76, 0xa9, 0xf0, 0x95, 0x95, 0x3e, 0x01, 0x4e, 0x2b,
-	0x29, 0x52, 0x42, 0x8e, 0x9d, 0x9a, 0x90, 0x5f, 0x23, 0xb8, 0x34, 0x80, 0xfd, 0x69, 0xa7, 0xd6,
-	0x13, 0xea, 0xfc, 0x23, 0x02, 0xbd, 0x8f, 0xe9, 0x16, 0xa5, 0xad, 0xd3, 0x56, 0x79, 0x60, 0xa7,
-	0x7d, 0x02, 0x91, 0xbf, 0x42, 0x30, 0x7b, 0x2c, 0xf5, 0xa7, 0x4b, 0xe2, 0x85, 0x36, 0x9c, 0xed,
-	0x39, 0x00, 0x2e, 0x81, 0xbe, 0xb1, 0xbb, 0xb9, 0x56, 0xdf, 0xbc, 0x66, 0x6f, 0xef, 0x2c, 0xef,
-	0xec, 0x6e, 0xdb, 0xbb, 0x9b, 0xdb, 0x5b, 0xeb, 0xab, 0xf5, 0x8d, 0xfa, 0xfa, 0xda, 0x54, 0x06,
-	0x5f, 0x80, 0xf3, 0x7d, 0xf6, 0xe5, 0xd5, 0x9d, 0xfa, 0xf5, 0xf5, 0x29, 0x84, 0x67, 0x61, 0xa6,
-	0xcf, 0x54, 0xdf, 0x54, 0xc6, 0x31, 0x3d, 0x77, 0xef, 0xcb, 0x52, 0xa6, 0xf6, 0x6f, 0x0e, 0x26,
-	0xd2, 0xdd, 0x0c, 0x7f, 0x84, 0xe4, 0x87, 0x91, 0x8f, 0xe7, 0x8f, 0x23, 0x7e, 0x4c, 0xcf, 0xd5,
-	0xab, 0xc3, 0x1d, 0xa5, 0x52, 0x95, 0xe7, 0x3f, 0xfe, 0xe5, 0xaf, 0xcf, 0xc7, 0x2e, 0xe1, 0x59,
-	0x73, 0xf0, 0x4b, 0x98, 0xbf, 0x2f, 0x92, 0xc7, 0xe5, 0xdc, 0x90, 0x9d, 0x13, 0x06, 0xf3, 0x43,
-	0xfd, 0x14, 0x81, 0x97, 0x04, 0x81, 0x39, 0xfc, 0xc2, 0x60, 0x02, 0xe6, 0x1d, 0x55, 0xd4, 0x77,
-	0xf1, 0x0f, 0x08, 0xa6, 0xfa, 0xab, 0x12, 0x2f, 0x9e, 0x88, 0x75, 0x4c, 0xfb, 0xd1, 0x97, 0xfe,
-	0x47, 0x84, 0xe2, 0xf9, 0x9a, 0xe0, 0x59, 0xc3, 0x8b, 0x83, 0x78, 0xf2, 0x28, 0xbb, 0x71, 0x60,
-	0x3f, 0xc2, 0xf9, 0x5b, 0x04, 0x93, 0xbd, 0x49, 0x8e, 0x8d, 0xc7, 0xc0, 0x4f, 0x15, 0xb2, 0x6e,
-	0x3e, 0xb6, 0xbf, 0x62, 0x7b, 0x55, 0xb0, 0x5d, 0xc4, 0xc6, 0x30, 0xb6, 0xbc, 0x90, 0xcd, 0x3b,
-	0xaa, 0xba, 0xef, 0xae, 0xac, 0xdd, 0x3f, 0x2c, 0xa1, 0x07, 0x87, 0x25, 0xf4, 0xe7, 0x61, 0x09,
-	0x7d, 0x76, 0x54, 0xca, 0x3c, 0x38, 0x2a, 0x65, 0x7e, 0x3b, 0x2a, 0x65, 0xde, 0x5d, 0x48, 0xbd,
-	0x17, 0xde, 0x7a, 0xe7, 0xfa, 0xfa, 0x26, 0x89, 0x6e, 0xd3, 0x70, 0xdf, 0x74, 0xf7, 0x1c, 0x3f,
-	0x30, 0xdf, 0x57, 0x10, 0xe2, 0xdd, 0xd0, 0xc8, 0x8b, 0xdf, 0x24, 0xaf, 0xfc, 0x17, 0x00, 0x00,
-	0xff, 0xff, 0x01, 0x21, 0xab, 0xbd, 0x5e, 0x0d, 0x00, 0x00,
+func (m *QueryFunderDelegatesRequest) Reset()         { *m = QueryFunderDelegatesRequest{} }
+func (m *QueryFunderDelegatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFunderDelegatesRequest) ProtoMessage()    {}
+func (*QueryFunderDelegatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a182f068d9f0dba9, []int{12}
+}
+func (m *QueryFunderDelegatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFunderDelegatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFunderDelegatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFunderDelegatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFunderDelegatesRequest.Merge(m, src)
+}
+func (m *QueryFunderDelegatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFunderDelegatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFunderDelegatesRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryFunderDelegatesRequest proto.InternalMessageInfo
 
-// QueryFundersClient is the client API for QueryFunders service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryFundersClient interface {
-	// Funders queries all funders.
-	Funders(ctx context.Context, in *QueryFundersRequest, opts ...grpc.CallOption) (*QueryFundersResponse, error)
-	// Funder queries a funder by address.
-	Funder(ctx context.Context, in *QueryFunderRequest, opts ...grpc.CallOption) (*QueryFunderResponse, error)
-	// FundingsByFunder queries all fundings of a funder by address.
-	FundingsByFunder(ctx context.Context, in *QueryFundingsByFunderRequest, opts ...grpc.CallOption) (*QueryFundingsByFunderResponse, error)
-	// FundingsByPool queries all fundings of a pool by id.
-	FundingsByPool(ctx context.Context, in *QueryFundingsByPoolRequest, opts ...grpc.CallOption) (*QueryFundingsByPoolResponse, error)
+func (m *QueryFunderDelegatesRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-type queryFundersClient struct {
-	cc grpc1.ClientConn
+// QueryFunderDelegatesResponse is the response type for the Query/FunderDelegates RPC method.
+type QueryFunderDelegatesResponse struct {
+	// delegates are all delegates of the funder including expired ones
+	Delegates []types.FunderDelegate `protobuf:"bytes,1,rep,name=delegates,proto3" json:"delegates"`
 }
 
-func NewQueryFundersClient(cc grpc1.ClientConn) QueryFundersClient {
-	return &queryFundersClient{cc}
+func (m *QueryFunderDelegatesResponse) Reset()         { *m = QueryFunderDelegatesResponse{} }
+func (m *QueryFunderDelegatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFunderDelegatesResponse) ProtoMessage()    {}
+func (*QueryFunderDelegatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a182f068d9f0dba9, []int{13}
 }
-
-func (c *queryFundersClient) Funders(ctx context.Context, in *QueryFundersRequest, opts ...grpc.CallOption) (*QueryFundersResponse, error) {
-	out := new(QueryFundersResponse)
-	err := c.cc.Invoke(ctx, "/kyve.query.v1beta1.QueryFunders/Funders", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryFunderDelegatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFunderDelegatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFunderDelegatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryFunderDelegatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFunderDelegatesResponse.Merge(m, src)
+}
+func (m *QueryFunderDelegatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFunderDelegatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFunderDelegatesResponse.DiscardUnknown(m)
 }
 
-func (c *queryFundersClient) Funder(ctx context.Context, in *QueryFunderRequest, opts ...grpc.CallOption) (*QueryFunderResponse, error) {
-	out := new(QueryFunderResponse)
-	err := c.cc.Invoke(ctx, "/kyve.query.v1beta1.QueryFunders/Funder", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFunderDelegatesResponse proto.InternalMessageInfo
+
+func (m *QueryFunderDelegatesResponse) GetDelegates() []types.FunderDelegate {
+	if m != nil {
+		return m.Delegates
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryFundersClient) FundingsByFunder(ctx context.Context, in *QueryFundingsByFunderRequest, opts ...grpc.CallOption) (*QueryFundingsByFunderResponse, error) {
-	out := new(QueryFundingsByFunderResponse)
-	err := c.cc.Invoke(ctx, "/kyve.query.v1beta1.QueryFunders/FundingsByFunder", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryCoinWeightsRequest ...
+type QueryCoinWeightsRequest struct {
 }
 
-func (c *queryFundersClient) FundingsByPool(ctx context.Context, in *QueryFundingsByPoolRequest, opts ...grpc.CallOption) (*QueryFundingsByPoolResponse, error) {
-	out := new(QueryFundingsByPoolResponse)
-	err := c.cc.Invoke(ctx, "/kyve.query.v1beta1.QueryFunders/FundingsByPool", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryCoinWeightsRequest) Reset()         { *m = QueryCoinWeightsRequest{} }
+func (m *QueryCoinWeightsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCoinWeightsRequest) ProtoMessage()    {}
+func (*QueryCoinWeightsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a182f068d9f0dba9, []int{14}
+}
+func (m *QueryCoinWeightsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCoinWeightsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCoinWeightsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// QueryFundersServer is the server API for QueryFunders service.
-type QueryFundersServer interface {
-	// Funders queries all funders.
-	Funders(context.Context, *QueryFundersRequest) (*QueryFundersResponse, error)
-	// Funder queries a funder by address.
-	Funder(context.Context, *QueryFunderRequest) (*QueryFunderResponse, error)
-	// FundingsByFunder queries all fundings of a funder by address.
-	FundingsByFunder(context.Context, *QueryFundingsByFunderRequest) (*QueryFundingsByFunderResponse, error)
-	// FundingsByPool queries all fundings of a pool by id.
-	FundingsByPool(context.Context, *QueryFundingsByPoolRequest) (*QueryFundingsByPoolResponse, error)
+func (m *QueryCoinWeightsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCoinWeightsRequest.Merge(m, src)
+}
+func (m *QueryCoinWeightsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCoinWeightsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCoinWeightsRequest.DiscardUnknown(m)
 }
 
-// UnimplementedQueryFundersServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryFundersServer struct {
+var xxx_messageInfo_QueryCoinWeightsRequest proto.InternalMessageInfo
+
+// QueryCoinWeightsResponse ...
+type QueryCoinWeightsResponse struct {
+	// coin_weights ...
+	CoinWeights []CoinWeight `protobuf:"bytes,1,rep,name=coin_weights,json=coinWeights,proto3" json:"coin_weights"`
 }
 
-func (*UnimplementedQueryFundersServer) Funders(ctx context.Context, req *QueryFundersRequest) (*QueryFundersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Funders not implemented")
+func (m *QueryCoinWeightsResponse) Reset()         { *m = QueryCoinWeightsResponse{} }
+func (m *QueryCoinWeightsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCoinWeightsResponse) ProtoMessage()    {}
+func (*QueryCoinWeightsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a182f068d9f0dba9, []int{15}
 }
-func (*UnimplementedQueryFundersServer) Funder(ctx context.Context, req *QueryFunderRequest) (*QueryFunderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Funder not implemented")
+func (m *QueryCoinWeightsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryFundersServer) FundingsByFunder(ctx context.Context, req *QueryFundingsByFunderRequest) (*QueryFundingsByFunderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FundingsByFunder not implemented")
+func (m *QueryCoinWeightsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCoinWeightsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryFundersServer) FundingsByPool(ctx context.Context, req *QueryFundingsByPoolRequest) (*QueryFundingsByPoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FundingsByPool not implemented")
+func (m *QueryCoinWeightsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCoinWeightsResponse.Merge(m, src)
 }
-
-func RegisterQueryFundersServer(s grpc1.Server, srv QueryFundersServer) {
-	s.RegisterService(&_QueryFunders_serviceDesc, srv)
+func (m *QueryCoinWeightsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCoinWeightsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCoinWeightsResponse.DiscardUnknown(m)
 }
 
-func _QueryFunders_Funders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFundersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryFundersServer).Funders(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.query.v1beta1.QueryFunders/Funders",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryFundersServer).Funders(ctx, req.(*QueryFundersRequest))
+var xxx_messageInfo_QueryCoinWeightsResponse proto.InternalMessageInfo
+
+func (m *QueryCoinWeightsResponse) GetCoinWeights() []CoinWeight {
+	if m != nil {
+		return m.CoinWeights
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _QueryFunders_Funder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFunderRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryFundersServer).Funder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.query.v1beta1.QueryFunders/Funder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryFundersServer).Funder(ctx, req.(*QueryFunderRequest))
+// CoinWeight ...
+type CoinWeight struct {
+	// denom is the denom of the whitelisted coin
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// static_weight is the coin weight defined in the coin whitelist
+	StaticWeight cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=static_weight,json=staticWeight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"static_weight"`
+	// oracle_weight is the latest coin weight derived from price reports
+	OracleWeight cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=oracle_weight,json=oracleWeight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"oracle_weight"`
+	// oracle_updated_at is the UNIX-timestamp (in seconds) of the last
+	// oracle update, zero if there was no update yet
+	OracleUpdatedAt uint64 `protobuf:"varint,4,opt,name=oracle_updated_at,json=oracleUpdatedAt,proto3" json:"oracle_updated_at,omitempty"`
+	// weight is the coin weight which is currently used by the protocol
+	Weight cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=weight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"weight"`
+}
+
+func (m *CoinWeight) Reset()         { *m = CoinWeight{} }
+func (m *CoinWeight) String() string { return proto.CompactTextString(m) }
+func (*CoinWeight) ProtoMessage()    {}
+func (*CoinWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a182f068d9f0dba9, []int{16}
+}
+func (m *CoinWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CoinWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CoinWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *CoinWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CoinWeight.Merge(m, src)
+}
+func (m *CoinWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *CoinWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_CoinWeight.DiscardUnknown(m)
 }
 
-func _QueryFunders_FundingsByFunder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFundingsByFunderRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryFundersServer).FundingsByFunder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.query.v1beta1.QueryFunders/FundingsByFunder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryFundersServer).FundingsByFunder(ctx, req.(*QueryFundingsByFunderRequest))
+var xxx_messageInfo_CoinWeight proto.InternalMessageInfo
+
+func (m *CoinWeight) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _QueryFunders_FundingsByPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFundingsByPoolRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryFundersServer).FundingsByPool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.query.v1beta1.QueryFunders/FundingsByPool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryFundersServer).FundingsByPool(ctx, req.(*QueryFundingsByPoolRequest))
+func (m *CoinWeight) GetOracleUpdatedAt() uint64 {
+	if m != nil {
+		return m.OracleUpdatedAt
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var QueryFunders_serviceDesc = _QueryFunders_serviceDesc
-var _QueryFunders_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "kyve.query.v1beta1.QueryFunders",
-	HandlerType: (*QueryFundersServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Funders",
-			Handler:    _QueryFunders_Funders_Handler,
-		},
-		{
-			MethodName: "Funder",
-			Handler:    _QueryFunders_Funder_Handler,
-		},
-		{
-			MethodName: "FundingsByFunder",
-			Handler:    _QueryFunders_FundingsByFunder_Handler,
-		},
-		{
-			MethodName: "FundingsByPool",
-			Handler:    _QueryFunders_FundingsByPool_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "kyve/query/v1beta1/funders.proto",
+// QueryMatchingCampaignsRequest ...
+type QueryMatchingCampaignsRequest struct {
+	// sponsor optionally filters the campaigns by their sponsor
+	Sponsor string `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
 }
 
-func (m *Funder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryMatchingCampaignsRequest) Reset()         { *m = QueryMatchingCampaignsRequest{} }
+func (m *QueryMatchingCampaignsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMatchingCampaignsRequest) ProtoMessage()    {}
+func (*QueryMatchingCampaignsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a182f068d9f0dba9, []int{17}
+}
+func (m *QueryMatchingCampaignsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMatchingCampaignsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMatchingCampaignsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryMatchingCampaignsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMatchingCampaignsRequest.Merge(m, src)
+}
+func (m *QueryMatchingCampaignsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMatchingCampaignsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMatchingCampaignsRequest.DiscardUnknown(m)
 }
 
-func (m *Funder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryMatchingCampaignsRequest proto.InternalMessageInfo
+
+func (m *QueryMatchingCampaignsRequest) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
 }
 
-func (m *Funder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Stats != nil {
-		{
-			size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintFunders(dAtA, i, uint64(size))
+// QueryMatchingCampaignsResponse ...
+type QueryMatchingCampaignsResponse struct {
+	// campaigns ...
+	Campaigns []types.MatchingCampaign `protobuf:"bytes,1,rep,name=campaigns,proto3" json:"campaigns"`
+}
+
+func (m *QueryMatchingCampaignsResponse) Reset()         { *m = QueryMatchingCampaignsResponse{} }
+func (m *QueryMatchingCampaignsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMatchingCampaignsResponse) ProtoMessage()    {}
+func (*QueryMatchingCampaignsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a182f068d9f0dba9, []int{18}
+}
+func (m *QueryMatchingCampaignsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMatchingCampaignsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMatchingCampaignsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintFunders(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Contact) > 0 {
-		i -= len(m.Contact)
-		copy(dAtA[i:], m.Contact)
-		i = encodeVarintFunders(dAtA, i, uint64(len(m.Contact)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Website) > 0 {
-		i -= len(m.Website)
-		copy(dAtA[i:], m.Website)
-		i = encodeVarintFunders(dAtA, i, uint64(len(m.Website)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Identity) > 0 {
-		i -= len(m.Identity)
-		copy(dAtA[i:], m.Identity)
-		i = encodeVarintFunders(dAtA, i, uint64(len(m.Identity)))
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if len(m.Moniker) > 0 {
-		i -= len(m.Moniker)
-		copy(dAtA[i:], m.Moniker)
-		i = encodeVarintFunders(dAtA, i, uint64(len(m.Moniker)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *QueryMatchingCampaignsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMatchingCampaignsResponse.Merge(m, src)
+}
+func (m *QueryMatchingCampaignsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMatchingCampaignsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMatchingCampaignsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMatchingCampaignsResponse proto.InternalMessageInfo
+
+func (m *QueryMatchingCampaignsResponse) GetCampaigns() []types.MatchingCampaign {
+	if m != nil {
+		return m.Campaigns
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintFunders(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+// QueryMatchingCampaignRequest ...
+type QueryMatchingCampaignRequest struct {
+	// id ...
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryMatchingCampaignRequest) Reset()         { *m = QueryMatchingCampaignRequest{} }
+func (m *QueryMatchingCampaignRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMatchingCampaignRequest) ProtoMessage()    {}
+func (*QueryMatchingCampaignRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a182f068d9f0dba9, []int{19}
+}
+func (m *QueryMatchingCampaignRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMatchingCampaignRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMatchingCampaignRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryMatchingCampaignRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMatchingCampaignRequest.Merge(m, src)
+}
+func (m *QueryMatchingCampaignRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMatchingCampaignRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMatchingCampaignRequest.DiscardUnknown(m)
 }
 
-func (m *FundingStats) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryMatchingCampaignRequest proto.InternalMessageInfo
+
+func (m *QueryMatchingCampaignRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *FundingStats) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryMatchingCampaignResponse ...
+type QueryMatchingCampaignResponse struct {
+	// campaign ...
+	Campaign types.MatchingCampaign `protobuf:"bytes,1,opt,name=campaign,proto3" json:"campaign"`
 }
 
-func (m *FundingStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Score != 0 {
-		i = encodeVarintFunders(dAtA, i, uint64(m.Score))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.PoolsFunded) > 0 {
-		dAtA3 := make([]byte, len(m.PoolsFunded)*10)
-		var j2 int
-		for _, num := range m.PoolsFunded {
-			for num >= 1<<7 {
-				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j2++
-			}
-			dAtA3[j2] = uint8(num)
-			j2++
+func (m *QueryMatchingCampaignResponse) Reset()         { *m = QueryMatchingCampaignResponse{} }
+func (m *QueryMatchingCampaignResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMatchingCampaignResponse) ProtoMessage()    {}
+func (*QueryMatchingCampaignResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a182f068d9f0dba9, []int{20}
+}
+func (m *QueryMatchingCampaignResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMatchingCampaignResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMatchingCampaignResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j2
-		copy(dAtA[i:], dAtA3[:j2])
-		i = encodeVarintFunders(dAtA, i, uint64(j2))
-		i--
-		dAtA[i] = 0x22
+		return b[:n], nil
 	}
-	if len(m.TotalAmountPerBundle) > 0 {
-		for iNdEx := len(m.TotalAmountPerBundle) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TotalAmountPerBundle[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintFunders(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.TotalAllocatedFunds) > 0 {
-		for iNdEx := len(m.TotalAllocatedFunds) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TotalAllocatedFunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintFunders(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.TotalUsedFunds) > 0 {
-		for iNdEx := len(m.TotalUsedFunds) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TotalUsedFunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintFunders(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryMatchingCampaignResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMatchingCampaignResponse.Merge(m, src)
+}
+func (m *QueryMatchingCampaignResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMatchingCampaignResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMatchingCampaignResponse.DiscardUnknown(m)
 }
 
-func (m *Funding) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryMatchingCampaignResponse proto.InternalMessageInfo
+
+func (m *QueryMatchingCampaignResponse) GetCampaign() types.MatchingCampaign {
+	if m != nil {
+		return m.Campaign
 	}
-	return dAtA[:n], nil
+	return types.MatchingCampaign{}
 }
 
-func (m *Funding) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryFundedBundlesRequest ...
+type QueryFundedBundlesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// address of the funder
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// pool_id ...
+	PoolId uint64 `protobuf:"varint,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
 }
 
-func (m *Funding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Score != 0 {
-		i = encodeVarintFunders(dAtA, i, uint64(m.Score))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.TotalFunded) > 0 {
-		for iNdEx := len(m.TotalFunded) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TotalFunded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintFunders(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.AmountsPerBundle) > 0 {
-		for iNdEx := len(m.AmountsPerBundle) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AmountsPerBundle[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintFunders(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.Amounts) > 0 {
-		for iNdEx := len(m.Amounts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintFunders(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *QueryFundedBundlesRequest) Reset()         { *m = QueryFundedBundlesRequest{} }
+func (m *QueryFundedBundlesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFundedBundlesRequest) ProtoMessage()    {}
+func (*QueryFundedBundlesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a182f068d9f0dba9, []int{21}
+}
+func (m *QueryFundedBundlesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFundedBundlesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFundedBundlesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintFunders(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.FunderAddress) > 0 {
-		i -= len(m.FunderAddress)
-		copy(dAtA[i:], m.FunderAddress)
-		i = encodeVarintFunders(dAtA, i, uint64(len(m.FunderAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryFundersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryFundedBundlesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFundedBundlesRequest.Merge(m, src)
 }
-
-func (m *QueryFundersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFundedBundlesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFundedBundlesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFundedBundlesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryFundersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Search) > 0 {
-		i -= len(m.Search)
-		copy(dAtA[i:], m.Search)
-		i = encodeVarintFunders(dAtA, i, uint64(len(m.Search)))
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_QueryFundedBundlesRequest proto.InternalMessageInfo
+
+func (m *QueryFundedBundlesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintFunders(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *QueryFundedBundlesRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryFundersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFundedBundlesRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryFundersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryFundedBundlesResponse ...
+type QueryFundedBundlesResponse struct {
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// contributions are the amounts the funder paid for each bundle ordered by the bundle id
+	Contributions []types.FundingContribution `protobuf:"bytes,2,rep,name=contributions,proto3" json:"contributions"`
 }
 
-func (m *QueryFundersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Funders) > 0 {
-		for iNdEx := len(m.Funders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Funders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintFunders(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *QueryFundedBundlesResponse) Reset()         { *m = QueryFundedBundlesResponse{} }
+func (m *QueryFundedBundlesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFundedBundlesResponse) ProtoMessage()    {}
+func (*QueryFundedBundlesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a182f068d9f0dba9, []int{22}
+}
+func (m *QueryFundedBundlesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFundedBundlesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFundedBundlesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintFunders(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryFundedBundlesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFundedBundlesResponse.Merge(m, src)
+}
+func (m *QueryFundedBundlesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFundedBundlesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFundedBundlesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFundedBundlesResponse proto.InternalMessageInfo
+
+func (m *QueryFundedBundlesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryFunderRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFundedBundlesResponse) GetContributions() []types.FundingContribution {
+	if m != nil {
+		return m.Contributions
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryFunderRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryBundleFundersRequest ...
+type QueryBundleFundersRequest struct {
+	// pool_id ...
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// bundle_id ...
+	BundleId uint64 `protobuf:"varint,2,opt,name=bundle_id,json=bundleId,proto3" json:"bundle_id,omitempty"`
 }
 
-func (m *QueryFunderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Status != 0 {
-		i = encodeVarintFunders(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x18
+func (m *QueryBundleFundersRequest) Reset()         { *m = QueryBundleFundersRequest{} }
+func (m *QueryBundleFundersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBundleFundersRequest) ProtoMessage()    {}
+func (*QueryBundleFundersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a182f068d9f0dba9, []int{23}
+}
+func (m *QueryBundleFundersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBundleFundersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBundleFundersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintFunders(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryBundleFundersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBundleFundersRequest.Merge(m, src)
+}
+func (m *QueryBundleFundersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBundleFundersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBundleFundersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBundleFundersRequest proto.InternalMessageInfo
+
+func (m *QueryBundleFundersRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryFunderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryBundleFundersRequest) GetBundleId() uint64 {
+	if m != nil {
+		return m.BundleId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryFunderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryBundleFundersResponse ...
+type QueryBundleFundersResponse struct {
+	// contributions are the amounts every funder paid for the bundle
+	Contributions []types.FundingContribution `protobuf:"bytes,1,rep,name=contributions,proto3" json:"contributions"`
 }
 
-func (m *QueryFunderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Fundings) > 0 {
-		for iNdEx := len(m.Fundings) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Fundings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintFunders(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *QueryBundleFundersResponse) Reset()         { *m = QueryBundleFundersResponse{} }
+func (m *QueryBundleFundersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBundleFundersResponse) ProtoMessage()    {}
+func (*QueryBundleFundersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a182f068d9f0dba9, []int{24}
+}
+func (m *QueryBundleFundersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBundleFundersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBundleFundersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Funder != nil {
-		{
-			size, err := m.Funder.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintFunders(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryBundleFundersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBundleFundersResponse.Merge(m, src)
+}
+func (m *QueryBundleFundersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBundleFundersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBundleFundersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBundleFundersResponse proto.InternalMessageInfo
+
+func (m *QueryBundleFundersResponse) GetContributions() []types.FundingContribution {
+	if m != nil {
+		return m.Contributions
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryFundingsByFunderRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterEnum("kyve.query.v1beta1.FundingStatus", FundingStatus_name, FundingStatus_value)
+	proto.RegisterType((*Funder)(nil), "kyve.query.v1beta1.Funder")
+	proto.RegisterType((*FunderLifetimeStats)(nil), "kyve.query.v1beta1.FunderLifetimeStats")
+	proto.RegisterType((*FundingStats)(nil), "kyve.query.v1beta1.FundingStats")
+	proto.RegisterType((*Funding)(nil), "kyve.query.v1beta1.Funding")
+	proto.RegisterType((*QueryFundersRequest)(nil), "kyve.query.v1beta1.QueryFundersRequest")
+	proto.RegisterType((*QueryFundersResponse)(nil), "kyve.query.v1beta1.QueryFundersResponse")
+	proto.RegisterType((*QueryFunderRequest)(nil), "kyve.query.v1beta1.QueryFunderRequest")
+	proto.RegisterType((*QueryFunderResponse)(nil), "kyve.query.v1beta1.QueryFunderResponse")
+	proto.RegisterType((*QueryFundingsByFunderRequest)(nil), "kyve.query.v1beta1.QueryFundingsByFunderRequest")
+	proto.RegisterType((*QueryFundingsByFunderResponse)(nil), "kyve.query.v1beta1.QueryFundingsByFunderResponse")
+	proto.RegisterType((*QueryFundingsByPoolRequest)(nil), "kyve.query.v1beta1.QueryFundingsByPoolRequest")
+	proto.RegisterType((*QueryFundingsByPoolResponse)(nil), "kyve.query.v1beta1.QueryFundingsByPoolResponse")
+	proto.RegisterType((*QueryFunderDelegatesRequest)(nil), "kyve.query.v1beta1.QueryFunderDelegatesRequest")
+	proto.RegisterType((*QueryFunderDelegatesResponse)(nil), "kyve.query.v1beta1.QueryFunderDelegatesResponse")
+	proto.RegisterType((*QueryCoinWeightsRequest)(nil), "kyve.query.v1beta1.QueryCoinWeightsRequest")
+	proto.RegisterType((*QueryCoinWeightsResponse)(nil), "kyve.query.v1beta1.QueryCoinWeightsResponse")
+	proto.RegisterType((*CoinWeight)(nil), "kyve.query.v1beta1.CoinWeight")
+	proto.RegisterType((*QueryMatchingCampaignsRequest)(nil), "kyve.query.v1beta1.QueryMatchingCampaignsRequest")
+	proto.RegisterType((*QueryMatchingCampaignsResponse)(nil), "kyve.query.v1beta1.QueryMatchingCampaignsResponse")
+	proto.RegisterType((*QueryMatchingCampaignRequest)(nil), "kyve.query.v1beta1.QueryMatchingCampaignRequest")
+	proto.RegisterType((*QueryMatchingCampaignResponse)(nil), "kyve.query.v1beta1.QueryMatchingCampaignResponse")
+	proto.RegisterType((*QueryFundedBundlesRequest)(nil), "kyve.query.v1beta1.QueryFundedBundlesRequest")
+	proto.RegisterType((*QueryFundedBundlesResponse)(nil), "kyve.query.v1beta1.QueryFundedBundlesResponse")
+	proto.RegisterType((*QueryBundleFundersRequest)(nil), "kyve.query.v1beta1.QueryBundleFundersRequest")
+	proto.RegisterType((*QueryBundleFundersResponse)(nil), "kyve.query.v1beta1.QueryBundleFundersResponse")
+}
+
+func init() { proto.RegisterFile("kyve/query/v1beta1/funders.proto", fileDescriptor_a182f068d9f0dba9) }
+
+var fileDescriptor_a182f068d9f0dba9 = []byte{
+	// 1768 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xdd, 0x6f, 0x1b, 0x59,
+	0x15, 0xcf, 0xd8, 0x8e, 0x93, 0x1c, 0xc7, 0x69, 0x7a, 0x9b, 0xdd, 0x38, 0x93, 0xae, 0xeb, 0x9d,
+	0x5d, 0x52, 0x13, 0xba, 0x33, 0x89, 0x11, 0x25, 0xbb, 0x08, 0x44, 0x3e, 0xb7, 0x86, 0x25, 0xca,
+	0x4e, 0x9a, 0x45, 0xf0, 0x62, 0x8d, 0x67, 0x6e, 0x9d, 0x21, 0xf6, 0x8c, 0x77, 0xee, 0x38, 0x25,
+	0x44, 0x45, 0x02, 0x21, 0xd1, 0x47, 0x54, 0x24, 0x5e, 0x10, 0xe2, 0x01, 0x21, 0xa0, 0x20, 0x54,
+	0xde, 0x90, 0xe0, 0x0f, 0xe8, 0x63, 0x25, 0x78, 0x40, 0x3c, 0x14, 0xd4, 0x22, 0xf1, 0xc4, 0xdf,
+	0x00, 0x9a, 0x7b, 0xcf, 0x8c, 0xc7, 0x5f, 0xb1, 0xa3, 0x26, 0x68, 0x5f, 0x5a, 0xdf, 0x7b, 0xcf,
+	0xc7, 0xef, 0x7c, 0xdc, 0x73, 0xce, 0xdc, 0x40, 0xe1, 0xe8, 0xe4, 0x98, 0x6a, 0x1f, 0xb7, 0xa8,
+	0x77, 0xa2, 0x1d, 0xaf, 0x56, 0xa9, 0x6f, 0xac, 0x6a, 0xf7, 0x5a, 0x8e, 0x45, 0x3d, 0xa6, 0x36,
+	0x3d, 0xd7, 0x77, 0x09, 0x09, 0x28, 0x54, 0x4e, 0xa1, 0x22, 0x85, 0x7c, 0xd5, 0x68, 0xd8, 0x8e,
+	0xab, 0xf1, 0x7f, 0x05, 0x99, 0xbc, 0x6c, 0xba, 0xac, 0xe1, 0x32, 0xad, 0x6a, 0xb0, 0x6e, 0x79,
+	0x4d, 0xa3, 0x66, 0x3b, 0x86, 0x6f, 0xbb, 0x0e, 0xd2, 0xe6, 0xe3, 0xb4, 0x21, 0x95, 0xe9, 0xda,
+	0xe1, 0xf9, 0x5c, 0xcd, 0xad, 0xb9, 0xfc, 0xa7, 0x16, 0xfc, 0xc2, 0xdd, 0xeb, 0x35, 0xd7, 0xad,
+	0xd5, 0xa9, 0x66, 0x34, 0x6d, 0xcd, 0x70, 0x1c, 0xd7, 0xe7, 0x22, 0x11, 0xa6, 0xac, 0x70, 0x43,
+	0x10, 0x7a, 0x7f, 0x53, 0x94, 0x9f, 0x25, 0x21, 0xbd, 0xc3, 0x77, 0x48, 0x0e, 0x26, 0x0c, 0xcb,
+	0xf2, 0x28, 0x63, 0x39, 0xa9, 0x20, 0x15, 0xa7, 0xf4, 0x70, 0x19, 0x9c, 0x34, 0x5c, 0xc7, 0x3e,
+	0xa2, 0x5e, 0x2e, 0x21, 0x4e, 0x70, 0x49, 0x64, 0x98, 0xb4, 0x2d, 0xea, 0xf8, 0xb6, 0x7f, 0x92,
+	0x4b, 0xf2, 0xa3, 0x68, 0x1d, 0x70, 0xdd, 0xa7, 0x55, 0x66, 0xfb, 0x34, 0x97, 0x12, 0x5c, 0xb8,
+	0x0c, 0x4e, 0x4c, 0xd7, 0xf1, 0x0d, 0xd3, 0xcf, 0x8d, 0x8b, 0x13, 0x5c, 0x92, 0x02, 0x64, 0x2c,
+	0xca, 0x4c, 0xcf, 0x6e, 0x06, 0x86, 0xe4, 0xd2, 0xfc, 0x34, 0xbe, 0x45, 0x6e, 0xc3, 0x38, 0xf3,
+	0x0d, 0x9f, 0xe5, 0x26, 0x0a, 0x52, 0x31, 0x53, 0x2a, 0xa8, 0xbd, 0xb1, 0x50, 0x03, 0x83, 0x6c,
+	0xa7, 0xb6, 0x1f, 0xd0, 0xe9, 0x82, 0x3c, 0x40, 0x7a, 0x4c, 0x3d, 0xfb, 0x9e, 0x4d, 0xad, 0xdc,
+	0x64, 0x41, 0x2a, 0x4e, 0xea, 0xd1, 0x9a, 0x94, 0x21, 0x63, 0xf8, 0x3e, 0x65, 0xc2, 0x7d, 0xb9,
+	0x29, 0x2e, 0xf9, 0xa6, 0x90, 0x1c, 0xba, 0x2b, 0x2e, 0x9b, 0x7a, 0xeb, 0x6d, 0x72, 0x3d, 0xce,
+	0x4b, 0x76, 0x61, 0xa6, 0x6e, 0xdf, 0xa3, 0xbe, 0xdd, 0xa0, 0x15, 0x81, 0x13, 0xe2, 0xd2, 0x7a,
+	0x71, 0x52, 0xef, 0x03, 0xa4, 0x17, 0x70, 0xb3, 0xf5, 0xf8, 0x52, 0xf9, 0x8f, 0x04, 0xd7, 0xfa,
+	0x90, 0x91, 0x13, 0xb8, 0x12, 0xe9, 0xe1, 0x10, 0xad, 0x9c, 0x54, 0x48, 0x16, 0x33, 0xa5, 0x05,
+	0x55, 0x64, 0x92, 0x1a, 0x64, 0x52, 0xa4, 0x69, 0xd3, 0xb5, 0x9d, 0x8d, 0xcf, 0x3d, 0x7d, 0x7e,
+	0x63, 0xec, 0xf1, 0x3f, 0x6e, 0x14, 0x6b, 0xb6, 0x7f, 0xd8, 0xaa, 0xaa, 0xa6, 0xdb, 0xd0, 0x30,
+	0xed, 0xc4, 0x7f, 0xef, 0x30, 0xeb, 0x48, 0xf3, 0x4f, 0x9a, 0x94, 0x71, 0x06, 0xf6, 0xeb, 0x7f,
+	0x3f, 0x59, 0x96, 0xf4, 0xc8, 0x20, 0x8e, 0xc3, 0x22, 0x6f, 0xc2, 0x74, 0xd3, 0x75, 0xeb, 0x2c,
+	0xd4, 0x9b, 0x28, 0x24, 0x8b, 0x29, 0x3d, 0xc3, 0xf7, 0x90, 0x64, 0x0d, 0x72, 0xc6, 0x31, 0xf5,
+	0x8c, 0x9a, 0x00, 0x67, 0x3b, 0xb5, 0x8a, 0xd5, 0xf2, 0x84, 0x77, 0x83, 0x34, 0x49, 0xe9, 0xaf,
+	0xe3, 0x39, 0x86, 0x6a, 0x0b, 0x4f, 0x95, 0xbf, 0x26, 0x61, 0x3a, 0x1e, 0x3e, 0xf2, 0x1d, 0x98,
+	0xf5, 0x5d, 0xdf, 0xa8, 0x57, 0x5a, 0x8c, 0x5a, 0x5c, 0x1a, 0xbb, 0x3c, 0x4b, 0xb9, 0xa6, 0x03,
+	0x46, 0xad, 0x00, 0x02, 0x23, 0x3f, 0x90, 0xe0, 0x35, 0xa1, 0xdc, 0xa8, 0xd7, 0x5d, 0xd3, 0xf0,
+	0x23, 0x04, 0x89, 0x4b, 0x42, 0x70, 0x8d, 0xab, 0x5b, 0x0f, 0xb5, 0x09, 0x18, 0x3f, 0x94, 0x60,
+	0x1e, 0x61, 0x34, 0xdc, 0x96, 0xe3, 0x57, 0x9a, 0xd4, 0xab, 0x54, 0x5b, 0x8e, 0x55, 0xa7, 0xb9,
+	0xe4, 0x25, 0x01, 0x99, 0x13, 0x40, 0xb8, 0xbe, 0x3d, 0xea, 0x6d, 0x70, 0x6d, 0x3d, 0xa1, 0x4f,
+	0xf5, 0x86, 0x7e, 0x0e, 0xc6, 0x99, 0xe9, 0x7a, 0x94, 0xdf, 0xec, 0x94, 0x2e, 0x16, 0xca, 0xa3,
+	0x14, 0x4c, 0x60, 0x58, 0xc9, 0xa7, 0x60, 0x46, 0x5c, 0xaa, 0x4a, 0x67, 0xb9, 0xc9, 0x8a, 0xdd,
+	0x75, 0xb1, 0x49, 0xe6, 0x61, 0x22, 0x90, 0x5b, 0xb1, 0x2d, 0x5e, 0x74, 0x52, 0x7a, 0x3a, 0x58,
+	0x96, 0x2d, 0xf2, 0x2d, 0x98, 0x10, 0x7e, 0x60, 0x97, 0x66, 0x7d, 0xa8, 0x80, 0x7c, 0x17, 0x08,
+	0xfe, 0x8c, 0x3b, 0x3d, 0x75, 0x49, 0x6a, 0x67, 0x51, 0x57, 0xdb, 0xe1, 0x0c, 0xa6, 0x45, 0xe4,
+	0xd1, 0xe1, 0xe3, 0x97, 0xa4, 0x39, 0xc3, 0xb5, 0x74, 0x87, 0x30, 0x1d, 0x0b, 0x21, 0xd9, 0x83,
+	0x8c, 0xe9, 0x3a, 0xcc, 0xf7, 0x0c, 0xdb, 0x89, 0xca, 0x6f, 0x71, 0x70, 0x91, 0xb4, 0x9d, 0xda,
+	0x66, 0x9b, 0x7e, 0x23, 0x15, 0x00, 0xd3, 0xe3, 0x22, 0x94, 0x16, 0x5c, 0xfb, 0x30, 0xa8, 0x87,
+	0xa2, 0xbe, 0x31, 0x9d, 0x7e, 0xdc, 0xa2, 0xcc, 0x27, 0x3b, 0x00, 0xed, 0xf6, 0xc8, 0x73, 0x23,
+	0x53, 0x5a, 0xea, 0xb0, 0xb8, 0xb3, 0x8a, 0xee, 0x19, 0x35, 0x8a, 0xbc, 0x7a, 0x8c, 0x93, 0xbc,
+	0x0e, 0x69, 0x46, 0x0d, 0xcf, 0x3c, 0xc4, 0xa6, 0x85, 0x2b, 0xe5, 0xa7, 0x12, 0xcc, 0x75, 0xea,
+	0x65, 0x4d, 0xd7, 0x61, 0x94, 0xbc, 0xdf, 0x47, 0xf1, 0xcd, 0xa1, 0x8a, 0x05, 0x73, 0x87, 0xe6,
+	0xf7, 0x60, 0x02, 0x3d, 0x82, 0x85, 0x42, 0x1e, 0x5c, 0xfd, 0xd1, 0x31, 0x21, 0x83, 0x62, 0x03,
+	0x89, 0x81, 0x0b, 0x7d, 0x32, 0xb8, 0x37, 0xbf, 0x0b, 0xe9, 0xa0, 0xcf, 0xb4, 0x18, 0x2f, 0xac,
+	0x33, 0xa5, 0x37, 0x87, 0x34, 0xc4, 0x16, 0xd3, 0x91, 0x41, 0x79, 0x28, 0x75, 0x04, 0x20, 0xf2,
+	0x43, 0x09, 0xd2, 0x02, 0x0d, 0xfa, 0xe0, 0x0c, 0xf4, 0x3a, 0x52, 0x92, 0x2f, 0xc2, 0x24, 0x56,
+	0xfa, 0xd0, 0xe6, 0xc5, 0x33, 0x80, 0xa0, 0xd1, 0x11, 0x8b, 0xf2, 0x27, 0x09, 0xae, 0x47, 0x50,
+	0x82, 0x9d, 0x8d, 0x2e, 0x07, 0x5c, 0x54, 0x52, 0xc4, 0x1c, 0x99, 0xb8, 0x30, 0x47, 0xfe, 0x4a,
+	0x82, 0x37, 0x06, 0xa0, 0xbf, 0xe8, 0xd4, 0x7a, 0x45, 0x3f, 0xff, 0x51, 0x02, 0xb9, 0x0b, 0xe9,
+	0x9e, 0xeb, 0xd6, 0x2f, 0xda, 0xcb, 0x03, 0x6b, 0xf7, 0x2b, 0x38, 0xf9, 0x97, 0x12, 0x2c, 0xf6,
+	0x85, 0xfe, 0x09, 0x73, 0xf1, 0xe7, 0x63, 0x30, 0xa9, 0xb7, 0x45, 0xeb, 0xb4, 0x66, 0xf8, 0x94,
+	0x0d, 0xbd, 0xc9, 0xca, 0x21, 0x5c, 0xef, 0xcf, 0x88, 0x06, 0xde, 0x81, 0x29, 0x2b, 0xdc, 0xc4,
+	0x11, 0xe8, 0xed, 0xb3, 0x66, 0xd4, 0x50, 0x02, 0x22, 0x6c, 0x33, 0x2b, 0x0b, 0x30, 0xcf, 0x35,
+	0x05, 0x0d, 0xe0, 0xeb, 0xd4, 0xae, 0x1d, 0xfa, 0x21, 0x3c, 0xc5, 0x84, 0x5c, 0xef, 0x51, 0xe4,
+	0xe1, 0xe9, 0xe0, 0x8b, 0xa4, 0x72, 0x5f, 0xec, 0x23, 0x86, 0x7c, 0x3f, 0xe7, 0xb4, 0xd9, 0xdb,
+	0x85, 0x3f, 0x12, 0xa8, 0xfc, 0x3c, 0x01, 0xd0, 0xa6, 0x08, 0xfa, 0x8d, 0x45, 0x1d, 0xb7, 0x81,
+	0x0e, 0x11, 0x0b, 0x72, 0x07, 0xb2, 0x7c, 0xa8, 0x36, 0x51, 0x9f, 0xb8, 0xaf, 0x1b, 0x6f, 0x05,
+	0xe2, 0xfe, 0xfe, 0xfc, 0xc6, 0xa2, 0x88, 0x2c, 0xb3, 0x8e, 0x54, 0xdb, 0xd5, 0x1a, 0x86, 0x7f,
+	0xa8, 0x7e, 0x40, 0x6b, 0x86, 0x79, 0xb2, 0x45, 0x4d, 0x7d, 0x5a, 0x70, 0xa2, 0xfc, 0x3b, 0x90,
+	0x75, 0x3d, 0xc3, 0xac, 0xd3, 0x50, 0x52, 0xf2, 0x1c, 0x92, 0x04, 0x27, 0x4a, 0x5a, 0x86, 0xab,
+	0x28, 0xa9, 0xd5, 0xb4, 0xf8, 0x38, 0x68, 0xf8, 0xfc, 0xe3, 0x26, 0xa5, 0x5f, 0x11, 0x07, 0x07,
+	0x62, 0x7f, 0xdd, 0x27, 0x5f, 0x80, 0x34, 0xaa, 0x1b, 0x1f, 0x5d, 0x1d, 0xb2, 0x28, 0xef, 0x62,
+	0x41, 0xf9, 0x9a, 0xe1, 0x9b, 0x87, 0x41, 0x27, 0x35, 0x1a, 0x4d, 0xc3, 0xae, 0x39, 0xf1, 0x34,
+	0xe2, 0x51, 0x71, 0xbd, 0x30, 0x8d, 0x70, 0xa9, 0xd4, 0x21, 0x3f, 0x88, 0x15, 0xe3, 0xf8, 0x15,
+	0x98, 0x32, 0xc3, 0x4d, 0x0c, 0xe2, 0x52, 0xff, 0x44, 0xea, 0x96, 0x11, 0xa6, 0x52, 0xc4, 0xae,
+	0xa8, 0x98, 0xb4, 0xdd, 0x94, 0x21, 0xce, 0x19, 0x48, 0xd8, 0x16, 0x87, 0x98, 0xd2, 0x13, 0xb6,
+	0xa5, 0xd8, 0x03, 0x0c, 0x8b, 0x65, 0xf9, 0x64, 0x28, 0x3d, 0x2a, 0x40, 0xe7, 0xc1, 0x16, 0x71,
+	0x2b, 0x3f, 0x91, 0x60, 0xa1, 0x7d, 0xa1, 0x2c, 0x31, 0x51, 0xb1, 0xff, 0x5f, 0x43, 0x89, 0x15,
+	0xc1, 0x64, 0xbc, 0x08, 0x2a, 0x7f, 0x8e, 0x17, 0xe1, 0x18, 0xb0, 0x8b, 0x2e, 0x64, 0x07, 0x90,
+	0x0d, 0xbe, 0xab, 0x3d, 0xbb, 0xda, 0x0a, 0xd6, 0x61, 0x35, 0xfb, 0xf4, 0xb0, 0x99, 0x2d, 0xe2,
+	0x40, 0x97, 0x76, 0x4a, 0x51, 0x3e, 0x44, 0xb7, 0x0a, 0xdc, 0x5d, 0xc3, 0x5b, 0xcc, 0x68, 0xa9,
+	0xa3, 0xf2, 0x2f, 0xc2, 0x94, 0x98, 0x9e, 0xdb, 0x4d, 0x61, 0x52, 0x6c, 0x94, 0x2d, 0x85, 0x81,
+	0xdc, 0x4f, 0x24, 0x3a, 0xa4, 0xc7, 0x0e, 0xe9, 0x22, 0xec, 0x58, 0x6e, 0x40, 0xb6, 0xa3, 0xd3,
+	0x90, 0x3c, 0xc8, 0x3b, 0x07, 0xbb, 0x5b, 0xe5, 0xdd, 0xf7, 0x2b, 0xfb, 0x77, 0xd7, 0xef, 0x1e,
+	0xec, 0x57, 0x0e, 0x76, 0xf7, 0xf7, 0xb6, 0x37, 0xcb, 0x3b, 0xe5, 0xed, 0xad, 0xd9, 0x31, 0xb2,
+	0x00, 0xaf, 0x75, 0x9d, 0xaf, 0x6f, 0xde, 0x2d, 0x7f, 0xb4, 0x3d, 0x2b, 0x91, 0x45, 0x98, 0xef,
+	0x3a, 0x2a, 0xef, 0xe2, 0x61, 0x42, 0x4e, 0x3d, 0xfc, 0x45, 0x7e, 0xac, 0xf4, 0xdf, 0x69, 0x98,
+	0x8e, 0x8f, 0x9d, 0xe4, 0x7b, 0x12, 0x4c, 0x84, 0xbf, 0xfb, 0x3e, 0x0f, 0xf4, 0x19, 0x8e, 0xe5,
+	0xe2, 0x70, 0x42, 0xe1, 0x35, 0xe5, 0xad, 0xef, 0xff, 0xe5, 0x5f, 0x3f, 0x4e, 0xbc, 0x41, 0x16,
+	0xb5, 0xc1, 0xef, 0x59, 0xc1, 0xa7, 0x65, 0xf8, 0xfc, 0xb3, 0x34, 0x44, 0x72, 0x88, 0xe0, 0xe6,
+	0x50, 0x3a, 0x04, 0x70, 0x8b, 0x03, 0x58, 0x22, 0x6f, 0x0f, 0x06, 0xa0, 0x9d, 0xe2, 0x65, 0x79,
+	0x40, 0xfe, 0x20, 0xc1, 0x6c, 0xf7, 0xf8, 0x44, 0x56, 0xce, 0xd4, 0xd5, 0x67, 0x4e, 0x94, 0x57,
+	0xcf, 0xc1, 0x81, 0x38, 0xd7, 0x38, 0xce, 0x12, 0x59, 0x19, 0x84, 0x33, 0xe0, 0xaa, 0x54, 0x4f,
+	0x2a, 0x3d, 0x98, 0x7f, 0x23, 0xc1, 0x4c, 0xe7, 0x34, 0x42, 0xd4, 0x11, 0xf4, 0xc7, 0x26, 0x2e,
+	0x59, 0x1b, 0x99, 0x1e, 0xd1, 0xde, 0xe6, 0x68, 0x57, 0x88, 0x3a, 0x0c, 0x6d, 0x70, 0xef, 0xb4,
+	0x53, 0xbc, 0x8c, 0x0f, 0xc8, 0xef, 0x24, 0xb8, 0xd2, 0x35, 0x59, 0x10, 0x6d, 0x48, 0x28, 0xbb,
+	0x87, 0x17, 0x79, 0x65, 0x74, 0x86, 0x51, 0xe1, 0x52, 0xaf, 0x12, 0x0d, 0x26, 0x31, 0xd7, 0x3e,
+	0x92, 0x20, 0x13, 0x9b, 0x41, 0xc8, 0x67, 0x06, 0x6a, 0xee, 0x1d, 0x62, 0xe4, 0x5b, 0xa3, 0x11,
+	0x23, 0xc4, 0x22, 0x87, 0xa8, 0x90, 0x42, 0x3f, 0x88, 0xf1, 0x81, 0x87, 0x3c, 0x96, 0xe0, 0x6a,
+	0x4f, 0x5b, 0x25, 0x83, 0x53, 0x6e, 0x50, 0xf7, 0x96, 0x4b, 0xe7, 0x61, 0x41, 0x98, 0x2a, 0x87,
+	0x59, 0x24, 0x4b, 0xfd, 0x60, 0x36, 0x90, 0xad, 0x12, 0x75, 0x66, 0xf2, 0x5b, 0x09, 0x66, 0xbb,
+	0xa5, 0x9d, 0x71, 0xa1, 0x06, 0x34, 0x70, 0x79, 0xf5, 0x1c, 0x1c, 0x88, 0xb4, 0xc4, 0x91, 0xde,
+	0x22, 0xcb, 0x23, 0x21, 0xd5, 0x4e, 0x83, 0xf4, 0xfc, 0xbd, 0x04, 0xd9, 0x8e, 0x76, 0x48, 0xde,
+	0x39, 0x3b, 0xd7, 0xba, 0xfa, 0xb9, 0xac, 0x8e, 0x4a, 0x8e, 0x20, 0xbf, 0xc4, 0x41, 0xae, 0x91,
+	0xdb, 0x03, 0x13, 0xd3, 0xc2, 0xe7, 0x9e, 0x58, 0x5a, 0xc6, 0xee, 0xd3, 0x13, 0x09, 0xb2, 0x1d,
+	0xed, 0xea, 0x0c, 0xc0, 0xfd, 0x3a, 0xa5, 0xac, 0x8e, 0x4a, 0x8e, 0x80, 0xbf, 0xcc, 0x01, 0xbf,
+	0x47, 0xd6, 0xfa, 0x01, 0xc6, 0xd6, 0x1a, 0xbe, 0xf5, 0x47, 0x30, 0xb5, 0xd3, 0xa8, 0xe9, 0x3e,
+	0xd8, 0xd8, 0x7a, 0xfa, 0x22, 0x2f, 0x3d, 0x7b, 0x91, 0x97, 0xfe, 0xf9, 0x22, 0x2f, 0xfd, 0xe8,
+	0x65, 0x7e, 0xec, 0xd9, 0xcb, 0xfc, 0xd8, 0xdf, 0x5e, 0xe6, 0xc7, 0xbe, 0xb9, 0x1c, 0x7b, 0x2d,
+	0xfa, 0xea, 0x37, 0x3e, 0xda, 0xde, 0xa5, 0xfe, 0x7d, 0xd7, 0x3b, 0xd2, 0xcc, 0x43, 0xc3, 0x76,
+	0xb4, 0x6f, 0xa3, 0x32, 0xfe, 0x6a, 0x54, 0x4d, 0xf3, 0x3f, 0x1c, 0x7c, 0xf6, 0x7f, 0x03, 0x00,
+	0x10, 0x8d, 0xe7, 0x34, 0x27, 0x19, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryFundersClient is the client API for QueryFunders service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryFundersClient interface {
+	// Funders queries all funders.
+	Funders(ctx context.Context, in *QueryFundersRequest, opts ...grpc.CallOption) (*QueryFundersResponse, error)
+	// Funder queries a funder by address.
+	Funder(ctx context.Context, in *QueryFunderRequest, opts ...grpc.CallOption) (*QueryFunderResponse, error)
+	// FundingsByFunder queries all fundings of a funder by address.
+	FundingsByFunder(ctx context.Context, in *QueryFundingsByFunderRequest, opts ...grpc.CallOption) (*QueryFundingsByFunderResponse, error)
+	// FundingsByPool queries all fundings of a pool by id.
+	FundingsByPool(ctx context.Context, in *QueryFundingsByPoolRequest, opts ...grpc.CallOption) (*QueryFundingsByPoolResponse, error)
+	// FunderDelegates queries all delegates of a funder by address.
+	FunderDelegates(ctx context.Context, in *QueryFunderDelegatesRequest, opts ...grpc.CallOption) (*QueryFunderDelegatesResponse, error)
+	// CoinWeights queries the static and oracle coin weights of all whitelisted coins.
+	CoinWeights(ctx context.Context, in *QueryCoinWeightsRequest, opts ...grpc.CallOption) (*QueryCoinWeightsResponse, error)
+	// MatchingCampaigns queries all matching campaigns.
+	MatchingCampaigns(ctx context.Context, in *QueryMatchingCampaignsRequest, opts ...grpc.CallOption) (*QueryMatchingCampaignsResponse, error)
+	// MatchingCampaign queries a matching campaign by id.
+	MatchingCampaign(ctx context.Context, in *QueryMatchingCampaignRequest, opts ...grpc.CallOption) (*QueryMatchingCampaignResponse, error)
+	// FundedBundles queries the contributions of a funder to the recent finalized bundles of a pool.
+	FundedBundles(ctx context.Context, in *QueryFundedBundlesRequest, opts ...grpc.CallOption) (*QueryFundedBundlesResponse, error)
+	// BundleFunders queries the contributions of all funders to a finalized bundle.
+	BundleFunders(ctx context.Context, in *QueryBundleFundersRequest, opts ...grpc.CallOption) (*QueryBundleFundersResponse, error)
+}
+
+type queryFundersClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryFundersClient(cc grpc1.ClientConn) QueryFundersClient {
+	return &queryFundersClient{cc}
+}
+
+func (c *queryFundersClient) Funders(ctx context.Context, in *QueryFundersRequest, opts ...grpc.CallOption) (*QueryFundersResponse, error) {
+	out := new(QueryFundersResponse)
+	err := c.cc.Invoke(ctx, "/kyve.query.v1beta1.QueryFunders/Funders", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryFundingsByFunderRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryFundersClient) Funder(ctx context.Context, in *QueryFunderRequest, opts ...grpc.CallOption) (*QueryFunderResponse, error) {
+	out := new(QueryFunderResponse)
+	err := c.cc.Invoke(ctx, "/kyve.query.v1beta1.QueryFunders/Funder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryFundingsByFunderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Status != 0 {
-		i = encodeVarintFunders(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintFunders(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintFunders(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryFundersClient) FundingsByFunder(ctx context.Context, in *QueryFundingsByFunderRequest, opts ...grpc.CallOption) (*QueryFundingsByFunderResponse, error) {
+	out := new(QueryFundingsByFunderResponse)
+	err := c.cc.Invoke(ctx, "/kyve.query.v1beta1.QueryFunders/FundingsByFunder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryFundingsByFunderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryFundersClient) FundingsByPool(ctx context.Context, in *QueryFundingsByPoolRequest, opts ...grpc.CallOption) (*QueryFundingsByPoolResponse, error) {
+	out := new(QueryFundingsByPoolResponse)
+	err := c.cc.Invoke(ctx, "/kyve.query.v1beta1.QueryFunders/FundingsByPool", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryFundingsByFunderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryFundersClient) FunderDelegates(ctx context.Context, in *QueryFunderDelegatesRequest, opts ...grpc.CallOption) (*QueryFunderDelegatesResponse, error) {
+	out := new(QueryFunderDelegatesResponse)
+	err := c.cc.Invoke(ctx, "/kyve.query.v1beta1.QueryFunders/FunderDelegates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryFundingsByFunderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Fundings) > 0 {
-		for iNdEx := len(m.Fundings) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Fundings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintFunders(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintFunders(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryFundersClient) CoinWeights(ctx context.Context, in *QueryCoinWeightsRequest, opts ...grpc.CallOption) (*QueryCoinWeightsResponse, error) {
+	out := new(QueryCoinWeightsResponse)
+	err := c.cc.Invoke(ctx, "/kyve.query.v1beta1.QueryFunders/CoinWeights", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryFundingsByPoolRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryFundersClient) MatchingCampaigns(ctx context.Context, in *QueryMatchingCampaignsRequest, opts ...grpc.CallOption) (*QueryMatchingCampaignsResponse, error) {
+	out := new(QueryMatchingCampaignsResponse)
+	err := c.cc.Invoke(ctx, "/kyve.query.v1beta1.QueryFunders/MatchingCampaigns", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryFundingsByPoolRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryFundersClient) MatchingCampaign(ctx context.Context, in *QueryMatchingCampaignRequest, opts ...grpc.CallOption) (*QueryMatchingCampaignResponse, error) {
+	out := new(QueryMatchingCampaignResponse)
+	err := c.cc.Invoke(ctx, "/kyve.query.v1beta1.QueryFunders/MatchingCampaign", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryFundingsByPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Status != 0 {
-		i = encodeVarintFunders(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintFunders(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintFunders(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryFundersClient) FundedBundles(ctx context.Context, in *QueryFundedBundlesRequest, opts ...grpc.CallOption) (*QueryFundedBundlesResponse, error) {
+	out := new(QueryFundedBundlesResponse)
+	err := c.cc.Invoke(ctx, "/kyve.query.v1beta1.QueryFunders/FundedBundles", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryFundingsByPoolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryFundersClient) BundleFunders(ctx context.Context, in *QueryBundleFundersRequest, opts ...grpc.CallOption) (*QueryBundleFundersResponse, error) {
+	out := new(QueryBundleFundersResponse)
+	err := c.cc.Invoke(ctx, "/kyve.query.v1beta1.QueryFunders/BundleFunders", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryFundingsByPoolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryFundersServer is the server API for QueryFunders service.
+type QueryFundersServer interface {
+	// Funders queries all funders.
+	Funders(context.Context, *QueryFundersRequest) (*QueryFundersResponse, error)
+	// Funder queries a funder by address.
+	Funder(context.Context, *QueryFunderRequest) (*QueryFunderResponse, error)
+	// FundingsByFunder queries all fundings of a funder by address.
+	FundingsByFunder(context.Context, *QueryFundingsByFunderRequest) (*QueryFundingsByFunderResponse, error)
+	// FundingsByPool queries all fundings of a pool by id.
+	FundingsByPool(context.Context, *QueryFundingsByPoolRequest) (*QueryFundingsByPoolResponse, error)
+	// FunderDelegates queries all delegates of a funder by address.
+	FunderDelegates(context.Context, *QueryFunderDelegatesRequest) (*QueryFunderDelegatesResponse, error)
+	// CoinWeights queries the static and oracle coin weights of all whitelisted coins.
+	CoinWeights(context.Context, *QueryCoinWeightsRequest) (*QueryCoinWeightsResponse, error)
+	// MatchingCampaigns queries all matching campaigns.
+	MatchingCampaigns(context.Context, *QueryMatchingCampaignsRequest) (*QueryMatchingCampaignsResponse, error)
+	// MatchingCampaign queries a matching campaign by id.
+	MatchingCampaign(context.Context, *QueryMatchingCampaignRequest) (*QueryMatchingCampaignResponse, error)
+	// FundedBundles queries the contributions of a funder to the recent finalized bundles of a pool.
+	FundedBundles(context.Context, *QueryFundedBundlesRequest) (*QueryFundedBundlesResponse, error)
+	// BundleFunders queries the contributions of all funders to a finalized bundle.
+	BundleFunders(context.Context, *QueryBundleFundersRequest) (*QueryBundleFundersResponse, error)
 }
 
-func (m *QueryFundingsByPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Fundings) > 0 {
-		for iNdEx := len(m.Fundings) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Fundings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintFunders(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintFunders(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryFundersServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryFundersServer struct {
 }
 
-func encodeVarintFunders(dAtA []byte, offset int, v uint64) int {
-	offset -= sovFunders(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (*UnimplementedQueryFundersServer) Funders(ctx context.Context, req *QueryFundersRequest) (*QueryFundersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Funders not implemented")
 }
-func (m *Funder) Size() (n int) {
-	if m == nil {
-		return 0
+func (*UnimplementedQueryFundersServer) Funder(ctx context.Context, req *QueryFunderRequest) (*QueryFunderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Funder not implemented")
+}
+func (*UnimplementedQueryFundersServer) FundingsByFunder(ctx context.Context, req *QueryFundingsByFunderRequest) (*QueryFundingsByFunderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundingsByFunder not implemented")
+}
+func (*UnimplementedQueryFundersServer) FundingsByPool(ctx context.Context, req *QueryFundingsByPoolRequest) (*QueryFundingsByPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundingsByPool not implemented")
+}
+func (*UnimplementedQueryFundersServer) FunderDelegates(ctx context.Context, req *QueryFunderDelegatesRequest) (*QueryFunderDelegatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FunderDelegates not implemented")
+}
+func (*UnimplementedQueryFundersServer) CoinWeights(ctx context.Context, req *QueryCoinWeightsRequest) (*QueryCoinWeightsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CoinWeights not implemented")
+}
+func (*UnimplementedQueryFundersServer) MatchingCampaigns(ctx context.Context, req *QueryMatchingCampaignsRequest) (*QueryMatchingCampaignsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MatchingCampaigns not implemented")
+}
+func (*UnimplementedQueryFundersServer) MatchingCampaign(ctx context.Context, req *QueryMatchingCampaignRequest) (*QueryMatchingCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MatchingCampaign not implemented")
+}
+func (*UnimplementedQueryFundersServer) FundedBundles(ctx context.Context, req *QueryFundedBundlesRequest) (*QueryFundedBundlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundedBundles not implemented")
+}
+func (*UnimplementedQueryFundersServer) BundleFunders(ctx context.Context, req *QueryBundleFundersRequest) (*QueryBundleFundersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BundleFunders not implemented")
+}
+
+func RegisterQueryFundersServer(s grpc1.Server, srv QueryFundersServer) {
+	s.RegisterService(&_QueryFunders_serviceDesc, srv)
+}
+
+func _QueryFunders_Funders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFundersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovFunders(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryFundersServer).Funders(ctx, in)
 	}
-	l = len(m.Moniker)
-	if l > 0 {
-		n += 1 + l + sovFunders(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.query.v1beta1.QueryFunders/Funders",
 	}
-	l = len(m.Identity)
-	if l > 0 {
-		n += 1 + l + sovFunders(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryFundersServer).Funders(ctx, req.(*QueryFundersRequest))
 	}
-	l = len(m.Website)
-	if l > 0 {
-		n += 1 + l + sovFunders(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryFunders_Funder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFunderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Contact)
-	if l > 0 {
-		n += 1 + l + sovFunders(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryFundersServer).Funder(ctx, in)
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovFunders(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.query.v1beta1.QueryFunders/Funder",
 	}
-	if m.Stats != nil {
-		l = m.Stats.Size()
-		n += 1 + l + sovFunders(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryFundersServer).Funder(ctx, req.(*QueryFunderRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *FundingStats) Size() (n int) {
-	if m == nil {
-		return 0
+func _QueryFunders_FundingsByFunder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFundingsByFunderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.TotalUsedFunds) > 0 {
-		for _, e := range m.TotalUsedFunds {
-			l = e.Size()
-			n += 1 + l + sovFunders(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryFundersServer).FundingsByFunder(ctx, in)
 	}
-	if len(m.TotalAllocatedFunds) > 0 {
-		for _, e := range m.TotalAllocatedFunds {
-			l = e.Size()
-			n += 1 + l + sovFunders(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.query.v1beta1.QueryFunders/FundingsByFunder",
 	}
-	if len(m.TotalAmountPerBundle) > 0 {
-		for _, e := range m.TotalAmountPerBundle {
-			l = e.Size()
-			n += 1 + l + sovFunders(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryFundersServer).FundingsByFunder(ctx, req.(*QueryFundingsByFunderRequest))
 	}
-	if len(m.PoolsFunded) > 0 {
-		l = 0
-		for _, e := range m.PoolsFunded {
-			l += sovFunders(uint64(e))
-		}
-		n += 1 + sovFunders(uint64(l)) + l
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryFunders_FundingsByPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFundingsByPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Score != 0 {
-		n += 1 + sovFunders(uint64(m.Score))
+	if interceptor == nil {
+		return srv.(QueryFundersServer).FundingsByPool(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.query.v1beta1.QueryFunders/FundingsByPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryFundersServer).FundingsByPool(ctx, req.(*QueryFundingsByPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Funding) Size() (n int) {
-	if m == nil {
-		return 0
+func _QueryFunders_FunderDelegates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFunderDelegatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.FunderAddress)
-	if l > 0 {
-		n += 1 + l + sovFunders(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryFundersServer).FunderDelegates(ctx, in)
 	}
-	if m.PoolId != 0 {
-		n += 1 + sovFunders(uint64(m.PoolId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.query.v1beta1.QueryFunders/FunderDelegates",
 	}
-	if len(m.Amounts) > 0 {
-		for _, e := range m.Amounts {
-			l = e.Size()
-			n += 1 + l + sovFunders(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryFundersServer).FunderDelegates(ctx, req.(*QueryFunderDelegatesRequest))
 	}
-	if len(m.AmountsPerBundle) > 0 {
-		for _, e := range m.AmountsPerBundle {
-			l = e.Size()
-			n += 1 + l + sovFunders(uint64(l))
-		}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryFunders_CoinWeights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCoinWeightsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.TotalFunded) > 0 {
-		for _, e := range m.TotalFunded {
-			l = e.Size()
-			n += 1 + l + sovFunders(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryFundersServer).CoinWeights(ctx, in)
 	}
-	if m.Score != 0 {
-		n += 1 + sovFunders(uint64(m.Score))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.query.v1beta1.QueryFunders/CoinWeights",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryFundersServer).CoinWeights(ctx, req.(*QueryCoinWeightsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryFundersRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _QueryFunders_MatchingCampaigns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMatchingCampaignsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovFunders(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryFundersServer).MatchingCampaigns(ctx, in)
 	}
-	l = len(m.Search)
-	if l > 0 {
-		n += 1 + l + sovFunders(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.query.v1beta1.QueryFunders/MatchingCampaigns",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryFundersServer).MatchingCampaigns(ctx, req.(*QueryMatchingCampaignsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryFundersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _QueryFunders_MatchingCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMatchingCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovFunders(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryFundersServer).MatchingCampaign(ctx, in)
 	}
-	if len(m.Funders) > 0 {
-		for _, e := range m.Funders {
-			l = e.Size()
-			n += 1 + l + sovFunders(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.query.v1beta1.QueryFunders/MatchingCampaign",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryFundersServer).MatchingCampaign(ctx, req.(*QueryMatchingCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryFunderRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _QueryFunders_FundedBundles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFundedBundlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovFunders(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryFundersServer).FundedBundles(ctx, in)
 	}
-	if m.Status != 0 {
-		n += 1 + sovFunders(uint64(m.Status))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.query.v1beta1.QueryFunders/FundedBundles",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryFundersServer).FundedBundles(ctx, req.(*QueryFundedBundlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryFunderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _QueryFunders_BundleFunders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBundleFundersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Funder != nil {
-		l = m.Funder.Size()
-		n += 1 + l + sovFunders(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryFundersServer).BundleFunders(ctx, in)
 	}
-	if len(m.Fundings) > 0 {
-		for _, e := range m.Fundings {
-			l = e.Size()
-			n += 1 + l + sovFunders(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.query.v1beta1.QueryFunders/BundleFunders",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryFundersServer).BundleFunders(ctx, req.(*QueryBundleFundersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryFundingsByFunderRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var QueryFunders_serviceDesc = _QueryFunders_serviceDesc
+var _QueryFunders_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kyve.query.v1beta1.QueryFunders",
+	HandlerType: (*QueryFundersServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Funders",
+			Handler:    _QueryFunders_Funders_Handler,
+		},
+		{
+			MethodName: "Funder",
+			Handler:    _QueryFunders_Funder_Handler,
+		},
+		{
+			MethodName: "FundingsByFunder",
+			Handler:    _QueryFunders_FundingsByFunder_Handler,
+		},
+		{
+			MethodName: "FundingsByPool",
+			Handler:    _QueryFunders_FundingsByPool_Handler,
+		},
+		{
+			MethodName: "FunderDelegates",
+			Handler:    _QueryFunders_FunderDelegates_Handler,
+		},
+		{
+			MethodName: "CoinWeights",
+			Handler:    _QueryFunders_CoinWeights_Handler,
+		},
+		{
+			MethodName: "MatchingCampaigns",
+			Handler:    _QueryFunders_MatchingCampaigns_Handler,
+		},
+		{
+			MethodName: "MatchingCampaign",
+			Handler:    _QueryFunders_MatchingCampaign_Handler,
+		},
+		{
+			MethodName: "FundedBundles",
+			Handler:    _QueryFunders_FundedBundles_Handler,
+		},
+		{
+			MethodName: "BundleFunders",
+			Handler:    _QueryFunders_BundleFunders_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "kyve/query/v1beta1/funders.proto",
+}
+
+func (m *Funder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Funder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Funder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovFunders(uint64(l))
+	if m.LifetimeStats != nil {
+		{
+			size, err := m.LifetimeStats.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFunders(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovFunders(uint64(l))
+	if m.Attestation != nil {
+		{
+			size, err := m.Attestation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFunders(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
 	}
-	if m.Status != 0 {
-		n += 1 + sovFunders(uint64(m.Status))
+	if m.Verified {
+		i--
+		if m.Verified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
 	}
-	return n
+	if m.Stats != nil {
+		{
+			size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFunders(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintFunders(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Contact) > 0 {
+		i -= len(m.Contact)
+		copy(dAtA[i:], m.Contact)
+		i = encodeVarintFunders(dAtA, i, uint64(len(m.Contact)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Website) > 0 {
+		i -= len(m.Website)
+		copy(dAtA[i:], m.Website)
+		i = encodeVarintFunders(dAtA, i, uint64(len(m.Website)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintFunders(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Moniker) > 0 {
+		i -= len(m.Moniker)
+		copy(dAtA[i:], m.Moniker)
+		i = encodeVarintFunders(dAtA, i, uint64(len(m.Moniker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintFunders(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryFundingsByFunderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FunderLifetimeStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *FunderLifetimeStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FunderLifetimeStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
+	if m.AverageFundingDuration != 0 {
+		i = encodeVarintFunders(dAtA, i, uint64(m.AverageFundingDuration))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PoolsFunded) > 0 {
+		dAtA5 := make([]byte, len(m.PoolsFunded)*10)
+		var j4 int
+		for _, num := range m.PoolsFunded {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintFunders(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.LifetimeFunded) > 0 {
+		for iNdEx := len(m.LifetimeFunded) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LifetimeFunded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFunders(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FundingStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FundingStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FundingStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Score != 0 {
+		i = encodeVarintFunders(dAtA, i, uint64(m.Score))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.PoolsFunded) > 0 {
+		dAtA7 := make([]byte, len(m.PoolsFunded)*10)
+		var j6 int
+		for _, num := range m.PoolsFunded {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintFunders(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TotalAmountPerBundle) > 0 {
+		for iNdEx := len(m.TotalAmountPerBundle) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalAmountPerBundle[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFunders(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.TotalAllocatedFunds) > 0 {
+		for iNdEx := len(m.TotalAllocatedFunds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalAllocatedFunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFunders(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TotalUsedFunds) > 0 {
+		for iNdEx := len(m.TotalUsedFunds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalUsedFunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFunders(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Funding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Funding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Funding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Constraints.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFunders(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.Score != 0 {
+		i = encodeVarintFunders(dAtA, i, uint64(m.Score))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.TotalFunded) > 0 {
+		for iNdEx := len(m.TotalFunded) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalFunded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFunders(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.AmountsPerBundle) > 0 {
+		for iNdEx := len(m.AmountsPerBundle) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AmountsPerBundle[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFunders(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Amounts) > 0 {
+		for iNdEx := len(m.Amounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFunders(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintFunders(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintFunders(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFundersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFundersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFundersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Search) > 0 {
+		i -= len(m.Search)
+		copy(dAtA[i:], m.Search)
+		i = encodeVarintFunders(dAtA, i, uint64(len(m.Search)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFunders(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFundersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFundersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFundersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Funders) > 0 {
+		for iNdEx := len(m.Funders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Funders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFunders(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFunders(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFunderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFunderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFunderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintFunders(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintFunders(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFunderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFunderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFunderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fundings) > 0 {
+		for iNdEx := len(m.Fundings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fundings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFunders(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Funder != nil {
+		{
+			size, err := m.Funder.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFunders(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFundingsByFunderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFundingsByFunderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFundingsByFunderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintFunders(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintFunders(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFunders(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFundingsByFunderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFundingsByFunderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFundingsByFunderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fundings) > 0 {
+		for iNdEx := len(m.Fundings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fundings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFunders(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFunders(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFundingsByPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFundingsByPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFundingsByPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintFunders(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintFunders(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFunders(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFundingsByPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFundingsByPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFundingsByPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fundings) > 0 {
+		for iNdEx := len(m.Fundings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fundings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFunders(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFunders(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFunderDelegatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFunderDelegatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFunderDelegatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintFunders(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFunderDelegatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFunderDelegatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFunderDelegatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegates) > 0 {
+		for iNdEx := len(m.Delegates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFunders(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCoinWeightsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCoinWeightsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCoinWeightsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCoinWeightsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCoinWeightsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCoinWeightsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CoinWeights) > 0 {
+		for iNdEx := len(m.CoinWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CoinWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFunders(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CoinWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CoinWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CoinWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFunders(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.OracleUpdatedAt != 0 {
+		i = encodeVarintFunders(dAtA, i, uint64(m.OracleUpdatedAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.OracleWeight.Size()
+		i -= size
+		if _, err := m.OracleWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFunders(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.StaticWeight.Size()
+		i -= size
+		if _, err := m.StaticWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFunders(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFunders(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMatchingCampaignsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMatchingCampaignsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMatchingCampaignsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintFunders(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMatchingCampaignsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMatchingCampaignsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMatchingCampaignsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Campaigns) > 0 {
+		for iNdEx := len(m.Campaigns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Campaigns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFunders(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMatchingCampaignRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMatchingCampaignRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMatchingCampaignRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintFunders(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMatchingCampaignResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMatchingCampaignResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMatchingCampaignResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Campaign.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFunders(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFundedBundlesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFundedBundlesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFundedBundlesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintFunders(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintFunders(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFunders(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFundedBundlesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFundedBundlesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFundedBundlesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contributions) > 0 {
+		for iNdEx := len(m.Contributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Contributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFunders(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFunders(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBundleFundersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBundleFundersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBundleFundersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BundleId != 0 {
+		i = encodeVarintFunders(dAtA, i, uint64(m.BundleId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintFunders(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBundleFundersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBundleFundersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBundleFundersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contributions) > 0 {
+		for iNdEx := len(m.Contributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Contributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFunders(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFunders(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFunders(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Funder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovFunders(uint64(l))
+	}
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovFunders(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovFunders(uint64(l))
+	}
+	l = len(m.Website)
+	if l > 0 {
+		n += 1 + l + sovFunders(uint64(l))
+	}
+	l = len(m.Contact)
+	if l > 0 {
+		n += 1 + l + sovFunders(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovFunders(uint64(l))
+	}
+	if m.Stats != nil {
+		l = m.Stats.Size()
+		n += 1 + l + sovFunders(uint64(l))
+	}
+	if m.Verified {
+		n += 2
+	}
+	if m.Attestation != nil {
+		l = m.Attestation.Size()
+		n += 1 + l + sovFunders(uint64(l))
+	}
+	if m.LifetimeStats != nil {
+		l = m.LifetimeStats.Size()
+		n += 1 + l + sovFunders(uint64(l))
+	}
+	return n
+}
+
+func (m *FunderLifetimeStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LifetimeFunded) > 0 {
+		for _, e := range m.LifetimeFunded {
+			l = e.Size()
+			n += 1 + l + sovFunders(uint64(l))
+		}
+	}
+	if len(m.PoolsFunded) > 0 {
+		l = 0
+		for _, e := range m.PoolsFunded {
+			l += sovFunders(uint64(e))
+		}
+		n += 1 + sovFunders(uint64(l)) + l
+	}
+	if m.AverageFundingDuration != 0 {
+		n += 1 + sovFunders(uint64(m.AverageFundingDuration))
+	}
+	return n
+}
+
+func (m *FundingStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TotalUsedFunds) > 0 {
+		for _, e := range m.TotalUsedFunds {
+			l = e.Size()
+			n += 1 + l + sovFunders(uint64(l))
+		}
+	}
+	if len(m.TotalAllocatedFunds) > 0 {
+		for _, e := range m.TotalAllocatedFunds {
+			l = e.Size()
+			n += 1 + l + sovFunders(uint64(l))
+		}
+	}
+	if len(m.TotalAmountPerBundle) > 0 {
+		for _, e := range m.TotalAmountPerBundle {
+			l = e.Size()
+			n += 1 + l + sovFunders(uint64(l))
+		}
+	}
+	if len(m.PoolsFunded) > 0 {
+		l = 0
+		for _, e := range m.PoolsFunded {
+			l += sovFunders(uint64(e))
+		}
+		n += 1 + sovFunders(uint64(l)) + l
+	}
+	if m.Score != 0 {
+		n += 1 + sovFunders(uint64(m.Score))
+	}
+	return n
+}
+
+func (m *Funding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovFunders(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovFunders(uint64(m.PoolId))
+	}
+	if len(m.Amounts) > 0 {
+		for _, e := range m.Amounts {
+			l = e.Size()
+			n += 1 + l + sovFunders(uint64(l))
+		}
+	}
+	if len(m.AmountsPerBundle) > 0 {
+		for _, e := range m.AmountsPerBundle {
+			l = e.Size()
+			n += 1 + l + sovFunders(uint64(l))
+		}
+	}
+	if len(m.TotalFunded) > 0 {
+		for _, e := range m.TotalFunded {
+			l = e.Size()
+			n += 1 + l + sovFunders(uint64(l))
+		}
+	}
+	if m.Score != 0 {
+		n += 1 + sovFunders(uint64(m.Score))
+	}
+	l = m.Constraints.Size()
+	n += 1 + l + sovFunders(uint64(l))
+	return n
+}
+
+func (m *QueryFundersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovFunders(uint64(l))
+	}
+	l = len(m.Search)
+	if l > 0 {
+		n += 1 + l + sovFunders(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFundersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovFunders(uint64(l))
+	}
+	if len(m.Funders) > 0 {
+		for _, e := range m.Funders {
+			l = e.Size()
+			n += 1 + l + sovFunders(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFunderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovFunders(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovFunders(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *QueryFunderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Funder != nil {
+		l = m.Funder.Size()
+		n += 1 + l + sovFunders(uint64(l))
+	}
+	if len(m.Fundings) > 0 {
+		for _, e := range m.Fundings {
+			l = e.Size()
+			n += 1 + l + sovFunders(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFundingsByFunderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovFunders(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovFunders(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovFunders(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *QueryFundingsByFunderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovFunders(uint64(l))
+	}
+	if len(m.Fundings) > 0 {
+		for _, e := range m.Fundings {
+			l = e.Size()
+			n += 1 + l + sovFunders(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFundingsByPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovFunders(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovFunders(uint64(m.PoolId))
+	}
+	if m.Status != 0 {
+		n += 1 + sovFunders(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *QueryFundingsByPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovFunders(uint64(l))
+	}
+	if len(m.Fundings) > 0 {
+		for _, e := range m.Fundings {
+			l = e.Size()
+			n += 1 + l + sovFunders(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFunderDelegatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovFunders(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFunderDelegatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Delegates) > 0 {
+		for _, e := range m.Delegates {
+			l = e.Size()
+			n += 1 + l + sovFunders(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCoinWeightsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCoinWeightsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CoinWeights) > 0 {
+		for _, e := range m.CoinWeights {
+			l = e.Size()
+			n += 1 + l + sovFunders(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CoinWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFunders(uint64(l))
+	}
+	l = m.StaticWeight.Size()
+	n += 1 + l + sovFunders(uint64(l))
+	l = m.OracleWeight.Size()
+	n += 1 + l + sovFunders(uint64(l))
+	if m.OracleUpdatedAt != 0 {
+		n += 1 + sovFunders(uint64(m.OracleUpdatedAt))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovFunders(uint64(l))
+	return n
+}
+
+func (m *QueryMatchingCampaignsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovFunders(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMatchingCampaignsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Campaigns) > 0 {
+		for _, e := range m.Campaigns {
+			l = e.Size()
+			n += 1 + l + sovFunders(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryMatchingCampaignRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovFunders(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryMatchingCampaignResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Campaign.Size()
+	n += 1 + l + sovFunders(uint64(l))
+	return n
+}
+
+func (m *QueryFundedBundlesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovFunders(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovFunders(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovFunders(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryFundedBundlesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
 		l = m.Pagination.Size()
 		n += 1 + l + sovFunders(uint64(l))
 	}
-	if len(m.Fundings) > 0 {
-		for _, e := range m.Fundings {
-			l = e.Size()
-			n += 1 + l + sovFunders(uint64(l))
+	if len(m.Contributions) > 0 {
+		for _, e := range m.Contributions {
+			l = e.Size()
+			n += 1 + l + sovFunders(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBundleFundersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovFunders(uint64(m.PoolId))
+	}
+	if m.BundleId != 0 {
+		n += 1 + sovFunders(uint64(m.BundleId))
+	}
+	return n
+}
+
+func (m *QueryBundleFundersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Contributions) > 0 {
+		for _, e := range m.Contributions {
+			l = e.Size()
+			n += 1 + l + sovFunders(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovFunders(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFunders(x uint64) (n int) {
+	return sovFunders(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Funder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFunders
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Funder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Funder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Website = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contact = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stats == nil {
+				m.Stats = &FundingStats{}
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Verified = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Attestation == nil {
+				m.Attestation = &types.FunderAttestation{}
+			}
+			if err := m.Attestation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LifetimeStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LifetimeStats == nil {
+				m.LifetimeStats = &FunderLifetimeStats{}
+			}
+			if err := m.LifetimeStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFunders(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FunderLifetimeStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFunders
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FunderLifetimeStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FunderLifetimeStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LifetimeFunded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LifetimeFunded = append(m.LifetimeFunded, types1.Coin{})
+			if err := m.LifetimeFunded[len(m.LifetimeFunded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowFunders
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PoolsFunded = append(m.PoolsFunded, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowFunders
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthFunders
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthFunders
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PoolsFunded) == 0 {
+					m.PoolsFunded = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFunders
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PoolsFunded = append(m.PoolsFunded, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolsFunded", wireType)
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageFundingDuration", wireType)
+			}
+			m.AverageFundingDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AverageFundingDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFunders(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FundingStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFunders
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FundingStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FundingStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalUsedFunds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalUsedFunds = append(m.TotalUsedFunds, types1.Coin{})
+			if err := m.TotalUsedFunds[len(m.TotalUsedFunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAllocatedFunds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalAllocatedFunds = append(m.TotalAllocatedFunds, types1.Coin{})
+			if err := m.TotalAllocatedFunds[len(m.TotalAllocatedFunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmountPerBundle", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalAmountPerBundle = append(m.TotalAmountPerBundle, types1.Coin{})
+			if err := m.TotalAmountPerBundle[len(m.TotalAmountPerBundle)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowFunders
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PoolsFunded = append(m.PoolsFunded, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowFunders
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthFunders
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthFunders
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PoolsFunded) == 0 {
+					m.PoolsFunded = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFunders
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PoolsFunded = append(m.PoolsFunded, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolsFunded", wireType)
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			m.Score = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Score |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFunders(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Funding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFunders
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Funding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Funding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amounts = append(m.Amounts, types1.Coin{})
+			if err := m.Amounts[len(m.Amounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountsPerBundle", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmountsPerBundle = append(m.AmountsPerBundle, types1.Coin{})
+			if err := m.AmountsPerBundle[len(m.AmountsPerBundle)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFunded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalFunded = append(m.TotalFunded, types1.Coin{})
+			if err := m.TotalFunded[len(m.TotalFunded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			m.Score = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Score |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constraints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Constraints.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFunders(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFundersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFunders
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFundersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFundersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Search", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Search = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFunders(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFundersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFunders
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFundersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFundersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funders = append(m.Funders, Funder{})
+			if err := m.Funders[len(m.Funders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFunders(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFunderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFunders
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFunderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFunderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= FundingStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFunders(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFunderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFunders
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFunderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFunderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Funder == nil {
+				m.Funder = &Funder{}
+			}
+			if err := m.Funder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fundings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fundings = append(m.Fundings, Funding{})
+			if err := m.Fundings[len(m.Fundings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFunders(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *QueryFundingsByPoolRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovFunders(uint64(l))
-	}
-	if m.PoolId != 0 {
-		n += 1 + sovFunders(uint64(m.PoolId))
-	}
-	if m.Status != 0 {
-		n += 1 + sovFunders(uint64(m.Status))
-	}
-	return n
-}
 
-func (m *QueryFundingsByPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovFunders(uint64(l))
-	}
-	if len(m.Fundings) > 0 {
-		for _, e := range m.Fundings {
-			l = e.Size()
-			n += 1 + l + sovFunders(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovFunders(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozFunders(x uint64) (n int) {
-	return sovFunders(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Funder) Unmarshal(dAtA []byte) error {
+func (m *QueryFundingsByFunderRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1995,17 +5359,17 @@ func (m *Funder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Funder: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFundingsByFunderRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Funder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFundingsByFunderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFunders
@@ -2015,27 +5379,31 @@ func (m *Funder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFunders
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFunders
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2063,13 +5431,13 @@ func (m *Funder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Moniker = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFunders
@@ -2079,29 +5447,66 @@ func (m *Funder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Status |= FundingStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthFunders
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFunders(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthFunders
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Identity = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFundingsByFunderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFunders
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFundingsByFunderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFundingsByFunderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFunders
@@ -2111,29 +5516,33 @@ func (m *Funder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFunders
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFunders
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Website = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contact", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fundings", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFunders
@@ -2143,29 +5552,81 @@ func (m *Funder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFunders
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFunders
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contact = string(dAtA[iNdEx:postIndex])
+			m.Fundings = append(m.Fundings, Funding{})
+			if err := m.Fundings[len(m.Fundings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFunders(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFundingsByPoolRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFunders
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFundingsByPoolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFundingsByPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFunders
@@ -2175,29 +5636,33 @@ func (m *Funder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFunders
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFunders
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var msglen int
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFunders
@@ -2207,28 +5672,30 @@ func (m *Funder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthFunders
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthFunders
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Stats == nil {
-				m.Stats = &FundingStats{}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFunders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= FundingStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFunders(dAtA[iNdEx:])
@@ -2250,7 +5717,7 @@ func (m *Funder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FundingStats) Unmarshal(dAtA []byte) error {
+func (m *QueryFundingsByPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2273,15 +5740,15 @@ func (m *FundingStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FundingStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFundingsByPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FundingStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFundingsByPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalUsedFunds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2308,14 +5775,16 @@ func (m *FundingStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalUsedFunds = append(m.TotalUsedFunds, types.Coin{})
-			if err := m.TotalUsedFunds[len(m.TotalUsedFunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalAllocatedFunds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fundings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2342,16 +5811,66 @@ func (m *FundingStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalAllocatedFunds = append(m.TotalAllocatedFunds, types.Coin{})
-			if err := m.TotalAllocatedFunds[len(m.TotalAllocatedFunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Fundings = append(m.Fundings, Funding{})
+			if err := m.Fundings[len(m.Fundings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFunders(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFunderDelegatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFunders
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFunderDelegatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFunderDelegatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmountPerBundle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFunders
@@ -2361,107 +5880,79 @@ func (m *FundingStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFunders
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFunders
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalAmountPerBundle = append(m.TotalAmountPerBundle, types.Coin{})
-			if err := m.TotalAmountPerBundle[len(m.TotalAmountPerBundle)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFunders(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowFunders
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.PoolsFunded = append(m.PoolsFunded, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowFunders
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthFunders
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthFunders
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.PoolsFunded) == 0 {
-					m.PoolsFunded = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowFunders
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.PoolsFunded = append(m.PoolsFunded, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolsFunded", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFunders
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Score = 0
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFunderDelegatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFunders
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFunderDelegatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFunderDelegatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegates", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFunders
@@ -2471,11 +5962,26 @@ func (m *FundingStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Score |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthFunders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegates = append(m.Delegates, types.FunderDelegate{})
+			if err := m.Delegates[len(m.Delegates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFunders(dAtA[iNdEx:])
@@ -2497,7 +6003,7 @@ func (m *FundingStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Funding) Unmarshal(dAtA []byte) error {
+func (m *QueryCoinWeightsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2520,66 +6026,65 @@ func (m *Funding) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Funding: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCoinWeightsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Funding: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCoinWeightsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFunders
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthFunders
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFunders(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthFunders
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FunderAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCoinWeightsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFunders
 			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFunders
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCoinWeightsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCoinWeightsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinWeights", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2606,16 +6111,66 @@ func (m *Funding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amounts = append(m.Amounts, types.Coin{})
-			if err := m.Amounts[len(m.Amounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CoinWeights = append(m.CoinWeights, CoinWeight{})
+			if err := m.CoinWeights[len(m.CoinWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFunders(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CoinWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFunders
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CoinWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CoinWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountsPerBundle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFunders
@@ -2625,31 +6180,29 @@ func (m *Funding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFunders
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFunders
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AmountsPerBundle = append(m.AmountsPerBundle, types.Coin{})
-			if err := m.AmountsPerBundle[len(m.AmountsPerBundle)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalFunded", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StaticWeight", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFunders
@@ -2659,31 +6212,31 @@ func (m *Funding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFunders
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFunders
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalFunded = append(m.TotalFunded, types.Coin{})
-			if err := m.TotalFunded[len(m.TotalFunded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StaticWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleWeight", wireType)
 			}
-			m.Score = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFunders
@@ -2693,66 +6246,31 @@ func (m *Funding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Score |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFunders(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFunders
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryFundersRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFunders
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFunders
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.OracleWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFundersRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFundersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleUpdatedAt", wireType)
 			}
-			var msglen int
+			m.OracleUpdatedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFunders
@@ -2762,31 +6280,14 @@ func (m *QueryFundersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.OracleUpdatedAt |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthFunders
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthFunders
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Search", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2814,7 +6315,9 @@ func (m *QueryFundersRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Search = string(dAtA[iNdEx:postIndex])
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2837,7 +6340,7 @@ func (m *QueryFundersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFundersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMatchingCampaignsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2860,17 +6363,17 @@ func (m *QueryFundersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFundersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMatchingCampaignsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFundersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMatchingCampaignsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFunders
@@ -2880,31 +6383,77 @@ func (m *QueryFundersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFunders
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFunders
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFunders(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFunders
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMatchingCampaignsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFunders
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMatchingCampaignsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMatchingCampaignsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Funders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Campaigns", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2931,8 +6480,8 @@ func (m *QueryFundersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Funders = append(m.Funders, Funder{})
-			if err := m.Funders[len(m.Funders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Campaigns = append(m.Campaigns, types.MatchingCampaign{})
+			if err := m.Campaigns[len(m.Campaigns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2957,7 +6506,7 @@ func (m *QueryFundersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFunderRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMatchingCampaignRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2980,49 +6529,17 @@ func (m *QueryFunderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFunderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMatchingCampaignRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFunderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMatchingCampaignRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFunders
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthFunders
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthFunders
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.Status = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFunders
@@ -3032,7 +6549,7 @@ func (m *QueryFunderRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= FundingStatus(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3058,7 +6575,7 @@ func (m *QueryFunderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFunderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMatchingCampaignResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3081,51 +6598,15 @@ func (m *QueryFunderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFunderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMatchingCampaignResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFunderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMatchingCampaignResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFunders
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthFunders
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthFunders
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Funder == nil {
-				m.Funder = &Funder{}
-			}
-			if err := m.Funder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fundings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Campaign", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3152,8 +6633,7 @@ func (m *QueryFunderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fundings = append(m.Fundings, Funding{})
-			if err := m.Fundings[len(m.Fundings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Campaign.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3178,7 +6658,7 @@ func (m *QueryFunderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFundingsByFunderRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFundedBundlesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3201,10 +6681,10 @@ func (m *QueryFundingsByFunderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFundingsByFunderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFundedBundlesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFundingsByFunderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFundedBundlesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3277,9 +6757,9 @@ func (m *QueryFundingsByFunderRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.Status = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFunders
@@ -3289,7 +6769,7 @@ func (m *QueryFundingsByFunderRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= FundingStatus(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3315,7 +6795,7 @@ func (m *QueryFundingsByFunderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFundingsByFunderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFundedBundlesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3338,10 +6818,10 @@ func (m *QueryFundingsByFunderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFundingsByFunderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFundedBundlesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFundingsByFunderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFundedBundlesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3382,7 +6862,7 @@ func (m *QueryFundingsByFunderResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fundings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contributions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3409,8 +6889,8 @@ func (m *QueryFundingsByFunderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fundings = append(m.Fundings, Funding{})
-			if err := m.Fundings[len(m.Fundings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Contributions = append(m.Contributions, types.FundingContribution{})
+			if err := m.Contributions[len(m.Contributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3435,7 +6915,7 @@ func (m *QueryFundingsByFunderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFundingsByPoolRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBundleFundersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3458,49 +6938,13 @@ func (m *QueryFundingsByPoolRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFundingsByPoolRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBundleFundersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFundingsByPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBundleFundersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFunders
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthFunders
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthFunders
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
@@ -3519,11 +6963,11 @@ func (m *QueryFundingsByPoolRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleId", wireType)
 			}
-			m.Status = 0
+			m.BundleId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFunders
@@ -3533,7 +6977,7 @@ func (m *QueryFundingsByPoolRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= FundingStatus(b&0x7F) << shift
+				m.BundleId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3559,7 +7003,7 @@ func (m *QueryFundingsByPoolRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFundingsByPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBundleFundersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3582,51 +7026,15 @@ func (m *QueryFundingsByPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFundingsByPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBundleFundersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFundingsByPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBundleFundersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFunders
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthFunders
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthFunders
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fundings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contributions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3653,8 +7061,8 @@ func (m *QueryFundingsByPoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fundings = append(m.Fundings, Funding{})
-			if err := m.Fundings[len(m.Fundings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Contributions = append(m.Contributions, types.FundingContribution{})
+			if err := m.Contributions[len(m.Contributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5,11 +5,15 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	types1 "github.com/KYVENetwork/chain/x/bundles/types"
 	types2 "github.com/KYVENetwork/chain/x/funders/types"
 	types "github.com/KYVENetwork/chain/x/pool/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types3 "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -389,64 +393,250 @@ func (m *QueryPoolResponse) GetPool() PoolResponse {
 	return PoolResponse{}
 }
 
+// QueryPoolRewardHistoryRequest is the request type for the Query/PoolRewardHistory RPC method.
+type QueryPoolRewardHistoryRequest struct {
+	// id defines the unique ID of the pool.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryPoolRewardHistoryRequest) Reset()         { *m = QueryPoolRewardHistoryRequest{} }
+func (m *QueryPoolRewardHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolRewardHistoryRequest) ProtoMessage()    {}
+func (*QueryPoolRewardHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b627739c2d7723dc, []int{5}
+}
+func (m *QueryPoolRewardHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolRewardHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolRewardHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolRewardHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolRewardHistoryRequest.Merge(m, src)
+}
+func (m *QueryPoolRewardHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolRewardHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolRewardHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolRewardHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryPoolRewardHistoryRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QueryPoolRewardHistoryResponse is the response type for the Query/PoolRewardHistory RPC method.
+type QueryPoolRewardHistoryResponse struct {
+	// epochs contains the reward history of the pool, ordered from the oldest to the newest epoch
+	Epochs []PoolRewardEpoch `protobuf:"bytes,1,rep,name=epochs,proto3" json:"epochs"`
+}
+
+func (m *QueryPoolRewardHistoryResponse) Reset()         { *m = QueryPoolRewardHistoryResponse{} }
+func (m *QueryPoolRewardHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolRewardHistoryResponse) ProtoMessage()    {}
+func (*QueryPoolRewardHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b627739c2d7723dc, []int{6}
+}
+func (m *QueryPoolRewardHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolRewardHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolRewardHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolRewardHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolRewardHistoryResponse.Merge(m, src)
+}
+func (m *QueryPoolRewardHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolRewardHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolRewardHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolRewardHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryPoolRewardHistoryResponse) GetEpochs() []PoolRewardEpoch {
+	if m != nil {
+		return m.Epochs
+	}
+	return nil
+}
+
+// PoolRewardEpoch contains the delegation rewards of a pool during a single epoch.
+type PoolRewardEpoch struct {
+	// start_date is the UNIX-timestamp (in seconds) of the start of the epoch
+	StartDate int64 `protobuf:"varint,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// end_date is the UNIX-timestamp (in seconds) of the end of the epoch
+	EndDate int64 `protobuf:"varint,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// delegation_rewards is the total amount of rewards paid out to delegators
+	DelegationRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=delegation_rewards,json=delegationRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"delegation_rewards"`
+	// pool_stake is the total stake of the pool at the last payout of the epoch
+	PoolStake uint64 `protobuf:"varint,4,opt,name=pool_stake,json=poolStake,proto3" json:"pool_stake,omitempty"`
+	// apr is the annualized rate of the $KYVE delegation rewards relative to the pool stake
+	Apr cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=apr,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"apr"`
+}
+
+func (m *PoolRewardEpoch) Reset()         { *m = PoolRewardEpoch{} }
+func (m *PoolRewardEpoch) String() string { return proto.CompactTextString(m) }
+func (*PoolRewardEpoch) ProtoMessage()    {}
+func (*PoolRewardEpoch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b627739c2d7723dc, []int{7}
+}
+func (m *PoolRewardEpoch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolRewardEpoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolRewardEpoch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolRewardEpoch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolRewardEpoch.Merge(m, src)
+}
+func (m *PoolRewardEpoch) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolRewardEpoch) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolRewardEpoch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolRewardEpoch proto.InternalMessageInfo
+
+func (m *PoolRewardEpoch) GetStartDate() int64 {
+	if m != nil {
+		return m.StartDate
+	}
+	return 0
+}
+
+func (m *PoolRewardEpoch) GetEndDate() int64 {
+	if m != nil {
+		return m.EndDate
+	}
+	return 0
+}
+
+func (m *PoolRewardEpoch) GetDelegationRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DelegationRewards
+	}
+	return nil
+}
+
+func (m *PoolRewardEpoch) GetPoolStake() uint64 {
+	if m != nil {
+		return m.PoolStake
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryPoolsRequest)(nil), "kyve.query.v1beta1.QueryPoolsRequest")
 	proto.RegisterType((*QueryPoolsResponse)(nil), "kyve.query.v1beta1.QueryPoolsResponse")
 	proto.RegisterType((*PoolResponse)(nil), "kyve.query.v1beta1.PoolResponse")
 	proto.RegisterType((*QueryPoolRequest)(nil), "kyve.query.v1beta1.QueryPoolRequest")
 	proto.RegisterType((*QueryPoolResponse)(nil), "kyve.query.v1beta1.QueryPoolResponse")
+	proto.RegisterType((*QueryPoolRewardHistoryRequest)(nil), "kyve.query.v1beta1.QueryPoolRewardHistoryRequest")
+	proto.RegisterType((*QueryPoolRewardHistoryResponse)(nil), "kyve.query.v1beta1.QueryPoolRewardHistoryResponse")
+	proto.RegisterType((*PoolRewardEpoch)(nil), "kyve.query.v1beta1.PoolRewardEpoch")
 }
 
 func init() { proto.RegisterFile("kyve/query/v1beta1/pools.proto", fileDescriptor_b627739c2d7723dc) }
 
 var fileDescriptor_b627739c2d7723dc = []byte{
-	// 723 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x4d, 0x4f, 0xd4, 0x4e,
-	0x18, 0xdf, 0xee, 0x1b, 0xcb, 0xf0, 0xff, 0x2f, 0x30, 0xf8, 0x52, 0x57, 0x29, 0xb5, 0xe1, 0x65,
-	0xc5, 0xa4, 0x0d, 0x6b, 0x3c, 0x68, 0x3c, 0x6d, 0x10, 0x63, 0x8c, 0xba, 0x96, 0xc4, 0x44, 0x2f,
-	0x9b, 0x69, 0x3b, 0x94, 0x86, 0xd2, 0x29, 0x9d, 0x29, 0xba, 0x1a, 0x2f, 0x7c, 0x02, 0x13, 0x8f,
-	0x7e, 0x21, 0x8e, 0x24, 0x5e, 0xf4, 0x62, 0x0c, 0xf8, 0x41, 0x4c, 0x67, 0xa6, 0xa5, 0x2b, 0x22,
-	0xde, 0xfa, 0xcc, 0xf3, 0xfb, 0x3d, 0xbf, 0xe7, 0xb5, 0x40, 0xdb, 0x19, 0xed, 0x63, 0x6b, 0x2f,
-	0xc5, 0xc9, 0xc8, 0xda, 0x5f, 0x73, 0x30, 0x43, 0x6b, 0x56, 0x4c, 0x48, 0x48, 0xcd, 0x38, 0x21,
-	0x8c, 0x40, 0x98, 0xf9, 0x4d, 0xee, 0x37, 0xa5, 0xbf, 0xb3, 0xea, 0x12, 0xba, 0x4b, 0xa8, 0xe5,
-	0x20, 0x7a, 0x86, 0x8a, 0xfc, 0x20, 0x42, 0x2c, 0x20, 0x91, 0xe0, 0x77, 0x2e, 0xf9, 0xc4, 0x27,
-	0xfc, 0xd3, 0xca, 0xbe, 0xe4, 0xeb, 0x0d, 0x9f, 0x10, 0x3f, 0xc4, 0x16, 0x8a, 0x03, 0x0b, 0x45,
-	0x11, 0x61, 0x9c, 0x22, 0x35, 0x3b, 0x06, 0xcf, 0xc9, 0x49, 0x23, 0x2f, 0xc4, 0xb4, 0x08, 0x2d,
-	0xed, 0x31, 0xcc, 0x56, 0x1a, 0x79, 0x38, 0x39, 0xc5, 0x48, 0x3b, 0x57, 0xe1, 0x98, 0xac, 0x9a,
-	0xb1, 0xd2, 0x84, 0xd7, 0xf8, 0xa6, 0x80, 0xd9, 0x17, 0x59, 0xf2, 0x83, 0xac, 0x5c, 0x1b, 0xef,
-	0xa5, 0x98, 0x32, 0xb8, 0x01, 0xc0, 0x69, 0x0d, 0xaa, 0xa2, 0x2b, 0xdd, 0xa9, 0xde, 0xb2, 0x29,
-	0x0a, 0x36, 0xb3, 0x82, 0xc7, 0x7b, 0x61, 0x0e, 0x90, 0x8f, 0x25, 0xd7, 0x2e, 0x31, 0xe1, 0x15,
-	0xd0, 0xa4, 0x18, 0x25, 0xee, 0xb6, 0x5a, 0xd5, 0x95, 0xee, 0xa4, 0x2d, 0x2d, 0xa8, 0x82, 0x89,
-	0x24, 0x8d, 0x58, 0xb0, 0x8b, 0xd5, 0x1a, 0x77, 0xe4, 0x26, 0xec, 0x80, 0x96, 0x17, 0x50, 0xe4,
-	0x84, 0xd8, 0x53, 0xeb, 0xba, 0xd2, 0x6d, 0xd9, 0x85, 0x0d, 0x4d, 0x30, 0x47, 0x19, 0x49, 0x90,
-	0x8f, 0x87, 0x71, 0x42, 0xf6, 0x03, 0x0f, 0x27, 0xc3, 0xc0, 0x53, 0x1b, 0xba, 0xd2, 0xfd, 0xdf,
-	0x9e, 0x95, 0xae, 0x81, 0xf4, 0x3c, 0xf6, 0x8c, 0xcf, 0x0a, 0x80, 0xe5, 0xda, 0x68, 0x4c, 0x22,
-	0x8a, 0xe1, 0x03, 0xd0, 0xe0, 0xb3, 0x55, 0x15, 0xbd, 0xd6, 0x9d, 0xea, 0xe9, 0xe6, 0xd9, 0xe1,
-	0x9a, 0x19, 0x23, 0x27, 0xf4, 0xeb, 0x87, 0xdf, 0x17, 0x2a, 0xb6, 0x20, 0xc1, 0x47, 0x63, 0xad,
-	0xa9, 0xf2, 0xd6, 0xac, 0x5c, 0xd8, 0x1a, 0x11, 0xa9, 0xdc, 0x1b, 0xe3, 0xb0, 0x06, 0xfe, 0x2b,
-	0xcb, 0xc0, 0x36, 0xa8, 0x06, 0x1e, 0x6f, 0x76, 0xdd, 0xae, 0x06, 0x1e, 0xbc, 0x0d, 0xea, 0x1e,
-	0x62, 0x48, 0x6a, 0x5c, 0x15, 0x69, 0xf2, 0xd1, 0x8d, 0x65, 0xc9, 0x41, 0xf0, 0x29, 0x98, 0x16,
-	0xab, 0x91, 0xb5, 0x26, 0x26, 0x14, 0x85, 0xbc, 0xb3, 0x53, 0xbd, 0x45, 0xc1, 0xcb, 0xf7, 0x26,
-	0xa7, 0xf6, 0xb9, 0x3d, 0x90, 0x58, 0xbb, 0xed, 0x8c, 0xd9, 0xd9, 0x80, 0x28, 0x43, 0x3b, 0x38,
-	0xa1, 0x6a, 0x5d, 0xaf, 0x65, 0x03, 0x92, 0x26, 0xec, 0x81, 0xcb, 0x8c, 0x30, 0x14, 0x0e, 0x29,
-	0x0e, 0xb7, 0x86, 0x1e, 0x0e, 0xb1, 0x2f, 0x5a, 0xd1, 0xe0, 0x89, 0xcf, 0x71, 0xe7, 0x26, 0x0e,
-	0xb7, 0xd6, 0x0b, 0x17, 0xbc, 0x05, 0x66, 0x04, 0xa7, 0x04, 0x6f, 0x72, 0xf8, 0x34, 0x7f, 0x2f,
-	0x41, 0xef, 0x82, 0x26, 0x65, 0x88, 0xa5, 0x54, 0x9d, 0xd0, 0x95, 0x6e, 0xbb, 0x37, 0x7f, 0x4e,
-	0xd9, 0x9b, 0x1c, 0x64, 0x4b, 0x70, 0x96, 0x2f, 0x72, 0x5d, 0x92, 0x46, 0x4c, 0x6d, 0x89, 0x85,
-	0x92, 0x26, 0x5c, 0x01, 0xd3, 0xf2, 0x73, 0xe8, 0xa0, 0x10, 0x45, 0x2e, 0x56, 0x27, 0xb9, 0x74,
-	0x5b, 0x3e, 0xf7, 0xc5, 0x2b, 0xbc, 0x07, 0x5a, 0xd9, 0xe1, 0x04, 0x91, 0x4f, 0x55, 0xc0, 0x37,
-	0x43, 0x6a, 0xe7, 0xe7, 0x94, 0xcb, 0x6f, 0x08, 0x94, 0x5d, 0xc0, 0x0d, 0x03, 0xcc, 0x14, 0x7b,
-	0x96, 0x9f, 0xd0, 0x6f, 0xd3, 0x34, 0x9e, 0x97, 0xee, 0xac, 0x18, 0xf9, 0x7d, 0x50, 0xcf, 0x2a,
-	0x93, 0x17, 0xf6, 0xaf, 0x9b, 0xc8, 0x39, 0xbd, 0x83, 0x2a, 0x98, 0x2c, 0x22, 0xc2, 0x11, 0x68,
-	0xf0, 0x2d, 0x87, 0x4b, 0x7f, 0x0a, 0x72, 0xe6, 0xc2, 0x3b, 0xcb, 0x17, 0xc1, 0x84, 0xa2, 0x71,
-	0xf3, 0xe0, 0xcb, 0xcf, 0x4f, 0xd5, 0xeb, 0xf0, 0x9a, 0x75, 0xde, 0x2f, 0x12, 0xbe, 0x03, 0x75,
-	0x9e, 0xc2, 0xe2, 0x5f, 0x43, 0xe6, 0xc2, 0x4b, 0x17, 0xa0, 0xa4, 0xee, 0x12, 0xd7, 0x5d, 0x80,
-	0xf3, 0xe7, 0xe9, 0x5a, 0xef, 0x03, 0xef, 0x43, 0x7f, 0xfd, 0xf0, 0x58, 0x53, 0x8e, 0x8e, 0x35,
-	0xe5, 0xc7, 0xb1, 0xa6, 0x7c, 0x3c, 0xd1, 0x2a, 0x47, 0x27, 0x5a, 0xe5, 0xeb, 0x89, 0x56, 0x79,
-	0xbd, 0xea, 0x07, 0x6c, 0x3b, 0x75, 0x4c, 0x97, 0xec, 0x5a, 0x4f, 0x5e, 0xbd, 0x7c, 0xf8, 0x0c,
-	0xb3, 0x37, 0x24, 0xd9, 0xb1, 0xdc, 0x6d, 0x14, 0x44, 0xd6, 0x5b, 0x19, 0x91, 0x8d, 0x62, 0x4c,
-	0x9d, 0x26, 0xff, 0x17, 0xde, 0xf9, 0x15, 0x00, 0x00, 0xff, 0xff, 0xef, 0xab, 0xd1, 0xcb, 0x07,
-	0x06, 0x00, 0x00,
+	// 980 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xae, 0x93, 0x34, 0x4d, 0xa6, 0xd0, 0x6e, 0x66, 0xf9, 0xe1, 0x66, 0x69, 0x1a, 0xbc, 0xdb,
+	0xdd, 0x50, 0xc0, 0x56, 0x03, 0x3d, 0x80, 0xb8, 0x10, 0xba, 0x0b, 0x88, 0x5f, 0xc1, 0x2b, 0x21,
+	0xc1, 0xc5, 0x9a, 0xd8, 0x53, 0xc7, 0x8a, 0xe3, 0xf1, 0x7a, 0x26, 0x5d, 0x02, 0x42, 0x48, 0xfc,
+	0x05, 0x48, 0x1c, 0xb9, 0x71, 0x42, 0x9c, 0xf6, 0xc2, 0xff, 0xd0, 0xe3, 0x4a, 0x5c, 0x80, 0xc3,
+	0x82, 0x5a, 0x24, 0xfe, 0x0d, 0x34, 0x6f, 0xc6, 0xa9, 0xd3, 0x10, 0xca, 0x5e, 0x5a, 0xbf, 0x79,
+	0xdf, 0x9b, 0xef, 0x9b, 0x37, 0xdf, 0x9b, 0xa0, 0xd6, 0x68, 0x7a, 0x4c, 0x9d, 0x7b, 0x13, 0x9a,
+	0x4d, 0x9d, 0xe3, 0xfd, 0x01, 0x15, 0x64, 0xdf, 0x49, 0x19, 0x8b, 0xb9, 0x9d, 0x66, 0x4c, 0x30,
+	0x8c, 0x65, 0xde, 0x86, 0xbc, 0xad, 0xf3, 0xcd, 0x06, 0x19, 0x47, 0x09, 0x73, 0xe0, 0xaf, 0x82,
+	0x35, 0xf7, 0x7c, 0xc6, 0xc7, 0x8c, 0x3b, 0x03, 0xc2, 0x17, 0x76, 0x23, 0x61, 0x94, 0x10, 0x11,
+	0xb1, 0x44, 0x63, 0x5b, 0x45, 0x6c, 0x8e, 0xf2, 0x59, 0x94, 0xe7, 0x9f, 0x0a, 0x59, 0xc8, 0xe0,
+	0xd3, 0x91, 0x5f, 0x7a, 0xf5, 0xb9, 0x90, 0xb1, 0x30, 0xa6, 0x0e, 0x49, 0x23, 0x87, 0x24, 0x09,
+	0x13, 0xb0, 0xa5, 0x96, 0xd9, 0xb4, 0xe0, 0x18, 0x83, 0x49, 0x12, 0xc4, 0x94, 0xcf, 0x36, 0xd5,
+	0xf1, 0x1c, 0xe6, 0x68, 0x92, 0x04, 0x34, 0x3b, 0xc7, 0xe8, 0x38, 0x67, 0x01, 0x8c, 0x6c, 0xc0,
+	0x5c, 0x37, 0x54, 0xd6, 0xfa, 0xcd, 0x40, 0x8d, 0x8f, 0xe5, 0xe1, 0xfa, 0xb2, 0x43, 0x2e, 0xbd,
+	0x37, 0xa1, 0x5c, 0xe0, 0x3b, 0x08, 0x9d, 0x9f, 0xd1, 0x34, 0xda, 0x46, 0x67, 0xbd, 0x7b, 0xd3,
+	0x56, 0x87, 0xb4, 0xe5, 0x21, 0xe7, 0xdb, 0x67, 0xf7, 0x49, 0x48, 0x75, 0xad, 0x5b, 0xa8, 0xc4,
+	0xcf, 0xa0, 0x2a, 0xa7, 0x24, 0xf3, 0x87, 0x66, 0xa9, 0x6d, 0x74, 0xea, 0xae, 0x8e, 0xb0, 0x89,
+	0xd6, 0xb2, 0x49, 0x22, 0xa2, 0x31, 0x35, 0xcb, 0x90, 0xc8, 0x43, 0xdc, 0x44, 0xb5, 0x20, 0xe2,
+	0x64, 0x10, 0xd3, 0xc0, 0xac, 0xb4, 0x8d, 0x4e, 0xcd, 0x9d, 0xc5, 0xd8, 0x46, 0x57, 0xb9, 0x60,
+	0x19, 0x09, 0xa9, 0x97, 0x66, 0xec, 0x38, 0x0a, 0x68, 0xe6, 0x45, 0x81, 0xb9, 0xda, 0x36, 0x3a,
+	0x4f, 0xba, 0x0d, 0x9d, 0xea, 0xeb, 0xcc, 0xbb, 0x81, 0xf5, 0xbd, 0x81, 0x70, 0xf1, 0x6c, 0x3c,
+	0x65, 0x09, 0xa7, 0xf8, 0x0d, 0xb4, 0x0a, 0x76, 0x30, 0x8d, 0x76, 0xb9, 0xb3, 0xde, 0x6d, 0xdb,
+	0x8b, 0x7e, 0xb0, 0x65, 0x45, 0x5e, 0xd0, 0xab, 0x9c, 0x3c, 0xda, 0x59, 0x71, 0x55, 0x11, 0x7e,
+	0x7b, 0xae, 0x35, 0x25, 0x68, 0xcd, 0xad, 0x4b, 0x5b, 0xa3, 0x76, 0x2a, 0xf6, 0xc6, 0x3a, 0x29,
+	0xa3, 0x27, 0x8a, 0x34, 0x78, 0x03, 0x95, 0xa2, 0x00, 0x9a, 0x5d, 0x71, 0x4b, 0x51, 0x80, 0x5f,
+	0x44, 0x95, 0x80, 0x08, 0xa2, 0x39, 0x9e, 0x55, 0x32, 0xe1, 0xea, 0xe6, 0x54, 0x02, 0x08, 0x7f,
+	0x80, 0x36, 0x95, 0x35, 0x64, 0x6b, 0x52, 0xc6, 0x49, 0x0c, 0x9d, 0x5d, 0xef, 0xde, 0x50, 0x75,
+	0xb9, 0x6f, 0xf2, 0xd2, 0x1e, 0xc4, 0x7d, 0x8d, 0x75, 0x37, 0x06, 0x73, 0xb1, 0xbc, 0x20, 0x2e,
+	0xc8, 0x88, 0x66, 0xdc, 0xac, 0xb4, 0xcb, 0xf2, 0x82, 0x74, 0x88, 0xbb, 0xe8, 0x69, 0xc1, 0x04,
+	0x89, 0x3d, 0x4e, 0xe3, 0x23, 0x2f, 0xa0, 0x31, 0x0d, 0x55, 0x2b, 0x56, 0x41, 0xf8, 0x55, 0x48,
+	0xde, 0xa5, 0xf1, 0xd1, 0xe1, 0x2c, 0x85, 0x5f, 0x40, 0x57, 0x54, 0x4d, 0x01, 0x5e, 0x05, 0xf8,
+	0x26, 0xac, 0x17, 0xa0, 0x07, 0xa8, 0xca, 0x05, 0x11, 0x13, 0x6e, 0xae, 0xb5, 0x8d, 0xce, 0x46,
+	0x77, 0x7b, 0xc9, 0xb1, 0xef, 0x02, 0xc8, 0xd5, 0x60, 0xa9, 0x97, 0xf8, 0x3e, 0x9b, 0x24, 0xc2,
+	0xac, 0x29, 0x43, 0xe9, 0x10, 0xdf, 0x42, 0x9b, 0xfa, 0xd3, 0x1b, 0x90, 0x98, 0x24, 0x3e, 0x35,
+	0xeb, 0x40, 0xbd, 0xa1, 0x97, 0x7b, 0x6a, 0x15, 0xbf, 0x86, 0x6a, 0x72, 0x70, 0xa2, 0x24, 0xe4,
+	0x26, 0x02, 0x67, 0x68, 0xee, 0x7c, 0x9c, 0x72, 0xfa, 0x3b, 0x0a, 0xe5, 0xce, 0xe0, 0x96, 0x85,
+	0xae, 0xcc, 0x7c, 0x96, 0x8f, 0xd0, 0x85, 0xdb, 0xb4, 0x3e, 0x2a, 0xcc, 0xd9, 0xec, 0xca, 0x5f,
+	0x47, 0x15, 0x79, 0x32, 0x3d, 0x61, 0xff, 0xd7, 0x89, 0x50, 0x63, 0x39, 0x68, 0xbb, 0xb0, 0xe1,
+	0x7d, 0x92, 0x05, 0xef, 0x44, 0x72, 0x06, 0xa6, 0xcb, 0x14, 0xf8, 0xa8, 0xb5, 0xac, 0x40, 0xcb,
+	0x79, 0x13, 0x55, 0x69, 0xca, 0xfc, 0x61, 0x3e, 0x1a, 0xd7, 0x97, 0x0b, 0x92, 0xe5, 0xb7, 0x25,
+	0x56, 0x6b, 0xd2, 0x85, 0xd6, 0x0f, 0x25, 0xb4, 0x79, 0x01, 0x81, 0xb7, 0x11, 0xe2, 0x82, 0x64,
+	0xc2, 0x0b, 0x88, 0xa0, 0x20, 0xa8, 0xec, 0xd6, 0x61, 0xe5, 0x90, 0x08, 0x8a, 0xb7, 0x50, 0x8d,
+	0x26, 0x81, 0x4a, 0x96, 0x20, 0xb9, 0x46, 0x93, 0x00, 0x52, 0x5f, 0x23, 0x7c, 0x6e, 0x19, 0x2f,
+	0x83, 0x3d, 0xb9, 0x59, 0x06, 0x71, 0x5b, 0x73, 0x43, 0x97, 0xab, 0x7b, 0x8b, 0x45, 0x49, 0xef,
+	0x40, 0x4a, 0xfa, 0xe9, 0x8f, 0x9d, 0x4e, 0x18, 0x89, 0xe1, 0x64, 0x60, 0xfb, 0x6c, 0xec, 0xe8,
+	0x17, 0x5a, 0xfd, 0x7b, 0x99, 0x07, 0x23, 0x47, 0x4c, 0x53, 0xca, 0xa1, 0x80, 0xff, 0xf8, 0xf7,
+	0x83, 0x3d, 0xc3, 0x6d, 0x9c, 0x73, 0x29, 0xf9, 0x5c, 0x4a, 0x97, 0xcd, 0xf6, 0xc0, 0xfd, 0xf0,
+	0x20, 0x55, 0xdc, 0x7a, 0xaa, 0xfc, 0x37, 0xa2, 0xf8, 0x00, 0x95, 0x49, 0x9a, 0x81, 0xf5, 0xeb,
+	0xbd, 0xeb, 0x92, 0xf5, 0xf7, 0x47, 0x3b, 0xd7, 0x14, 0x07, 0x0f, 0x46, 0x76, 0xc4, 0x9c, 0x31,
+	0x11, 0x43, 0xfb, 0x7d, 0x1a, 0x12, 0x7f, 0x7a, 0x48, 0x7d, 0x57, 0xe2, 0xbb, 0x3f, 0x97, 0x51,
+	0x7d, 0x76, 0x15, 0x78, 0x8a, 0x56, 0xfb, 0xf0, 0xb4, 0xec, 0xfe, 0x5b, 0xbb, 0x17, 0x1e, 0xe7,
+	0xe6, 0xcd, 0xcb, 0x60, 0xea, 0x36, 0xad, 0xe7, 0xbf, 0xf9, 0xe5, 0xaf, 0xef, 0x4a, 0xd7, 0xf0,
+	0x96, 0xb3, 0xec, 0x07, 0x11, 0x7f, 0x81, 0x2a, 0x20, 0xe1, 0xc6, 0x7f, 0x6e, 0x99, 0x13, 0xef,
+	0x5e, 0x82, 0xd2, 0xbc, 0xbb, 0xc0, 0xbb, 0x83, 0xb7, 0x97, 0xf1, 0x3a, 0x5f, 0x46, 0xc1, 0x57,
+	0xf8, 0x81, 0x81, 0x1a, 0x0b, 0x56, 0xc4, 0xfb, 0x97, 0x70, 0x2c, 0xfa, 0xbc, 0xd9, 0x7d, 0x9c,
+	0x12, 0xad, 0xf1, 0x55, 0xd0, 0x68, 0xe3, 0x97, 0x96, 0x69, 0xd4, 0x66, 0xf3, 0x86, 0xaa, 0x10,
+	0x24, 0xf7, 0x0e, 0x4f, 0x4e, 0x5b, 0xc6, 0xc3, 0xd3, 0x96, 0xf1, 0xe7, 0x69, 0xcb, 0xf8, 0xf6,
+	0xac, 0xb5, 0xf2, 0xf0, 0xac, 0xb5, 0xf2, 0xeb, 0x59, 0x6b, 0xe5, 0xb3, 0xbd, 0x82, 0xd3, 0xde,
+	0xfb, 0xf4, 0x93, 0xdb, 0x1f, 0x52, 0x71, 0x9f, 0x65, 0x23, 0xc7, 0x1f, 0x92, 0x28, 0x71, 0x3e,
+	0xd7, 0x04, 0xe0, 0xb8, 0x41, 0x15, 0x7e, 0x79, 0x5f, 0xf9, 0x67, 0x00, 0x88, 0xa6, 0x90, 0x45,
+	0xa8, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -465,6 +655,8 @@ type QueryPoolClient interface {
 	Pools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error)
 	// Pool queries a pool by its Id.
 	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
+	// PoolRewardHistory queries the delegation rewards and APR of a pool for the recent epochs.
+	PoolRewardHistory(ctx context.Context, in *QueryPoolRewardHistoryRequest, opts ...grpc.CallOption) (*QueryPoolRewardHistoryResponse, error)
 }
 
 type queryPoolClient struct {
@@ -493,12 +685,23 @@ func (c *queryPoolClient) Pool(ctx context.Context, in *QueryPoolRequest, opts .
 	return out, nil
 }
 
+func (c *queryPoolClient) PoolRewardHistory(ctx context.Context, in *QueryPoolRewardHistoryRequest, opts ...grpc.CallOption) (*QueryPoolRewardHistoryResponse, error) {
+	out := new(QueryPoolRewardHistoryResponse)
+	err := c.cc.Invoke(ctx, "/kyve.query.v1beta1.QueryPool/PoolRewardHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryPoolServer is the server API for QueryPool service.
 type QueryPoolServer interface {
 	// Pools queries for all pools.
 	Pools(context.Context, *QueryPoolsRequest) (*QueryPoolsResponse, error)
 	// Pool queries a pool by its Id.
 	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
+	// PoolRewardHistory queries the delegation rewards and APR of a pool for the recent epochs.
+	PoolRewardHistory(context.Context, *QueryPoolRewardHistoryRequest) (*QueryPoolRewardHistoryResponse, error)
 }
 
 // UnimplementedQueryPoolServer can be embedded to have forward compatible implementations.
@@ -511,6 +714,9 @@ func (*UnimplementedQueryPoolServer) Pools(ctx context.Context, req *QueryPoolsR
 func (*UnimplementedQueryPoolServer) Pool(ctx context.Context, req *QueryPoolRequest) (*QueryPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Pool not implemented")
 }
+func (*UnimplementedQueryPoolServer) PoolRewardHistory(ctx context.Context, req *QueryPoolRewardHistoryRequest) (*QueryPoolRewardHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolRewardHistory not implemented")
+}
 
 func RegisterQueryPoolServer(s grpc1.Server, srv QueryPoolServer) {
 	s.RegisterService(&_QueryPool_serviceDesc, srv)
@@ -552,6 +758,24 @@ func _QueryPool_Pool_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryPool_PoolRewardHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolRewardHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryPoolServer).PoolRewardHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.query.v1beta1.QueryPool/PoolRewardHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryPoolServer).PoolRewardHistory(ctx, req.(*QueryPoolRewardHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var QueryPool_serviceDesc = _QueryPool_serviceDesc
 var _QueryPool_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kyve.query.v1beta1.QueryPool",
@@ -565,6 +789,10 @@ var _QueryPool_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Pool",
 			Handler:    _QueryPool_Pool_Handler,
 		},
+		{
+			MethodName: "PoolRewardHistory",
+			Handler:    _QueryPool_PoolRewardHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kyve/query/v1beta1/pools.proto",
@@ -846,6 +1074,133 @@ func (m *QueryPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryPoolRewardHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolRewardHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolRewardHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintPools(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolRewardHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolRewardHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolRewardHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Epochs) > 0 {
+		for iNdEx := len(m.Epochs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Epochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPools(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolRewardEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolRewardEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolRewardEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Apr.Size()
+		i -= size
+		if _, err := m.Apr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPools(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.PoolStake != 0 {
+		i = encodeVarintPools(dAtA, i, uint64(m.PoolStake))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.DelegationRewards) > 0 {
+		for iNdEx := len(m.DelegationRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegationRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPools(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.EndDate != 0 {
+		i = encodeVarintPools(dAtA, i, uint64(m.EndDate))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartDate != 0 {
+		i = encodeVarintPools(dAtA, i, uint64(m.StartDate))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPools(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPools(v)
 	base := offset
@@ -974,25 +1329,78 @@ func (m *QueryPoolResponse) Size() (n int) {
 	return n
 }
 
-func sovPools(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryPoolRewardHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovPools(uint64(m.Id))
+	}
+	return n
 }
-func sozPools(x uint64) (n int) {
-	return sovPools(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryPoolRewardHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Epochs) > 0 {
+		for _, e := range m.Epochs {
+			l = e.Size()
+			n += 1 + l + sovPools(uint64(l))
+		}
+	}
+	return n
 }
-func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPools
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
+
+func (m *PoolRewardEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartDate != 0 {
+		n += 1 + sovPools(uint64(m.StartDate))
+	}
+	if m.EndDate != 0 {
+		n += 1 + sovPools(uint64(m.EndDate))
+	}
+	if len(m.DelegationRewards) > 0 {
+		for _, e := range m.DelegationRewards {
+			l = e.Size()
+			n += 1 + l + sovPools(uint64(l))
+		}
+	}
+	if m.PoolStake != 0 {
+		n += 1 + sovPools(uint64(m.PoolStake))
+	}
+	l = m.Apr.Size()
+	n += 1 + l + sovPools(uint64(l))
+	return n
+}
+
+func sovPools(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPools(x uint64) (n int) {
+	return sovPools(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPools
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
 			b := dAtA[iNdEx]
 			iNdEx++
 			wire |= uint64(b&0x7F) << shift
@@ -1756,6 +2164,334 @@ func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryPoolRewardHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPools
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolRewardHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolRewardHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPools(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPools
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolRewardHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPools
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolRewardHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolRewardHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPools
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPools
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Epochs = append(m.Epochs, PoolRewardEpoch{})
+			if err := m.Epochs[len(m.Epochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPools(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPools
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolRewardEpoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPools
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolRewardEpoch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolRewardEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartDate", wireType)
+			}
+			m.StartDate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartDate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDate", wireType)
+			}
+			m.EndDate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndDate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPools
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPools
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegationRewards = append(m.DelegationRewards, types3.Coin{})
+			if err := m.DelegationRewards[len(m.DelegationRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolStake", wireType)
+			}
+			m.PoolStake = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolStake |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Apr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPools
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPools
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPools
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Apr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPools(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPools
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipPools(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -7,6 +7,7 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	types1 "github.com/KYVENetwork/chain/x/pool/types"
+	types3 "github.com/KYVENetwork/chain/x/stakers/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
@@ -167,6 +168,17 @@ type FullStaker struct {
 	// pools is a list of all pools the staker is currently
 	// participating, i.e. allowed to vote and upload data.
 	Pools []*PoolMembership `protobuf:"bytes,8,rep,name=pools,proto3" json:"pools,omitempty"`
+	// validator_inactive is true if the validator is not in the active set,
+	// i.e. unbonding, unbonded or jailed. Inactive validators get removed
+	// from all pools after the inactive validator grace period.
+	ValidatorInactive bool `protobuf:"varint,9,opt,name=validator_inactive,json=validatorInactive,proto3" json:"validator_inactive,omitempty"`
+	// auto_leave_date is the UNIX-timestamp (in seconds) after which the
+	// validator automatically starts leaving all pools. It is zero if the
+	// validator is active or already leaving.
+	AutoLeaveDate int64 `protobuf:"varint,10,opt,name=auto_leave_date,json=autoLeaveDate,proto3" json:"auto_leave_date,omitempty"`
+	// commission_rewards_settings specifies which denoms the validator accepts
+	// as commission rewards. It is not set if the validator accepts all denoms.
+	CommissionRewardsSettings *types3.CommissionRewardsSettings `protobuf:"bytes,11,opt,name=commission_rewards_settings,json=commissionRewardsSettings,proto3" json:"commission_rewards_settings,omitempty"`
 }
 
 func (m *FullStaker) Reset()         { *m = FullStaker{} }
@@ -258,6 +270,27 @@ func (m *FullStaker) GetPools() []*PoolMembership {
 	return nil
 }
 
+func (m *FullStaker) GetValidatorInactive() bool {
+	if m != nil {
+		return m.ValidatorInactive
+	}
+	return false
+}
+
+func (m *FullStaker) GetAutoLeaveDate() int64 {
+	if m != nil {
+		return m.AutoLeaveDate
+	}
+	return 0
+}
+
+func (m *FullStaker) GetCommissionRewardsSettings() *types3.CommissionRewardsSettings {
+	if m != nil {
+		return m.CommissionRewardsSettings
+	}
+	return nil
+}
+
 // CommissionChangeEntry shows when the old commission
 // of a staker will change to the new commission
 type CommissionChangeEntry struct {
@@ -405,6 +438,18 @@ type PoolMembership struct {
 	// in this pool. It can be lower than the specified stake fraction
 	// because of the max voting power limit
 	PoolStake uint64 `protobuf:"varint,10,opt,name=pool_stake,json=poolStake,proto3" json:"pool_stake,omitempty"`
+	// max_commission is the highest commission the validator
+	// can ever charge in this pool
+	MaxCommission cosmossdk_io_math.LegacyDec `protobuf:"bytes,11,opt,name=max_commission,json=maxCommission,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_commission"`
+	// max_commission_change_rate is the maximum amount the
+	// commission can be changed by within one day
+	MaxCommissionChangeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,12,opt,name=max_commission_change_rate,json=maxCommissionChangeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_commission_change_rate"`
+	// stats contains the protocol performance counters of the
+	// staker in this pool
+	Stats types3.PoolAccountStats `protobuf:"bytes,13,opt,name=stats,proto3" json:"stats"`
+	// reliability_score is a value between zero and one derived
+	// from the stats. A staker without any history has a score of one.
+	ReliabilityScore cosmossdk_io_math.LegacyDec `protobuf:"bytes,14,opt,name=reliability_score,json=reliabilityScore,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"reliability_score"`
 }
 
 func (m *PoolMembership) Reset()         { *m = PoolMembership{} }
@@ -496,6 +541,13 @@ func (m *PoolMembership) GetPoolStake() uint64 {
 	return 0
 }
 
+func (m *PoolMembership) GetStats() types3.PoolAccountStats {
+	if m != nil {
+		return m.Stats
+	}
+	return types3.PoolAccountStats{}
+}
+
 func init() {
 	proto.RegisterType((*BasicPool)(nil), "kyve.query.v1beta1.BasicPool")
 	proto.RegisterType((*FullStaker)(nil), "kyve.query.v1beta1.FullStaker")
@@ -507,65 +559,76 @@ func init() {
 func init() { proto.RegisterFile("kyve/query/v1beta1/query.proto", fileDescriptor_6b41255feae93a15) }
 
 var fileDescriptor_6b41255feae93a15 = []byte{
-	// 920 bytes of a gzipped FileDescriptorProto
+	// 1101 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0x26, 0x5b, 0x27, 0x7e, 0x6e, 0x8d, 0x18, 0x42, 0xbb, 0x09, 0x8d, 0x93, 0xba, 0x48,
-	0x98, 0x0a, 0x76, 0x95, 0xa0, 0x4a, 0x08, 0x0e, 0x88, 0xc4, 0x8d, 0x04, 0x14, 0x84, 0x36, 0x50,
-	0x54, 0x2e, 0xd6, 0x78, 0x77, 0xbc, 0x1e, 0x79, 0x77, 0xc6, 0xdd, 0x19, 0x3b, 0xf8, 0xc8, 0x99,
-	0x0b, 0x82, 0x2f, 0x81, 0x10, 0x07, 0xbe, 0x01, 0xd7, 0x1e, 0x7b, 0x44, 0x1c, 0x0a, 0x4a, 0x0e,
-	0x7c, 0x0d, 0x34, 0xff, 0xd6, 0x4e, 0x30, 0x52, 0xa9, 0xd4, 0x8b, 0x3d, 0xef, 0xff, 0x9b, 0xf7,
-	0xfb, 0xbd, 0xb1, 0xa1, 0x35, 0x9a, 0x4d, 0x49, 0xf4, 0x68, 0x42, 0xca, 0x59, 0x34, 0xdd, 0xef,
-	0x13, 0x89, 0xf7, 0x8d, 0x14, 0x8e, 0x4b, 0x2e, 0x39, 0x42, 0xca, 0x1e, 0x1a, 0x8d, 0xb5, 0x6f,
-	0xbf, 0x8c, 0x0b, 0xca, 0x78, 0xa4, 0x3f, 0x8d, 0xdb, 0x76, 0x2b, 0xe1, 0xa2, 0xe0, 0x22, 0xea,
-	0x63, 0x41, 0xaa, 0x3c, 0x09, 0xa7, 0xcc, 0xda, 0x5f, 0xb7, 0x76, 0x21, 0xf1, 0x88, 0xb2, 0xac,
-	0x72, 0xb1, 0xb2, 0xf5, 0xda, 0xcc, 0x78, 0xc6, 0xf5, 0x31, 0x52, 0x27, 0xab, 0xbd, 0xa9, 0x5b,
-	0x1c, 0x73, 0x9e, 0x57, 0x61, 0x4a, 0x30, 0xd6, 0xf6, 0x2f, 0x6b, 0x50, 0x3f, 0xc4, 0x82, 0x26,
-	0x9f, 0x73, 0x9e, 0xa3, 0x26, 0xac, 0xd2, 0x34, 0xf0, 0xf6, 0xbc, 0x8e, 0x1f, 0xaf, 0xd2, 0x14,
-	0x21, 0xf0, 0x19, 0x2e, 0x48, 0xb0, 0xba, 0xe7, 0x75, 0xea, 0xb1, 0x3e, 0xa3, 0x00, 0xd6, 0xcb,
-	0x09, 0x93, 0xb4, 0x20, 0xc1, 0x9a, 0x56, 0x3b, 0x51, 0x79, 0xe7, 0x3c, 0xe3, 0x81, 0x6f, 0xbc,
-	0xd5, 0x19, 0x3d, 0x84, 0xeb, 0x94, 0x0d, 0x72, 0x2c, 0x29, 0x67, 0x3d, 0x31, 0xc4, 0x25, 0xe9,
-	0x9d, 0x12, 0x9a, 0x0d, 0x65, 0x70, 0x45, 0x79, 0x1d, 0xde, 0x7e, 0xfc, 0x74, 0x77, 0xe5, 0x8f,
-	0xa7, 0xbb, 0xaf, 0x99, 0x1b, 0x8a, 0x74, 0x14, 0x52, 0x1e, 0x15, 0x58, 0x0e, 0xc3, 0xfb, 0x24,
-	0xc3, 0xc9, 0xac, 0x4b, 0x92, 0x78, 0xb3, 0x4a, 0x71, 0xa2, 0x32, 0x7c, 0xa5, 0x13, 0xa0, 0x37,
-	0xe0, 0xa5, 0xc9, 0x38, 0xe7, 0x38, 0xed, 0x51, 0x26, 0x49, 0x39, 0xc5, 0x79, 0x50, 0xd3, 0x9d,
-	0x37, 0x8d, 0xfa, 0x23, 0xab, 0x45, 0x8f, 0xa0, 0x21, 0xb9, 0xc4, 0x79, 0x6f, 0x30, 0x61, 0xa9,
-	0x08, 0xd6, 0xf7, 0xd6, 0x3a, 0x8d, 0x83, 0xad, 0xd0, 0x54, 0x0c, 0xd5, 0xcc, 0x1d, 0x36, 0xe1,
-	0x11, 0xa7, 0xec, 0xf0, 0xae, 0xea, 0xe9, 0xe7, 0x3f, 0x77, 0x3b, 0x19, 0x95, 0xc3, 0x49, 0x3f,
-	0x4c, 0x78, 0x11, 0x59, 0x00, 0xcc, 0xd7, 0xdb, 0x22, 0x1d, 0x45, 0x72, 0x36, 0x26, 0x42, 0x07,
-	0x88, 0x9f, 0xfe, 0xfe, 0xf5, 0x8e, 0x17, 0x83, 0x2e, 0x72, 0xac, 0x6a, 0xa0, 0x5d, 0x57, 0x52,
-	0x21, 0x44, 0x82, 0x0d, 0xdd, 0x97, 0x71, 0x38, 0x51, 0x1a, 0x74, 0x17, 0x6a, 0x42, 0x62, 0x39,
-	0x11, 0x41, 0x7d, 0xcf, 0xeb, 0x34, 0x0f, 0x76, 0x42, 0xcd, 0x14, 0x8d, 0x8c, 0x6b, 0x46, 0x41,
-	0x72, 0xa2, 0x9d, 0x62, 0xeb, 0xdc, 0xfe, 0xd1, 0x07, 0x38, 0x9e, 0xe4, 0x26, 0x49, 0xa9, 0xb0,
-	0xc0, 0x69, 0x5a, 0x12, 0x21, 0x34, 0x68, 0xf5, 0xd8, 0x89, 0xe8, 0x03, 0xa8, 0x4f, 0x71, 0x4e,
-	0x53, 0x2c, 0x79, 0xa9, 0xe1, 0x6b, 0x1c, 0xdc, 0x72, 0x37, 0x76, 0xac, 0x71, 0x75, 0x1e, 0x38,
-	0xc7, 0x78, 0x1e, 0x83, 0xf6, 0x61, 0xb3, 0x12, 0x7a, 0x29, 0xc9, 0x49, 0xa6, 0x4e, 0x42, 0x63,
-	0xee, 0xc7, 0xaf, 0x54, 0xb6, 0x6e, 0x65, 0x42, 0xef, 0xc1, 0xd6, 0x3c, 0x44, 0x90, 0x7c, 0xe0,
-	0xe2, 0x28, 0x67, 0x9a, 0x14, 0x7e, 0x7c, 0xa3, 0x72, 0x38, 0x21, 0xf9, 0xa0, 0x5b, 0x99, 0x51,
-	0x04, 0xf3, 0x94, 0xbd, 0x09, 0xeb, 0x73, 0x96, 0x52, 0x96, 0x69, 0x92, 0xf8, 0x31, 0xaa, 0x4c,
-	0x5f, 0x3a, 0x0b, 0x7a, 0x1f, 0xb6, 0xe7, 0x01, 0x66, 0xd6, 0x6a, 0x78, 0x76, 0xe0, 0xb5, 0x4b,
-	0xd5, 0xbe, 0x50, 0x0e, 0x76, 0x9e, 0x23, 0x82, 0x7e, 0xf0, 0xe0, 0xe6, 0x3c, 0x3a, 0xe1, 0x45,
-	0x41, 0x85, 0x50, 0x0c, 0x2d, 0xc9, 0x29, 0x2e, 0x5f, 0x20, 0x47, 0xe6, 0x3d, 0x1f, 0x55, 0x45,
-	0x63, 0x53, 0x13, 0xbd, 0x0b, 0x57, 0xd4, 0x0d, 0x44, 0xb0, 0xa1, 0x8b, 0xb7, 0xc3, 0x7f, 0xbf,
-	0x1d, 0x9a, 0x12, 0x9f, 0x92, 0xa2, 0x4f, 0x4a, 0x31, 0xa4, 0xe3, 0xd8, 0x04, 0xb4, 0xbf, 0xf5,
-	0xe0, 0xd5, 0x79, 0xbe, 0xa3, 0x21, 0x66, 0x19, 0xb9, 0xc7, 0x64, 0x39, 0x43, 0x47, 0x00, 0xf3,
-	0xdb, 0x19, 0x8e, 0x3c, 0xdb, 0xca, 0x2d, 0x84, 0xa1, 0xdb, 0x70, 0x2d, 0x29, 0x89, 0x59, 0xe1,
-	0x14, 0x4b, 0xf3, 0x1c, 0xac, 0xc5, 0x57, 0x9d, 0xb2, 0x8b, 0x25, 0x69, 0x7f, 0xe7, 0x41, 0xa0,
-	0x87, 0x7b, 0x5c, 0xe2, 0x44, 0x5e, 0x6a, 0xe3, 0x63, 0x68, 0x6a, 0x5c, 0x7a, 0x03, 0x6b, 0xfc,
-	0x3f, 0xad, 0x5c, 0x13, 0x8b, 0x69, 0x9f, 0xad, 0x9b, 0xdf, 0x7c, 0x68, 0x5e, 0x9c, 0x15, 0xda,
-	0x07, 0x5f, 0x4d, 0x4b, 0x57, 0x6e, 0xb8, 0x7d, 0xbb, 0x38, 0xdd, 0xea, 0x21, 0x8c, 0xb5, 0x2b,
-	0xba, 0x0e, 0xb5, 0x31, 0xa7, 0x4c, 0x0a, 0x5d, 0xc3, 0x8f, 0xad, 0x84, 0x76, 0x00, 0xa8, 0xe8,
-	0xe5, 0x04, 0x4f, 0x15, 0x47, 0xd5, 0x46, 0x6c, 0xc4, 0x75, 0x2a, 0xee, 0x1b, 0x05, 0xba, 0x05,
-	0x57, 0x35, 0x15, 0xdd, 0x6a, 0x9a, 0xf7, 0xb0, 0xa1, 0x74, 0x1f, 0xda, 0xf5, 0x0c, 0x60, 0xbd,
-	0x8f, 0x73, 0xcc, 0x12, 0x62, 0x29, 0xee, 0xc4, 0x4b, 0x88, 0xd5, 0x9e, 0x0f, 0x31, 0x02, 0x5b,
-	0x63, 0xa2, 0xf7, 0x64, 0x91, 0xdc, 0x89, 0x46, 0x24, 0x58, 0xd7, 0x03, 0x78, 0x73, 0xd9, 0x00,
-	0x96, 0x92, 0x28, 0xbe, 0x61, 0x73, 0x5d, 0xb6, 0x2e, 0x81, 0x75, 0xe3, 0xb9, 0x61, 0xe5, 0xb0,
-	0xe3, 0x5a, 0xbe, 0x98, 0xd3, 0xb5, 0x5d, 0xd7, 0x6d, 0xbf, 0xb5, 0xac, 0xed, 0xff, 0xe2, 0x5d,
-	0xbc, 0x6d, 0x53, 0x2e, 0x71, 0x50, 0x20, 0x2e, 0x3c, 0x18, 0xa0, 0x51, 0xa8, 0x8f, 0xdd, 0x13,
-	0x71, 0xd8, 0x7d, 0x7c, 0xd6, 0xf2, 0x9e, 0x9c, 0xb5, 0xbc, 0xbf, 0xce, 0x5a, 0xde, 0xf7, 0xe7,
-	0xad, 0x95, 0x27, 0xe7, 0xad, 0x95, 0xdf, 0xcf, 0x5b, 0x2b, 0x5f, 0xdf, 0x59, 0x58, 0xf9, 0x4f,
-	0x1e, 0x3e, 0xb8, 0xf7, 0x19, 0x91, 0xa7, 0xbc, 0x1c, 0x45, 0xc9, 0x10, 0x53, 0x16, 0x7d, 0x63,
-	0xff, 0x0d, 0xe8, 0xd5, 0xef, 0xd7, 0xf4, 0xaf, 0xec, 0x3b, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff,
-	0xa9, 0xae, 0xc2, 0xd0, 0x28, 0x08, 0x00, 0x00,
+	0x14, 0xcf, 0x26, 0x8e, 0x1b, 0x3f, 0x27, 0x2e, 0x19, 0x42, 0xb3, 0x49, 0x1b, 0x27, 0x4d, 0x11,
+	0x98, 0x88, 0x7a, 0x95, 0xa0, 0x4a, 0x08, 0x84, 0x50, 0x93, 0x34, 0x52, 0x4b, 0x40, 0xd5, 0x1a,
+	0x8a, 0xca, 0x65, 0x19, 0xef, 0x4e, 0xd6, 0x83, 0x77, 0x67, 0xdc, 0x9d, 0xb1, 0x13, 0x1f, 0x39,
+	0x73, 0x41, 0x7c, 0x0a, 0x84, 0x38, 0xf0, 0x31, 0x7a, 0xec, 0x09, 0x21, 0x0e, 0x05, 0x25, 0x07,
+	0xbe, 0x05, 0x42, 0xf3, 0x67, 0xd7, 0x4e, 0xea, 0x4a, 0x69, 0x25, 0x2e, 0xc9, 0xcc, 0xfb, 0xff,
+	0xde, 0xef, 0x37, 0xcf, 0x0b, 0xf5, 0xee, 0x70, 0x40, 0xbc, 0x27, 0x7d, 0x92, 0x0d, 0xbd, 0xc1,
+	0x76, 0x9b, 0x48, 0xbc, 0x6d, 0x6e, 0xcd, 0x5e, 0xc6, 0x25, 0x47, 0x48, 0xe9, 0x9b, 0x46, 0x62,
+	0xf5, 0xab, 0x8b, 0x38, 0xa5, 0x8c, 0x7b, 0xfa, 0xaf, 0x31, 0x5b, 0xad, 0x87, 0x5c, 0xa4, 0x5c,
+	0x78, 0x6d, 0x2c, 0x48, 0x11, 0x27, 0xe4, 0x94, 0x59, 0xfd, 0xdb, 0x56, 0x2f, 0x24, 0xee, 0x52,
+	0x16, 0x17, 0x26, 0xf6, 0x6e, 0xad, 0x96, 0x62, 0x1e, 0x73, 0x7d, 0xf4, 0xd4, 0xc9, 0x4a, 0x6f,
+	0xe8, 0x12, 0x7b, 0x9c, 0x27, 0x85, 0x9b, 0xba, 0x58, 0xed, 0x9a, 0xd6, 0xaa, 0x38, 0x24, 0x13,
+	0xde, 0x60, 0x3b, 0x3f, 0x1a, 0xf5, 0xe6, 0xaf, 0x33, 0x50, 0xd9, 0xc5, 0x82, 0x86, 0x0f, 0x39,
+	0x4f, 0x50, 0x0d, 0xa6, 0x69, 0xe4, 0x3a, 0x1b, 0x4e, 0xa3, 0xe4, 0x4f, 0xd3, 0x08, 0x21, 0x28,
+	0x31, 0x9c, 0x12, 0x77, 0x7a, 0xc3, 0x69, 0x54, 0x7c, 0x7d, 0x46, 0x2e, 0x5c, 0xc9, 0xfa, 0x4c,
+	0xd2, 0x94, 0xb8, 0x33, 0x5a, 0x9c, 0x5f, 0x95, 0x75, 0xc2, 0x63, 0xee, 0x96, 0x8c, 0xb5, 0x3a,
+	0xa3, 0xc7, 0x70, 0x8d, 0xb2, 0xa3, 0x04, 0x4b, 0xca, 0x59, 0x20, 0x3a, 0x38, 0x23, 0xc1, 0x31,
+	0xa1, 0x71, 0x47, 0xba, 0xb3, 0xca, 0x6a, 0xf7, 0xd6, 0xd3, 0xe7, 0xeb, 0x53, 0x7f, 0x3e, 0x5f,
+	0xbf, 0x6e, 0x06, 0x20, 0xa2, 0x6e, 0x93, 0x72, 0x2f, 0xc5, 0xb2, 0xd3, 0x3c, 0x24, 0x31, 0x0e,
+	0x87, 0xfb, 0x24, 0xf4, 0x97, 0x8a, 0x10, 0x2d, 0x15, 0xe1, 0x6b, 0x1d, 0x00, 0xbd, 0x0b, 0x57,
+	0xfb, 0xbd, 0x84, 0xe3, 0x28, 0xa0, 0x4c, 0x92, 0x6c, 0x80, 0x13, 0xb7, 0xac, 0x2b, 0xaf, 0x19,
+	0xf1, 0x7d, 0x2b, 0x45, 0x4f, 0xa0, 0x2a, 0xb9, 0xc4, 0x49, 0x70, 0xd4, 0x67, 0x91, 0x70, 0xaf,
+	0x6c, 0xcc, 0x34, 0xaa, 0x3b, 0x2b, 0x4d, 0x93, 0xb1, 0xa9, 0x20, 0xc9, 0xa1, 0x6b, 0xee, 0x71,
+	0xca, 0x76, 0xef, 0xa8, 0x9a, 0x7e, 0xf9, 0x6b, 0xbd, 0x11, 0x53, 0xd9, 0xe9, 0xb7, 0x9b, 0x21,
+	0x4f, 0x3d, 0x8b, 0x8f, 0xf9, 0x77, 0x5b, 0x44, 0x5d, 0x4f, 0x0e, 0x7b, 0x44, 0x68, 0x07, 0xf1,
+	0xf3, 0x3f, 0xbf, 0x6d, 0x39, 0x3e, 0xe8, 0x24, 0x07, 0x2a, 0x07, 0x5a, 0xcf, 0x53, 0xea, 0x69,
+	0xbb, 0x73, 0xba, 0x2e, 0x63, 0xd0, 0x52, 0x12, 0x74, 0x07, 0xca, 0x42, 0x62, 0xd9, 0x17, 0x6e,
+	0x65, 0xc3, 0x69, 0xd4, 0x76, 0xd6, 0x9a, 0x9a, 0x48, 0x1a, 0xb8, 0xbc, 0x18, 0x05, 0x49, 0x4b,
+	0x1b, 0xf9, 0xd6, 0x78, 0xf3, 0xf7, 0x59, 0x80, 0x83, 0x7e, 0x62, 0x82, 0x64, 0x0a, 0x0b, 0x1c,
+	0x45, 0x19, 0x11, 0x42, 0x83, 0x56, 0xf1, 0xf3, 0x2b, 0xfa, 0x14, 0x2a, 0x03, 0x9c, 0xd0, 0x08,
+	0x4b, 0x9e, 0x69, 0xf8, 0xaa, 0x3b, 0x37, 0xf3, 0x8e, 0x73, 0x52, 0xe5, 0x79, 0x1e, 0xe5, 0x86,
+	0xfe, 0xc8, 0x07, 0x6d, 0xc3, 0x52, 0x71, 0x09, 0x22, 0x92, 0x90, 0x58, 0x9d, 0x84, 0xc6, 0xbc,
+	0xe4, 0xbf, 0x59, 0xe8, 0xf6, 0x0b, 0x15, 0xfa, 0x08, 0x56, 0x46, 0x2e, 0x82, 0x24, 0x47, 0xb9,
+	0x1f, 0xe5, 0x4c, 0x93, 0xa2, 0xe4, 0x2f, 0x17, 0x06, 0x2d, 0x92, 0x1c, 0xed, 0x17, 0x6a, 0xe4,
+	0xc1, 0x28, 0x64, 0xd0, 0x67, 0x6d, 0xce, 0x22, 0xca, 0x62, 0x4d, 0x92, 0x92, 0x8f, 0x0a, 0xd5,
+	0x57, 0xb9, 0x06, 0x7d, 0x0c, 0xab, 0x23, 0x07, 0x33, 0x6b, 0x35, 0x3c, 0x3b, 0xf0, 0xf2, 0x85,
+	0x6c, 0x5f, 0x2a, 0x03, 0x3b, 0xcf, 0x2e, 0x41, 0x3f, 0x39, 0x70, 0x63, 0xe4, 0x1d, 0xf2, 0x34,
+	0xa5, 0x42, 0x28, 0x86, 0x66, 0xe4, 0x18, 0x67, 0xff, 0x23, 0x47, 0x46, 0x35, 0xef, 0x15, 0x49,
+	0x7d, 0x93, 0x13, 0x7d, 0x08, 0xb3, 0xaa, 0x03, 0xe1, 0xce, 0xe9, 0xe4, 0x9b, 0xcd, 0x17, 0x57,
+	0x8b, 0xa6, 0xc4, 0xe7, 0x24, 0x6d, 0x93, 0x4c, 0x74, 0x68, 0xcf, 0x37, 0x0e, 0xe8, 0x36, 0x8c,
+	0x26, 0x14, 0x50, 0x86, 0x43, 0x49, 0x07, 0x44, 0x13, 0x6b, 0xce, 0x5f, 0x2c, 0x34, 0xf7, 0xad,
+	0x02, 0xbd, 0x03, 0x57, 0x71, 0x5f, 0xf2, 0x20, 0x21, 0x78, 0x40, 0x82, 0x08, 0x4b, 0xe2, 0xc2,
+	0x86, 0xd3, 0x98, 0xf1, 0x17, 0x94, 0xf8, 0x50, 0x49, 0xf7, 0xb1, 0x24, 0xe8, 0x3b, 0xb8, 0xfe,
+	0xe2, 0x68, 0x02, 0x41, 0xa4, 0xa4, 0x2c, 0x16, 0x6e, 0x55, 0xb3, 0x6a, 0xcb, 0x94, 0x99, 0x6f,
+	0x95, 0x81, 0x9a, 0xcf, 0x85, 0xce, 0x5a, 0xd6, 0xc3, 0x5f, 0x09, 0x5f, 0xa6, 0xda, 0xfc, 0xde,
+	0x81, 0xb7, 0x46, 0x8e, 0x7b, 0x1d, 0xcc, 0x62, 0x72, 0x8f, 0xc9, 0x6c, 0x88, 0xf6, 0x00, 0x46,
+	0x6e, 0xae, 0x73, 0xf9, 0xad, 0x31, 0xe6, 0x86, 0x6e, 0xc1, 0x42, 0x98, 0x11, 0xb3, 0x85, 0x74,
+	0xc3, 0xd3, 0xba, 0xe1, 0xf9, 0x5c, 0xa8, 0xfa, 0xdd, 0xfc, 0xc1, 0x01, 0x57, 0xf3, 0xe3, 0x20,
+	0x53, 0x93, 0x3a, 0x5f, 0xc6, 0x03, 0xa8, 0xe9, 0x1e, 0x83, 0x23, 0xab, 0x7c, 0x95, 0x52, 0x16,
+	0xc4, 0x78, 0xd8, 0xcb, 0x55, 0xf3, 0x6f, 0x19, 0x6a, 0xe7, 0xe1, 0x46, 0xdb, 0x50, 0x52, 0x80,
+	0xeb, 0xcc, 0xd5, 0x9d, 0xb5, 0x49, 0x04, 0x29, 0x76, 0xb9, 0xaf, 0x4d, 0xd1, 0x35, 0x28, 0xf7,
+	0x38, 0x65, 0x52, 0xe8, 0x1c, 0x25, 0xdf, 0xde, 0xd0, 0x1a, 0x00, 0x15, 0x9a, 0x01, 0xea, 0x99,
+	0xcd, 0x68, 0xaa, 0x54, 0xa8, 0x38, 0x34, 0x02, 0x74, 0x13, 0xe6, 0xf5, 0x6b, 0xca, 0xb7, 0x8b,
+	0x59, 0xe9, 0x55, 0x25, 0xbb, 0x6b, 0x44, 0x6a, 0xf7, 0xb4, 0x71, 0x82, 0x59, 0x48, 0xec, 0x2b,
+	0xcd, 0xaf, 0x17, 0x10, 0x2b, 0xbf, 0x1e, 0x62, 0x04, 0x56, 0x7a, 0x44, 0x3f, 0xf5, 0xf1, 0xf7,
+	0x19, 0x6a, 0x44, 0xdc, 0x2b, 0x7a, 0x00, 0xef, 0x4d, 0x1a, 0xc0, 0x44, 0x12, 0xf9, 0xcb, 0x36,
+	0xd6, 0x45, 0xed, 0x04, 0x58, 0xe7, 0x5e, 0x1b, 0x56, 0x0e, 0x6b, 0x79, 0xc9, 0xe7, 0x63, 0xe6,
+	0x65, 0x57, 0x74, 0xd9, 0xef, 0x4f, 0x2a, 0xfb, 0x65, 0xbc, 0xf3, 0x57, 0x6d, 0xc8, 0x09, 0x06,
+	0x0a, 0xc4, 0xb1, 0x9d, 0x07, 0x1a, 0x85, 0x4a, 0xaf, 0xd8, 0x72, 0x0f, 0xa0, 0x96, 0xe2, 0x93,
+	0xb1, 0xf1, 0xb9, 0xd5, 0x57, 0xe8, 0x2d, 0xc5, 0x27, 0xa3, 0x69, 0xa1, 0x6f, 0x61, 0xf5, 0x7c,
+	0x2c, 0xdb, 0x53, 0x90, 0x29, 0xfe, 0xce, 0x5f, 0x3e, 0xee, 0xf2, 0xb9, 0xb8, 0xa6, 0x11, 0x5f,
+	0x6d, 0x9b, 0x4f, 0x60, 0x56, 0x48, 0x2c, 0x85, 0xbb, 0x60, 0x7f, 0xad, 0x2e, 0xee, 0x15, 0xc5,
+	0xea, 0xbb, 0x61, 0xc8, 0xfb, 0x4c, 0xaa, 0x5f, 0x45, 0xb1, 0x5b, 0x52, 0xf9, 0x7c, 0xe3, 0x85,
+	0x1e, 0xc2, 0x62, 0x46, 0x12, 0x8a, 0xdb, 0x34, 0xa1, 0x72, 0x18, 0x88, 0x90, 0x67, 0xc4, 0xad,
+	0x5d, 0xbe, 0xae, 0x37, 0xc6, 0xbc, 0x5b, 0xca, 0x79, 0x77, 0xff, 0xe9, 0x69, 0xdd, 0x79, 0x76,
+	0x5a, 0x77, 0xfe, 0x3e, 0xad, 0x3b, 0x3f, 0x9e, 0xd5, 0xa7, 0x9e, 0x9d, 0xd5, 0xa7, 0xfe, 0x38,
+	0xab, 0x4f, 0x7d, 0xb3, 0x35, 0xb6, 0xf4, 0x3f, 0x7b, 0xfc, 0xe8, 0xde, 0x17, 0x44, 0x1e, 0xf3,
+	0xac, 0xeb, 0x85, 0x1d, 0x4c, 0x99, 0x77, 0x62, 0x3f, 0x17, 0xf5, 0xf2, 0x6f, 0x97, 0xf5, 0x77,
+	0xd6, 0x07, 0xff, 0x0d, 0x00, 0xe2, 0xe5, 0x5d, 0x9a, 0x49, 0x0a, 0x00, 0x00,
 }
 
 func (m *BasicPool) Marshal() (dAtA []byte, err error) {
@@ -676,6 +739,33 @@ func (m *FullStaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CommissionRewardsSettings != nil {
+		{
+			size, err := m.CommissionRewardsSettings.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.AutoLeaveDate != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AutoLeaveDate))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ValidatorInactive {
+		i--
+		if m.ValidatorInactive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
 	if len(m.Pools) > 0 {
 		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -842,6 +932,46 @@ func (m *PoolMembership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.ReliabilityScore.Size()
+		i -= size
+		if _, err := m.ReliabilityScore.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.MaxCommissionChangeRate.Size()
+		i -= size
+		if _, err := m.MaxCommissionChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.MaxCommission.Size()
+		i -= size
+		if _, err := m.MaxCommission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
 	if m.PoolStake != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.PoolStake))
 		i--
@@ -1023,6 +1153,16 @@ func (m *FullStaker) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if m.ValidatorInactive {
+		n += 2
+	}
+	if m.AutoLeaveDate != 0 {
+		n += 1 + sovQuery(uint64(m.AutoLeaveDate))
+	}
+	if m.CommissionRewardsSettings != nil {
+		l = m.CommissionRewardsSettings.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -1092,6 +1232,14 @@ func (m *PoolMembership) Size() (n int) {
 	if m.PoolStake != 0 {
 		n += 1 + sovQuery(uint64(m.PoolStake))
 	}
+	l = m.MaxCommission.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MaxCommissionChangeRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Stats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ReliabilityScore.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
@@ -1632,6 +1780,81 @@ func (m *FullStaker) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorInactive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ValidatorInactive = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoLeaveDate", wireType)
+			}
+			m.AutoLeaveDate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoLeaveDate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRewardsSettings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CommissionRewardsSettings == nil {
+				m.CommissionRewardsSettings = &types3.CommissionRewardsSettings{}
+			}
+			if err := m.CommissionRewardsSettings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2173,6 +2396,141 @@ func (m *PoolMembership) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxCommission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommissionChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxCommissionChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReliabilityScore", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReliabilityScore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -474,6 +475,191 @@ func (m *QueryStakersByPoolCountResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryStakeAllocationRequest is the request type for the Query/StakeAllocation RPC method.
+type QueryStakeAllocationRequest struct {
+	// address of the validator
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryStakeAllocationRequest) Reset()         { *m = QueryStakeAllocationRequest{} }
+func (m *QueryStakeAllocationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStakeAllocationRequest) ProtoMessage()    {}
+func (*QueryStakeAllocationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11570d62f30fe615, []int{8}
+}
+func (m *QueryStakeAllocationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStakeAllocationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStakeAllocationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStakeAllocationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakeAllocationRequest.Merge(m, src)
+}
+func (m *QueryStakeAllocationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStakeAllocationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakeAllocationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStakeAllocationRequest proto.InternalMessageInfo
+
+func (m *QueryStakeAllocationRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryStakeAllocationResponse is the response type for the Query/StakeAllocation RPC method.
+type QueryStakeAllocationResponse struct {
+	// total_stake_fraction is the sum of the stake fractions over all pools
+	TotalStakeFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=total_stake_fraction,json=totalStakeFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"total_stake_fraction"`
+	// max_stake_fraction_sum is the current limit for total_stake_fraction, zero means no limit
+	MaxStakeFractionSum cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=max_stake_fraction_sum,json=maxStakeFractionSum,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_stake_fraction_sum"`
+	// total_pool_stake is the effective stake the validator has at risk in all pools combined
+	TotalPoolStake uint64 `protobuf:"varint,3,opt,name=total_pool_stake,json=totalPoolStake,proto3" json:"total_pool_stake,omitempty"`
+	// max_slash_fraction is the fraction of the validator's bonded tokens which would
+	// get slashed if the validator receives the highest slash in every pool at once
+	MaxSlashFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=max_slash_fraction,json=maxSlashFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_slash_fraction"`
+	// max_slash_amount is the amount of $KYVE which would get slashed if the validator
+	// receives the highest slash in every pool at once
+	MaxSlashAmount uint64 `protobuf:"varint,5,opt,name=max_slash_amount,json=maxSlashAmount,proto3" json:"max_slash_amount,omitempty"`
+	// pools contains the stake allocation for each pool the validator participates in
+	Pools []PoolStakeAllocation `protobuf:"bytes,6,rep,name=pools,proto3" json:"pools"`
+}
+
+func (m *QueryStakeAllocationResponse) Reset()         { *m = QueryStakeAllocationResponse{} }
+func (m *QueryStakeAllocationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStakeAllocationResponse) ProtoMessage()    {}
+func (*QueryStakeAllocationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11570d62f30fe615, []int{9}
+}
+func (m *QueryStakeAllocationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStakeAllocationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStakeAllocationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStakeAllocationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakeAllocationResponse.Merge(m, src)
+}
+func (m *QueryStakeAllocationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStakeAllocationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakeAllocationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStakeAllocationResponse proto.InternalMessageInfo
+
+func (m *QueryStakeAllocationResponse) GetTotalPoolStake() uint64 {
+	if m != nil {
+		return m.TotalPoolStake
+	}
+	return 0
+}
+
+func (m *QueryStakeAllocationResponse) GetMaxSlashAmount() uint64 {
+	if m != nil {
+		return m.MaxSlashAmount
+	}
+	return 0
+}
+
+func (m *QueryStakeAllocationResponse) GetPools() []PoolStakeAllocation {
+	if m != nil {
+		return m.Pools
+	}
+	return nil
+}
+
+// PoolStakeAllocation contains the stake allocation of a validator in a single pool.
+type PoolStakeAllocation struct {
+	// pool_id of the pool
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// stake_fraction the validator has specified for this pool
+	StakeFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=stake_fraction,json=stakeFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"stake_fraction"`
+	// pool_stake is the effective stake the validator has at risk in this pool
+	PoolStake uint64 `protobuf:"varint,3,opt,name=pool_stake,json=poolStake,proto3" json:"pool_stake,omitempty"`
+	// max_slash_amount is the amount of $KYVE which would get slashed in this pool
+	// with the highest slash
+	MaxSlashAmount uint64 `protobuf:"varint,4,opt,name=max_slash_amount,json=maxSlashAmount,proto3" json:"max_slash_amount,omitempty"`
+}
+
+func (m *PoolStakeAllocation) Reset()         { *m = PoolStakeAllocation{} }
+func (m *PoolStakeAllocation) String() string { return proto.CompactTextString(m) }
+func (*PoolStakeAllocation) ProtoMessage()    {}
+func (*PoolStakeAllocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11570d62f30fe615, []int{10}
+}
+func (m *PoolStakeAllocation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolStakeAllocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolStakeAllocation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolStakeAllocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolStakeAllocation.Merge(m, src)
+}
+func (m *PoolStakeAllocation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolStakeAllocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolStakeAllocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolStakeAllocation proto.InternalMessageInfo
+
+func (m *PoolStakeAllocation) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolStakeAllocation) GetPoolStake() uint64 {
+	if m != nil {
+		return m.PoolStake
+	}
+	return 0
+}
+
+func (m *PoolStakeAllocation) GetMaxSlashAmount() uint64 {
+	if m != nil {
+		return m.MaxSlashAmount
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("kyve.query.v1.StakerStatus", StakerStatus_name, StakerStatus_value)
 	proto.RegisterType((*QueryStakersRequest)(nil), "kyve.query.v1.QueryStakersRequest")
@@ -484,57 +670,76 @@ func init() {
 	proto.RegisterType((*QueryStakersByPoolResponse)(nil), "kyve.query.v1.QueryStakersByPoolResponse")
 	proto.RegisterType((*QueryStakersByPoolCountRequest)(nil), "kyve.query.v1.QueryStakersByPoolCountRequest")
 	proto.RegisterType((*QueryStakersByPoolCountResponse)(nil), "kyve.query.v1.QueryStakersByPoolCountResponse")
+	proto.RegisterType((*QueryStakeAllocationRequest)(nil), "kyve.query.v1.QueryStakeAllocationRequest")
+	proto.RegisterType((*QueryStakeAllocationResponse)(nil), "kyve.query.v1.QueryStakeAllocationResponse")
+	proto.RegisterType((*PoolStakeAllocation)(nil), "kyve.query.v1.PoolStakeAllocation")
 }
 
 func init() { proto.RegisterFile("kyve/query/v1/stakers.proto", fileDescriptor_11570d62f30fe615) }
 
 var fileDescriptor_11570d62f30fe615 = []byte{
-	// 716 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0x4f, 0x4f, 0x13, 0x4d,
-	0x18, 0xef, 0x94, 0xa6, 0x0d, 0x03, 0xbc, 0x21, 0xf3, 0x12, 0xde, 0xb2, 0xc0, 0xb6, 0x2c, 0x6f,
-	0xa0, 0x36, 0xba, 0x23, 0xc5, 0xa3, 0x31, 0x29, 0xa5, 0x68, 0x83, 0x29, 0x75, 0x5b, 0x48, 0x34,
-	0x26, 0xcd, 0xb6, 0x9d, 0x6c, 0x9b, 0x96, 0x9d, 0xb2, 0xb3, 0x45, 0x1a, 0xc2, 0xc5, 0x93, 0x47,
-	0x13, 0x13, 0xaf, 0xc6, 0x78, 0xf3, 0x1b, 0x78, 0xf0, 0xce, 0x91, 0xc4, 0x8b, 0x27, 0x63, 0xc0,
-	0x0f, 0x62, 0x76, 0x76, 0x16, 0x76, 0xa5, 0x14, 0x0e, 0x1c, 0xbc, 0xcd, 0x74, 0x9e, 0xdf, 0xf3,
-	0xfb, 0x93, 0xe7, 0xd9, 0xc2, 0xd9, 0x76, 0x7f, 0x9f, 0xe0, 0xbd, 0x1e, 0xb1, 0xfa, 0x78, 0x7f,
-	0x05, 0x33, 0x5b, 0x6f, 0x13, 0x8b, 0xa9, 0x5d, 0x8b, 0xda, 0x14, 0x4d, 0x38, 0x8f, 0x2a, 0x7f,
-	0x54, 0xf7, 0x57, 0xa4, 0x74, 0x9d, 0xb2, 0x5d, 0xca, 0x70, 0x4d, 0x67, 0x17, 0x90, 0x1a, 0xb1,
-	0xf5, 0x15, 0xdc, 0xd5, 0x8d, 0x96, 0xa9, 0xdb, 0x2d, 0x6a, 0xba, 0x50, 0x69, 0xca, 0xa0, 0x06,
-	0xe5, 0x47, 0xec, 0x9c, 0xc4, 0xaf, 0x73, 0x06, 0xa5, 0x46, 0x87, 0x60, 0xbd, 0xdb, 0xc2, 0xba,
-	0x69, 0x52, 0x9b, 0x43, 0x04, 0x9d, 0x24, 0x07, 0xb4, 0xb8, 0x8d, 0x5d, 0x72, 0xfe, 0xae, 0x7c,
-	0x06, 0xf0, 0xdf, 0x67, 0xce, 0xbd, 0xec, 0xaa, 0xd4, 0xc8, 0x5e, 0x8f, 0x30, 0x1b, 0x6d, 0x40,
-	0x78, 0xc1, 0x1f, 0x07, 0x49, 0x90, 0x1a, 0xcb, 0x2c, 0xa9, 0xae, 0x58, 0xd5, 0x11, 0x7b, 0x6e,
-	0x81, 0xf7, 0x54, 0x4b, 0xba, 0x41, 0x04, 0x56, 0xf3, 0x21, 0xd1, 0x2a, 0x8c, 0x32, 0x5b, 0xb7,
-	0x7b, 0x2c, 0x1e, 0x4e, 0x82, 0xd4, 0x3f, 0x99, 0x59, 0x35, 0xe0, 0x5f, 0x75, 0x69, 0xcb, 0xbc,
-	0x44, 0x13, 0xa5, 0x68, 0x1a, 0x46, 0x19, 0xd1, 0xad, 0x7a, 0x33, 0x3e, 0x92, 0x04, 0xa9, 0x51,
-	0x4d, 0xdc, 0x94, 0x0f, 0x00, 0x4e, 0x05, 0xc5, 0xb2, 0x2e, 0x35, 0x19, 0x41, 0x8f, 0x60, 0x4c,
-	0xa4, 0x1c, 0x07, 0xc9, 0x91, 0xd4, 0x58, 0x46, 0x0e, 0xd2, 0xb8, 0x1a, 0x37, 0x7a, 0x9d, 0x8e,
-	0x8b, 0x5c, 0x8b, 0x1c, 0xff, 0x48, 0x84, 0x34, 0x0f, 0x84, 0x1e, 0x07, 0xdc, 0x86, 0xb9, 0xdb,
-	0xe5, 0x6b, 0xdd, 0xba, 0xe4, 0x7e, 0xbb, 0x8a, 0x0a, 0x91, 0x4f, 0xa0, 0x17, 0x66, 0x1c, 0xc6,
-	0xf4, 0x46, 0xc3, 0x22, 0x8c, 0xf1, 0x24, 0x47, 0x35, 0xef, 0xaa, 0x94, 0x03, 0xe9, 0x9f, 0xfb,
-	0x79, 0xc8, 0x53, 0x6b, 0x13, 0x4b, 0x24, 0x7f, 0x33, 0x3b, 0x02, 0xa3, 0x3c, 0x80, 0x33, 0xfe,
-	0x94, 0xd6, 0xfa, 0x25, 0x4a, 0x3b, 0x9e, 0x96, 0xff, 0x60, 0xac, 0x4b, 0x69, 0xa7, 0xda, 0x6a,
-	0xf0, 0xde, 0x11, 0x2d, 0xea, 0x5c, 0x0b, 0x0d, 0xe5, 0x25, 0x94, 0x06, 0xa1, 0x6e, 0x27, 0x61,
-	0xa5, 0x09, 0xe5, 0xcb, 0xdd, 0x73, 0xb4, 0x67, 0xda, 0xb7, 0x3c, 0x71, 0xce, 0x44, 0x27, 0xae,
-	0xa4, 0xfa, 0xcb, 0xe6, 0x25, 0xfd, 0x15, 0xc0, 0x71, 0xff, 0x0a, 0xa0, 0x79, 0x38, 0x53, 0xae,
-	0x64, 0x37, 0xf3, 0x5a, 0xb5, 0x5c, 0xc9, 0x56, 0xb6, 0xcb, 0xd5, 0xed, 0x62, 0xb9, 0x94, 0xcf,
-	0x15, 0x36, 0x0a, 0xf9, 0xf5, 0xc9, 0x10, 0x5a, 0x80, 0xf3, 0xc1, 0xe7, 0x92, 0xb6, 0x55, 0xd9,
-	0xca, 0x6d, 0x3d, 0xad, 0x66, 0x73, 0x95, 0xc2, 0x4e, 0x7e, 0x12, 0xa0, 0x45, 0x98, 0xb8, 0xa2,
-	0xa4, 0x50, 0x14, 0x45, 0x61, 0x24, 0x43, 0x29, 0x58, 0x94, 0x7b, 0x92, 0x2d, 0x14, 0xbd, 0x26,
-	0x23, 0x28, 0x09, 0xe7, 0x06, 0xbd, 0x9f, 0x77, 0x88, 0x48, 0x91, 0x37, 0x9f, 0xe4, 0x50, 0xe6,
-	0x4b, 0x04, 0x8e, 0xfb, 0xc3, 0x46, 0x16, 0x8c, 0x79, 0x47, 0xe5, 0x8f, 0x55, 0x1f, 0xf0, 0x99,
-	0x91, 0x16, 0x87, 0xd6, 0xb8, 0x81, 0x29, 0xf2, 0xeb, 0x6f, 0xbf, 0xde, 0x85, 0xe3, 0x68, 0x1a,
-	0x0f, 0xfc, 0xb0, 0xa2, 0x03, 0x18, 0x75, 0x21, 0x68, 0xe1, 0xea, 0x76, 0x1e, 0xa3, 0x32, 0xac,
-	0x44, 0x10, 0x2e, 0x73, 0xc2, 0x05, 0x94, 0x18, 0x48, 0x88, 0x0f, 0xc5, 0xf6, 0x1e, 0xa1, 0xf7,
-	0x00, 0x4e, 0x04, 0xc6, 0x0c, 0xa5, 0x86, 0x18, 0x0a, 0x2c, 0xa2, 0x74, 0xe7, 0x06, 0x95, 0x42,
-	0xcf, 0x7d, 0xae, 0x27, 0x8d, 0x52, 0x83, 0x03, 0xa8, 0xd6, 0xfa, 0x55, 0x67, 0x89, 0xf1, 0xa1,
-	0xd8, 0xec, 0x23, 0xf4, 0x11, 0x40, 0x74, 0x79, 0xfe, 0xd1, 0xbd, 0x6b, 0x39, 0xfd, 0x2b, 0x29,
-	0xa9, 0x37, 0x2d, 0x17, 0x3a, 0xef, 0x72, 0x9d, 0x4b, 0xe8, 0xff, 0xe1, 0x3a, 0xab, 0x75, 0x07,
-	0xb5, 0xb6, 0x7e, 0x7c, 0x2a, 0x83, 0x93, 0x53, 0x19, 0xfc, 0x3c, 0x95, 0xc1, 0xdb, 0x33, 0x39,
-	0x74, 0x72, 0x26, 0x87, 0xbe, 0x9f, 0xc9, 0xa1, 0x17, 0x69, 0xa3, 0x65, 0x37, 0x7b, 0x35, 0xb5,
-	0x4e, 0x77, 0xf1, 0xe6, 0xf3, 0x9d, 0x7c, 0x91, 0xd8, 0xaf, 0xa8, 0xd5, 0xc6, 0xf5, 0xa6, 0xde,
-	0x32, 0xf1, 0x81, 0x68, 0x6c, 0xf7, 0xbb, 0x84, 0xd5, 0xa2, 0xfc, 0x7f, 0x6c, 0xf5, 0x77, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x2f, 0xb2, 0xd2, 0x70, 0x75, 0x07, 0x00, 0x00,
+	// 964 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0xd3, 0x34, 0x55, 0xdf, 0x6e, 0x4b, 0x34, 0xad, 0x8a, 0xd7, 0x6d, 0x9d, 0xd4, 0x45,
+	0xbb, 0xd9, 0x2c, 0xd8, 0xb4, 0x8b, 0xc4, 0x05, 0xad, 0x94, 0xa6, 0x29, 0x84, 0x5d, 0xb5, 0x5d,
+	0x27, 0x5d, 0x01, 0x42, 0xb2, 0x26, 0xce, 0x90, 0x44, 0x71, 0x32, 0x59, 0x8f, 0x53, 0x1a, 0xad,
+	0xf6, 0xc2, 0x89, 0x23, 0x12, 0x12, 0x37, 0x84, 0x10, 0x37, 0xfe, 0x00, 0x6e, 0x9c, 0xb8, 0xec,
+	0x71, 0x25, 0x2e, 0xc0, 0x61, 0x85, 0x5a, 0xfe, 0x10, 0xe4, 0xf1, 0xe4, 0x87, 0x53, 0xa7, 0x0d,
+	0xd2, 0x1e, 0xb8, 0xd9, 0x33, 0xef, 0x7b, 0xdf, 0xf7, 0xbe, 0x79, 0xf3, 0x6c, 0x58, 0x6f, 0xf5,
+	0x4f, 0x89, 0xf1, 0xb4, 0x47, 0xdc, 0xbe, 0x71, 0xba, 0x63, 0x30, 0x0f, 0xb7, 0x88, 0xcb, 0xf4,
+	0xae, 0x4b, 0x3d, 0x8a, 0x96, 0xfc, 0x4d, 0x9d, 0x6f, 0xea, 0xa7, 0x3b, 0x4a, 0xce, 0xa6, 0xac,
+	0x4d, 0x99, 0x51, 0xc5, 0x6c, 0x04, 0xa9, 0x12, 0x0f, 0xef, 0x18, 0x5d, 0x5c, 0x6f, 0x76, 0xb0,
+	0xd7, 0xa4, 0x9d, 0x00, 0xaa, 0xac, 0xd6, 0x69, 0x9d, 0xf2, 0x47, 0xc3, 0x7f, 0x12, 0xab, 0x1b,
+	0x75, 0x4a, 0xeb, 0x0e, 0x31, 0x70, 0xb7, 0x69, 0xe0, 0x4e, 0x87, 0x7a, 0x1c, 0x22, 0xe8, 0x14,
+	0x35, 0xa4, 0x25, 0x48, 0x1c, 0x90, 0xf3, 0x7d, 0xed, 0x67, 0x09, 0x56, 0x1e, 0xfb, 0xef, 0xe5,
+	0x40, 0xa5, 0x49, 0x9e, 0xf6, 0x08, 0xf3, 0xd0, 0x01, 0xc0, 0x88, 0x5f, 0x96, 0x32, 0x52, 0xf6,
+	0xc6, 0xee, 0x6d, 0x3d, 0x10, 0xab, 0xfb, 0x62, 0x87, 0x25, 0xf0, 0x9c, 0xfa, 0x31, 0xae, 0x13,
+	0x81, 0x35, 0xc7, 0x90, 0xe8, 0x3e, 0x24, 0x99, 0x87, 0xbd, 0x1e, 0x93, 0xe3, 0x19, 0x29, 0xbb,
+	0xbc, 0xbb, 0xae, 0x87, 0xea, 0xd7, 0x03, 0xda, 0x32, 0x0f, 0x31, 0x45, 0x28, 0x5a, 0x83, 0x24,
+	0x23, 0xd8, 0xb5, 0x1b, 0xf2, 0x5c, 0x46, 0xca, 0x2e, 0x9a, 0xe2, 0x4d, 0xfb, 0x41, 0x82, 0xd5,
+	0xb0, 0x58, 0xd6, 0xa5, 0x1d, 0x46, 0xd0, 0x03, 0x58, 0x10, 0x2e, 0xcb, 0x52, 0x66, 0x2e, 0x7b,
+	0x63, 0x57, 0x0d, 0xd3, 0x04, 0x1a, 0x0f, 0x7a, 0x8e, 0x13, 0x20, 0xf7, 0x12, 0x2f, 0x5e, 0xa5,
+	0x63, 0xe6, 0x00, 0x84, 0x3e, 0x0c, 0x55, 0x1b, 0xe7, 0xd5, 0xde, 0xb9, 0xb6, 0xda, 0x80, 0x7c,
+	0xbc, 0x5c, 0x4d, 0x07, 0x34, 0x26, 0x70, 0x60, 0xa6, 0x0c, 0x0b, 0xb8, 0x56, 0x73, 0x09, 0x63,
+	0xdc, 0xc9, 0x45, 0x73, 0xf0, 0xaa, 0x95, 0x43, 0xee, 0x0f, 0xeb, 0xf9, 0x80, 0xbb, 0xd6, 0x22,
+	0xae, 0x70, 0x7e, 0xb6, 0x72, 0x04, 0x46, 0x7b, 0x0f, 0x6e, 0x8d, 0xbb, 0xb4, 0xd7, 0x3f, 0xa6,
+	0xd4, 0x19, 0x68, 0x79, 0x13, 0x16, 0xba, 0x94, 0x3a, 0x56, 0xb3, 0xc6, 0x73, 0x27, 0xcc, 0xa4,
+	0xff, 0x5a, 0xaa, 0x69, 0x9f, 0x83, 0x12, 0x85, 0x7a, 0x3d, 0x0e, 0x6b, 0x0d, 0x50, 0x2f, 0x67,
+	0x2f, 0xd0, 0x5e, 0xc7, 0x7b, 0xcd, 0x1d, 0xe7, 0x77, 0x74, 0x7a, 0x2a, 0xd5, 0xff, 0xad, 0x5f,
+	0xde, 0x87, 0xf5, 0x91, 0xd6, 0xbc, 0xe3, 0x50, 0x9b, 0xaf, 0x5f, 0xdf, 0x38, 0xbf, 0xcc, 0xc1,
+	0x46, 0x34, 0x52, 0x94, 0x78, 0x02, 0xab, 0x1e, 0xf5, 0xb0, 0x63, 0x71, 0xcd, 0xd6, 0x17, 0x2e,
+	0xb6, 0x87, 0xc6, 0x2e, 0xee, 0x6d, 0xfb, 0xf5, 0xfc, 0xf5, 0x2a, 0xbd, 0x1e, 0x68, 0x66, 0xb5,
+	0x96, 0xde, 0xa4, 0x46, 0x1b, 0x7b, 0x0d, 0xfd, 0x11, 0xa9, 0x63, 0xbb, 0xbf, 0x4f, 0x6c, 0x13,
+	0xf1, 0x04, 0x9c, 0xe0, 0x40, 0xc0, 0xd1, 0x27, 0xb0, 0xd6, 0xc6, 0x67, 0x13, 0x49, 0x2d, 0xd6,
+	0x6b, 0xcb, 0xf1, 0xd9, 0x13, 0xaf, 0xb4, 0xf1, 0x59, 0x28, 0x6d, 0xb9, 0xd7, 0x46, 0x59, 0x48,
+	0x05, 0x82, 0x79, 0x7b, 0x72, 0x02, 0x7e, 0xfd, 0x13, 0xe6, 0x32, 0x5f, 0xf7, 0x4f, 0x91, 0x83,
+	0xd0, 0x63, 0x40, 0x5c, 0x83, 0x83, 0x59, 0x63, 0x54, 0x58, 0x62, 0x76, 0xfe, 0x94, 0xcf, 0xef,
+	0xa3, 0x87, 0x65, 0x65, 0x21, 0x35, 0x4a, 0x89, 0xdb, 0x7e, 0xb3, 0xc8, 0xf3, 0x01, 0xf9, 0x20,
+	0x36, 0xcf, 0x57, 0xd1, 0x03, 0x98, 0xf7, 0x05, 0x32, 0x39, 0xc9, 0x1b, 0x47, 0x9b, 0x98, 0x67,
+	0x43, 0x95, 0xa3, 0x23, 0x11, 0xcd, 0x13, 0xc0, 0xb4, 0xdf, 0x24, 0x58, 0x89, 0x08, 0x9a, 0x7a,
+	0x2f, 0xd1, 0xc7, 0xb0, 0x3c, 0x71, 0x84, 0xff, 0xc1, 0xe9, 0x25, 0x16, 0x3a, 0xbd, 0x4d, 0x80,
+	0x4b, 0xee, 0x2e, 0x76, 0x87, 0xc6, 0x46, 0xb9, 0x90, 0x88, 0x72, 0x21, 0xf7, 0xab, 0x04, 0x37,
+	0xc7, 0x47, 0x37, 0xda, 0x84, 0x5b, 0xe5, 0x4a, 0xfe, 0x61, 0xd1, 0xb4, 0xca, 0x95, 0x7c, 0xe5,
+	0xa4, 0x6c, 0x9d, 0x1c, 0x96, 0x8f, 0x8b, 0x85, 0xd2, 0x41, 0xa9, 0xb8, 0x9f, 0x8a, 0xa1, 0x2d,
+	0xd8, 0x0c, 0x6f, 0x1f, 0x9b, 0x47, 0x95, 0xa3, 0xc2, 0xd1, 0x23, 0x2b, 0x5f, 0xa8, 0x94, 0x9e,
+	0x14, 0x53, 0x12, 0xda, 0x86, 0xf4, 0x94, 0x90, 0xd2, 0xa1, 0x08, 0x8a, 0x23, 0x15, 0x94, 0x70,
+	0x50, 0xe1, 0xa3, 0x7c, 0xe9, 0x70, 0x90, 0x64, 0x0e, 0x65, 0x60, 0x23, 0x6a, 0x7f, 0x98, 0x21,
+	0xa1, 0x24, 0xbe, 0xfe, 0x49, 0x8d, 0xed, 0xfe, 0x39, 0x0f, 0x37, 0xc7, 0x87, 0x04, 0x72, 0x61,
+	0x61, 0xf0, 0x38, 0x79, 0xa4, 0x11, 0x9f, 0x47, 0x65, 0xfb, 0xca, 0x98, 0xe0, 0x0a, 0x6a, 0xea,
+	0x57, 0xbf, 0xff, 0xf3, 0x6d, 0x5c, 0x46, 0x6b, 0x46, 0xe4, 0x0f, 0x01, 0x3a, 0x83, 0x64, 0x00,
+	0x41, 0x5b, 0xd3, 0xd3, 0x0d, 0x18, 0xb5, 0xab, 0x42, 0x04, 0xe1, 0x1d, 0x4e, 0xb8, 0x85, 0xd2,
+	0x91, 0x84, 0xc6, 0x33, 0x31, 0x3c, 0x9e, 0xa3, 0xef, 0x24, 0x58, 0x0a, 0x8d, 0x47, 0x94, 0xbd,
+	0xa2, 0xa0, 0xd0, 0x07, 0x44, 0xb9, 0x3b, 0x43, 0xa4, 0xd0, 0xf3, 0x2e, 0xd7, 0x93, 0x43, 0xd9,
+	0x68, 0x03, 0xac, 0x6a, 0x9f, 0x5f, 0x76, 0xe3, 0x99, 0xe8, 0xfc, 0xe7, 0xe8, 0x47, 0x09, 0xd0,
+	0xe5, 0xb9, 0x8d, 0xde, 0xb9, 0x96, 0x73, 0xfc, 0x53, 0xa2, 0xe8, 0xb3, 0x86, 0x0b, 0x9d, 0x6f,
+	0x73, 0x9d, 0xb7, 0xd1, 0x5b, 0x57, 0xeb, 0xb4, 0x6c, 0x2e, 0xe6, 0x7b, 0x09, 0xde, 0x98, 0xbc,
+	0xbd, 0xb9, 0xa9, 0x8c, 0x97, 0x86, 0xba, 0x72, 0x6f, 0xa6, 0x58, 0x21, 0x6d, 0x87, 0x4b, 0xbb,
+	0x87, 0xee, 0x46, 0x49, 0xb3, 0xf0, 0x10, 0x30, 0x3a, 0xdc, 0xbd, 0xfd, 0x17, 0xe7, 0xaa, 0xf4,
+	0xf2, 0x5c, 0x95, 0xfe, 0x3e, 0x57, 0xa5, 0x6f, 0x2e, 0xd4, 0xd8, 0xcb, 0x0b, 0x35, 0xf6, 0xc7,
+	0x85, 0x1a, 0xfb, 0x2c, 0x57, 0x6f, 0x7a, 0x8d, 0x5e, 0x55, 0xb7, 0x69, 0xdb, 0x78, 0xf8, 0xe9,
+	0x93, 0xe2, 0x21, 0xf1, 0xbe, 0xa4, 0x6e, 0xcb, 0xb0, 0x1b, 0xb8, 0xd9, 0x31, 0xce, 0x44, 0x76,
+	0xaf, 0xdf, 0x25, 0xac, 0x9a, 0xe4, 0xff, 0x87, 0xf7, 0xff, 0x1d, 0x00, 0x88, 0x56, 0x6c, 0xfb,
+	0xcd, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -558,6 +763,9 @@ type QueryStakersClient interface {
 	// StakersByPool queries for all stakers and sorted them first by number of pools participating and
 	// then by delegation
 	StakersByPoolCount(ctx context.Context, in *QueryStakersByPoolCountRequest, opts ...grpc.CallOption) (*QueryStakersByPoolCountResponse, error)
+	// StakeAllocation queries the stake a validator has allocated across all pools
+	// together with the worst-case slash exposure of that allocation.
+	StakeAllocation(ctx context.Context, in *QueryStakeAllocationRequest, opts ...grpc.CallOption) (*QueryStakeAllocationResponse, error)
 }
 
 type queryStakersClient struct {
@@ -604,6 +812,15 @@ func (c *queryStakersClient) StakersByPoolCount(ctx context.Context, in *QuerySt
 	return out, nil
 }
 
+func (c *queryStakersClient) StakeAllocation(ctx context.Context, in *QueryStakeAllocationRequest, opts ...grpc.CallOption) (*QueryStakeAllocationResponse, error) {
+	out := new(QueryStakeAllocationResponse)
+	err := c.cc.Invoke(ctx, "/kyve.query.v1.QueryStakers/StakeAllocation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryStakersServer is the server API for QueryStakers service.
 type QueryStakersServer interface {
 	// Stakers queries for all stakers.
@@ -615,6 +832,9 @@ type QueryStakersServer interface {
 	// StakersByPool queries for all stakers and sorted them first by number of pools participating and
 	// then by delegation
 	StakersByPoolCount(context.Context, *QueryStakersByPoolCountRequest) (*QueryStakersByPoolCountResponse, error)
+	// StakeAllocation queries the stake a validator has allocated across all pools
+	// together with the worst-case slash exposure of that allocation.
+	StakeAllocation(context.Context, *QueryStakeAllocationRequest) (*QueryStakeAllocationResponse, error)
 }
 
 // UnimplementedQueryStakersServer can be embedded to have forward compatible implementations.
@@ -633,6 +853,9 @@ func (*UnimplementedQueryStakersServer) StakersByPool(ctx context.Context, req *
 func (*UnimplementedQueryStakersServer) StakersByPoolCount(ctx context.Context, req *QueryStakersByPoolCountRequest) (*QueryStakersByPoolCountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StakersByPoolCount not implemented")
 }
+func (*UnimplementedQueryStakersServer) StakeAllocation(ctx context.Context, req *QueryStakeAllocationRequest) (*QueryStakeAllocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StakeAllocation not implemented")
+}
 
 func RegisterQueryStakersServer(s grpc1.Server, srv QueryStakersServer) {
 	s.RegisterService(&_QueryStakers_serviceDesc, srv)
@@ -710,6 +933,24 @@ func _QueryStakers_StakersByPoolCount_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryStakers_StakeAllocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStakeAllocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryStakersServer).StakeAllocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.query.v1.QueryStakers/StakeAllocation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryStakersServer).StakeAllocation(ctx, req.(*QueryStakeAllocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var QueryStakers_serviceDesc = _QueryStakers_serviceDesc
 var _QueryStakers_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kyve.query.v1.QueryStakers",
@@ -731,6 +972,10 @@ var _QueryStakers_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StakersByPoolCount",
 			Handler:    _QueryStakers_StakersByPoolCount_Handler,
 		},
+		{
+			MethodName: "StakeAllocation",
+			Handler:    _QueryStakers_StakeAllocation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kyve/query/v1/stakers.proto",
@@ -1044,6 +1289,161 @@ func (m *QueryStakersByPoolCountResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryStakeAllocationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakeAllocationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakeAllocationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintStakers(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakeAllocationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakeAllocationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakeAllocationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStakers(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.MaxSlashAmount != 0 {
+		i = encodeVarintStakers(dAtA, i, uint64(m.MaxSlashAmount))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.MaxSlashFraction.Size()
+		i -= size
+		if _, err := m.MaxSlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStakers(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.TotalPoolStake != 0 {
+		i = encodeVarintStakers(dAtA, i, uint64(m.TotalPoolStake))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.MaxStakeFractionSum.Size()
+		i -= size
+		if _, err := m.MaxStakeFractionSum.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStakers(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TotalStakeFraction.Size()
+		i -= size
+		if _, err := m.TotalStakeFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStakers(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolStakeAllocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolStakeAllocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolStakeAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxSlashAmount != 0 {
+		i = encodeVarintStakers(dAtA, i, uint64(m.MaxSlashAmount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PoolStake != 0 {
+		i = encodeVarintStakers(dAtA, i, uint64(m.PoolStake))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.StakeFraction.Size()
+		i -= size
+		if _, err := m.StakeFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStakers(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintStakers(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintStakers(dAtA []byte, offset int, v uint64) int {
 	offset -= sovStakers(v)
 	base := offset
@@ -1177,16 +1577,76 @@ func (m *QueryStakersByPoolCountResponse) Size() (n int) {
 	return n
 }
 
-func sovStakers(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozStakers(x uint64) (n int) {
-	return sovStakers(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryStakeAllocationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovStakers(uint64(l))
+	}
+	return n
 }
-func (m *QueryStakersRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
+
+func (m *QueryStakeAllocationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalStakeFraction.Size()
+	n += 1 + l + sovStakers(uint64(l))
+	l = m.MaxStakeFractionSum.Size()
+	n += 1 + l + sovStakers(uint64(l))
+	if m.TotalPoolStake != 0 {
+		n += 1 + sovStakers(uint64(m.TotalPoolStake))
+	}
+	l = m.MaxSlashFraction.Size()
+	n += 1 + l + sovStakers(uint64(l))
+	if m.MaxSlashAmount != 0 {
+		n += 1 + sovStakers(uint64(m.MaxSlashAmount))
+	}
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovStakers(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolStakeAllocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovStakers(uint64(m.PoolId))
+	}
+	l = m.StakeFraction.Size()
+	n += 1 + l + sovStakers(uint64(l))
+	if m.PoolStake != 0 {
+		n += 1 + sovStakers(uint64(m.PoolStake))
+	}
+	if m.MaxSlashAmount != 0 {
+		n += 1 + sovStakers(uint64(m.MaxSlashAmount))
+	}
+	return n
+}
+
+func sovStakers(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStakers(x uint64) (n int) {
+	return sovStakers(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryStakersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -1964,6 +2424,453 @@ func (m *QueryStakersByPoolCountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryStakeAllocationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStakers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakeAllocationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakeAllocationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStakers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStakers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStakers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakeAllocationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStakers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakeAllocationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakeAllocationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalStakeFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStakers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalStakeFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStakeFractionSum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStakers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxStakeFractionSum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPoolStake", wireType)
+			}
+			m.TotalPoolStake = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPoolStake |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStakers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlashAmount", wireType)
+			}
+			m.MaxSlashAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSlashAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStakers
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, PoolStakeAllocation{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStakers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStakers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolStakeAllocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStakers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolStakeAllocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolStakeAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStakers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakeFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolStake", wireType)
+			}
+			m.PoolStake = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolStake |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlashAmount", wireType)
+			}
+			m.MaxSlashAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSlashAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStakers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStakers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipStakers(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
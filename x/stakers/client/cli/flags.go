@@ -0,0 +1,33 @@
+package cli
+
+import (
+	flag "github.com/spf13/pflag"
+)
+
+const (
+	FlagMaxCommission           = "max-commission"
+	FlagMaxCommissionChangeRate = "max-commission-change-rate"
+)
+
+func flagSetJoinPool() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagMaxCommission, "", "The (optional) highest commission the validator can ever charge in the pool, defaults to the pool limit")
+	fs.String(FlagMaxCommissionChangeRate, "", "The (optional) maximum daily commission change, defaults to the pool limit")
+
+	return fs
+}
+
+const (
+	FlagAcceptAll     = "accept-all"
+	FlagForwardPoolId = "forward-pool-id"
+)
+
+func flagSetUpdateCommissionRewardsSettings() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.Bool(FlagAcceptAll, false, "Accept all denoms as commission rewards and remove the current settings")
+	fs.String(FlagForwardPoolId, "", "The (optional) pool unaccepted denoms are forwarded to, defaults to the community pool")
+
+	return fs
+}
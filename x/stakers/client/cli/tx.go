@@ -23,6 +23,7 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdLeavePool())
 	cmd.AddCommand(CmdUpdateCommission())
 	cmd.AddCommand(CmdUpdateStakeFraction())
+	cmd.AddCommand(CmdUpdateCommissionRewardsSettings())
 
 	return cmd
 }
@@ -38,18 +38,35 @@ func CmdJoinPool() *cobra.Command {
 				return err
 			}
 
+			// The commission rules are optional and default to the pool limits.
+			var maxCommission, maxCommissionChangeRate math.LegacyDec
+
+			if value, _ := cmd.Flags().GetString(FlagMaxCommission); value != "" {
+				if maxCommission, err = math.LegacyNewDecFromStr(value); err != nil {
+					return err
+				}
+			}
+
+			if value, _ := cmd.Flags().GetString(FlagMaxCommissionChangeRate); value != "" {
+				if maxCommissionChangeRate, err = math.LegacyNewDecFromStr(value); err != nil {
+					return err
+				}
+			}
+
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
 			msg := types.MsgJoinPool{
-				Creator:       clientCtx.GetFromAddress().String(),
-				PoolId:        argPoolId,
-				PoolAddress:   argPoolAddress,
-				Amount:        argAmount,
-				Commission:    argCommission,
-				StakeFraction: argStakeFraction,
+				Creator:                 clientCtx.GetFromAddress().String(),
+				PoolId:                  argPoolId,
+				PoolAddress:             argPoolAddress,
+				Amount:                  argAmount,
+				Commission:              argCommission,
+				StakeFraction:           argStakeFraction,
+				MaxCommission:           maxCommission,
+				MaxCommissionChangeRate: maxCommissionChangeRate,
 			}
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -60,6 +77,7 @@ func CmdJoinPool() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().AddFlagSet(flagSetJoinPool())
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
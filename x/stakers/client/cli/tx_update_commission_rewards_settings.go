@@ -0,0 +1,67 @@
+package cli
+
+import (
+	"strings"
+
+	"github.com/KYVENetwork/chain/x/stakers/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdUpdateCommissionRewardsSettings() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-commission-rewards-settings [accepted_denoms]",
+		Short: "Broadcast message update-commission-rewards-settings",
+		Long:  "Set the comma-separated denoms the validator accepts as commission rewards. All other denoms are forwarded to the community pool or to the pool given by --forward-pool-id.",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			acceptAll, err := cmd.Flags().GetBool(FlagAcceptAll)
+			if err != nil {
+				return err
+			}
+
+			var acceptedDenoms []string
+			if len(args) > 0 && args[0] != "" {
+				acceptedDenoms = strings.Split(args[0], ",")
+			}
+
+			msg := types.MsgUpdateCommissionRewardsSettings{
+				Creator:         clientCtx.GetFromAddress().String(),
+				AcceptAllDenoms: acceptAll,
+				AcceptedDenoms:  acceptedDenoms,
+			}
+
+			forwardPoolId, err := cmd.Flags().GetString(FlagForwardPoolId)
+			if err != nil {
+				return err
+			}
+
+			if forwardPoolId != "" {
+				msg.ForwardPoolId, err = cast.ToUint64E(forwardPoolId)
+				if err != nil {
+					return err
+				}
+				msg.ForwardDestination = types.COMMISSION_FORWARD_DESTINATION_POOL
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetUpdateCommissionRewardsSettings())
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
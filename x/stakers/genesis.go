@@ -1,6 +1,7 @@
 package stakers
 
 import (
+	"cosmossdk.io/math"
 	"github.com/KYVENetwork/chain/x/stakers/keeper"
 	"github.com/KYVENetwork/chain/x/stakers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -12,6 +13,14 @@ func InitGenesis(ctx sdk.Context, k *keeper.Keeper, genState types.GenesisState)
 	k.SetParams(ctx, genState.Params)
 
 	for _, entry := range genState.PoolAccountList {
+		// Pool accounts exported before the commission rules were introduced
+		// are not restricted.
+		if entry.MaxCommission.IsNil() {
+			entry.MaxCommission = math.LegacyOneDec()
+		}
+		if entry.MaxCommissionChangeRate.IsNil() {
+			entry.MaxCommissionChangeRate = math.LegacyOneDec()
+		}
 		k.SetPoolAccount(ctx, entry)
 		k.AddOneToCount(ctx, entry.PoolId)
 	}
@@ -28,9 +37,34 @@ func InitGenesis(ctx sdk.Context, k *keeper.Keeper, genState types.GenesisState)
 		k.SetStakeFractionChangeEntry(ctx, entry)
 	}
 
+	for _, entry := range genState.InactiveValidatorEntries {
+		k.SetInactiveValidatorEntry(ctx, entry)
+	}
+
+	for _, entry := range genState.ValidatorPoolRewards {
+		k.SetValidatorPoolRewards(ctx, entry)
+	}
+
+	for _, entry := range genState.DelegatorPoolRewards {
+		k.SetDelegatorPoolRewards(ctx, entry)
+	}
+
+	for _, entry := range genState.PoolRewardHistory {
+		k.SetPoolRewardHistoryEntry(ctx, entry)
+	}
+
+	for _, entry := range genState.PoolAccountStatsList {
+		k.SetPoolAccountStats(ctx, entry)
+	}
+
+	for _, entry := range genState.CommissionRewardsSettingsList {
+		k.SetCommissionRewardsSettings(ctx, entry)
+	}
+
 	k.SetQueueState(ctx, types.QUEUE_IDENTIFIER_COMMISSION, genState.QueueStateCommission)
 	k.SetQueueState(ctx, types.QUEUE_IDENTIFIER_LEAVE, genState.QueueStateLeave)
 	k.SetQueueState(ctx, types.QUEUE_IDENTIFIER_STAKE_FRACTION, genState.QueueStateStakeFraction)
+	k.SetQueueState(ctx, types.QUEUE_IDENTIFIER_INACTIVE, genState.QueueStateInactiveValidator)
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -52,5 +86,19 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
 
 	genesis.QueueStateStakeFraction = k.GetQueueState(ctx, types.QUEUE_IDENTIFIER_STAKE_FRACTION)
 
+	genesis.InactiveValidatorEntries = k.GetAllInactiveValidatorEntries(ctx)
+
+	genesis.QueueStateInactiveValidator = k.GetQueueState(ctx, types.QUEUE_IDENTIFIER_INACTIVE)
+
+	genesis.ValidatorPoolRewards = k.GetAllValidatorPoolRewards(ctx)
+
+	genesis.DelegatorPoolRewards = k.GetAllDelegatorPoolRewards(ctx)
+
+	genesis.PoolRewardHistory = k.GetAllPoolRewardHistoryEntries(ctx)
+
+	genesis.PoolAccountStatsList = k.GetAllPoolAccountStats(ctx)
+
+	genesis.CommissionRewardsSettingsList = k.GetAllCommissionRewardsSettings(ctx)
+
 	return genesis
 }
@@ -189,6 +189,17 @@ func (k Keeper) GetValidatorTotalPoolStake(ctx sdk.Context, staker string) (tota
 	return
 }
 
+// GetValidatorTotalStakeFraction returns the sum of all stake fractions the validator has in every pool
+func (k Keeper) GetValidatorTotalStakeFraction(ctx sdk.Context, staker string) math.LegacyDec {
+	totalStakeFraction := math.LegacyZeroDec()
+
+	for _, poolAccount := range k.GetPoolAccountsFromStaker(ctx, staker) {
+		totalStakeFraction = totalStakeFraction.Add(poolAccount.StakeFraction)
+	}
+
+	return totalStakeFraction
+}
+
 // GetValidatorPoolStakes returns a map for all pool validators with their effective stake. Effective stake
 // is the actual amount which determines the validator's voting power and is the actual amount at risk for
 // slashing. The effective stake can be lower (never higher) than the specified stake by the validators by
@@ -416,6 +427,10 @@ func (k Keeper) Slash(ctx sdk.Context, poolId uint64, staker string, slashType s
 		return
 	}
 
+	k.UpdatePoolAccountStats(ctx, staker, poolId, func(stats *stakertypes.PoolAccountStats) {
+		stats.Slashes += 1
+	})
+
 	_ = ctx.EventManager().EmitTypedEvent(&stakertypes.EventSlash{
 		PoolId:        poolId,
 		Staker:        staker,
@@ -430,7 +445,7 @@ func (k Keeper) Slash(ctx sdk.Context, poolId uint64, staker string, slashType s
 // Delegators can then receive these rewards if they call the `withdraw`-transaction.
 // If the staker has no delegators or the module to module transfer fails, the method fails and
 // returns an error.
-func (k Keeper) PayoutRewards(ctx sdk.Context, staker string, amount sdk.Coins, payerModuleName string) error {
+func (k Keeper) PayoutRewards(ctx sdk.Context, staker string, poolId uint64, amount sdk.Coins, payerModuleName string) error {
 	// Assert there is an amount
 	if amount.Empty() {
 		return nil
@@ -475,11 +490,16 @@ func (k Keeper) PayoutRewards(ctx sdk.Context, staker string, amount sdk.Coins,
 		return err
 	}
 
+	// Track the rewards of the pool separately, so delegators can see how much they earned in each pool
+	k.increasePoolRewardIndex(ctx, staker, poolId, validator.GetDelegatorShares(), amount)
+
 	return nil
 }
 
 // PayoutAdditionalCommissionRewards pays out some additional tokens to the validator.
-func (k Keeper) PayoutAdditionalCommissionRewards(ctx sdk.Context, validator string, payerModuleName string, amount sdk.Coins) error {
+// Denoms the validator does not accept as commission are forwarded according to the
+// commission rewards settings of the validator.
+func (k Keeper) PayoutAdditionalCommissionRewards(ctx sdk.Context, validator string, poolId uint64, payerModuleName string, amount sdk.Coins) error {
 	// Assert there is an amount
 	if amount.Empty() {
 		return nil
@@ -490,6 +510,16 @@ func (k Keeper) PayoutAdditionalCommissionRewards(ctx sdk.Context, validator str
 		return errors.Wrapf(sdkErrors.ErrNotFound, "staker does not exist")
 	}
 
+	// forward all denoms the validator does not accept as commission
+	amount, err := k.forwardUnacceptedCommissionRewards(ctx, validator, poolId, payerModuleName, amount)
+	if err != nil {
+		return err
+	}
+
+	if amount.Empty() {
+		return nil
+	}
+
 	// transfer funds from pool to distribution module
 	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, payerModuleName, distrtypes.ModuleName, amount); err != nil {
 		return err
@@ -530,6 +560,8 @@ func (k Keeper) PayoutAdditionalCommissionRewards(ctx sdk.Context, validator str
 		return err
 	}
 
+	k.increasePoolCommissionRewards(ctx, validator, poolId, amount)
+
 	return nil
 }
 
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	storeTypes "cosmossdk.io/store/types"
+	"github.com/KYVENetwork/chain/x/stakers/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetCommissionRewardsSettings ...
+func (k Keeper) SetCommissionRewardsSettings(ctx sdk.Context, settings types.CommissionRewardsSettings) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.CommissionRewardsSettingsKeyPrefix)
+	b := k.cdc.MustMarshal(&settings)
+	store.Set(types.CommissionRewardsSettingsKey(settings.Staker), b)
+}
+
+// GetCommissionRewardsSettings returns the commission rewards settings of a validator.
+// If the validator has no settings, all denoms are accepted.
+func (k Keeper) GetCommissionRewardsSettings(ctx sdk.Context, staker string) (val types.CommissionRewardsSettings, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.CommissionRewardsSettingsKeyPrefix)
+
+	b := store.Get(types.CommissionRewardsSettingsKey(staker))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveCommissionRewardsSettings ...
+func (k Keeper) RemoveCommissionRewardsSettings(ctx sdk.Context, staker string) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.CommissionRewardsSettingsKeyPrefix)
+	store.Delete(types.CommissionRewardsSettingsKey(staker))
+}
+
+// GetAllCommissionRewardsSettings ...
+func (k Keeper) GetAllCommissionRewardsSettings(ctx sdk.Context) (list []types.CommissionRewardsSettings) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.CommissionRewardsSettingsKeyPrefix)
+	iterator := storeTypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.CommissionRewardsSettings
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
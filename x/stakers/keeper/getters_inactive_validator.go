@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/store/prefix"
+	storeTypes "cosmossdk.io/store/types"
+	"github.com/KYVENetwork/chain/x/stakers/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// #####################
+// === QUEUE ENTRIES ===
+// #####################
+
+// SetInactiveValidatorEntry ...
+func (k Keeper) SetInactiveValidatorEntry(ctx sdk.Context, inactiveValidatorEntry types.InactiveValidatorEntry) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.InactiveValidatorEntryKeyPrefix)
+	b := k.cdc.MustMarshal(&inactiveValidatorEntry)
+	store.Set(types.InactiveValidatorEntryKey(inactiveValidatorEntry.Index), b)
+
+	// Insert the same entry with a different key prefix for query lookup
+	indexBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(indexBytes, inactiveValidatorEntry.Index)
+
+	indexStore := prefix.NewStore(storeAdapter, types.InactiveValidatorEntryKeyPrefixIndex2)
+	indexStore.Set(types.InactiveValidatorEntryKeyIndex2(inactiveValidatorEntry.Staker), indexBytes)
+}
+
+// GetInactiveValidatorEntry ...
+func (k Keeper) GetInactiveValidatorEntry(ctx sdk.Context, index uint64) (val types.InactiveValidatorEntry, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.InactiveValidatorEntryKeyPrefix)
+
+	b := store.Get(types.InactiveValidatorEntryKey(index))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetInactiveValidatorEntryByIndex2 returns the pending inactive validator entry of a staker (if there is one)
+func (k Keeper) GetInactiveValidatorEntryByIndex2(ctx sdk.Context, staker string) (val types.InactiveValidatorEntry, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.InactiveValidatorEntryKeyPrefixIndex2)
+
+	b := store.Get(types.InactiveValidatorEntryKeyIndex2(staker))
+	if b == nil {
+		return val, false
+	}
+
+	index := binary.BigEndian.Uint64(b)
+
+	return k.GetInactiveValidatorEntry(ctx, index)
+}
+
+// RemoveInactiveValidatorEntry ...
+func (k Keeper) RemoveInactiveValidatorEntry(ctx sdk.Context, inactiveValidatorEntry *types.InactiveValidatorEntry) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.InactiveValidatorEntryKeyPrefix)
+	store.Delete(types.InactiveValidatorEntryKey(inactiveValidatorEntry.Index))
+
+	indexStore := prefix.NewStore(storeAdapter, types.InactiveValidatorEntryKeyPrefixIndex2)
+	indexStore.Delete(types.InactiveValidatorEntryKeyIndex2(inactiveValidatorEntry.Staker))
+}
+
+// GetAllInactiveValidatorEntries returns all pending inactive validator entries of all stakers
+func (k Keeper) GetAllInactiveValidatorEntries(ctx sdk.Context) (list []types.InactiveValidatorEntry) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.InactiveValidatorEntryKeyPrefix)
+	iterator := storeTypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.InactiveValidatorEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
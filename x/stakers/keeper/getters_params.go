@@ -50,6 +50,23 @@ func (k Keeper) GetTimeoutSlash(ctx sdk.Context) (res math.LegacyDec) {
 	return k.GetParams(ctx).TimeoutSlash
 }
 
+// GetMaxStakeFractionSum returns the MaxStakeFractionSum param
+func (k Keeper) GetMaxStakeFractionSum(ctx sdk.Context) (res math.LegacyDec) {
+	return k.GetParams(ctx).MaxStakeFractionSum
+}
+
+// GetInactiveValidatorGracePeriod returns the InactiveValidatorGracePeriod param
+func (k Keeper) GetInactiveValidatorGracePeriod(ctx sdk.Context) (res uint64) {
+	return k.GetParams(ctx).InactiveValidatorGracePeriod
+}
+
+// GetMaxSlashFraction returns the highest slash fraction a validator
+// can receive for a single slash
+func (k Keeper) GetMaxSlashFraction(ctx sdk.Context) (res math.LegacyDec) {
+	params := k.GetParams(ctx)
+	return math.LegacyMaxDec(params.VoteSlash, math.LegacyMaxDec(params.UploadSlash, params.TimeoutSlash))
+}
+
 func (k Keeper) getSlashFraction(ctx sdk.Context, slashType types.SlashType) (slashAmountRatio math.LegacyDec) {
 	// Retrieve slash fraction from params
 	switch slashType {
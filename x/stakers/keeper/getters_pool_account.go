@@ -125,6 +125,8 @@ func (k Keeper) GetPoolAccount(ctx sdk.Context, stakerAddress string, poolId uin
 	if b == nil {
 		val.Commission = math.LegacyZeroDec()
 		val.StakeFraction = math.LegacyZeroDec()
+		val.MaxCommission = math.LegacyZeroDec()
+		val.MaxCommissionChangeRate = math.LegacyZeroDec()
 		return val, false
 	}
 
@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storeTypes "cosmossdk.io/store/types"
+	"github.com/KYVENetwork/chain/x/stakers/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// UpdatePoolAccountStats applies the given update to the performance stats
+// of a staker in the given pool.
+func (k Keeper) UpdatePoolAccountStats(ctx sdk.Context, staker string, poolId uint64, update func(stats *types.PoolAccountStats)) {
+	stats, _ := k.GetPoolAccountStats(ctx, staker, poolId)
+	update(&stats)
+	k.SetPoolAccountStats(ctx, stats)
+}
+
+// GetReliabilityScore returns the reliability score of a staker in the given pool.
+func (k Keeper) GetReliabilityScore(ctx sdk.Context, staker string, poolId uint64) math.LegacyDec {
+	stats, _ := k.GetPoolAccountStats(ctx, staker, poolId)
+	return stats.ReliabilityScore()
+}
+
+// SetPoolAccountStats ...
+func (k Keeper) SetPoolAccountStats(ctx sdk.Context, stats types.PoolAccountStats) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.PoolAccountStatsKeyPrefix)
+	b := k.cdc.MustMarshal(&stats)
+	store.Set(types.PoolAccountStatsKey(stats.Staker, stats.PoolId), b)
+}
+
+// GetPoolAccountStats returns the performance stats of a staker in a pool. If the staker
+// has no history in the pool an empty entry is returned.
+func (k Keeper) GetPoolAccountStats(ctx sdk.Context, staker string, poolId uint64) (val types.PoolAccountStats, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.PoolAccountStatsKeyPrefix)
+
+	b := store.Get(types.PoolAccountStatsKey(staker, poolId))
+	if b == nil {
+		val.Staker = staker
+		val.PoolId = poolId
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllPoolAccountStats ...
+func (k Keeper) GetAllPoolAccountStats(ctx sdk.Context) (list []types.PoolAccountStats) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.PoolAccountStatsKeyPrefix)
+	iterator := storeTypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PoolAccountStats
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
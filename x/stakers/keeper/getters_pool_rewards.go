@@ -0,0 +1,204 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	storeTypes "cosmossdk.io/store/types"
+	"github.com/KYVENetwork/chain/util"
+	"github.com/KYVENetwork/chain/x/stakers/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ##############################
+// === VALIDATOR POOL REWARDS ===
+// ##############################
+
+// SetValidatorPoolRewards ...
+func (k Keeper) SetValidatorPoolRewards(ctx sdk.Context, validatorPoolRewards types.ValidatorPoolRewards) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.ValidatorPoolRewardsKeyPrefix)
+	b := k.cdc.MustMarshal(&validatorPoolRewards)
+	store.Set(types.ValidatorPoolRewardsKey(validatorPoolRewards.Staker, validatorPoolRewards.PoolId), b)
+}
+
+// GetValidatorPoolRewards returns the cumulative rewards of a validator in a pool. If the validator
+// never received rewards in the pool an empty entry is returned.
+func (k Keeper) GetValidatorPoolRewards(ctx sdk.Context, staker string, poolId uint64) (val types.ValidatorPoolRewards, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.ValidatorPoolRewardsKeyPrefix)
+
+	b := store.Get(types.ValidatorPoolRewardsKey(staker, poolId))
+	if b == nil {
+		val.Staker = staker
+		val.PoolId = poolId
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetValidatorPoolRewardsOfStaker returns the cumulative rewards of a validator in every pool
+func (k Keeper) GetValidatorPoolRewardsOfStaker(ctx sdk.Context, staker string) (list []types.ValidatorPoolRewards) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.ValidatorPoolRewardsKeyPrefix)
+	iterator := storeTypes.KVStorePrefixIterator(store, util.GetByteKey(staker))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ValidatorPoolRewards
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAllValidatorPoolRewards ...
+func (k Keeper) GetAllValidatorPoolRewards(ctx sdk.Context) (list []types.ValidatorPoolRewards) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.ValidatorPoolRewardsKeyPrefix)
+	iterator := storeTypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ValidatorPoolRewards
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// ##############################
+// === DELEGATOR POOL REWARDS ===
+// ##############################
+
+// SetDelegatorPoolRewards ...
+func (k Keeper) SetDelegatorPoolRewards(ctx sdk.Context, delegatorPoolRewards types.DelegatorPoolRewards) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.DelegatorPoolRewardsKeyPrefix)
+	b := k.cdc.MustMarshal(&delegatorPoolRewards)
+	store.Set(types.DelegatorPoolRewardsKey(
+		delegatorPoolRewards.Staker,
+		delegatorPoolRewards.Delegator,
+		delegatorPoolRewards.PoolId,
+	), b)
+}
+
+// GetDelegatorPoolRewards returns the settled rewards of a delegator in a pool. If the rewards
+// were never settled an empty entry is returned.
+func (k Keeper) GetDelegatorPoolRewards(ctx sdk.Context, staker string, delegator string, poolId uint64) (val types.DelegatorPoolRewards, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.DelegatorPoolRewardsKeyPrefix)
+
+	b := store.Get(types.DelegatorPoolRewardsKey(staker, delegator, poolId))
+	if b == nil {
+		val.Staker = staker
+		val.Delegator = delegator
+		val.PoolId = poolId
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveDelegatorPoolRewards ...
+func (k Keeper) RemoveDelegatorPoolRewards(ctx sdk.Context, delegatorPoolRewards *types.DelegatorPoolRewards) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.DelegatorPoolRewardsKeyPrefix)
+	store.Delete(types.DelegatorPoolRewardsKey(
+		delegatorPoolRewards.Staker,
+		delegatorPoolRewards.Delegator,
+		delegatorPoolRewards.PoolId,
+	))
+}
+
+// GetAllDelegatorPoolRewards ...
+func (k Keeper) GetAllDelegatorPoolRewards(ctx sdk.Context) (list []types.DelegatorPoolRewards) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.DelegatorPoolRewardsKeyPrefix)
+	iterator := storeTypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.DelegatorPoolRewards
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// ###########################
+// === POOL REWARD HISTORY ===
+// ###########################
+
+// SetPoolRewardHistoryEntry ...
+func (k Keeper) SetPoolRewardHistoryEntry(ctx sdk.Context, entry types.PoolRewardHistoryEntry) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.PoolRewardHistoryKeyPrefix)
+	b := k.cdc.MustMarshal(&entry)
+	store.Set(types.PoolRewardHistoryKey(entry.PoolId, entry.Epoch), b)
+}
+
+// GetPoolRewardHistoryEntry ...
+func (k Keeper) GetPoolRewardHistoryEntry(ctx sdk.Context, poolId uint64, epoch uint64) (val types.PoolRewardHistoryEntry, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.PoolRewardHistoryKeyPrefix)
+
+	b := store.Get(types.PoolRewardHistoryKey(poolId, epoch))
+	if b == nil {
+		val.PoolId = poolId
+		val.Epoch = epoch
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemovePoolRewardHistoryEntry ...
+func (k Keeper) RemovePoolRewardHistoryEntry(ctx sdk.Context, entry *types.PoolRewardHistoryEntry) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.PoolRewardHistoryKeyPrefix)
+	store.Delete(types.PoolRewardHistoryKey(entry.PoolId, entry.Epoch))
+}
+
+// GetPoolRewardHistory returns the reward history of a pool ordered from the oldest to the newest epoch
+func (k Keeper) GetPoolRewardHistory(ctx sdk.Context, poolId uint64) (list []types.PoolRewardHistoryEntry) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.PoolRewardHistoryKeyPrefix)
+	iterator := storeTypes.KVStorePrefixIterator(store, util.GetByteKey(poolId))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PoolRewardHistoryEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAllPoolRewardHistoryEntries ...
+func (k Keeper) GetAllPoolRewardHistoryEntries(ctx sdk.Context) (list []types.PoolRewardHistoryEntry) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.PoolRewardHistoryKeyPrefix)
+	iterator := storeTypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PoolRewardHistoryEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -22,15 +22,17 @@ import (
 
 // AddPoolAccountToPool adds a pool account to a pool.
 // If pool account already active in the to pool nothing happens.
-func (k Keeper) AddPoolAccountToPool(ctx sdk.Context, stakerAddress string, poolId uint64, poolAddress string, commission, stakeFraction math.LegacyDec) {
+func (k Keeper) AddPoolAccountToPool(ctx sdk.Context, stakerAddress string, poolId uint64, poolAddress string, commission, stakeFraction, maxCommission, maxCommissionChangeRate math.LegacyDec) {
 	if _, validatorExists := k.GetValidator(ctx, stakerAddress); validatorExists {
 		if _, active := k.GetPoolAccount(ctx, stakerAddress, poolId); !active {
 			k.SetPoolAccount(ctx, types.PoolAccount{
-				PoolId:        poolId,
-				Staker:        stakerAddress,
-				PoolAddress:   poolAddress,
-				Commission:    commission,
-				StakeFraction: stakeFraction,
+				PoolId:                  poolId,
+				Staker:                  stakerAddress,
+				PoolAddress:             poolAddress,
+				Commission:              commission,
+				StakeFraction:           stakeFraction,
+				MaxCommission:           maxCommission,
+				MaxCommissionChangeRate: maxCommissionChangeRate,
 			})
 			k.AddOneToCount(ctx, poolId)
 		}
@@ -80,27 +80,41 @@ func (k Keeper) AfterValidatorRemoved(ctx context.Context, consAddr sdk.ConsAddr
 	return nil
 }
 
-func (k Keeper) AfterValidatorBonded(ctx context.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+func (k Keeper) AfterValidatorBonded(goCtx context.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// the validator has returned to the active set within the grace period
+	k.removeInactiveValidator(ctx, util.MustAccountAddressFromValAddress(valAddr.String()))
 	return nil
 }
 
 func (k Keeper) AfterValidatorBeginUnbonding(goCtx context.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	for _, v := range k.GetPoolAccountsFromStaker(ctx, util.MustAccountAddressFromValAddress(valAddr.String())) {
-		k.LeavePool(ctx, v.Staker, v.PoolId)
+	staker := util.MustAccountAddressFromValAddress(valAddr.String())
+	// the validator has left the active set, if it does not return within the
+	// grace period it gets removed from all pools
+	if len(k.GetPoolAccountsFromStaker(ctx, staker)) > 0 {
+		k.orderInactiveValidator(ctx, staker)
 	}
 	return nil
 }
 
-func (k Keeper) BeforeDelegationCreated(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+func (k Keeper) BeforeDelegationCreated(goCtx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// start tracking the pool rewards of the new delegation from the current reward index
+	k.settleDelegatorPoolRewards(ctx, util.MustAccountAddressFromValAddress(valAddr.String()), delAddr.String())
 	return nil
 }
 
-func (k Keeper) BeforeDelegationSharesModified(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+func (k Keeper) BeforeDelegationSharesModified(goCtx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// settle the pool rewards which were accrued with the previous shares
+	k.settleDelegatorPoolRewards(ctx, util.MustAccountAddressFromValAddress(valAddr.String()), delAddr.String())
 	return nil
 }
 
-func (k Keeper) BeforeDelegationRemoved(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+func (k Keeper) BeforeDelegationRemoved(goCtx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.removeDelegatorPoolRewards(ctx, util.MustAccountAddressFromValAddress(valAddr.String()), delAddr.String())
 	return nil
 }
 
@@ -0,0 +1,209 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	"github.com/KYVENetwork/chain/util"
+	globalTypes "github.com/KYVENetwork/chain/x/global/types"
+	querytypes "github.com/KYVENetwork/chain/x/query/types"
+	stakerstypes "github.com/KYVENetwork/chain/x/stakers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	mintTypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+)
+
+/*
+
+TEST CASES - keeper_suite_pool_rewards_test.go
+
+* Payout rewards in a single pool
+* Payout rewards in multiple pools
+* Delegate after rewards were paid out
+* Undelegate after rewards were paid out
+* Query pool reward history
+
+*/
+
+var _ = Describe("keeper_suite_pool_rewards_test.go", Ordered, func() {
+	s := i.NewCleanChain()
+
+	gov := s.App().GovKeeper.GetGovernanceAccount(s.Ctx()).GetAddress().String()
+
+	payoutRewards := func(staker string, poolId uint64, amount uint64) {
+		coins := sdk.NewCoins(sdk.NewInt64Coin(globalTypes.Denom, int64(amount)))
+		Expect(s.App().BankKeeper.MintCoins(s.Ctx(), mintTypes.ModuleName, coins)).To(Succeed())
+		Expect(s.App().StakersKeeper.PayoutRewards(s.Ctx(), staker, poolId, coins, mintTypes.ModuleName)).To(Succeed())
+	}
+
+	queryPoolRewards := func(address string) []querytypes.AccountPoolRewards {
+		res, err := s.App().QueryKeeper.AccountPoolRewards(s.Ctx(), &querytypes.QueryAccountPoolRewardsRequest{
+			Address: address,
+		})
+		Expect(err).To(BeNil())
+		return res.Pools
+	}
+
+	BeforeEach(func() {
+		// init new clean chain
+		s = i.NewCleanChain()
+
+		// create pools
+		msg := &pooltypes.MsgCreatePool{
+			Authority:            gov,
+			UploadInterval:       60,
+			MaxBundleSize:        100,
+			InflationShareWeight: math.LegacyZeroDec(),
+			Binaries:             "{}",
+		}
+		s.RunTxPoolSuccess(msg)
+		s.RunTxPoolSuccess(msg)
+
+		s.SetMaxVotingPower("1")
+
+		s.CreateValidator(i.STAKER_0, "Staker-0", int64(100*i.KYVE))
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgJoinPool{
+			Creator:       i.STAKER_0,
+			PoolId:        0,
+			PoolAddress:   i.POOL_ADDRESS_0_A,
+			Commission:    math.LegacyMustNewDecFromStr("0.1"),
+			StakeFraction: math.LegacyMustNewDecFromStr("1"),
+		})
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgJoinPool{
+			Creator:       i.STAKER_0,
+			PoolId:        1,
+			PoolAddress:   i.POOL_ADDRESS_0_B,
+			Commission:    math.LegacyMustNewDecFromStr("0.1"),
+			StakeFraction: math.LegacyMustNewDecFromStr("1"),
+		})
+
+		s.RunTxSuccess(stakingTypes.NewMsgDelegate(
+			i.ALICE,
+			util.MustValaddressFromOperatorAddress(i.STAKER_0),
+			sdk.NewInt64Coin(globalTypes.Denom, int64(100*i.KYVE)),
+		))
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Payout rewards in a single pool", func() {
+		// ACT
+		payoutRewards(i.STAKER_0, 0, 100*i.KYVE)
+
+		// ASSERT
+		validatorPoolRewards, found := s.App().StakersKeeper.GetValidatorPoolRewards(s.Ctx(), i.STAKER_0, 0)
+		Expect(found).To(BeTrue())
+		Expect(validatorPoolRewards.DelegationRewards.String()).To(Equal(i.KYVECoins(100 * i.T_KYVE).String()))
+
+		_, found = s.App().StakersKeeper.GetValidatorPoolRewards(s.Ctx(), i.STAKER_0, 1)
+		Expect(found).To(BeFalse())
+
+		aliceRewards := queryPoolRewards(i.ALICE)
+		Expect(aliceRewards).To(HaveLen(1))
+		Expect(aliceRewards[0].PoolId).To(Equal(uint64(0)))
+		Expect(aliceRewards[0].Rewards.String()).To(Equal(i.KYVECoins(50 * i.T_KYVE).String()))
+		Expect(aliceRewards[0].Validators).To(HaveLen(1))
+		Expect(aliceRewards[0].Validators[0].Staker).To(Equal(i.STAKER_0))
+
+		stakerRewards := queryPoolRewards(i.STAKER_0)
+		Expect(stakerRewards).To(HaveLen(1))
+		Expect(stakerRewards[0].Rewards.String()).To(Equal(i.KYVECoins(50 * i.T_KYVE).String()))
+	})
+
+	It("Payout rewards in multiple pools", func() {
+		// ACT
+		payoutRewards(i.STAKER_0, 0, 100*i.KYVE)
+		payoutRewards(i.STAKER_0, 1, 300*i.KYVE)
+		payoutRewards(i.STAKER_0, 0, 100*i.KYVE)
+
+		// ASSERT
+		aliceRewards := queryPoolRewards(i.ALICE)
+		Expect(aliceRewards).To(HaveLen(2))
+		Expect(aliceRewards[0].PoolId).To(Equal(uint64(0)))
+		Expect(aliceRewards[0].Rewards.String()).To(Equal(i.KYVECoins(100 * i.T_KYVE).String()))
+		Expect(aliceRewards[1].PoolId).To(Equal(uint64(1)))
+		Expect(aliceRewards[1].Rewards.String()).To(Equal(i.KYVECoins(150 * i.T_KYVE).String()))
+	})
+
+	It("Delegate after rewards were paid out", func() {
+		// ARRANGE
+		payoutRewards(i.STAKER_0, 0, 100*i.KYVE)
+
+		// ACT
+		s.RunTxSuccess(stakingTypes.NewMsgDelegate(
+			i.ALICE,
+			util.MustValaddressFromOperatorAddress(i.STAKER_0),
+			sdk.NewInt64Coin(globalTypes.Denom, int64(100*i.KYVE)),
+		))
+
+		s.RunTxSuccess(stakingTypes.NewMsgDelegate(
+			i.BOB,
+			util.MustValaddressFromOperatorAddress(i.STAKER_0),
+			sdk.NewInt64Coin(globalTypes.Denom, int64(100*i.KYVE)),
+		))
+
+		payoutRewards(i.STAKER_0, 0, 400*i.KYVE)
+
+		// ASSERT
+		// alice: 50 from the first payout and 200 from the second payout
+		aliceRewards := queryPoolRewards(i.ALICE)
+		Expect(aliceRewards).To(HaveLen(1))
+		Expect(aliceRewards[0].Rewards.String()).To(Equal(i.KYVECoins(250 * i.T_KYVE).String()))
+
+		// bob only receives rewards from the second payout
+		bobRewards := queryPoolRewards(i.BOB)
+		Expect(bobRewards).To(HaveLen(1))
+		Expect(bobRewards[0].Rewards.String()).To(Equal(i.KYVECoins(100 * i.T_KYVE).String()))
+	})
+
+	It("Undelegate after rewards were paid out", func() {
+		// ARRANGE
+		payoutRewards(i.STAKER_0, 0, 100*i.KYVE)
+
+		// ACT
+		s.RunTxSuccess(stakingTypes.NewMsgUndelegate(
+			i.ALICE,
+			util.MustValaddressFromOperatorAddress(i.STAKER_0),
+			sdk.NewInt64Coin(globalTypes.Denom, int64(100*i.KYVE)),
+		))
+
+		// ASSERT
+		Expect(queryPoolRewards(i.ALICE)).To(BeEmpty())
+
+		_, found := s.App().StakersKeeper.GetDelegatorPoolRewards(s.Ctx(), i.STAKER_0, i.ALICE, 0)
+		Expect(found).To(BeFalse())
+	})
+
+	It("Query pool reward history", func() {
+		// ACT
+		payoutRewards(i.STAKER_0, 0, 100*i.KYVE)
+		payoutRewards(i.STAKER_0, 0, 100*i.KYVE)
+
+		s.CommitAfterSeconds(stakerstypes.RewardHistoryEpochDuration)
+
+		payoutRewards(i.STAKER_0, 0, 50*i.KYVE)
+
+		// ASSERT
+		res, err := s.App().QueryKeeper.PoolRewardHistory(s.Ctx(), &querytypes.QueryPoolRewardHistoryRequest{Id: 0})
+		Expect(err).To(BeNil())
+		Expect(res.Epochs).To(HaveLen(2))
+
+		Expect(res.Epochs[0].DelegationRewards.String()).To(Equal(i.KYVECoins(200 * i.T_KYVE).String()))
+		Expect(res.Epochs[0].PoolStake).To(Equal(200 * i.KYVE))
+		Expect(res.Epochs[0].EndDate - res.Epochs[0].StartDate).To(Equal(int64(stakerstypes.RewardHistoryEpochDuration)))
+		Expect(res.Epochs[0].Apr).To(Equal(math.LegacyNewDec(365)))
+
+		Expect(res.Epochs[1].DelegationRewards.String()).To(Equal(i.KYVECoins(50 * i.T_KYVE).String()))
+
+		_, err = s.App().QueryKeeper.PoolRewardHistory(s.Ctx(), &querytypes.QueryPoolRewardHistoryRequest{Id: 2})
+		Expect(err).To(HaveOccurred())
+	})
+})
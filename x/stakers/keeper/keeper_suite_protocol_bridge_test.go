@@ -20,6 +20,8 @@ TEST CASES - msg_server_join_pool.go
 * Consensus slash leads to removal from pool
 * Consensus slash leads to removal from all pool
 * Getting pushed out of the active set leads to removal from pool
+* Returning to the active set within the grace period keeps validator in pool
+* Already leaving validator is not ordered to leave again
 * Unbonded validator can not join a pool
 
 */
@@ -80,12 +82,34 @@ var _ = Describe("keeper_suite_protocol_bridge.go", Ordered, func() {
 		s.CommitAfterSeconds(1)
 
 		// Assert
-		poolMembersCount := s.App().StakersKeeper.GetStakerCountOfPool(s.Ctx(), 0)
-		Expect(poolMembersCount).To(Equal(uint64(0)))
-
 		postBonded, _ := s.App().StakingKeeper.GetBondedValidatorsByPower(s.Ctx())
 		Expect(postBonded).To(HaveLen(1))
 
+		// validator stays in the pool during the grace period
+		poolMembersCount := s.App().StakersKeeper.GetStakerCountOfPool(s.Ctx(), 0)
+		Expect(poolMembersCount).To(Equal(uint64(1)))
+
+		_, found := s.App().StakersKeeper.GetInactiveValidatorEntryByIndex2(s.Ctx(), validator1.Address)
+		Expect(found).To(BeTrue())
+
+		// wait for grace period
+		s.CommitAfterSeconds(s.App().StakersKeeper.GetInactiveValidatorGracePeriod(s.Ctx()))
+		s.CommitAfterSeconds(1)
+
+		_, found = s.App().StakersKeeper.GetInactiveValidatorEntryByIndex2(s.Ctx(), validator1.Address)
+		Expect(found).To(BeFalse())
+
+		poolAccount, _ := s.App().StakersKeeper.GetPoolAccount(s.Ctx(), validator1.Address, 0)
+		Expect(poolAccount.IsLeaving).To(BeTrue())
+		Expect(s.App().StakersKeeper.DoesLeavePoolEntryExistByIndex2(s.Ctx(), validator1.Address, 0)).To(BeTrue())
+
+		// wait for leave pool time
+		s.CommitAfterSeconds(s.App().StakersKeeper.GetLeavePoolTime(s.Ctx()))
+		s.CommitAfterSeconds(1)
+
+		poolMembersCount = s.App().StakersKeeper.GetStakerCountOfPool(s.Ctx(), 0)
+		Expect(poolMembersCount).To(Equal(uint64(0)))
+
 		poolAccounts := s.App().StakersKeeper.GetPoolAccountsFromStaker(s.Ctx(), validator1.Address)
 		Expect(poolAccounts).To(HaveLen(0))
 	})
@@ -129,6 +153,12 @@ var _ = Describe("keeper_suite_protocol_bridge.go", Ordered, func() {
 		s.CommitAfterSeconds(1)
 		s.CommitAfterSeconds(1)
 
+		// wait for grace period and leave pool time
+		s.CommitAfterSeconds(s.App().StakersKeeper.GetInactiveValidatorGracePeriod(s.Ctx()))
+		s.CommitAfterSeconds(1)
+		s.CommitAfterSeconds(s.App().StakersKeeper.GetLeavePoolTime(s.Ctx()))
+		s.CommitAfterSeconds(1)
+
 		// Assert
 		for k := 1; k < 10; k++ {
 			poolMembersCount := s.App().StakersKeeper.GetStakerCountOfPool(s.Ctx(), uint64(k))
@@ -170,6 +200,12 @@ var _ = Describe("keeper_suite_protocol_bridge.go", Ordered, func() {
 		s.CommitAfterSeconds(1)
 		s.CommitAfterSeconds(1)
 
+		// wait for grace period and leave pool time
+		s.CommitAfterSeconds(s.App().StakersKeeper.GetInactiveValidatorGracePeriod(s.Ctx()))
+		s.CommitAfterSeconds(1)
+		s.CommitAfterSeconds(s.App().StakersKeeper.GetLeavePoolTime(s.Ctx()))
+		s.CommitAfterSeconds(1)
+
 		// Assert
 		poolMembersCount := s.App().StakersKeeper.GetStakerCountOfPool(s.Ctx(), 0)
 		Expect(poolMembersCount).To(Equal(uint64(0)))
@@ -181,6 +217,92 @@ var _ = Describe("keeper_suite_protocol_bridge.go", Ordered, func() {
 		Expect(poolAccounts).To(HaveLen(0))
 	})
 
+	It("Returning to the active set within the grace period keeps validator in pool", func() {
+		// Arrange
+
+		// There are 51 validator slots (1 is occupied by the default validator (1 $KYVE) and another one by validator1)
+		for k := 0; k < 50; k++ {
+			s.CreateNewValidator(fmt.Sprintf("val-%d", k), 10000*i.KYVE)
+		}
+		s.CommitAfterSeconds(1)
+		s.CommitAfterSeconds(1)
+
+		s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+			Creator:       validator1.Address,
+			PoolId:        0,
+			PoolAddress:   validator1.PoolAccount[0],
+			Commission:    math.LegacyMustNewDecFromStr("0.1"),
+			StakeFraction: math.LegacyMustNewDecFromStr("1"),
+		})
+
+		// Create one more validator to kick out validator1
+		s.CreateNewValidator(fmt.Sprintf("val-%d", 51), 10000*i.KYVE)
+		s.CommitAfterSeconds(1)
+		s.CommitAfterSeconds(1)
+
+		_, found := s.App().StakersKeeper.GetInactiveValidatorEntryByIndex2(s.Ctx(), validator1.Address)
+		Expect(found).To(BeTrue())
+
+		// Act
+
+		// validator1 returns to the active set by increasing his stake
+		_ = s.MintBaseCoins(validator1.Address, 20000*i.KYVE)
+		s.SelfDelegateValidator(validator1.Address, 20000*i.KYVE)
+		s.CommitAfterSeconds(1)
+		s.CommitAfterSeconds(1)
+
+		s.CommitAfterSeconds(s.App().StakersKeeper.GetInactiveValidatorGracePeriod(s.Ctx()))
+		s.CommitAfterSeconds(1)
+
+		// Assert
+		_, found = s.App().StakersKeeper.GetInactiveValidatorEntryByIndex2(s.Ctx(), validator1.Address)
+		Expect(found).To(BeFalse())
+
+		poolAccount, active := s.App().StakersKeeper.GetPoolAccount(s.Ctx(), validator1.Address, 0)
+		Expect(active).To(BeTrue())
+		Expect(poolAccount.IsLeaving).To(BeFalse())
+
+		poolMembersCount := s.App().StakersKeeper.GetStakerCountOfPool(s.Ctx(), 0)
+		Expect(poolMembersCount).To(Equal(uint64(1)))
+	})
+
+	It("Already leaving validator is not ordered to leave again", func() {
+		// Arrange
+		params, _ := s.App().SlashingKeeper.GetParams(s.Ctx())
+		params.MinSignedPerWindow = math.LegacyMustNewDecFromStr("1")
+		params.SignedBlocksWindow = 1
+		_ = s.App().SlashingKeeper.SetParams(s.Ctx(), params)
+
+		s.RunTxStakersSuccess(&stakertypes.MsgJoinPool{
+			Creator:       validator1.Address,
+			PoolId:        0,
+			PoolAddress:   validator1.PoolAccount[0],
+			Commission:    math.LegacyMustNewDecFromStr("0.1"),
+			StakeFraction: math.LegacyMustNewDecFromStr("1"),
+		})
+
+		s.RunTxStakersSuccess(&stakertypes.MsgLeavePool{
+			Creator: validator1.Address,
+			PoolId:  0,
+		})
+
+		leavePoolEntries := s.App().StakersKeeper.GetAllLeavePoolEntries(s.Ctx())
+		Expect(leavePoolEntries).To(HaveLen(1))
+
+		// Make validator not participate in block votes to have him kicked out
+		s.AddAbciAbsentVote(validator1.ConsAccAddress)
+
+		// Act
+		s.CommitAfterSeconds(1)
+		s.CommitAfterSeconds(1)
+
+		s.CommitAfterSeconds(s.App().StakersKeeper.GetInactiveValidatorGracePeriod(s.Ctx()))
+		s.CommitAfterSeconds(1)
+
+		// Assert
+		Expect(s.App().StakersKeeper.GetAllLeavePoolEntries(s.Ctx())).To(Equal(leavePoolEntries))
+	})
+
 	It("Unbonded validator can not join a pool", func() {
 		// Arrange
 
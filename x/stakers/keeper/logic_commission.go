@@ -1,16 +1,84 @@
 package keeper
 
 import (
+	"cosmossdk.io/errors"
 	"cosmossdk.io/math"
+	poolTypes "github.com/KYVENetwork/chain/x/pool/types"
 	"github.com/KYVENetwork/chain/x/stakers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
+// validateCommissionRules checks that the commission rules a validator chooses
+// when joining a pool are within the limits set by the governance for that pool.
+func (k Keeper) validateCommissionRules(pool poolTypes.Pool, commission, maxCommission, maxCommissionChangeRate math.LegacyDec) error {
+	if poolMaxCommission := pool.GetMaxCommissionLimit(); maxCommission.GT(poolMaxCommission) {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, types.ErrMaxCommissionExceedsPoolLimit.Error(), maxCommission, poolMaxCommission)
+	}
+
+	if poolMaxChangeRate := pool.GetMaxCommissionChangeRateLimit(); maxCommissionChangeRate.GT(poolMaxChangeRate) {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, types.ErrMaxChangeRateExceedsPoolLimit.Error(), maxCommissionChangeRate, poolMaxChangeRate)
+	}
+
+	if maxCommissionChangeRate.GT(maxCommission) {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, types.ErrMaxChangeRateExceedsMaxCommission.Error(), maxCommissionChangeRate, maxCommission)
+	}
+
+	if commission.GT(maxCommission) {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, types.ErrCommissionExceedsMax.Error(), commission, maxCommission)
+	}
+
+	return nil
+}
+
+// validateCommissionChange checks if the pool account is allowed to change
+// its commission to the given value. The new commission can not exceed
+// the max commission and the difference to the current commission can not
+// be greater than the max commission change rate. Both rules are bounded
+// by the pool limits. Additionally, only one commission change can be
+// applied per day.
+func (k Keeper) validateCommissionChange(ctx sdk.Context, poolAccount types.PoolAccount, commission math.LegacyDec) error {
+	pool, err := k.poolKeeper.GetPoolWithError(ctx, poolAccount.PoolId)
+	if err != nil {
+		return err
+	}
+
+	maxCommission := pool.GetMaxCommissionLimit()
+	if !poolAccount.MaxCommission.IsNil() {
+		maxCommission = math.LegacyMinDec(poolAccount.MaxCommission, maxCommission)
+	}
+	if commission.GT(maxCommission) {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, types.ErrCommissionExceedsMax.Error(), commission, maxCommission)
+	}
+
+	maxCommissionChangeRate := pool.GetMaxCommissionChangeRateLimit()
+	if !poolAccount.MaxCommissionChangeRate.IsNil() {
+		maxCommissionChangeRate = math.LegacyMinDec(poolAccount.MaxCommissionChangeRate, maxCommissionChangeRate)
+	}
+	if change := commission.Sub(poolAccount.Commission).Abs(); change.GT(maxCommissionChangeRate) {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, types.ErrCommissionChangeRateExceeded.Error(), change, maxCommissionChangeRate)
+	}
+
+	if poolAccount.CommissionLastChanged+types.CommissionChangeRatePeriod > ctx.BlockTime().Unix() {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, types.ErrCommissionChangeTooFrequent.Error())
+	}
+
+	return nil
+}
+
 // orderNewCommissionChange inserts a new change entry into the queue.
 // The queue is checked in every endBlock and when the commissionChangeTime
 // is over the new commission will be applied to the user.
 // If another entry is currently in the queue it will be removed.
-func (k Keeper) orderNewCommissionChange(ctx sdk.Context, staker string, poolId uint64, commission math.LegacyDec) {
+// An error is returned if the commission change violates the commission
+// rules of the pool account.
+func (k Keeper) orderNewCommissionChange(ctx sdk.Context, staker string, poolId uint64, commission math.LegacyDec) error {
+	poolAccount, _ := k.GetPoolAccount(ctx, staker, poolId)
+	if err := k.validateCommissionChange(ctx, poolAccount, commission); err != nil {
+		return err
+	}
+
 	// Remove existing queue entry
 	queueEntry, found := k.GetCommissionChangeEntryByIndex2(ctx, staker, poolId)
 	if found {
@@ -28,6 +96,8 @@ func (k Keeper) orderNewCommissionChange(ctx sdk.Context, staker string, poolId
 	}
 
 	k.SetCommissionChangeEntry(ctx, commissionChangeEntry)
+
+	return nil
 }
 
 // ProcessCommissionChangeQueue checks the queue for entries which are due
@@ -52,6 +122,7 @@ func (k Keeper) ProcessCommissionChangeQueue(ctx sdk.Context) {
 			}
 
 			poolAccount.Commission = queueEntry.Commission
+			poolAccount.CommissionLastChanged = ctx.BlockTime().Unix()
 			k.SetPoolAccount(ctx, poolAccount)
 
 			_ = ctx.EventManager().EmitTypedEvent(&types.EventUpdateCommission{
@@ -68,3 +139,56 @@ func (k Keeper) ProcessCommissionChangeQueue(ctx sdk.Context) {
 		return false
 	})
 }
+
+// forwardUnacceptedCommissionRewards forwards all commission rewards of denoms the validator
+// does not accept to the forward destination of the validator and returns the accepted rewards.
+// If the forward pool does not exist anymore or is disabled, the rewards go to the community pool.
+func (k Keeper) forwardUnacceptedCommissionRewards(ctx sdk.Context, staker string, poolId uint64, payerModuleName string, amount sdk.Coins) (sdk.Coins, error) {
+	settings, found := k.GetCommissionRewardsSettings(ctx, staker)
+	if !found {
+		return amount, nil
+	}
+
+	accepted := sdk.NewCoins()
+	for _, coin := range amount {
+		if settings.AcceptsDenom(coin.Denom) {
+			accepted = accepted.Add(coin)
+		}
+	}
+
+	unaccepted := amount.Sub(accepted...)
+	if unaccepted.Empty() {
+		return accepted, nil
+	}
+
+	destination := settings.ForwardDestination
+	forwardPoolId := uint64(0)
+
+	if destination == types.COMMISSION_FORWARD_DESTINATION_POOL {
+		pool, err := k.poolKeeper.GetPoolWithError(ctx, settings.ForwardPoolId)
+		if err == nil && !pool.Disabled {
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, payerModuleName, pool.GetPoolAccount(), unaccepted); err != nil {
+				return nil, err
+			}
+			forwardPoolId = pool.Id
+		} else {
+			destination = types.COMMISSION_FORWARD_DESTINATION_COMMUNITY_POOL
+		}
+	}
+
+	if destination == types.COMMISSION_FORWARD_DESTINATION_COMMUNITY_POOL {
+		if err := k.distKeeper.FundCommunityPool(ctx, unaccepted, authTypes.NewModuleAddress(payerModuleName)); err != nil {
+			return nil, err
+		}
+	}
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventForwardCommissionRewards{
+		Staker:             staker,
+		PoolId:             poolId,
+		Amounts:            unaccepted.String(),
+		ForwardDestination: destination,
+		ForwardPoolId:      forwardPoolId,
+	})
+
+	return accepted, nil
+}
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"github.com/KYVENetwork/chain/x/stakers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// orderInactiveValidator inserts a new entry into the inactive validator queue
+// once a validator has left the active set. If the validator does not return
+// to the active set within the grace period it will get removed from all pools.
+// If the validator is already in the queue nothing happens.
+func (k Keeper) orderInactiveValidator(ctx sdk.Context, staker string) {
+	if _, found := k.GetInactiveValidatorEntryByIndex2(ctx, staker); found {
+		return
+	}
+
+	queueIndex := k.getNextQueueSlot(ctx, types.QUEUE_IDENTIFIER_INACTIVE)
+
+	inactiveValidatorEntry := types.InactiveValidatorEntry{
+		Index:        queueIndex,
+		Staker:       staker,
+		CreationDate: ctx.BlockTime().Unix(),
+	}
+
+	k.SetInactiveValidatorEntry(ctx, inactiveValidatorEntry)
+}
+
+// removeInactiveValidator removes the inactive validator entry of a staker
+// which has returned to the active set within the grace period.
+func (k Keeper) removeInactiveValidator(ctx sdk.Context, staker string) {
+	if queueEntry, found := k.GetInactiveValidatorEntryByIndex2(ctx, staker); found {
+		k.RemoveInactiveValidatorEntry(ctx, &queueEntry)
+	}
+}
+
+// ProcessInactiveValidatorQueue checks the queue for validators whose grace
+// period is over. If such a validator is still not in the active set a pool
+// leave is ordered for every pool the validator is currently participating in.
+func (k Keeper) ProcessInactiveValidatorQueue(ctx sdk.Context) {
+	k.processQueue(ctx, types.QUEUE_IDENTIFIER_INACTIVE, func(index uint64) bool {
+		// Get queue entry in question
+		queueEntry, found := k.GetInactiveValidatorEntry(ctx, index)
+		if !found {
+			// continue with the next entry
+			return true
+		}
+
+		if queueEntry.CreationDate+int64(k.GetInactiveValidatorGracePeriod(ctx)) <= ctx.BlockTime().Unix() {
+			k.RemoveInactiveValidatorEntry(ctx, &queueEntry)
+
+			// the validator has returned to the active set in the meantime
+			if validator, found := k.GetValidator(ctx, queueEntry.Staker); found && validator.IsBonded() {
+				return true
+			}
+
+			for _, poolAccount := range k.GetPoolAccountsFromStaker(ctx, queueEntry.Staker) {
+				if poolAccount.IsLeaving {
+					continue
+				}
+
+				if err := k.orderLeavePool(ctx, poolAccount.Staker, poolAccount.PoolId); err != nil {
+					continue
+				}
+
+				poolAccount.IsLeaving = true
+				k.SetPoolAccount(ctx, *poolAccount)
+
+				_ = ctx.EventManager().EmitTypedEvent(&types.EventAutoLeavePool{
+					PoolId:        poolAccount.PoolId,
+					Staker:        poolAccount.Staker,
+					InactiveSince: queueEntry.CreationDate,
+				})
+			}
+
+			// Continue with next entry
+			return true
+		}
+
+		// Stop queue processing
+		return false
+	})
+}
@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	"github.com/KYVENetwork/chain/util"
+	globalTypes "github.com/KYVENetwork/chain/x/global/types"
+	"github.com/KYVENetwork/chain/x/stakers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// increasePoolRewardIndex adds the given delegation rewards to the reward index of the
+// validator in the given pool. The reward index tracks the cumulative rewards per
+// delegator share, which allows to determine the rewards of every single delegator
+// without iterating over all delegators of the validator.
+func (k Keeper) increasePoolRewardIndex(ctx sdk.Context, staker string, poolId uint64, delegatorShares math.LegacyDec, amount sdk.Coins) {
+	validatorPoolRewards, _ := k.GetValidatorPoolRewards(ctx, staker, poolId)
+
+	if delegatorShares.IsPositive() {
+		validatorPoolRewards.RewardIndex = validatorPoolRewards.RewardIndex.Add(
+			sdk.NewDecCoinsFromCoins(amount...).QuoDecTruncate(delegatorShares)...,
+		)
+	}
+	validatorPoolRewards.DelegationRewards = validatorPoolRewards.DelegationRewards.Add(amount...)
+
+	k.SetValidatorPoolRewards(ctx, validatorPoolRewards)
+
+	k.addPoolRewardHistory(ctx, poolId, amount)
+}
+
+// increasePoolCommissionRewards adds the given commission rewards to the cumulative
+// commission rewards of the validator in the given pool.
+func (k Keeper) increasePoolCommissionRewards(ctx sdk.Context, staker string, poolId uint64, amount sdk.Coins) {
+	validatorPoolRewards, _ := k.GetValidatorPoolRewards(ctx, staker, poolId)
+	validatorPoolRewards.CommissionRewards = validatorPoolRewards.CommissionRewards.Add(amount...)
+	k.SetValidatorPoolRewards(ctx, validatorPoolRewards)
+}
+
+// addPoolRewardHistory adds the given delegation rewards to the reward history entry of
+// the current epoch. Epochs which are older than the history length are pruned.
+func (k Keeper) addPoolRewardHistory(ctx sdk.Context, poolId uint64, amount sdk.Coins) {
+	epoch := uint64(ctx.BlockTime().Unix()) / types.RewardHistoryEpochDuration
+
+	entry, found := k.GetPoolRewardHistoryEntry(ctx, poolId, epoch)
+	entry.DelegationRewards = entry.DelegationRewards.Add(amount...)
+	entry.PoolStake = k.GetTotalStakeOfPool(ctx, poolId)
+	k.SetPoolRewardHistoryEntry(ctx, entry)
+
+	// prune old epochs only once per epoch
+	if !found {
+		for _, oldEntry := range k.GetPoolRewardHistory(ctx, poolId) {
+			if oldEntry.Epoch+types.RewardHistoryEpochs <= epoch {
+				k.RemovePoolRewardHistoryEntry(ctx, &oldEntry)
+			}
+		}
+	}
+}
+
+// settleDelegatorPoolRewards adds the rewards a delegator has accrued since the last
+// settlement to its accrued rewards for every pool of the validator. This needs to be
+// called every time before the shares of a delegator change.
+func (k Keeper) settleDelegatorPoolRewards(ctx sdk.Context, staker string, delegator string) {
+	shares := math.LegacyZeroDec()
+
+	delAddr, err := sdk.AccAddressFromBech32(delegator)
+	if err != nil {
+		return
+	}
+	valAddr, err := sdk.ValAddressFromBech32(util.MustValaddressFromOperatorAddress(staker))
+	if err != nil {
+		return
+	}
+	if delegation, err := k.stakingKeeper.GetDelegation(ctx, delAddr, valAddr); err == nil {
+		shares = delegation.Shares
+	}
+
+	for _, validatorPoolRewards := range k.GetValidatorPoolRewardsOfStaker(ctx, staker) {
+		delegatorPoolRewards, _ := k.GetDelegatorPoolRewards(ctx, staker, delegator, validatorPoolRewards.PoolId)
+
+		delegatorPoolRewards.AccruedRewards = delegatorPoolRewards.AccruedRewards.Add(
+			calculatePendingPoolRewards(validatorPoolRewards.RewardIndex, delegatorPoolRewards.RewardIndex, shares)...,
+		)
+		delegatorPoolRewards.RewardIndex = validatorPoolRewards.RewardIndex
+
+		k.SetDelegatorPoolRewards(ctx, delegatorPoolRewards)
+	}
+}
+
+// removeDelegatorPoolRewards removes the accrued rewards of a delegator in every pool
+// of the validator. This is called once the delegation got removed.
+func (k Keeper) removeDelegatorPoolRewards(ctx sdk.Context, staker string, delegator string) {
+	for _, validatorPoolRewards := range k.GetValidatorPoolRewardsOfStaker(ctx, staker) {
+		if delegatorPoolRewards, found := k.GetDelegatorPoolRewards(ctx, staker, delegator, validatorPoolRewards.PoolId); found {
+			k.RemoveDelegatorPoolRewards(ctx, &delegatorPoolRewards)
+		}
+	}
+}
+
+// calculatePendingPoolRewards returns the rewards which have been accrued with the given
+// amount of shares between the settled and the current reward index.
+func calculatePendingPoolRewards(currentIndex sdk.DecCoins, settledIndex sdk.DecCoins, shares math.LegacyDec) sdk.DecCoins {
+	difference, hasNeg := currentIndex.SafeSub(settledIndex)
+	if hasNeg || difference.IsZero() || !shares.IsPositive() {
+		return sdk.NewDecCoins()
+	}
+
+	return difference.MulDecTruncate(shares)
+}
+
+// GetDelegatorPoolRewardsOfDelegation returns the estimated rewards a delegator has accrued
+// in every pool of the validator since delegating to it.
+func (k Keeper) GetDelegatorPoolRewardsOfDelegation(ctx sdk.Context, staker string, delegator string, shares math.LegacyDec) map[uint64]sdk.Coins {
+	rewards := make(map[uint64]sdk.Coins)
+
+	for _, validatorPoolRewards := range k.GetValidatorPoolRewardsOfStaker(ctx, staker) {
+		delegatorPoolRewards, _ := k.GetDelegatorPoolRewards(ctx, staker, delegator, validatorPoolRewards.PoolId)
+
+		accrued := delegatorPoolRewards.AccruedRewards.Add(
+			calculatePendingPoolRewards(validatorPoolRewards.RewardIndex, delegatorPoolRewards.RewardIndex, shares)...,
+		)
+
+		if !accrued.IsZero() {
+			rewards[validatorPoolRewards.PoolId] = util.TruncateDecCoins(accrued)
+		}
+	}
+
+	return rewards
+}
+
+// GetPoolRewardHistoryAPR returns the annualized rate of the native delegation rewards
+// of a history entry relative to the pool stake.
+func GetPoolRewardHistoryAPR(entry types.PoolRewardHistoryEntry) math.LegacyDec {
+	if entry.PoolStake == 0 {
+		return math.LegacyZeroDec()
+	}
+
+	epochsPerYear := int64(60 * 60 * 24 * 365 / types.RewardHistoryEpochDuration)
+
+	return math.LegacyNewDecFromInt(entry.DelegationRewards.AmountOf(globalTypes.Denom)).
+		MulInt64(epochsPerYear).
+		QuoInt64(int64(entry.PoolStake))
+}
@@ -1,11 +1,39 @@
 package keeper
 
 import (
+	"cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	"github.com/KYVENetwork/chain/x/stakers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
+// ensureMaxStakeFractionSum checks if the validator would exceed the maximum sum
+// of stake fractions across all pools if the stake fraction in the given pool
+// would be set to the given value. Since the same bonded tokens are at risk
+// in every pool this limits the combined slash exposure of a validator.
+func (k Keeper) ensureMaxStakeFractionSum(ctx sdk.Context, staker string, poolId uint64, stakeFraction math.LegacyDec) error {
+	maxStakeFractionSum := k.GetMaxStakeFractionSum(ctx)
+
+	// a max stake fraction sum of zero means there is no limit
+	if maxStakeFractionSum.IsNil() || maxStakeFractionSum.IsZero() {
+		return nil
+	}
+
+	totalStakeFraction := stakeFraction
+	for _, poolAccount := range k.GetPoolAccountsFromStaker(ctx, staker) {
+		if poolAccount.PoolId != poolId {
+			totalStakeFraction = totalStakeFraction.Add(poolAccount.StakeFraction)
+		}
+	}
+
+	if totalStakeFraction.GT(maxStakeFractionSum) {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, types.ErrMaxStakeFractionSumExceeded.Error(), totalStakeFraction, maxStakeFractionSum)
+	}
+
+	return nil
+}
+
 // orderNewStakeFractionChange inserts a new change entry into the queue.
 // The queue is checked in every endBlock and when the stakeFractionChangeTime
 // is over the new stake fraction will be applied to the user.
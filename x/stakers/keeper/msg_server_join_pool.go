@@ -53,6 +53,28 @@ func (k msgServer) JoinPool(goCtx context.Context, msg *types.MsgJoinPool) (*typ
 		return nil, errors.Wrapf(errorsTypes.ErrInvalidRequest, types.ErrAlreadyJoinedPool.Error())
 	}
 
+	// The commission rules are fixed when joining the pool. If the validator
+	// does not specify them they default to the limits set by the governance.
+	maxCommission := msg.MaxCommission
+	if maxCommission.IsNil() {
+		maxCommission = pool.GetMaxCommissionLimit()
+	}
+
+	maxCommissionChangeRate := msg.MaxCommissionChangeRate
+	if maxCommissionChangeRate.IsNil() {
+		maxCommissionChangeRate = pool.GetMaxCommissionChangeRateLimit()
+	}
+
+	if err := k.validateCommissionRules(pool, msg.Commission, maxCommission, maxCommissionChangeRate); err != nil {
+		return nil, err
+	}
+
+	// The validator is not allowed to allocate more stake across all pools
+	// than permitted by the governance.
+	if err := k.ensureMaxStakeFractionSum(ctx, msg.Creator, msg.PoolId, msg.StakeFraction); err != nil {
+		return nil, err
+	}
+
 	// Only join if it is possible
 	if errFreeSlot := k.ensureFreeSlot(ctx, msg.PoolId, msg.Creator, msg.StakeFraction); errFreeSlot != nil {
 		return nil, errFreeSlot
@@ -76,19 +98,21 @@ func (k msgServer) JoinPool(goCtx context.Context, msg *types.MsgJoinPool) (*typ
 		}
 	}
 
-	k.AddPoolAccountToPool(ctx, msg.Creator, msg.PoolId, msg.PoolAddress, msg.Commission, msg.StakeFraction)
+	k.AddPoolAccountToPool(ctx, msg.Creator, msg.PoolId, msg.PoolAddress, msg.Commission, msg.StakeFraction, maxCommission, maxCommissionChangeRate)
 
 	if err := util.TransferFromAddressToAddress(k.bankKeeper, ctx, msg.Creator, msg.PoolAddress, msg.Amount); err != nil {
 		return nil, err
 	}
 
 	_ = ctx.EventManager().EmitTypedEvent(&types.EventJoinPool{
-		PoolId:        msg.PoolId,
-		Staker:        msg.Creator,
-		PoolAddress:   msg.PoolAddress,
-		Amount:        msg.Amount,
-		Commission:    msg.Commission,
-		StakeFraction: msg.StakeFraction,
+		PoolId:                  msg.PoolId,
+		Staker:                  msg.Creator,
+		PoolAddress:             msg.PoolAddress,
+		Amount:                  msg.Amount,
+		Commission:              msg.Commission,
+		StakeFraction:           msg.StakeFraction,
+		MaxCommission:           maxCommission,
+		MaxCommissionChangeRate: maxCommissionChangeRate,
 	})
 
 	return &types.MsgJoinPoolResponse{}, nil
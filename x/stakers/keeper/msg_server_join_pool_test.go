@@ -45,6 +45,13 @@ TEST CASES - msg_server_join_pool.go
 * Fail to kick out lowest staker because not enough stake + delegation
 * Join pool again with same pool address after staker has left pool
 * Join pool again with different pool address after staker has left pool
+* Join a pool without commission rules
+* Join a pool with commission rules
+* Try to join a pool with a commission higher than the max commission
+* Try to join a pool with commission rules above the pool limits
+* Join a pool without commission limits
+* Try to join another pool exceeding the max stake fraction sum
+* Join another pool without exceeding the max stake fraction sum
 
 */
 
@@ -1039,4 +1046,211 @@ var _ = Describe("msg_server_join_pool.go", Ordered, func() {
 
 		Expect(s.App().StakersKeeper.GetDelegationAmountOfDelegator(s.Ctx(), i.STAKER_0, i.STAKER_0)).To(Equal(totalStakeOfPool))
 	})
+
+	It("Join a pool without commission rules", func() {
+		// ACT
+		s.RunTxStakersSuccess(&stakerstypes.MsgJoinPool{
+			Creator:       i.STAKER_0,
+			PoolId:        0,
+			PoolAddress:   i.POOL_ADDRESS_0_A,
+			Amount:        100 * i.KYVE,
+			Commission:    math.LegacyMustNewDecFromStr("0.1"),
+			StakeFraction: math.LegacyMustNewDecFromStr("1"),
+		})
+
+		// ASSERT
+		poolAccount, _ := s.App().StakersKeeper.GetPoolAccount(s.Ctx(), i.STAKER_0, 0)
+
+		Expect(poolAccount.MaxCommission).To(Equal(pooltypes.DefaultMaxCommission))
+		Expect(poolAccount.MaxCommissionChangeRate).To(Equal(pooltypes.DefaultMaxCommissionChangeRate))
+	})
+
+	It("Join a pool with commission rules", func() {
+		// ACT
+		s.RunTxStakersSuccess(&stakerstypes.MsgJoinPool{
+			Creator:                 i.STAKER_0,
+			PoolId:                  0,
+			PoolAddress:             i.POOL_ADDRESS_0_A,
+			Amount:                  100 * i.KYVE,
+			Commission:              math.LegacyMustNewDecFromStr("0.1"),
+			StakeFraction:           math.LegacyMustNewDecFromStr("1"),
+			MaxCommission:           math.LegacyMustNewDecFromStr("0.2"),
+			MaxCommissionChangeRate: math.LegacyMustNewDecFromStr("0.01"),
+		})
+
+		// ASSERT
+		poolAccount, _ := s.App().StakersKeeper.GetPoolAccount(s.Ctx(), i.STAKER_0, 0)
+
+		Expect(poolAccount.Commission).To(Equal(math.LegacyMustNewDecFromStr("0.1")))
+		Expect(poolAccount.MaxCommission).To(Equal(math.LegacyMustNewDecFromStr("0.2")))
+		Expect(poolAccount.MaxCommissionChangeRate).To(Equal(math.LegacyMustNewDecFromStr("0.01")))
+		Expect(poolAccount.CommissionLastChanged).To(BeZero())
+	})
+
+	It("Try to join a pool with a commission higher than the max commission", func() {
+		// ACT
+		s.RunTxStakersError(&stakerstypes.MsgJoinPool{
+			Creator:       i.STAKER_0,
+			PoolId:        0,
+			PoolAddress:   i.POOL_ADDRESS_0_A,
+			Amount:        100 * i.KYVE,
+			Commission:    math.LegacyMustNewDecFromStr("0.3"),
+			StakeFraction: math.LegacyMustNewDecFromStr("1"),
+			MaxCommission: math.LegacyMustNewDecFromStr("0.2"),
+		})
+
+		// ASSERT
+		_, active := s.App().StakersKeeper.GetPoolAccount(s.Ctx(), i.STAKER_0, 0)
+		Expect(active).To(BeFalse())
+	})
+
+	It("Try to join a pool with commission rules above the pool limits", func() {
+		// ARRANGE
+		s.RunTxPoolSuccess(&pooltypes.MsgUpdatePool{
+			Authority: gov,
+			Id:        0,
+			Payload:   "{\"MaxCommission\":\"0.2\",\"MaxCommissionChangeRate\":\"0.05\"}",
+		})
+
+		// ACT
+		s.RunTxStakersError(&stakerstypes.MsgJoinPool{
+			Creator:       i.STAKER_0,
+			PoolId:        0,
+			PoolAddress:   i.POOL_ADDRESS_0_A,
+			Amount:        100 * i.KYVE,
+			Commission:    math.LegacyMustNewDecFromStr("0.1"),
+			StakeFraction: math.LegacyMustNewDecFromStr("1"),
+			MaxCommission: math.LegacyMustNewDecFromStr("0.5"),
+		})
+
+		s.RunTxStakersError(&stakerstypes.MsgJoinPool{
+			Creator:                 i.STAKER_0,
+			PoolId:                  0,
+			PoolAddress:             i.POOL_ADDRESS_0_A,
+			Amount:                  100 * i.KYVE,
+			Commission:              math.LegacyMustNewDecFromStr("0.1"),
+			StakeFraction:           math.LegacyMustNewDecFromStr("1"),
+			MaxCommissionChangeRate: math.LegacyMustNewDecFromStr("0.1"),
+		})
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgJoinPool{
+			Creator:       i.STAKER_0,
+			PoolId:        0,
+			PoolAddress:   i.POOL_ADDRESS_0_A,
+			Amount:        100 * i.KYVE,
+			Commission:    math.LegacyMustNewDecFromStr("0.1"),
+			StakeFraction: math.LegacyMustNewDecFromStr("1"),
+		})
+
+		// ASSERT
+		poolAccount, _ := s.App().StakersKeeper.GetPoolAccount(s.Ctx(), i.STAKER_0, 0)
+
+		Expect(poolAccount.MaxCommission).To(Equal(math.LegacyMustNewDecFromStr("0.2")))
+		Expect(poolAccount.MaxCommissionChangeRate).To(Equal(math.LegacyMustNewDecFromStr("0.05")))
+	})
+
+	It("Join a pool without commission limits", func() {
+		// ARRANGE
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 0)
+		pool.MaxCommission = math.LegacyDec{}
+		pool.MaxCommissionChangeRate = math.LegacyDec{}
+		s.App().PoolKeeper.SetPool(s.Ctx(), pool)
+
+		// ACT
+		s.RunTxStakersSuccess(&stakerstypes.MsgJoinPool{
+			Creator:       i.STAKER_0,
+			PoolId:        0,
+			PoolAddress:   i.POOL_ADDRESS_0_A,
+			Amount:        100 * i.KYVE,
+			Commission:    math.LegacyMustNewDecFromStr("0.1"),
+			StakeFraction: math.LegacyMustNewDecFromStr("1"),
+			MaxCommission: math.LegacyMustNewDecFromStr("0.5"),
+		})
+
+		// ASSERT
+		poolAccount, _ := s.App().StakersKeeper.GetPoolAccount(s.Ctx(), i.STAKER_0, 0)
+
+		Expect(poolAccount.MaxCommission).To(Equal(math.LegacyMustNewDecFromStr("0.5")))
+		Expect(poolAccount.MaxCommissionChangeRate).To(Equal(pooltypes.DefaultMaxCommissionChangeRate))
+	})
+
+	It("Try to join another pool exceeding the max stake fraction sum", func() {
+		// ARRANGE
+		params := s.App().StakersKeeper.GetParams(s.Ctx())
+		params.MaxStakeFractionSum = math.LegacyMustNewDecFromStr("1.5")
+		s.App().StakersKeeper.SetParams(s.Ctx(), params)
+
+		s.RunTxPoolSuccess(&pooltypes.MsgCreatePool{
+			Authority:            gov,
+			UploadInterval:       60,
+			MaxBundleSize:        100,
+			InflationShareWeight: math.LegacyZeroDec(),
+			Binaries:             "{}",
+		})
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgJoinPool{
+			Creator:       i.STAKER_0,
+			PoolId:        0,
+			PoolAddress:   i.POOL_ADDRESS_0_A,
+			Amount:        100 * i.KYVE,
+			Commission:    math.LegacyMustNewDecFromStr("0.1"),
+			StakeFraction: math.LegacyMustNewDecFromStr("1"),
+		})
+
+		// ACT
+		s.RunTxStakersError(&stakerstypes.MsgJoinPool{
+			Creator:       i.STAKER_0,
+			PoolId:        1,
+			PoolAddress:   i.POOL_ADDRESS_0_B,
+			Amount:        100 * i.KYVE,
+			Commission:    math.LegacyMustNewDecFromStr("0.1"),
+			StakeFraction: math.LegacyMustNewDecFromStr("0.6"),
+		})
+
+		// ASSERT
+		_, active := s.App().StakersKeeper.GetPoolAccount(s.Ctx(), i.STAKER_0, 1)
+		Expect(active).To(BeFalse())
+
+		Expect(s.App().StakersKeeper.GetValidatorTotalStakeFraction(s.Ctx(), i.STAKER_0)).To(Equal(math.LegacyOneDec()))
+	})
+
+	It("Join another pool without exceeding the max stake fraction sum", func() {
+		// ARRANGE
+		params := s.App().StakersKeeper.GetParams(s.Ctx())
+		params.MaxStakeFractionSum = math.LegacyMustNewDecFromStr("1.5")
+		s.App().StakersKeeper.SetParams(s.Ctx(), params)
+
+		s.RunTxPoolSuccess(&pooltypes.MsgCreatePool{
+			Authority:            gov,
+			UploadInterval:       60,
+			MaxBundleSize:        100,
+			InflationShareWeight: math.LegacyZeroDec(),
+			Binaries:             "{}",
+		})
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgJoinPool{
+			Creator:       i.STAKER_0,
+			PoolId:        0,
+			PoolAddress:   i.POOL_ADDRESS_0_A,
+			Amount:        100 * i.KYVE,
+			Commission:    math.LegacyMustNewDecFromStr("0.1"),
+			StakeFraction: math.LegacyMustNewDecFromStr("1"),
+		})
+
+		// ACT
+		s.RunTxStakersSuccess(&stakerstypes.MsgJoinPool{
+			Creator:       i.STAKER_0,
+			PoolId:        1,
+			PoolAddress:   i.POOL_ADDRESS_0_B,
+			Amount:        100 * i.KYVE,
+			Commission:    math.LegacyMustNewDecFromStr("0.1"),
+			StakeFraction: math.LegacyMustNewDecFromStr("0.5"),
+		})
+
+		// ASSERT
+		_, active := s.App().StakersKeeper.GetPoolAccount(s.Ctx(), i.STAKER_0, 1)
+		Expect(active).To(BeTrue())
+
+		Expect(s.App().StakersKeeper.GetValidatorTotalStakeFraction(s.Ctx(), i.STAKER_0)).To(Equal(math.LegacyMustNewDecFromStr("1.5")))
+	})
 })
@@ -23,7 +23,9 @@ func (k msgServer) UpdateCommission(goCtx context.Context, msg *types.MsgUpdateC
 	}
 
 	// Insert commission change into queue
-	k.orderNewCommissionChange(ctx, msg.Creator, msg.PoolId, msg.Commission)
+	if err := k.orderNewCommissionChange(ctx, msg.Creator, msg.PoolId, msg.Commission); err != nil {
+		return nil, err
+	}
 
 	return &types.MsgUpdateCommissionResponse{}, nil
 }
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"cosmossdk.io/errors"
+	"github.com/KYVENetwork/chain/x/stakers/types"
+)
+
+// UpdateCommissionRewardsSettings updates which denoms a validator accepts as commission
+// rewards from the protocol. All other denoms are forwarded to the community pool or
+// to a pool of the validator's choice.
+func (k msgServer) UpdateCommissionRewardsSettings(goCtx context.Context, msg *types.MsgUpdateCommissionRewardsSettings) (*types.MsgUpdateCommissionRewardsSettingsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetValidator(ctx, msg.Creator); !found {
+		return nil, errors.Wrap(errorsTypes.ErrUnauthorized, types.ErrNoStaker.Error())
+	}
+
+	if msg.AcceptAllDenoms {
+		k.RemoveCommissionRewardsSettings(ctx, msg.Creator)
+	} else {
+		if msg.ForwardDestination == types.COMMISSION_FORWARD_DESTINATION_POOL {
+			pool, err := k.poolKeeper.GetPoolWithError(ctx, msg.ForwardPoolId)
+			if err != nil || pool.Disabled {
+				return nil, errors.Wrapf(errorsTypes.ErrInvalidRequest, types.ErrInvalidForwardPool.Error(), msg.ForwardPoolId)
+			}
+		}
+
+		k.SetCommissionRewardsSettings(ctx, types.CommissionRewardsSettings{
+			Staker:             msg.Creator,
+			AcceptedDenoms:     msg.AcceptedDenoms,
+			ForwardDestination: msg.ForwardDestination,
+			ForwardPoolId:      msg.ForwardPoolId,
+		})
+	}
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventUpdateCommissionRewardsSettings{
+		Staker:             msg.Creator,
+		AcceptAllDenoms:    msg.AcceptAllDenoms,
+		AcceptedDenoms:     msg.AcceptedDenoms,
+		ForwardDestination: msg.ForwardDestination,
+		ForwardPoolId:      msg.ForwardPoolId,
+	})
+
+	return &types.MsgUpdateCommissionRewardsSettingsResponse{}, nil
+}
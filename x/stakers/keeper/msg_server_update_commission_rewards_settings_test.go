@@ -0,0 +1,214 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	globaltypes "github.com/KYVENetwork/chain/x/global/types"
+	pooltypes "github.com/KYVENetwork/chain/x/pool/types"
+	querytypes "github.com/KYVENetwork/chain/x/query/types"
+	stakerstypes "github.com/KYVENetwork/chain/x/stakers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+/*
+
+TEST CASES - msg_server_update_commission_rewards_settings.go
+
+* Accept all denoms as commission rewards by default
+* Forward unaccepted denoms to the community pool
+* Forward unaccepted denoms to a pool
+* Forward unaccepted denoms to the community pool if the forward pool is disabled
+* Reset the settings by accepting all denoms
+* Try to forward unaccepted denoms to a pool which does not exist
+* Try to update the settings as a non-validator
+* Expose the settings in the full staker
+
+*/
+
+var _ = Describe("msg_server_update_commission_rewards_settings.go", Ordered, func() {
+	s := i.NewCleanChain()
+
+	gov := s.App().GovKeeper.GetGovernanceAccount(s.Ctx()).GetAddress().String()
+
+	payout := func(coins sdk.Coins) {
+		for _, coin := range coins {
+			Expect(s.MintDenomToModule(pooltypes.ModuleName, coin.Amount.Uint64(), coin.Denom)).To(Succeed())
+		}
+		Expect(s.App().StakersKeeper.PayoutAdditionalCommissionRewards(s.Ctx(), i.STAKER_0, 0, pooltypes.ModuleName, coins)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		// init new clean chain
+		s = i.NewCleanChain()
+
+		// create pools
+		msg := &pooltypes.MsgCreatePool{
+			Authority:            gov,
+			UploadInterval:       60,
+			MaxBundleSize:        100,
+			InflationShareWeight: math.LegacyZeroDec(),
+			Binaries:             "{}",
+		}
+		s.RunTxPoolSuccess(msg)
+		s.RunTxPoolSuccess(msg)
+
+		s.CreateValidator(i.STAKER_0, "Staker-0", int64(100*i.KYVE))
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgJoinPool{
+			Creator:       i.STAKER_0,
+			PoolId:        0,
+			PoolAddress:   i.POOL_ADDRESS_0_A,
+			Amount:        100 * i.KYVE,
+			Commission:    math.LegacyMustNewDecFromStr("0.1"),
+			StakeFraction: math.LegacyMustNewDecFromStr("1"),
+		})
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Accept all denoms as commission rewards by default", func() {
+		// ACT
+		payout(i.ACoins(100).Add(i.KYVECoins(100)...))
+
+		// ASSERT
+		_, found := s.App().StakersKeeper.GetCommissionRewardsSettings(s.Ctx(), i.STAKER_0)
+		Expect(found).To(BeFalse())
+
+		Expect(s.App().StakersKeeper.GetOutstandingCommissionRewards(s.Ctx(), i.STAKER_0).String()).To(Equal(i.ACoins(100).Add(i.KYVECoins(100)...).String()))
+	})
+
+	It("Forward unaccepted denoms to the community pool", func() {
+		// ARRANGE
+		s.RunTxStakersSuccess(&stakerstypes.MsgUpdateCommissionRewardsSettings{
+			Creator:        i.STAKER_0,
+			AcceptedDenoms: []string{i.B_DENOM},
+		})
+		communityPool := s.GetCoinsFromCommunityPool()
+
+		// ACT
+		payout(i.ACoins(100).Add(i.BCoins(200)...).Add(i.KYVECoins(300)...))
+
+		// ASSERT
+		Expect(s.App().StakersKeeper.GetOutstandingCommissionRewards(s.Ctx(), i.STAKER_0).String()).To(Equal(i.BCoins(200).Add(i.KYVECoins(300)...).String()))
+		Expect(s.GetCoinsFromCommunityPool().AmountOf(i.A_DENOM).Sub(communityPool.AmountOf(i.A_DENOM)).Int64()).To(Equal(int64(100)))
+		Expect(s.GetCoinsFromCommunityPool().AmountOf(i.B_DENOM)).To(Equal(communityPool.AmountOf(i.B_DENOM)))
+	})
+
+	It("Forward unaccepted denoms to a pool", func() {
+		// ARRANGE
+		s.RunTxStakersSuccess(&stakerstypes.MsgUpdateCommissionRewardsSettings{
+			Creator:            i.STAKER_0,
+			AcceptedDenoms:     []string{},
+			ForwardDestination: stakerstypes.COMMISSION_FORWARD_DESTINATION_POOL,
+			ForwardPoolId:      1,
+		})
+
+		// ACT
+		payout(i.ACoins(100).Add(i.BCoins(200)...).Add(i.KYVECoins(300)...))
+
+		// ASSERT
+		Expect(s.App().StakersKeeper.GetOutstandingCommissionRewards(s.Ctx(), i.STAKER_0).String()).To(Equal(i.KYVECoins(300).String()))
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 1)
+		balance := s.App().BankKeeper.GetAllBalances(s.Ctx(), pool.GetPoolAccount())
+		Expect(balance.String()).To(Equal(i.ACoins(100).Add(i.BCoins(200)...).String()))
+	})
+
+	It("Forward unaccepted denoms to the community pool if the forward pool is disabled", func() {
+		// ARRANGE
+		s.RunTxStakersSuccess(&stakerstypes.MsgUpdateCommissionRewardsSettings{
+			Creator:            i.STAKER_0,
+			AcceptedDenoms:     []string{},
+			ForwardDestination: stakerstypes.COMMISSION_FORWARD_DESTINATION_POOL,
+			ForwardPoolId:      1,
+		})
+
+		s.RunTxPoolSuccess(&pooltypes.MsgDisablePool{
+			Authority: gov,
+			Id:        1,
+		})
+		communityPool := s.GetCoinsFromCommunityPool()
+
+		// ACT
+		payout(i.ACoins(100))
+
+		// ASSERT
+		Expect(s.App().StakersKeeper.GetOutstandingCommissionRewards(s.Ctx(), i.STAKER_0)).To(BeEmpty())
+		Expect(s.GetCoinsFromCommunityPool().AmountOf(i.A_DENOM).Sub(communityPool.AmountOf(i.A_DENOM)).Int64()).To(Equal(int64(100)))
+
+		pool, _ := s.App().PoolKeeper.GetPool(s.Ctx(), 1)
+		Expect(s.App().BankKeeper.GetAllBalances(s.Ctx(), pool.GetPoolAccount())).To(BeEmpty())
+	})
+
+	It("Reset the settings by accepting all denoms", func() {
+		// ARRANGE
+		s.RunTxStakersSuccess(&stakerstypes.MsgUpdateCommissionRewardsSettings{
+			Creator:        i.STAKER_0,
+			AcceptedDenoms: []string{i.B_DENOM},
+		})
+
+		// ACT
+		s.RunTxStakersSuccess(&stakerstypes.MsgUpdateCommissionRewardsSettings{
+			Creator:         i.STAKER_0,
+			AcceptAllDenoms: true,
+		})
+		payout(i.ACoins(100))
+
+		// ASSERT
+		_, found := s.App().StakersKeeper.GetCommissionRewardsSettings(s.Ctx(), i.STAKER_0)
+		Expect(found).To(BeFalse())
+
+		Expect(s.App().StakersKeeper.GetOutstandingCommissionRewards(s.Ctx(), i.STAKER_0).String()).To(Equal(i.ACoins(100).String()))
+	})
+
+	It("Try to forward unaccepted denoms to a pool which does not exist", func() {
+		// ACT
+		err := s.RunTxError(&stakerstypes.MsgUpdateCommissionRewardsSettings{
+			Creator:            i.STAKER_0,
+			AcceptedDenoms:     []string{i.B_DENOM},
+			ForwardDestination: stakerstypes.COMMISSION_FORWARD_DESTINATION_POOL,
+			ForwardPoolId:      2,
+		})
+
+		// ASSERT
+		Expect(err.Error()).To(ContainSubstring("forward pool 2 does not exist or is disabled"))
+
+		_, found := s.App().StakersKeeper.GetCommissionRewardsSettings(s.Ctx(), i.STAKER_0)
+		Expect(found).To(BeFalse())
+	})
+
+	It("Try to update the settings as a non-validator", func() {
+		// ACT
+		s.RunTxStakersError(&stakerstypes.MsgUpdateCommissionRewardsSettings{
+			Creator:        i.ALICE,
+			AcceptedDenoms: []string{i.B_DENOM},
+		})
+
+		// ASSERT
+		Expect(s.App().StakersKeeper.GetAllCommissionRewardsSettings(s.Ctx())).To(BeEmpty())
+	})
+
+	It("Expose the settings in the full staker", func() {
+		// ARRANGE
+		res, err := s.App().QueryKeeper.Staker(s.Ctx(), &querytypes.QueryStakerRequest{Address: i.STAKER_0})
+		Expect(err).To(BeNil())
+		Expect(res.Staker.CommissionRewardsSettings).To(BeNil())
+
+		// ACT
+		s.RunTxStakersSuccess(&stakerstypes.MsgUpdateCommissionRewardsSettings{
+			Creator:        i.STAKER_0,
+			AcceptedDenoms: []string{i.B_DENOM},
+		})
+
+		// ASSERT
+		res, err = s.App().QueryKeeper.Staker(s.Ctx(), &querytypes.QueryStakerRequest{Address: i.STAKER_0})
+		Expect(err).To(BeNil())
+		Expect(res.Staker.CommissionRewardsSettings.AcceptedDenoms).To(Equal([]string{i.B_DENOM}))
+		Expect(res.Staker.CommissionRewardsSettings.AcceptsDenom(globaltypes.Denom)).To(BeTrue())
+		Expect(res.Staker.CommissionRewardsSettings.AcceptsDenom(i.A_DENOM)).To(BeFalse())
+	})
+})
@@ -23,6 +23,10 @@ TEST CASES - msg_server_update_commission.go
 * Update commission multiple times during the commission change time
 * Update commission multiple times during the commission change time with the same value
 * Update commission with multiple pools
+* Update commission above the max commission
+* Update commission by more than the max commission change rate
+* Update commission above the max commission of the pool
+* Update commission twice within one day
 
 */
 
@@ -278,4 +282,149 @@ var _ = Describe("msg_server_update_commission.go", Ordered, func() {
 		poolAccount1, _ = s.App().StakersKeeper.GetPoolAccount(s.Ctx(), i.STAKER_0, 1)
 		Expect(poolAccount1.Commission).To(Equal(math.LegacyMustNewDecFromStr("0.5")))
 	})
+
+	It("Update commission above the max commission", func() {
+		// ARRANGE
+		s.RunTxStakersSuccess(&stakerstypes.MsgLeavePool{
+			Creator: i.STAKER_0,
+			PoolId:  0,
+		})
+		s.CommitAfterSeconds(s.App().StakersKeeper.GetLeavePoolTime(s.Ctx()))
+		s.CommitAfterSeconds(1)
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgJoinPool{
+			Creator:                 i.STAKER_0,
+			PoolId:                  0,
+			PoolAddress:             i.POOL_ADDRESS_0_A,
+			Amount:                  0,
+			Commission:              math.LegacyMustNewDecFromStr("0.1"),
+			StakeFraction:           math.LegacyMustNewDecFromStr("1"),
+			MaxCommission:           math.LegacyMustNewDecFromStr("0.2"),
+			MaxCommissionChangeRate: math.LegacyMustNewDecFromStr("0.2"),
+		})
+
+		// ACT
+		s.RunTxStakersError(&stakerstypes.MsgUpdateCommission{
+			Creator:    i.STAKER_0,
+			PoolId:     0,
+			Commission: math.LegacyMustNewDecFromStr("0.25"),
+		})
+
+		// ASSERT
+		_, found := s.App().StakersKeeper.GetCommissionChangeEntryByIndex2(s.Ctx(), i.STAKER_0, 0)
+		Expect(found).To(BeFalse())
+
+		poolAccount, _ := s.App().StakersKeeper.GetPoolAccount(s.Ctx(), i.STAKER_0, 0)
+		Expect(poolAccount.Commission).To(Equal(math.LegacyMustNewDecFromStr("0.1")))
+		Expect(poolAccount.MaxCommission).To(Equal(math.LegacyMustNewDecFromStr("0.2")))
+		Expect(poolAccount.MaxCommissionChangeRate).To(Equal(math.LegacyMustNewDecFromStr("0.2")))
+	})
+
+	It("Update commission by more than the max commission change rate", func() {
+		// ARRANGE
+		s.RunTxStakersSuccess(&stakerstypes.MsgLeavePool{
+			Creator: i.STAKER_0,
+			PoolId:  0,
+		})
+		s.CommitAfterSeconds(s.App().StakersKeeper.GetLeavePoolTime(s.Ctx()))
+		s.CommitAfterSeconds(1)
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgJoinPool{
+			Creator:                 i.STAKER_0,
+			PoolId:                  0,
+			PoolAddress:             i.POOL_ADDRESS_0_A,
+			Amount:                  0,
+			Commission:              math.LegacyMustNewDecFromStr("0.1"),
+			StakeFraction:           math.LegacyMustNewDecFromStr("1"),
+			MaxCommission:           math.LegacyMustNewDecFromStr("0.5"),
+			MaxCommissionChangeRate: math.LegacyMustNewDecFromStr("0.05"),
+		})
+
+		// ACT
+		s.RunTxStakersError(&stakerstypes.MsgUpdateCommission{
+			Creator:    i.STAKER_0,
+			PoolId:     0,
+			Commission: math.LegacyMustNewDecFromStr("0.2"),
+		})
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgUpdateCommission{
+			Creator:    i.STAKER_0,
+			PoolId:     0,
+			Commission: math.LegacyMustNewDecFromStr("0.15"),
+		})
+
+		// ASSERT
+		s.CommitAfterSeconds(s.App().StakersKeeper.GetCommissionChangeTime(s.Ctx()))
+		s.CommitAfterSeconds(1)
+
+		poolAccount, _ := s.App().StakersKeeper.GetPoolAccount(s.Ctx(), i.STAKER_0, 0)
+		Expect(poolAccount.Commission).To(Equal(math.LegacyMustNewDecFromStr("0.15")))
+	})
+
+	It("Update commission above the max commission of the pool", func() {
+		// ARRANGE
+		s.RunTxPoolSuccess(&pooltypes.MsgUpdatePool{
+			Authority: gov,
+			Id:        0,
+			Payload:   "{\"MaxCommission\":\"0.3\"}",
+		})
+
+		// ACT
+		s.RunTxStakersError(&stakerstypes.MsgUpdateCommission{
+			Creator:    i.STAKER_0,
+			PoolId:     0,
+			Commission: math.LegacyMustNewDecFromStr("0.4"),
+		})
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgUpdateCommission{
+			Creator:    i.STAKER_0,
+			PoolId:     0,
+			Commission: math.LegacyMustNewDecFromStr("0.3"),
+		})
+
+		// ASSERT
+		s.CommitAfterSeconds(s.App().StakersKeeper.GetCommissionChangeTime(s.Ctx()))
+		s.CommitAfterSeconds(1)
+
+		poolAccount, _ := s.App().StakersKeeper.GetPoolAccount(s.Ctx(), i.STAKER_0, 0)
+		Expect(poolAccount.Commission).To(Equal(math.LegacyMustNewDecFromStr("0.3")))
+	})
+
+	It("Update commission twice within one day", func() {
+		// ARRANGE
+		params := s.App().StakersKeeper.GetParams(s.Ctx())
+		params.CommissionChangeTime = 60
+		s.App().StakersKeeper.SetParams(s.Ctx(), params)
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgUpdateCommission{
+			Creator:    i.STAKER_0,
+			PoolId:     0,
+			Commission: math.LegacyMustNewDecFromStr("0.2"),
+		})
+
+		s.CommitAfterSeconds(60)
+		s.CommitAfterSeconds(1)
+
+		poolAccount, _ := s.App().StakersKeeper.GetPoolAccount(s.Ctx(), i.STAKER_0, 0)
+		Expect(poolAccount.Commission).To(Equal(math.LegacyMustNewDecFromStr("0.2")))
+
+		// ACT
+		s.RunTxStakersError(&stakerstypes.MsgUpdateCommission{
+			Creator:    i.STAKER_0,
+			PoolId:     0,
+			Commission: math.LegacyMustNewDecFromStr("0.3"),
+		})
+
+		s.CommitAfterSeconds(stakerstypes.CommissionChangeRatePeriod)
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgUpdateCommission{
+			Creator:    i.STAKER_0,
+			PoolId:     0,
+			Commission: math.LegacyMustNewDecFromStr("0.3"),
+		})
+
+		// ASSERT
+		_, found := s.App().StakersKeeper.GetCommissionChangeEntryByIndex2(s.Ctx(), i.STAKER_0, 0)
+		Expect(found).To(BeTrue())
+	})
 })
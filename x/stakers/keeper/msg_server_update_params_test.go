@@ -46,6 +46,9 @@ TEST CASES - msg_server_update_params.go
 * Update timeout slash
 * Update timeout slash with invalid value
 
+* Update max stake fraction sum
+* Update max stake fraction sum with invalid value
+
 */
 
 var _ = Describe("msg_server_update_params.go", Ordered, func() {
@@ -620,4 +623,68 @@ var _ = Describe("msg_server_update_params.go", Ordered, func() {
 		Expect(updatedParams.TimeoutSlash).To(Equal(types.DefaultTimeoutSlash))
 		Expect(updatedParams.VoteSlash).To(Equal(types.DefaultVoteSlash))
 	})
+
+	It("Update max stake fraction sum", func() {
+		// ARRANGE
+		payload := `{
+			"max_stake_fraction_sum": "2.5"
+		}`
+
+		msg := &types.MsgUpdateParams{
+			Authority: gov,
+			Payload:   payload,
+		}
+
+		proposal, _ := govV1Types.NewMsgSubmitProposal(
+			[]sdk.Msg{msg}, minDeposit, i.DUMMY[0], "", "title", "summary", false,
+		)
+
+		vote := govV1Types.NewMsgVote(
+			voter, 1, govV1Types.VoteOption_VOTE_OPTION_YES, "",
+		)
+
+		// ACT
+		_, submitErr := s.RunTx(proposal)
+		_, voteErr := s.RunTx(vote)
+
+		s.CommitAfter(*votingPeriod)
+		s.Commit()
+
+		// ASSERT
+		updatedParams := s.App().StakersKeeper.GetParams(s.Ctx())
+
+		Expect(submitErr).NotTo(HaveOccurred())
+		Expect(voteErr).NotTo(HaveOccurred())
+
+		Expect(updatedParams.MaxStakeFractionSum).To(Equal(math.LegacyMustNewDecFromStr("2.5")))
+	})
+
+	It("Update max stake fraction sum with invalid value", func() {
+		// ARRANGE
+		payload := `{
+			"max_stake_fraction_sum": "-1"
+		}`
+
+		msg := &types.MsgUpdateParams{
+			Authority: gov,
+			Payload:   payload,
+		}
+
+		proposal, _ := govV1Types.NewMsgSubmitProposal(
+			[]sdk.Msg{msg}, minDeposit, i.DUMMY[0], "", "title", "summary", false,
+		)
+
+		// ACT
+		_, submitErr := s.RunTx(proposal)
+
+		s.CommitAfter(*votingPeriod)
+		s.Commit()
+
+		// ASSERT
+		updatedParams := s.App().StakersKeeper.GetParams(s.Ctx())
+
+		Expect(submitErr).To(HaveOccurred())
+
+		Expect(updatedParams.MaxStakeFractionSum).To(Equal(types.DefaultMaxStakeFractionSum))
+	})
 })
@@ -31,6 +31,16 @@ func (k msgServer) UpdateStakeFraction(goCtx context.Context, msg *types.MsgUpda
 		return &types.MsgUpdateStakeFractionResponse{}, nil
 	}
 
+	// the validator is not allowed to allocate more stake across all pools
+	// than permitted by the governance. This is only checked on increases, so
+	// validators above the limit (e.g. after the governance lowered it) can
+	// still keep or reduce their allocation.
+	if msg.StakeFraction.GT(poolAccount.StakeFraction) {
+		if err := k.ensureMaxStakeFractionSum(ctx, msg.Creator, msg.PoolId, msg.StakeFraction); err != nil {
+			return nil, err
+		}
+	}
+
 	// if the validator wants to increase their stake fraction we can do this immediately.
 	// Before we clear any change entries if there are currently bonding
 	queueEntry, found := k.GetStakeFractionChangeEntryByIndex2(ctx, msg.Creator, msg.PoolId)
@@ -28,6 +28,10 @@ TEST CASES - msg_server_update_stake_fraction.go
 * Update stake fraction with multiple pools
 * Validator stake increases while stake fraction stays the same
 * Validator stake decreases while stake fraction stays the same
+* Increase stake fraction above the max stake fraction sum
+* Increase stake fraction up to the max stake fraction sum
+* Decrease stake fraction while exceeding the max stake fraction sum
+* Keep stake fraction while exceeding the max stake fraction sum
 
 */
 
@@ -406,4 +410,141 @@ var _ = Describe("msg_server_update_stake_fraction.go", Ordered, func() {
 		Expect(s.App().StakersKeeper.GetValidatorPoolStake(s.Ctx(), i.STAKER_0, 0)).To(Equal(5 * i.KYVE))
 		Expect(s.App().StakersKeeper.GetTotalStakeOfPool(s.Ctx(), 0)).To(Equal(5 * i.KYVE))
 	})
+
+	It("Increase stake fraction above the max stake fraction sum", func() {
+		// ARRANGE
+		params := s.App().StakersKeeper.GetParams(s.Ctx())
+		params.MaxStakeFractionSum = math.LegacyMustNewDecFromStr("0.5")
+		s.App().StakersKeeper.SetParams(s.Ctx(), params)
+
+		s.RunTxPoolSuccess(&pooltypes.MsgCreatePool{
+			Authority:            gov,
+			UploadInterval:       60,
+			MaxBundleSize:        100,
+			InflationShareWeight: math.LegacyZeroDec(),
+			Binaries:             "{}",
+		})
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgJoinPool{
+			Creator:       i.STAKER_0,
+			PoolId:        1,
+			PoolAddress:   i.POOL_ADDRESS_0_B,
+			Amount:        100 * i.KYVE,
+			Commission:    math.LegacyMustNewDecFromStr("0.1"),
+			StakeFraction: math.LegacyMustNewDecFromStr("0.3"),
+		})
+
+		// ACT
+		s.RunTxStakersError(&stakerstypes.MsgUpdateStakeFraction{
+			Creator:       i.STAKER_0,
+			PoolId:        0,
+			StakeFraction: math.LegacyMustNewDecFromStr("0.3"),
+		})
+
+		// ASSERT
+		poolAccount, _ := s.App().StakersKeeper.GetPoolAccount(s.Ctx(), i.STAKER_0, 0)
+		Expect(poolAccount.StakeFraction).To(Equal(math.LegacyMustNewDecFromStr("0.1")))
+		Expect(s.App().StakersKeeper.GetValidatorTotalStakeFraction(s.Ctx(), i.STAKER_0)).To(Equal(math.LegacyMustNewDecFromStr("0.4")))
+	})
+
+	It("Increase stake fraction up to the max stake fraction sum", func() {
+		// ARRANGE
+		params := s.App().StakersKeeper.GetParams(s.Ctx())
+		params.MaxStakeFractionSum = math.LegacyMustNewDecFromStr("0.5")
+		s.App().StakersKeeper.SetParams(s.Ctx(), params)
+
+		s.RunTxPoolSuccess(&pooltypes.MsgCreatePool{
+			Authority:            gov,
+			UploadInterval:       60,
+			MaxBundleSize:        100,
+			InflationShareWeight: math.LegacyZeroDec(),
+			Binaries:             "{}",
+		})
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgJoinPool{
+			Creator:       i.STAKER_0,
+			PoolId:        1,
+			PoolAddress:   i.POOL_ADDRESS_0_B,
+			Amount:        100 * i.KYVE,
+			Commission:    math.LegacyMustNewDecFromStr("0.1"),
+			StakeFraction: math.LegacyMustNewDecFromStr("0.3"),
+		})
+
+		// ACT
+		s.RunTxStakersSuccess(&stakerstypes.MsgUpdateStakeFraction{
+			Creator:       i.STAKER_0,
+			PoolId:        0,
+			StakeFraction: math.LegacyMustNewDecFromStr("0.2"),
+		})
+
+		// ASSERT
+		poolAccount, _ := s.App().StakersKeeper.GetPoolAccount(s.Ctx(), i.STAKER_0, 0)
+		Expect(poolAccount.StakeFraction).To(Equal(math.LegacyMustNewDecFromStr("0.2")))
+		Expect(s.App().StakersKeeper.GetValidatorTotalStakeFraction(s.Ctx(), i.STAKER_0)).To(Equal(math.LegacyMustNewDecFromStr("0.5")))
+	})
+
+	It("Decrease stake fraction while exceeding the max stake fraction sum", func() {
+		// ARRANGE
+		s.RunTxStakersSuccess(&stakerstypes.MsgUpdateStakeFraction{
+			Creator:       i.STAKER_0,
+			PoolId:        0,
+			StakeFraction: math.LegacyMustNewDecFromStr("0.8"),
+		})
+
+		params := s.App().StakersKeeper.GetParams(s.Ctx())
+		params.MaxStakeFractionSum = math.LegacyMustNewDecFromStr("0.5")
+		s.App().StakersKeeper.SetParams(s.Ctx(), params)
+
+		// ACT
+		s.RunTxStakersSuccess(&stakerstypes.MsgUpdateStakeFraction{
+			Creator:       i.STAKER_0,
+			PoolId:        0,
+			StakeFraction: math.LegacyMustNewDecFromStr("0.6"),
+		})
+
+		// wait for update
+		s.CommitAfterSeconds(s.App().StakersKeeper.GetStakeFractionChangeTime(s.Ctx()))
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		poolAccount, _ := s.App().StakersKeeper.GetPoolAccount(s.Ctx(), i.STAKER_0, 0)
+		Expect(poolAccount.StakeFraction).To(Equal(math.LegacyMustNewDecFromStr("0.6")))
+	})
+
+	It("Keep stake fraction while exceeding the max stake fraction sum", func() {
+		// ARRANGE
+		s.RunTxStakersSuccess(&stakerstypes.MsgUpdateStakeFraction{
+			Creator:       i.STAKER_0,
+			PoolId:        0,
+			StakeFraction: math.LegacyMustNewDecFromStr("0.8"),
+		})
+
+		params := s.App().StakersKeeper.GetParams(s.Ctx())
+		params.MaxStakeFractionSum = math.LegacyMustNewDecFromStr("0.5")
+		s.App().StakersKeeper.SetParams(s.Ctx(), params)
+
+		s.RunTxStakersSuccess(&stakerstypes.MsgUpdateStakeFraction{
+			Creator:       i.STAKER_0,
+			PoolId:        0,
+			StakeFraction: math.LegacyMustNewDecFromStr("0.6"),
+		})
+
+		// ACT
+		// cancel the pending decrease by keeping the current stake fraction
+		s.RunTxStakersSuccess(&stakerstypes.MsgUpdateStakeFraction{
+			Creator:       i.STAKER_0,
+			PoolId:        0,
+			StakeFraction: math.LegacyMustNewDecFromStr("0.8"),
+		})
+
+		s.CommitAfterSeconds(s.App().StakersKeeper.GetStakeFractionChangeTime(s.Ctx()))
+		s.CommitAfterSeconds(1)
+
+		// ASSERT
+		poolAccount, _ := s.App().StakersKeeper.GetPoolAccount(s.Ctx(), i.STAKER_0, 0)
+		Expect(poolAccount.StakeFraction).To(Equal(math.LegacyMustNewDecFromStr("0.8")))
+
+		_, found := s.App().StakersKeeper.GetStakeFractionChangeEntryByIndex2(s.Ctx(), i.STAKER_0, 0)
+		Expect(found).To(BeFalse())
+	})
 })
@@ -154,6 +154,7 @@ func (AppModule) ConsensusVersion() uint64 { return 1 }
 func (am AppModule) BeginBlock(ctx context.Context) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	am.keeper.ProcessCommissionChangeQueue(sdkCtx)
+	am.keeper.ProcessInactiveValidatorQueue(sdkCtx)
 	am.keeper.ProcessLeavePoolQueue(sdkCtx)
 	am.keeper.ProcessStakeFractionChangeQueue(sdkCtx)
 	return nil
@@ -12,6 +12,7 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateCommission{}, "kyve/stakers/MsgUpdateCommission", nil)
 	cdc.RegisterConcrete(&MsgUpdateStakeFraction{}, "kyve/stakers/MsgUpdateStakeFraction", nil)
+	cdc.RegisterConcrete(&MsgUpdateCommissionRewardsSettings{}, "kyve/stakers/MsgUpdateCommissionRewardsSettings", nil)
 	cdc.RegisterConcrete(&MsgJoinPool{}, "kyve/stakers/MsgJoinPool", nil)
 	cdc.RegisterConcrete(&MsgLeavePool{}, "kyve/stakers/MsgLeavePool", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "kyve/stakers/MsgUpdateParams", nil)
@@ -20,6 +21,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 func RegisterInterfaces(registry codecTypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUpdateCommission{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUpdateStakeFraction{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUpdateCommissionRewardsSettings{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgJoinPool{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgLeavePool{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUpdateParams{})
@@ -23,4 +23,13 @@ var (
 	ErrPoolAccountUnauthorized    = errors.Register(ModuleName, 1118, "pool account unauthorized")
 	ErrValidatorNotInActiveSet    = errors.Register(ModuleName, 1119, "validator not in active set")
 	ErrNoPoolAccount              = errors.Register(ModuleName, 1120, "sender has no pool account")
+
+	ErrCommissionExceedsMax              = errors.Register(ModuleName, 1121, "commission %v exceeds max commission %v")
+	ErrMaxCommissionExceedsPoolLimit     = errors.Register(ModuleName, 1122, "max commission %v exceeds pool limit %v")
+	ErrMaxChangeRateExceedsPoolLimit     = errors.Register(ModuleName, 1123, "max commission change rate %v exceeds pool limit %v")
+	ErrCommissionChangeRateExceeded      = errors.Register(ModuleName, 1124, "commission change of %v exceeds max commission change rate %v")
+	ErrCommissionChangeTooFrequent       = errors.Register(ModuleName, 1125, "commission can only be changed once per day")
+	ErrMaxChangeRateExceedsMaxCommission = errors.Register(ModuleName, 1126, "max commission change rate %v exceeds max commission %v")
+	ErrMaxStakeFractionSumExceeded       = errors.Register(ModuleName, 1127, "total stake fraction %v exceeds maximum of %v")
+	ErrInvalidForwardPool                = errors.Register(ModuleName, 1128, "forward pool %v does not exist or is disabled")
 )
@@ -261,6 +261,173 @@ func (m *EventClaimCommissionRewards) GetAmounts() string {
 	return ""
 }
 
+// EventUpdateCommissionRewardsSettings ...
+// emitted_by: MsgUpdateCommissionRewardsSettings
+type EventUpdateCommissionRewardsSettings struct {
+	// staker is the account address of the protocol node.
+	Staker string `protobuf:"bytes,1,opt,name=staker,proto3" json:"staker,omitempty"`
+	// accept_all_denoms ...
+	AcceptAllDenoms bool `protobuf:"varint,2,opt,name=accept_all_denoms,json=acceptAllDenoms,proto3" json:"accept_all_denoms,omitempty"`
+	// accepted_denoms ...
+	AcceptedDenoms []string `protobuf:"bytes,3,rep,name=accepted_denoms,json=acceptedDenoms,proto3" json:"accepted_denoms,omitempty"`
+	// forward_destination ...
+	ForwardDestination CommissionForwardDestination `protobuf:"varint,4,opt,name=forward_destination,json=forwardDestination,proto3,enum=kyve.stakers.v1.CommissionForwardDestination" json:"forward_destination,omitempty"`
+	// forward_pool_id ...
+	ForwardPoolId uint64 `protobuf:"varint,5,opt,name=forward_pool_id,json=forwardPoolId,proto3" json:"forward_pool_id,omitempty"`
+}
+
+func (m *EventUpdateCommissionRewardsSettings) Reset()         { *m = EventUpdateCommissionRewardsSettings{} }
+func (m *EventUpdateCommissionRewardsSettings) String() string { return proto.CompactTextString(m) }
+func (*EventUpdateCommissionRewardsSettings) ProtoMessage()    {}
+func (*EventUpdateCommissionRewardsSettings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_826aef2b0a39e8f7, []int{4}
+}
+func (m *EventUpdateCommissionRewardsSettings) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUpdateCommissionRewardsSettings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUpdateCommissionRewardsSettings.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUpdateCommissionRewardsSettings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUpdateCommissionRewardsSettings.Merge(m, src)
+}
+func (m *EventUpdateCommissionRewardsSettings) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUpdateCommissionRewardsSettings) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUpdateCommissionRewardsSettings.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUpdateCommissionRewardsSettings proto.InternalMessageInfo
+
+func (m *EventUpdateCommissionRewardsSettings) GetStaker() string {
+	if m != nil {
+		return m.Staker
+	}
+	return ""
+}
+
+func (m *EventUpdateCommissionRewardsSettings) GetAcceptAllDenoms() bool {
+	if m != nil {
+		return m.AcceptAllDenoms
+	}
+	return false
+}
+
+func (m *EventUpdateCommissionRewardsSettings) GetAcceptedDenoms() []string {
+	if m != nil {
+		return m.AcceptedDenoms
+	}
+	return nil
+}
+
+func (m *EventUpdateCommissionRewardsSettings) GetForwardDestination() CommissionForwardDestination {
+	if m != nil {
+		return m.ForwardDestination
+	}
+	return COMMISSION_FORWARD_DESTINATION_COMMUNITY_POOL
+}
+
+func (m *EventUpdateCommissionRewardsSettings) GetForwardPoolId() uint64 {
+	if m != nil {
+		return m.ForwardPoolId
+	}
+	return 0
+}
+
+// EventForwardCommissionRewards is an event emitted when commission rewards of
+// denoms which the validator does not accept are forwarded.
+// emitted_by: MsgSubmitBundleProposal
+type EventForwardCommissionRewards struct {
+	// staker is the account address of the protocol node.
+	Staker string `protobuf:"bytes,1,opt,name=staker,proto3" json:"staker,omitempty"`
+	// pool_id is the pool in which the commission rewards were earned
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// amounts are the forwarded commission rewards
+	Amounts string `protobuf:"bytes,3,opt,name=amounts,proto3" json:"amounts,omitempty"`
+	// forward_destination ...
+	ForwardDestination CommissionForwardDestination `protobuf:"varint,4,opt,name=forward_destination,json=forwardDestination,proto3,enum=kyve.stakers.v1.CommissionForwardDestination" json:"forward_destination,omitempty"`
+	// forward_pool_id ...
+	ForwardPoolId uint64 `protobuf:"varint,5,opt,name=forward_pool_id,json=forwardPoolId,proto3" json:"forward_pool_id,omitempty"`
+}
+
+func (m *EventForwardCommissionRewards) Reset()         { *m = EventForwardCommissionRewards{} }
+func (m *EventForwardCommissionRewards) String() string { return proto.CompactTextString(m) }
+func (*EventForwardCommissionRewards) ProtoMessage()    {}
+func (*EventForwardCommissionRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_826aef2b0a39e8f7, []int{5}
+}
+func (m *EventForwardCommissionRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventForwardCommissionRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventForwardCommissionRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventForwardCommissionRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventForwardCommissionRewards.Merge(m, src)
+}
+func (m *EventForwardCommissionRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventForwardCommissionRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventForwardCommissionRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventForwardCommissionRewards proto.InternalMessageInfo
+
+func (m *EventForwardCommissionRewards) GetStaker() string {
+	if m != nil {
+		return m.Staker
+	}
+	return ""
+}
+
+func (m *EventForwardCommissionRewards) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *EventForwardCommissionRewards) GetAmounts() string {
+	if m != nil {
+		return m.Amounts
+	}
+	return ""
+}
+
+func (m *EventForwardCommissionRewards) GetForwardDestination() CommissionForwardDestination {
+	if m != nil {
+		return m.ForwardDestination
+	}
+	return COMMISSION_FORWARD_DESTINATION_COMMUNITY_POOL
+}
+
+func (m *EventForwardCommissionRewards) GetForwardPoolId() uint64 {
+	if m != nil {
+		return m.ForwardPoolId
+	}
+	return 0
+}
+
 // EventJoinPool ...
 // emitted_by: MsgJoinPool
 type EventJoinPool struct {
@@ -277,13 +444,17 @@ type EventJoinPool struct {
 	Commission cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=commission,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"commission"`
 	// stake_fraction ...
 	StakeFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=stake_fraction,json=stakeFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"stake_fraction"`
+	// max_commission ...
+	MaxCommission cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=max_commission,json=maxCommission,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_commission"`
+	// max_commission_change_rate ...
+	MaxCommissionChangeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=max_commission_change_rate,json=maxCommissionChangeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_commission_change_rate"`
 }
 
 func (m *EventJoinPool) Reset()         { *m = EventJoinPool{} }
 func (m *EventJoinPool) String() string { return proto.CompactTextString(m) }
 func (*EventJoinPool) ProtoMessage()    {}
 func (*EventJoinPool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_826aef2b0a39e8f7, []int{4}
+	return fileDescriptor_826aef2b0a39e8f7, []int{6}
 }
 func (m *EventJoinPool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -353,7 +524,7 @@ func (m *EventLeavePool) Reset()         { *m = EventLeavePool{} }
 func (m *EventLeavePool) String() string { return proto.CompactTextString(m) }
 func (*EventLeavePool) ProtoMessage()    {}
 func (*EventLeavePool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_826aef2b0a39e8f7, []int{5}
+	return fileDescriptor_826aef2b0a39e8f7, []int{7}
 }
 func (m *EventLeavePool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -396,6 +567,73 @@ func (m *EventLeavePool) GetStaker() string {
 	return ""
 }
 
+// EventAutoLeavePool is an event emitted when a validator automatically
+// starts leaving a pool because it is no longer in the active set.
+// emitted_by: EndBlock
+type EventAutoLeavePool struct {
+	// pool_id ...
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// staker ...
+	Staker string `protobuf:"bytes,2,opt,name=staker,proto3" json:"staker,omitempty"`
+	// inactive_since is the UNIX-timestamp in seconds when the
+	// validator left the active set.
+	InactiveSince int64 `protobuf:"varint,3,opt,name=inactive_since,json=inactiveSince,proto3" json:"inactive_since,omitempty"`
+}
+
+func (m *EventAutoLeavePool) Reset()         { *m = EventAutoLeavePool{} }
+func (m *EventAutoLeavePool) String() string { return proto.CompactTextString(m) }
+func (*EventAutoLeavePool) ProtoMessage()    {}
+func (*EventAutoLeavePool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_826aef2b0a39e8f7, []int{8}
+}
+func (m *EventAutoLeavePool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAutoLeavePool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAutoLeavePool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAutoLeavePool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAutoLeavePool.Merge(m, src)
+}
+func (m *EventAutoLeavePool) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAutoLeavePool) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAutoLeavePool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAutoLeavePool proto.InternalMessageInfo
+
+func (m *EventAutoLeavePool) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *EventAutoLeavePool) GetStaker() string {
+	if m != nil {
+		return m.Staker
+	}
+	return ""
+}
+
+func (m *EventAutoLeavePool) GetInactiveSince() int64 {
+	if m != nil {
+		return m.InactiveSince
+	}
+	return 0
+}
+
 // EventSlash is an event emitted when a protocol node is slashed.
 // emitted_by: MsgSubmitBundleProposal, EndBlock
 type EventSlash struct {
@@ -416,7 +654,7 @@ func (m *EventSlash) Reset()         { *m = EventSlash{} }
 func (m *EventSlash) String() string { return proto.CompactTextString(m) }
 func (*EventSlash) ProtoMessage()    {}
 func (*EventSlash) Descriptor() ([]byte, []int) {
-	return fileDescriptor_826aef2b0a39e8f7, []int{6}
+	return fileDescriptor_826aef2b0a39e8f7, []int{9}
 }
 func (m *EventSlash) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -478,49 +716,65 @@ func init() {
 	proto.RegisterType((*EventUpdateCommission)(nil), "kyve.stakers.v1.EventUpdateCommission")
 	proto.RegisterType((*EventUpdateStakeFraction)(nil), "kyve.stakers.v1.EventUpdateStakeFraction")
 	proto.RegisterType((*EventClaimCommissionRewards)(nil), "kyve.stakers.v1.EventClaimCommissionRewards")
+	proto.RegisterType((*EventUpdateCommissionRewardsSettings)(nil), "kyve.stakers.v1.EventUpdateCommissionRewardsSettings")
+	proto.RegisterType((*EventForwardCommissionRewards)(nil), "kyve.stakers.v1.EventForwardCommissionRewards")
 	proto.RegisterType((*EventJoinPool)(nil), "kyve.stakers.v1.EventJoinPool")
 	proto.RegisterType((*EventLeavePool)(nil), "kyve.stakers.v1.EventLeavePool")
+	proto.RegisterType((*EventAutoLeavePool)(nil), "kyve.stakers.v1.EventAutoLeavePool")
 	proto.RegisterType((*EventSlash)(nil), "kyve.stakers.v1.EventSlash")
 }
 
 func init() { proto.RegisterFile("kyve/stakers/v1/events.proto", fileDescriptor_826aef2b0a39e8f7) }
 
 var fileDescriptor_826aef2b0a39e8f7 = []byte{
-	// 538 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xce, 0xa6, 0x69, 0xa2, 0x4c, 0x69, 0x10, 0x11, 0x50, 0x2b, 0x05, 0xb7, 0x98, 0x4b, 0x0f,
-	0xc8, 0x56, 0xcb, 0x09, 0x89, 0x4b, 0x13, 0x5a, 0x89, 0x52, 0x41, 0xe5, 0x02, 0x12, 0x5c, 0xa2,
-	0xad, 0xbd, 0x24, 0x56, 0x6c, 0x8f, 0xe5, 0xdd, 0x26, 0xe4, 0x1d, 0x40, 0xe2, 0xc6, 0x33, 0xf0,
-	0x26, 0x3d, 0xf6, 0x88, 0x10, 0xaa, 0x50, 0xf2, 0x22, 0x68, 0x77, 0x9d, 0xe6, 0xa7, 0x45, 0xa2,
-	0xbe, 0x79, 0x76, 0xe6, 0xfb, 0xd9, 0x6f, 0xe4, 0x85, 0x07, 0xbd, 0x61, 0x9f, 0x39, 0x5c, 0xd0,
-	0x1e, 0x4b, 0xb9, 0xd3, 0xdf, 0x76, 0x58, 0x9f, 0xc5, 0x82, 0xdb, 0x49, 0x8a, 0x02, 0xeb, 0xb7,
-	0x65, 0xd7, 0xce, 0xba, 0x76, 0x7f, 0xbb, 0x71, 0xb7, 0x83, 0x1d, 0x54, 0x3d, 0x47, 0x7e, 0xe9,
-	0xb1, 0xc6, 0x15, 0x92, 0x84, 0xa6, 0x34, 0xca, 0x48, 0x1a, 0x0f, 0x17, 0xbb, 0x13, 0x3e, 0xd5,
-	0xb6, 0x7e, 0x10, 0xb8, 0xb3, 0x27, 0x45, 0xdf, 0x25, 0x3e, 0x15, 0xec, 0x48, 0x41, 0xeb, 0xcf,
-	0x01, 0x30, 0xf4, 0xdb, 0x9a, 0xc8, 0x20, 0x9b, 0x64, 0x6b, 0x65, 0x67, 0xcd, 0x5e, 0xb0, 0x63,
-	0xeb, 0xe1, 0x66, 0xe9, 0xec, 0x62, 0xa3, 0xe0, 0x56, 0x31, 0xf4, 0xa7, 0xe8, 0x98, 0x0d, 0x26,
-	0xe8, 0xe2, 0x7f, 0xa1, 0x63, 0x36, 0xc8, 0xd0, 0x06, 0x54, 0x12, 0x3a, 0x0c, 0x91, 0xfa, 0xc6,
-	0xd2, 0x26, 0xd9, 0xaa, 0xba, 0x93, 0xd2, 0xfa, 0x4a, 0xe0, 0xde, 0x8c, 0xd7, 0x16, 0x46, 0x51,
-	0xc0, 0x79, 0x80, 0x71, 0xfd, 0x3e, 0x94, 0x35, 0xb3, 0xf2, 0x5a, 0x75, 0xb3, 0xaa, 0xbe, 0x06,
-	0x95, 0x04, 0x31, 0x6c, 0x07, 0xbe, 0xb2, 0x51, 0x72, 0xcb, 0xb2, 0x7c, 0xe9, 0xd7, 0x5b, 0x00,
-	0xde, 0x25, 0x5c, 0xeb, 0x34, 0x1f, 0x4b, 0x27, 0xbf, 0x2e, 0x36, 0xd6, 0x3d, 0xe4, 0x11, 0x72,
-	0xee, 0xf7, 0xec, 0x00, 0x9d, 0x88, 0x8a, 0xae, 0x7d, 0xc8, 0x3a, 0xd4, 0x1b, 0xbe, 0x60, 0x9e,
-	0x3b, 0x03, 0xb3, 0xbe, 0x13, 0x30, 0x66, 0xfc, 0x1c, 0x4b, 0xcd, 0xfd, 0x94, 0x7a, 0x22, 0x97,
-	0xa5, 0x03, 0xa8, 0xa9, 0x91, 0xf6, 0xa7, 0x8c, 0xe2, 0x26, 0xb6, 0x56, 0xf9, 0xac, 0xb8, 0xf5,
-	0x06, 0xd6, 0x95, 0xb1, 0x56, 0x48, 0x83, 0x68, 0x9a, 0x93, 0xcb, 0x06, 0x34, 0xf5, 0xf9, 0x3f,
-	0xbd, 0x19, 0x50, 0xa1, 0x11, 0x9e, 0xc6, 0x42, 0x6f, 0xad, 0xea, 0x4e, 0x4a, 0xeb, 0x4b, 0x11,
-	0x56, 0x15, 0xe3, 0x01, 0x06, 0xf1, 0x11, 0x62, 0x38, 0x7b, 0x0f, 0x32, 0x77, 0x8f, 0x29, 0x79,
-	0x71, 0x8e, 0xfc, 0x11, 0xdc, 0x52, 0x00, 0xea, 0xfb, 0x29, 0xe3, 0x3c, 0x5b, 0xee, 0x8a, 0x3c,
-	0xdb, 0xd5, 0x47, 0x12, 0xaa, 0x05, 0x8d, 0x92, 0xa6, 0xd4, 0xd5, 0xc2, 0xb6, 0x96, 0x73, 0x6d,
-	0xeb, 0x9a, 0x7c, 0xcb, 0xb9, 0xf3, 0xdd, 0x85, 0x9a, 0x4a, 0xe3, 0x90, 0xd1, 0x3e, 0xcb, 0x15,
-	0x87, 0xf5, 0x9b, 0x00, 0x28, 0x8e, 0xe3, 0x90, 0xf2, 0xee, 0xcd, 0xe3, 0x9c, 0x66, 0xb5, 0x34,
-	0x97, 0xd5, 0x33, 0x00, 0x2e, 0x19, 0xdb, 0x62, 0x98, 0x30, 0x95, 0x63, 0x6d, 0xa7, 0x71, 0xe5,
-	0xe7, 0x53, 0xa2, 0x6f, 0x87, 0x09, 0x73, 0xab, 0x7c, 0xf2, 0x79, 0x4d, 0x42, 0xcb, 0x79, 0x13,
-	0x6a, 0xee, 0x9f, 0x8d, 0x4c, 0x72, 0x3e, 0x32, 0xc9, 0x9f, 0x91, 0x49, 0xbe, 0x8d, 0xcd, 0xc2,
-	0xf9, 0xd8, 0x2c, 0xfc, 0x1c, 0x9b, 0x85, 0x8f, 0x4f, 0x3a, 0x81, 0xe8, 0x9e, 0x9e, 0xd8, 0x1e,
-	0x46, 0xce, 0xab, 0x0f, 0xef, 0xf7, 0x5e, 0x33, 0x31, 0xc0, 0xb4, 0xe7, 0x78, 0x5d, 0x1a, 0xc4,
-	0xce, 0xe7, 0xcb, 0xa7, 0x4a, 0xfa, 0xe7, 0x27, 0x65, 0xf5, 0x4c, 0x3d, 0xfd, 0x1b, 0x00, 0x00,
-	0xff, 0xff, 0x45, 0x5f, 0x0c, 0x9f, 0x2a, 0x05, 0x00, 0x00,
+	// 750 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xcd, 0x6e, 0xd3, 0x4a,
+	0x14, 0x8e, 0xeb, 0x34, 0x69, 0x4e, 0x6f, 0x52, 0xd5, 0xf7, 0x5e, 0x1a, 0xa5, 0x34, 0x0d, 0xe6,
+	0x2f, 0x42, 0x60, 0xab, 0x65, 0x85, 0xc4, 0x26, 0x4d, 0x5b, 0x89, 0x52, 0x41, 0xe5, 0x00, 0x12,
+	0x2c, 0x30, 0x53, 0x7b, 0x9a, 0x58, 0xb1, 0x3d, 0x96, 0x67, 0x9a, 0x34, 0x0f, 0x81, 0xc4, 0x8e,
+	0x05, 0x4f, 0xc0, 0x2b, 0xf0, 0x04, 0x5d, 0x76, 0x89, 0x10, 0xaa, 0x50, 0xfb, 0x06, 0x3c, 0x01,
+	0x9a, 0xb1, 0xdd, 0x38, 0x4d, 0x2b, 0xb5, 0x59, 0xb1, 0xcb, 0x9c, 0x73, 0xbe, 0xef, 0x7c, 0xe7,
+	0x2f, 0x86, 0x9b, 0xdd, 0x41, 0x0f, 0xeb, 0x94, 0xa1, 0x2e, 0x0e, 0xa9, 0xde, 0x5b, 0xd1, 0x71,
+	0x0f, 0xfb, 0x8c, 0x6a, 0x41, 0x48, 0x18, 0x51, 0xe6, 0xb8, 0x57, 0x8b, 0xbd, 0x5a, 0x6f, 0xa5,
+	0xf2, 0x5f, 0x9b, 0xb4, 0x89, 0xf0, 0xe9, 0xfc, 0x57, 0x14, 0x56, 0x19, 0x23, 0x09, 0x50, 0x88,
+	0xbc, 0x98, 0xa4, 0xb2, 0x74, 0xde, 0x9b, 0xf0, 0x09, 0xb7, 0xfa, 0x55, 0x82, 0xf9, 0x0d, 0x9e,
+	0xf4, 0x75, 0x60, 0x23, 0x86, 0x77, 0x04, 0x54, 0x79, 0x0a, 0x40, 0x5c, 0xdb, 0x8c, 0x88, 0xca,
+	0x52, 0x4d, 0xaa, 0xcf, 0xae, 0x2e, 0x68, 0xe7, 0xe4, 0x68, 0x51, 0xf0, 0x5a, 0xf6, 0xf0, 0x78,
+	0x39, 0x63, 0x14, 0x88, 0x6b, 0x0f, 0xd1, 0x3e, 0xee, 0x27, 0xe8, 0xa9, 0x2b, 0xa1, 0x7d, 0xdc,
+	0x8f, 0xd1, 0x65, 0xc8, 0x07, 0x68, 0xe0, 0x12, 0x64, 0x97, 0xe5, 0x9a, 0x54, 0x2f, 0x18, 0xc9,
+	0x53, 0xfd, 0x28, 0xc1, 0xff, 0x29, 0xad, 0x4d, 0xe2, 0x79, 0x0e, 0xa5, 0x0e, 0xf1, 0x95, 0x1b,
+	0x90, 0x8b, 0x98, 0x85, 0xd6, 0x82, 0x11, 0xbf, 0x94, 0x05, 0xc8, 0x07, 0x84, 0xb8, 0xa6, 0x63,
+	0x0b, 0x19, 0x59, 0x23, 0xc7, 0x9f, 0xcf, 0x6c, 0xa5, 0x09, 0x60, 0x9d, 0xc1, 0xa3, 0x3c, 0x6b,
+	0xb7, 0xb9, 0x92, 0x1f, 0xc7, 0xcb, 0x8b, 0x16, 0xa1, 0x1e, 0xa1, 0xd4, 0xee, 0x6a, 0x0e, 0xd1,
+	0x3d, 0xc4, 0x3a, 0xda, 0x36, 0x6e, 0x23, 0x6b, 0xb0, 0x8e, 0x2d, 0x23, 0x05, 0x53, 0x3f, 0x4b,
+	0x50, 0x4e, 0xe9, 0x69, 0xf1, 0x9c, 0x9b, 0x21, 0xb2, 0xd8, 0x44, 0x92, 0xb6, 0xa0, 0x24, 0x42,
+	0xcc, 0xbd, 0x98, 0xe2, 0x3a, 0xb2, 0x8a, 0x34, 0x9d, 0x5c, 0x7d, 0x09, 0x8b, 0x42, 0x58, 0xd3,
+	0x45, 0x8e, 0x37, 0xec, 0x93, 0x81, 0xfb, 0x28, 0xb4, 0xe9, 0xa5, 0xda, 0xca, 0x90, 0x47, 0x1e,
+	0xd9, 0xf7, 0x59, 0x34, 0xb5, 0x82, 0x91, 0x3c, 0xd5, 0x2f, 0x53, 0x70, 0xe7, 0xc2, 0xd6, 0xc7,
+	0x94, 0x2d, 0xcc, 0x98, 0xe3, 0xb7, 0x2f, 0xa7, 0x7e, 0x00, 0xf3, 0xc8, 0xb2, 0x70, 0xc0, 0x4c,
+	0xe4, 0xba, 0xa6, 0x8d, 0x7d, 0x12, 0xaf, 0xc6, 0x8c, 0x31, 0x17, 0x39, 0x1a, 0xae, 0xbb, 0x2e,
+	0xcc, 0xca, 0x7d, 0x88, 0x4d, 0xd8, 0x4e, 0x22, 0xe5, 0x9a, 0x5c, 0x2f, 0x18, 0xa5, 0xc4, 0x1c,
+	0x07, 0xbe, 0x87, 0x7f, 0xf7, 0x48, 0xc8, 0x05, 0x98, 0x36, 0xa6, 0xcc, 0xf1, 0x91, 0xe8, 0x5b,
+	0xb6, 0x26, 0xd5, 0x4b, 0xab, 0x8f, 0xc6, 0x36, 0x6e, 0xa8, 0x7a, 0x33, 0x42, 0xad, 0x0f, 0x41,
+	0x86, 0xb2, 0x37, 0x66, 0x53, 0xee, 0xc1, 0x5c, 0xc2, 0x9f, 0xcc, 0x6c, 0x5a, 0xcc, 0xac, 0x18,
+	0x9b, 0x77, 0xc4, 0xe8, 0xd4, 0xdf, 0x12, 0x2c, 0x89, 0xee, 0xc4, 0xbc, 0x57, 0xef, 0xf8, 0xa5,
+	0xdb, 0x90, 0x1a, 0x85, 0x3c, 0x32, 0x8a, 0xbf, 0xa6, 0xe8, 0x6f, 0x32, 0x14, 0x45, 0xd1, 0x5b,
+	0xc4, 0xf1, 0xb9, 0x2d, 0x5d, 0x8c, 0x34, 0x52, 0xcc, 0xb0, 0xfa, 0xa9, 0x91, 0xea, 0x6f, 0xc1,
+	0x3f, 0x02, 0x80, 0x6c, 0x3b, 0xc4, 0x34, 0xa9, 0x74, 0x96, 0xdb, 0x1a, 0x91, 0x89, 0x43, 0xa3,
+	0xc2, 0x45, 0x81, 0x59, 0x23, 0x7e, 0x9d, 0x3b, 0xe0, 0xe9, 0x89, 0x0e, 0xf8, 0x82, 0x93, 0xcb,
+	0x4d, 0x7a, 0x72, 0x9c, 0xcb, 0x43, 0x07, 0x66, 0x4a, 0x54, 0xfe, 0x1a, 0x5c, 0x1e, 0x3a, 0x48,
+	0xfd, 0x9d, 0x7d, 0x80, 0xca, 0x28, 0x97, 0x69, 0x75, 0x90, 0xdf, 0xc6, 0x66, 0x88, 0x18, 0x2e,
+	0xcf, 0x5c, 0x9d, 0x77, 0x61, 0x84, 0xb7, 0x29, 0x48, 0x0c, 0xc4, 0xb0, 0xda, 0x80, 0x92, 0x98,
+	0xdd, 0x36, 0x46, 0x3d, 0x3c, 0xd1, 0xf0, 0x54, 0x17, 0x14, 0x41, 0xd1, 0xd8, 0x67, 0x64, 0x72,
+	0x1a, 0xe5, 0x2e, 0x94, 0x1c, 0x9f, 0xf7, 0xb0, 0x87, 0x4d, 0xea, 0xf8, 0x16, 0x16, 0x5b, 0x20,
+	0x1b, 0xc5, 0xc4, 0xda, 0xe2, 0x46, 0xf5, 0xa7, 0x04, 0x20, 0xd2, 0xb5, 0x5c, 0x44, 0x3b, 0xd7,
+	0x4f, 0x33, 0xdc, 0x23, 0x79, 0x64, 0x8f, 0x9e, 0x00, 0x50, 0xce, 0x68, 0xb2, 0x41, 0x80, 0xe3,
+	0x23, 0xaa, 0x8c, 0x1d, 0x91, 0x48, 0xfa, 0x6a, 0x10, 0x60, 0xa3, 0x40, 0x93, 0x9f, 0x17, 0x6c,
+	0xcf, 0xf4, 0xa4, 0xdb, 0xb3, 0xb6, 0x79, 0x78, 0x52, 0x95, 0x8e, 0x4e, 0xaa, 0xd2, 0xaf, 0x93,
+	0xaa, 0xf4, 0xe9, 0xb4, 0x9a, 0x39, 0x3a, 0xad, 0x66, 0xbe, 0x9f, 0x56, 0x33, 0xef, 0x1e, 0xb6,
+	0x1d, 0xd6, 0xd9, 0xdf, 0xd5, 0x2c, 0xe2, 0xe9, 0xcf, 0xdf, 0xbe, 0xd9, 0x78, 0x81, 0x59, 0x9f,
+	0x84, 0x5d, 0xdd, 0xea, 0x20, 0xc7, 0xd7, 0x0f, 0xce, 0xbe, 0xec, 0x5c, 0x3f, 0xdd, 0xcd, 0x89,
+	0xaf, 0xfa, 0xe3, 0x3f, 0x03, 0x00, 0xb7, 0xf9, 0x72, 0x7a, 0x59, 0x08, 0x00, 0x00,
 }
 
 func (m *EventUpdateParams) Marshal() (dAtA []byte, err error) {
@@ -700,6 +954,117 @@ func (m *EventClaimCommissionRewards) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *EventUpdateCommissionRewardsSettings) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateCommissionRewardsSettings) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateCommissionRewardsSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ForwardPoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ForwardPoolId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ForwardDestination != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ForwardDestination))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.AcceptedDenoms) > 0 {
+		for iNdEx := len(m.AcceptedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AcceptedDenoms[iNdEx])
+			copy(dAtA[i:], m.AcceptedDenoms[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.AcceptedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.AcceptAllDenoms {
+		i--
+		if m.AcceptAllDenoms {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventForwardCommissionRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventForwardCommissionRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventForwardCommissionRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ForwardPoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ForwardPoolId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ForwardDestination != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ForwardDestination))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Amounts) > 0 {
+		i -= len(m.Amounts)
+		copy(dAtA[i:], m.Amounts)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amounts)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventJoinPool) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -720,6 +1085,26 @@ func (m *EventJoinPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxCommissionChangeRate.Size()
+		i -= size
+		if _, err := m.MaxCommissionChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.MaxCommission.Size()
+		i -= size
+		if _, err := m.MaxCommission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
 	{
 		size := m.StakeFraction.Size()
 		i -= size
@@ -802,7 +1187,7 @@ func (m *EventLeavePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventSlash) Marshal() (dAtA []byte, err error) {
+func (m *EventAutoLeavePool) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -812,30 +1197,70 @@ func (m *EventSlash) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventSlash) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventAutoLeavePool) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventSlash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventAutoLeavePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.StakeFraction.Size()
-		i -= size
-		if _, err := m.StakeFraction.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if m.SlashType != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.SlashType))
+	if m.InactiveSince != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.InactiveSince))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x18
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSlash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSlash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSlash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.StakeFraction.Size()
+		i -= size
+		if _, err := m.StakeFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.SlashType != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SlashType))
+		i--
+		dAtA[i] = 0x20
 	}
 	if m.Amount != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.Amount))
@@ -938,6 +1363,60 @@ func (m *EventClaimCommissionRewards) Size() (n int) {
 	return n
 }
 
+func (m *EventUpdateCommissionRewardsSettings) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.AcceptAllDenoms {
+		n += 2
+	}
+	if len(m.AcceptedDenoms) > 0 {
+		for _, s := range m.AcceptedDenoms {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.ForwardDestination != 0 {
+		n += 1 + sovEvents(uint64(m.ForwardDestination))
+	}
+	if m.ForwardPoolId != 0 {
+		n += 1 + sovEvents(uint64(m.ForwardPoolId))
+	}
+	return n
+}
+
+func (m *EventForwardCommissionRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	l = len(m.Amounts)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ForwardDestination != 0 {
+		n += 1 + sovEvents(uint64(m.ForwardDestination))
+	}
+	if m.ForwardPoolId != 0 {
+		n += 1 + sovEvents(uint64(m.ForwardPoolId))
+	}
+	return n
+}
+
 func (m *EventJoinPool) Size() (n int) {
 	if m == nil {
 		return 0
@@ -962,6 +1441,10 @@ func (m *EventJoinPool) Size() (n int) {
 	n += 1 + l + sovEvents(uint64(l))
 	l = m.StakeFraction.Size()
 	n += 1 + l + sovEvents(uint64(l))
+	l = m.MaxCommission.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.MaxCommissionChangeRate.Size()
+	n += 1 + l + sovEvents(uint64(l))
 	return n
 }
 
@@ -981,6 +1464,25 @@ func (m *EventLeavePool) Size() (n int) {
 	return n
 }
 
+func (m *EventAutoLeavePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.InactiveSince != 0 {
+		n += 1 + sovEvents(uint64(m.InactiveSince))
+	}
+	return n
+}
+
 func (m *EventSlash) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1543,7 +2045,7 @@ func (m *EventClaimCommissionRewards) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventJoinPool) Unmarshal(dAtA []byte) error {
+func (m *EventUpdateCommissionRewardsSettings) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1566,32 +2068,13 @@ func (m *EventJoinPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventJoinPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventUpdateCommissionRewardsSettings: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventJoinPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventUpdateCommissionRewardsSettings: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
 			}
@@ -1623,9 +2106,29 @@ func (m *EventJoinPool) Unmarshal(dAtA []byte) error {
 			}
 			m.Staker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptAllDenoms", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AcceptAllDenoms = bool(v != 0)
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedDenoms", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1653,13 +2156,13 @@ func (m *EventJoinPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolAddress = string(dAtA[iNdEx:postIndex])
+			m.AcceptedDenoms = append(m.AcceptedDenoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardDestination", wireType)
 			}
-			m.Amount = 0
+			m.ForwardDestination = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1669,50 +2172,16 @@ func (m *EventJoinPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				m.ForwardDestination |= CommissionForwardDestination(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakeFraction", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardPoolId", wireType)
 			}
-			var stringLen uint64
+			m.ForwardPoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1722,26 +2191,11 @@ func (m *EventJoinPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ForwardPoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.StakeFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1763,7 +2217,7 @@ func (m *EventJoinPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventLeavePool) Unmarshal(dAtA []byte) error {
+func (m *EventForwardCommissionRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1786,13 +2240,45 @@ func (m *EventLeavePool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventLeavePool: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventForwardCommissionRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventLeavePool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventForwardCommissionRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
@@ -1811,9 +2297,9 @@ func (m *EventLeavePool) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1841,8 +2327,555 @@ func (m *EventLeavePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Staker = string(dAtA[iNdEx:postIndex])
+			m.Amounts = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardDestination", wireType)
+			}
+			m.ForwardDestination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForwardDestination |= CommissionForwardDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardPoolId", wireType)
+			}
+			m.ForwardPoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForwardPoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventJoinPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventJoinPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventJoinPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakeFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxCommission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommissionChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxCommissionChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventLeavePool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventLeavePool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventLeavePool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAutoLeavePool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAutoLeavePool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAutoLeavePool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InactiveSince", wireType)
+			}
+			m.InactiveSince = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InactiveSince |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
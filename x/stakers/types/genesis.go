@@ -83,5 +83,34 @@ func (gs GenesisState) Validate() error {
 		stakeFractionChangeMap[index] = struct{}{}
 	}
 
+	// Inactive Validator
+	inactiveValidatorMap := make(map[string]struct{})
+
+	for _, elem := range gs.InactiveValidatorEntries {
+		index := string(InactiveValidatorEntryKeyIndex2(elem.Staker))
+		if _, ok := inactiveValidatorMap[index]; ok {
+			return fmt.Errorf("duplicated staker for inactive validator entry %v", elem)
+		}
+		if elem.Index > gs.QueueStateInactiveValidator.HighIndex {
+			return fmt.Errorf("inactive validator entry index too high: %v", elem)
+		}
+		if elem.Index < gs.QueueStateInactiveValidator.LowIndex {
+			return fmt.Errorf("inactive validator entry index too low: %v", elem)
+		}
+
+		inactiveValidatorMap[index] = struct{}{}
+	}
+
+	// Commission Rewards Settings
+	commissionRewardsSettingsMap := make(map[string]struct{})
+
+	for _, elem := range gs.CommissionRewardsSettingsList {
+		index := string(CommissionRewardsSettingsKey(elem.Staker))
+		if _, ok := commissionRewardsSettingsMap[index]; ok {
+			return fmt.Errorf("duplicated staker for commission rewards settings %v", elem)
+		}
+		commissionRewardsSettingsMap[index] = struct{}{}
+	}
+
 	return gs.Params.Validate()
 }
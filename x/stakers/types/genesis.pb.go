@@ -43,6 +43,20 @@ type GenesisState struct {
 	StakeFractionChangeEntries []StakeFractionChangeEntry `protobuf:"bytes,8,rep,name=stake_fraction_change_entries,json=stakeFractionChangeEntries,proto3" json:"stake_fraction_change_entries"`
 	// queue_state_stake_fraction ...
 	QueueStateStakeFraction QueueState `protobuf:"bytes,9,opt,name=queue_state_stake_fraction,json=queueStateStakeFraction,proto3" json:"queue_state_stake_fraction"`
+	// inactive_validator_entries ...
+	InactiveValidatorEntries []InactiveValidatorEntry `protobuf:"bytes,10,rep,name=inactive_validator_entries,json=inactiveValidatorEntries,proto3" json:"inactive_validator_entries"`
+	// queue_state_inactive_validator ...
+	QueueStateInactiveValidator QueueState `protobuf:"bytes,11,opt,name=queue_state_inactive_validator,json=queueStateInactiveValidator,proto3" json:"queue_state_inactive_validator"`
+	// validator_pool_rewards ...
+	ValidatorPoolRewards []ValidatorPoolRewards `protobuf:"bytes,12,rep,name=validator_pool_rewards,json=validatorPoolRewards,proto3" json:"validator_pool_rewards"`
+	// delegator_pool_rewards ...
+	DelegatorPoolRewards []DelegatorPoolRewards `protobuf:"bytes,13,rep,name=delegator_pool_rewards,json=delegatorPoolRewards,proto3" json:"delegator_pool_rewards"`
+	// pool_reward_history ...
+	PoolRewardHistory []PoolRewardHistoryEntry `protobuf:"bytes,14,rep,name=pool_reward_history,json=poolRewardHistory,proto3" json:"pool_reward_history"`
+	// pool_account_stats_list ...
+	PoolAccountStatsList []PoolAccountStats `protobuf:"bytes,15,rep,name=pool_account_stats_list,json=poolAccountStatsList,proto3" json:"pool_account_stats_list"`
+	// commission_rewards_settings_list ...
+	CommissionRewardsSettingsList []CommissionRewardsSettings `protobuf:"bytes,16,rep,name=commission_rewards_settings_list,json=commissionRewardsSettingsList,proto3" json:"commission_rewards_settings_list"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -141,6 +155,55 @@ func (m *GenesisState) GetQueueStateStakeFraction() QueueState {
 	return QueueState{}
 }
 
+func (m *GenesisState) GetInactiveValidatorEntries() []InactiveValidatorEntry {
+	if m != nil {
+		return m.InactiveValidatorEntries
+	}
+	return nil
+}
+
+func (m *GenesisState) GetQueueStateInactiveValidator() QueueState {
+	if m != nil {
+		return m.QueueStateInactiveValidator
+	}
+	return QueueState{}
+}
+
+func (m *GenesisState) GetValidatorPoolRewards() []ValidatorPoolRewards {
+	if m != nil {
+		return m.ValidatorPoolRewards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDelegatorPoolRewards() []DelegatorPoolRewards {
+	if m != nil {
+		return m.DelegatorPoolRewards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPoolRewardHistory() []PoolRewardHistoryEntry {
+	if m != nil {
+		return m.PoolRewardHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPoolAccountStatsList() []PoolAccountStats {
+	if m != nil {
+		return m.PoolAccountStatsList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCommissionRewardsSettingsList() []CommissionRewardsSettings {
+	if m != nil {
+		return m.CommissionRewardsSettingsList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "kyve.stakers.v1.GenesisState")
 }
@@ -148,36 +211,48 @@ func init() {
 func init() { proto.RegisterFile("kyve/stakers/v1/genesis.proto", fileDescriptor_5f5ffc24bd7be1aa) }
 
 var fileDescriptor_5f5ffc24bd7be1aa = []byte{
-	// 460 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xc1, 0x6e, 0xd4, 0x30,
-	0x14, 0xdc, 0xd0, 0xb2, 0x80, 0x8b, 0xb4, 0xd4, 0xaa, 0xd8, 0x10, 0xda, 0xb4, 0xe2, 0x80, 0x40,
-	0x42, 0x89, 0x0a, 0xe2, 0x8a, 0x44, 0xab, 0x96, 0x03, 0xa5, 0x02, 0x56, 0x02, 0xc1, 0x01, 0xcb,
-	0xb5, 0x4c, 0xd6, 0xda, 0x6c, 0x9c, 0xda, 0x4e, 0x60, 0xff, 0xa2, 0x9f, 0xd5, 0x63, 0x8f, 0x9c,
-	0x10, 0xda, 0xfd, 0x11, 0xe4, 0x17, 0x77, 0x93, 0x36, 0x39, 0xf4, 0x16, 0xbd, 0x99, 0x37, 0x33,
-	0x6f, 0x14, 0xa3, 0xad, 0xc9, 0xac, 0xe4, 0xb1, 0x36, 0x74, 0xc2, 0x95, 0x8e, 0xcb, 0xdd, 0x38,
-	0xe1, 0x19, 0xd7, 0x42, 0x47, 0xb9, 0x92, 0x46, 0xe2, 0x81, 0x85, 0x23, 0x07, 0x47, 0xe5, 0x6e,
-	0xb0, 0x91, 0xc8, 0x44, 0x02, 0x16, 0xdb, 0xaf, 0x8a, 0x16, 0x6c, 0x5e, 0x57, 0xc9, 0xa9, 0xa2,
-	0x53, 0x27, 0x12, 0xb4, 0x3c, 0x2e, 0xf5, 0x00, 0x7e, 0x72, 0xd6, 0x47, 0xf7, 0xdf, 0x55, 0xae,
-	0x23, 0x43, 0x0d, 0xc7, 0xaf, 0x51, 0xbf, 0xda, 0xf7, 0xbd, 0x1d, 0xef, 0xd9, 0xda, 0xcb, 0x61,
-	0x74, 0x2d, 0x45, 0xf4, 0x11, 0xe0, 0xbd, 0xd5, 0xf3, 0xbf, 0xdb, 0xbd, 0xcf, 0x8e, 0x8c, 0xdf,
-	0xa0, 0xb5, 0x8a, 0x42, 0x52, 0xa1, 0x8d, 0x7f, 0x6b, 0x67, 0xa5, 0x73, 0x77, 0x04, 0x9f, 0x6e,
-	0x17, 0x55, 0xc0, 0x91, 0xd0, 0x06, 0x1f, 0xa3, 0xf5, 0x5c, 0xca, 0x94, 0x50, 0xc6, 0x64, 0x91,
-	0x99, 0x4a, 0x65, 0x05, 0x54, 0x36, 0xdb, 0x09, 0xa4, 0x4c, 0xdf, 0x56, 0x44, 0x27, 0x35, 0xc8,
-	0xeb, 0x11, 0xe8, 0x8d, 0xd1, 0x23, 0x26, 0xa7, 0x53, 0xa1, 0xb5, 0x90, 0x19, 0x61, 0x63, 0x9a,
-	0x25, 0x9c, 0xf0, 0xcc, 0x28, 0xc1, 0xb5, 0xbf, 0x0a, 0xba, 0x4f, 0x5b, 0xba, 0xfb, 0xcb, 0x8d,
-	0x7d, 0x58, 0x38, 0xc8, 0x8c, 0x9a, 0x39, 0x87, 0x21, 0xeb, 0x00, 0x05, 0xd7, 0xf8, 0x2b, 0x7a,
-	0x78, 0x5a, 0xf0, 0x82, 0x13, 0x6d, 0xfb, 0x23, 0x35, 0xcd, 0xbf, 0x0d, 0x05, 0x3e, 0x6e, 0xd9,
-	0x7c, 0xb2, 0x74, 0x68, 0xdb, 0x69, 0x6f, 0x9c, 0x2e, 0x27, 0x75, 0x04, 0x3c, 0x42, 0x38, 0xe5,
-	0xb4, 0xe4, 0x04, 0x8a, 0xb9, 0xcc, 0xde, 0x87, 0xec, 0xdb, 0x2d, 0xd1, 0x23, 0x4b, 0xb5, 0xc5,
-	0x34, 0x43, 0x3f, 0x48, 0x9b, 0x53, 0x9b, 0xf6, 0x03, 0x5a, 0x6f, 0xa6, 0x05, 0xdc, 0xbf, 0x73,
-	0xd3, 0xa0, 0x83, 0x3a, 0x28, 0xf8, 0x61, 0x85, 0xb6, 0x80, 0x4f, 0x7e, 0x2a, 0xca, 0x4c, 0x47,
-	0xd5, 0x77, 0x21, 0xee, 0xf3, 0xee, 0x1f, 0xe1, 0xd0, 0x2d, 0xb5, 0xdb, 0x0e, 0x74, 0x37, 0x6e,
-	0x4f, 0xf8, 0x81, 0x82, 0xe6, 0x09, 0x57, 0xfd, 0xfd, 0x7b, 0x37, 0xbd, 0x65, 0x58, 0xdf, 0x72,
-	0x25, 0xcc, 0xde, 0xe1, 0xf9, 0x3c, 0xf4, 0x2e, 0xe6, 0xa1, 0xf7, 0x6f, 0x1e, 0x7a, 0x67, 0x8b,
-	0xb0, 0x77, 0xb1, 0x08, 0x7b, 0x7f, 0x16, 0x61, 0xef, 0xfb, 0x8b, 0x44, 0x98, 0x71, 0x71, 0x12,
-	0x31, 0x39, 0x8d, 0xdf, 0x7f, 0xfb, 0x72, 0x70, 0xcc, 0xcd, 0x2f, 0xa9, 0x26, 0x31, 0x1b, 0x53,
-	0x91, 0xc5, 0xbf, 0x97, 0xaf, 0xcc, 0xcc, 0x72, 0xae, 0x4f, 0xfa, 0xf0, 0xc2, 0x5e, 0xfd, 0x0f,
-	0x00, 0x00, 0xff, 0xff, 0x56, 0x98, 0xa6, 0x41, 0xe6, 0x03, 0x00, 0x00,
+	// 645 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x6f, 0x4f, 0xd3, 0x40,
+	0x18, 0xdf, 0x04, 0x51, 0x6f, 0xe8, 0xe0, 0x24, 0xac, 0x16, 0x28, 0x68, 0xe2, 0xdf, 0x98, 0x36,
+	0x68, 0x7c, 0x6b, 0x22, 0x08, 0x6a, 0x44, 0xa2, 0x2c, 0xc1, 0x68, 0x22, 0xcd, 0xd1, 0x1d, 0xdd,
+	0x65, 0x5d, 0xaf, 0xdc, 0xdd, 0x8a, 0xfb, 0x16, 0x7e, 0x2c, 0x5e, 0xf2, 0xd2, 0x57, 0xc6, 0xb0,
+	0xf8, 0x3d, 0xcc, 0x5d, 0x6f, 0x6d, 0xb7, 0x76, 0x64, 0xef, 0x9a, 0xfb, 0x3d, 0xcf, 0xef, 0xcf,
+	0x73, 0x97, 0xa7, 0x60, 0xad, 0xd3, 0x8f, 0xb1, 0xc3, 0x05, 0xea, 0x60, 0xc6, 0x9d, 0x78, 0xd3,
+	0xf1, 0x71, 0x88, 0x39, 0xe1, 0x76, 0xc4, 0xa8, 0xa0, 0xb0, 0x2e, 0x61, 0x5b, 0xc3, 0x76, 0xbc,
+	0x69, 0x2e, 0xf9, 0xd4, 0xa7, 0x0a, 0x73, 0xe4, 0x57, 0x52, 0x66, 0xae, 0x8e, 0xb3, 0x44, 0x88,
+	0xa1, 0xae, 0x26, 0x31, 0x0b, 0x1a, 0x43, 0x3e, 0x05, 0x3f, 0xf8, 0x57, 0x03, 0xf3, 0xef, 0x12,
+	0xd5, 0xa6, 0x40, 0x02, 0xc3, 0x57, 0x60, 0x2e, 0xe9, 0x37, 0xaa, 0x1b, 0xd5, 0x27, 0xb5, 0x17,
+	0x0d, 0x7b, 0xcc, 0x85, 0xfd, 0x59, 0xc1, 0x5b, 0xb3, 0xe7, 0x7f, 0xd6, 0x2b, 0x07, 0xba, 0x18,
+	0xbe, 0x06, 0xb5, 0xa4, 0xc4, 0x0d, 0x08, 0x17, 0xc6, 0xb5, 0x8d, 0x99, 0xd2, 0xde, 0xa6, 0xfa,
+	0xd4, 0xbd, 0x20, 0x01, 0xf6, 0x08, 0x17, 0x70, 0x1f, 0x2c, 0x46, 0x94, 0x06, 0x2e, 0xf2, 0x3c,
+	0xda, 0x0b, 0x45, 0xc2, 0x32, 0xa3, 0x58, 0x56, 0x8b, 0x0e, 0x28, 0x0d, 0xde, 0x24, 0x85, 0x9a,
+	0xaa, 0x1e, 0x65, 0x47, 0x8a, 0xaf, 0x0d, 0xee, 0x79, 0xb4, 0xdb, 0x25, 0x9c, 0x13, 0x1a, 0xba,
+	0x5e, 0x1b, 0x85, 0x3e, 0x76, 0x71, 0x28, 0x18, 0xc1, 0xdc, 0x98, 0x55, 0xbc, 0x8f, 0x0a, 0xbc,
+	0xdb, 0x69, 0xc7, 0xb6, 0x6a, 0xd8, 0x09, 0x05, 0xeb, 0x6b, 0x85, 0x86, 0x57, 0x02, 0x12, 0xcc,
+	0xe1, 0x57, 0xb0, 0x7c, 0xda, 0xc3, 0x3d, 0xec, 0x72, 0x39, 0x3f, 0x37, 0x2b, 0x33, 0xae, 0xab,
+	0x01, 0xae, 0x14, 0x64, 0xbe, 0xc8, 0x72, 0x35, 0x6d, 0xcd, 0xbd, 0x74, 0x9a, 0x9e, 0x64, 0x16,
+	0x60, 0x13, 0xc0, 0x00, 0xa3, 0x18, 0xbb, 0x6a, 0x30, 0x43, 0xef, 0x73, 0xca, 0xfb, 0x7a, 0x81,
+	0x74, 0x4f, 0x96, 0xca, 0xc1, 0xe4, 0x4d, 0x2f, 0x04, 0xf9, 0x53, 0xe9, 0xf6, 0x13, 0x58, 0xcc,
+	0xbb, 0x55, 0xb8, 0x71, 0x63, 0x5a, 0xa3, 0xf5, 0xcc, 0xa8, 0xd2, 0x83, 0x0c, 0xac, 0xa9, 0x7a,
+	0xf7, 0x84, 0x21, 0x4f, 0x94, 0x8c, 0xfa, 0xa6, 0xb2, 0xfb, 0xb4, 0xfc, 0x21, 0xec, 0xea, 0xa6,
+	0xe2, 0xb4, 0x4d, 0x5e, 0x8e, 0xcb, 0x08, 0x47, 0xc0, 0xcc, 0x47, 0x18, 0xd5, 0x37, 0x6e, 0x4d,
+	0x9b, 0xa5, 0x91, 0x65, 0x19, 0x31, 0x03, 0x3b, 0xc0, 0x24, 0xa1, 0xfc, 0x8e, 0xb1, 0x1b, 0xa3,
+	0x80, 0xb4, 0x90, 0xa0, 0x2c, 0x0d, 0x04, 0x54, 0xa0, 0xc7, 0x05, 0xfe, 0x0f, 0xba, 0xe5, 0x70,
+	0xd8, 0x91, 0x8f, 0x63, 0x90, 0x32, 0x54, 0x86, 0x39, 0x01, 0x56, 0x3e, 0x4c, 0x51, 0xd8, 0xa8,
+	0x4d, 0x1b, 0x68, 0x25, 0x0b, 0x54, 0x30, 0x03, 0x11, 0x58, 0xce, 0xb2, 0xa8, 0x07, 0xc5, 0xf0,
+	0x19, 0x62, 0x2d, 0x6e, 0xcc, 0xab, 0x40, 0x0f, 0x0b, 0xfc, 0x69, 0xaf, 0x7c, 0x3e, 0x07, 0x49,
+	0xf1, 0xf0, 0xbd, 0xc6, 0x25, 0x98, 0x94, 0x68, 0xe1, 0x00, 0xfb, 0x45, 0x89, 0xdb, 0x13, 0x24,
+	0xde, 0x0e, 0xcb, 0x4b, 0x24, 0x5a, 0x25, 0x18, 0xfc, 0x01, 0xee, 0xe6, 0x88, 0xdd, 0x36, 0xe1,
+	0x82, 0xb2, 0xbe, 0x71, 0x67, 0xc2, 0x9d, 0x64, 0xad, 0xef, 0x93, 0xca, 0xfc, 0x9d, 0x2c, 0x46,
+	0xe3, 0x28, 0x3c, 0x02, 0x8d, 0x91, 0x25, 0x24, 0xef, 0x84, 0x27, 0xab, 0xa8, 0xae, 0x24, 0xee,
+	0x5f, 0xb5, 0x8a, 0xe4, 0xe4, 0x53, 0xfb, 0xd1, 0xd8, 0xb9, 0x5a, 0x4a, 0x7d, 0xb0, 0x91, 0x5b,
+	0x4a, 0x7a, 0x3a, 0x2e, 0xc7, 0x42, 0x90, 0xd0, 0xd7, 0x42, 0x0b, 0x4a, 0xe8, 0xd9, 0x15, 0xbb,
+	0x49, 0x0f, 0xa3, 0xa9, 0xdb, 0xb4, 0xe2, 0x9a, 0x37, 0xa9, 0x40, 0x4a, 0x6f, 0xed, 0x9e, 0x5f,
+	0x5a, 0xd5, 0x8b, 0x4b, 0xab, 0xfa, 0xf7, 0xd2, 0xaa, 0xfe, 0x1a, 0x58, 0x95, 0x8b, 0x81, 0x55,
+	0xf9, 0x3d, 0xb0, 0x2a, 0xdf, 0x9f, 0xfb, 0x44, 0xb4, 0x7b, 0xc7, 0xb6, 0x47, 0xbb, 0xce, 0xc7,
+	0x6f, 0x87, 0x3b, 0xfb, 0x58, 0x9c, 0x51, 0xd6, 0x71, 0xbc, 0x36, 0x22, 0xa1, 0xf3, 0x33, 0xfd,
+	0x75, 0x88, 0x7e, 0x84, 0xf9, 0xf1, 0x9c, 0xfa, 0x6d, 0xbc, 0xfc, 0x3f, 0x00, 0x61, 0x56, 0x0d,
+	0x33, 0xbb, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -200,6 +275,102 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CommissionRewardsSettingsList) > 0 {
+		for iNdEx := len(m.CommissionRewardsSettingsList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommissionRewardsSettingsList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.PoolAccountStatsList) > 0 {
+		for iNdEx := len(m.PoolAccountStatsList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolAccountStatsList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.PoolRewardHistory) > 0 {
+		for iNdEx := len(m.PoolRewardHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolRewardHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.DelegatorPoolRewards) > 0 {
+		for iNdEx := len(m.DelegatorPoolRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatorPoolRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.ValidatorPoolRewards) > 0 {
+		for iNdEx := len(m.ValidatorPoolRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorPoolRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	{
+		size, err := m.QueueStateInactiveValidator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if len(m.InactiveValidatorEntries) > 0 {
+		for iNdEx := len(m.InactiveValidatorEntries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InactiveValidatorEntries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	{
 		size, err := m.QueueStateStakeFraction.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -368,6 +539,44 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.QueueStateStakeFraction.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.InactiveValidatorEntries) > 0 {
+		for _, e := range m.InactiveValidatorEntries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.QueueStateInactiveValidator.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ValidatorPoolRewards) > 0 {
+		for _, e := range m.ValidatorPoolRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DelegatorPoolRewards) > 0 {
+		for _, e := range m.DelegatorPoolRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PoolRewardHistory) > 0 {
+		for _, e := range m.PoolRewardHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PoolAccountStatsList) > 0 {
+		for _, e := range m.PoolAccountStatsList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CommissionRewardsSettingsList) > 0 {
+		for _, e := range m.CommissionRewardsSettingsList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -708,6 +917,243 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InactiveValidatorEntries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InactiveValidatorEntries = append(m.InactiveValidatorEntries, InactiveValidatorEntry{})
+			if err := m.InactiveValidatorEntries[len(m.InactiveValidatorEntries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueStateInactiveValidator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QueueStateInactiveValidator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPoolRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorPoolRewards = append(m.ValidatorPoolRewards, ValidatorPoolRewards{})
+			if err := m.ValidatorPoolRewards[len(m.ValidatorPoolRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorPoolRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorPoolRewards = append(m.DelegatorPoolRewards, DelegatorPoolRewards{})
+			if err := m.DelegatorPoolRewards[len(m.DelegatorPoolRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolRewardHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolRewardHistory = append(m.PoolRewardHistory, PoolRewardHistoryEntry{})
+			if err := m.PoolRewardHistory[len(m.PoolRewardHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolAccountStatsList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolAccountStatsList = append(m.PoolAccountStatsList, PoolAccountStats{})
+			if err := m.PoolAccountStatsList[len(m.PoolAccountStatsList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRewardsSettingsList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommissionRewardsSettingsList = append(m.CommissionRewardsSettingsList, CommissionRewardsSettings{})
+			if err := m.CommissionRewardsSettingsList[len(m.CommissionRewardsSettingsList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
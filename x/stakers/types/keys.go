@@ -46,6 +46,26 @@ var (
 	StakeFractionChangeEntryKeyPrefix = []byte{7, 0}
 	// StakeFractionChangeKeyPrefixIndex2 | <staker> | <poolId>
 	StakeFractionChangeKeyPrefixIndex2 = []byte{7, 1}
+
+	// InactiveValidatorEntryKeyPrefix | <index>
+	InactiveValidatorEntryKeyPrefix = []byte{8, 0}
+	// InactiveValidatorEntryKeyPrefixIndex2 | <staker>
+	InactiveValidatorEntryKeyPrefixIndex2 = []byte{8, 1}
+
+	// ValidatorPoolRewardsKeyPrefix | <staker> | <poolId>
+	ValidatorPoolRewardsKeyPrefix = []byte{9}
+
+	// DelegatorPoolRewardsKeyPrefix | <staker> | <delegator> | <poolId>
+	DelegatorPoolRewardsKeyPrefix = []byte{10}
+
+	// PoolRewardHistoryKeyPrefix | <poolId> | <epoch>
+	PoolRewardHistoryKeyPrefix = []byte{11}
+
+	// PoolAccountStatsKeyPrefix | <staker> | <poolId>
+	PoolAccountStatsKeyPrefix = []byte{12}
+
+	// CommissionRewardsSettingsKeyPrefix | <staker>
+	CommissionRewardsSettingsKeyPrefix = []byte{13}
 )
 
 // ENUM aggregated data types
@@ -60,10 +80,23 @@ var (
 	QUEUE_IDENTIFIER_COMMISSION     QUEUE_IDENTIFIER = []byte{30, 2}
 	QUEUE_IDENTIFIER_LEAVE          QUEUE_IDENTIFIER = []byte{30, 3}
 	QUEUE_IDENTIFIER_STAKE_FRACTION QUEUE_IDENTIFIER = []byte{30, 4}
+	QUEUE_IDENTIFIER_INACTIVE       QUEUE_IDENTIFIER = []byte{30, 5}
 )
 
 const MaxStakers = 50
 
+// RewardHistoryEpochDuration is the duration of a single pool reward
+// history epoch in seconds.
+const RewardHistoryEpochDuration = 60 * 60 * 24
+
+// RewardHistoryEpochs is the number of epochs the pool reward history
+// is kept for. Older epochs are pruned.
+const RewardHistoryEpochs = 30
+
+// CommissionChangeRatePeriod is the time in seconds which needs to pass
+// after a commission change was applied before the next one can be ordered.
+const CommissionChangeRatePeriod = 60 * 60 * 24
+
 func PoolAccountKey(poolId uint64, staker string) []byte {
 	return util.GetByteKey(poolId, staker)
 }
@@ -96,3 +129,31 @@ func StakeFractionChangeEntryKey(index uint64) []byte {
 func StakeFractionChangeEntryKeyIndex2(staker string, poolId uint64) []byte {
 	return util.GetByteKey(staker, poolId)
 }
+
+func InactiveValidatorEntryKey(index uint64) []byte {
+	return util.GetByteKey(index)
+}
+
+func InactiveValidatorEntryKeyIndex2(staker string) []byte {
+	return util.GetByteKey(staker)
+}
+
+func ValidatorPoolRewardsKey(staker string, poolId uint64) []byte {
+	return util.GetByteKey(staker, poolId)
+}
+
+func DelegatorPoolRewardsKey(staker string, delegator string, poolId uint64) []byte {
+	return util.GetByteKey(staker, delegator, poolId)
+}
+
+func PoolRewardHistoryKey(poolId uint64, epoch uint64) []byte {
+	return util.GetByteKey(poolId, epoch)
+}
+
+func PoolAccountStatsKey(staker string, poolId uint64) []byte {
+	return util.GetByteKey(staker, poolId)
+}
+
+func CommissionRewardsSettingsKey(staker string) []byte {
+	return util.GetByteKey(staker)
+}
@@ -56,5 +56,26 @@ func (msg *MsgJoinPool) ValidateBasic() error {
 		return errors.Wrapf(errorsTypes.ErrInvalidRequest, "invalid stake fraction")
 	}
 
+	// The commission rules are optional and default to the limits of the pool.
+	if !msg.MaxCommission.IsNil() {
+		if util.ValidatePercentage(msg.MaxCommission) != nil {
+			return errors.Wrapf(errorsTypes.ErrInvalidRequest, "invalid max commission")
+		}
+
+		if msg.Commission.GT(msg.MaxCommission) {
+			return errors.Wrapf(errorsTypes.ErrInvalidRequest, ErrCommissionExceedsMax.Error(), msg.Commission, msg.MaxCommission)
+		}
+	}
+
+	if !msg.MaxCommissionChangeRate.IsNil() {
+		if util.ValidatePercentage(msg.MaxCommissionChangeRate) != nil {
+			return errors.Wrapf(errorsTypes.ErrInvalidRequest, "invalid max commission change rate")
+		}
+
+		if !msg.MaxCommission.IsNil() && msg.MaxCommissionChangeRate.GT(msg.MaxCommission) {
+			return errors.Wrapf(errorsTypes.ErrInvalidRequest, ErrMaxChangeRateExceedsMaxCommission.Error(), msg.MaxCommissionChangeRate, msg.MaxCommission)
+		}
+	}
+
 	return nil
 }
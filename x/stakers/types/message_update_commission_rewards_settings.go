@@ -0,0 +1,63 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var (
+	_ legacytx.LegacyMsg = &MsgUpdateCommissionRewardsSettings{}
+	_ sdk.Msg            = &MsgUpdateCommissionRewardsSettings{}
+)
+
+func (msg *MsgUpdateCommissionRewardsSettings) GetSignBytes() []byte {
+	bz := Amino.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateCommissionRewardsSettings) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUpdateCommissionRewardsSettings) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateCommissionRewardsSettings) Type() string {
+	return "kyve/stakers/MsgUpdateCommissionRewardsSettings"
+}
+
+func (msg *MsgUpdateCommissionRewardsSettings) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidAddress, "invalid validator address: %s", err)
+	}
+
+	if msg.AcceptAllDenoms {
+		return nil
+	}
+
+	denoms := make(map[string]struct{})
+	for _, denom := range msg.AcceptedDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return errors.Wrapf(errorsTypes.ErrInvalidRequest, "invalid accepted denom: %s", err)
+		}
+
+		if _, ok := denoms[denom]; ok {
+			return errors.Wrapf(errorsTypes.ErrInvalidRequest, "duplicate accepted denom %s", denom)
+		}
+		denoms[denom] = struct{}{}
+	}
+
+	if _, ok := CommissionForwardDestination_name[int32(msg.ForwardDestination)]; !ok {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, "invalid forward destination %v", msg.ForwardDestination)
+	}
+
+	return nil
+}
@@ -23,6 +23,12 @@ var DefaultUploadSlash = math.LegacyMustNewDecFromStr("0.02")
 // DefaultTimeoutSlash ...
 var DefaultTimeoutSlash = math.LegacyMustNewDecFromStr("0.002")
 
+// DefaultMaxStakeFractionSum ...
+var DefaultMaxStakeFractionSum = math.LegacyZeroDec()
+
+// DefaultInactiveValidatorGracePeriod ...
+var DefaultInactiveValidatorGracePeriod = uint64(60 * 60 * 24)
+
 // NewParams creates a new Params instance
 func NewParams(
 	commissionChangeTime uint64,
@@ -31,14 +37,18 @@ func NewParams(
 	voteSlash math.LegacyDec,
 	uploadSlash math.LegacyDec,
 	timeoutSlash math.LegacyDec,
+	maxStakeFractionSum math.LegacyDec,
+	inactiveValidatorGracePeriod uint64,
 ) Params {
 	return Params{
-		CommissionChangeTime:    commissionChangeTime,
-		LeavePoolTime:           leavePoolTime,
-		StakeFractionChangeTime: stakeFractionChangeTime,
-		VoteSlash:               voteSlash,
-		UploadSlash:             uploadSlash,
-		TimeoutSlash:            timeoutSlash,
+		CommissionChangeTime:         commissionChangeTime,
+		LeavePoolTime:                leavePoolTime,
+		StakeFractionChangeTime:      stakeFractionChangeTime,
+		VoteSlash:                    voteSlash,
+		UploadSlash:                  uploadSlash,
+		TimeoutSlash:                 timeoutSlash,
+		MaxStakeFractionSum:          maxStakeFractionSum,
+		InactiveValidatorGracePeriod: inactiveValidatorGracePeriod,
 	}
 }
 
@@ -51,6 +61,8 @@ func DefaultParams() Params {
 		DefaultVoteSlash,
 		DefaultUploadSlash,
 		DefaultTimeoutSlash,
+		DefaultMaxStakeFractionSum,
+		DefaultInactiveValidatorGracePeriod,
 	)
 }
 
@@ -80,5 +92,13 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := util.ValidateDecimal(p.MaxStakeFractionSum); err != nil {
+		return err
+	}
+
+	if err := util.ValidateNumber(p.InactiveValidatorGracePeriod); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -38,6 +38,12 @@ type Params struct {
 	UploadSlash cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=upload_slash,json=uploadSlash,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"upload_slash"`
 	// timeout_slash ...
 	TimeoutSlash cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=timeout_slash,json=timeoutSlash,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"timeout_slash"`
+	// max_stake_fraction_sum is the maximum sum of stake fractions a validator
+	// is allowed to allocate across all pools. Zero means no limit.
+	MaxStakeFractionSum cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=max_stake_fraction_sum,json=maxStakeFractionSum,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_stake_fraction_sum"`
+	// inactive_validator_grace_period is the time in seconds a validator can be
+	// outside the active set before it gets removed from all pools.
+	InactiveValidatorGracePeriod uint64 `protobuf:"varint,8,opt,name=inactive_validator_grace_period,json=inactiveValidatorGracePeriod,proto3" json:"inactive_validator_grace_period,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -94,6 +100,13 @@ func (m *Params) GetStakeFractionChangeTime() uint64 {
 	return 0
 }
 
+func (m *Params) GetInactiveValidatorGracePeriod() uint64 {
+	if m != nil {
+		return m.InactiveValidatorGracePeriod
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "kyve.stakers.v1.Params")
 }
@@ -101,29 +114,33 @@ func init() {
 func init() { proto.RegisterFile("kyve/stakers/v1/params.proto", fileDescriptor_359e17165d020e84) }
 
 var fileDescriptor_359e17165d020e84 = []byte{
-	// 346 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x41, 0x4b, 0x32, 0x41,
-	0x18, 0xc7, 0x77, 0x7d, 0x7d, 0x05, 0x27, 0x45, 0x58, 0xa4, 0xc4, 0x62, 0x95, 0x82, 0xf0, 0x10,
-	0x3b, 0x48, 0xdd, 0xba, 0x59, 0x49, 0x50, 0x84, 0x58, 0x04, 0x75, 0x59, 0xc6, 0x71, 0xda, 0x1d,
-	0x76, 0xc7, 0x67, 0xd9, 0x19, 0xb7, 0xfc, 0x16, 0x7d, 0x2c, 0x8f, 0x1e, 0xa3, 0x83, 0x84, 0x7e,
-	0x8a, 0x6e, 0xb1, 0xb3, 0x4b, 0x45, 0x27, 0x6f, 0xc3, 0xfc, 0xff, 0xbf, 0xdf, 0xf3, 0xc0, 0x83,
-	0xf6, 0x82, 0x59, 0xc2, 0xb0, 0x54, 0x24, 0x60, 0xb1, 0xc4, 0x49, 0x17, 0x47, 0x24, 0x26, 0x42,
-	0x3a, 0x51, 0x0c, 0x0a, 0xac, 0x5a, 0x9a, 0x3a, 0x79, 0xea, 0x24, 0xdd, 0x66, 0xdd, 0x03, 0x0f,
-	0x74, 0x86, 0xd3, 0x57, 0x56, 0xdb, 0xff, 0x2c, 0xa0, 0xd2, 0x40, 0x73, 0xd6, 0x09, 0xda, 0xa6,
-	0x20, 0x04, 0x97, 0x92, 0xc3, 0xc4, 0xa5, 0x3e, 0x99, 0x78, 0xcc, 0x55, 0x5c, 0xb0, 0x86, 0xd9,
-	0x36, 0x3b, 0xc5, 0x61, 0xfd, 0x27, 0x3d, 0xd3, 0xe1, 0x1d, 0x17, 0xcc, 0x3a, 0x44, 0xb5, 0x90,
-	0x91, 0x84, 0xb9, 0x11, 0x40, 0x98, 0xd5, 0x0b, 0xba, 0x5e, 0xd5, 0xdf, 0x03, 0x80, 0x50, 0xf7,
-	0x4e, 0x51, 0x53, 0x2f, 0xe3, 0x3e, 0xc5, 0x84, 0xaa, 0xbf, 0x13, 0xfe, 0x69, 0x64, 0x47, 0x37,
-	0xfa, 0x79, 0xe1, 0xd7, 0x90, 0x1e, 0x42, 0x09, 0x28, 0xe6, 0xca, 0x90, 0x48, 0xbf, 0x51, 0x6c,
-	0x9b, 0x9d, 0x72, 0xef, 0x60, 0xbe, 0x6c, 0x19, 0xef, 0xcb, 0xd6, 0x2e, 0x05, 0x29, 0x40, 0xca,
-	0x71, 0xe0, 0x70, 0xc0, 0x82, 0x28, 0xdf, 0xb9, 0x66, 0x1e, 0xa1, 0xb3, 0x73, 0x46, 0x87, 0xe5,
-	0x14, 0xbb, 0x4d, 0x29, 0xab, 0x8f, 0x2a, 0xd3, 0x28, 0x04, 0x32, 0xce, 0x2d, 0xff, 0x37, 0xb7,
-	0x6c, 0x65, 0x60, 0xe6, 0xb9, 0x44, 0xd5, 0x74, 0x65, 0x98, 0xaa, 0x5c, 0x54, 0xda, 0x5c, 0x54,
-	0xc9, 0x49, 0x6d, 0xea, 0xf5, 0xe7, 0x2b, 0xdb, 0x5c, 0xac, 0x6c, 0xf3, 0x63, 0x65, 0x9b, 0xaf,
-	0x6b, 0xdb, 0x58, 0xac, 0x6d, 0xe3, 0x6d, 0x6d, 0x1b, 0x8f, 0x47, 0x1e, 0x57, 0xfe, 0x74, 0xe4,
-	0x50, 0x10, 0xf8, 0xea, 0xe1, 0xfe, 0xe2, 0x86, 0xa9, 0x67, 0x88, 0x03, 0x4c, 0x7d, 0xc2, 0x27,
-	0xf8, 0xe5, 0xfb, 0xe8, 0x6a, 0x16, 0x31, 0x39, 0x2a, 0xe9, 0x53, 0x1e, 0x7f, 0x05, 0x00, 0x00,
-	0xff, 0xff, 0x57, 0x87, 0x5e, 0x71, 0x11, 0x02, 0x00, 0x00,
+	// 410 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0x6b, 0xd4, 0x40,
+	0x14, 0xc7, 0x13, 0x5d, 0x57, 0x3b, 0xb6, 0x14, 0x62, 0xa9, 0xa1, 0x96, 0x6c, 0x51, 0x90, 0x1e,
+	0x24, 0x43, 0xd1, 0x9b, 0xb7, 0xd5, 0xae, 0x82, 0x22, 0x4b, 0x57, 0x8a, 0x7a, 0x19, 0x5e, 0x27,
+	0x63, 0x32, 0x24, 0x93, 0x17, 0x66, 0x26, 0x71, 0xf7, 0x5b, 0x78, 0xf6, 0x13, 0xf5, 0xd8, 0xa3,
+	0x78, 0x28, 0xb2, 0xfb, 0x45, 0x24, 0x93, 0xd4, 0x96, 0x9e, 0xf6, 0x96, 0xcc, 0xff, 0xf7, 0xff,
+	0xcd, 0xf0, 0x78, 0x64, 0x3f, 0x5f, 0x34, 0x82, 0x1a, 0x0b, 0xb9, 0xd0, 0x86, 0x36, 0x47, 0xb4,
+	0x02, 0x0d, 0xca, 0xc4, 0x95, 0x46, 0x8b, 0xc1, 0x76, 0x9b, 0xc6, 0x7d, 0x1a, 0x37, 0x47, 0x7b,
+	0x3b, 0x29, 0xa6, 0xe8, 0x32, 0xda, 0x7e, 0x75, 0xd8, 0xd3, 0x5f, 0x03, 0x32, 0x9c, 0xba, 0x5e,
+	0xf0, 0x8a, 0xec, 0x72, 0x54, 0x4a, 0x1a, 0x23, 0xb1, 0x64, 0x3c, 0x83, 0x32, 0x15, 0xcc, 0x4a,
+	0x25, 0x42, 0xff, 0xc0, 0x3f, 0x1c, 0x9c, 0xec, 0x5c, 0xa7, 0x6f, 0x5c, 0xf8, 0x59, 0x2a, 0x11,
+	0x3c, 0x27, 0xdb, 0x85, 0x80, 0x46, 0xb0, 0x0a, 0xb1, 0xe8, 0xf0, 0x3b, 0x0e, 0xdf, 0x72, 0xc7,
+	0x53, 0xc4, 0xc2, 0x71, 0xaf, 0xc9, 0x9e, 0x7b, 0x0c, 0xfb, 0xae, 0x81, 0xdb, 0xdb, 0x37, 0xdc,
+	0x75, 0x95, 0xc7, 0x8e, 0x98, 0xf4, 0xc0, 0x8d, 0x4b, 0xc6, 0x84, 0x34, 0x68, 0x05, 0x33, 0x05,
+	0x98, 0x2c, 0x1c, 0x1c, 0xf8, 0x87, 0x1b, 0xe3, 0x67, 0xe7, 0x97, 0x23, 0xef, 0xcf, 0xe5, 0xe8,
+	0x09, 0x47, 0xa3, 0xd0, 0x98, 0x24, 0x8f, 0x25, 0x52, 0x05, 0x36, 0x8b, 0x3f, 0x8a, 0x14, 0xf8,
+	0xe2, 0xad, 0xe0, 0x27, 0x1b, 0x6d, 0x6d, 0xd6, 0xb6, 0x82, 0x09, 0xd9, 0xac, 0xab, 0x02, 0x21,
+	0xe9, 0x2d, 0xf7, 0xd6, 0xb7, 0x3c, 0xec, 0x8a, 0x9d, 0xe7, 0x3d, 0xd9, 0x6a, 0x9f, 0x8c, 0xb5,
+	0xed, 0x45, 0xc3, 0xf5, 0x45, 0x9b, 0x7d, 0xb3, 0x33, 0x7d, 0x21, 0xbb, 0x0a, 0xe6, 0xec, 0xd6,
+	0x58, 0x4c, 0xad, 0xc2, 0xfb, 0xeb, 0x2b, 0x1f, 0x29, 0x98, 0xcf, 0x6e, 0x8e, 0x6d, 0x56, 0xab,
+	0xe0, 0x98, 0x8c, 0x64, 0xd9, 0xfe, 0x36, 0x82, 0x35, 0x50, 0xc8, 0x04, 0x2c, 0x6a, 0x96, 0x6a,
+	0xe0, 0x82, 0x55, 0x42, 0x4b, 0x4c, 0xc2, 0x07, 0x6e, 0xe2, 0xfb, 0x57, 0xd8, 0xe9, 0x15, 0xf5,
+	0xae, 0x85, 0xa6, 0x8e, 0x19, 0x4f, 0xce, 0x97, 0x91, 0x7f, 0xb1, 0x8c, 0xfc, 0xbf, 0xcb, 0xc8,
+	0xff, 0xb9, 0x8a, 0xbc, 0x8b, 0x55, 0xe4, 0xfd, 0x5e, 0x45, 0xde, 0xb7, 0x17, 0xa9, 0xb4, 0x59,
+	0x7d, 0x16, 0x73, 0x54, 0xf4, 0xc3, 0xd7, 0xd3, 0xe3, 0x4f, 0xc2, 0xfe, 0x40, 0x9d, 0x53, 0x9e,
+	0x81, 0x2c, 0xe9, 0xfc, 0xff, 0x56, 0xda, 0x45, 0x25, 0xcc, 0xd9, 0xd0, 0xed, 0xda, 0xcb, 0x7f,
+	0x03, 0x00, 0x80, 0x83, 0xe7, 0x26, 0xb2, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -146,6 +163,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.InactiveValidatorGracePeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.InactiveValidatorGracePeriod))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.MaxStakeFractionSum.Size()
+		i -= size
+		if _, err := m.MaxStakeFractionSum.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
 	{
 		size := m.TimeoutSlash.Size()
 		i -= size
@@ -226,6 +258,11 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovParams(uint64(l))
 	l = m.TimeoutSlash.Size()
 	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxStakeFractionSum.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.InactiveValidatorGracePeriod != 0 {
+		n += 1 + sovParams(uint64(m.InactiveValidatorGracePeriod))
+	}
 	return n
 }
 
@@ -423,6 +460,59 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStakeFractionSum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxStakeFractionSum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InactiveValidatorGracePeriod", wireType)
+			}
+			m.InactiveValidatorGracePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InactiveValidatorGracePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
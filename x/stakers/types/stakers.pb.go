@@ -27,6 +27,36 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// CommissionForwardDestination ...
+type CommissionForwardDestination int32
+
+const (
+	// COMMISSION_FORWARD_DESTINATION_COMMUNITY_POOL forwards the unaccepted
+	// denoms to the community pool
+	COMMISSION_FORWARD_DESTINATION_COMMUNITY_POOL CommissionForwardDestination = 0
+	// COMMISSION_FORWARD_DESTINATION_POOL forwards the unaccepted denoms to
+	// the account of the forward pool, where they are used as rewards
+	COMMISSION_FORWARD_DESTINATION_POOL CommissionForwardDestination = 1
+)
+
+var CommissionForwardDestination_name = map[int32]string{
+	0: "COMMISSION_FORWARD_DESTINATION_COMMUNITY_POOL",
+	1: "COMMISSION_FORWARD_DESTINATION_POOL",
+}
+
+var CommissionForwardDestination_value = map[string]int32{
+	"COMMISSION_FORWARD_DESTINATION_COMMUNITY_POOL": 0,
+	"COMMISSION_FORWARD_DESTINATION_POOL":           1,
+}
+
+func (x CommissionForwardDestination) String() string {
+	return proto.EnumName(CommissionForwardDestination_name, int32(x))
+}
+
+func (CommissionForwardDestination) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4a43c1df37c9604e, []int{0}
+}
+
 // SlashType ...
 type SlashType int32
 
@@ -60,7 +90,7 @@ func (x SlashType) String() string {
 }
 
 func (SlashType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_4a43c1df37c9604e, []int{0}
+	return fileDescriptor_4a43c1df37c9604e, []int{1}
 }
 
 // deprecated
@@ -192,6 +222,15 @@ type PoolAccount struct {
 	Commission cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=commission,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"commission"`
 	// stake_fraction ...
 	StakeFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=stake_fraction,json=stakeFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"stake_fraction"`
+	// max_commission is the highest commission the validator
+	// can ever charge in this pool. It is fixed when joining the pool.
+	MaxCommission cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=max_commission,json=maxCommission,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_commission"`
+	// max_commission_change_rate is the maximum amount the commission
+	// can be changed by within one day. It is fixed when joining the pool.
+	MaxCommissionChangeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=max_commission_change_rate,json=maxCommissionChangeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_commission_change_rate"`
+	// commission_last_changed is the UNIX-timestamp in seconds
+	// when the last commission change was applied.
+	CommissionLastChanged int64 `protobuf:"varint,10,opt,name=commission_last_changed,json=commissionLastChanged,proto3" json:"commission_last_changed,omitempty"`
 }
 
 func (m *PoolAccount) Reset()         { *m = PoolAccount{} }
@@ -262,6 +301,13 @@ func (m *PoolAccount) GetIsLeaving() bool {
 	return false
 }
 
+func (m *PoolAccount) GetCommissionLastChanged() int64 {
+	if m != nil {
+		return m.CommissionLastChanged
+	}
+	return 0
+}
+
 // CommissionChangeEntry stores the information for an
 // upcoming commission change. A commission change is never
 // instant, so delegators have time to redelegate in case
@@ -503,29 +549,33 @@ func (m *LeavePoolEntry) GetCreationDate() int64 {
 	return 0
 }
 
-// UnbondingState stores the state for the unbonding of stakes and delegations.
-type QueueState struct {
-	// low_index is the tail of the queue. It is the
-	// oldest entry in the queue. If this entry isn't
-	// due, non of the other entries is.
-	LowIndex uint64 `protobuf:"varint,1,opt,name=low_index,json=lowIndex,proto3" json:"low_index,omitempty"`
-	// high_index is the head of the queue. New entries
-	// are added to the top.
-	HighIndex uint64 `protobuf:"varint,2,opt,name=high_index,json=highIndex,proto3" json:"high_index,omitempty"`
+// InactiveValidatorEntry stores the information for a validator
+// which has left the active set. If the validator does not return
+// to the active set within the `InactiveValidatorGracePeriod` it
+// gets removed from all pools through the leave pool queue.
+type InactiveValidatorEntry struct {
+	// index is needed for the queue-algorithm which
+	// processes the inactive validators
+	Index uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// staker is the address of the affected staker
+	Staker string `protobuf:"bytes,2,opt,name=staker,proto3" json:"staker,omitempty"`
+	// creation_date is the UNIX-timestamp in seconds
+	// when the validator left the active set.
+	CreationDate int64 `protobuf:"varint,3,opt,name=creation_date,json=creationDate,proto3" json:"creation_date,omitempty"`
 }
 
-func (m *QueueState) Reset()         { *m = QueueState{} }
-func (m *QueueState) String() string { return proto.CompactTextString(m) }
-func (*QueueState) ProtoMessage()    {}
-func (*QueueState) Descriptor() ([]byte, []int) {
+func (m *InactiveValidatorEntry) Reset()         { *m = InactiveValidatorEntry{} }
+func (m *InactiveValidatorEntry) String() string { return proto.CompactTextString(m) }
+func (*InactiveValidatorEntry) ProtoMessage()    {}
+func (*InactiveValidatorEntry) Descriptor() ([]byte, []int) {
 	return fileDescriptor_4a43c1df37c9604e, []int{5}
 }
-func (m *QueueState) XXX_Unmarshal(b []byte) error {
+func (m *InactiveValidatorEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueueState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *InactiveValidatorEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueueState.Marshal(b, m, deterministic)
+		return xxx_messageInfo_InactiveValidatorEntry.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -535,624 +585,2901 @@ func (m *QueueState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *QueueState) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueueState.Merge(m, src)
+func (m *InactiveValidatorEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InactiveValidatorEntry.Merge(m, src)
 }
-func (m *QueueState) XXX_Size() int {
+func (m *InactiveValidatorEntry) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueueState) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueueState.DiscardUnknown(m)
+func (m *InactiveValidatorEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_InactiveValidatorEntry.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueueState proto.InternalMessageInfo
+var xxx_messageInfo_InactiveValidatorEntry proto.InternalMessageInfo
 
-func (m *QueueState) GetLowIndex() uint64 {
+func (m *InactiveValidatorEntry) GetIndex() uint64 {
 	if m != nil {
-		return m.LowIndex
+		return m.Index
 	}
 	return 0
 }
 
-func (m *QueueState) GetHighIndex() uint64 {
+func (m *InactiveValidatorEntry) GetStaker() string {
 	if m != nil {
-		return m.HighIndex
+		return m.Staker
 	}
-	return 0
+	return ""
 }
 
-func init() {
-	proto.RegisterEnum("kyve.stakers.v1.SlashType", SlashType_name, SlashType_value)
-	proto.RegisterType((*Staker)(nil), "kyve.stakers.v1.Staker")
-	proto.RegisterType((*PoolAccount)(nil), "kyve.stakers.v1.PoolAccount")
-	proto.RegisterType((*CommissionChangeEntry)(nil), "kyve.stakers.v1.CommissionChangeEntry")
-	proto.RegisterType((*StakeFractionChangeEntry)(nil), "kyve.stakers.v1.StakeFractionChangeEntry")
-	proto.RegisterType((*LeavePoolEntry)(nil), "kyve.stakers.v1.LeavePoolEntry")
-	proto.RegisterType((*QueueState)(nil), "kyve.stakers.v1.QueueState")
+func (m *InactiveValidatorEntry) GetCreationDate() int64 {
+	if m != nil {
+		return m.CreationDate
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("kyve/stakers/v1/stakers.proto", fileDescriptor_4a43c1df37c9604e) }
+// ValidatorPoolRewards stores the cumulative protocol rewards a validator
+// has received for a single pool.
+type ValidatorPoolRewards struct {
+	// staker is the address of the validator
+	Staker string `protobuf:"bytes,1,opt,name=staker,proto3" json:"staker,omitempty"`
+	// pool_id ...
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// reward_index is the cumulative amount of delegation rewards
+	// paid out per delegator share of the validator.
+	RewardIndex github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=reward_index,json=rewardIndex,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"reward_index"`
+	// delegation_rewards is the total amount of rewards paid
+	// out to the delegators of the validator in this pool.
+	DelegationRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=delegation_rewards,json=delegationRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"delegation_rewards"`
+	// commission_rewards is the total amount of rewards paid
+	// out to the validator as commission in this pool.
+	CommissionRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=commission_rewards,json=commissionRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"commission_rewards"`
+}
 
-var fileDescriptor_4a43c1df37c9604e = []byte{
-	// 759 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x4f, 0x6f, 0xda, 0x48,
-	0x14, 0xc7, 0x40, 0xf8, 0x33, 0xf9, 0x47, 0x66, 0x13, 0xd6, 0x4b, 0x14, 0x27, 0x4b, 0x2e, 0xd9,
-	0x68, 0xd7, 0x16, 0xbb, 0xda, 0x0f, 0x40, 0x80, 0x28, 0xec, 0xb2, 0x09, 0x6b, 0x48, 0xa4, 0xf4,
-	0x62, 0x0d, 0xf6, 0x14, 0x46, 0x80, 0x87, 0x7a, 0x06, 0x08, 0x52, 0xa5, 0x5e, 0x7b, 0xec, 0x77,
-	0xe8, 0xa5, 0xed, 0xa9, 0x1f, 0x23, 0xc7, 0xa8, 0xa7, 0xaa, 0x87, 0xb4, 0x4a, 0x0e, 0xfd, 0x14,
-	0x95, 0xaa, 0x19, 0x9b, 0xc4, 0x69, 0x5a, 0x29, 0x8a, 0xda, 0x0b, 0xcc, 0xef, 0xf7, 0xf3, 0x7b,
-	0x6f, 0xde, 0xef, 0x59, 0xcf, 0x60, 0xad, 0x3b, 0x19, 0x61, 0x83, 0x71, 0xd4, 0xc5, 0x1e, 0x33,
-	0x46, 0x85, 0xe9, 0x51, 0x1f, 0x78, 0x94, 0x53, 0xb8, 0x28, 0x64, 0x7d, 0xca, 0x8d, 0x0a, 0xb9,
-	0x25, 0xd4, 0x27, 0x2e, 0x35, 0xe4, 0xaf, 0xff, 0x4c, 0x4e, 0xb3, 0x29, 0xeb, 0x53, 0x66, 0xb4,
-	0x10, 0xc3, 0xc6, 0xa8, 0xd0, 0xc2, 0x1c, 0x15, 0x0c, 0x9b, 0x12, 0x37, 0xd0, 0x97, 0xdb, 0xb4,
-	0x4d, 0xe5, 0xd1, 0x10, 0x27, 0x9f, 0xcd, 0x7f, 0x8a, 0x82, 0x44, 0x43, 0xe6, 0x85, 0x2a, 0x48,
-	0x22, 0xc7, 0xf1, 0x30, 0x63, 0xaa, 0xb2, 0xa1, 0x6c, 0xa5, 0xcd, 0x29, 0x84, 0x25, 0x00, 0x6c,
-	0xda, 0xef, 0x13, 0xc6, 0x08, 0x75, 0xd5, 0xa8, 0x10, 0x77, 0x36, 0x4f, 0xcf, 0xd7, 0x23, 0xef,
-	0xce, 0xd7, 0x57, 0xfd, 0xb2, 0xcc, 0xe9, 0xea, 0x84, 0x1a, 0x7d, 0xc4, 0x3b, 0x7a, 0x0d, 0xb7,
-	0x91, 0x3d, 0x29, 0x63, 0xdb, 0x0c, 0x85, 0x89, 0xf4, 0x7d, 0xea, 0x92, 0x2e, 0xf6, 0xd4, 0x98,
-	0x9f, 0x3e, 0x80, 0x42, 0x19, 0xe3, 0x16, 0x23, 0x1c, 0xab, 0x71, 0x5f, 0x09, 0x20, 0xcc, 0x81,
-	0x14, 0x71, 0xb0, 0xcb, 0x09, 0x9f, 0xa8, 0x33, 0x52, 0xba, 0xc2, 0xf0, 0x37, 0x90, 0x61, 0xd8,
-	0x1e, 0x7a, 0x84, 0x4f, 0x2c, 0x9b, 0xba, 0x1c, 0xd9, 0x5c, 0x4d, 0xc8, 0x67, 0x16, 0xa7, 0x7c,
-	0xc9, 0xa7, 0x45, 0x01, 0x07, 0x73, 0x44, 0x7a, 0x4c, 0x4d, 0xfa, 0x05, 0x02, 0x08, 0x9f, 0x00,
-	0x78, 0x7d, 0x45, 0xcb, 0xc3, 0x63, 0xe4, 0x39, 0x4c, 0x4d, 0x6d, 0xc4, 0xb6, 0x66, 0xff, 0xfc,
-	0x45, 0xf7, 0x5b, 0xd3, 0x85, 0xa3, 0x7a, 0xe0, 0xa8, 0x5e, 0xa2, 0xc4, 0xdd, 0xf9, 0x5b, 0x34,
-	0xff, 0xea, 0xfd, 0xfa, 0x56, 0x9b, 0xf0, 0xce, 0xb0, 0xa5, 0xdb, 0xb4, 0x6f, 0x04, 0xf6, 0xfb,
-	0x7f, 0x7f, 0x30, 0xa7, 0x6b, 0xf0, 0xc9, 0x00, 0x33, 0x19, 0xc0, 0x5e, 0x7c, 0x7c, 0xbd, 0xad,
-	0x98, 0x4b, 0xd7, 0xb5, 0x4c, 0xbf, 0x54, 0xfe, 0x65, 0x14, 0xcc, 0xd6, 0x29, 0xed, 0x15, 0x6d,
-	0x9b, 0x0e, 0x5d, 0x0e, 0x7f, 0x06, 0xc9, 0x01, 0xa5, 0x3d, 0x8b, 0x38, 0x72, 0x08, 0x71, 0x33,
-	0x21, 0x60, 0xd5, 0x81, 0x59, 0x90, 0xf0, 0xe7, 0xef, 0xfb, 0x6f, 0x06, 0x08, 0xfe, 0x0a, 0xe6,
-	0x64, 0xc0, 0x74, 0x74, 0xbe, 0xb7, 0xb3, 0x82, 0x2b, 0x06, 0xe3, 0xcb, 0x82, 0xc4, 0x80, 0x12,
-	0x97, 0x33, 0x69, 0xaf, 0x4c, 0x29, 0x10, 0x5c, 0x03, 0x80, 0x30, 0xab, 0x87, 0xd1, 0x88, 0xb8,
-	0x6d, 0xe9, 0x6f, 0xca, 0x4c, 0x13, 0x56, 0xf3, 0x89, 0x2f, 0xa6, 0x9e, 0xb8, 0xdf, 0xd4, 0xff,
-	0x01, 0x0b, 0xf2, 0xa2, 0xd6, 0x43, 0x0f, 0xd9, 0x5c, 0x24, 0x4a, 0xde, 0x3d, 0xd1, 0xbc, 0x0c,
-	0xdd, 0x0d, 0x22, 0xf3, 0xa7, 0x0a, 0x58, 0x29, 0x5d, 0xa5, 0x2e, 0x75, 0x90, 0xdb, 0xc6, 0x15,
-	0x97, 0x7b, 0x13, 0xb8, 0x0c, 0x66, 0x88, 0xeb, 0xe0, 0x93, 0xc0, 0x33, 0x1f, 0x7c, 0xd3, 0xb2,
-	0x90, 0xc7, 0xb1, 0x1b, 0x1e, 0xdf, 0xec, 0x38, 0x7e, 0xbf, 0x8e, 0x37, 0xc1, 0xbc, 0xed, 0x61,
-	0x24, 0x6e, 0x6c, 0x39, 0x88, 0x63, 0x69, 0x6c, 0xcc, 0x9c, 0x9b, 0x92, 0x65, 0xc4, 0x71, 0xfe,
-	0x8d, 0x02, 0xd4, 0x46, 0xb8, 0xb9, 0x1f, 0xd0, 0xcd, 0x6d, 0xeb, 0xe3, 0xf7, 0xb5, 0xfe, 0x6e,
-	0x4d, 0x3d, 0x06, 0x0b, 0xe2, 0xdd, 0xc1, 0xe2, 0x7d, 0xfe, 0xae, 0x9d, 0xdc, 0xaa, 0x1e, 0xff,
-	0x4a, 0xf5, 0x3d, 0x00, 0xfe, 0x1f, 0xe2, 0x21, 0x6e, 0x70, 0xc4, 0x31, 0x5c, 0x05, 0xe9, 0x1e,
-	0x1d, 0x5b, 0xe1, 0xea, 0xa9, 0x1e, 0x1d, 0x57, 0xe5, 0x05, 0xd6, 0x00, 0xe8, 0x90, 0x76, 0x27,
-	0x50, 0xa3, 0x52, 0x4d, 0x0b, 0x46, 0xca, 0xdb, 0x8f, 0x40, 0xba, 0xd1, 0x43, 0xac, 0xd3, 0x9c,
-	0x0c, 0xc4, 0x0a, 0xca, 0x36, 0x6a, 0xc5, 0xc6, 0x9e, 0xd5, 0x3c, 0xae, 0x57, 0xac, 0xc3, 0xfd,
-	0x46, 0xbd, 0x52, 0xaa, 0xee, 0x56, 0x2b, 0xe5, 0x4c, 0x04, 0x66, 0x01, 0x0c, 0x69, 0xcd, 0xea,
-	0x7f, 0x95, 0x83, 0xc3, 0x66, 0x46, 0x81, 0x3f, 0x81, 0xc5, 0x10, 0x7f, 0x74, 0xd0, 0xac, 0x64,
-	0xa2, 0x70, 0x05, 0x2c, 0x85, 0x13, 0xd5, 0x6b, 0x07, 0xc5, 0x72, 0x26, 0x96, 0x8b, 0x3f, 0x7d,
-	0xae, 0x45, 0x76, 0x76, 0x4f, 0x2f, 0x34, 0xe5, 0xec, 0x42, 0x53, 0x3e, 0x5c, 0x68, 0xca, 0xb3,
-	0x4b, 0x2d, 0x72, 0x76, 0xa9, 0x45, 0xde, 0x5e, 0x6a, 0x91, 0x07, 0xbf, 0x87, 0x56, 0xcc, 0xbf,
-	0xc7, 0x47, 0x95, 0x7d, 0xcc, 0xc7, 0xd4, 0xeb, 0x1a, 0x76, 0x07, 0x11, 0xd7, 0x38, 0xb9, 0xfa,
-	0x66, 0xc8, 0x65, 0xd3, 0x4a, 0xc8, 0xad, 0xfe, 0xd7, 0xe7, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1e,
-	0x1a, 0x18, 0xaf, 0x50, 0x06, 0x00, 0x00,
+func (m *ValidatorPoolRewards) Reset()         { *m = ValidatorPoolRewards{} }
+func (m *ValidatorPoolRewards) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPoolRewards) ProtoMessage()    {}
+func (*ValidatorPoolRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a43c1df37c9604e, []int{6}
+}
+func (m *ValidatorPoolRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPoolRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPoolRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorPoolRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPoolRewards.Merge(m, src)
+}
+func (m *ValidatorPoolRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPoolRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPoolRewards.DiscardUnknown(m)
 }
 
-func (m *Staker) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ValidatorPoolRewards proto.InternalMessageInfo
+
+func (m *ValidatorPoolRewards) GetStaker() string {
+	if m != nil {
+		return m.Staker
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Staker) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ValidatorPoolRewards) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
 }
 
-func (m *Staker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CommissionRewards) > 0 {
-		for iNdEx := len(m.CommissionRewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CommissionRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintStakers(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x42
-		}
+func (m *ValidatorPoolRewards) GetRewardIndex() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.RewardIndex
 	}
-	if len(m.Details) > 0 {
-		i -= len(m.Details)
-		copy(dAtA[i:], m.Details)
-		i = encodeVarintStakers(dAtA, i, uint64(len(m.Details)))
-		i--
-		dAtA[i] = 0x3a
+	return nil
+}
+
+func (m *ValidatorPoolRewards) GetDelegationRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DelegationRewards
 	}
-	if len(m.SecurityContact) > 0 {
-		i -= len(m.SecurityContact)
-		copy(dAtA[i:], m.SecurityContact)
-		i = encodeVarintStakers(dAtA, i, uint64(len(m.SecurityContact)))
-		i--
-		dAtA[i] = 0x32
+	return nil
+}
+
+func (m *ValidatorPoolRewards) GetCommissionRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CommissionRewards
 	}
-	if len(m.Identity) > 0 {
-		i -= len(m.Identity)
-		copy(dAtA[i:], m.Identity)
-		i = encodeVarintStakers(dAtA, i, uint64(len(m.Identity)))
-		i--
-		dAtA[i] = 0x2a
+	return nil
+}
+
+// DelegatorPoolRewards stores the protocol rewards a delegator
+// has accrued in a single pool through a validator.
+type DelegatorPoolRewards struct {
+	// delegator is the address of the delegator
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// staker is the address of the validator
+	Staker string `protobuf:"bytes,2,opt,name=staker,proto3" json:"staker,omitempty"`
+	// pool_id ...
+	PoolId uint64 `protobuf:"varint,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// reward_index is the reward index of the validator in
+	// this pool at the time the rewards were last settled.
+	RewardIndex github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,4,rep,name=reward_index,json=rewardIndex,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"reward_index"`
+	// accrued_rewards are the rewards the delegator has accrued
+	// until the rewards were last settled.
+	AccruedRewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,5,rep,name=accrued_rewards,json=accruedRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"accrued_rewards"`
+}
+
+func (m *DelegatorPoolRewards) Reset()         { *m = DelegatorPoolRewards{} }
+func (m *DelegatorPoolRewards) String() string { return proto.CompactTextString(m) }
+func (*DelegatorPoolRewards) ProtoMessage()    {}
+func (*DelegatorPoolRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a43c1df37c9604e, []int{7}
+}
+func (m *DelegatorPoolRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegatorPoolRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegatorPoolRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Website) > 0 {
-		i -= len(m.Website)
-		copy(dAtA[i:], m.Website)
-		i = encodeVarintStakers(dAtA, i, uint64(len(m.Website)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *DelegatorPoolRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatorPoolRewards.Merge(m, src)
+}
+func (m *DelegatorPoolRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegatorPoolRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatorPoolRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegatorPoolRewards proto.InternalMessageInfo
+
+func (m *DelegatorPoolRewards) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
 	}
-	if len(m.Moniker) > 0 {
-		i -= len(m.Moniker)
-		copy(dAtA[i:], m.Moniker)
-		i = encodeVarintStakers(dAtA, i, uint64(len(m.Moniker)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *DelegatorPoolRewards) GetStaker() string {
+	if m != nil {
+		return m.Staker
 	}
-	{
-		size := m.Commission.Size()
-		i -= size
-		if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintStakers(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *DelegatorPoolRewards) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintStakers(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *DelegatorPoolRewards) GetRewardIndex() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.RewardIndex
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *PoolAccount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DelegatorPoolRewards) GetAccruedRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.AccruedRewards
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *PoolAccount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// PoolRewardHistoryEntry stores the delegation rewards which were paid
+// out in a pool during a single reward history epoch.
+type PoolRewardHistoryEntry struct {
+	// pool_id ...
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// epoch is the number of the epoch since the UNIX-epoch
+	Epoch uint64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// delegation_rewards is the total amount of rewards paid out
+	// to delegators in this pool during the epoch.
+	DelegationRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=delegation_rewards,json=delegationRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"delegation_rewards"`
+	// pool_stake is the total stake of the pool at the time of
+	// the last payout during the epoch.
+	PoolStake uint64 `protobuf:"varint,4,opt,name=pool_stake,json=poolStake,proto3" json:"pool_stake,omitempty"`
 }
 
-func (m *PoolAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.StakeFraction.Size()
-		i -= size
-		if _, err := m.StakeFraction.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *PoolRewardHistoryEntry) Reset()         { *m = PoolRewardHistoryEntry{} }
+func (m *PoolRewardHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*PoolRewardHistoryEntry) ProtoMessage()    {}
+func (*PoolRewardHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a43c1df37c9604e, []int{8}
+}
+func (m *PoolRewardHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolRewardHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolRewardHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintStakers(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.Commission.Size()
-		i -= size
-		if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintStakers(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	if m.IsLeaving {
-		i--
-		if m.IsLeaving {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.Points != 0 {
-		i = encodeVarintStakers(dAtA, i, uint64(m.Points))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.PoolAddress) > 0 {
-		i -= len(m.PoolAddress)
-		copy(dAtA[i:], m.PoolAddress)
-		i = encodeVarintStakers(dAtA, i, uint64(len(m.PoolAddress)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *PoolRewardHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolRewardHistoryEntry.Merge(m, src)
+}
+func (m *PoolRewardHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolRewardHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolRewardHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolRewardHistoryEntry proto.InternalMessageInfo
+
+func (m *PoolRewardHistoryEntry) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	if len(m.Staker) > 0 {
-		i -= len(m.Staker)
-		copy(dAtA[i:], m.Staker)
-		i = encodeVarintStakers(dAtA, i, uint64(len(m.Staker)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *PoolRewardHistoryEntry) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintStakers(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *PoolRewardHistoryEntry) GetDelegationRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DelegationRewards
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *CommissionChangeEntry) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PoolRewardHistoryEntry) GetPoolStake() uint64 {
+	if m != nil {
+		return m.PoolStake
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *CommissionChangeEntry) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// PoolAccountStats stores the protocol performance of a staker in
+// a single pool. The counters are kept when the staker leaves the pool
+// so that the reputation is restored once the staker joins again.
+type PoolAccountStats struct {
+	// staker is the address of the validator
+	Staker string `protobuf:"bytes,1,opt,name=staker,proto3" json:"staker,omitempty"`
+	// pool_id ...
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// bundles_uploaded is the number of bundle proposals of
+	// the staker which were evaluated.
+	BundlesUploaded uint64 `protobuf:"varint,3,opt,name=bundles_uploaded,json=bundlesUploaded,proto3" json:"bundles_uploaded,omitempty"`
+	// bundles_finalized is the number of bundle proposals of
+	// the staker which were voted valid and got finalized.
+	BundlesFinalized uint64 `protobuf:"varint,4,opt,name=bundles_finalized,json=bundlesFinalized,proto3" json:"bundles_finalized,omitempty"`
+	// votes_valid is the number of valid votes cast on
+	// evaluated bundle proposals.
+	VotesValid uint64 `protobuf:"varint,5,opt,name=votes_valid,json=votesValid,proto3" json:"votes_valid,omitempty"`
+	// votes_invalid is the number of invalid votes cast on
+	// evaluated bundle proposals.
+	VotesInvalid uint64 `protobuf:"varint,6,opt,name=votes_invalid,json=votesInvalid,proto3" json:"votes_invalid,omitempty"`
+	// votes_abstain is the number of abstain votes cast on
+	// evaluated bundle proposals.
+	VotesAbstain uint64 `protobuf:"varint,7,opt,name=votes_abstain,json=votesAbstain,proto3" json:"votes_abstain,omitempty"`
+	// votes_agreed is the number of valid and invalid votes
+	// which agreed with the final outcome of the bundle proposal.
+	VotesAgreed uint64 `protobuf:"varint,8,opt,name=votes_agreed,json=votesAgreed,proto3" json:"votes_agreed,omitempty"`
+	// timeouts is the number of upload timeouts of the staker.
+	Timeouts uint64 `protobuf:"varint,9,opt,name=timeouts,proto3" json:"timeouts,omitempty"`
+	// slashes is the number of times the staker got slashed
+	// in this pool.
+	Slashes uint64 `protobuf:"varint,10,opt,name=slashes,proto3" json:"slashes,omitempty"`
+	// missed_votes is the number of evaluated bundle proposals
+	// the staker did not vote on.
+	MissedVotes uint64 `protobuf:"varint,11,opt,name=missed_votes,json=missedVotes,proto3" json:"missed_votes,omitempty"`
 }
 
-func (m *CommissionChangeEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CreationDate != 0 {
-		i = encodeVarintStakers(dAtA, i, uint64(m.CreationDate))
-		i--
-		dAtA[i] = 0x28
-	}
-	{
-		size := m.Commission.Size()
-		i -= size
-		if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *PoolAccountStats) Reset()         { *m = PoolAccountStats{} }
+func (m *PoolAccountStats) String() string { return proto.CompactTextString(m) }
+func (*PoolAccountStats) ProtoMessage()    {}
+func (*PoolAccountStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a43c1df37c9604e, []int{9}
+}
+func (m *PoolAccountStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolAccountStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolAccountStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintStakers(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if m.PoolId != 0 {
-		i = encodeVarintStakers(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Staker) > 0 {
-		i -= len(m.Staker)
-		copy(dAtA[i:], m.Staker)
-		i = encodeVarintStakers(dAtA, i, uint64(len(m.Staker)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Index != 0 {
-		i = encodeVarintStakers(dAtA, i, uint64(m.Index))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *PoolAccountStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolAccountStats.Merge(m, src)
+}
+func (m *PoolAccountStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolAccountStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolAccountStats.DiscardUnknown(m)
 }
 
-func (m *StakeFractionChangeEntry) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PoolAccountStats proto.InternalMessageInfo
+
+func (m *PoolAccountStats) GetStaker() string {
+	if m != nil {
+		return m.Staker
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *StakeFractionChangeEntry) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PoolAccountStats) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
 }
 
-func (m *StakeFractionChangeEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CreationDate != 0 {
-		i = encodeVarintStakers(dAtA, i, uint64(m.CreationDate))
-		i--
-		dAtA[i] = 0x28
-	}
-	{
-		size := m.StakeFraction.Size()
-		i -= size
-		if _, err := m.StakeFraction.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintStakers(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if m.PoolId != 0 {
-		i = encodeVarintStakers(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Staker) > 0 {
-		i -= len(m.Staker)
-		copy(dAtA[i:], m.Staker)
-		i = encodeVarintStakers(dAtA, i, uint64(len(m.Staker)))
-		i--
-		dAtA[i] = 0x12
+func (m *PoolAccountStats) GetBundlesUploaded() uint64 {
+	if m != nil {
+		return m.BundlesUploaded
 	}
-	if m.Index != 0 {
-		i = encodeVarintStakers(dAtA, i, uint64(m.Index))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *PoolAccountStats) GetBundlesFinalized() uint64 {
+	if m != nil {
+		return m.BundlesFinalized
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *LeavePoolEntry) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PoolAccountStats) GetVotesValid() uint64 {
+	if m != nil {
+		return m.VotesValid
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *LeavePoolEntry) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PoolAccountStats) GetVotesInvalid() uint64 {
+	if m != nil {
+		return m.VotesInvalid
+	}
+	return 0
 }
 
-func (m *LeavePoolEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CreationDate != 0 {
-		i = encodeVarintStakers(dAtA, i, uint64(m.CreationDate))
-		i--
-		dAtA[i] = 0x20
+func (m *PoolAccountStats) GetVotesAbstain() uint64 {
+	if m != nil {
+		return m.VotesAbstain
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintStakers(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x18
+	return 0
+}
+
+func (m *PoolAccountStats) GetVotesAgreed() uint64 {
+	if m != nil {
+		return m.VotesAgreed
 	}
-	if len(m.Staker) > 0 {
-		i -= len(m.Staker)
-		copy(dAtA[i:], m.Staker)
-		i = encodeVarintStakers(dAtA, i, uint64(len(m.Staker)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *PoolAccountStats) GetTimeouts() uint64 {
+	if m != nil {
+		return m.Timeouts
 	}
-	if m.Index != 0 {
-		i = encodeVarintStakers(dAtA, i, uint64(m.Index))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *PoolAccountStats) GetSlashes() uint64 {
+	if m != nil {
+		return m.Slashes
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueueState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PoolAccountStats) GetMissedVotes() uint64 {
+	if m != nil {
+		return m.MissedVotes
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueueState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// CommissionRewardsSettings specifies which denoms a validator accepts as
+// commission rewards from the protocol. All other denoms are forwarded to the
+// forward destination. If a validator has no settings, all denoms are accepted.
+type CommissionRewardsSettings struct {
+	// staker is the address of the validator
+	Staker string `protobuf:"bytes,1,opt,name=staker,proto3" json:"staker,omitempty"`
+	// accepted_denoms are the denoms the validator accepts as commission
+	// rewards. The native denom is always accepted.
+	AcceptedDenoms []string `protobuf:"bytes,2,rep,name=accepted_denoms,json=acceptedDenoms,proto3" json:"accepted_denoms,omitempty"`
+	// forward_destination is the destination of the unaccepted denoms
+	ForwardDestination CommissionForwardDestination `protobuf:"varint,3,opt,name=forward_destination,json=forwardDestination,proto3,enum=kyve.stakers.v1.CommissionForwardDestination" json:"forward_destination,omitempty"`
+	// forward_pool_id is the pool which receives the unaccepted denoms
+	// if the forward destination is a pool
+	ForwardPoolId uint64 `protobuf:"varint,4,opt,name=forward_pool_id,json=forwardPoolId,proto3" json:"forward_pool_id,omitempty"`
 }
 
-func (m *QueueState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.HighIndex != 0 {
-		i = encodeVarintStakers(dAtA, i, uint64(m.HighIndex))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.LowIndex != 0 {
-		i = encodeVarintStakers(dAtA, i, uint64(m.LowIndex))
-		i--
-		dAtA[i] = 0x8
+func (m *CommissionRewardsSettings) Reset()         { *m = CommissionRewardsSettings{} }
+func (m *CommissionRewardsSettings) String() string { return proto.CompactTextString(m) }
+func (*CommissionRewardsSettings) ProtoMessage()    {}
+func (*CommissionRewardsSettings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a43c1df37c9604e, []int{10}
+}
+func (m *CommissionRewardsSettings) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommissionRewardsSettings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommissionRewardsSettings.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *CommissionRewardsSettings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommissionRewardsSettings.Merge(m, src)
+}
+func (m *CommissionRewardsSettings) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommissionRewardsSettings) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommissionRewardsSettings.DiscardUnknown(m)
 }
 
-func encodeVarintStakers(dAtA []byte, offset int, v uint64) int {
-	offset -= sovStakers(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_CommissionRewardsSettings proto.InternalMessageInfo
+
+func (m *CommissionRewardsSettings) GetStaker() string {
+	if m != nil {
+		return m.Staker
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *Staker) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovStakers(uint64(l))
-	}
-	l = m.Commission.Size()
-	n += 1 + l + sovStakers(uint64(l))
-	l = len(m.Moniker)
-	if l > 0 {
-		n += 1 + l + sovStakers(uint64(l))
-	}
-	l = len(m.Website)
-	if l > 0 {
-		n += 1 + l + sovStakers(uint64(l))
-	}
-	l = len(m.Identity)
-	if l > 0 {
-		n += 1 + l + sovStakers(uint64(l))
+
+func (m *CommissionRewardsSettings) GetAcceptedDenoms() []string {
+	if m != nil {
+		return m.AcceptedDenoms
 	}
-	l = len(m.SecurityContact)
-	if l > 0 {
-		n += 1 + l + sovStakers(uint64(l))
+	return nil
+}
+
+func (m *CommissionRewardsSettings) GetForwardDestination() CommissionForwardDestination {
+	if m != nil {
+		return m.ForwardDestination
 	}
-	l = len(m.Details)
-	if l > 0 {
-		n += 1 + l + sovStakers(uint64(l))
+	return COMMISSION_FORWARD_DESTINATION_COMMUNITY_POOL
+}
+
+func (m *CommissionRewardsSettings) GetForwardPoolId() uint64 {
+	if m != nil {
+		return m.ForwardPoolId
 	}
-	if len(m.CommissionRewards) > 0 {
-		for _, e := range m.CommissionRewards {
-			l = e.Size()
-			n += 1 + l + sovStakers(uint64(l))
+	return 0
+}
+
+// UnbondingState stores the state for the unbonding of stakes and delegations.
+type QueueState struct {
+	// low_index is the tail of the queue. It is the
+	// oldest entry in the queue. If this entry isn't
+	// due, non of the other entries is.
+	LowIndex uint64 `protobuf:"varint,1,opt,name=low_index,json=lowIndex,proto3" json:"low_index,omitempty"`
+	// high_index is the head of the queue. New entries
+	// are added to the top.
+	HighIndex uint64 `protobuf:"varint,2,opt,name=high_index,json=highIndex,proto3" json:"high_index,omitempty"`
+}
+
+func (m *QueueState) Reset()         { *m = QueueState{} }
+func (m *QueueState) String() string { return proto.CompactTextString(m) }
+func (*QueueState) ProtoMessage()    {}
+func (*QueueState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a43c1df37c9604e, []int{11}
+}
+func (m *QueueState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueueState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueueState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueState.Merge(m, src)
+}
+func (m *QueueState) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueState) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueState.DiscardUnknown(m)
 }
 
-func (m *PoolAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovStakers(uint64(m.PoolId))
-	}
-	l = len(m.Staker)
-	if l > 0 {
-		n += 1 + l + sovStakers(uint64(l))
-	}
-	l = len(m.PoolAddress)
-	if l > 0 {
-		n += 1 + l + sovStakers(uint64(l))
-	}
-	if m.Points != 0 {
-		n += 1 + sovStakers(uint64(m.Points))
-	}
-	if m.IsLeaving {
-		n += 2
+var xxx_messageInfo_QueueState proto.InternalMessageInfo
+
+func (m *QueueState) GetLowIndex() uint64 {
+	if m != nil {
+		return m.LowIndex
 	}
-	l = m.Commission.Size()
-	n += 1 + l + sovStakers(uint64(l))
-	l = m.StakeFraction.Size()
-	n += 1 + l + sovStakers(uint64(l))
-	return n
+	return 0
 }
 
-func (m *CommissionChangeEntry) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Index != 0 {
-		n += 1 + sovStakers(uint64(m.Index))
-	}
-	l = len(m.Staker)
-	if l > 0 {
-		n += 1 + l + sovStakers(uint64(l))
-	}
-	if m.PoolId != 0 {
-		n += 1 + sovStakers(uint64(m.PoolId))
-	}
-	l = m.Commission.Size()
-	n += 1 + l + sovStakers(uint64(l))
-	if m.CreationDate != 0 {
-		n += 1 + sovStakers(uint64(m.CreationDate))
+func (m *QueueState) GetHighIndex() uint64 {
+	if m != nil {
+		return m.HighIndex
 	}
-	return n
+	return 0
 }
 
-func (m *StakeFractionChangeEntry) Size() (n int) {
-	if m == nil {
-		return 0
+func init() {
+	proto.RegisterEnum("kyve.stakers.v1.CommissionForwardDestination", CommissionForwardDestination_name, CommissionForwardDestination_value)
+	proto.RegisterEnum("kyve.stakers.v1.SlashType", SlashType_name, SlashType_value)
+	proto.RegisterType((*Staker)(nil), "kyve.stakers.v1.Staker")
+	proto.RegisterType((*PoolAccount)(nil), "kyve.stakers.v1.PoolAccount")
+	proto.RegisterType((*CommissionChangeEntry)(nil), "kyve.stakers.v1.CommissionChangeEntry")
+	proto.RegisterType((*StakeFractionChangeEntry)(nil), "kyve.stakers.v1.StakeFractionChangeEntry")
+	proto.RegisterType((*LeavePoolEntry)(nil), "kyve.stakers.v1.LeavePoolEntry")
+	proto.RegisterType((*InactiveValidatorEntry)(nil), "kyve.stakers.v1.InactiveValidatorEntry")
+	proto.RegisterType((*ValidatorPoolRewards)(nil), "kyve.stakers.v1.ValidatorPoolRewards")
+	proto.RegisterType((*DelegatorPoolRewards)(nil), "kyve.stakers.v1.DelegatorPoolRewards")
+	proto.RegisterType((*PoolRewardHistoryEntry)(nil), "kyve.stakers.v1.PoolRewardHistoryEntry")
+	proto.RegisterType((*PoolAccountStats)(nil), "kyve.stakers.v1.PoolAccountStats")
+	proto.RegisterType((*CommissionRewardsSettings)(nil), "kyve.stakers.v1.CommissionRewardsSettings")
+	proto.RegisterType((*QueueState)(nil), "kyve.stakers.v1.QueueState")
+}
+
+func init() { proto.RegisterFile("kyve/stakers/v1/stakers.proto", fileDescriptor_4a43c1df37c9604e) }
+
+var fileDescriptor_4a43c1df37c9604e = []byte{
+	// 1331 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
+	0x1b, 0xf7, 0xc6, 0x1b, 0x27, 0x7e, 0x92, 0x26, 0xce, 0x34, 0x4d, 0xb7, 0x69, 0xe3, 0xe4, 0x75,
+	0xa5, 0xb7, 0x69, 0xfb, 0xd6, 0x56, 0xfa, 0xea, 0x7d, 0xc5, 0xd5, 0xb5, 0x1d, 0xd5, 0x90, 0xc6,
+	0x61, 0xed, 0x04, 0x95, 0x03, 0xcb, 0x78, 0x77, 0x62, 0x8f, 0x6c, 0xef, 0x98, 0x9d, 0xb1, 0x13,
+	0x23, 0xa4, 0x22, 0x4e, 0xdc, 0xe0, 0x3b, 0x70, 0x41, 0x9c, 0xf8, 0x10, 0x1c, 0x7a, 0xac, 0x38,
+	0x21, 0x0e, 0x05, 0x5a, 0xa4, 0x7e, 0x0a, 0x24, 0x34, 0x33, 0xbb, 0xb1, 0x9d, 0x3f, 0x90, 0x46,
+	0xb4, 0x17, 0x7b, 0x9f, 0xdf, 0x33, 0xf3, 0x7b, 0xfe, 0xce, 0xb3, 0xb3, 0xb0, 0xd2, 0x1a, 0xf4,
+	0x49, 0x8e, 0x0b, 0xdc, 0x22, 0x01, 0xcf, 0xf5, 0x37, 0xa2, 0xc7, 0x6c, 0x37, 0x60, 0x82, 0xa1,
+	0x79, 0xa9, 0xce, 0x46, 0x58, 0x7f, 0x63, 0x79, 0x01, 0x77, 0xa8, 0xcf, 0x72, 0xea, 0x57, 0xaf,
+	0x59, 0x4e, 0xbb, 0x8c, 0x77, 0x18, 0xcf, 0xd5, 0x31, 0x27, 0xb9, 0xfe, 0x46, 0x9d, 0x08, 0xbc,
+	0x91, 0x73, 0x19, 0xf5, 0x43, 0xfd, 0x62, 0x83, 0x35, 0x98, 0x7a, 0xcc, 0xc9, 0x27, 0x8d, 0x66,
+	0xfe, 0x98, 0x80, 0x44, 0x55, 0xf1, 0x22, 0x0b, 0xa6, 0xb0, 0xe7, 0x05, 0x84, 0x73, 0xcb, 0x58,
+	0x33, 0xd6, 0x93, 0x76, 0x24, 0xa2, 0x02, 0x80, 0xcb, 0x3a, 0x1d, 0xca, 0x39, 0x65, 0xbe, 0x35,
+	0x21, 0x95, 0x0f, 0x6e, 0x3e, 0x7d, 0xbe, 0x1a, 0xfb, 0xf9, 0xf9, 0xea, 0x75, 0x6d, 0x96, 0x7b,
+	0xad, 0x2c, 0x65, 0xb9, 0x0e, 0x16, 0xcd, 0xec, 0x16, 0x69, 0x60, 0x77, 0x50, 0x24, 0xae, 0x3d,
+	0xb2, 0x4d, 0xd2, 0x77, 0x98, 0x4f, 0x5b, 0x24, 0xb0, 0xe2, 0x9a, 0x3e, 0x14, 0xa5, 0xe6, 0x80,
+	0xd4, 0x39, 0x15, 0xc4, 0x32, 0xb5, 0x26, 0x14, 0xd1, 0x32, 0x4c, 0x53, 0x8f, 0xf8, 0x82, 0x8a,
+	0x81, 0x35, 0xa9, 0x54, 0x47, 0x32, 0xba, 0x0d, 0x29, 0x4e, 0xdc, 0x5e, 0x40, 0xc5, 0xc0, 0x71,
+	0x99, 0x2f, 0xb0, 0x2b, 0xac, 0x84, 0x5a, 0x33, 0x1f, 0xe1, 0x05, 0x0d, 0x4b, 0x03, 0x1e, 0x11,
+	0x98, 0xb6, 0xb9, 0x35, 0xa5, 0x0d, 0x84, 0x22, 0x7a, 0x02, 0x68, 0xe8, 0xa2, 0x13, 0x90, 0x03,
+	0x1c, 0x78, 0xdc, 0x9a, 0x5e, 0x8b, 0xaf, 0xcf, 0xdc, 0xbf, 0x96, 0xd5, 0xa1, 0x65, 0x65, 0x46,
+	0xb3, 0x61, 0x46, 0xb3, 0x05, 0x46, 0xfd, 0x07, 0xff, 0x93, 0xc1, 0x7f, 0xf7, 0xcb, 0xea, 0x7a,
+	0x83, 0x8a, 0x66, 0xaf, 0x9e, 0x75, 0x59, 0x27, 0x17, 0xa6, 0x5f, 0xff, 0xdd, 0xe3, 0x5e, 0x2b,
+	0x27, 0x06, 0x5d, 0xc2, 0xd5, 0x06, 0xfe, 0xed, 0xab, 0xef, 0xef, 0x18, 0xf6, 0xc2, 0xd0, 0x96,
+	0xad, 0x4d, 0x65, 0xbe, 0x32, 0x61, 0x66, 0x87, 0xb1, 0x76, 0xde, 0x75, 0x59, 0xcf, 0x17, 0xe8,
+	0x2a, 0x4c, 0x75, 0x19, 0x6b, 0x3b, 0xd4, 0x53, 0x45, 0x30, 0xed, 0x84, 0x14, 0xcb, 0x1e, 0x5a,
+	0x82, 0x84, 0xae, 0xbf, 0xce, 0xbf, 0x1d, 0x4a, 0xe8, 0x5f, 0x30, 0xab, 0x36, 0x44, 0xa5, 0xd3,
+	0xb9, 0x9d, 0x91, 0x58, 0x3e, 0x2c, 0xdf, 0x12, 0x24, 0xba, 0x8c, 0xfa, 0x82, 0x5b, 0x66, 0x44,
+	0x29, 0x25, 0xb4, 0x02, 0x40, 0xb9, 0xd3, 0x26, 0xb8, 0x4f, 0xfd, 0x86, 0xca, 0xef, 0xb4, 0x9d,
+	0xa4, 0x7c, 0x4b, 0x03, 0xc7, 0xaa, 0x9e, 0xb8, 0x58, 0xd5, 0xdf, 0x85, 0x39, 0xe5, 0xa8, 0xb3,
+	0x1f, 0x60, 0x57, 0x48, 0xa2, 0xa9, 0xf3, 0x13, 0x5d, 0x52, 0x5b, 0x37, 0xc3, 0x9d, 0x92, 0xab,
+	0x83, 0x0f, 0x9d, 0x11, 0xa7, 0xa6, 0x5f, 0x83, 0xab, 0x83, 0x0f, 0x0b, 0x43, 0xbf, 0x3e, 0x86,
+	0xe5, 0x71, 0x2e, 0xc7, 0x6d, 0x62, 0xbf, 0x41, 0x9c, 0x00, 0x0b, 0x62, 0x25, 0xcf, 0xcf, 0x7b,
+	0x75, 0x8c, 0xb7, 0xa0, 0x48, 0x6c, 0x2c, 0x08, 0xfa, 0x3f, 0x5c, 0x1d, 0x61, 0x6f, 0x63, 0x2e,
+	0x42, 0x13, 0x9e, 0x05, 0x6b, 0xc6, 0x7a, 0xdc, 0xbe, 0x32, 0x54, 0x6f, 0x61, 0x2e, 0xf4, 0x56,
+	0x2f, 0xf3, 0xd4, 0x80, 0x2b, 0xc7, 0x09, 0x4b, 0xbe, 0x08, 0x06, 0x68, 0x11, 0x26, 0xa9, 0xef,
+	0x91, 0xc3, 0xb0, 0x33, 0xb4, 0x70, 0x66, 0x63, 0x8c, 0x74, 0x52, 0x7c, 0xac, 0x93, 0xc6, 0xeb,
+	0x6a, 0x5e, 0xac, 0xae, 0x37, 0xe1, 0x92, 0x1b, 0x10, 0x2c, 0xeb, 0xe2, 0x78, 0x32, 0x65, 0x93,
+	0x2a, 0xa6, 0xd9, 0x08, 0x2c, 0x62, 0x41, 0x32, 0x3f, 0x1a, 0x60, 0x55, 0x47, 0x4b, 0xf8, 0x06,
+	0xa2, 0x39, 0xd9, 0x60, 0xe6, 0x85, 0x1b, 0xec, 0x5c, 0x41, 0x7d, 0x06, 0x73, 0xf2, 0x84, 0x10,
+	0x79, 0x6a, 0xff, 0xd1, 0x48, 0x4e, 0x58, 0x37, 0x4f, 0xb1, 0xde, 0x82, 0xa5, 0xb2, 0x2f, 0xdd,
+	0xed, 0x93, 0x3d, 0xdc, 0xa6, 0x1e, 0x16, 0x2c, 0xb8, 0x88, 0x17, 0x27, 0x8c, 0xc5, 0x4f, 0x31,
+	0xf6, 0x43, 0x1c, 0x16, 0x8f, 0xac, 0xc8, 0x78, 0xc3, 0xa9, 0x35, 0xc2, 0x6a, 0x9c, 0x15, 0xdb,
+	0xc4, 0x58, 0x6c, 0x03, 0x98, 0xd5, 0xc3, 0xd5, 0xd1, 0x3e, 0xc6, 0xd5, 0x84, 0xbd, 0x71, 0xea,
+	0x84, 0x2d, 0x12, 0x57, 0x0d, 0xd9, 0x77, 0xc2, 0x21, 0x7b, 0xf7, 0x1c, 0x43, 0x36, 0xdc, 0x13,
+	0xce, 0xd9, 0x19, 0x6d, 0xab, 0xac, 0x32, 0xf0, 0x04, 0x90, 0x47, 0xda, 0xa4, 0xa1, 0x63, 0x8d,
+	0x46, 0xbc, 0xf9, 0xa6, 0x46, 0xfc, 0xd0, 0x56, 0x94, 0xac, 0xd3, 0xdf, 0x31, 0x93, 0x6f, 0xef,
+	0x1d, 0xf3, 0xdb, 0x04, 0x2c, 0x16, 0xb5, 0x5b, 0xe3, 0x65, 0xbc, 0x01, 0x49, 0x2f, 0xc2, 0xc3,
+	0x4a, 0x0e, 0x81, 0xd7, 0x6f, 0xe0, 0xe3, 0x45, 0x36, 0xdf, 0x66, 0x91, 0xe7, 0xb1, 0xeb, 0x06,
+	0x3d, 0xe2, 0x1d, 0x4b, 0xf0, 0x9b, 0xb2, 0x3e, 0x17, 0x9a, 0x8b, 0x72, 0xfc, 0xca, 0x80, 0xa5,
+	0x61, 0x6a, 0x1f, 0x52, 0x2e, 0x58, 0x30, 0xd0, 0x07, 0xf3, 0xcc, 0x57, 0xfa, 0x22, 0x4c, 0x92,
+	0x2e, 0x73, 0x9b, 0xe1, 0x59, 0xd1, 0xc2, 0x19, 0xfd, 0x1a, 0x7f, 0x7b, 0xfd, 0xba, 0x02, 0xa0,
+	0xfc, 0x55, 0xe5, 0x0e, 0xaf, 0x0c, 0x49, 0x89, 0xa8, 0x51, 0x9e, 0xf9, 0x22, 0x0e, 0xa9, 0x91,
+	0x1b, 0x4b, 0x55, 0x60, 0x71, 0x81, 0x81, 0x70, 0x1b, 0x52, 0xf5, 0x9e, 0xef, 0xb5, 0x09, 0x77,
+	0x7a, 0xdd, 0x36, 0xc3, 0x1e, 0x89, 0xba, 0x69, 0x3e, 0xc4, 0x77, 0x43, 0x18, 0xdd, 0x85, 0x85,
+	0x68, 0xe9, 0x3e, 0xf5, 0x71, 0x9b, 0x7e, 0x4a, 0xbc, 0xd0, 0xad, 0x88, 0x63, 0x33, 0xc2, 0xd1,
+	0x2a, 0xcc, 0xf4, 0x99, 0x20, 0xdc, 0xe9, 0xcb, 0xb9, 0xa5, 0x06, 0xb8, 0x69, 0x83, 0x82, 0xd4,
+	0x24, 0x93, 0x83, 0x4f, 0x2f, 0xa0, 0xbe, 0x5e, 0x92, 0x50, 0x4b, 0x66, 0x15, 0x58, 0xf6, 0xfb,
+	0xe3, 0x8b, 0x70, 0x9d, 0x0b, 0x4c, 0xf5, 0xa5, 0x25, 0x5a, 0x94, 0xd7, 0x98, 0xbc, 0x79, 0x85,
+	0x8b, 0x1a, 0x01, 0x21, 0x9e, 0xba, 0x8c, 0x98, 0xb6, 0x36, 0x9f, 0x57, 0x90, 0xbc, 0xbf, 0x0a,
+	0xda, 0x21, 0xac, 0x27, 0xb8, 0xba, 0x53, 0x98, 0xf6, 0x91, 0x2c, 0x2f, 0xa5, 0xbc, 0x8d, 0x79,
+	0x93, 0x70, 0x75, 0x1f, 0x30, 0xed, 0x48, 0x94, 0xc4, 0xf2, 0x04, 0x13, 0xcf, 0x51, 0x5c, 0xd6,
+	0x8c, 0x26, 0xd6, 0xd8, 0x9e, 0x84, 0x32, 0xbf, 0x1b, 0x70, 0xad, 0x70, 0xfc, 0xa0, 0x57, 0x89,
+	0x10, 0xd4, 0x6f, 0x9c, 0x5d, 0x8d, 0x5b, 0xea, 0x94, 0x90, 0xae, 0x20, 0x9e, 0xe3, 0x11, 0x9f,
+	0x75, 0xb8, 0x35, 0xb1, 0x16, 0x5f, 0x4f, 0xda, 0x73, 0x11, 0x5c, 0x54, 0x28, 0xfa, 0x08, 0x2e,
+	0xef, 0xb3, 0x40, 0x1d, 0x65, 0x8f, 0x70, 0x41, 0x7d, 0xd5, 0x20, 0xaa, 0x40, 0x73, 0xf7, 0xef,
+	0x65, 0x8f, 0x7d, 0x8d, 0x64, 0x87, 0x9e, 0x6c, 0xea, 0x5d, 0xc5, 0xe1, 0x26, 0x1b, 0xed, 0x9f,
+	0xc0, 0xd0, 0xbf, 0x61, 0x3e, 0xe2, 0x8f, 0xda, 0x43, 0x17, 0xf4, 0x52, 0x08, 0xef, 0xa8, 0x2e,
+	0xc9, 0x3c, 0x04, 0x78, 0xbf, 0x47, 0x7a, 0x44, 0x36, 0x19, 0x41, 0xd7, 0x21, 0xd9, 0x66, 0x07,
+	0xce, 0xe8, 0x5b, 0x6e, 0xba, 0xcd, 0x0e, 0xf4, 0x04, 0x58, 0x01, 0x68, 0xd2, 0x46, 0x33, 0xd4,
+	0xea, 0x66, 0x4b, 0x4a, 0x44, 0xa9, 0xef, 0x7c, 0x6e, 0xc0, 0x8d, 0xbf, 0x72, 0x13, 0x6d, 0xc0,
+	0xbd, 0x42, 0xe5, 0xd1, 0xa3, 0x72, 0xb5, 0x5a, 0xae, 0x6c, 0x3b, 0x9b, 0x15, 0xfb, 0x83, 0xbc,
+	0x5d, 0x74, 0x8a, 0xa5, 0x6a, 0xad, 0xbc, 0x9d, 0xaf, 0x49, 0x4c, 0xaa, 0x77, 0xb7, 0xcb, 0xb5,
+	0xc7, 0xce, 0x4e, 0xa5, 0xb2, 0x95, 0x8a, 0xa1, 0x5b, 0x70, 0xf3, 0x6f, 0xb6, 0xa8, 0x85, 0xc6,
+	0xb2, 0xf9, 0xe5, 0x37, 0xe9, 0xd8, 0x9d, 0x4f, 0x20, 0x59, 0x95, 0x15, 0xae, 0x0d, 0xba, 0xf2,
+	0xcb, 0x66, 0xa9, 0xba, 0x95, 0xaf, 0x3e, 0x74, 0x6a, 0x8f, 0x77, 0x4a, 0xce, 0xee, 0x76, 0x75,
+	0xa7, 0x54, 0x28, 0x6f, 0x96, 0x4b, 0xc5, 0x54, 0x0c, 0x2d, 0x01, 0x1a, 0xd1, 0xd5, 0xca, 0x8f,
+	0x4a, 0x95, 0xdd, 0x5a, 0xca, 0x40, 0x97, 0x61, 0x7e, 0x04, 0xdf, 0xab, 0xd4, 0x4a, 0xa9, 0x09,
+	0x74, 0x05, 0x16, 0x46, 0x89, 0x76, 0xb6, 0x2a, 0xf9, 0x62, 0x2a, 0xae, 0x4d, 0x3e, 0xd8, 0x7c,
+	0xfa, 0x22, 0x6d, 0x3c, 0x7b, 0x91, 0x36, 0x7e, 0x7d, 0x91, 0x36, 0xbe, 0x7e, 0x99, 0x8e, 0x3d,
+	0x7b, 0x99, 0x8e, 0xfd, 0xf4, 0x32, 0x1d, 0xfb, 0xf0, 0x3f, 0x23, 0x63, 0xe2, 0xbd, 0xc7, 0x7b,
+	0xa5, 0x6d, 0x22, 0x0e, 0x58, 0xd0, 0xca, 0xb9, 0x4d, 0x4c, 0xfd, 0xdc, 0xe1, 0xd1, 0xa7, 0xa8,
+	0x1a, 0x18, 0xf5, 0x84, 0xfa, 0x58, 0xfc, 0xef, 0x9f, 0x03, 0x00, 0x69, 0xd6, 0x57, 0xf0, 0xa7,
+	0x0e, 0x00, 0x00,
+}
+
+func (m *Staker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Staker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Staker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Index != 0 {
-		n += 1 + sovStakers(uint64(m.Index))
+	if len(m.CommissionRewards) > 0 {
+		for iNdEx := len(m.CommissionRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommissionRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStakers(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
 	}
-	l = len(m.Staker)
-	if l > 0 {
-		n += 1 + l + sovStakers(uint64(l))
+	if len(m.Details) > 0 {
+		i -= len(m.Details)
+		copy(dAtA[i:], m.Details)
+		i = encodeVarintStakers(dAtA, i, uint64(len(m.Details)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.PoolId != 0 {
-		n += 1 + sovStakers(uint64(m.PoolId))
+	if len(m.SecurityContact) > 0 {
+		i -= len(m.SecurityContact)
+		copy(dAtA[i:], m.SecurityContact)
+		i = encodeVarintStakers(dAtA, i, uint64(len(m.SecurityContact)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = m.StakeFraction.Size()
-	n += 1 + l + sovStakers(uint64(l))
-	if m.CreationDate != 0 {
-		n += 1 + sovStakers(uint64(m.CreationDate))
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintStakers(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	return n
+	if len(m.Website) > 0 {
+		i -= len(m.Website)
+		copy(dAtA[i:], m.Website)
+		i = encodeVarintStakers(dAtA, i, uint64(len(m.Website)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Moniker) > 0 {
+		i -= len(m.Moniker)
+		copy(dAtA[i:], m.Moniker)
+		i = encodeVarintStakers(dAtA, i, uint64(len(m.Moniker)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Commission.Size()
+		i -= size
+		if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStakers(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintStakers(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *LeavePoolEntry) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PoolAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CommissionLastChanged != 0 {
+		i = encodeVarintStakers(dAtA, i, uint64(m.CommissionLastChanged))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.MaxCommissionChangeRate.Size()
+		i -= size
+		if _, err := m.MaxCommissionChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStakers(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.MaxCommission.Size()
+		i -= size
+		if _, err := m.MaxCommission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStakers(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.StakeFraction.Size()
+		i -= size
+		if _, err := m.StakeFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStakers(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Commission.Size()
+		i -= size
+		if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStakers(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.IsLeaving {
+		i--
+		if m.IsLeaving {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Points != 0 {
+		i = encodeVarintStakers(dAtA, i, uint64(m.Points))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PoolAddress) > 0 {
+		i -= len(m.PoolAddress)
+		copy(dAtA[i:], m.PoolAddress)
+		i = encodeVarintStakers(dAtA, i, uint64(len(m.PoolAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintStakers(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintStakers(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommissionChangeEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CommissionChangeEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommissionChangeEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if m.CreationDate != 0 {
+		i = encodeVarintStakers(dAtA, i, uint64(m.CreationDate))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Commission.Size()
+		i -= size
+		if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStakers(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.PoolId != 0 {
+		i = encodeVarintStakers(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintStakers(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Index != 0 {
-		n += 1 + sovStakers(uint64(m.Index))
+		i = encodeVarintStakers(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = len(m.Staker)
-	if l > 0 {
-		n += 1 + l + sovStakers(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *StakeFractionChangeEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StakeFractionChangeEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StakeFractionChangeEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreationDate != 0 {
+		i = encodeVarintStakers(dAtA, i, uint64(m.CreationDate))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.StakeFraction.Size()
+		i -= size
+		if _, err := m.StakeFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStakers(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x22
 	if m.PoolId != 0 {
-		n += 1 + sovStakers(uint64(m.PoolId))
+		i = encodeVarintStakers(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintStakers(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintStakers(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LeavePoolEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *LeavePoolEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LeavePoolEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.CreationDate != 0 {
-		n += 1 + sovStakers(uint64(m.CreationDate))
+		i = encodeVarintStakers(dAtA, i, uint64(m.CreationDate))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintStakers(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintStakers(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintStakers(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InactiveValidatorEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InactiveValidatorEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InactiveValidatorEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreationDate != 0 {
+		i = encodeVarintStakers(dAtA, i, uint64(m.CreationDate))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintStakers(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintStakers(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorPoolRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPoolRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPoolRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CommissionRewards) > 0 {
+		for iNdEx := len(m.CommissionRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommissionRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStakers(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.DelegationRewards) > 0 {
+		for iNdEx := len(m.DelegationRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegationRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStakers(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.RewardIndex) > 0 {
+		for iNdEx := len(m.RewardIndex) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardIndex[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStakers(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintStakers(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintStakers(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegatorPoolRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatorPoolRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorPoolRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AccruedRewards) > 0 {
+		for iNdEx := len(m.AccruedRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccruedRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStakers(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.RewardIndex) > 0 {
+		for iNdEx := len(m.RewardIndex) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardIndex[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStakers(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintStakers(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintStakers(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintStakers(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolRewardHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolRewardHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolRewardHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolStake != 0 {
+		i = encodeVarintStakers(dAtA, i, uint64(m.PoolStake))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.DelegationRewards) > 0 {
+		for iNdEx := len(m.DelegationRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegationRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStakers(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintStakers(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintStakers(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolAccountStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolAccountStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolAccountStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MissedVotes != 0 {
+		i = encodeVarintStakers(dAtA, i, uint64(m.MissedVotes))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Slashes != 0 {
+		i = encodeVarintStakers(dAtA, i, uint64(m.Slashes))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Timeouts != 0 {
+		i = encodeVarintStakers(dAtA, i, uint64(m.Timeouts))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.VotesAgreed != 0 {
+		i = encodeVarintStakers(dAtA, i, uint64(m.VotesAgreed))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.VotesAbstain != 0 {
+		i = encodeVarintStakers(dAtA, i, uint64(m.VotesAbstain))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.VotesInvalid != 0 {
+		i = encodeVarintStakers(dAtA, i, uint64(m.VotesInvalid))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.VotesValid != 0 {
+		i = encodeVarintStakers(dAtA, i, uint64(m.VotesValid))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BundlesFinalized != 0 {
+		i = encodeVarintStakers(dAtA, i, uint64(m.BundlesFinalized))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BundlesUploaded != 0 {
+		i = encodeVarintStakers(dAtA, i, uint64(m.BundlesUploaded))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintStakers(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintStakers(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommissionRewardsSettings) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommissionRewardsSettings) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommissionRewardsSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ForwardPoolId != 0 {
+		i = encodeVarintStakers(dAtA, i, uint64(m.ForwardPoolId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ForwardDestination != 0 {
+		i = encodeVarintStakers(dAtA, i, uint64(m.ForwardDestination))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AcceptedDenoms) > 0 {
+		for iNdEx := len(m.AcceptedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AcceptedDenoms[iNdEx])
+			copy(dAtA[i:], m.AcceptedDenoms[iNdEx])
+			i = encodeVarintStakers(dAtA, i, uint64(len(m.AcceptedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintStakers(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HighIndex != 0 {
+		i = encodeVarintStakers(dAtA, i, uint64(m.HighIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LowIndex != 0 {
+		i = encodeVarintStakers(dAtA, i, uint64(m.LowIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStakers(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStakers(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Staker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovStakers(uint64(l))
+	}
+	l = m.Commission.Size()
+	n += 1 + l + sovStakers(uint64(l))
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovStakers(uint64(l))
+	}
+	l = len(m.Website)
+	if l > 0 {
+		n += 1 + l + sovStakers(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovStakers(uint64(l))
+	}
+	l = len(m.SecurityContact)
+	if l > 0 {
+		n += 1 + l + sovStakers(uint64(l))
+	}
+	l = len(m.Details)
+	if l > 0 {
+		n += 1 + l + sovStakers(uint64(l))
+	}
+	if len(m.CommissionRewards) > 0 {
+		for _, e := range m.CommissionRewards {
+			l = e.Size()
+			n += 1 + l + sovStakers(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovStakers(uint64(m.PoolId))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovStakers(uint64(l))
+	}
+	l = len(m.PoolAddress)
+	if l > 0 {
+		n += 1 + l + sovStakers(uint64(l))
+	}
+	if m.Points != 0 {
+		n += 1 + sovStakers(uint64(m.Points))
+	}
+	if m.IsLeaving {
+		n += 2
+	}
+	l = m.Commission.Size()
+	n += 1 + l + sovStakers(uint64(l))
+	l = m.StakeFraction.Size()
+	n += 1 + l + sovStakers(uint64(l))
+	l = m.MaxCommission.Size()
+	n += 1 + l + sovStakers(uint64(l))
+	l = m.MaxCommissionChangeRate.Size()
+	n += 1 + l + sovStakers(uint64(l))
+	if m.CommissionLastChanged != 0 {
+		n += 1 + sovStakers(uint64(m.CommissionLastChanged))
+	}
+	return n
+}
+
+func (m *CommissionChangeEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovStakers(uint64(m.Index))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovStakers(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovStakers(uint64(m.PoolId))
+	}
+	l = m.Commission.Size()
+	n += 1 + l + sovStakers(uint64(l))
+	if m.CreationDate != 0 {
+		n += 1 + sovStakers(uint64(m.CreationDate))
+	}
+	return n
+}
+
+func (m *StakeFractionChangeEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovStakers(uint64(m.Index))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovStakers(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovStakers(uint64(m.PoolId))
+	}
+	l = m.StakeFraction.Size()
+	n += 1 + l + sovStakers(uint64(l))
+	if m.CreationDate != 0 {
+		n += 1 + sovStakers(uint64(m.CreationDate))
+	}
+	return n
+}
+
+func (m *LeavePoolEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovStakers(uint64(m.Index))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovStakers(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovStakers(uint64(m.PoolId))
+	}
+	if m.CreationDate != 0 {
+		n += 1 + sovStakers(uint64(m.CreationDate))
+	}
+	return n
+}
+
+func (m *InactiveValidatorEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovStakers(uint64(m.Index))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovStakers(uint64(l))
+	}
+	if m.CreationDate != 0 {
+		n += 1 + sovStakers(uint64(m.CreationDate))
+	}
+	return n
+}
+
+func (m *ValidatorPoolRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovStakers(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovStakers(uint64(m.PoolId))
+	}
+	if len(m.RewardIndex) > 0 {
+		for _, e := range m.RewardIndex {
+			l = e.Size()
+			n += 1 + l + sovStakers(uint64(l))
+		}
+	}
+	if len(m.DelegationRewards) > 0 {
+		for _, e := range m.DelegationRewards {
+			l = e.Size()
+			n += 1 + l + sovStakers(uint64(l))
+		}
+	}
+	if len(m.CommissionRewards) > 0 {
+		for _, e := range m.CommissionRewards {
+			l = e.Size()
+			n += 1 + l + sovStakers(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DelegatorPoolRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovStakers(uint64(l))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovStakers(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovStakers(uint64(m.PoolId))
+	}
+	if len(m.RewardIndex) > 0 {
+		for _, e := range m.RewardIndex {
+			l = e.Size()
+			n += 1 + l + sovStakers(uint64(l))
+		}
+	}
+	if len(m.AccruedRewards) > 0 {
+		for _, e := range m.AccruedRewards {
+			l = e.Size()
+			n += 1 + l + sovStakers(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolRewardHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovStakers(uint64(m.PoolId))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovStakers(uint64(m.Epoch))
+	}
+	if len(m.DelegationRewards) > 0 {
+		for _, e := range m.DelegationRewards {
+			l = e.Size()
+			n += 1 + l + sovStakers(uint64(l))
+		}
+	}
+	if m.PoolStake != 0 {
+		n += 1 + sovStakers(uint64(m.PoolStake))
+	}
+	return n
+}
+
+func (m *PoolAccountStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovStakers(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovStakers(uint64(m.PoolId))
+	}
+	if m.BundlesUploaded != 0 {
+		n += 1 + sovStakers(uint64(m.BundlesUploaded))
+	}
+	if m.BundlesFinalized != 0 {
+		n += 1 + sovStakers(uint64(m.BundlesFinalized))
+	}
+	if m.VotesValid != 0 {
+		n += 1 + sovStakers(uint64(m.VotesValid))
+	}
+	if m.VotesInvalid != 0 {
+		n += 1 + sovStakers(uint64(m.VotesInvalid))
+	}
+	if m.VotesAbstain != 0 {
+		n += 1 + sovStakers(uint64(m.VotesAbstain))
+	}
+	if m.VotesAgreed != 0 {
+		n += 1 + sovStakers(uint64(m.VotesAgreed))
+	}
+	if m.Timeouts != 0 {
+		n += 1 + sovStakers(uint64(m.Timeouts))
+	}
+	if m.Slashes != 0 {
+		n += 1 + sovStakers(uint64(m.Slashes))
+	}
+	if m.MissedVotes != 0 {
+		n += 1 + sovStakers(uint64(m.MissedVotes))
+	}
+	return n
+}
+
+func (m *CommissionRewardsSettings) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovStakers(uint64(l))
+	}
+	if len(m.AcceptedDenoms) > 0 {
+		for _, s := range m.AcceptedDenoms {
+			l = len(s)
+			n += 1 + l + sovStakers(uint64(l))
+		}
+	}
+	if m.ForwardDestination != 0 {
+		n += 1 + sovStakers(uint64(m.ForwardDestination))
+	}
+	if m.ForwardPoolId != 0 {
+		n += 1 + sovStakers(uint64(m.ForwardPoolId))
+	}
+	return n
+}
+
+func (m *QueueState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LowIndex != 0 {
+		n += 1 + sovStakers(uint64(m.LowIndex))
+	}
+	if m.HighIndex != 0 {
+		n += 1 + sovStakers(uint64(m.HighIndex))
+	}
+	return n
+}
+
+func sovStakers(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStakers(x uint64) (n int) {
+	return sovStakers(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Staker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStakers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Staker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Staker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStakers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStakers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStakers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStakers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Website = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStakers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStakers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecurityContact = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStakers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Details = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStakers
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommissionRewards = append(m.CommissionRewards, types.Coin{})
+			if err := m.CommissionRewards[len(m.CommissionRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStakers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStakers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStakers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStakers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStakers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
+			}
+			m.Points = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Points |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsLeaving", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsLeaving = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStakers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStakers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakeFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStakers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxCommission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommissionChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStakers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxCommissionChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionLastChanged", wireType)
+			}
+			m.CommissionLastChanged = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommissionLastChanged |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStakers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStakers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommissionChangeEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStakers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommissionChangeEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommissionChangeEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStakers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStakers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationDate", wireType)
+			}
+			m.CreationDate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationDate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStakers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStakers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StakeFractionChangeEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStakers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakeFractionChangeEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakeFractionChangeEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStakers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStakers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakeFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationDate", wireType)
+			}
+			m.CreationDate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationDate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStakers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStakers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LeavePoolEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStakers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LeavePoolEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LeavePoolEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStakers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationDate", wireType)
+			}
+			m.CreationDate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationDate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStakers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStakers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueueState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.LowIndex != 0 {
-		n += 1 + sovStakers(uint64(m.LowIndex))
-	}
-	if m.HighIndex != 0 {
-		n += 1 + sovStakers(uint64(m.HighIndex))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovStakers(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozStakers(x uint64) (n int) {
-	return sovStakers(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Staker) Unmarshal(dAtA []byte) error {
+func (m *InactiveValidatorEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1175,17 +3502,17 @@ func (m *Staker) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Staker: wiretype end group for non-group")
+			return fmt.Errorf("proto: InactiveValidatorEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Staker: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InactiveValidatorEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var stringLen uint64
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStakers
@@ -1195,27 +3522,14 @@ func (m *Staker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Index |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStakers
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStakers
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1243,15 +3557,13 @@ func (m *Staker) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationDate", wireType)
 			}
-			var stringLen uint64
+			m.CreationDate = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStakers
@@ -1261,27 +3573,64 @@ func (m *Staker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CreationDate |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStakers
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStakers(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthStakers
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Moniker = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorPoolRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStakers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPoolRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPoolRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1309,13 +3658,13 @@ func (m *Staker) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Website = string(dAtA[iNdEx:postIndex])
+			m.Staker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var stringLen uint64
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStakers
@@ -1325,29 +3674,16 @@ func (m *Staker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStakers
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStakers
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Identity = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContact", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardIndex", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStakers
@@ -1357,29 +3693,31 @@ func (m *Staker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStakers
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStakers
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SecurityContact = string(dAtA[iNdEx:postIndex])
+			m.RewardIndex = append(m.RewardIndex, types.DecCoin{})
+			if err := m.RewardIndex[len(m.RewardIndex)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationRewards", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStakers
@@ -1389,25 +3727,27 @@ func (m *Staker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStakers
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStakers
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Details = string(dAtA[iNdEx:postIndex])
+			m.DelegationRewards = append(m.DelegationRewards, types.Coin{})
+			if err := m.DelegationRewards[len(m.DelegationRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRewards", wireType)
 			}
@@ -1462,7 +3802,7 @@ func (m *Staker) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolAccount) Unmarshal(dAtA []byte) error {
+func (m *DelegatorPoolRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1485,34 +3825,15 @@ func (m *PoolAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegatorPoolRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegatorPoolRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStakers
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1540,11 +3861,11 @@ func (m *PoolAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Staker = string(dAtA[iNdEx:postIndex])
+			m.Delegator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1572,32 +3893,13 @@ func (m *PoolAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolAddress = string(dAtA[iNdEx:postIndex])
+			m.Staker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
-			}
-			m.Points = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStakers
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Points |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsLeaving", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var v int
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStakers
@@ -1607,17 +3909,16 @@ func (m *PoolAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsLeaving = bool(v != 0)
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardIndex", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStakers
@@ -1627,31 +3928,31 @@ func (m *PoolAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStakers
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStakers
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RewardIndex = append(m.RewardIndex, types.DecCoin{})
+			if err := m.RewardIndex[len(m.RewardIndex)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakeFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccruedRewards", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStakers
@@ -1661,23 +3962,23 @@ func (m *PoolAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStakers
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStakers
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StakeFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AccruedRewards = append(m.AccruedRewards, types.DecCoin{})
+			if err := m.AccruedRewards[len(m.AccruedRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1702,7 +4003,7 @@ func (m *PoolAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommissionChangeEntry) Unmarshal(dAtA []byte) error {
+func (m *PoolRewardHistoryEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1725,17 +4026,17 @@ func (m *CommissionChangeEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommissionChangeEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolRewardHistoryEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommissionChangeEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolRewardHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.Index = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStakers
@@ -1745,48 +4046,16 @@ func (m *CommissionChangeEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStakers
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStakers
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStakers
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Staker = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			m.PoolId = 0
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStakers
@@ -1796,16 +4065,16 @@ func (m *CommissionChangeEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				m.Epoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationRewards", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStakers
@@ -1815,31 +4084,31 @@ func (m *CommissionChangeEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStakers
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStakers
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DelegationRewards = append(m.DelegationRewards, types.Coin{})
+			if err := m.DelegationRewards[len(m.DelegationRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolStake", wireType)
 			}
-			m.CreationDate = 0
+			m.PoolStake = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStakers
@@ -1849,7 +4118,7 @@ func (m *CommissionChangeEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreationDate |= int64(b&0x7F) << shift
+				m.PoolStake |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1875,7 +4144,7 @@ func (m *CommissionChangeEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StakeFractionChangeEntry) Unmarshal(dAtA []byte) error {
+func (m *PoolAccountStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1898,32 +4167,13 @@ func (m *StakeFractionChangeEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StakeFractionChangeEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolAccountStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StakeFractionChangeEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolAccountStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
-			}
-			m.Index = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStakers
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Index |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
 			}
@@ -1955,7 +4205,7 @@ func (m *StakeFractionChangeEntry) Unmarshal(dAtA []byte) error {
 			}
 			m.Staker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
@@ -1974,11 +4224,30 @@ func (m *StakeFractionChangeEntry) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundlesUploaded", wireType)
+			}
+			m.BundlesUploaded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BundlesUploaded |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakeFraction", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundlesFinalized", wireType)
 			}
-			var stringLen uint64
+			m.BundlesFinalized = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStakers
@@ -1988,31 +4257,54 @@ func (m *StakeFractionChangeEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BundlesFinalized |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStakers
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotesValid", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStakers
+			m.VotesValid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotesValid |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotesInvalid", wireType)
 			}
-			if err := m.StakeFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.VotesInvalid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotesInvalid |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotesAbstain", wireType)
 			}
-			m.CreationDate = 0
+			m.VotesAbstain = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStakers
@@ -2022,7 +4314,83 @@ func (m *StakeFractionChangeEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreationDate |= int64(b&0x7F) << shift
+				m.VotesAbstain |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotesAgreed", wireType)
+			}
+			m.VotesAgreed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotesAgreed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeouts", wireType)
+			}
+			m.Timeouts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeouts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashes", wireType)
+			}
+			m.Slashes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slashes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedVotes", wireType)
+			}
+			m.MissedVotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedVotes |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2048,7 +4416,7 @@ func (m *StakeFractionChangeEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LeavePoolEntry) Unmarshal(dAtA []byte) error {
+func (m *CommissionRewardsSettings) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2071,17 +4439,17 @@ func (m *LeavePoolEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LeavePoolEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommissionRewardsSettings: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LeavePoolEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommissionRewardsSettings: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
 			}
-			m.Index = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStakers
@@ -2091,14 +4459,27 @@ func (m *LeavePoolEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStakers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedDenoms", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2126,13 +4507,13 @@ func (m *LeavePoolEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Staker = string(dAtA[iNdEx:postIndex])
+			m.AcceptedDenoms = append(m.AcceptedDenoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardDestination", wireType)
 			}
-			m.PoolId = 0
+			m.ForwardDestination = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStakers
@@ -2142,16 +4523,16 @@ func (m *LeavePoolEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				m.ForwardDestination |= CommissionForwardDestination(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardPoolId", wireType)
 			}
-			m.CreationDate = 0
+			m.ForwardPoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStakers
@@ -2161,7 +4542,7 @@ func (m *LeavePoolEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreationDate |= int64(b&0x7F) << shift
+				m.ForwardPoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
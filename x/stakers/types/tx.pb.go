@@ -219,6 +219,131 @@ func (m *MsgUpdateStakeFractionResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateStakeFractionResponse proto.InternalMessageInfo
 
+// MsgUpdateCommissionRewardsSettings updates which denoms the validator
+// accepts as commission rewards and where the other denoms are forwarded to.
+type MsgUpdateCommissionRewardsSettings struct {
+	// creator ...
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// accept_all_denoms removes the settings, so that all denoms are accepted again
+	AcceptAllDenoms bool `protobuf:"varint,2,opt,name=accept_all_denoms,json=acceptAllDenoms,proto3" json:"accept_all_denoms,omitempty"`
+	// accepted_denoms are the denoms the validator accepts as commission rewards
+	// besides the native denom
+	AcceptedDenoms []string `protobuf:"bytes,3,rep,name=accepted_denoms,json=acceptedDenoms,proto3" json:"accepted_denoms,omitempty"`
+	// forward_destination ...
+	ForwardDestination CommissionForwardDestination `protobuf:"varint,4,opt,name=forward_destination,json=forwardDestination,proto3,enum=kyve.stakers.v1.CommissionForwardDestination" json:"forward_destination,omitempty"`
+	// forward_pool_id ...
+	ForwardPoolId uint64 `protobuf:"varint,5,opt,name=forward_pool_id,json=forwardPoolId,proto3" json:"forward_pool_id,omitempty"`
+}
+
+func (m *MsgUpdateCommissionRewardsSettings) Reset()         { *m = MsgUpdateCommissionRewardsSettings{} }
+func (m *MsgUpdateCommissionRewardsSettings) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCommissionRewardsSettings) ProtoMessage()    {}
+func (*MsgUpdateCommissionRewardsSettings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d636e4ed34b3d79a, []int{4}
+}
+func (m *MsgUpdateCommissionRewardsSettings) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCommissionRewardsSettings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCommissionRewardsSettings.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateCommissionRewardsSettings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCommissionRewardsSettings.Merge(m, src)
+}
+func (m *MsgUpdateCommissionRewardsSettings) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCommissionRewardsSettings) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCommissionRewardsSettings.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateCommissionRewardsSettings proto.InternalMessageInfo
+
+func (m *MsgUpdateCommissionRewardsSettings) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgUpdateCommissionRewardsSettings) GetAcceptAllDenoms() bool {
+	if m != nil {
+		return m.AcceptAllDenoms
+	}
+	return false
+}
+
+func (m *MsgUpdateCommissionRewardsSettings) GetAcceptedDenoms() []string {
+	if m != nil {
+		return m.AcceptedDenoms
+	}
+	return nil
+}
+
+func (m *MsgUpdateCommissionRewardsSettings) GetForwardDestination() CommissionForwardDestination {
+	if m != nil {
+		return m.ForwardDestination
+	}
+	return COMMISSION_FORWARD_DESTINATION_COMMUNITY_POOL
+}
+
+func (m *MsgUpdateCommissionRewardsSettings) GetForwardPoolId() uint64 {
+	if m != nil {
+		return m.ForwardPoolId
+	}
+	return 0
+}
+
+// MsgUpdateCommissionRewardsSettingsResponse ...
+type MsgUpdateCommissionRewardsSettingsResponse struct {
+}
+
+func (m *MsgUpdateCommissionRewardsSettingsResponse) Reset() {
+	*m = MsgUpdateCommissionRewardsSettingsResponse{}
+}
+func (m *MsgUpdateCommissionRewardsSettingsResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgUpdateCommissionRewardsSettingsResponse) ProtoMessage() {}
+func (*MsgUpdateCommissionRewardsSettingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d636e4ed34b3d79a, []int{5}
+}
+func (m *MsgUpdateCommissionRewardsSettingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCommissionRewardsSettingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCommissionRewardsSettingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateCommissionRewardsSettingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCommissionRewardsSettingsResponse.Merge(m, src)
+}
+func (m *MsgUpdateCommissionRewardsSettingsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCommissionRewardsSettingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCommissionRewardsSettingsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateCommissionRewardsSettingsResponse proto.InternalMessageInfo
+
 // MsgJoinPool ...
 type MsgJoinPool struct {
 	// creator ...
@@ -233,13 +358,19 @@ type MsgJoinPool struct {
 	Commission cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=commission,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"commission"`
 	// stake_fraction ...
 	StakeFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=stake_fraction,json=stakeFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"stake_fraction"`
+	// max_commission is the highest commission the validator can ever
+	// charge in this pool. Defaults to the pool limit if not set.
+	MaxCommission cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=max_commission,json=maxCommission,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_commission"`
+	// max_commission_change_rate is the maximum daily commission change.
+	// Defaults to the pool limit if not set.
+	MaxCommissionChangeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=max_commission_change_rate,json=maxCommissionChangeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_commission_change_rate"`
 }
 
 func (m *MsgJoinPool) Reset()         { *m = MsgJoinPool{} }
 func (m *MsgJoinPool) String() string { return proto.CompactTextString(m) }
 func (*MsgJoinPool) ProtoMessage()    {}
 func (*MsgJoinPool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d636e4ed34b3d79a, []int{4}
+	return fileDescriptor_d636e4ed34b3d79a, []int{6}
 }
 func (m *MsgJoinPool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -304,7 +435,7 @@ func (m *MsgJoinPoolResponse) Reset()         { *m = MsgJoinPoolResponse{} }
 func (m *MsgJoinPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgJoinPoolResponse) ProtoMessage()    {}
 func (*MsgJoinPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d636e4ed34b3d79a, []int{5}
+	return fileDescriptor_d636e4ed34b3d79a, []int{7}
 }
 func (m *MsgJoinPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -345,7 +476,7 @@ func (m *MsgLeavePool) Reset()         { *m = MsgLeavePool{} }
 func (m *MsgLeavePool) String() string { return proto.CompactTextString(m) }
 func (*MsgLeavePool) ProtoMessage()    {}
 func (*MsgLeavePool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d636e4ed34b3d79a, []int{6}
+	return fileDescriptor_d636e4ed34b3d79a, []int{8}
 }
 func (m *MsgLeavePool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -396,7 +527,7 @@ func (m *MsgLeavePoolResponse) Reset()         { *m = MsgLeavePoolResponse{} }
 func (m *MsgLeavePoolResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgLeavePoolResponse) ProtoMessage()    {}
 func (*MsgLeavePoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d636e4ed34b3d79a, []int{7}
+	return fileDescriptor_d636e4ed34b3d79a, []int{9}
 }
 func (m *MsgLeavePoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -437,7 +568,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d636e4ed34b3d79a, []int{8}
+	return fileDescriptor_d636e4ed34b3d79a, []int{10}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -488,7 +619,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d636e4ed34b3d79a, []int{9}
+	return fileDescriptor_d636e4ed34b3d79a, []int{11}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -522,6 +653,8 @@ func init() {
 	proto.RegisterType((*MsgUpdateCommissionResponse)(nil), "kyve.stakers.v1.MsgUpdateCommissionResponse")
 	proto.RegisterType((*MsgUpdateStakeFraction)(nil), "kyve.stakers.v1.MsgUpdateStakeFraction")
 	proto.RegisterType((*MsgUpdateStakeFractionResponse)(nil), "kyve.stakers.v1.MsgUpdateStakeFractionResponse")
+	proto.RegisterType((*MsgUpdateCommissionRewardsSettings)(nil), "kyve.stakers.v1.MsgUpdateCommissionRewardsSettings")
+	proto.RegisterType((*MsgUpdateCommissionRewardsSettingsResponse)(nil), "kyve.stakers.v1.MsgUpdateCommissionRewardsSettingsResponse")
 	proto.RegisterType((*MsgJoinPool)(nil), "kyve.stakers.v1.MsgJoinPool")
 	proto.RegisterType((*MsgJoinPoolResponse)(nil), "kyve.stakers.v1.MsgJoinPoolResponse")
 	proto.RegisterType((*MsgLeavePool)(nil), "kyve.stakers.v1.MsgLeavePool")
@@ -533,46 +666,58 @@ func init() {
 func init() { proto.RegisterFile("kyve/stakers/v1/tx.proto", fileDescriptor_d636e4ed34b3d79a) }
 
 var fileDescriptor_d636e4ed34b3d79a = []byte{
-	// 617 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcf, 0x4f, 0x13, 0x41,
-	0x18, 0xed, 0xf2, 0xa3, 0xd8, 0x8f, 0x0a, 0x66, 0x41, 0x58, 0x16, 0x59, 0x6a, 0xd5, 0x48, 0x08,
-	0xee, 0x06, 0x4d, 0x3c, 0x70, 0x13, 0x94, 0x44, 0xa4, 0x88, 0x4b, 0x34, 0x91, 0x0b, 0x19, 0x76,
-	0x87, 0xed, 0xa6, 0xdd, 0x9d, 0xcd, 0xce, 0xb4, 0xd2, 0x9b, 0xf1, 0x2f, 0xf0, 0xac, 0x67, 0xef,
-	0x1c, 0xfc, 0x23, 0x38, 0x12, 0x4f, 0xc6, 0x03, 0x31, 0xad, 0x09, 0xff, 0x86, 0xd9, 0x4e, 0x77,
-	0x5a, 0xd6, 0x05, 0xb1, 0xf1, 0xd6, 0x6f, 0xde, 0x9b, 0xef, 0xbd, 0x79, 0x33, 0xfd, 0x16, 0x94,
-	0x4a, 0xa3, 0x8e, 0x0d, 0xca, 0x50, 0x05, 0x87, 0xd4, 0xa8, 0x2f, 0x1b, 0xec, 0x50, 0x0f, 0x42,
-	0xc2, 0x88, 0x3c, 0x1e, 0x21, 0x7a, 0x07, 0xd1, 0xeb, 0xcb, 0xea, 0xb4, 0x45, 0xa8, 0x47, 0xa8,
-	0xe1, 0x51, 0x27, 0x22, 0x7a, 0xd4, 0xe1, 0x4c, 0x75, 0x86, 0x03, 0x7b, 0xed, 0xca, 0xe0, 0x45,
-	0x07, 0x9a, 0x74, 0x88, 0x43, 0xf8, 0x7a, 0xf4, 0x8b, 0xaf, 0x16, 0x3f, 0x4b, 0x30, 0x51, 0xa2,
-	0xce, 0xeb, 0xc0, 0x46, 0x0c, 0xaf, 0x11, 0xcf, 0x73, 0x29, 0x75, 0x89, 0x2f, 0x2b, 0x30, 0x62,
-	0x85, 0x18, 0x31, 0x12, 0x2a, 0x52, 0x41, 0x5a, 0xc8, 0x99, 0x71, 0x29, 0x4f, 0xc3, 0x48, 0x40,
-	0x48, 0x75, 0xcf, 0xb5, 0x95, 0x81, 0x82, 0xb4, 0x30, 0x64, 0x66, 0xa3, 0xf2, 0xb9, 0x2d, 0xaf,
-	0x01, 0x58, 0xa2, 0x81, 0x32, 0x18, 0xed, 0x5a, 0xbd, 0x73, 0x7c, 0x3a, 0x9f, 0xf9, 0x71, 0x3a,
-	0x3f, 0xcb, 0xad, 0x50, 0xbb, 0xa2, 0xbb, 0xc4, 0xf0, 0x10, 0x2b, 0xeb, 0x9b, 0xd8, 0x41, 0x56,
-	0xe3, 0x29, 0xb6, 0xcc, 0x9e, 0x6d, 0x2b, 0xf9, 0x0f, 0x67, 0x47, 0x8b, 0xb1, 0x56, 0x71, 0x0e,
-	0x66, 0x53, 0xcc, 0x99, 0x98, 0x06, 0xc4, 0xa7, 0xb8, 0xf8, 0x45, 0x82, 0x29, 0x81, 0xef, 0x44,
-	0xf1, 0xac, 0x87, 0xc8, 0x62, 0x7d, 0xfa, 0xdf, 0x80, 0xb1, 0x76, 0xc4, 0x7b, 0x07, 0x9d, 0x26,
-	0xff, 0x72, 0x86, 0xeb, 0xb4, 0x57, 0x3e, 0x71, 0x8c, 0x02, 0x68, 0xe9, 0x36, 0xc5, 0x49, 0x3e,
-	0x0d, 0xc0, 0x68, 0x89, 0x3a, 0x1b, 0xc4, 0xf5, 0xb7, 0x09, 0xa9, 0xf6, 0x63, 0xff, 0x36, 0xe4,
-	0xdb, 0x00, 0xb2, 0xed, 0x10, 0x53, 0xca, 0xcd, 0x9b, 0xa3, 0xd1, 0xda, 0x13, 0xbe, 0x24, 0x4f,
-	0x41, 0x16, 0x79, 0xa4, 0xe6, 0x33, 0x65, 0x88, 0x6f, 0xe5, 0x55, 0xe2, 0xe6, 0x86, 0xfb, 0xba,
-	0xb9, 0x94, 0xf8, 0xb2, 0xff, 0x29, 0xbe, 0x9b, 0xed, 0x27, 0x1a, 0x67, 0x23, 0x32, 0x7b, 0x09,
-	0xf9, 0x12, 0x75, 0x36, 0x31, 0xaa, 0xe3, 0x3e, 0x33, 0x4b, 0xe8, 0x4c, 0xc1, 0x64, 0x6f, 0x43,
-	0x21, 0x44, 0x61, 0x5c, 0x5c, 0xdf, 0x36, 0x0a, 0x91, 0x47, 0xe5, 0xc7, 0x90, 0x43, 0x35, 0x56,
-	0x26, 0xa1, 0xcb, 0x1a, 0x5c, 0x6d, 0x55, 0xf9, 0xf6, 0xf5, 0xc1, 0x64, 0xe7, 0x1f, 0xd7, 0x09,
-	0x7c, 0x87, 0x85, 0xae, 0xef, 0x98, 0x5d, 0x6a, 0xe4, 0x31, 0x40, 0x8d, 0x2a, 0x41, 0xdc, 0x49,
-	0xce, 0x8c, 0xcb, 0x95, 0xb1, 0xc8, 0x4a, 0x97, 0x59, 0x9c, 0x81, 0xe9, 0x84, 0x68, 0xec, 0xe7,
-	0xe1, 0xaf, 0x41, 0x18, 0x2c, 0x51, 0x47, 0xde, 0x82, 0x6b, 0xe2, 0xc1, 0xdc, 0xd2, 0x13, 0x33,
-	0x42, 0xef, 0x89, 0x4c, 0xbd, 0x7b, 0x19, 0x1a, 0xf7, 0x95, 0x5f, 0x41, 0xae, 0x9b, 0xe6, 0x5c,
-	0xda, 0x16, 0x01, 0xab, 0xf7, 0x2e, 0x85, 0x45, 0xcb, 0x03, 0xb8, 0xf1, 0xc7, 0x68, 0x49, 0x35,
-	0x93, 0x64, 0xa9, 0x4b, 0x57, 0x61, 0x09, 0x1d, 0x02, 0x13, 0x69, 0x53, 0xe0, 0xfe, 0xc5, 0x4d,
-	0xce, 0x11, 0x55, 0xe3, 0x8a, 0x44, 0x21, 0xb8, 0x0b, 0xf9, 0x73, 0x0f, 0xa2, 0x70, 0x71, 0x03,
-	0xce, 0x50, 0x17, 0xfe, 0xc6, 0x88, 0x7b, 0xab, 0xc3, 0xef, 0xcf, 0x8e, 0x16, 0xa5, 0xd5, 0xf5,
-	0xe3, 0xa6, 0x26, 0x9d, 0x34, 0x35, 0xe9, 0x67, 0x53, 0x93, 0x3e, 0xb6, 0xb4, 0xcc, 0x49, 0x4b,
-	0xcb, 0x7c, 0x6f, 0x69, 0x99, 0xdd, 0x25, 0xc7, 0x65, 0xe5, 0xda, 0xbe, 0x6e, 0x11, 0xcf, 0x78,
-	0xf1, 0xf6, 0xcd, 0xb3, 0x2d, 0xcc, 0xde, 0x91, 0xb0, 0x62, 0x58, 0x65, 0xe4, 0xfa, 0xc6, 0xa1,
-	0xf8, 0x86, 0xb0, 0x46, 0x80, 0xe9, 0x7e, 0xb6, 0x3d, 0xe9, 0x1f, 0xfd, 0x0e, 0x00, 0x00, 0xff,
-	0xff, 0xfc, 0x90, 0x0d, 0x50, 0x60, 0x06, 0x00, 0x00,
+	// 811 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x3b, 0x4f, 0xfb, 0x56,
+	0x14, 0x8f, 0x49, 0x08, 0xe4, 0x10, 0x92, 0xd6, 0xa1, 0x24, 0x98, 0x12, 0xd2, 0xf4, 0x41, 0x14,
+	0x81, 0x2d, 0x40, 0xea, 0x40, 0x27, 0x1e, 0x45, 0x2a, 0x25, 0x94, 0x1a, 0xb5, 0x52, 0x19, 0xea,
+	0x5e, 0xec, 0x1b, 0xc7, 0x4a, 0xec, 0x1b, 0xf9, 0x5e, 0x42, 0xb2, 0x55, 0xfd, 0x04, 0x95, 0x3a,
+	0x76, 0xee, 0xd0, 0x8d, 0xa1, 0x1f, 0x82, 0x11, 0x75, 0xaa, 0x3a, 0xa0, 0x0a, 0x06, 0xbe, 0x43,
+	0xa7, 0xbf, 0xfc, 0x4c, 0x62, 0x02, 0x84, 0x6c, 0x39, 0xe7, 0xfc, 0xce, 0xef, 0xfc, 0xce, 0xc3,
+	0x8e, 0xa1, 0xd0, 0xec, 0x75, 0xb0, 0x44, 0x19, 0x6a, 0x62, 0x9b, 0x4a, 0x9d, 0x4d, 0x89, 0x75,
+	0xc5, 0xb6, 0x4d, 0x18, 0xe1, 0xb3, 0x4e, 0x44, 0xf4, 0x23, 0x62, 0x67, 0x53, 0xc8, 0xab, 0x84,
+	0x9a, 0x84, 0x4a, 0x26, 0xd5, 0x1d, 0xa0, 0x49, 0x75, 0x0f, 0x29, 0x2c, 0x79, 0x01, 0xc5, 0xb5,
+	0x24, 0xcf, 0xf0, 0x43, 0x0b, 0x3a, 0xd1, 0x89, 0xe7, 0x77, 0x7e, 0xf9, 0xde, 0x95, 0x68, 0xd1,
+	0xa0, 0x8a, 0x1b, 0x2e, 0xff, 0xce, 0x41, 0xae, 0x46, 0xf5, 0xef, 0xda, 0x1a, 0x62, 0x78, 0x9f,
+	0x98, 0xa6, 0x41, 0xa9, 0x41, 0x2c, 0xbe, 0x00, 0x33, 0xaa, 0x8d, 0x11, 0x23, 0x76, 0x81, 0x2b,
+	0x71, 0x95, 0x94, 0x1c, 0x98, 0x7c, 0x1e, 0x66, 0xda, 0x84, 0xb4, 0x14, 0x43, 0x2b, 0x4c, 0x95,
+	0xb8, 0x4a, 0x42, 0x4e, 0x3a, 0xe6, 0x57, 0x1a, 0xbf, 0x0f, 0xa0, 0x86, 0x04, 0x85, 0xb8, 0x93,
+	0xb5, 0xf7, 0xf1, 0xcd, 0xdd, 0x6a, 0xec, 0xdf, 0xbb, 0xd5, 0x65, 0x4f, 0x29, 0xd5, 0x9a, 0xa2,
+	0x41, 0x24, 0x13, 0xb1, 0x86, 0x78, 0x8c, 0x75, 0xa4, 0xf6, 0x0e, 0xb0, 0x2a, 0x0f, 0xa4, 0xed,
+	0xa4, 0x7f, 0x79, 0xbc, 0xae, 0x06, 0xb5, 0xca, 0x2b, 0xb0, 0x3c, 0x42, 0x9c, 0x8c, 0x69, 0x9b,
+	0x58, 0x14, 0x97, 0xff, 0xe0, 0x60, 0x31, 0x8c, 0x9f, 0x39, 0x7d, 0x1d, 0xda, 0x48, 0x65, 0x13,
+	0xea, 0x3f, 0x82, 0x8c, 0x3b, 0x1b, 0xa5, 0xee, 0x93, 0xbc, 0xa5, 0x87, 0x79, 0x3a, 0x58, 0x3e,
+	0xd2, 0x46, 0x09, 0x8a, 0xa3, 0x65, 0x86, 0x9d, 0xfc, 0x39, 0x05, 0xe5, 0x91, 0x9d, 0x5e, 0x21,
+	0x5b, 0xa3, 0x67, 0x98, 0x31, 0xc3, 0xd2, 0xe9, 0x0b, 0x5d, 0x55, 0xe1, 0x7d, 0xa4, 0xaa, 0xb8,
+	0xcd, 0x14, 0xd4, 0x6a, 0x29, 0x1a, 0xb6, 0x88, 0x49, 0xdd, 0xfe, 0x66, 0xe5, 0xac, 0x17, 0xd8,
+	0x6d, 0xb5, 0x0e, 0x5c, 0x37, 0xbf, 0x06, 0xbe, 0x0b, 0x6b, 0x01, 0x32, 0x5e, 0x8a, 0x57, 0x52,
+	0x72, 0x26, 0x70, 0xfb, 0xc0, 0x1f, 0x21, 0x57, 0x27, 0xb6, 0x23, 0x41, 0xd1, 0x30, 0x65, 0x86,
+	0x85, 0xdc, 0xb1, 0x24, 0x4a, 0x5c, 0x25, 0xb3, 0xb5, 0x21, 0x46, 0x8e, 0x56, 0xec, 0xeb, 0x3e,
+	0xf4, 0xb2, 0x0e, 0xfa, 0x49, 0x32, 0x5f, 0x7f, 0xe2, 0xe3, 0x3f, 0x83, 0x6c, 0xc0, 0x1f, 0xac,
+	0x64, 0xda, 0x5d, 0xc9, 0xbc, 0xef, 0x3e, 0x75, 0x37, 0x13, 0x99, 0xe6, 0x3a, 0x54, 0x5f, 0x1f,
+	0x55, 0x38, 0xd9, 0x9b, 0x38, 0xcc, 0xd5, 0xa8, 0x7e, 0x44, 0x0c, 0xcb, 0x61, 0x9b, 0xe4, 0x30,
+	0x3e, 0x82, 0xb4, 0x1b, 0x40, 0x9a, 0x66, 0x63, 0x4a, 0xbd, 0xb3, 0x90, 0xe7, 0x1c, 0xdf, 0xae,
+	0xe7, 0xe2, 0x17, 0x21, 0x89, 0x4c, 0x72, 0x69, 0x31, 0x77, 0x38, 0x09, 0xd9, 0xb7, 0x22, 0xcf,
+	0xc4, 0xf4, 0x44, 0xcf, 0xc4, 0x88, 0xc3, 0x4c, 0x4e, 0x7a, 0x98, 0x0e, 0x97, 0x89, 0xba, 0xca,
+	0x80, 0xa8, 0x99, 0x37, 0x70, 0x99, 0xa8, 0x3b, 0xf0, 0x8e, 0xf8, 0x09, 0x84, 0x61, 0x2e, 0x45,
+	0x6d, 0x20, 0x4b, 0xc7, 0x8a, 0x8d, 0x18, 0x2e, 0xcc, 0x8e, 0xcf, 0x9b, 0x1f, 0xe2, 0xdd, 0x77,
+	0x49, 0x64, 0xc4, 0x70, 0x64, 0xf1, 0x1f, 0x40, 0x6e, 0x60, 0x93, 0xe1, 0x86, 0xbf, 0x81, 0x74,
+	0x8d, 0xea, 0xc7, 0x18, 0x75, 0xf0, 0x84, 0x1b, 0x8e, 0xd4, 0x59, 0x84, 0x85, 0x41, 0xc2, 0xb0,
+	0x10, 0x85, 0x6c, 0x78, 0x78, 0xa7, 0xc8, 0x46, 0x26, 0xe5, 0x3f, 0x87, 0x14, 0xba, 0x64, 0x0d,
+	0x62, 0x1b, 0xac, 0xe7, 0x55, 0xdb, 0x2b, 0xfc, 0xfd, 0xd7, 0xc6, 0x82, 0xff, 0x62, 0xf6, 0xcf,
+	0xe3, 0x8c, 0xd9, 0x86, 0xa5, 0xcb, 0x7d, 0xa8, 0xa3, 0xb1, 0x8d, 0x7a, 0x2d, 0x82, 0x3c, 0x25,
+	0x29, 0x39, 0x30, 0x77, 0x32, 0x8e, 0x94, 0x3e, 0xb2, 0xbc, 0x04, 0xf9, 0x48, 0xd1, 0x40, 0xcf,
+	0xd6, 0xff, 0x09, 0x88, 0xd7, 0xa8, 0xce, 0x9f, 0xc0, 0x6c, 0x78, 0xde, 0x1f, 0x3e, 0x79, 0x2a,
+	0x07, 0x46, 0x26, 0x7c, 0xf2, 0x52, 0x34, 0xe0, 0xe5, 0xbf, 0x85, 0x54, 0x7f, 0x9a, 0x2b, 0xa3,
+	0x52, 0xc2, 0xb0, 0xf0, 0xe9, 0x8b, 0xe1, 0x90, 0xb2, 0x0e, 0xef, 0x3d, 0xf9, 0x8b, 0x19, 0x29,
+	0x26, 0x8a, 0x12, 0xd6, 0xc7, 0x41, 0x85, 0x75, 0x08, 0xe4, 0x46, 0xfd, 0x1b, 0xac, 0x3d, 0x4f,
+	0x32, 0x04, 0x14, 0xa4, 0x31, 0x81, 0x61, 0xc1, 0xdf, 0x38, 0x58, 0x7d, 0xed, 0xad, 0xbd, 0x3d,
+	0x5e, 0x0b, 0x43, 0x49, 0xc2, 0x17, 0x13, 0x24, 0x85, 0xaa, 0xce, 0x21, 0x3d, 0x74, 0xa6, 0xa5,
+	0xe7, 0xc9, 0x3c, 0x84, 0x50, 0x79, 0x0d, 0x11, 0x70, 0x0b, 0xd3, 0x3f, 0x3f, 0x5e, 0x57, 0xb9,
+	0xbd, 0xc3, 0x9b, 0xfb, 0x22, 0x77, 0x7b, 0x5f, 0xe4, 0xfe, 0xbb, 0x2f, 0x72, 0xbf, 0x3e, 0x14,
+	0x63, 0xb7, 0x0f, 0xc5, 0xd8, 0x3f, 0x0f, 0xc5, 0xd8, 0xf9, 0xba, 0x6e, 0xb0, 0xc6, 0xe5, 0x85,
+	0xa8, 0x12, 0x53, 0xfa, 0xfa, 0x87, 0xef, 0xbf, 0x3c, 0xc1, 0xec, 0x8a, 0xd8, 0x4d, 0x49, 0x6d,
+	0x20, 0xc3, 0x92, 0xba, 0xe1, 0xb7, 0x08, 0xeb, 0xb5, 0x31, 0xbd, 0x48, 0xba, 0xdf, 0x21, 0xdb,
+	0xef, 0x06, 0x00, 0x00, 0x54, 0x51, 0xdb, 0x1d, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -595,6 +740,8 @@ type MsgClient interface {
 	UpdateCommission(ctx context.Context, in *MsgUpdateCommission, opts ...grpc.CallOption) (*MsgUpdateCommissionResponse, error)
 	// UpdateStakeFraction ...
 	UpdateStakeFraction(ctx context.Context, in *MsgUpdateStakeFraction, opts ...grpc.CallOption) (*MsgUpdateStakeFractionResponse, error)
+	// UpdateCommissionRewardsSettings ...
+	UpdateCommissionRewardsSettings(ctx context.Context, in *MsgUpdateCommissionRewardsSettings, opts ...grpc.CallOption) (*MsgUpdateCommissionRewardsSettingsResponse, error)
 	// UpdateParams defines a governance operation for updating the x/stakers module
 	// parameters. The authority is hard-coded to the x/gov module account.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
@@ -644,6 +791,15 @@ func (c *msgClient) UpdateStakeFraction(ctx context.Context, in *MsgUpdateStakeF
 	return out, nil
 }
 
+func (c *msgClient) UpdateCommissionRewardsSettings(ctx context.Context, in *MsgUpdateCommissionRewardsSettings, opts ...grpc.CallOption) (*MsgUpdateCommissionRewardsSettingsResponse, error) {
+	out := new(MsgUpdateCommissionRewardsSettingsResponse)
+	err := c.cc.Invoke(ctx, "/kyve.stakers.v1.Msg/UpdateCommissionRewardsSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/kyve.stakers.v1.Msg/UpdateParams", in, out, opts...)
@@ -663,6 +819,8 @@ type MsgServer interface {
 	UpdateCommission(context.Context, *MsgUpdateCommission) (*MsgUpdateCommissionResponse, error)
 	// UpdateStakeFraction ...
 	UpdateStakeFraction(context.Context, *MsgUpdateStakeFraction) (*MsgUpdateStakeFractionResponse, error)
+	// UpdateCommissionRewardsSettings ...
+	UpdateCommissionRewardsSettings(context.Context, *MsgUpdateCommissionRewardsSettings) (*MsgUpdateCommissionRewardsSettingsResponse, error)
 	// UpdateParams defines a governance operation for updating the x/stakers module
 	// parameters. The authority is hard-coded to the x/gov module account.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
@@ -684,6 +842,9 @@ func (*UnimplementedMsgServer) UpdateCommission(ctx context.Context, req *MsgUpd
 func (*UnimplementedMsgServer) UpdateStakeFraction(ctx context.Context, req *MsgUpdateStakeFraction) (*MsgUpdateStakeFractionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateStakeFraction not implemented")
 }
+func (*UnimplementedMsgServer) UpdateCommissionRewardsSettings(ctx context.Context, req *MsgUpdateCommissionRewardsSettings) (*MsgUpdateCommissionRewardsSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCommissionRewardsSettings not implemented")
+}
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -764,6 +925,24 @@ func _Msg_UpdateStakeFraction_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateCommissionRewardsSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateCommissionRewardsSettings)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateCommissionRewardsSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.stakers.v1.Msg/UpdateCommissionRewardsSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateCommissionRewardsSettings(ctx, req.(*MsgUpdateCommissionRewardsSettings))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -803,6 +982,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateStakeFraction",
 			Handler:    _Msg_UpdateStakeFraction_Handler,
 		},
+		{
+			MethodName: "UpdateCommissionRewardsSettings",
+			Handler:    _Msg_UpdateCommissionRewardsSettings_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -948,6 +1131,88 @@ func (m *MsgUpdateStakeFractionResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateCommissionRewardsSettings) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCommissionRewardsSettings) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCommissionRewardsSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ForwardPoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ForwardPoolId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ForwardDestination != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ForwardDestination))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.AcceptedDenoms) > 0 {
+		for iNdEx := len(m.AcceptedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AcceptedDenoms[iNdEx])
+			copy(dAtA[i:], m.AcceptedDenoms[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AcceptedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.AcceptAllDenoms {
+		i--
+		if m.AcceptAllDenoms {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCommissionRewardsSettingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCommissionRewardsSettingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCommissionRewardsSettingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgJoinPool) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -968,6 +1233,26 @@ func (m *MsgJoinPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxCommissionChangeRate.Size()
+		i -= size
+		if _, err := m.MaxCommissionChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.MaxCommission.Size()
+		i -= size
+		if _, err := m.MaxCommission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
 	{
 		size := m.StakeFraction.Size()
 		i -= size
@@ -1221,6 +1506,43 @@ func (m *MsgUpdateStakeFractionResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateCommissionRewardsSettings) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AcceptAllDenoms {
+		n += 2
+	}
+	if len(m.AcceptedDenoms) > 0 {
+		for _, s := range m.AcceptedDenoms {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.ForwardDestination != 0 {
+		n += 1 + sovTx(uint64(m.ForwardDestination))
+	}
+	if m.ForwardPoolId != 0 {
+		n += 1 + sovTx(uint64(m.ForwardPoolId))
+	}
+	return n
+}
+
+func (m *MsgUpdateCommissionRewardsSettingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgJoinPool) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1245,6 +1567,10 @@ func (m *MsgJoinPool) Size() (n int) {
 	n += 1 + l + sovTx(uint64(l))
 	l = m.StakeFraction.Size()
 	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxCommission.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxCommissionChangeRate.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
@@ -1684,6 +2010,228 @@ func (m *MsgUpdateStakeFractionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateCommissionRewardsSettings) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateCommissionRewardsSettings: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateCommissionRewardsSettings: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptAllDenoms", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AcceptAllDenoms = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AcceptedDenoms = append(m.AcceptedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardDestination", wireType)
+			}
+			m.ForwardDestination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForwardDestination |= CommissionForwardDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardPoolId", wireType)
+			}
+			m.ForwardPoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForwardPoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateCommissionRewardsSettingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateCommissionRewardsSettingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateCommissionRewardsSettingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgJoinPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1883,6 +2431,74 @@ func (m *MsgJoinPool) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxCommission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommissionChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxCommissionChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1 +1,43 @@
 package types
+
+import (
+	"cosmossdk.io/math"
+	globalTypes "github.com/KYVENetwork/chain/x/global/types"
+)
+
+// ReliabilityScore returns the share of successful protocol actions of a
+// staker in a pool. Finalized bundles and votes which agreed with the
+// final outcome count as successful, while timeouts, missed votes and
+// slashes only count as failed actions. Abstain votes are ignored. A
+// staker without any history has a score of one.
+func (s PoolAccountStats) ReliabilityScore() math.LegacyDec {
+	successful := s.BundlesFinalized + s.VotesAgreed
+	total := s.BundlesUploaded + s.VotesValid + s.VotesInvalid + s.Timeouts + s.MissedVotes + s.Slashes
+
+	if total == 0 {
+		return math.LegacyOneDec()
+	}
+
+	score := math.LegacyNewDec(int64(successful)).QuoInt64(int64(total))
+	if score.GT(math.LegacyOneDec()) {
+		return math.LegacyOneDec()
+	}
+
+	return score
+}
+
+// AcceptsDenom returns true if the validator accepts the denom as commission
+// rewards. The native denom is always accepted.
+func (s CommissionRewardsSettings) AcceptsDenom(denom string) bool {
+	if denom == globalTypes.Denom {
+		return true
+	}
+
+	for _, acceptedDenom := range s.AcceptedDenoms {
+		if acceptedDenom == denom {
+			return true
+		}
+	}
+
+	return false
+}
@@ -23,6 +23,12 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdCreateTeamVestingAccount())
 	cmd.AddCommand(CmdClaimAuthorityRewards())
 	cmd.AddCommand(CmdClaimAccountRewards())
+	cmd.AddCommand(CmdProposeBeneficiary())
+	cmd.AddCommand(CmdAcceptBeneficiary())
+	cmd.AddCommand(CmdPartialClawback())
+	cmd.AddCommand(CmdReverseClawback())
+	cmd.AddCommand(CmdDelegateTeamTokens())
+	cmd.AddCommand(CmdUndelegateTeamTokens())
 
 	return cmd
 }
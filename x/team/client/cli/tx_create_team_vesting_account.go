@@ -9,6 +9,14 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const (
+	FlagCliffDuration   = "cliff-duration"
+	FlagVestingDuration = "vesting-duration"
+	FlagUnlockDuration  = "unlock-duration"
+	FlagMonthlySteps    = "monthly-steps"
+	FlagBeneficiary     = "beneficiary"
+)
+
 func CmdCreateTeamVestingAccount() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create [total_allocation] [commencement]",
@@ -35,6 +43,32 @@ func CmdCreateTeamVestingAccount() *cobra.Command {
 				TotalAllocation: argAllocation,
 				Commencement:    argCommencementTimeStamp,
 			}
+
+			if msg.Beneficiary, err = cmd.Flags().GetString(FlagBeneficiary); err != nil {
+				return err
+			}
+
+			// only set a schedule if one of the schedule flags was provided
+			if cmd.Flags().Changed(FlagCliffDuration) || cmd.Flags().Changed(FlagVestingDuration) ||
+				cmd.Flags().Changed(FlagUnlockDuration) || cmd.Flags().Changed(FlagMonthlySteps) {
+				schedule := types.DefaultVestingSchedule()
+
+				if schedule.CliffDuration, err = cmd.Flags().GetUint64(FlagCliffDuration); err != nil {
+					return err
+				}
+				if schedule.VestingDuration, err = cmd.Flags().GetUint64(FlagVestingDuration); err != nil {
+					return err
+				}
+				if schedule.UnlockDuration, err = cmd.Flags().GetUint64(FlagUnlockDuration); err != nil {
+					return err
+				}
+				if schedule.MonthlySteps, err = cmd.Flags().GetBool(FlagMonthlySteps); err != nil {
+					return err
+				}
+
+				msg.Schedule = &schedule
+			}
+
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -42,6 +76,11 @@ func CmdCreateTeamVestingAccount() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Uint64(FlagCliffDuration, types.CLIFF_DURATION, "The (optional) cliff duration of the vesting schedule in seconds")
+	cmd.Flags().Uint64(FlagVestingDuration, types.VESTING_DURATION, "The (optional) vesting duration of the vesting schedule in seconds")
+	cmd.Flags().Uint64(FlagUnlockDuration, types.UNLOCK_DURATION, "The (optional) unlock duration of the vesting schedule in seconds")
+	cmd.Flags().Bool(FlagMonthlySteps, false, "Vest in monthly steps instead of linearly")
+	cmd.Flags().String(FlagBeneficiary, "", "The (optional) beneficiary address which can claim on behalf of the account")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -0,0 +1,49 @@
+package cli
+
+import (
+	"github.com/KYVENetwork/chain/x/team/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdDelegateTeamTokens() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegate-team-tokens [id] [validator] [amount]",
+		Short: "Broadcast message delegate-team-tokens",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			argAmount, err := cast.ToUint64E(args[2])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgDelegateTeamTokens{
+				Authority: clientCtx.GetFromAddress().String(),
+				Id:        argId,
+				Validator: args[1],
+				Amount:    argAmount,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
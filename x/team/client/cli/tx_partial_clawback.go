@@ -0,0 +1,74 @@
+package cli
+
+import (
+	"cosmossdk.io/math"
+	"github.com/KYVENetwork/chain/x/team/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FlagFraction = "fraction"
+	FlagTime     = "time"
+)
+
+func CmdPartialClawback() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "partial-clawback [id] [amount]",
+		Short: "Broadcast message partial-clawback",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			argAmount := uint64(0)
+			if len(args) > 1 {
+				if argAmount, err = cast.ToUint64E(args[1]); err != nil {
+					return err
+				}
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgPartialClawback{
+				Authority: clientCtx.GetFromAddress().String(),
+				Id:        argId,
+				Amount:    argAmount,
+				Fraction:  math.LegacyZeroDec(),
+			}
+
+			fraction, err := cmd.Flags().GetString(FlagFraction)
+			if err != nil {
+				return err
+			}
+			if fraction != "" {
+				if msg.Fraction, err = math.LegacyNewDecFromStr(fraction); err != nil {
+					return err
+				}
+			}
+
+			if msg.Time, err = cmd.Flags().GetUint64(FlagTime); err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagFraction, "", "The share of the remaining allocation which should be clawed back instead of an amount")
+	cmd.Flags().Uint64(FlagTime, 0, "The unix timestamp of when the partial clawback should be applied, defaults to now")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
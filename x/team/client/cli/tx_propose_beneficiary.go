@@ -0,0 +1,43 @@
+package cli
+
+import (
+	"github.com/KYVENetwork/chain/x/team/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdProposeBeneficiary() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-beneficiary [id] [new_beneficiary]",
+		Short: "Broadcast message propose-beneficiary",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgProposeBeneficiary{
+				Creator:        clientCtx.GetFromAddress().String(),
+				Id:             argId,
+				NewBeneficiary: args[1],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,54 @@
+package cli
+
+import (
+	"github.com/KYVENetwork/chain/x/team/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FlagPartialClawbackIndex = "partial-clawback-index"
+)
+
+func CmdReverseClawback() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reverse-clawback [id]",
+		Short: "Broadcast message reverse-clawback",
+		Long:  "Reverses the full clawback of the account or, if --partial-clawback-index is set, the partial clawback with the given index",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgReverseClawback{
+				Authority: clientCtx.GetFromAddress().String(),
+				Id:        argId,
+				Full:      !cmd.Flags().Changed(FlagPartialClawbackIndex),
+			}
+
+			if msg.Index, err = cmd.Flags().GetUint64(FlagPartialClawbackIndex); err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagPartialClawbackIndex, 0, "The index of the partial clawback which should be reversed")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
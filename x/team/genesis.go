@@ -15,6 +15,24 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	}
 
 	k.SetTeamVestingAccountCount(ctx, genState.AccountCount)
+
+	for _, elem := range genState.BeneficiaryChangeList {
+		k.SetBeneficiaryChange(ctx, elem)
+	}
+
+	k.SetTeamAuthorities(ctx, genState.TeamAuthorities)
+
+	for _, elem := range genState.TeamDelegationList {
+		k.SetTeamDelegation(ctx, elem)
+	}
+
+	for _, elem := range genState.TeamUnbondingList {
+		k.SetTeamUnbonding(ctx, elem)
+	}
+
+	for _, elem := range genState.TeamPendingClaimList {
+		k.SetTeamPendingClaim(ctx, elem)
+	}
 }
 
 // ExportGenesis returns the team module's exported genesis.
@@ -24,6 +42,11 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.Authority = k.GetAuthority(ctx)
 	genesis.AccountList = k.GetTeamVestingAccounts(ctx)
 	genesis.AccountCount = k.GetTeamVestingAccountCount(ctx)
+	genesis.BeneficiaryChangeList = k.GetAllBeneficiaryChanges(ctx)
+	genesis.TeamAuthorities = k.GetTeamAuthorities(ctx)
+	genesis.TeamDelegationList = k.GetAllTeamDelegations(ctx)
+	genesis.TeamUnbondingList = k.GetAllTeamUnbondings(ctx)
+	genesis.TeamPendingClaimList = k.GetAllTeamPendingClaims(ctx)
 
 	return genesis
 }
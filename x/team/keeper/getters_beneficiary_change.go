@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	storeTypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/KYVENetwork/chain/util"
+	"github.com/KYVENetwork/chain/x/team/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetBeneficiaryChange stores a beneficiary change of a team vesting account.
+func (k Keeper) SetBeneficiaryChange(ctx sdk.Context, change types.BeneficiaryChange) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.BeneficiaryChangeKey)
+	b := k.cdc.MustMarshal(&change)
+	store.Set(types.BeneficiaryChangeKeyPrefix(change.AccountId, change.Index), b)
+}
+
+// GetBeneficiaryChangesOfAccount returns all beneficiary changes of a team vesting
+// account in chronological order.
+func (k Keeper) GetBeneficiaryChangesOfAccount(ctx sdk.Context, accountId uint64) (changes []types.BeneficiaryChange) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.BeneficiaryChangeKey)
+	iterator := storeTypes.KVStorePrefixIterator(store, util.GetByteKey(accountId))
+
+	//goland:noinspection GoUnhandledErrorResult
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var change types.BeneficiaryChange
+		k.cdc.MustUnmarshal(iterator.Value(), &change)
+		changes = append(changes, change)
+	}
+
+	return
+}
+
+// GetAllBeneficiaryChanges returns all beneficiary changes of all team vesting accounts.
+func (k Keeper) GetAllBeneficiaryChanges(ctx sdk.Context) (changes []types.BeneficiaryChange) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.BeneficiaryChangeKey)
+	iterator := storeTypes.KVStorePrefixIterator(store, []byte{})
+
+	//goland:noinspection GoUnhandledErrorResult
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var change types.BeneficiaryChange
+		k.cdc.MustUnmarshal(iterator.Value(), &change)
+		changes = append(changes, change)
+	}
+
+	return
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/KYVENetwork/chain/x/team/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetTeamAuthorities returns the current team authorities. If they were never
+// set the link-time authorities are returned.
+func (k Keeper) GetTeamAuthorities(ctx sdk.Context) (authorities types.TeamAuthorities) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	bz := store.Get(types.TeamAuthoritiesKey)
+
+	// TeamAuthorities don't exist: use link-time authorities
+	if bz == nil {
+		return types.DefaultTeamAuthorities()
+	}
+
+	k.cdc.MustUnmarshal(bz, &authorities)
+	return
+}
+
+// SetTeamAuthorities sets the team authorities
+func (k Keeper) SetTeamAuthorities(ctx sdk.Context, authorities types.TeamAuthorities) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	b := k.cdc.MustMarshal(&authorities)
+	store.Set(types.TeamAuthoritiesKey, b)
+}
@@ -0,0 +1,303 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	storeTypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/KYVENetwork/chain/util"
+	"github.com/KYVENetwork/chain/x/team/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetTeamDelegation stores a team delegation. Delegations without an amount
+// are removed.
+func (k Keeper) SetTeamDelegation(ctx sdk.Context, delegation types.TeamDelegation) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.TeamDelegationKey)
+	key := types.TeamDelegationKeyPrefix(delegation.AccountId, delegation.Validator)
+
+	if delegation.Amount == 0 {
+		store.Delete(key)
+		return
+	}
+
+	b := k.cdc.MustMarshal(&delegation)
+	store.Set(key, b)
+}
+
+// GetTeamDelegation returns the delegation of a team vesting account to the given validator
+func (k Keeper) GetTeamDelegation(ctx sdk.Context, accountId uint64, validator string) (delegation types.TeamDelegation, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.TeamDelegationKey)
+
+	b := store.Get(types.TeamDelegationKeyPrefix(accountId, validator))
+	if b == nil {
+		return types.TeamDelegation{AccountId: accountId, Validator: validator}, false
+	}
+
+	k.cdc.MustUnmarshal(b, &delegation)
+	return delegation, true
+}
+
+// GetTeamDelegationsOfAccount returns all delegations of a team vesting account
+func (k Keeper) GetTeamDelegationsOfAccount(ctx sdk.Context, accountId uint64) (delegations []types.TeamDelegation) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.TeamDelegationKey)
+	iterator := storeTypes.KVStorePrefixIterator(store, util.GetByteKey(accountId))
+
+	//goland:noinspection GoUnhandledErrorResult
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var delegation types.TeamDelegation
+		k.cdc.MustUnmarshal(iterator.Value(), &delegation)
+		delegations = append(delegations, delegation)
+	}
+
+	return
+}
+
+// GetAllTeamDelegations returns all delegations of all team vesting accounts
+func (k Keeper) GetAllTeamDelegations(ctx sdk.Context) (delegations []types.TeamDelegation) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.TeamDelegationKey)
+	iterator := storeTypes.KVStorePrefixIterator(store, []byte{})
+
+	//goland:noinspection GoUnhandledErrorResult
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var delegation types.TeamDelegation
+		k.cdc.MustUnmarshal(iterator.Value(), &delegation)
+		delegations = append(delegations, delegation)
+	}
+
+	return
+}
+
+// SetTeamUnbonding stores a team unbonding. Unbondings without an amount
+// are removed.
+func (k Keeper) SetTeamUnbonding(ctx sdk.Context, unbonding types.TeamUnbonding) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.TeamUnbondingKey)
+	key := types.TeamUnbondingKeyPrefix(unbonding.AccountId, unbonding.Validator, unbonding.CreationHeight, unbonding.CompletionTime)
+
+	queueStore := prefix.NewStore(storeAdapter, types.TeamUnbondingQueueKey)
+	queueKey := types.TeamUnbondingQueueKeyPrefix(unbonding.CompletionTime, unbonding.AccountId, unbonding.Validator, unbonding.CreationHeight)
+
+	if unbonding.Amount == 0 {
+		store.Delete(key)
+		queueStore.Delete(queueKey)
+		return
+	}
+
+	b := k.cdc.MustMarshal(&unbonding)
+	store.Set(key, b)
+	queueStore.Set(queueKey, b)
+}
+
+// GetTeamUnbonding returns the unbonding of a team vesting account which was
+// started at the given height and completes at the given time
+func (k Keeper) GetTeamUnbonding(ctx sdk.Context, accountId uint64, validator string, creationHeight int64, completionTime int64) (unbonding types.TeamUnbonding, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.TeamUnbondingKey)
+
+	b := store.Get(types.TeamUnbondingKeyPrefix(accountId, validator, creationHeight, completionTime))
+	if b == nil {
+		return types.TeamUnbonding{
+			AccountId:      accountId,
+			Validator:      validator,
+			CreationHeight: creationHeight,
+			CompletionTime: completionTime,
+		}, false
+	}
+
+	k.cdc.MustUnmarshal(b, &unbonding)
+	return unbonding, true
+}
+
+// GetTeamUnbondingsOfAccount returns all unbondings of a team vesting account
+func (k Keeper) GetTeamUnbondingsOfAccount(ctx sdk.Context, accountId uint64) (unbondings []types.TeamUnbonding) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.TeamUnbondingKey)
+	iterator := storeTypes.KVStorePrefixIterator(store, util.GetByteKey(accountId))
+
+	//goland:noinspection GoUnhandledErrorResult
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var unbonding types.TeamUnbonding
+		k.cdc.MustUnmarshal(iterator.Value(), &unbonding)
+		unbondings = append(unbondings, unbonding)
+	}
+
+	return
+}
+
+// GetAllTeamUnbondings returns all unbondings of all team vesting accounts
+func (k Keeper) GetAllTeamUnbondings(ctx sdk.Context) (unbondings []types.TeamUnbonding) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.TeamUnbondingKey)
+	iterator := storeTypes.KVStorePrefixIterator(store, []byte{})
+
+	//goland:noinspection GoUnhandledErrorResult
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var unbonding types.TeamUnbonding
+		k.cdc.MustUnmarshal(iterator.Value(), &unbonding)
+		unbondings = append(unbondings, unbonding)
+	}
+
+	return
+}
+
+// GetDueTeamUnbondings returns all unbondings of all team vesting accounts which
+// complete at or before the given unix timestamp in nanoseconds
+func (k Keeper) GetDueTeamUnbondings(ctx sdk.Context, time int64) (unbondings []types.TeamUnbonding) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.TeamUnbondingQueueKey)
+	iterator := store.Iterator(nil, util.GetByteKey(uint64(time)+1))
+
+	//goland:noinspection GoUnhandledErrorResult
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var unbonding types.TeamUnbonding
+		k.cdc.MustUnmarshal(iterator.Value(), &unbonding)
+		unbondings = append(unbondings, unbonding)
+	}
+
+	return
+}
+
+// SetTeamPendingClaim stores a pending claim. Pending claims without an amount
+// are removed.
+func (k Keeper) SetTeamPendingClaim(ctx sdk.Context, claim types.TeamPendingClaim) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.TeamPendingClaimKey)
+	key := types.TeamPendingClaimKeyPrefix(claim.AccountId, claim.Recipient)
+
+	if claim.Amount == 0 {
+		store.Delete(key)
+		return
+	}
+
+	b := k.cdc.MustMarshal(&claim)
+	store.Set(key, b)
+}
+
+// GetTeamPendingClaim returns the pending claim of a team vesting account for the given recipient
+func (k Keeper) GetTeamPendingClaim(ctx sdk.Context, accountId uint64, recipient string) (claim types.TeamPendingClaim, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.TeamPendingClaimKey)
+
+	b := store.Get(types.TeamPendingClaimKeyPrefix(accountId, recipient))
+	if b == nil {
+		return types.TeamPendingClaim{AccountId: accountId, Recipient: recipient}, false
+	}
+
+	k.cdc.MustUnmarshal(b, &claim)
+	return claim, true
+}
+
+// GetTeamPendingClaimsOfAccount returns all pending claims of a team vesting account
+func (k Keeper) GetTeamPendingClaimsOfAccount(ctx sdk.Context, accountId uint64) (claims []types.TeamPendingClaim) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.TeamPendingClaimKey)
+	iterator := storeTypes.KVStorePrefixIterator(store, util.GetByteKey(accountId))
+
+	//goland:noinspection GoUnhandledErrorResult
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var claim types.TeamPendingClaim
+		k.cdc.MustUnmarshal(iterator.Value(), &claim)
+		claims = append(claims, claim)
+	}
+
+	return
+}
+
+// GetAllTeamPendingClaims returns all pending claims of all team vesting accounts
+func (k Keeper) GetAllTeamPendingClaims(ctx sdk.Context) (claims []types.TeamPendingClaim) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.TeamPendingClaimKey)
+	iterator := storeTypes.KVStorePrefixIterator(store, []byte{})
+
+	//goland:noinspection GoUnhandledErrorResult
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var claim types.TeamPendingClaim
+		k.cdc.MustUnmarshal(iterator.Value(), &claim)
+		claims = append(claims, claim)
+	}
+
+	return
+}
+
+// SetTeamRebalanceTime schedules the rebalance of the delegations of a team vesting
+// account at the given unix timestamp in seconds, replacing the previous schedule.
+func (k Keeper) SetTeamRebalanceTime(ctx sdk.Context, accountId uint64, time uint64) {
+	k.RemoveTeamRebalanceTime(ctx, accountId)
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefix.NewStore(storeAdapter, types.TeamRebalanceTimeKey).Set(util.GetByteKey(accountId), util.GetByteKey(time))
+	prefix.NewStore(storeAdapter, types.TeamRebalanceQueueKey).Set(types.TeamRebalanceQueueKeyPrefix(time, accountId), []byte{1})
+}
+
+// RemoveTeamRebalanceTime removes the scheduled rebalance of a team vesting account
+func (k Keeper) RemoveTeamRebalanceTime(ctx sdk.Context, accountId uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	timeStore := prefix.NewStore(storeAdapter, types.TeamRebalanceTimeKey)
+
+	b := timeStore.Get(util.GetByteKey(accountId))
+	if b == nil {
+		return
+	}
+
+	timeStore.Delete(util.GetByteKey(accountId))
+	prefix.NewStore(storeAdapter, types.TeamRebalanceQueueKey).Delete(types.TeamRebalanceQueueKeyPrefix(binary.BigEndian.Uint64(b), accountId))
+}
+
+// GetDueTeamRebalances returns the ids of all team vesting accounts whose rebalance
+// is scheduled at or before the given unix timestamp in seconds
+func (k Keeper) GetDueTeamRebalances(ctx sdk.Context, time uint64) (accountIds []uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.TeamRebalanceQueueKey)
+	iterator := store.Iterator(nil, util.GetByteKey(time+1))
+
+	//goland:noinspection GoUnhandledErrorResult
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		accountIds = append(accountIds, binary.BigEndian.Uint64(iterator.Key()[8:16]))
+	}
+
+	return
+}
+
+// SetTeamRebalanceDuration stores the unbonding time in seconds the rebalances were scheduled with
+func (k Keeper) SetTeamRebalanceDuration(ctx sdk.Context, duration uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	store.Set(types.TeamRebalanceDurationKey, util.GetByteKey(duration))
+}
+
+// GetTeamRebalanceDuration returns the unbonding time in seconds the rebalances were scheduled with
+func (k Keeper) GetTeamRebalanceDuration(ctx sdk.Context) uint64 {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+
+	b := store.Get(types.TeamRebalanceDurationKey)
+	if b == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(b)
+}
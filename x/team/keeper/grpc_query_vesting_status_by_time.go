@@ -39,14 +39,16 @@ func (k Keeper) TeamVestingStatusByTime(c context.Context, req *types.QueryTeamV
 	vestingPlan := GetVestingPlan(account)
 
 	queryVestingPlan := types.QueryVestingPlan{
-		Commencement:         time.Unix(int64(account.Commencement), 0).String(),
-		TokenVestingStart:    time.Unix(int64(vestingPlan.TokenVestingStart), 0).String(),
-		TokenVestingFinished: time.Unix(int64(vestingPlan.TokenVestingFinished), 0).String(),
-		TokenUnlockStart:     time.Unix(int64(vestingPlan.TokenUnlockStart), 0).String(),
-		TokenUnlockFinished:  time.Unix(int64(vestingPlan.TokenUnlockFinished), 0).String(),
-		Clawback:             account.Clawback,
-		ClawbackAmount:       vestingPlan.ClawbackAmount,
-		MaximumVestingAmount: vestingPlan.MaximumVestingAmount,
+		Commencement:          time.Unix(int64(account.Commencement), 0).String(),
+		TokenVestingStart:     time.Unix(int64(vestingPlan.TokenVestingStart), 0).String(),
+		TokenVestingFinished:  time.Unix(int64(vestingPlan.TokenVestingFinished), 0).String(),
+		TokenUnlockStart:      time.Unix(int64(vestingPlan.TokenUnlockStart), 0).String(),
+		TokenUnlockFinished:   time.Unix(int64(vestingPlan.TokenUnlockFinished), 0).String(),
+		Clawback:              account.Clawback,
+		ClawbackAmount:        vestingPlan.ClawbackAmount,
+		MaximumVestingAmount:  vestingPlan.MaximumVestingAmount,
+		PartialClawbackAmount: vestingPlan.PartialClawbackAmount,
+		PartialClawbacks:      account.PartialClawbacks,
 	}
 
 	return &types.QueryTeamVestingStatusByTimeResponse{
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/KYVENetwork/chain/x/team/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) TeamBeneficiaryHistory(c context.Context, req *types.QueryTeamBeneficiaryHistoryRequest) (*types.QueryTeamBeneficiaryHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	account, found := k.GetTeamVestingAccount(ctx, req.Id)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	return &types.QueryTeamBeneficiaryHistoryResponse{
+		Beneficiary:        account.Beneficiary,
+		PendingBeneficiary: account.PendingBeneficiary,
+		Changes:            k.GetBeneficiaryChangesOfAccount(ctx, account.Id),
+	}, nil
+}
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/KYVENetwork/chain/x/team/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) TeamDelegations(c context.Context, req *types.QueryTeamDelegationsRequest) (*types.QueryTeamDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	account, found := k.GetTeamVestingAccount(ctx, req.Id)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	unbondingDuration, err := k.getUnbondingDuration(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	delegated, unbonding := k.GetTeamDelegationAmounts(ctx, account.Id)
+
+	return &types.QueryTeamDelegationsResponse{
+		Delegations:      k.GetTeamDelegationsOfAccount(ctx, account.Id),
+		Unbondings:       k.GetTeamUnbondingsOfAccount(ctx, account.Id),
+		TotalDelegated:   delegated,
+		TotalUnbonding:   unbonding,
+		MaxDelegation:    GetMaxTeamDelegation(account, uint64(ctx.BlockTime().Unix()), 2*unbondingDuration),
+		DelegationLosses: account.DelegationLosses,
+		PendingClaims:    k.GetTeamPendingClaimsOfAccount(ctx, account.Id),
+	}, nil
+}
@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/KYVENetwork/chain/x/team/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterInvariants registers all team invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "module-balance", ModuleBalanceInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "team-allocation", TeamAllocationInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "claimed-unlocked", ClaimedUnlockedInvariant(k))
+}
+
+// AllInvariants runs all invariants of the team module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := ModuleBalanceInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		res, stop = TeamAllocationInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return ClaimedUnlockedInvariant(k)(ctx)
+	}
+}
+
+// ModuleBalanceInvariant checks that the team module balance covers the
+// remaining team allocation and all unclaimed inflation rewards.
+func ModuleBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		info := k.GetTeamInfo(ctx)
+
+		broken := info.TeamModuleBalance < info.RequiredModuleBalance
+
+		return sdk.FormatInvariant(types.ModuleName, "module-balance", fmt.Sprintf(
+			"\tteam module balance: %v\n\trequired module balance: %v\n",
+			info.TeamModuleBalance, info.RequiredModuleBalance,
+		)), broken
+	}
+}
+
+// TeamAllocationInvariant checks that the allocations issued to all team vesting
+// accounts, after full and partial clawbacks, do not exceed the team allocation.
+func TeamAllocationInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		issued := k.GetIssuedTeamAllocation(ctx)
+
+		broken := issued > types.TEAM_ALLOCATION
+
+		return sdk.FormatInvariant(types.ModuleName, "team-allocation", fmt.Sprintf(
+			"\tissued team allocation: %v\n\tteam allocation: %v\n",
+			issued, types.TEAM_ALLOCATION,
+		)), broken
+	}
+}
+
+// ClaimedUnlockedInvariant checks that no team vesting account has claimed more
+// than its unlocked amount or its inflation rewards.
+func ClaimedUnlockedInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		now := uint64(ctx.BlockTime().Unix())
+
+		for _, account := range k.GetTeamVestingAccounts(ctx) {
+			status := GetVestingStatus(account, now)
+
+			if account.UnlockedClaimed > status.TotalUnlockedAmount {
+				broken = true
+				msg += fmt.Sprintf("\taccount %v claimed %v but only %v is unlocked\n",
+					account.Id, account.UnlockedClaimed, status.TotalUnlockedAmount)
+			}
+
+			if account.RewardsClaimed > account.TotalRewards {
+				broken = true
+				msg += fmt.Sprintf("\taccount %v claimed %v rewards but only received %v\n",
+					account.Id, account.RewardsClaimed, account.TotalRewards)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "claimed-unlocked", msg), broken
+	}
+}
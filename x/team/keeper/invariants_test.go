@@ -0,0 +1,117 @@
+package keeper_test
+
+import (
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	teamKeeper "github.com/KYVENetwork/chain/x/team/keeper"
+	"github.com/KYVENetwork/chain/x/team/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+/*
+
+TEST CASES - invariants.go
+
+* invariants_hold_after_claims_and_clawbacks
+* module_balance_invariant_breaks
+* team_allocation_invariant_breaks
+* claimed_unlocked_invariant_breaks
+
+*/
+
+var _ = Describe("invariants.go", Ordered, func() {
+	s := i.NewCleanChainAtTime(int64(types.TGE))
+
+	BeforeEach(func() {
+		// init new clean chain at TGE time
+		s = i.NewCleanChainAtTime(int64(types.TGE))
+
+		s.RunTxTeamSuccess(&types.MsgCreateTeamVestingAccount{
+			Authority:       types.FOUNDATION_ADDRESS,
+			TotalAllocation: 1_000_000 * i.KYVE, // 1m
+			Commencement:    types.TGE - YEAR,
+		})
+
+		s.CommitAfterSeconds(2 * YEAR)
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("invariants_hold_after_claims_and_clawbacks", func() {
+		// ACT
+		s.RunTxTeamSuccess(&types.MsgClaimUnlocked{
+			Authority: types.FOUNDATION_ADDRESS,
+			Id:        0,
+			Amount:    100_000 * i.KYVE,
+			Recipient: i.ALICE,
+		})
+		s.RunTxTeamSuccess(&types.MsgClawback{
+			Authority: types.FOUNDATION_ADDRESS,
+			Id:        0,
+			Clawback:  uint64(s.Ctx().BlockTime().Unix()),
+		})
+
+		s.CommitAfterSeconds(YEAR)
+
+		// ASSERT
+		_, broken := teamKeeper.AllInvariants(s.App().TeamKeeper)(s.Ctx())
+		Expect(broken).To(BeFalse())
+	})
+
+	It("module_balance_invariant_breaks", func() {
+		// ARRANGE
+		authority := s.App().TeamKeeper.GetAuthority(s.Ctx())
+		authority.TotalRewards += 1
+
+		// ACT
+		s.App().TeamKeeper.SetAuthority(s.Ctx(), authority)
+
+		// ASSERT
+		_, broken := teamKeeper.ModuleBalanceInvariant(s.App().TeamKeeper)(s.Ctx())
+		Expect(broken).To(BeTrue())
+
+		// restore the state for the validity checks
+		authority.TotalRewards -= 1
+		s.App().TeamKeeper.SetAuthority(s.Ctx(), authority)
+	})
+
+	It("team_allocation_invariant_breaks", func() {
+		// ACT
+		s.App().TeamKeeper.AppendTeamVestingAccount(s.Ctx(), types.TeamVestingAccount{
+			TotalAllocation: types.TEAM_ALLOCATION,
+			Commencement:    types.TGE,
+		})
+
+		// ASSERT
+		_, broken := teamKeeper.TeamAllocationInvariant(s.App().TeamKeeper)(s.Ctx())
+		Expect(broken).To(BeTrue())
+
+		// restore the state for the validity checks
+		tva, _ := s.App().TeamKeeper.GetTeamVestingAccount(s.Ctx(), 1)
+		tva.Clawback = tva.Commencement
+		s.App().TeamKeeper.SetTeamVestingAccount(s.Ctx(), tva)
+
+		_, broken = teamKeeper.TeamAllocationInvariant(s.App().TeamKeeper)(s.Ctx())
+		Expect(broken).To(BeFalse())
+	})
+
+	It("claimed_unlocked_invariant_breaks", func() {
+		// ARRANGE
+		tva, _ := s.App().TeamKeeper.GetTeamVestingAccount(s.Ctx(), 0)
+		status := teamKeeper.GetVestingStatus(tva, uint64(s.Ctx().BlockTime().Unix()))
+
+		// ACT
+		tva.UnlockedClaimed = status.TotalUnlockedAmount + 1
+		s.App().TeamKeeper.SetTeamVestingAccount(s.Ctx(), tva)
+
+		// ASSERT
+		_, broken := teamKeeper.ClaimedUnlockedInvariant(s.App().TeamKeeper)(s.Ctx())
+		Expect(broken).To(BeTrue())
+
+		// restore the state for the validity checks
+		tva.UnlockedClaimed = 0
+		s.App().TeamKeeper.SetTeamVestingAccount(s.Ctx(), tva)
+	})
+})
@@ -21,10 +21,14 @@ type (
 		storeService store.KVStoreService
 		logger       log.Logger
 
+		authority string
+
 		accountKeeper util.AccountKeeper
 		bankKeeper    types.BankKeeper
 		mintKeeper    mintKeeper.Keeper
 		upgradeKeeper util.UpgradeKeeper
+		stakingKeeper types.StakingKeeper
+		distrKeeper   types.DistributionKeeper
 	}
 )
 
@@ -33,20 +37,28 @@ func NewKeeper(
 	storeService store.KVStoreService,
 	logger log.Logger,
 
+	authority string,
+
 	accountKeeper util.AccountKeeper,
 	bankKeeper types.BankKeeper,
 	mintKeeper mintKeeper.Keeper,
 	upgradeKeeper util.UpgradeKeeper,
+	stakingKeeper types.StakingKeeper,
+	distrKeeper types.DistributionKeeper,
 ) Keeper {
 	return Keeper{
 		cdc:          cdc,
 		storeService: storeService,
 		logger:       logger,
 
+		authority: authority,
+
 		accountKeeper: accountKeeper,
 		bankKeeper:    bankKeeper,
 		mintKeeper:    mintKeeper,
 		upgradeKeeper: upgradeKeeper,
+		stakingKeeper: stakingKeeper,
+		distrKeeper:   distrKeeper,
 	}
 }
 
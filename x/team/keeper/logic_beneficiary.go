@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"github.com/KYVENetwork/chain/x/team/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// canClaimForAccount returns true if the given address is allowed to claim
+// unlocked tokens and inflation rewards of the team vesting account. This is
+// the case for the authority and the beneficiary of the account.
+func (k Keeper) canClaimForAccount(ctx sdk.Context, account types.TeamVestingAccount, address string) bool {
+	authorities := k.GetTeamAuthorities(ctx)
+	if authorities.IsAuthority(address) {
+		return true
+	}
+
+	return account.Beneficiary != "" && account.Beneficiary == address
+}
+
+// changeBeneficiary sets the new beneficiary of the given account, clears a
+// pending beneficiary and records the change in the beneficiary history of the
+// account. The caller is responsible for storing the updated account.
+func (k Keeper) changeBeneficiary(ctx sdk.Context, account *types.TeamVestingAccount, newBeneficiary string, proposer string) {
+	previousBeneficiary := account.Beneficiary
+
+	account.Beneficiary = newBeneficiary
+	account.PendingBeneficiary = ""
+	account.PendingBeneficiaryProposer = ""
+
+	k.SetBeneficiaryChange(ctx, types.BeneficiaryChange{
+		AccountId:           account.Id,
+		Index:               uint64(len(k.GetBeneficiaryChangesOfAccount(ctx, account.Id))),
+		PreviousBeneficiary: previousBeneficiary,
+		NewBeneficiary:      newBeneficiary,
+		Proposer:            proposer,
+		Timestamp:           uint64(ctx.BlockTime().Unix()),
+	})
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventBeneficiaryChanged{
+		Id:                  account.Id,
+		PreviousBeneficiary: previousBeneficiary,
+		NewBeneficiary:      newBeneficiary,
+		Proposer:            proposer,
+	})
+}
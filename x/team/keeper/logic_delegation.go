@@ -0,0 +1,685 @@
+package keeper
+
+import (
+	"sort"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	"github.com/KYVENetwork/chain/util"
+	globalTypes "github.com/KYVENetwork/chain/x/global/types"
+	"github.com/KYVENetwork/chain/x/team/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetMaxTeamDelegation returns the maximum amount of $KYVE a team vesting account can have
+// delegated at the given time. Only tokens which are still locked after the given duration
+// can be delegated, so that undelegated tokens are liquid again before they unlock. Of those
+// tokens at most MAX_DELEGATION_PERCENTAGE can be delegated.
+func GetMaxTeamDelegation(account types.TeamVestingAccount, time uint64, duration uint64) uint64 {
+	maxAmount := getVestingMaxAmount(account)
+	unlocked := getUnlockedAmount(account, time+duration)
+
+	if maxAmount <= unlocked+account.DelegationLosses {
+		return 0
+	}
+
+	return (maxAmount - unlocked - account.DelegationLosses) * types.MAX_DELEGATION_PERCENTAGE / 100
+}
+
+// getHeldAmount returns the amount of $KYVE the team module still holds for the given
+// account, no matter if it is liquid, delegated or unbonding.
+func getHeldAmount(account types.TeamVestingAccount) uint64 {
+	maxAmount := getVestingMaxAmount(account)
+
+	if maxAmount <= account.UnlockedClaimed+account.DelegationLosses {
+		return 0
+	}
+
+	return maxAmount - account.UnlockedClaimed - account.DelegationLosses
+}
+
+// GetTeamDelegationAmounts returns the amount of $KYVE of the given account which is
+// currently bonded and unbonding.
+func (k Keeper) GetTeamDelegationAmounts(ctx sdk.Context, accountId uint64) (delegated uint64, unbonding uint64) {
+	for _, delegation := range k.GetTeamDelegationsOfAccount(ctx, accountId) {
+		delegated += delegation.Amount
+	}
+
+	for _, entry := range k.GetTeamUnbondingsOfAccount(ctx, accountId) {
+		unbonding += entry.Amount
+	}
+
+	return
+}
+
+// getUnbondingDuration returns the unbonding time of the staking module in seconds
+func (k Keeper) getUnbondingDuration(ctx sdk.Context) (uint64, error) {
+	unbondingTime, err := k.stakingKeeper.UnbondingTime(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(unbondingTime.Seconds()), nil
+}
+
+// delegateTeamTokens delegates the given amount of $KYVE of the team module to the
+// validator and tracks it for the given account. The tokens are delegated by the team
+// delegation account, because the team module account can not receive the staking rewards
+// and the tokens of completed unbondings. Tokens which are lost due to rounding of the
+// delegation shares are recorded as delegation losses of the account.
+func (k Keeper) delegateTeamTokens(ctx sdk.Context, account *types.TeamVestingAccount, validator string, amount uint64) error {
+	valAddress, err := sdk.ValAddressFromBech32(validator)
+	if err != nil {
+		return errors.Wrapf(sdkErrors.ErrInvalidAddress, "invalid validator address (%s)", err)
+	}
+	validator = valAddress.String()
+
+	stakingValidator, err := k.stakingKeeper.GetValidator(ctx, valAddress)
+	if err != nil {
+		return errors.Wrapf(sdkErrors.ErrNotFound, "validator %v not found", validator)
+	}
+
+	k.creditTeamDelegationRewards(ctx, account, valAddress)
+
+	if err := util.TransferFromModuleToModule(k.bankKeeper, ctx, types.ModuleName, types.TeamDelegationAccountName, amount); err != nil {
+		return err
+	}
+
+	delegationAddress := k.accountKeeper.GetModuleAddress(types.TeamDelegationAccountName)
+
+	newShares, err := k.stakingKeeper.Delegate(ctx, delegationAddress, math.NewIntFromUint64(amount), stakingTypes.Unbonded, stakingValidator, true)
+	if err != nil {
+		return err
+	}
+
+	// get the token value of the new shares with the updated validator
+	stakingValidator, err = k.stakingKeeper.GetValidator(ctx, valAddress)
+	if err != nil {
+		return err
+	}
+
+	bonded := stakingValidator.TokensFromShares(newShares).TruncateInt().Uint64()
+	if bonded > amount {
+		bonded = amount
+	}
+
+	delegation, _ := k.GetTeamDelegation(ctx, account.Id, validator)
+	delegation.Amount += bonded
+	k.SetTeamDelegation(ctx, delegation)
+
+	account.DelegationLosses += amount - bonded
+	k.scheduleTeamRebalance(ctx, *account)
+
+	return nil
+}
+
+// undelegateTeamTokens starts unbonding the given amount of $KYVE of the account from
+// the validator. Tokens which are lost due to rounding of the delegation shares are
+// recorded as delegation losses of the account. The authority is empty if the tokens
+// get undelegated automatically.
+func (k Keeper) undelegateTeamTokens(ctx sdk.Context, account *types.TeamVestingAccount, validator string, amount uint64, authority string) error {
+	valAddress, err := sdk.ValAddressFromBech32(validator)
+	if err != nil {
+		return errors.Wrapf(sdkErrors.ErrInvalidAddress, "invalid validator address (%s)", err)
+	}
+	validator = valAddress.String()
+
+	delegation, found := k.GetTeamDelegation(ctx, account.Id, validator)
+	if !found || delegation.Amount < amount {
+		return errors.Wrapf(sdkErrors.ErrLogic, types.ErrUndelegationTooHigh.Error(), amount, delegation.Amount, validator)
+	}
+
+	k.creditTeamDelegationRewards(ctx, account, valAddress)
+
+	delegationAddress := k.accountKeeper.GetModuleAddress(types.TeamDelegationAccountName)
+
+	shares, err := k.stakingKeeper.ValidateUnbondAmount(ctx, delegationAddress, valAddress, math.NewIntFromUint64(amount))
+	if err != nil {
+		return err
+	}
+
+	completionTime, returnAmount, err := k.stakingKeeper.Undelegate(ctx, delegationAddress, valAddress, shares)
+	if err != nil {
+		return err
+	}
+
+	unbondingAmount := returnAmount.Uint64()
+	if unbondingAmount > amount {
+		unbondingAmount = amount
+	}
+
+	delegation.Amount -= amount
+	k.SetTeamDelegation(ctx, delegation)
+
+	unbonding, _ := k.GetTeamUnbonding(ctx, account.Id, validator, ctx.BlockHeight(), completionTime.UnixNano())
+	unbonding.Amount += unbondingAmount
+	k.SetTeamUnbonding(ctx, unbonding)
+
+	account.DelegationLosses += amount - unbondingAmount
+	k.scheduleTeamRebalance(ctx, *account)
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventUndelegateTeamTokens{
+		Authority:      authority,
+		Id:             account.Id,
+		Validator:      validator,
+		Amount:         unbondingAmount,
+		CompletionTime: uint64(completionTime.Unix()),
+	})
+
+	return nil
+}
+
+// undelegateFromAccount undelegates the given amount of $KYVE from all validators the
+// account has delegated to, in the order the delegations are stored.
+func (k Keeper) undelegateFromAccount(ctx sdk.Context, account *types.TeamVestingAccount, amount uint64, authority string) error {
+	for _, delegation := range k.GetTeamDelegationsOfAccount(ctx, account.Id) {
+		if amount == 0 {
+			break
+		}
+
+		undelegation := delegation.Amount
+		if undelegation > amount {
+			undelegation = amount
+		}
+
+		if err := k.undelegateTeamTokens(ctx, account, delegation.Validator, undelegation, authority); err != nil {
+			return err
+		}
+
+		amount -= undelegation
+	}
+
+	return nil
+}
+
+// getPendingClaimAmount returns the amount of $KYVE of all pending claims of the account
+func (k Keeper) getPendingClaimAmount(ctx sdk.Context, accountId uint64) (pending uint64) {
+	for _, claim := range k.GetTeamPendingClaimsOfAccount(ctx, accountId) {
+		pending += claim.Amount
+	}
+
+	return
+}
+
+// getLiquidAmount returns the amount of $KYVE the team module holds for the account
+// minus the given amount of tokens which are not available, e.g. because they are
+// delegated, unbonding or reserved for pending claims.
+func getLiquidAmount(account types.TeamVestingAccount, unavailable uint64) uint64 {
+	held := getHeldAmount(account)
+	if held <= unavailable {
+		return 0
+	}
+
+	return held - unavailable
+}
+
+// addPendingClaim undelegates the tokens of the account which are missing to pay the
+// claim and records the claim, so that it gets paid once the unbonding has completed.
+// Tokens which are already unbonding and not reserved for other pending claims count
+// towards the claim.
+func (k Keeper) addPendingClaim(ctx sdk.Context, account *types.TeamVestingAccount, recipient string, amount uint64, authority string) error {
+	delegated, _ := k.GetTeamDelegationAmounts(ctx, account.Id)
+
+	// the account can only keep the delegations which are covered by the tokens it still
+	// holds after all pending claims and this claim got paid
+	required := delegated + k.getPendingClaimAmount(ctx, account.Id) + amount
+	if held := getHeldAmount(*account); required > held && delegated > 0 {
+		undelegation := required - held
+		if undelegation > delegated {
+			undelegation = delegated
+		}
+
+		if err := k.undelegateFromAccount(ctx, account, undelegation, ""); err != nil {
+			return err
+		}
+	}
+
+	claim, _ := k.GetTeamPendingClaim(ctx, account.Id, recipient)
+	claim.Amount += amount
+	claim.Authority = authority
+	k.SetTeamPendingClaim(ctx, claim)
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventClaimUnlockedPending{
+		Authority: authority,
+		Id:        account.Id,
+		Amount:    amount,
+		Recipient: recipient,
+	})
+
+	return nil
+}
+
+// payPendingClaims pays the pending claims of the given accounts once the liquid tokens
+// of the account cover them. As long as tokens of the account are unbonding the claims
+// wait, afterwards they are paid as far as the liquid and claimable tokens of the
+// account allow, e.g. if the unbonding tokens got slashed.
+func (k Keeper) payPendingClaims(ctx sdk.Context, accountIds []uint64) {
+	now := uint64(ctx.BlockTime().Unix())
+
+	for _, accountId := range accountIds {
+		claims := k.GetTeamPendingClaimsOfAccount(ctx, accountId)
+		if len(claims) == 0 {
+			continue
+		}
+
+		account, found := k.GetTeamVestingAccount(ctx, accountId)
+		if !found {
+			continue
+		}
+
+		delegated, unbonding := k.GetTeamDelegationAmounts(ctx, account.Id)
+
+		for _, claim := range claims {
+			liquid := getLiquidAmount(account, delegated+unbonding)
+			claimable := GetVestingStatus(account, now).CurrentClaimableAmount
+
+			amount := claim.Amount
+			if amount > liquid || amount > claimable {
+				if unbonding > 0 {
+					continue
+				}
+
+				amount = util.MinUInt64(amount, util.MinUInt64(liquid, claimable))
+			}
+
+			if amount > 0 {
+				if err := util.TransferFromModuleToAddress(k.bankKeeper, ctx, types.ModuleName, claim.Recipient, amount); err != nil {
+					k.Logger().Error("failed to pay pending claim", "account", account.Id, "recipient", claim.Recipient, "err", err)
+					continue
+				}
+
+				account.UnlockedClaimed += amount
+				account.LastClaimedTime = now
+
+				_ = ctx.EventManager().EmitTypedEvent(&types.EventClaimedUnlocked{
+					Authority: claim.Authority,
+					Id:        account.Id,
+					Amount:    amount,
+					Recipient: claim.Recipient,
+				})
+			}
+
+			claim.Amount = 0
+			k.SetTeamPendingClaim(ctx, claim)
+		}
+
+		k.SetTeamVestingAccount(ctx, account)
+	}
+}
+
+// creditTeamDelegationRewards withdraws the staking rewards of the team delegations to
+// the given validator and credits them to the inflation rewards of the delegating
+// accounts, proportionally to their delegations. The rewards have to be withdrawn
+// before the delegations change, because the distribution module withdraws them to
+// the team delegation account otherwise. The withdrawn rewards are moved to the team
+// module, which pays them out. The given account is updated in place and has to be
+// stored by the caller, rewards in other denoms than $KYVE stay with the team module.
+func (k Keeper) creditTeamDelegationRewards(ctx sdk.Context, account *types.TeamVestingAccount, valAddress sdk.ValAddress) {
+	validator := valAddress.String()
+
+	delegations := make([]types.TeamDelegation, 0)
+	total := uint64(0)
+
+	for _, delegation := range k.GetAllTeamDelegations(ctx) {
+		if delegation.Validator == validator {
+			delegations = append(delegations, delegation)
+			total += delegation.Amount
+		}
+	}
+
+	if total == 0 {
+		return
+	}
+
+	// withdraw and move the rewards in a cache context, so that no rewards are credited
+	// which did not arrive at the team module
+	cacheCtx, write := ctx.CacheContext()
+
+	rewards, err := k.distrKeeper.WithdrawDelegationRewards(cacheCtx, k.accountKeeper.GetModuleAddress(types.TeamDelegationAccountName), valAddress)
+	if err != nil {
+		k.Logger().Error("failed to withdraw team delegation rewards", "validator", validator, "err", err)
+		return
+	}
+
+	if !rewards.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(cacheCtx, types.TeamDelegationAccountName, types.ModuleName, rewards); err != nil {
+			k.Logger().Error("failed to move team delegation rewards", "validator", validator, "err", err)
+			return
+		}
+	}
+
+	write()
+
+	// distribute the rewards proportionally between the accounts, the remainder is
+	// assigned to the last account
+	amount := rewards.AmountOf(globalTypes.Denom).Uint64()
+	remaining := amount
+
+	for i, delegation := range delegations {
+		reward := uint64(math.LegacyNewDec(int64(amount)).MulInt64(int64(delegation.Amount)).QuoInt64(int64(total)).TruncateInt64())
+		if i == len(delegations)-1 {
+			reward = remaining
+		}
+		remaining -= reward
+
+		if reward == 0 {
+			continue
+		}
+
+		if delegation.AccountId == account.Id {
+			account.TotalRewards += reward
+		} else {
+			delegator, found := k.GetTeamVestingAccount(ctx, delegation.AccountId)
+			if !found {
+				continue
+			}
+
+			delegator.TotalRewards += reward
+			k.SetTeamVestingAccount(ctx, delegator)
+		}
+
+		_ = ctx.EventManager().EmitTypedEvent(&types.EventTeamDelegationRewards{
+			Id:        delegation.AccountId,
+			Validator: validator,
+			Amount:    reward,
+		})
+	}
+}
+
+// getRebalanceTime returns the first time at or after the given time at which the given
+// delegation exceeds the maximum delegation of the account for the given duration. The
+// maximum delegation can only decrease over time, so the time is found by a binary
+// search. If the delegation never exceeds the maximum delegation false is returned.
+func getRebalanceTime(account types.TeamVestingAccount, delegated uint64, time uint64, duration uint64) (uint64, bool) {
+	plan := GetVestingPlan(account)
+
+	// once everything has vested and unlocked the maximum delegation does not change anymore
+	high := util.MaxUInt64(time, util.MaxUInt64(plan.TokenVestingFinished, plan.TokenUnlockFinished))
+	if delegated <= GetMaxTeamDelegation(account, high, duration) {
+		return 0, false
+	}
+
+	low := time
+	for low < high {
+		mid := low + (high-low)/2
+		if delegated > GetMaxTeamDelegation(account, mid, duration) {
+			high = mid
+		} else {
+			low = mid + 1
+		}
+	}
+
+	return low, true
+}
+
+// scheduleTeamRebalance schedules the next rebalance of the delegations of the account at
+// the time its delegations exceed its maximum delegation for the unbonding time. It has to
+// be called whenever the delegations or the vesting of the account change.
+func (k Keeper) scheduleTeamRebalance(ctx sdk.Context, account types.TeamVestingAccount) {
+	unbondingDuration, err := k.getUnbondingDuration(ctx)
+	if err != nil {
+		k.Logger().Error("failed to get unbonding time", "err", err)
+		return
+	}
+
+	delegated, _ := k.GetTeamDelegationAmounts(ctx, account.Id)
+
+	rebalanceTime, found := getRebalanceTime(account, delegated, uint64(ctx.BlockTime().Unix()), unbondingDuration)
+	if !found {
+		k.RemoveTeamRebalanceTime(ctx, account.Id)
+		return
+	}
+
+	k.SetTeamRebalanceTime(ctx, account.Id, rebalanceTime)
+}
+
+// RebalanceTeamDelegations undelegates the tokens of the team vesting accounts whose
+// scheduled rebalance is due and which exceed their maximum delegation. To avoid
+// undelegating small amounts every block the delegations are reduced to the maximum
+// delegation for twice the unbonding time once they exceed the maximum delegation for
+// the unbonding time. If the unbonding time changed, all accounts get rescheduled.
+func (k Keeper) RebalanceTeamDelegations(ctx sdk.Context) {
+	unbondingDuration, err := k.getUnbondingDuration(ctx)
+	if err != nil {
+		k.Logger().Error("failed to get unbonding time", "err", err)
+		return
+	}
+
+	if unbondingDuration != k.GetTeamRebalanceDuration(ctx) {
+		for _, account := range k.GetTeamVestingAccounts(ctx) {
+			k.scheduleTeamRebalance(ctx, account)
+		}
+
+		k.SetTeamRebalanceDuration(ctx, unbondingDuration)
+	}
+
+	now := uint64(ctx.BlockTime().Unix())
+
+	for _, accountId := range k.GetDueTeamRebalances(ctx, now) {
+		account, found := k.GetTeamVestingAccount(ctx, accountId)
+		if !found {
+			k.RemoveTeamRebalanceTime(ctx, accountId)
+			continue
+		}
+
+		delegated, _ := k.GetTeamDelegationAmounts(ctx, account.Id)
+		if delegated > GetMaxTeamDelegation(account, now, unbondingDuration) {
+			target := GetMaxTeamDelegation(account, now, 2*unbondingDuration)
+
+			// undelegations can fail, e.g. if the maximum number of unbonding entries is reached.
+			// In that case the state is not changed and the rebalance stays due for the next block
+			cacheCtx, write := ctx.CacheContext()
+			if err := k.undelegateFromAccount(cacheCtx, &account, delegated-target, ""); err != nil {
+				k.Logger().Error("failed to rebalance team delegations", "account", account.Id, "err", err)
+				continue
+			}
+
+			k.SetTeamVestingAccount(cacheCtx, account)
+			write()
+		}
+
+		k.scheduleTeamRebalance(ctx, account)
+	}
+}
+
+// ProcessTeamUnbondings syncs the team unbondings whose completion time has passed and pays
+// the pending claims of the accounts which got their tokens back. Unbondings which have not
+// been completed by the staking module yet stay in the queue and are synced again next block.
+func (k Keeper) ProcessTeamUnbondings(ctx sdk.Context) {
+	unbondings := k.GetDueTeamUnbondings(ctx, ctx.BlockTime().UnixNano())
+	if len(unbondings) == 0 {
+		return
+	}
+
+	k.payPendingClaims(ctx, k.syncTeamUnbondings(ctx, unbondings))
+}
+
+// SyncTeamUnbondings compares the tracked unbondings of the given validator with the
+// unbonding delegations of the staking module and pays the pending claims of the
+// affected accounts.
+func (k Keeper) SyncTeamUnbondings(ctx sdk.Context, validator string) {
+	unbondings := make([]types.TeamUnbonding, 0)
+
+	for _, unbonding := range k.GetAllTeamUnbondings(ctx) {
+		if unbonding.Validator == validator {
+			unbondings = append(unbondings, unbonding)
+		}
+	}
+
+	k.payPendingClaims(ctx, k.syncTeamUnbondings(ctx, unbondings))
+}
+
+// syncTeamUnbondings compares the given tracked unbondings with the unbonding delegations
+// of the staking module. Slashed unbondings are recorded as delegation losses of the
+// corresponding accounts and completed unbondings get removed. It returns the sorted ids
+// of all accounts whose unbondings changed.
+func (k Keeper) syncTeamUnbondings(ctx sdk.Context, trackedUnbondings []types.TeamUnbonding) []uint64 {
+	type entryKey struct {
+		validator      string
+		creationHeight int64
+		completionTime int64
+	}
+
+	// group the tracked unbondings like the staking module merges unbonding entries
+	groups := make(map[entryKey][]types.TeamUnbonding)
+	keys := make([]entryKey, 0)
+
+	for _, unbonding := range trackedUnbondings {
+		key := entryKey{unbonding.Validator, unbonding.CreationHeight, unbonding.CompletionTime}
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], unbonding)
+	}
+
+	// sort the keys so that the state changes are deterministic
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].validator != keys[j].validator {
+			return keys[i].validator < keys[j].validator
+		}
+		if keys[i].creationHeight != keys[j].creationHeight {
+			return keys[i].creationHeight < keys[j].creationHeight
+		}
+		return keys[i].completionTime < keys[j].completionTime
+	})
+
+	delegationAddress := k.accountKeeper.GetModuleAddress(types.TeamDelegationAccountName)
+	changed := make(map[uint64]struct{})
+
+	for _, key := range keys {
+		unbondings := groups[key]
+
+		balance, found := k.getUnbondingEntryBalance(ctx, delegationAddress, key.validator, key.creationHeight, key.completionTime)
+
+		// the unbonding has completed and the tokens were paid out to the team delegation
+		// account, from where they are moved back to the team module
+		if !found {
+			completed := uint64(0)
+			for _, unbonding := range unbondings {
+				completed += unbonding.Amount
+			}
+
+			if err := util.TransferFromModuleToModule(k.bankKeeper, ctx, types.TeamDelegationAccountName, types.ModuleName, completed); err != nil {
+				k.Logger().Error("failed to return completed team unbonding", "validator", key.validator, "err", err)
+				continue
+			}
+
+			for _, unbonding := range unbondings {
+				unbonding.Amount = 0
+				k.SetTeamUnbonding(ctx, unbonding)
+				changed[unbonding.AccountId] = struct{}{}
+			}
+			continue
+		}
+
+		total := uint64(0)
+		for _, unbonding := range unbondings {
+			total += unbonding.Amount
+		}
+
+		if balance >= total {
+			continue
+		}
+
+		// distribute the slashed amount proportionally between the accounts, the
+		// remainder is assigned to the last account
+		slashed := total - balance
+		remaining := slashed
+
+		for i, unbonding := range unbondings {
+			loss := uint64(math.LegacyNewDec(int64(slashed)).MulInt64(int64(unbonding.Amount)).QuoInt64(int64(total)).TruncateInt64())
+			if i == len(unbondings)-1 {
+				loss = remaining
+			}
+			if loss > unbonding.Amount {
+				loss = unbonding.Amount
+			}
+			remaining -= loss
+
+			unbonding.Amount -= loss
+			k.SetTeamUnbonding(ctx, unbonding)
+			changed[unbonding.AccountId] = struct{}{}
+
+			k.recordDelegationLoss(ctx, unbonding.AccountId, unbonding.Validator, loss)
+		}
+	}
+
+	accountIds := make([]uint64, 0, len(changed))
+	for accountId := range changed {
+		accountIds = append(accountIds, accountId)
+	}
+	sort.Slice(accountIds, func(i, j int) bool { return accountIds[i] < accountIds[j] })
+
+	return accountIds
+}
+
+// getUnbondingEntryBalance returns the balance of the unbonding delegation entry of the
+// team delegation account which was created at the given height and completes at the given time.
+func (k Keeper) getUnbondingEntryBalance(ctx sdk.Context, delegator sdk.AccAddress, validator string, creationHeight int64, completionTime int64) (uint64, bool) {
+	valAddress, err := sdk.ValAddressFromBech32(validator)
+	if err != nil {
+		return 0, false
+	}
+
+	unbondingDelegation, err := k.stakingKeeper.GetUnbondingDelegation(ctx, delegator, valAddress)
+	if err != nil {
+		return 0, false
+	}
+
+	for _, entry := range unbondingDelegation.Entries {
+		if entry.CreationHeight == creationHeight && entry.CompletionTime.UnixNano() == completionTime {
+			return entry.Balance.Uint64(), true
+		}
+	}
+
+	return 0, false
+}
+
+// slashTeamDelegations reduces the delegations of all team vesting accounts to the given
+// validator by the slashed fraction and records the slashed tokens as delegation losses.
+// The slashed amount is rounded up, so that the recorded losses are never lower than
+// the tokens which were actually burned.
+func (k Keeper) slashTeamDelegations(ctx sdk.Context, validator string, fraction math.LegacyDec) {
+	for _, delegation := range k.GetAllTeamDelegations(ctx) {
+		if delegation.Validator != validator {
+			continue
+		}
+
+		loss := uint64(math.LegacyNewDec(int64(delegation.Amount)).Mul(fraction).Ceil().TruncateInt64())
+		if loss > delegation.Amount {
+			loss = delegation.Amount
+		}
+
+		delegation.Amount -= loss
+		k.SetTeamDelegation(ctx, delegation)
+
+		k.recordDelegationLoss(ctx, delegation.AccountId, delegation.Validator, loss)
+	}
+}
+
+// recordDelegationLoss adds the lost tokens to the delegation losses of the account
+func (k Keeper) recordDelegationLoss(ctx sdk.Context, accountId uint64, validator string, loss uint64) {
+	if loss == 0 {
+		return
+	}
+
+	account, found := k.GetTeamVestingAccount(ctx, accountId)
+	if !found {
+		return
+	}
+
+	account.DelegationLosses += loss
+	k.SetTeamVestingAccount(ctx, account)
+
+	// the maximum delegation of the account decreased, so it might have to be rebalanced earlier
+	k.scheduleTeamRebalance(ctx, account)
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventTeamDelegationSlashed{
+		Id:        accountId,
+		Validator: validator,
+		Amount:    loss,
+	})
+}
@@ -54,8 +54,9 @@ func GetVestingStatus(account types.TeamVestingAccount, time uint64) *types.Vest
 	// get total allocation
 	status.TotalVestedAmount = getVestedAmount(account, time)
 	status.TotalUnlockedAmount = getUnlockedAmount(account, time)
-	if status.TotalUnlockedAmount > account.UnlockedClaimed {
-		status.CurrentClaimableAmount = getUnlockedAmount(account, time) - account.UnlockedClaimed
+	// tokens lost through slashed delegations are deducted from the claimable amount
+	if status.TotalUnlockedAmount > account.UnlockedClaimed+account.DelegationLosses {
+		status.CurrentClaimableAmount = status.TotalUnlockedAmount - account.UnlockedClaimed - account.DelegationLosses
 	}
 
 	status.LockedVestedAmount = status.TotalVestedAmount - status.TotalUnlockedAmount
@@ -71,19 +72,26 @@ func GetVestingPlan(account types.TeamVestingAccount) *types.VestingPlan {
 	plan.MaximumVestingAmount = getVestingMaxAmount(account)
 	plan.ClawbackAmount = account.TotalAllocation - plan.MaximumVestingAmount
 
-	plan.TokenVestingStart = account.Commencement + types.CLIFF_DURATION
-	plan.TokenVestingFinished = account.Commencement + types.VESTING_DURATION
+	// the partial clawback amount is the clawback amount the account would have without a full clawback
+	withoutClawback := account
+	withoutClawback.Clawback = 0
+	plan.PartialClawbackAmount = account.TotalAllocation - getVestingMaxAmount(withoutClawback)
+
+	schedule := account.GetVestingSchedule()
+
+	plan.TokenVestingStart = account.Commencement + schedule.CliffDuration
+	plan.TokenVestingFinished = account.Commencement + schedule.VestingDuration
 
 	plan.TokenUnlockStart = getLockUpReferenceDate(account)
-	plan.TokenUnlockFinished = getLockUpReferenceDate(account) + types.UNLOCK_DURATION
+	plan.TokenUnlockFinished = getLockUpReferenceDate(account) + schedule.UnlockDuration
 
 	return &plan
 }
 
 // GetIssuedTeamAllocation gets the total amount in $KYVE which is issued to all team vesting accounts.
 // It is equal to the sum of all max vesting amounts, because normally the usage of all
-// vesting accounts is the sum of all allocations minus the clawback which getVestingMaxAmount
-// already takes into account
+// vesting accounts is the sum of all allocations minus the full and partial clawbacks which
+// getVestingMaxAmount already takes into account
 func (k Keeper) GetIssuedTeamAllocation(ctx sdk.Context) (used uint64) {
 	for _, account := range k.GetTeamVestingAccounts(ctx) {
 		used += getVestingMaxAmount(account)
@@ -95,8 +103,9 @@ func (k Keeper) GetIssuedTeamAllocation(ctx sdk.Context) (used uint64) {
 func (k Keeper) GetTeamInfo(ctx sdk.Context) (info *types.QueryTeamInfoResponse) {
 	info = &types.QueryTeamInfoResponse{}
 
-	info.FoundationAuthority = types.FOUNDATION_ADDRESS
-	info.BcpAuthority = types.BCP_ADDRESS
+	authorities := k.GetTeamAuthorities(ctx)
+	info.FoundationAuthority = authorities.Foundation
+	info.BcpAuthority = authorities.Bcp
 	info.TotalTeamAllocation = types.TEAM_ALLOCATION
 
 	info.IssuedTeamAllocation = k.GetIssuedTeamAllocation(ctx)
@@ -114,12 +123,30 @@ func (k Keeper) GetTeamInfo(ctx sdk.Context) (info *types.QueryTeamInfoResponse)
 		info.ClaimedAccountRewards += account.RewardsClaimed
 		info.AvailableAccountRewards += account.TotalRewards - account.RewardsClaimed
 
+		// clawed back allocations stay part of the team allocation, therefore only
+		// the claimed unlocked tokens have left the module
 		info.RequiredModuleBalance += account.TotalRewards - account.RewardsClaimed
 		info.RequiredModuleBalance -= account.UnlockedClaimed
+
+		// tokens lost through slashed delegations have left the module as well
+		info.RequiredModuleBalance -= account.DelegationLosses
+	}
+
+	moduleAddress := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	delegationAddress := k.accountKeeper.GetModuleAddress(types.TeamDelegationAccountName)
+
+	// delegated and unbonding tokens are still owned by the team module, as well as
+	// the tokens of completed unbondings which were not moved back to the team module yet
+	if bonded, err := k.stakingKeeper.GetDelegatorBonded(ctx, delegationAddress); err == nil {
+		info.TotalDelegated = bonded.Uint64()
+	}
+	if unbonding, err := k.stakingKeeper.GetDelegatorUnbonding(ctx, delegationAddress); err == nil {
+		info.TotalUnbonding = unbonding.Uint64()
 	}
 
-	coins := k.bankKeeper.GetBalance(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName), globalTypes.Denom)
-	info.TeamModuleBalance = uint64(coins.Amount.Int64())
+	coins := k.bankKeeper.GetBalance(ctx, moduleAddress, globalTypes.Denom)
+	delegationCoins := k.bankKeeper.GetBalance(ctx, delegationAddress, globalTypes.Denom)
+	info.TeamModuleBalance = uint64(coins.Amount.Int64()) + uint64(delegationCoins.Amount.Int64()) + info.TotalDelegated + info.TotalUnbonding
 
 	return
 }
@@ -127,6 +154,30 @@ func (k Keeper) GetTeamInfo(ctx sdk.Context) (info *types.QueryTeamInfoResponse)
 // getVestedAmount returns the total amount of $KYVE that has vested until the given time for the given user.
 // The function is well-defined for all values of t
 func getVestedAmount(account types.TeamVestingAccount, time uint64) uint64 {
+	// if a clawback time is defined and if it is before the specified time the account only vests
+	// until the clawback time
+	if account.Clawback > 0 && account.Clawback < time {
+		time = account.Clawback
+	}
+
+	// every partially clawed back amount vests like the rest of the allocation, but only
+	// until the time of its partial clawback
+	remainingAllocation := account.TotalAllocation
+	vested := uint64(0)
+
+	for _, partialClawback := range account.PartialClawbacks {
+		remainingAllocation -= partialClawback.Amount
+		vested += getVestedAmountOfAllocation(account, partialClawback.Amount, util.MinUInt64(time, partialClawback.Time))
+	}
+
+	return vested + getVestedAmountOfAllocation(account, remainingAllocation, time)
+}
+
+// getVestedAmountOfAllocation returns the amount of the given allocation which has vested until the given
+// time based on the commencement and the vesting schedule of the account.
+func getVestedAmountOfAllocation(account types.TeamVestingAccount, allocation uint64, time uint64) uint64 {
+	schedule := account.GetVestingSchedule()
+
 	// the account vesting duration is the time in seconds an account is already vesting
 	accountVestingDuration := uint64(0)
 
@@ -135,41 +186,40 @@ func getVestedAmount(account types.TeamVestingAccount, time uint64) uint64 {
 		accountVestingDuration = time - account.Commencement
 	}
 
-	// if a clawback time is defined and if it is before the specified time the vesting duration only goes
-	// until the clawback time
-	if account.Clawback > 0 && account.Clawback < time {
-		accountVestingDuration = account.Clawback - account.Commencement
-	}
-
 	// if account is vesting less than the vesting cliff the vested amount is zero
-	if accountVestingDuration < types.CLIFF_DURATION {
+	if accountVestingDuration < schedule.CliffDuration {
 		return 0
 	}
 
 	// if user is vesting less than the vesting duration the vested amount is linear to the membership time
-	if accountVestingDuration < types.VESTING_DURATION {
-		vested := math.LegacyNewDec(int64(account.TotalAllocation)).
+	if accountVestingDuration < schedule.VestingDuration {
+		// with monthly steps only completed months count towards the vested amount
+		if schedule.MonthlySteps {
+			accountVestingDuration -= accountVestingDuration % types.MONTH_DURATION
+		}
+
+		vested := math.LegacyNewDec(int64(allocation)).
 			Mul(math.LegacyNewDec(int64(accountVestingDuration))).
-			Quo(math.LegacyNewDec(int64(types.VESTING_DURATION)))
+			Quo(math.LegacyNewDec(int64(schedule.VestingDuration)))
 
 		return uint64(vested.TruncateInt64())
 	}
 
 	// if user is vesting longer than the vesting duration the entire allocation has vested
-	return account.TotalAllocation
+	return allocation
 }
 
 // getVestingMaxAmount gets the maximum amount an account can possibly vest
 func getVestingMaxAmount(account types.TeamVestingAccount) uint64 {
 	// in order to get the maximum possible vesting amount we add the total vesting duration to the
 	// commencement date as the specified time
-	return getVestedAmount(account, account.Commencement+types.VESTING_DURATION)
+	return getVestedAmount(account, account.Commencement+account.GetVestingSchedule().VestingDuration)
 }
 
 // getLockUpReferenceDate gets the unix time the unlocking starts for an account
 func getLockUpReferenceDate(account types.TeamVestingAccount) uint64 {
-	// the unlocking starts exactly 1 year after the commencement or TGE, whatever the latter is
-	return util.MaxUInt64(account.Commencement, types.TGE) + types.CLIFF_DURATION
+	// the unlocking starts exactly one cliff duration after the commencement or TGE, whatever the latter is
+	return util.MaxUInt64(account.Commencement, types.TGE) + account.GetVestingSchedule().CliffDuration
 }
 
 // getUnlockedAmount returns total amount of $KYVE that has unlocked until the given time for the given user.
@@ -184,18 +234,21 @@ func getUnlockedAmount(account types.TeamVestingAccount, time uint64) uint64 {
 	}
 	// => time - timeUnlock >= 0
 
-	if time-timeUnlock < types.UNLOCK_DURATION {
+	unlockDuration := account.GetVestingSchedule().UnlockDuration
+
+	if time-timeUnlock < unlockDuration {
 		// get the total vested amount based on specified time
 		vested := getVestedAmount(account, time)
 
 		// calculate the unlocked amount linearly based on time
 		unlocked := math.LegacyNewDec(int64(vested)).
 			Mul(math.LegacyNewDec(int64(time - timeUnlock))).
-			Quo(math.LegacyNewDec(int64(types.UNLOCK_DURATION)))
+			Quo(math.LegacyNewDec(int64(unlockDuration)))
 
 		return uint64(unlocked.TruncateInt64())
 	}
 
-	// if specified time comes after the unlock duration the full maximum vesting amount is unlocked
-	return getVestingMaxAmount(account)
+	// if specified time comes after the unlock duration everything which has vested so far is unlocked.
+	// For the default schedule this is always the full maximum vesting amount
+	return getVestedAmount(account, time)
 }
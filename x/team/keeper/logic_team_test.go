@@ -21,6 +21,9 @@ TEST CASES - logic_team.go
 * no_clawback_tjoin_lt_tge
 * no_clawback_tjoin_gt_tge
 * leave_minus_join_gt_3y_and_tge_eq_join
+* custom_schedule_linear
+* custom_schedule_monthly_steps
+* custom_schedule_with_clawback
 
 */
 
@@ -394,4 +397,90 @@ var _ = Describe("logic_team_test.go", Ordered, func() {
 		Expect(statusJCU.LockedVestedAmount).To(Equal(uint64(0)))
 		Expect(uint64(0)).To(Equal(statusJCU.RemainingUnvestedAmount))
 	})
+
+	It("custom_schedule_linear", func() {
+		// ARRANGE
+		account := createTeamAccount(ALLOCATION, types.TGE, 0)
+		account.Clawback = 0
+		account.Schedule = &types.VestingSchedule{
+			CliffDuration:   6 * MONTH,
+			VestingDuration: 2 * YEAR,
+			UnlockDuration:  YEAR,
+		}
+
+		// ASSERT
+		plan := teamKeeper.GetVestingPlan(account)
+		Expect(plan.MaximumVestingAmount).To(Equal(ALLOCATION))
+		Expect(plan.TokenVestingStart).To(Equal(types.TGE + 6*MONTH))
+		Expect(plan.TokenVestingFinished).To(Equal(types.TGE + 2*YEAR))
+		Expect(plan.TokenUnlockStart).To(Equal(types.TGE + 6*MONTH))
+		Expect(plan.TokenUnlockFinished).To(Equal(types.TGE + 18*MONTH))
+
+		// t < t_cliff => nothing is vested
+		status := teamKeeper.GetVestingStatus(account, types.TGE+6*MONTH-1)
+		Expect(status.TotalVestedAmount).To(BeZero())
+		Expect(status.TotalUnlockedAmount).To(BeZero())
+
+		// t = t_join + 1 Year => 1/2 is vested and half of it is unlocked
+		status = teamKeeper.GetVestingStatus(account, types.TGE+YEAR)
+		Expect(status.TotalVestedAmount).To(Equal(ALLOCATION / 2))
+		Expect(status.TotalUnlockedAmount).To(Equal(ALLOCATION / 4))
+		Expect(status.CurrentClaimableAmount).To(Equal(ALLOCATION / 4))
+		Expect(status.LockedVestedAmount).To(Equal(ALLOCATION / 4))
+		Expect(status.RemainingUnvestedAmount).To(Equal(ALLOCATION / 2))
+
+		// t = t_join + 2 Years => everything is vested and unlocked
+		status = teamKeeper.GetVestingStatus(account, types.TGE+2*YEAR)
+		Expect(status.TotalVestedAmount).To(Equal(ALLOCATION))
+		Expect(status.TotalUnlockedAmount).To(Equal(ALLOCATION))
+		Expect(status.RemainingUnvestedAmount).To(BeZero())
+	})
+
+	It("custom_schedule_monthly_steps", func() {
+		// ARRANGE
+		account := createTeamAccount(ALLOCATION, types.TGE, 0)
+		account.Clawback = 0
+		account.Schedule = &types.VestingSchedule{
+			CliffDuration:   0,
+			VestingDuration: 10 * MONTH,
+			UnlockDuration:  1,
+			MonthlySteps:    true,
+		}
+
+		// ASSERT
+		// t = t_join + 2.5 Months => only two completed months are vested
+		status := teamKeeper.GetVestingStatus(account, types.TGE+2*MONTH+MONTH/2)
+		Expect(status.TotalVestedAmount).To(Equal(ALLOCATION * 2 / 10))
+		Expect(status.TotalUnlockedAmount).To(Equal(ALLOCATION * 2 / 10))
+
+		// t = t_join + 3 Months => three months are vested
+		status = teamKeeper.GetVestingStatus(account, types.TGE+3*MONTH)
+		Expect(status.TotalVestedAmount).To(Equal(ALLOCATION * 3 / 10))
+
+		// t = t_join + 10 Months => everything is vested
+		status = teamKeeper.GetVestingStatus(account, types.TGE+10*MONTH)
+		Expect(status.TotalVestedAmount).To(Equal(ALLOCATION))
+		Expect(status.RemainingUnvestedAmount).To(BeZero())
+	})
+
+	It("custom_schedule_with_clawback", func() {
+		// ARRANGE
+		account := createTeamAccount(ALLOCATION, types.TGE, 4*MONTH+MONTH/2)
+		account.Schedule = &types.VestingSchedule{
+			CliffDuration:   MONTH,
+			VestingDuration: 10 * MONTH,
+			UnlockDuration:  MONTH,
+			MonthlySteps:    true,
+		}
+
+		// ASSERT
+		plan := teamKeeper.GetVestingPlan(account)
+		Expect(plan.MaximumVestingAmount).To(Equal(ALLOCATION * 4 / 10))
+		Expect(plan.ClawbackAmount).To(Equal(ALLOCATION * 6 / 10))
+
+		status := teamKeeper.GetVestingStatus(account, types.TGE+10*MONTH)
+		Expect(status.TotalVestedAmount).To(Equal(ALLOCATION * 4 / 10))
+		Expect(status.TotalUnlockedAmount).To(Equal(ALLOCATION * 4 / 10))
+		Expect(status.RemainingUnvestedAmount).To(BeZero())
+	})
 })
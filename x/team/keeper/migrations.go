@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"github.com/KYVENetwork/chain/x/team/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2 by seeding the team authorities
+// in the module state with the link-time authorities.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.keeper.SetTeamAuthorities(ctx, types.DefaultTeamAuthorities())
+	return nil
+}
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"github.com/KYVENetwork/chain/x/team/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// AcceptBeneficiary completes the transfer of the beneficiary rights of a team
+// vesting account to the pending beneficiary.
+func (k msgServer) AcceptBeneficiary(goCtx context.Context, msg *types.MsgAcceptBeneficiary) (*types.MsgAcceptBeneficiaryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	account, found := k.GetTeamVestingAccount(ctx, msg.Id)
+	if !found {
+		return nil, sdkErrors.ErrNotFound
+	}
+
+	if account.PendingBeneficiary == "" {
+		return nil, errors.Wrapf(sdkErrors.ErrLogic, types.ErrNoPendingBeneficiary.Error(), account.Id)
+	}
+
+	if account.PendingBeneficiary != msg.Creator {
+		return nil, errors.Wrapf(sdkErrors.ErrUnauthorized, types.ErrNotPendingBeneficiary.Error(), account.PendingBeneficiary, msg.Creator)
+	}
+
+	k.changeBeneficiary(ctx, &account, msg.Creator, account.PendingBeneficiaryProposer)
+	k.SetTeamVestingAccount(ctx, account)
+
+	return &types.MsgAcceptBeneficiaryResponse{}, nil
+}
@@ -0,0 +1,262 @@
+package keeper_test
+
+import (
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	"github.com/KYVENetwork/chain/x/team/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+/*
+
+TEST CASES - msg_server_propose_beneficiary.go, msg_server_accept_beneficiary.go
+
+* create_account_with_beneficiary
+* beneficiary_claims_unlocked
+* beneficiary_claims_more_than_unlocked
+* beneficiary_claims_account_rewards
+* other_address_can_not_claim
+* transfer_beneficiary_in_two_steps
+* previous_beneficiary_can_not_claim_after_transfer
+* accept_without_pending_beneficiary
+* accept_from_wrong_address
+* propose_from_unauthorized_address
+* authority_proposes_beneficiary
+
+*/
+
+var _ = Describe("msg_server_propose_beneficiary.go, msg_server_accept_beneficiary.go", Ordered, func() {
+	s := i.NewCleanChainAtTime(int64(types.TGE))
+
+	BeforeEach(func() {
+		// init new clean chain at TGE time
+		s = i.NewCleanChainAtTime(int64(types.TGE))
+
+		s.RunTxTeamSuccess(&types.MsgCreateTeamVestingAccount{
+			Authority:       types.FOUNDATION_ADDRESS,
+			TotalAllocation: 1_000_000 * i.KYVE, // 1m
+			Commencement:    types.TGE - YEAR,
+			Beneficiary:     i.ALICE,
+		})
+
+		s.CommitAfterSeconds(3 * YEAR)
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("create_account_with_beneficiary", func() {
+		// ASSERT
+		tva, _ := s.App().TeamKeeper.GetTeamVestingAccount(s.Ctx(), 0)
+		Expect(tva.Beneficiary).To(Equal(i.ALICE))
+		Expect(tva.PendingBeneficiary).To(BeEmpty())
+
+		res, err := s.App().TeamKeeper.TeamBeneficiaryHistory(s.Ctx(), &types.QueryTeamBeneficiaryHistoryRequest{Id: 0})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Beneficiary).To(Equal(i.ALICE))
+		Expect(res.Changes).To(HaveLen(1))
+		Expect(res.Changes[0].PreviousBeneficiary).To(BeEmpty())
+		Expect(res.Changes[0].NewBeneficiary).To(Equal(i.ALICE))
+		Expect(res.Changes[0].Proposer).To(Equal(types.FOUNDATION_ADDRESS))
+	})
+
+	It("beneficiary_claims_unlocked", func() {
+		// ACT
+		s.RunTxTeamSuccess(&types.MsgClaimUnlocked{
+			Authority: i.ALICE,
+			Id:        0,
+			Amount:    100_000 * i.KYVE,
+			Recipient: i.CHARLIE,
+		})
+
+		// ASSERT
+		tva, _ := s.App().TeamKeeper.GetTeamVestingAccount(s.Ctx(), 0)
+		Expect(tva.UnlockedClaimed).To(Equal(100_000 * i.KYVE))
+		Expect(s.GetBalanceFromAddress(i.CHARLIE)).To(Equal(1000*i.KYVE + 100_000*i.KYVE))
+	})
+
+	It("beneficiary_claims_more_than_unlocked", func() {
+		// ACT
+		s.RunTxTeamError(&types.MsgClaimUnlocked{
+			Authority: i.ALICE,
+			Id:        0,
+			Amount:    1_000_001 * i.KYVE,
+			Recipient: i.ALICE,
+		})
+
+		// ASSERT
+		tva, _ := s.App().TeamKeeper.GetTeamVestingAccount(s.Ctx(), 0)
+		Expect(tva.UnlockedClaimed).To(BeZero())
+	})
+
+	It("beneficiary_claims_account_rewards", func() {
+		// ARRANGE
+		tva, _ := s.App().TeamKeeper.GetTeamVestingAccount(s.Ctx(), 0)
+		Expect(tva.TotalRewards).To(BeNumerically(">", uint64(0)))
+
+		// ACT
+		s.RunTxTeamSuccess(&types.MsgClaimAccountRewards{
+			Authority: i.ALICE,
+			Id:        0,
+			Amount:    tva.TotalRewards,
+			Recipient: i.ALICE,
+		})
+
+		// ASSERT
+		tva, _ = s.App().TeamKeeper.GetTeamVestingAccount(s.Ctx(), 0)
+		Expect(tva.RewardsClaimed).To(Equal(tva.TotalRewards))
+	})
+
+	It("other_address_can_not_claim", func() {
+		// ACT
+		s.RunTxTeamError(&types.MsgClaimUnlocked{
+			Authority: i.BOB,
+			Id:        0,
+			Amount:    1 * i.KYVE,
+			Recipient: i.BOB,
+		})
+		s.RunTxTeamError(&types.MsgClaimAccountRewards{
+			Authority: i.BOB,
+			Id:        0,
+			Amount:    1,
+			Recipient: i.BOB,
+		})
+
+		// ASSERT
+		tva, _ := s.App().TeamKeeper.GetTeamVestingAccount(s.Ctx(), 0)
+		Expect(tva.UnlockedClaimed).To(BeZero())
+		Expect(tva.RewardsClaimed).To(BeZero())
+	})
+
+	It("transfer_beneficiary_in_two_steps", func() {
+		// ACT
+		s.RunTxTeamSuccess(&types.MsgProposeBeneficiary{
+			Creator:        i.ALICE,
+			Id:             0,
+			NewBeneficiary: i.BOB,
+		})
+
+		// ASSERT
+		tva, _ := s.App().TeamKeeper.GetTeamVestingAccount(s.Ctx(), 0)
+		Expect(tva.Beneficiary).To(Equal(i.ALICE))
+		Expect(tva.PendingBeneficiary).To(Equal(i.BOB))
+
+		// ACT
+		s.RunTxTeamSuccess(&types.MsgAcceptBeneficiary{
+			Creator: i.BOB,
+			Id:      0,
+		})
+
+		// ASSERT
+		tva, _ = s.App().TeamKeeper.GetTeamVestingAccount(s.Ctx(), 0)
+		Expect(tva.Beneficiary).To(Equal(i.BOB))
+		Expect(tva.PendingBeneficiary).To(BeEmpty())
+		Expect(tva.PendingBeneficiaryProposer).To(BeEmpty())
+
+		res, err := s.App().TeamKeeper.TeamBeneficiaryHistory(s.Ctx(), &types.QueryTeamBeneficiaryHistoryRequest{Id: 0})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Changes).To(HaveLen(2))
+		Expect(res.Changes[1].Index).To(Equal(uint64(1)))
+		Expect(res.Changes[1].PreviousBeneficiary).To(Equal(i.ALICE))
+		Expect(res.Changes[1].NewBeneficiary).To(Equal(i.BOB))
+		Expect(res.Changes[1].Proposer).To(Equal(i.ALICE))
+		Expect(res.Changes[1].Timestamp).To(Equal(uint64(s.Ctx().BlockTime().Unix())))
+	})
+
+	It("previous_beneficiary_can_not_claim_after_transfer", func() {
+		// ARRANGE
+		s.RunTxTeamSuccess(&types.MsgProposeBeneficiary{
+			Creator:        i.ALICE,
+			Id:             0,
+			NewBeneficiary: i.BOB,
+		})
+		s.RunTxTeamSuccess(&types.MsgAcceptBeneficiary{
+			Creator: i.BOB,
+			Id:      0,
+		})
+
+		// ACT
+		s.RunTxTeamError(&types.MsgClaimUnlocked{
+			Authority: i.ALICE,
+			Id:        0,
+			Amount:    1 * i.KYVE,
+			Recipient: i.ALICE,
+		})
+		s.RunTxTeamSuccess(&types.MsgClaimUnlocked{
+			Authority: i.BOB,
+			Id:        0,
+			Amount:    1 * i.KYVE,
+			Recipient: i.BOB,
+		})
+
+		// ASSERT
+		tva, _ := s.App().TeamKeeper.GetTeamVestingAccount(s.Ctx(), 0)
+		Expect(tva.UnlockedClaimed).To(Equal(1 * i.KYVE))
+	})
+
+	It("accept_without_pending_beneficiary", func() {
+		// ACT
+		s.RunTxTeamError(&types.MsgAcceptBeneficiary{
+			Creator: i.BOB,
+			Id:      0,
+		})
+
+		// ASSERT
+		tva, _ := s.App().TeamKeeper.GetTeamVestingAccount(s.Ctx(), 0)
+		Expect(tva.Beneficiary).To(Equal(i.ALICE))
+	})
+
+	It("accept_from_wrong_address", func() {
+		// ARRANGE
+		s.RunTxTeamSuccess(&types.MsgProposeBeneficiary{
+			Creator:        i.ALICE,
+			Id:             0,
+			NewBeneficiary: i.BOB,
+		})
+
+		// ACT
+		s.RunTxTeamError(&types.MsgAcceptBeneficiary{
+			Creator: i.CHARLIE,
+			Id:      0,
+		})
+
+		// ASSERT
+		tva, _ := s.App().TeamKeeper.GetTeamVestingAccount(s.Ctx(), 0)
+		Expect(tva.Beneficiary).To(Equal(i.ALICE))
+		Expect(tva.PendingBeneficiary).To(Equal(i.BOB))
+	})
+
+	It("propose_from_unauthorized_address", func() {
+		// ACT
+		s.RunTxTeamError(&types.MsgProposeBeneficiary{
+			Creator:        i.CHARLIE,
+			Id:             0,
+			NewBeneficiary: i.CHARLIE,
+		})
+
+		// ASSERT
+		tva, _ := s.App().TeamKeeper.GetTeamVestingAccount(s.Ctx(), 0)
+		Expect(tva.PendingBeneficiary).To(BeEmpty())
+	})
+
+	It("authority_proposes_beneficiary", func() {
+		// ACT
+		s.RunTxTeamSuccess(&types.MsgProposeBeneficiary{
+			Creator:        types.BCP_ADDRESS,
+			Id:             0,
+			NewBeneficiary: i.CHARLIE,
+		})
+		s.RunTxTeamSuccess(&types.MsgAcceptBeneficiary{
+			Creator: i.CHARLIE,
+			Id:      0,
+		})
+
+		// ASSERT
+		res, err := s.App().TeamKeeper.TeamBeneficiaryHistory(s.Ctx(), &types.QueryTeamBeneficiaryHistoryRequest{Id: 0})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Beneficiary).To(Equal(i.CHARLIE))
+		Expect(res.Changes).To(HaveLen(2))
+		Expect(res.Changes[1].Proposer).To(Equal(types.BCP_ADDRESS))
+	})
+})
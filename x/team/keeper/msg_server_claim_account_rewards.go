@@ -14,15 +14,27 @@ import (
 func (k msgServer) ClaimAccountRewards(goCtx context.Context, msg *types.MsgClaimAccountRewards) (*types.MsgClaimAccountRewardsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if types.FOUNDATION_ADDRESS != msg.Authority && types.BCP_ADDRESS != msg.Authority {
-		return nil, errors.Wrapf(sdkErrors.ErrLogic, types.ErrInvalidAuthority.Error(), types.FOUNDATION_ADDRESS, types.BCP_ADDRESS, msg.Authority)
-	}
-
 	account, found := k.GetTeamVestingAccount(ctx, msg.Id)
 	if !found {
 		return nil, sdkErrors.ErrNotFound
 	}
 
+	// the authority and the beneficiary of the account are allowed to claim
+	if !k.canClaimForAccount(ctx, account, msg.Authority) {
+		if account.Beneficiary == "" {
+			authorities := k.GetTeamAuthorities(ctx)
+			return nil, errors.Wrapf(sdkErrors.ErrLogic, types.ErrInvalidAuthority.Error(), authorities.Foundation, authorities.Bcp, msg.Authority)
+		}
+		return nil, errors.Wrapf(sdkErrors.ErrUnauthorized, types.ErrNotBeneficiary.Error(), account.Beneficiary, msg.Authority)
+	}
+
+	// credit the pending staking rewards of the delegations of the account
+	for _, delegation := range k.GetTeamDelegationsOfAccount(ctx, account.Id) {
+		if valAddress, err := sdk.ValAddressFromBech32(delegation.Validator); err == nil {
+			k.creditTeamDelegationRewards(ctx, &account, valAddress)
+		}
+	}
+
 	// check if account has available inflation rewards which can be claimed
 	if account.TotalRewards-account.RewardsClaimed < msg.Amount {
 		return nil, errors.Wrapf(sdkErrors.ErrLogic, types.ErrClaimAmountTooHigh.Error(), account.TotalRewards-account.RewardsClaimed, msg.Amount)
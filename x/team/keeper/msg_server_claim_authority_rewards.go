@@ -14,8 +14,9 @@ import (
 func (k msgServer) ClaimAuthorityRewards(goCtx context.Context, msg *types.MsgClaimAuthorityRewards) (*types.MsgClaimAuthorityRewardsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if types.FOUNDATION_ADDRESS != msg.Authority {
-		return nil, errors.Wrapf(sdkErrors.ErrLogic, types.ErrInvalidAuthority.Error(), types.FOUNDATION_ADDRESS, msg.Authority)
+	authorities := k.GetTeamAuthorities(ctx)
+	if authorities.Foundation != msg.Authority {
+		return nil, errors.Wrapf(sdkErrors.ErrLogic, types.ErrInvalidAuthority.Error(), authorities.Foundation, msg.Authority)
 	}
 
 	authority := k.GetAuthority(ctx)
@@ -5,6 +5,7 @@ import (
 
 	"cosmossdk.io/errors"
 	"github.com/KYVENetwork/chain/util"
+	globalTypes "github.com/KYVENetwork/chain/x/global/types"
 	"github.com/KYVENetwork/chain/x/team/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -13,21 +14,52 @@ import (
 func (k msgServer) ClaimUnlocked(goCtx context.Context, msg *types.MsgClaimUnlocked) (*types.MsgClaimUnlockedResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if types.FOUNDATION_ADDRESS != msg.Authority && types.BCP_ADDRESS != msg.Authority {
-		return nil, errors.Wrapf(sdkErrors.ErrLogic, types.ErrInvalidAuthority.Error(), types.FOUNDATION_ADDRESS, types.BCP_ADDRESS, msg.Authority)
-	}
-
 	account, found := k.GetTeamVestingAccount(ctx, msg.Id)
 	if !found {
 		return nil, sdkErrors.ErrNotFound
 	}
 
+	// the authority and the beneficiary of the account are allowed to claim
+	if !k.canClaimForAccount(ctx, account, msg.Authority) {
+		if account.Beneficiary == "" {
+			authorities := k.GetTeamAuthorities(ctx)
+			return nil, errors.Wrapf(sdkErrors.ErrLogic, types.ErrInvalidAuthority.Error(), authorities.Foundation, authorities.Bcp, msg.Authority)
+		}
+		return nil, errors.Wrapf(sdkErrors.ErrUnauthorized, types.ErrNotBeneficiary.Error(), account.Beneficiary, msg.Authority)
+	}
+
 	// get current claimable amount
 	currentProgress := GetVestingStatus(account, uint64(ctx.BlockTime().Unix()))
 
+	// unlocked tokens which are reserved for pending claims can not be claimed again
+	pending := k.getPendingClaimAmount(ctx, account.Id)
+
+	claimable := uint64(0)
+	if currentProgress.CurrentClaimableAmount > pending {
+		claimable = currentProgress.CurrentClaimableAmount - pending
+	}
+
 	// throw error if the requested claim amount is bigger than the available unlocked amount
-	if msg.Amount > currentProgress.CurrentClaimableAmount {
-		return nil, errors.Wrapf(sdkErrors.ErrLogic, types.ErrClaimAmountTooHigh.Error(), msg.Amount, currentProgress.CurrentClaimableAmount)
+	if msg.Amount > claimable {
+		return nil, errors.Wrapf(sdkErrors.ErrLogic, types.ErrClaimAmountTooHigh.Error(), msg.Amount, claimable)
+	}
+
+	// if the liquid tokens of the account do not cover the claim its delegated tokens start
+	// unbonding and the claim gets paid once the unbonding has completed
+	delegated, unbonding := k.GetTeamDelegationAmounts(ctx, account.Id)
+	if msg.Amount > getLiquidAmount(account, delegated+unbonding+pending) {
+		if err := k.addPendingClaim(ctx, &account, msg.Recipient, msg.Amount, msg.Authority); err != nil {
+			return nil, err
+		}
+
+		k.SetTeamVestingAccount(ctx, account)
+
+		return &types.MsgClaimUnlockedResponse{}, nil
+	}
+
+	moduleBalance := k.bankKeeper.GetBalance(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName), globalTypes.Denom).Amount.Uint64()
+	if moduleBalance < msg.Amount {
+		return nil, errors.Wrapf(sdkErrors.ErrInsufficientFunds, types.ErrInsufficientLiquidity.Error(), moduleBalance, msg.Amount)
 	}
 
 	// Transfer claim amount from this module to recipient.
@@ -12,8 +12,9 @@ import (
 func (k msgServer) Clawback(goCtx context.Context, msg *types.MsgClawback) (*types.MsgClawbackResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if types.FOUNDATION_ADDRESS != msg.Authority && types.BCP_ADDRESS != msg.Authority {
-		return nil, errors.Wrapf(sdkErrors.ErrLogic, types.ErrInvalidAuthority.Error(), types.FOUNDATION_ADDRESS, types.BCP_ADDRESS, msg.Authority)
+	authorities := k.GetTeamAuthorities(ctx)
+	if !authorities.IsAuthority(msg.Authority) {
+		return nil, errors.Wrapf(sdkErrors.ErrLogic, types.ErrInvalidAuthority.Error(), authorities.Foundation, authorities.Bcp, msg.Authority)
 	}
 
 	account, found := k.GetTeamVestingAccount(ctx, msg.Id)
@@ -34,6 +35,9 @@ func (k msgServer) Clawback(goCtx context.Context, msg *types.MsgClawback) (*typ
 	account.Clawback = msg.Clawback
 	k.SetTeamVestingAccount(ctx, account)
 
+	// the maximum delegation of the account changed, so the delegations have to be rebalanced at a different time
+	k.scheduleTeamRebalance(ctx, account)
+
 	_ = ctx.EventManager().EmitTypedEvent(&types.EventClawback{
 		Authority: msg.Authority,
 		Id:        account.Id,
@@ -12,8 +12,9 @@ import (
 func (k msgServer) CreateTeamVestingAccount(goCtx context.Context, msg *types.MsgCreateTeamVestingAccount) (*types.MsgCreateTeamVestingAccountResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if types.FOUNDATION_ADDRESS != msg.Authority && types.BCP_ADDRESS != msg.Authority {
-		return nil, errors.Wrapf(errorsTypes.ErrLogic, types.ErrInvalidAuthority.Error(), types.FOUNDATION_ADDRESS, types.BCP_ADDRESS, msg.Authority)
+	authorities := k.GetTeamAuthorities(ctx)
+	if !authorities.IsAuthority(msg.Authority) {
+		return nil, errors.Wrapf(errorsTypes.ErrLogic, types.ErrInvalidAuthority.Error(), authorities.Foundation, authorities.Bcp, msg.Authority)
 	}
 
 	if msg.TotalAllocation == 0 || msg.Commencement == 0 {
@@ -25,17 +26,44 @@ func (k msgServer) CreateTeamVestingAccount(goCtx context.Context, msg *types.Ms
 		return nil, errors.Wrapf(errorsTypes.ErrLogic, types.ErrAvailableFundsTooLow.Error(), types.TEAM_ALLOCATION-k.GetIssuedTeamAllocation(ctx), msg.TotalAllocation)
 	}
 
+	// accounts without a schedule use the default team vesting schedule
+	if msg.Schedule != nil {
+		if err := msg.Schedule.Validate(); err != nil {
+			return nil, errors.Wrapf(errorsTypes.ErrInvalidRequest, types.ErrInvalidSchedule.Error(), err)
+		}
+	}
+
+	if msg.Beneficiary != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.Beneficiary); err != nil {
+			return nil, errors.Wrapf(errorsTypes.ErrInvalidAddress, "invalid beneficiary address (%s)", err)
+		}
+	}
+
 	id := k.AppendTeamVestingAccount(ctx, types.TeamVestingAccount{
 		TotalAllocation: msg.TotalAllocation,
 		Commencement:    msg.Commencement,
+		Schedule:        msg.Schedule,
 	})
 
+	schedule := types.DefaultVestingSchedule()
+	if msg.Schedule != nil {
+		schedule = *msg.Schedule
+	}
+
 	_ = ctx.EventManager().EmitTypedEvent(&types.EventCreateTeamVestingAccount{
 		Authority:       msg.Authority,
 		Id:              id,
 		TotalAllocation: msg.TotalAllocation,
 		Commencement:    msg.Commencement,
+		Schedule:        &schedule,
 	})
 
+	// record the initial beneficiary in the beneficiary history of the account
+	if msg.Beneficiary != "" {
+		account, _ := k.GetTeamVestingAccount(ctx, id)
+		k.changeBeneficiary(ctx, &account, msg.Beneficiary, msg.Authority)
+		k.SetTeamVestingAccount(ctx, account)
+	}
+
 	return &types.MsgCreateTeamVestingAccountResponse{}, nil
 }
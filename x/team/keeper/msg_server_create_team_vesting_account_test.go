@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	i "github.com/KYVENetwork/chain/testutil/integration"
 	"github.com/KYVENetwork/chain/x/team/types"
 	. "github.com/onsi/ginkgo/v2"
@@ -19,6 +21,8 @@ TEST CASES - msg_server_create_team_vesting_account.go
 * Create a first TVA with commencement 3 years before TGE with other authority
 * Create TVA with more Allocation than available
 * Create multiple TVAs
+* Create a TVA with a custom vesting schedule
+* Create a TVA with an invalid vesting schedule
 
 */
 
@@ -234,4 +238,53 @@ var _ = Describe("msg_server_create_team_vesting_account.go", Ordered, func() {
 		Expect(info.RequiredModuleBalance).To(Equal(types.TEAM_ALLOCATION + info.TotalAuthorityRewards))
 		Expect(info.TeamModuleBalance).To(Equal(types.TEAM_ALLOCATION + info.TotalAuthorityRewards))
 	})
+
+	It("Create a TVA with a custom vesting schedule", func() {
+		// ACT
+		s.RunTxTeamSuccess(&types.MsgCreateTeamVestingAccount{
+			Authority:       types.FOUNDATION_ADDRESS,
+			TotalAllocation: 1_000_000 * i.KYVE, // 1m
+			Commencement:    types.TGE,
+			Schedule: &types.VestingSchedule{
+				CliffDuration:   6 * types.MONTH_DURATION,
+				VestingDuration: 24 * types.MONTH_DURATION,
+				UnlockDuration:  12 * types.MONTH_DURATION,
+				MonthlySteps:    true,
+			},
+		})
+
+		// ASSERT
+		tva, found := s.App().TeamKeeper.GetTeamVestingAccount(s.Ctx(), 0)
+		Expect(found).To(BeTrue())
+		Expect(tva.Schedule.CliffDuration).To(Equal(6 * types.MONTH_DURATION))
+		Expect(tva.Schedule.MonthlySteps).To(BeTrue())
+
+		res, err := s.App().TeamKeeper.TeamVestingStatusByTime(s.Ctx(), &types.QueryTeamVestingStatusByTimeRequest{
+			Id:   0,
+			Time: types.TGE + 12*types.MONTH_DURATION,
+		})
+		Expect(err).To(BeNil())
+		Expect(res.Status.TotalVestedAmount).To(Equal(500_000 * i.KYVE))
+		Expect(res.Status.TotalUnlockedAmount).To(Equal(250_000 * i.KYVE))
+		Expect(res.Plan.TokenVestingStart).To(Equal(time.Unix(int64(types.TGE+6*types.MONTH_DURATION), 0).String()))
+		Expect(res.Plan.TokenUnlockFinished).To(Equal(time.Unix(int64(types.TGE+18*types.MONTH_DURATION), 0).String()))
+	})
+
+	It("Create a TVA with an invalid vesting schedule", func() {
+		// ACT
+		s.RunTxTeamError(&types.MsgCreateTeamVestingAccount{
+			Authority:       types.FOUNDATION_ADDRESS,
+			TotalAllocation: 1_000_000 * i.KYVE, // 1m
+			Commencement:    types.TGE,
+			Schedule: &types.VestingSchedule{
+				CliffDuration:   24 * types.MONTH_DURATION,
+				VestingDuration: 12 * types.MONTH_DURATION,
+				UnlockDuration:  12 * types.MONTH_DURATION,
+			},
+		})
+
+		// ASSERT
+		tvas := s.App().TeamKeeper.GetTeamVestingAccounts(s.Ctx())
+		Expect(tvas).To(HaveLen(0))
+	})
 })
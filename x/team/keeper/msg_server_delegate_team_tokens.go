@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"github.com/KYVENetwork/chain/x/team/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) DelegateTeamTokens(goCtx context.Context, msg *types.MsgDelegateTeamTokens) (*types.MsgDelegateTeamTokensResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorities := k.GetTeamAuthorities(ctx)
+	if !authorities.IsAuthority(msg.Authority) {
+		return nil, errors.Wrapf(sdkErrors.ErrLogic, types.ErrInvalidAuthority.Error(), authorities.Foundation, authorities.Bcp, msg.Authority)
+	}
+
+	account, found := k.GetTeamVestingAccount(ctx, msg.Id)
+	if !found {
+		return nil, sdkErrors.ErrNotFound
+	}
+
+	unbondingDuration, err := k.getUnbondingDuration(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// only locked tokens which do not unlock within twice the unbonding time can be delegated,
+	// so that they are not undelegated by the rebalancing right away
+	maxDelegation := GetMaxTeamDelegation(account, uint64(ctx.BlockTime().Unix()), 2*unbondingDuration)
+	delegated, _ := k.GetTeamDelegationAmounts(ctx, account.Id)
+
+	available := uint64(0)
+	if maxDelegation > delegated {
+		available = maxDelegation - delegated
+	}
+
+	if msg.Amount == 0 || msg.Amount > available {
+		return nil, errors.Wrapf(sdkErrors.ErrLogic, types.ErrDelegationTooHigh.Error(), msg.Amount, available)
+	}
+
+	if err := k.delegateTeamTokens(ctx, &account, msg.Validator, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	k.SetTeamVestingAccount(ctx, account)
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventDelegateTeamTokens{
+		Authority: msg.Authority,
+		Id:        account.Id,
+		Validator: msg.Validator,
+		Amount:    msg.Amount,
+	})
+
+	return &types.MsgDelegateTeamTokensResponse{}, nil
+}
@@ -0,0 +1,549 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	teamKeeper "github.com/KYVENetwork/chain/x/team/keeper"
+	"github.com/KYVENetwork/chain/x/team/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distributionTypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+/*
+
+TEST CASES - msg_server_delegate_team_tokens.go
+
+* try_with_invalid_authority
+* try_to_delegate_more_than_max_delegation
+* try_to_delegate_to_unknown_validator
+* delegate_locked_tokens
+* undelegate_team_tokens
+* try_to_undelegate_more_than_delegated
+* slash_team_delegation
+* slash_team_unbonding
+* rebalance_delegations_after_clawback
+* claim_is_paid_after_unbonding
+* credit_staking_rewards
+* rebalance_delegations_at_scheduled_time
+* delegate_withdraw_rewards_and_unbond_through_blocks
+
+*/
+
+var _ = Describe("msg_server_delegate_team_tokens.go", Ordered, func() {
+	s := i.NewCleanChainAtTime(int64(types.TGE))
+
+	var validator string
+
+	BeforeEach(func() {
+		// init new clean chain
+		s = i.NewCleanChainAtTime(int64(types.TGE))
+
+		delegations, _ := s.App().StakingKeeper.GetAllDelegations(s.Ctx())
+		validator = delegations[0].ValidatorAddress
+
+		s.RunTxTeamSuccess(&types.MsgCreateTeamVestingAccount{
+			Authority:       types.FOUNDATION_ADDRESS,
+			TotalAllocation: 1_000_000 * i.KYVE, // 1m
+			Commencement:    types.TGE,
+		})
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	slash := func(infractionHeight int64, power int64, fraction math.LegacyDec) {
+		valAddress, _ := sdk.ValAddressFromBech32(validator)
+		stakingValidator, _ := s.App().StakingKeeper.GetValidator(s.Ctx(), valAddress)
+		consAddress, _ := stakingValidator.GetConsAddr()
+
+		_, err := s.App().StakingKeeper.Slash(s.Ctx(), consAddress, infractionHeight, power, fraction)
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	validatorPower := func() int64 {
+		valAddress, _ := sdk.ValAddressFromBech32(validator)
+		stakingValidator, _ := s.App().StakingKeeper.GetValidator(s.Ctx(), valAddress)
+		return stakingValidator.GetConsensusPower(s.App().StakingKeeper.PowerReduction(s.Ctx()))
+	}
+
+	It("try_with_invalid_authority", func() {
+		// ACT
+		s.RunTxTeamError(&types.MsgDelegateTeamTokens{
+			Authority: i.ALICE,
+			Id:        0,
+			Validator: validator,
+			Amount:    100_000 * i.KYVE,
+		})
+
+		// ASSERT
+		res, err := s.App().TeamKeeper.TeamDelegations(s.Ctx(), &types.QueryTeamDelegationsRequest{Id: 0})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Delegations).To(BeEmpty())
+		Expect(res.TotalDelegated).To(BeZero())
+	})
+
+	It("try_to_delegate_more_than_max_delegation", func() {
+		// ARRANGE
+		res, _ := s.App().TeamKeeper.TeamDelegations(s.Ctx(), &types.QueryTeamDelegationsRequest{Id: 0})
+		Expect(res.MaxDelegation).To(Equal(500_000 * i.KYVE))
+
+		// ACT
+		s.RunTxTeamError(&types.MsgDelegateTeamTokens{
+			Authority: types.FOUNDATION_ADDRESS,
+			Id:        0,
+			Validator: validator,
+			Amount:    500_000*i.KYVE + 1,
+		})
+
+		// ASSERT
+		res, _ = s.App().TeamKeeper.TeamDelegations(s.Ctx(), &types.QueryTeamDelegationsRequest{Id: 0})
+		Expect(res.TotalDelegated).To(BeZero())
+	})
+
+	It("try_to_delegate_to_unknown_validator", func() {
+		// ACT
+		s.RunTxTeamError(&types.MsgDelegateTeamTokens{
+			Authority: types.FOUNDATION_ADDRESS,
+			Id:        0,
+			Validator: i.GenerateTestValidatorAddress("unknown").ValAddress,
+			Amount:    100_000 * i.KYVE,
+		})
+
+		// ASSERT
+		res, _ := s.App().TeamKeeper.TeamDelegations(s.Ctx(), &types.QueryTeamDelegationsRequest{Id: 0})
+		Expect(res.TotalDelegated).To(BeZero())
+	})
+
+	It("delegate_locked_tokens", func() {
+		// ARRANGE
+		moduleBalance := s.App().TeamKeeper.GetTeamInfo(s.Ctx()).TeamModuleBalance
+
+		// ACT
+		s.RunTxTeamSuccess(&types.MsgDelegateTeamTokens{
+			Authority: types.FOUNDATION_ADDRESS,
+			Id:        0,
+			Validator: validator,
+			Amount:    100_000 * i.KYVE,
+		})
+
+		// ASSERT
+		res, err := s.App().TeamKeeper.TeamDelegations(s.Ctx(), &types.QueryTeamDelegationsRequest{Id: 0})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Delegations).To(HaveLen(1))
+		Expect(res.Delegations[0].Validator).To(Equal(validator))
+		Expect(res.Delegations[0].Amount).To(Equal(100_000 * i.KYVE))
+		Expect(res.TotalDelegated).To(Equal(100_000 * i.KYVE))
+		Expect(res.MaxDelegation).To(Equal(500_000 * i.KYVE))
+		Expect(res.DelegationLosses).To(BeZero())
+
+		info := s.App().TeamKeeper.GetTeamInfo(s.Ctx())
+		Expect(info.TotalDelegated).To(Equal(100_000 * i.KYVE))
+		Expect(info.TeamModuleBalance).To(Equal(moduleBalance))
+
+		// the remaining delegation is still bounded
+		s.RunTxTeamError(&types.MsgDelegateTeamTokens{
+			Authority: types.FOUNDATION_ADDRESS,
+			Id:        0,
+			Validator: validator,
+			Amount:    400_000*i.KYVE + 1,
+		})
+		s.RunTxTeamSuccess(&types.MsgDelegateTeamTokens{
+			Authority: types.FOUNDATION_ADDRESS,
+			Id:        0,
+			Validator: validator,
+			Amount:    400_000 * i.KYVE,
+		})
+
+		res, _ = s.App().TeamKeeper.TeamDelegations(s.Ctx(), &types.QueryTeamDelegationsRequest{Id: 0})
+		Expect(res.TotalDelegated).To(Equal(500_000 * i.KYVE))
+
+		// delegations of locked tokens are kept in the following blocks
+		s.CommitAfterSeconds(60)
+
+		res, _ = s.App().TeamKeeper.TeamDelegations(s.Ctx(), &types.QueryTeamDelegationsRequest{Id: 0})
+		Expect(res.TotalDelegated).To(Equal(500_000 * i.KYVE))
+		Expect(res.Unbondings).To(BeEmpty())
+	})
+
+	It("undelegate_team_tokens", func() {
+		// ARRANGE
+		s.RunTxTeamSuccess(&types.MsgDelegateTeamTokens{
+			Authority: types.FOUNDATION_ADDRESS,
+			Id:        0,
+			Validator: validator,
+			Amount:    100_000 * i.KYVE,
+		})
+		s.Commit()
+
+		unbondingTime, _ := s.App().StakingKeeper.UnbondingTime(s.Ctx())
+
+		// ACT
+		s.RunTxTeamSuccess(&types.MsgUndelegateTeamTokens{
+			Authority: types.FOUNDATION_ADDRESS,
+			Id:        0,
+			Validator: validator,
+			Amount:    40_000 * i.KYVE,
+		})
+
+		// ASSERT
+		res, _ := s.App().TeamKeeper.TeamDelegations(s.Ctx(), &types.QueryTeamDelegationsRequest{Id: 0})
+		Expect(res.TotalDelegated).To(Equal(60_000 * i.KYVE))
+		Expect(res.TotalUnbonding).To(Equal(40_000 * i.KYVE))
+		Expect(res.Unbondings).To(HaveLen(1))
+		Expect(res.Unbondings[0].CreationHeight).To(Equal(s.Ctx().BlockHeight()))
+		Expect(res.Unbondings[0].CompletionTime).To(Equal(s.Ctx().BlockTime().Add(unbondingTime).UnixNano()))
+
+		info := s.App().TeamKeeper.GetTeamInfo(s.Ctx())
+		Expect(info.TotalDelegated).To(Equal(60_000 * i.KYVE))
+		Expect(info.TotalUnbonding).To(Equal(40_000 * i.KYVE))
+
+		// the unbonding is removed once it completed
+		s.CommitAfter(unbondingTime)
+		s.CommitAfterSeconds(60)
+
+		res, _ = s.App().TeamKeeper.TeamDelegations(s.Ctx(), &types.QueryTeamDelegationsRequest{Id: 0})
+		Expect(res.TotalDelegated).To(Equal(60_000 * i.KYVE))
+		Expect(res.TotalUnbonding).To(BeZero())
+		Expect(res.Unbondings).To(BeEmpty())
+		Expect(res.DelegationLosses).To(BeZero())
+	})
+
+	It("try_to_undelegate_more_than_delegated", func() {
+		// ARRANGE
+		s.RunTxTeamSuccess(&types.MsgDelegateTeamTokens{
+			Authority: types.FOUNDATION_ADDRESS,
+			Id:        0,
+			Validator: validator,
+			Amount:    100_000 * i.KYVE,
+		})
+
+		// ACT
+		s.RunTxTeamError(&types.MsgUndelegateTeamTokens{
+			Authority: types.FOUNDATION_ADDRESS,
+			Id:        0,
+			Validator: validator,
+			Amount:    100_000*i.KYVE + 1,
+		})
+
+		// ASSERT
+		res, _ := s.App().TeamKeeper.TeamDelegations(s.Ctx(), &types.QueryTeamDelegationsRequest{Id: 0})
+		Expect(res.TotalDelegated).To(Equal(100_000 * i.KYVE))
+		Expect(res.Unbondings).To(BeEmpty())
+	})
+
+	It("slash_team_delegation", func() {
+		// ARRANGE
+		s.RunTxTeamSuccess(&types.MsgDelegateTeamTokens{
+			Authority: types.FOUNDATION_ADDRESS,
+			Id:        0,
+			Validator: validator,
+			Amount:    100_000 * i.KYVE,
+		})
+		s.Commit()
+
+		// ACT
+		slash(s.Ctx().BlockHeight(), validatorPower(), math.LegacyNewDecWithPrec(1, 1))
+
+		// ASSERT
+		res, _ := s.App().TeamKeeper.TeamDelegations(s.Ctx(), &types.QueryTeamDelegationsRequest{Id: 0})
+		Expect(res.DelegationLosses).To(BeNumerically("~", 10_000*i.KYVE, 1))
+		Expect(res.TotalDelegated + res.DelegationLosses).To(Equal(100_000 * i.KYVE))
+
+		tva, _ := s.App().TeamKeeper.GetTeamVestingAccount(s.Ctx(), 0)
+		Expect(tva.DelegationLosses).To(Equal(res.DelegationLosses))
+
+		// the losses reduce the tokens which can be delegated
+		Expect(res.MaxDelegation).To(Equal((1_000_000*i.KYVE - res.DelegationLosses) / 2))
+	})
+
+	It("slash_team_unbonding", func() {
+		// ARRANGE
+		s.RunTxTeamSuccess(&types.MsgDelegateTeamTokens{
+			Authority: types.FOUNDATION_ADDRESS,
+			Id:        0,
+			Validator: validator,
+			Amount:    100_000 * i.KYVE,
+		})
+		s.Commit()
+
+		infractionHeight := s.Ctx().BlockHeight()
+		power := validatorPower()
+
+		s.RunTxTeamSuccess(&types.MsgUndelegateTeamTokens{
+			Authority: types.FOUNDATION_ADDRESS,
+			Id:        0,
+			Validator: validator,
+			Amount:    50_000 * i.KYVE,
+		})
+		s.Commit()
+
+		// ACT
+		slash(infractionHeight, power, math.LegacyNewDecWithPrec(1, 1))
+
+		// ASSERT
+		res, _ := s.App().TeamKeeper.TeamDelegations(s.Ctx(), &types.QueryTeamDelegationsRequest{Id: 0})
+		Expect(res.TotalUnbonding).To(Equal(45_000 * i.KYVE))
+		Expect(res.TotalDelegated).To(BeNumerically("~", 45_000*i.KYVE, 1))
+		Expect(res.DelegationLosses).To(BeNumerically("~", 10_000*i.KYVE, 1))
+		Expect(res.TotalDelegated + res.TotalUnbonding + res.DelegationLosses).To(Equal(100_000 * i.KYVE))
+	})
+
+	It("rebalance_delegations_after_clawback", func() {
+		// ARRANGE
+		s.RunTxTeamSuccess(&types.MsgDelegateTeamTokens{
+			Authority: types.FOUNDATION_ADDRESS,
+			Id:        0,
+			Validator: validator,
+			Amount:    100_000 * i.KYVE,
+		})
+
+		// ACT
+		s.RunTxTeamSuccess(&types.MsgClawback{
+			Authority: types.FOUNDATION_ADDRESS,
+			Id:        0,
+			Clawback:  uint64(s.Ctx().BlockTime().Unix()),
+		})
+		s.CommitAfterSeconds(60)
+
+		// ASSERT
+		res, _ := s.App().TeamKeeper.TeamDelegations(s.Ctx(), &types.QueryTeamDelegationsRequest{Id: 0})
+		Expect(res.Delegations).To(BeEmpty())
+		Expect(res.TotalUnbonding).To(Equal(100_000 * i.KYVE))
+		Expect(res.MaxDelegation).To(BeZero())
+
+		// the tokens are liquid again once the unbonding completed
+		unbondingTime, _ := s.App().StakingKeeper.UnbondingTime(s.Ctx())
+		s.CommitAfter(unbondingTime)
+		s.CommitAfterSeconds(60)
+
+		res, _ = s.App().TeamKeeper.TeamDelegations(s.Ctx(), &types.QueryTeamDelegationsRequest{Id: 0})
+		Expect(res.Unbondings).To(BeEmpty())
+
+		info := s.App().TeamKeeper.GetTeamInfo(s.Ctx())
+		Expect(info.TotalDelegated).To(BeZero())
+		Expect(info.TotalUnbonding).To(BeZero())
+	})
+
+	It("claim_is_paid_after_unbonding", func() {
+		// ARRANGE
+		s.RunTxTeamSuccess(&types.MsgCreateTeamVestingAccount{
+			Authority:       types.FOUNDATION_ADDRESS,
+			TotalAllocation: 1_000_000 * i.KYVE, // 1m
+			Commencement:    types.TGE - YEAR,
+			Schedule: &types.VestingSchedule{
+				CliffDuration:   0,
+				VestingDuration: 4 * YEAR,
+				UnlockDuration:  1,
+			},
+		})
+		s.CommitAfterSeconds(10 * 24 * 60 * 60) // 10 days
+
+		s.RunTxTeamSuccess(&types.MsgDelegateTeamTokens{
+			Authority: types.FOUNDATION_ADDRESS,
+			Id:        1,
+			Validator: validator,
+			Amount:    300_000 * i.KYVE,
+		})
+
+		// after the clawback the account holds fewer tokens than are delegated
+		s.RunTxTeamSuccess(&types.MsgClawback{
+			Authority: types.FOUNDATION_ADDRESS,
+			Id:        1,
+			Clawback:  uint64(s.Ctx().BlockTime().Unix()),
+		})
+
+		// ACT
+		s.RunTxTeamSuccess(&types.MsgClaimUnlocked{
+			Authority: types.FOUNDATION_ADDRESS,
+			Id:        1,
+			Amount:    100_000 * i.KYVE,
+			Recipient: i.ALICE,
+		})
+
+		// ASSERT
+		// the claim is not paid until the undelegated tokens are liquid again
+		Expect(s.GetBalanceFromAddress(i.ALICE)).To(Equal(1_000 * i.KYVE))
+
+		res, _ := s.App().TeamKeeper.TeamDelegations(s.Ctx(), &types.QueryTeamDelegationsRequest{Id: 1})
+		Expect(res.PendingClaims).To(HaveLen(1))
+		Expect(res.PendingClaims[0].Recipient).To(Equal(i.ALICE))
+		Expect(res.PendingClaims[0].Amount).To(Equal(100_000 * i.KYVE))
+		Expect(res.TotalDelegated + res.TotalUnbonding).To(Equal(300_000 * i.KYVE))
+		Expect(res.TotalUnbonding).To(BeNumerically(">", 100_000*i.KYVE))
+
+		// the pending claim can not be claimed again
+		tva, _ := s.App().TeamKeeper.GetTeamVestingAccount(s.Ctx(), 1)
+		status := teamKeeper.GetVestingStatus(tva, uint64(s.Ctx().BlockTime().Unix()))
+		s.RunTxTeamError(&types.MsgClaimUnlocked{
+			Authority: types.FOUNDATION_ADDRESS,
+			Id:        1,
+			Amount:    status.CurrentClaimableAmount - 100_000*i.KYVE + 1,
+			Recipient: i.ALICE,
+		})
+
+		// the claim is paid once the unbonding has completed
+		unbondingTime, _ := s.App().StakingKeeper.UnbondingTime(s.Ctx())
+		s.CommitAfterSeconds(60)
+		s.CommitAfter(unbondingTime)
+		s.CommitAfterSeconds(60)
+
+		Expect(s.GetBalanceFromAddress(i.ALICE)).To(Equal(1_000*i.KYVE + 100_000*i.KYVE))
+
+		res, _ = s.App().TeamKeeper.TeamDelegations(s.Ctx(), &types.QueryTeamDelegationsRequest{Id: 1})
+		Expect(res.PendingClaims).To(BeEmpty())
+		Expect(res.Unbondings).To(BeEmpty())
+
+		tva, _ = s.App().TeamKeeper.GetTeamVestingAccount(s.Ctx(), 1)
+		Expect(tva.UnlockedClaimed).To(Equal(100_000 * i.KYVE))
+	})
+
+	It("credit_staking_rewards", func() {
+		// ARRANGE
+		s.RunTxTeamSuccess(&types.MsgDelegateTeamTokens{
+			Authority: types.FOUNDATION_ADDRESS,
+			Id:        0,
+			Validator: validator,
+			Amount:    100_000 * i.KYVE,
+		})
+
+		Expect(s.MintDenomToModule(distributionTypes.ModuleName, 1_000*i.KYVE, i.KYVE_DENOM)).To(Succeed())
+
+		valAddress, _ := sdk.ValAddressFromBech32(validator)
+		stakingValidator, _ := s.App().StakingKeeper.GetValidator(s.Ctx(), valAddress)
+		rewards := sdk.NewDecCoins(sdk.NewDecCoin(i.KYVE_DENOM, math.NewIntFromUint64(1_000*i.KYVE)))
+		Expect(s.App().DistributionKeeper.AllocateTokensToValidator(s.Ctx(), stakingValidator, rewards)).To(Succeed())
+
+		tvaBefore, _ := s.App().TeamKeeper.GetTeamVestingAccount(s.Ctx(), 0)
+		balanceBefore := s.GetBalanceFromModule(types.ModuleName)
+
+		// ACT
+		s.RunTxTeamSuccess(&types.MsgUndelegateTeamTokens{
+			Authority: types.FOUNDATION_ADDRESS,
+			Id:        0,
+			Validator: validator,
+			Amount:    10_000 * i.KYVE,
+		})
+
+		// ASSERT
+		// the withdrawn staking rewards are credited to the inflation rewards of the account
+		tvaAfter, _ := s.App().TeamKeeper.GetTeamVestingAccount(s.Ctx(), 0)
+		Expect(tvaAfter.TotalRewards).To(BeNumerically(">", tvaBefore.TotalRewards))
+		Expect(tvaAfter.TotalRewards - tvaBefore.TotalRewards).To(Equal(s.GetBalanceFromModule(types.ModuleName) - balanceBefore))
+	})
+
+	It("rebalance_delegations_at_scheduled_time", func() {
+		// ARRANGE
+		unbondingTime, _ := s.App().StakingKeeper.UnbondingTime(s.Ctx())
+
+		// ACT
+		s.RunTxTeamSuccess(&types.MsgDelegateTeamTokens{
+			Authority: types.FOUNDATION_ADDRESS,
+			Id:        0,
+			Validator: validator,
+			Amount:    500_000 * i.KYVE,
+		})
+
+		// ASSERT
+		// the delegation exceeds the maximum delegation once the tokens start unlocking
+		// within the unbonding time
+		now := uint64(s.Ctx().BlockTime().Unix())
+		Expect(s.App().TeamKeeper.GetDueTeamRebalances(s.Ctx(), now)).To(BeEmpty())
+		Expect(s.App().TeamKeeper.GetDueTeamRebalances(s.Ctx(), types.TGE+YEAR)).To(Equal([]uint64{0}))
+
+		s.CommitAfter(time.Duration(YEAR)*time.Second - unbondingTime - time.Minute)
+
+		res, _ := s.App().TeamKeeper.TeamDelegations(s.Ctx(), &types.QueryTeamDelegationsRequest{Id: 0})
+		Expect(res.TotalDelegated).To(Equal(500_000 * i.KYVE))
+		Expect(res.Unbondings).To(BeEmpty())
+
+		s.CommitAfterSeconds(3600)
+
+		res, _ = s.App().TeamKeeper.TeamDelegations(s.Ctx(), &types.QueryTeamDelegationsRequest{Id: 0})
+		Expect(res.TotalDelegated).To(BeNumerically("<", 500_000*i.KYVE))
+		Expect(res.TotalDelegated).To(Equal(res.MaxDelegation))
+		Expect(res.TotalUnbonding).To(BeNumerically(">", 0))
+
+		// the next rebalance is scheduled after the current block
+		now = uint64(s.Ctx().BlockTime().Unix())
+		Expect(s.App().TeamKeeper.GetDueTeamRebalances(s.Ctx(), now)).To(BeEmpty())
+	})
+
+	It("delegate_withdraw_rewards_and_unbond_through_blocks", func() {
+		// ARRANGE
+		s.RunTxTeamSuccess(&types.MsgDelegateTeamTokens{
+			Authority: types.FOUNDATION_ADDRESS,
+			Id:        0,
+			Validator: validator,
+			Amount:    100_000 * i.KYVE,
+		})
+
+		// the tokens are delegated by the team delegation account
+		delegationAddress := s.App().AccountKeeper.GetModuleAddress(types.TeamDelegationAccountName)
+		valAddress, _ := sdk.ValAddressFromBech32(validator)
+		_, err := s.App().StakingKeeper.GetDelegation(s.Ctx(), delegationAddress, valAddress)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(s.MintDenomToModule(distributionTypes.ModuleName, 1_000*i.KYVE, i.KYVE_DENOM)).To(Succeed())
+		stakingValidator, _ := s.App().StakingKeeper.GetValidator(s.Ctx(), valAddress)
+		rewards := sdk.NewDecCoins(sdk.NewDecCoin(i.KYVE_DENOM, math.NewIntFromUint64(1_000*i.KYVE)))
+		Expect(s.App().DistributionKeeper.AllocateTokensToValidator(s.Ctx(), stakingValidator, rewards)).To(Succeed())
+
+		s.CommitAfterSeconds(60)
+
+		tvaBefore, _ := s.App().TeamKeeper.GetTeamVestingAccount(s.Ctx(), 0)
+		balanceBefore := s.GetBalanceFromModule(types.ModuleName)
+
+		// ACT
+		s.RunTxTeamSuccess(&types.MsgClaimAccountRewards{
+			Authority: types.FOUNDATION_ADDRESS,
+			Id:        0,
+			Amount:    1,
+			Recipient: i.ALICE,
+		})
+
+		// ASSERT
+		// the staking rewards were withdrawn to the team delegation account and moved to the team module
+		tvaAfter, _ := s.App().TeamKeeper.GetTeamVestingAccount(s.Ctx(), 0)
+		Expect(tvaAfter.TotalRewards).To(BeNumerically(">", tvaBefore.TotalRewards))
+		Expect(tvaAfter.TotalRewards - tvaBefore.TotalRewards).To(Equal(s.GetBalanceFromModule(types.ModuleName) - balanceBefore + 1))
+		Expect(s.GetBalanceFromModule(types.TeamDelegationAccountName)).To(BeZero())
+
+		// ACT
+		s.RunTxTeamSuccess(&types.MsgUndelegateTeamTokens{
+			Authority: types.FOUNDATION_ADDRESS,
+			Id:        0,
+			Validator: validator,
+			Amount:    100_000 * i.KYVE,
+		})
+
+		unbondingTime, _ := s.App().StakingKeeper.UnbondingTime(s.Ctx())
+		s.CommitAfterSeconds(60)
+		s.CommitAfter(unbondingTime)
+		s.CommitAfterSeconds(60)
+		s.CommitAfterSeconds(60)
+
+		// ASSERT
+		// the staking module completed the unbonding and the tokens were moved back to the team module
+		_, err = s.App().StakingKeeper.GetUnbondingDelegation(s.Ctx(), delegationAddress, valAddress)
+		Expect(err).To(HaveOccurred())
+
+		res, _ := s.App().TeamKeeper.TeamDelegations(s.Ctx(), &types.QueryTeamDelegationsRequest{Id: 0})
+		Expect(res.Delegations).To(BeEmpty())
+		Expect(res.Unbondings).To(BeEmpty())
+		Expect(res.TotalDelegated).To(BeZero())
+		Expect(res.TotalUnbonding).To(BeZero())
+
+		Expect(s.GetBalanceFromModule(types.TeamDelegationAccountName)).To(BeZero())
+
+		info := s.App().TeamKeeper.GetTeamInfo(s.Ctx())
+		Expect(info.TotalDelegated).To(BeZero())
+		Expect(info.TotalUnbonding).To(BeZero())
+		Expect(info.TeamModuleBalance).To(BeNumerically(">=", info.RequiredModuleBalance))
+	})
+})
@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/KYVENetwork/chain/x/team/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) PartialClawback(goCtx context.Context, msg *types.MsgPartialClawback) (*types.MsgPartialClawbackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorities := k.GetTeamAuthorities(ctx)
+	if !authorities.IsAuthority(msg.Authority) {
+		return nil, errors.Wrapf(sdkErrors.ErrLogic, types.ErrInvalidAuthority.Error(), authorities.Foundation, authorities.Bcp, msg.Authority)
+	}
+
+	if err := msg.ValidateAmount(); err != nil {
+		return nil, errors.Wrapf(sdkErrors.ErrInvalidRequest, types.ErrInvalidPartialClawback.Error(), err)
+	}
+
+	account, found := k.GetTeamVestingAccount(ctx, msg.Id)
+	if !found {
+		return nil, sdkErrors.ErrNotFound
+	}
+
+	// partial clawbacks only apply going forward, by default from the current block on
+	now := uint64(ctx.BlockTime().Unix())
+	clawbackTime := msg.Time
+	if clawbackTime == 0 {
+		clawbackTime = now
+	}
+
+	if clawbackTime < now {
+		return nil, errors.Wrapf(sdkErrors.ErrLogic, types.ErrInvalidPartialClawback.Error(), fmt.Sprintf("time %v is in the past", clawbackTime))
+	}
+
+	// the amount can only be taken from the allocation which was not clawed back yet
+	remainingAllocation := account.TotalAllocation - account.GetPartialClawbackAmount()
+
+	amount := msg.Amount
+	if msg.HasFraction() {
+		amount = uint64(math.LegacyNewDec(int64(remainingAllocation)).Mul(msg.Fraction).TruncateInt64())
+	}
+
+	if amount == 0 || amount > remainingAllocation {
+		return nil, errors.Wrapf(sdkErrors.ErrLogic, types.ErrInvalidPartialClawback.Error(), fmt.Sprintf("amount %v exceeds remaining allocation %v or is zero", amount, remainingAllocation))
+	}
+
+	account.PartialClawbacks = append(account.PartialClawbacks, types.PartialClawback{
+		Time:   clawbackTime,
+		Amount: amount,
+	})
+	k.SetTeamVestingAccount(ctx, account)
+
+	// the maximum delegation of the account changed, so the delegations have to be rebalanced at a different time
+	k.scheduleTeamRebalance(ctx, account)
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventPartialClawback{
+		Authority: msg.Authority,
+		Id:        account.Id,
+		Time:      clawbackTime,
+		Amount:    amount,
+	})
+
+	return &types.MsgPartialClawbackResponse{}, nil
+}
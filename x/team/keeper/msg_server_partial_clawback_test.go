@@ -0,0 +1,355 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	teamKeeper "github.com/KYVENetwork/chain/x/team/keeper"
+	"github.com/KYVENetwork/chain/x/team/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+/*
+
+TEST CASES - msg_server_partial_clawback.go, msg_server_reverse_clawback.go
+
+* try_with_invalid_authority
+* try_partial_clawback_in_the_past
+* try_partial_clawback_higher_than_remaining_allocation
+* try_partial_clawback_with_amount_and_fraction
+* partial_clawback_by_amount
+* partial_clawback_by_fraction_multiple_times
+* partial_clawback_combined_with_full_clawback
+* reverse_pending_partial_clawback
+* try_to_reverse_effective_partial_clawback
+* reverse_pending_full_clawback
+* try_to_reverse_non_existing_clawback
+* try_to_reverse_partial_clawback_without_available_allocation
+
+*/
+
+var _ = Describe("msg_server_partial_clawback.go, msg_server_reverse_clawback.go", Ordered, func() {
+	s := i.NewCleanChainAtTime(int64(types.TGE))
+
+	BeforeEach(func() {
+		// init new clean chain at TGE time
+		s = i.NewCleanChainAtTime(int64(types.TGE))
+
+		s.RunTxTeamSuccess(&types.MsgCreateTeamVestingAccount{
+			Authority:       types.FOUNDATION_ADDRESS,
+			TotalAllocation: 1_000_000 * i.KYVE, // 1m
+			Commencement:    types.TGE,
+		})
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("try_with_invalid_authority", func() {
+		// ACT
+		s.RunTxTeamError(&types.MsgPartialClawback{
+			Authority: i.ALICE,
+			Id:        0,
+			Amount:    100_000 * i.KYVE,
+			Fraction:  math.LegacyZeroDec(),
+		})
+
+		// ASSERT
+		tva, _ := s.App().TeamKeeper.GetTeamVestingAccount(s.Ctx(), 0)
+		Expect(tva.PartialClawbacks).To(BeEmpty())
+	})
+
+	It("try_partial_clawback_in_the_past", func() {
+		// ARRANGE
+		s.CommitAfterSeconds(YEAR)
+
+		// ACT
+		s.RunTxTeamError(&types.MsgPartialClawback{
+			Authority: types.FOUNDATION_ADDRESS,
+			Id:        0,
+			Amount:    100_000 * i.KYVE,
+			Fraction:  math.LegacyZeroDec(),
+			Time:      types.TGE + YEAR/2,
+		})
+
+		// ASSERT
+		tva, _ := s.App().TeamKeeper.GetTeamVestingAccount(s.Ctx(), 0)
+		Expect(tva.PartialClawbacks).To(BeEmpty())
+	})
+
+	It("try_partial_clawback_higher_than_remaining_allocation", func() {
+		// ARRANGE
+		s.RunTxTeamSuccess(&types.MsgPartialClawback{
+			Authority: types.FOUNDATION_ADDRESS,
+			Id:        0,
+			Amount:    600_000 * i.KYVE,
+			Fraction:  math.LegacyZeroDec(),
+		})
+
+		// ACT
+		s.RunTxTeamError(&types.MsgPartialClawback{
+			Authority: types.FOUNDATION_ADDRESS,
+			Id:        0,
+			Amount:    400_001 * i.KYVE,
+			Fraction:  math.LegacyZeroDec(),
+		})
+
+		// ASSERT
+		tva, _ := s.App().TeamKeeper.GetTeamVestingAccount(s.Ctx(), 0)
+		Expect(tva.PartialClawbacks).To(HaveLen(1))
+		Expect(tva.GetPartialClawbackAmount()).To(Equal(600_000 * i.KYVE))
+	})
+
+	It("try_partial_clawback_with_amount_and_fraction", func() {
+		// ACT
+		s.RunTxTeamError(&types.MsgPartialClawback{
+			Authority: types.FOUNDATION_ADDRESS,
+			Id:        0,
+			Amount:    100_000 * i.KYVE,
+			Fraction:  math.LegacyMustNewDecFromStr("0.5"),
+		})
+		s.RunTxTeamError(&types.MsgPartialClawback{
+			Authority: types.FOUNDATION_ADDRESS,
+			Id:        0,
+			Fraction:  math.LegacyMustNewDecFromStr("1.5"),
+		})
+
+		// ASSERT
+		tva, _ := s.App().TeamKeeper.GetTeamVestingAccount(s.Ctx(), 0)
+		Expect(tva.PartialClawbacks).To(BeEmpty())
+	})
+
+	It("partial_clawback_by_amount", func() {
+		// ACT
+		s.RunTxTeamSuccess(&types.MsgPartialClawback{
+			Authority: types.FOUNDATION_ADDRESS,
+			Id:        0,
+			Amount:    300_000 * i.KYVE,
+			Fraction:  math.LegacyZeroDec(),
+			Time:      types.TGE + 2*YEAR,
+		})
+
+		// ASSERT
+		tva, _ := s.App().TeamKeeper.GetTeamVestingAccount(s.Ctx(), 0)
+		Expect(tva.TotalAllocation).To(Equal(1_000_000 * i.KYVE))
+
+		// the clawed back amount vests until its clawback time, so 2/3 of it stays with the account
+		plan := teamKeeper.GetVestingPlan(tva)
+		Expect(plan.MaximumVestingAmount).To(Equal(900_000 * i.KYVE))
+		Expect(plan.ClawbackAmount).To(Equal(100_000 * i.KYVE))
+		Expect(plan.PartialClawbackAmount).To(Equal(100_000 * i.KYVE))
+
+		info := s.App().TeamKeeper.GetTeamInfo(s.Ctx())
+		Expect(info.IssuedTeamAllocation).To(Equal(900_000 * i.KYVE))
+
+		// before the partial clawback the account vests as before
+		status := teamKeeper.GetVestingStatus(tva, types.TGE+YEAR+YEAR/2)
+		Expect(status.TotalVestedAmount).To(Equal(500_000 * i.KYVE))
+
+		// ACT
+		s.CommitAfterSeconds(3 * YEAR)
+
+		s.RunTxTeamSuccess(&types.MsgClaimUnlocked{
+			Authority: types.FOUNDATION_ADDRESS,
+			Id:        0,
+			Amount:    900_000 * i.KYVE,
+			Recipient: i.ALICE,
+		})
+		s.RunTxTeamError(&types.MsgClaimUnlocked{
+			Authority: types.FOUNDATION_ADDRESS,
+			Id:        0,
+			Amount:    1,
+			Recipient: i.ALICE,
+		})
+
+		// ASSERT
+		tva, _ = s.App().TeamKeeper.GetTeamVestingAccount(s.Ctx(), 0)
+		status = teamKeeper.GetVestingStatus(tva, uint64(s.Ctx().BlockTime().Unix()))
+		Expect(status.TotalVestedAmount).To(Equal(900_000 * i.KYVE))
+		Expect(status.RemainingUnvestedAmount).To(BeZero())
+		Expect(tva.UnlockedClaimed).To(Equal(900_000 * i.KYVE))
+	})
+
+	It("partial_clawback_by_fraction_multiple_times", func() {
+		// ACT
+		s.RunTxTeamSuccess(&types.MsgPartialClawback{
+			Authority: types.FOUNDATION_ADDRESS,
+			Id:        0,
+			Fraction:  math.LegacyMustNewDecFromStr("0.5"),
+		})
+		s.RunTxTeamSuccess(&types.MsgPartialClawback{
+			Authority: types.FOUNDATION_ADDRESS,
+			Id:        0,
+			Fraction:  math.LegacyMustNewDecFromStr("0.5"),
+		})
+
+		// ASSERT
+		tva, _ := s.App().TeamKeeper.GetTeamVestingAccount(s.Ctx(), 0)
+		Expect(tva.PartialClawbacks).To(HaveLen(2))
+		Expect(tva.PartialClawbacks[0].Amount).To(Equal(500_000 * i.KYVE))
+		Expect(tva.PartialClawbacks[1].Amount).To(Equal(250_000 * i.KYVE))
+
+		// nothing has vested before the cliff, so the entire amounts are clawed back
+		plan := teamKeeper.GetVestingPlan(tva)
+		Expect(plan.MaximumVestingAmount).To(Equal(250_000 * i.KYVE))
+		Expect(plan.ClawbackAmount).To(Equal(750_000 * i.KYVE))
+		Expect(plan.PartialClawbackAmount).To(Equal(750_000 * i.KYVE))
+
+		info := s.App().TeamKeeper.GetTeamInfo(s.Ctx())
+		Expect(info.AvailableTeamAllocation).To(Equal(types.TEAM_ALLOCATION - 250_000*i.KYVE))
+	})
+
+	It("partial_clawback_combined_with_full_clawback", func() {
+		// ACT
+		s.RunTxTeamSuccess(&types.MsgPartialClawback{
+			Authority: types.FOUNDATION_ADDRESS,
+			Id:        0,
+			Amount:    300_000 * i.KYVE,
+			Fraction:  math.LegacyZeroDec(),
+			Time:      types.TGE + 2*YEAR,
+		})
+		s.RunTxTeamSuccess(&types.MsgClawback{
+			Authority: types.FOUNDATION_ADDRESS,
+			Id:        0,
+			Clawback:  types.TGE + YEAR + YEAR/2,
+		})
+
+		// ASSERT
+		tva, _ := s.App().TeamKeeper.GetTeamVestingAccount(s.Ctx(), 0)
+
+		// the full clawback comes first and stops the vesting of the entire allocation
+		plan := teamKeeper.GetVestingPlan(tva)
+		Expect(plan.MaximumVestingAmount).To(Equal(500_000 * i.KYVE))
+		Expect(plan.ClawbackAmount).To(Equal(500_000 * i.KYVE))
+		Expect(plan.PartialClawbackAmount).To(Equal(100_000 * i.KYVE))
+
+		info := s.App().TeamKeeper.GetTeamInfo(s.Ctx())
+		Expect(info.IssuedTeamAllocation).To(Equal(500_000 * i.KYVE))
+	})
+
+	It("reverse_pending_partial_clawback", func() {
+		// ARRANGE
+		s.RunTxTeamSuccess(&types.MsgPartialClawback{
+			Authority: types.FOUNDATION_ADDRESS,
+			Id:        0,
+			Amount:    100_000 * i.KYVE,
+			Fraction:  math.LegacyZeroDec(),
+			Time:      types.TGE + 2*YEAR,
+		})
+		s.RunTxTeamSuccess(&types.MsgPartialClawback{
+			Authority: types.FOUNDATION_ADDRESS,
+			Id:        0,
+			Amount:    300_000 * i.KYVE,
+			Fraction:  math.LegacyZeroDec(),
+			Time:      types.TGE + 2*YEAR,
+		})
+
+		s.CommitAfterSeconds(YEAR)
+
+		// ACT
+		s.RunTxTeamSuccess(&types.MsgReverseClawback{
+			Authority: types.FOUNDATION_ADDRESS,
+			Id:        0,
+			Index:     1,
+		})
+
+		// ASSERT
+		tva, _ := s.App().TeamKeeper.GetTeamVestingAccount(s.Ctx(), 0)
+		Expect(tva.PartialClawbacks).To(HaveLen(1))
+		Expect(tva.PartialClawbacks[0].Amount).To(Equal(100_000 * i.KYVE))
+
+		plan := teamKeeper.GetVestingPlan(tva)
+		Expect(plan.MaximumVestingAmount).To(Equal(uint64(966_666_666_666)))
+	})
+
+	It("try_to_reverse_effective_partial_clawback", func() {
+		// ARRANGE
+		s.RunTxTeamSuccess(&types.MsgPartialClawback{
+			Authority: types.FOUNDATION_ADDRESS,
+			Id:        0,
+			Amount:    300_000 * i.KYVE,
+			Fraction:  math.LegacyZeroDec(),
+			Time:      types.TGE + YEAR,
+		})
+
+		s.CommitAfterSeconds(YEAR + 1)
+
+		// ACT
+		s.RunTxTeamError(&types.MsgReverseClawback{
+			Authority: types.FOUNDATION_ADDRESS,
+			Id:        0,
+			Index:     0,
+		})
+
+		// ASSERT
+		tva, _ := s.App().TeamKeeper.GetTeamVestingAccount(s.Ctx(), 0)
+		Expect(tva.PartialClawbacks).To(HaveLen(1))
+	})
+
+	It("reverse_pending_full_clawback", func() {
+		// ARRANGE
+		s.RunTxTeamSuccess(&types.MsgClawback{
+			Authority: types.FOUNDATION_ADDRESS,
+			Id:        0,
+			Clawback:  types.TGE + 2*YEAR,
+		})
+
+		// ACT
+		s.RunTxTeamSuccess(&types.MsgReverseClawback{
+			Authority: types.FOUNDATION_ADDRESS,
+			Id:        0,
+			Full:      true,
+		})
+
+		// ASSERT
+		tva, _ := s.App().TeamKeeper.GetTeamVestingAccount(s.Ctx(), 0)
+		Expect(tva.Clawback).To(BeZero())
+		Expect(teamKeeper.GetVestingPlan(tva).MaximumVestingAmount).To(Equal(1_000_000 * i.KYVE))
+	})
+
+	It("try_to_reverse_non_existing_clawback", func() {
+		// ACT
+		s.RunTxTeamError(&types.MsgReverseClawback{
+			Authority: types.FOUNDATION_ADDRESS,
+			Id:        0,
+			Full:      true,
+		})
+		s.RunTxTeamError(&types.MsgReverseClawback{
+			Authority: types.FOUNDATION_ADDRESS,
+			Id:        0,
+			Index:     0,
+		})
+	})
+
+	It("try_to_reverse_partial_clawback_without_available_allocation", func() {
+		// ARRANGE
+		s.RunTxTeamSuccess(&types.MsgPartialClawback{
+			Authority: types.FOUNDATION_ADDRESS,
+			Id:        0,
+			Amount:    300_000 * i.KYVE,
+			Fraction:  math.LegacyZeroDec(),
+			Time:      types.TGE + 2*YEAR,
+		})
+
+		// issue the entire remaining team allocation including the clawed back amount
+		info := s.App().TeamKeeper.GetTeamInfo(s.Ctx())
+		s.RunTxTeamSuccess(&types.MsgCreateTeamVestingAccount{
+			Authority:       types.FOUNDATION_ADDRESS,
+			TotalAllocation: info.AvailableTeamAllocation,
+			Commencement:    types.TGE,
+		})
+
+		// ACT
+		s.RunTxTeamError(&types.MsgReverseClawback{
+			Authority: types.FOUNDATION_ADDRESS,
+			Id:        0,
+			Index:     0,
+		})
+
+		// ASSERT
+		tva, _ := s.App().TeamKeeper.GetTeamVestingAccount(s.Ctx(), 0)
+		Expect(tva.PartialClawbacks).To(HaveLen(1))
+		Expect(s.App().TeamKeeper.GetTeamInfo(s.Ctx()).AvailableTeamAllocation).To(BeZero())
+	})
+})
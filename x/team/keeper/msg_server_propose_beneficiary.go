@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"github.com/KYVENetwork/chain/x/team/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ProposeBeneficiary proposes a new beneficiary for a team vesting account. The
+// proposal has to be accepted by the new beneficiary with MsgAcceptBeneficiary
+// before it takes effect.
+func (k msgServer) ProposeBeneficiary(goCtx context.Context, msg *types.MsgProposeBeneficiary) (*types.MsgProposeBeneficiaryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	account, found := k.GetTeamVestingAccount(ctx, msg.Id)
+	if !found {
+		return nil, sdkErrors.ErrNotFound
+	}
+
+	// the authority and the current beneficiary are allowed to propose a new beneficiary
+	if !k.canClaimForAccount(ctx, account, msg.Creator) {
+		return nil, errors.Wrapf(sdkErrors.ErrUnauthorized, types.ErrNotBeneficiary.Error(), account.Beneficiary, msg.Creator)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.NewBeneficiary); err != nil {
+		return nil, errors.Wrapf(sdkErrors.ErrInvalidAddress, "invalid beneficiary address (%s)", err)
+	}
+
+	account.PendingBeneficiary = msg.NewBeneficiary
+	account.PendingBeneficiaryProposer = msg.Creator
+	k.SetTeamVestingAccount(ctx, account)
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventProposeBeneficiary{
+		Proposer:           msg.Creator,
+		Id:                 account.Id,
+		Beneficiary:        account.Beneficiary,
+		PendingBeneficiary: account.PendingBeneficiary,
+	})
+
+	return &types.MsgProposeBeneficiaryResponse{}, nil
+}
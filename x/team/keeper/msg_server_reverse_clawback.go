@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/errors"
+	"github.com/KYVENetwork/chain/x/team/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) ReverseClawback(goCtx context.Context, msg *types.MsgReverseClawback) (*types.MsgReverseClawbackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorities := k.GetTeamAuthorities(ctx)
+	if !authorities.IsAuthority(msg.Authority) {
+		return nil, errors.Wrapf(sdkErrors.ErrLogic, types.ErrInvalidAuthority.Error(), authorities.Foundation, authorities.Bcp, msg.Authority)
+	}
+
+	account, found := k.GetTeamVestingAccount(ctx, msg.Id)
+	if !found {
+		return nil, sdkErrors.ErrNotFound
+	}
+
+	now := uint64(ctx.BlockTime().Unix())
+	previousMaxAmount := getVestingMaxAmount(account)
+
+	var clawbackTime uint64
+	if msg.Full {
+		if account.Clawback == 0 {
+			return nil, errors.Wrapf(sdkErrors.ErrLogic, types.ErrClawbackNotReversible.Error(), "account has no clawback")
+		}
+
+		clawbackTime = account.Clawback
+		account.Clawback = 0
+	} else {
+		if msg.Index >= uint64(len(account.PartialClawbacks)) {
+			return nil, errors.Wrapf(sdkErrors.ErrLogic, types.ErrClawbackNotReversible.Error(), fmt.Sprintf("partial clawback %v does not exist", msg.Index))
+		}
+
+		clawbackTime = account.PartialClawbacks[msg.Index].Time
+		account.PartialClawbacks = append(account.PartialClawbacks[:msg.Index], account.PartialClawbacks[msg.Index+1:]...)
+	}
+
+	// only clawbacks which have not taken effect yet can be reversed
+	if clawbackTime <= now {
+		return nil, errors.Wrapf(sdkErrors.ErrLogic, types.ErrClawbackNotReversible.Error(), fmt.Sprintf("clawback at %v has already taken effect", clawbackTime))
+	}
+
+	// the reversed amount is issued to the account again, so the team needs enough available allocation
+	amount := getVestingMaxAmount(account) - previousMaxAmount
+	if k.GetIssuedTeamAllocation(ctx)+amount > types.TEAM_ALLOCATION {
+		return nil, errors.Wrapf(sdkErrors.ErrLogic, types.ErrAvailableFundsTooLow.Error(), types.TEAM_ALLOCATION-k.GetIssuedTeamAllocation(ctx), amount)
+	}
+
+	k.SetTeamVestingAccount(ctx, account)
+
+	// the maximum delegation of the account changed, so the delegations have to be rebalanced at a different time
+	k.scheduleTeamRebalance(ctx, account)
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventReverseClawback{
+		Authority: msg.Authority,
+		Id:        account.Id,
+		Full:      msg.Full,
+		Index:     msg.Index,
+		Time:      clawbackTime,
+		Amount:    amount,
+	})
+
+	return &types.MsgReverseClawbackResponse{}, nil
+}
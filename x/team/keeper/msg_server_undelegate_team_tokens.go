@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"github.com/KYVENetwork/chain/x/team/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) UndelegateTeamTokens(goCtx context.Context, msg *types.MsgUndelegateTeamTokens) (*types.MsgUndelegateTeamTokensResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorities := k.GetTeamAuthorities(ctx)
+	if !authorities.IsAuthority(msg.Authority) {
+		return nil, errors.Wrapf(sdkErrors.ErrLogic, types.ErrInvalidAuthority.Error(), authorities.Foundation, authorities.Bcp, msg.Authority)
+	}
+
+	account, found := k.GetTeamVestingAccount(ctx, msg.Id)
+	if !found {
+		return nil, sdkErrors.ErrNotFound
+	}
+
+	if msg.Amount == 0 {
+		return nil, errors.Wrapf(sdkErrors.ErrInvalidRequest, "amount must be greater than zero")
+	}
+
+	if err := k.undelegateTeamTokens(ctx, &account, msg.Validator, msg.Amount, msg.Authority); err != nil {
+		return nil, err
+	}
+
+	k.SetTeamVestingAccount(ctx, account)
+
+	return &types.MsgUndelegateTeamTokensResponse{}, nil
+}
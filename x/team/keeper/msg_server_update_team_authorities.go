@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"github.com/KYVENetwork/chain/x/team/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	// Gov
+	govTypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// UpdateTeamAuthorities replaces the foundation and the bcp authority. It can
+// only be executed by governance.
+func (k msgServer) UpdateTeamAuthorities(goCtx context.Context, msg *types.MsgUpdateTeamAuthorities) (*types.MsgUpdateTeamAuthoritiesResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(govTypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	newAuthorities := types.TeamAuthorities{
+		Foundation: msg.FoundationAuthority,
+		Bcp:        msg.BcpAuthority,
+	}
+	if err := newAuthorities.Validate(); err != nil {
+		return nil, errors.Wrapf(sdkErrors.ErrInvalidRequest, types.ErrInvalidTeamAuthorities.Error(), err)
+	}
+
+	oldAuthorities := k.GetTeamAuthorities(ctx)
+	k.SetTeamAuthorities(ctx, newAuthorities)
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventUpdateTeamAuthorities{
+		OldAuthorities: oldAuthorities,
+		NewAuthorities: newAuthorities,
+	})
+
+	return &types.MsgUpdateTeamAuthoritiesResponse{}, nil
+}
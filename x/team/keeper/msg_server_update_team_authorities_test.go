@@ -0,0 +1,202 @@
+package keeper_test
+
+import (
+	"time"
+
+	i "github.com/KYVENetwork/chain/testutil/integration"
+	"github.com/KYVENetwork/chain/x/team/keeper"
+	"github.com/KYVENetwork/chain/x/team/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	// Gov
+	govV1Types "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+/*
+
+TEST CASES - msg_server_update_team_authorities.go
+
+* Check default team authorities
+* Invalid authority (transaction)
+* Invalid authority (proposal)
+* Update team authorities
+* New foundation authority can create team vesting accounts
+* Update team authorities with invalid address
+* Migration seeds the link-time team authorities
+
+*/
+
+var _ = Describe("msg_server_update_team_authorities.go", Ordered, func() {
+	var s *i.KeeperTestSuite
+	var gov string
+	var minDeposit sdk.Coins
+	var votingPeriod *time.Duration
+	var voter sdk.AccAddress
+	var delegations []stakingtypes.Delegation
+
+	// submitAndPass submits the given message as a proposal and votes yes on it
+	submitAndPass := func(msg sdk.Msg) {
+		proposal, _ := govV1Types.NewMsgSubmitProposal(
+			[]sdk.Msg{msg}, minDeposit, i.DUMMY[0], "", "title", "summary", false,
+		)
+
+		vote := govV1Types.NewMsgVote(
+			voter, 1, govV1Types.VoteOption_VOTE_OPTION_YES, "",
+		)
+
+		_, submitErr := s.RunTx(proposal)
+		_, voteErr := s.RunTx(vote)
+
+		s.CommitAfter(*votingPeriod)
+		s.Commit()
+
+		Expect(submitErr).NotTo(HaveOccurred())
+		Expect(voteErr).NotTo(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		// init new clean chain at TGE time
+		s = i.NewCleanChainAtTime(int64(types.TGE))
+
+		gov = s.App().GovKeeper.GetGovernanceAccount(s.Ctx()).GetAddress().String()
+		params, err := s.App().GovKeeper.Params.Get(s.Ctx())
+		Expect(err).NotTo(HaveOccurred())
+
+		minDeposit = params.MinDeposit
+		votingPeriod = params.VotingPeriod
+
+		delegations, err = s.App().StakingKeeper.GetAllDelegations(s.Ctx())
+		Expect(err).NotTo(HaveOccurred())
+
+		voter = sdk.MustAccAddressFromBech32(delegations[0].DelegatorAddress)
+	})
+
+	AfterEach(func() {
+		s.PerformValidityChecks()
+	})
+
+	It("Check default team authorities", func() {
+		// ASSERT
+		authorities := s.App().TeamKeeper.GetTeamAuthorities(s.Ctx())
+
+		Expect(authorities).To(Equal(types.DefaultTeamAuthorities()))
+		Expect(authorities.Foundation).To(Equal(types.FOUNDATION_ADDRESS))
+		Expect(authorities.Bcp).To(Equal(types.BCP_ADDRESS))
+	})
+
+	It("Invalid authority (transaction)", func() {
+		// ACT
+		s.RunTxTeamError(&types.MsgUpdateTeamAuthorities{
+			Authority:           types.FOUNDATION_ADDRESS,
+			FoundationAuthority: i.ALICE,
+			BcpAuthority:        i.BOB,
+		})
+
+		// ASSERT
+		Expect(s.App().TeamKeeper.GetTeamAuthorities(s.Ctx())).To(Equal(types.DefaultTeamAuthorities()))
+	})
+
+	It("Invalid authority (proposal)", func() {
+		// ARRANGE
+		msg := &types.MsgUpdateTeamAuthorities{
+			Authority:           i.DUMMY[0],
+			FoundationAuthority: i.ALICE,
+			BcpAuthority:        i.BOB,
+		}
+
+		proposal, _ := govV1Types.NewMsgSubmitProposal(
+			[]sdk.Msg{msg}, minDeposit, i.DUMMY[0], "", "title", "summary", false,
+		)
+
+		// ACT
+		_, err := s.RunTx(proposal)
+
+		// ASSERT
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("Update team authorities", func() {
+		// ACT
+		submitAndPass(&types.MsgUpdateTeamAuthorities{
+			Authority:           gov,
+			FoundationAuthority: i.ALICE,
+			BcpAuthority:        i.BOB,
+		})
+
+		// ASSERT
+		authorities := s.App().TeamKeeper.GetTeamAuthorities(s.Ctx())
+		Expect(authorities.Foundation).To(Equal(i.ALICE))
+		Expect(authorities.Bcp).To(Equal(i.BOB))
+
+		info := s.App().TeamKeeper.GetTeamInfo(s.Ctx())
+		Expect(info.FoundationAuthority).To(Equal(i.ALICE))
+		Expect(info.BcpAuthority).To(Equal(i.BOB))
+	})
+
+	It("New foundation authority can create team vesting accounts", func() {
+		// ARRANGE
+		submitAndPass(&types.MsgUpdateTeamAuthorities{
+			Authority:           gov,
+			FoundationAuthority: i.ALICE,
+			BcpAuthority:        i.BOB,
+		})
+
+		// ACT
+		s.RunTxTeamError(&types.MsgCreateTeamVestingAccount{
+			Authority:       types.FOUNDATION_ADDRESS,
+			TotalAllocation: 1_000_000 * i.KYVE,
+			Commencement:    types.TGE,
+		})
+
+		s.RunTxTeamSuccess(&types.MsgCreateTeamVestingAccount{
+			Authority:       i.ALICE,
+			TotalAllocation: 1_000_000 * i.KYVE,
+			Commencement:    types.TGE,
+		})
+
+		// ASSERT
+		Expect(s.App().TeamKeeper.GetTeamVestingAccounts(s.Ctx())).To(HaveLen(1))
+
+		// old bcp authority can no longer clawback
+		s.RunTxTeamError(&types.MsgClawback{
+			Authority: types.BCP_ADDRESS,
+			Id:        0,
+			Clawback:  0,
+		})
+
+		// new bcp authority can clawback
+		s.RunTxTeamSuccess(&types.MsgClawback{
+			Authority: i.BOB,
+			Id:        0,
+			Clawback:  0,
+		})
+	})
+
+	It("Update team authorities with invalid address", func() {
+		// ACT
+		submitAndPass(&types.MsgUpdateTeamAuthorities{
+			Authority:           gov,
+			FoundationAuthority: "invalid",
+			BcpAuthority:        i.BOB,
+		})
+
+		// ASSERT
+		Expect(s.App().TeamKeeper.GetTeamAuthorities(s.Ctx())).To(Equal(types.DefaultTeamAuthorities()))
+	})
+
+	It("Migration seeds the link-time team authorities", func() {
+		// ACT
+		err := keeper.NewMigrator(s.App().TeamKeeper).Migrate1to2(s.Ctx())
+		Expect(err).NotTo(HaveOccurred())
+
+		// ASSERT
+		Expect(s.App().TeamKeeper.GetTeamAuthorities(s.Ctx())).To(Equal(types.DefaultTeamAuthorities()))
+
+		info := s.App().TeamKeeper.GetTeamInfo(s.Ctx())
+		Expect(info.FoundationAuthority).To(Equal(types.FOUNDATION_ADDRESS))
+	})
+})
@@ -16,7 +16,10 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	mintKeeper "github.com/cosmos/cosmos-sdk/x/mint/keeper"
+	stakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
 
@@ -132,10 +135,17 @@ func (AppModule) QuerierRoute() string { return types.RouterKey }
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
 }
 
 // RegisterInvariants registers the invariants of the module. If an invariant deviates from its predicted value, the InvariantRegistry triggers appropriate logic (most often the chain will be halted)
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
 
 // InitGenesis performs the module's genesis initialization. It returns no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, gs json.RawMessage) {
@@ -153,11 +163,16 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion is a sequence number for state-breaking change of the module. It should be incremented on each consensus-breaking change introduced by the module. To avoid wrong/empty versions, the initial version should be set to 1
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return 2 }
 
 // BeginBlock contains the logic that is automatically triggered at the beginning of each block
 func (am AppModule) BeginBlock(ctx context.Context) error {
-	DistributeTeamInflation(sdk.UnwrapSDKContext(ctx), am.bk, am.keeper, am.uk)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	am.keeper.ProcessTeamUnbondings(sdkCtx)
+	am.keeper.RebalanceTeamDelegations(sdkCtx)
+
+	DistributeTeamInflation(sdkCtx, am.bk, am.keeper, am.uk)
 	return nil
 }
 
@@ -190,6 +205,8 @@ type ModuleInputs struct {
 	BankKeeper    types.BankKeeper
 	MintKeeper    mintKeeper.Keeper
 	UpgradeKeeper util.UpgradeKeeper
+	StakingKeeper types.StakingKeeper
+	DistrKeeper   types.DistributionKeeper
 }
 
 type ModuleOutputs struct {
@@ -197,17 +214,27 @@ type ModuleOutputs struct {
 
 	TeamKeeper keeper.Keeper
 	Module     appmodule.AppModule
+	Hooks      stakingTypes.StakingHooksWrapper
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
+	// default to governance authority if not provided
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	if in.Config.Authority != "" {
+		authority = authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
+	}
+
 	k := keeper.NewKeeper(
 		in.Cdc,
 		in.StoreService,
 		in.Logger,
+		authority.String(),
 		in.AccountKeeper,
 		in.BankKeeper,
 		in.MintKeeper,
 		in.UpgradeKeeper,
+		in.StakingKeeper,
+		in.DistrKeeper,
 	)
 	m := NewAppModule(
 		in.Cdc,
@@ -217,5 +244,5 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.UpgradeKeeper,
 	)
 
-	return ModuleOutputs{TeamKeeper: k, Module: m}
+	return ModuleOutputs{TeamKeeper: k, Module: m, Hooks: stakingTypes.StakingHooksWrapper{StakingHooks: k.Hooks()}}
 }
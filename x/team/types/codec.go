@@ -13,6 +13,13 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgClawback{}, "kyve/team/MsgClawback", nil)
 	cdc.RegisterConcrete(&MsgClaimAccountRewards{}, "kyve/team/MsgClaimAccountRewards", nil)
 	cdc.RegisterConcrete(&MsgClaimAuthorityRewards{}, "kyve/team/MsgClaimAuthorityRewards", nil)
+	cdc.RegisterConcrete(&MsgProposeBeneficiary{}, "kyve/team/MsgProposeBeneficiary", nil)
+	cdc.RegisterConcrete(&MsgAcceptBeneficiary{}, "kyve/team/MsgAcceptBeneficiary", nil)
+	cdc.RegisterConcrete(&MsgPartialClawback{}, "kyve/team/MsgPartialClawback", nil)
+	cdc.RegisterConcrete(&MsgReverseClawback{}, "kyve/team/MsgReverseClawback", nil)
+	cdc.RegisterConcrete(&MsgUpdateTeamAuthorities{}, "kyve/team/MsgUpdateTeamAuthorities", nil)
+	cdc.RegisterConcrete(&MsgDelegateTeamTokens{}, "kyve/team/MsgDelegateTeamTokens", nil)
+	cdc.RegisterConcrete(&MsgUndelegateTeamTokens{}, "kyve/team/MsgUndelegateTeamTokens", nil)
 }
 
 func RegisterInterfaces(registry codecTypes.InterfaceRegistry) {
@@ -21,6 +28,13 @@ func RegisterInterfaces(registry codecTypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgClawback{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgClaimAccountRewards{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgClaimAuthorityRewards{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgProposeBeneficiary{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgAcceptBeneficiary{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgPartialClawback{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgReverseClawback{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUpdateTeamAuthorities{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgDelegateTeamTokens{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUndelegateTeamTokens{})
 }
 
 var Amino = codec.NewLegacyAmino()
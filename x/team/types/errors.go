@@ -5,8 +5,18 @@ import (
 )
 
 var (
-	ErrInvalidAuthority     = errors.Register(ModuleName, 1100, "invalid authority; expected %v, got %v")
-	ErrClaimAmountTooHigh   = errors.Register(ModuleName, 1101, "tried to claim %v tkyve, unlocked amount is only %v tkyve")
-	ErrAvailableFundsTooLow = errors.Register(ModuleName, 1102, "team has %v tkyve available, asking for %v tkyve")
-	ErrInvalidClawbackDate  = errors.Register(ModuleName, 1103, "The clawback can not be set earlier than the last claimed amount")
+	ErrInvalidAuthority       = errors.Register(ModuleName, 1100, "invalid authority; expected %v, got %v")
+	ErrClaimAmountTooHigh     = errors.Register(ModuleName, 1101, "tried to claim %v tkyve, unlocked amount is only %v tkyve")
+	ErrAvailableFundsTooLow   = errors.Register(ModuleName, 1102, "team has %v tkyve available, asking for %v tkyve")
+	ErrInvalidClawbackDate    = errors.Register(ModuleName, 1103, "The clawback can not be set earlier than the last claimed amount")
+	ErrInvalidSchedule        = errors.Register(ModuleName, 1104, "invalid vesting schedule: %v")
+	ErrNotBeneficiary         = errors.Register(ModuleName, 1105, "invalid signer; expected authority or beneficiary %v, got %v")
+	ErrNoPendingBeneficiary   = errors.Register(ModuleName, 1106, "team vesting account %v has no pending beneficiary")
+	ErrNotPendingBeneficiary  = errors.Register(ModuleName, 1107, "invalid signer; expected pending beneficiary %v, got %v")
+	ErrInvalidPartialClawback = errors.Register(ModuleName, 1108, "invalid partial clawback: %v")
+	ErrClawbackNotReversible  = errors.Register(ModuleName, 1109, "clawback can not be reversed: %v")
+	ErrInvalidTeamAuthorities = errors.Register(ModuleName, 1110, "invalid team authorities: %v")
+	ErrDelegationTooHigh      = errors.Register(ModuleName, 1111, "tried to delegate %v tkyve, account can only delegate %v tkyve")
+	ErrUndelegationTooHigh    = errors.Register(ModuleName, 1112, "tried to undelegate %v tkyve, account only delegated %v tkyve to %v")
+	ErrInsufficientLiquidity  = errors.Register(ModuleName, 1113, "team module has %v tkyve liquid, asking for %v tkyve")
 )
@@ -4,7 +4,9 @@ import "fmt"
 
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
-	return &GenesisState{}
+	return &GenesisState{
+		TeamAuthorities: DefaultTeamAuthorities(),
+	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any failure.
@@ -21,6 +23,69 @@ func (gs GenesisState) Validate() error {
 		if elem.Id >= gs.AccountCount {
 			return fmt.Errorf("account id higher than account count %v", elem)
 		}
+		if elem.GetPartialClawbackAmount() > elem.TotalAllocation {
+			return fmt.Errorf("partial clawbacks higher than total allocation %v", elem)
+		}
+	}
+
+	// Check for duplicated index in beneficiary change entries
+	beneficiaryChangeIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.BeneficiaryChangeList {
+		index := string(BeneficiaryChangeKeyPrefix(elem.AccountId, elem.Index))
+		if _, ok := beneficiaryChangeIndexMap[index]; ok {
+			return fmt.Errorf("duplicated beneficiary change %v", elem)
+		}
+		beneficiaryChangeIndexMap[index] = struct{}{}
+		if elem.AccountId >= gs.AccountCount {
+			return fmt.Errorf("beneficiary change account id higher than account count %v", elem)
+		}
+	}
+
+	// Check for duplicated index in team delegation entries
+	teamDelegationIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.TeamDelegationList {
+		index := string(TeamDelegationKeyPrefix(elem.AccountId, elem.Validator))
+		if _, ok := teamDelegationIndexMap[index]; ok {
+			return fmt.Errorf("duplicated team delegation %v", elem)
+		}
+		teamDelegationIndexMap[index] = struct{}{}
+		if _, ok := accountsIndexMap[string(TeamVestingAccountKeyPrefix(elem.AccountId))]; !ok {
+			return fmt.Errorf("team delegation of unknown account %v", elem)
+		}
+	}
+
+	// Check for duplicated index in team unbonding entries
+	teamUnbondingIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.TeamUnbondingList {
+		index := string(TeamUnbondingKeyPrefix(elem.AccountId, elem.Validator, elem.CreationHeight, elem.CompletionTime))
+		if _, ok := teamUnbondingIndexMap[index]; ok {
+			return fmt.Errorf("duplicated team unbonding %v", elem)
+		}
+		teamUnbondingIndexMap[index] = struct{}{}
+		if _, ok := accountsIndexMap[string(TeamVestingAccountKeyPrefix(elem.AccountId))]; !ok {
+			return fmt.Errorf("team unbonding of unknown account %v", elem)
+		}
+	}
+
+	// Check for duplicated index in team pending claim entries
+	teamPendingClaimIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.TeamPendingClaimList {
+		index := string(TeamPendingClaimKeyPrefix(elem.AccountId, elem.Recipient))
+		if _, ok := teamPendingClaimIndexMap[index]; ok {
+			return fmt.Errorf("duplicated team pending claim %v", elem)
+		}
+		teamPendingClaimIndexMap[index] = struct{}{}
+		if _, ok := accountsIndexMap[string(TeamVestingAccountKeyPrefix(elem.AccountId))]; !ok {
+			return fmt.Errorf("team pending claim of unknown account %v", elem)
+		}
+	}
+
+	if err := gs.TeamAuthorities.Validate(); err != nil {
+		return fmt.Errorf("invalid team authorities %v: %w", gs.TeamAuthorities, err)
 	}
 
 	if gs.Authority.RewardsClaimed > gs.Authority.TotalRewards {
@@ -31,6 +31,16 @@ type GenesisState struct {
 	AccountList []TeamVestingAccount `protobuf:"bytes,3,rep,name=account_list,json=accountList,proto3" json:"account_list"`
 	// account_count ...
 	AccountCount uint64 `protobuf:"varint,4,opt,name=account_count,json=accountCount,proto3" json:"account_count,omitempty"`
+	// beneficiary_change_list ...
+	BeneficiaryChangeList []BeneficiaryChange `protobuf:"bytes,5,rep,name=beneficiary_change_list,json=beneficiaryChangeList,proto3" json:"beneficiary_change_list"`
+	// team_authorities ...
+	TeamAuthorities TeamAuthorities `protobuf:"bytes,6,opt,name=team_authorities,json=teamAuthorities,proto3" json:"team_authorities"`
+	// team_delegation_list ...
+	TeamDelegationList []TeamDelegation `protobuf:"bytes,7,rep,name=team_delegation_list,json=teamDelegationList,proto3" json:"team_delegation_list"`
+	// team_unbonding_list ...
+	TeamUnbondingList []TeamUnbonding `protobuf:"bytes,8,rep,name=team_unbonding_list,json=teamUnbondingList,proto3" json:"team_unbonding_list"`
+	// team_pending_claim_list ...
+	TeamPendingClaimList []TeamPendingClaim `protobuf:"bytes,9,rep,name=team_pending_claim_list,json=teamPendingClaimList,proto3" json:"team_pending_claim_list"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -87,6 +97,41 @@ func (m *GenesisState) GetAccountCount() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetBeneficiaryChangeList() []BeneficiaryChange {
+	if m != nil {
+		return m.BeneficiaryChangeList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTeamAuthorities() TeamAuthorities {
+	if m != nil {
+		return m.TeamAuthorities
+	}
+	return TeamAuthorities{}
+}
+
+func (m *GenesisState) GetTeamDelegationList() []TeamDelegation {
+	if m != nil {
+		return m.TeamDelegationList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTeamUnbondingList() []TeamUnbonding {
+	if m != nil {
+		return m.TeamUnbondingList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTeamPendingClaimList() []TeamPendingClaim {
+	if m != nil {
+		return m.TeamPendingClaimList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "kyve.team.v1beta1.GenesisState")
 }
@@ -94,25 +139,35 @@ func init() {
 func init() { proto.RegisterFile("kyve/team/v1beta1/genesis.proto", fileDescriptor_6a6a0401797f9ed5) }
 
 var fileDescriptor_6a6a0401797f9ed5 = []byte{
-	// 279 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcf, 0xae, 0x2c, 0x4b,
-	0xd5, 0x2f, 0x49, 0x4d, 0xcc, 0xd5, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f,
-	0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x04, 0x29, 0xd0,
-	0x03, 0x29, 0xd0, 0x83, 0x2a, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xea, 0x83, 0x58,
-	0x10, 0x85, 0x52, 0x32, 0x98, 0x26, 0x81, 0x75, 0x81, 0x65, 0x95, 0x8e, 0x32, 0x72, 0xf1, 0xb8,
-	0x43, 0x0c, 0x0e, 0x2e, 0x49, 0x2c, 0x49, 0x15, 0x72, 0xe0, 0xe2, 0x4c, 0x2c, 0x2d, 0xc9, 0xc8,
-	0x2f, 0xca, 0x2c, 0xa9, 0x94, 0x60, 0x52, 0x60, 0xd4, 0xe0, 0x36, 0x92, 0xd1, 0xc3, 0xb0, 0x4b,
-	0xcf, 0x11, 0xa6, 0xc6, 0x89, 0xe5, 0xc4, 0x3d, 0x79, 0x86, 0x20, 0x84, 0x26, 0x21, 0x3f, 0x2e,
-	0x9e, 0xc4, 0xe4, 0xe4, 0xfc, 0xd2, 0xbc, 0x92, 0xf8, 0x9c, 0xcc, 0xe2, 0x12, 0x09, 0x66, 0x05,
-	0x66, 0x0d, 0x6e, 0x23, 0x55, 0x2c, 0x86, 0x84, 0xa4, 0x26, 0xe6, 0x86, 0xa5, 0x16, 0x97, 0x64,
-	0xe6, 0xa5, 0x3b, 0x42, 0x74, 0x40, 0x4d, 0xe3, 0x86, 0x1a, 0xe0, 0x93, 0x59, 0x5c, 0x22, 0xa4,
-	0xcc, 0xc5, 0x0b, 0x33, 0x0f, 0x4c, 0x4a, 0xb0, 0x28, 0x30, 0x6a, 0xb0, 0x04, 0xc1, 0x2c, 0x71,
-	0x06, 0xeb, 0x73, 0x3e, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18,
-	0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xcd, 0xf4,
-	0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0xef, 0xc8, 0x30, 0x57, 0xbf, 0xd4,
-	0x92, 0xf2, 0xfc, 0xa2, 0x6c, 0xfd, 0xe4, 0x8c, 0xc4, 0xcc, 0x3c, 0xfd, 0x0a, 0x48, 0xc8, 0x94,
-	0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0xc3, 0xc4, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0xee,
-	0x1b, 0x1e, 0x76, 0x7d, 0x01, 0x00, 0x00,
+	// 440 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xcd, 0x6e, 0xd3, 0x40,
+	0x14, 0x85, 0x63, 0x9a, 0x16, 0x3a, 0x29, 0x82, 0x9a, 0xa0, 0x46, 0x55, 0xe5, 0x86, 0x16, 0xa4,
+	0xb0, 0xb1, 0xd5, 0xf2, 0x02, 0x24, 0x01, 0xb1, 0x00, 0x55, 0xa8, 0x85, 0x48, 0x65, 0x13, 0xc6,
+	0xee, 0x65, 0x3c, 0x6a, 0x3c, 0x13, 0xd9, 0xd7, 0x05, 0xbf, 0x05, 0x12, 0x2f, 0xd5, 0x65, 0x97,
+	0xac, 0x10, 0x4a, 0x5e, 0x04, 0xf9, 0xce, 0x98, 0xf4, 0x27, 0xde, 0x8c, 0xac, 0x7b, 0xcf, 0xf9,
+	0xce, 0x19, 0x6b, 0xd8, 0xee, 0x79, 0x71, 0x01, 0x01, 0x02, 0x4f, 0x82, 0x8b, 0x83, 0x10, 0x90,
+	0x1f, 0x04, 0x02, 0x14, 0x64, 0x32, 0xf3, 0xa7, 0xa9, 0x46, 0xed, 0x6e, 0x96, 0x02, 0xbf, 0x14,
+	0xf8, 0x56, 0xb0, 0xdd, 0x16, 0x5a, 0x68, 0xda, 0x06, 0xe5, 0x97, 0x11, 0x6e, 0xef, 0xdc, 0x25,
+	0x91, 0x8b, 0xb6, 0x7b, 0xbf, 0x56, 0xd9, 0xc6, 0x3b, 0x03, 0x3e, 0x41, 0x8e, 0xe0, 0xbe, 0x66,
+	0xeb, 0x3c, 0xc7, 0x58, 0xa7, 0x12, 0x8b, 0xce, 0xbd, 0xae, 0xd3, 0x6b, 0x1d, 0xee, 0xf8, 0x77,
+	0xb2, 0xfc, 0x7e, 0xa5, 0x19, 0x34, 0x2f, 0xff, 0xec, 0x36, 0x8e, 0x17, 0x26, 0xf7, 0x88, 0x6d,
+	0xf0, 0x28, 0xd2, 0xb9, 0xc2, 0xf1, 0x44, 0x66, 0xd8, 0x59, 0xe9, 0xae, 0xf4, 0x5a, 0x87, 0x2f,
+	0x96, 0x40, 0x3e, 0x01, 0x4f, 0x46, 0x90, 0xa1, 0x54, 0xa2, 0x6f, 0x1c, 0x96, 0xd6, 0xb2, 0x80,
+	0x0f, 0x32, 0x43, 0x77, 0x9f, 0x3d, 0xac, 0x78, 0x74, 0x76, 0x9a, 0x5d, 0xa7, 0xd7, 0x3c, 0xae,
+	0x42, 0x86, 0xe5, 0xe1, 0x86, 0x6c, 0x2b, 0x04, 0x05, 0xdf, 0x64, 0x24, 0x79, 0x5a, 0x8c, 0xa3,
+	0x98, 0x2b, 0x01, 0x26, 0x7f, 0x95, 0xf2, 0x9f, 0x2f, 0xc9, 0x1f, 0x2c, 0x1c, 0x43, 0x32, 0xd8,
+	0xf8, 0xa7, 0xe1, 0xed, 0x05, 0x15, 0x39, 0x61, 0x8f, 0x4b, 0xfb, 0xb8, 0xba, 0xaa, 0x84, 0xac,
+	0xb3, 0x46, 0x7f, 0x68, 0xaf, 0xe6, 0x72, 0xfd, 0x85, 0xd2, 0xa2, 0x1f, 0xe1, 0xcd, 0xb1, 0x7b,
+	0xca, 0xda, 0x04, 0x3d, 0x83, 0x09, 0x08, 0x8e, 0x52, 0x2b, 0xd3, 0xfa, 0x3e, 0xb5, 0x7e, 0x56,
+	0x03, 0x7e, 0xf3, 0x5f, 0x6d, 0xb9, 0x2e, 0xde, 0x98, 0x52, 0xdf, 0x11, 0x7b, 0x42, 0xe8, 0x5c,
+	0x85, 0x5a, 0x9d, 0x49, 0x25, 0x0c, 0xf9, 0x01, 0x91, 0xbb, 0x35, 0xe4, 0xcf, 0x95, 0xd8, 0x82,
+	0x37, 0xf1, 0xfa, 0x90, 0xb8, 0x5f, 0xd9, 0x16, 0x71, 0xa7, 0x60, 0xa8, 0xd1, 0x84, 0xcb, 0xc4,
+	0xb0, 0xd7, 0x89, 0xbd, 0x5f, 0xc3, 0xfe, 0x68, 0x0c, 0xc3, 0x52, 0x6f, 0xf1, 0x6d, 0xbc, 0x35,
+	0x2f, 0x13, 0x06, 0xc3, 0xcb, 0x99, 0xe7, 0x5c, 0xcd, 0x3c, 0xe7, 0xef, 0xcc, 0x73, 0x7e, 0xce,
+	0xbd, 0xc6, 0xd5, 0xdc, 0x6b, 0xfc, 0x9e, 0x7b, 0x8d, 0x2f, 0x2f, 0x85, 0xc4, 0x38, 0x0f, 0xfd,
+	0x48, 0x27, 0xc1, 0xfb, 0xd3, 0xd1, 0xdb, 0x23, 0xc0, 0xef, 0x3a, 0x3d, 0x0f, 0xa2, 0x98, 0x4b,
+	0x15, 0xfc, 0x30, 0xef, 0x1c, 0x8b, 0x29, 0x64, 0xe1, 0x1a, 0xbd, 0xf0, 0x57, 0xff, 0x06, 0x00,
+	0x96, 0x84, 0x25, 0x72, 0x4b, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -135,6 +190,72 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TeamPendingClaimList) > 0 {
+		for iNdEx := len(m.TeamPendingClaimList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TeamPendingClaimList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.TeamUnbondingList) > 0 {
+		for iNdEx := len(m.TeamUnbondingList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TeamUnbondingList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.TeamDelegationList) > 0 {
+		for iNdEx := len(m.TeamDelegationList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TeamDelegationList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size, err := m.TeamAuthorities.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.BeneficiaryChangeList) > 0 {
+		for iNdEx := len(m.BeneficiaryChangeList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BeneficiaryChangeList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.AccountCount != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.AccountCount))
 		i--
@@ -195,6 +316,32 @@ func (m *GenesisState) Size() (n int) {
 	if m.AccountCount != 0 {
 		n += 1 + sovGenesis(uint64(m.AccountCount))
 	}
+	if len(m.BeneficiaryChangeList) > 0 {
+		for _, e := range m.BeneficiaryChangeList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.TeamAuthorities.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.TeamDelegationList) > 0 {
+		for _, e := range m.TeamDelegationList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TeamUnbondingList) > 0 {
+		for _, e := range m.TeamUnbondingList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TeamPendingClaimList) > 0 {
+		for _, e := range m.TeamPendingClaimList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -319,6 +466,175 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeneficiaryChangeList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BeneficiaryChangeList = append(m.BeneficiaryChangeList, BeneficiaryChange{})
+			if err := m.BeneficiaryChangeList[len(m.BeneficiaryChangeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TeamAuthorities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TeamAuthorities.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TeamDelegationList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TeamDelegationList = append(m.TeamDelegationList, TeamDelegation{})
+			if err := m.TeamDelegationList[len(m.TeamDelegationList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TeamUnbondingList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TeamUnbondingList = append(m.TeamUnbondingList, TeamUnbonding{})
+			if err := m.TeamUnbondingList[len(m.TeamUnbondingList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TeamPendingClaimList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TeamPendingClaimList = append(m.TeamPendingClaimList, TeamPendingClaim{})
+			if err := m.TeamPendingClaimList[len(m.TeamPendingClaimList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -13,6 +13,11 @@ const (
 
 	// RouterKey defines the module's message routing key
 	RouterKey = ModuleName
+
+	// TeamDelegationAccountName is the module account which delegates the team tokens. Other
+	// than the team module account it is not blocked, so it can receive staking rewards and
+	// the tokens of completed unbondings.
+	TeamDelegationAccountName = "team_delegation"
 )
 
 // Team module account address
@@ -27,10 +32,19 @@ const UNLOCK_DURATION uint64 = 2 * 365 * 24 * 3600 // 2 * 365 * 24 * 3600
 // CLIFF_DURATION 1 year
 const CLIFF_DURATION uint64 = 1 * 365 * 24 * 3600 // 1 * 365 * 24 * 3600
 
-// FOUNDATION_ADDRESS is initialised in types.go by the init function which uses linker flags
+// MONTH_DURATION 1/12 year, used for vesting schedules with monthly steps
+const MONTH_DURATION uint64 = 365 * 24 * 3600 / 12 // 365 * 24 * 3600 / 12
+
+// MAX_DELEGATION_PERCENTAGE is the maximum percentage of the locked $KYVE of a
+// team vesting account which can be delegated to validators
+const MAX_DELEGATION_PERCENTAGE uint64 = 50
+
+// FOUNDATION_ADDRESS is initialised in types.go by the init function which uses linker flags.
+// It is only used as the default foundation authority, the current one is stored in the module state
 var FOUNDATION_ADDRESS = ""
 
-// BCP_ADDRESS is initialised in types.go by the init function which uses linker flags
+// BCP_ADDRESS is initialised in types.go by the init function which uses linker flags.
+// It is only used as the default bcp authority, the current one is stored in the module state
 var BCP_ADDRESS = ""
 
 // TEAM_ALLOCATION is initialised in types.go by the init function which uses linker flags
@@ -44,8 +58,46 @@ var (
 	AuthorityKey               = []byte{0x01}
 	TeamVestingAccountKey      = []byte{0x02}
 	TeamVestingAccountCountKey = []byte{0x03}
+	BeneficiaryChangeKey       = []byte{0x04}
+	TeamAuthoritiesKey         = []byte{0x05}
+	TeamDelegationKey          = []byte{0x06}
+	TeamUnbondingKey           = []byte{0x07}
+	TeamPendingClaimKey        = []byte{0x08}
+	// TeamUnbondingQueueKey indexes the team unbondings by their completion time
+	TeamUnbondingQueueKey = []byte{0x09}
+	// TeamRebalanceQueueKey indexes the team vesting accounts by the time their
+	// delegations exceed the maximum delegation
+	TeamRebalanceQueueKey = []byte{0x0A}
+	// TeamRebalanceTimeKey stores the scheduled rebalance time of every account
+	TeamRebalanceTimeKey = []byte{0x0B}
+	// TeamRebalanceDurationKey stores the unbonding time the rebalances were scheduled with
+	TeamRebalanceDurationKey = []byte{0x0C}
 )
 
 func TeamVestingAccountKeyPrefix(id uint64) []byte {
 	return util.GetByteKey(id)
 }
+
+func BeneficiaryChangeKeyPrefix(accountId uint64, index uint64) []byte {
+	return util.GetByteKey(accountId, index)
+}
+
+func TeamDelegationKeyPrefix(accountId uint64, validator string) []byte {
+	return util.GetByteKey(accountId, validator)
+}
+
+func TeamUnbondingKeyPrefix(accountId uint64, validator string, creationHeight int64, completionTime int64) []byte {
+	return util.GetByteKey(accountId, validator, uint64(creationHeight), uint64(completionTime))
+}
+
+func TeamPendingClaimKeyPrefix(accountId uint64, recipient string) []byte {
+	return util.GetByteKey(accountId, recipient)
+}
+
+func TeamUnbondingQueueKeyPrefix(completionTime int64, accountId uint64, validator string, creationHeight int64) []byte {
+	return util.GetByteKey(uint64(completionTime), accountId, validator, uint64(creationHeight))
+}
+
+func TeamRebalanceQueueKeyPrefix(time uint64, accountId uint64) []byte {
+	return util.GetByteKey(time, accountId)
+}
@@ -39,5 +39,18 @@ func (msg *MsgCreateTeamVestingAccount) ValidateBasic() error {
 	if err != nil {
 		return errors.Wrapf(errorsTypes.ErrInvalidAddress, "invalid authority address (%s)", err)
 	}
+
+	if msg.Beneficiary != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.Beneficiary); err != nil {
+			return errors.Wrapf(errorsTypes.ErrInvalidAddress, "invalid beneficiary address (%s)", err)
+		}
+	}
+
+	if msg.Schedule != nil {
+		if err := msg.Schedule.Validate(); err != nil {
+			return errors.Wrapf(errorsTypes.ErrInvalidRequest, ErrInvalidSchedule.Error(), err)
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var (
+	_ legacytx.LegacyMsg = &MsgDelegateTeamTokens{}
+	_ sdk.Msg            = &MsgDelegateTeamTokens{}
+)
+
+func (msg *MsgDelegateTeamTokens) GetSignBytes() []byte {
+	bz := Amino.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgDelegateTeamTokens) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgDelegateTeamTokens) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgDelegateTeamTokens) Type() string {
+	return "kyve/team/MsgDelegateTeamTokens"
+}
+
+func (msg *MsgDelegateTeamTokens) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	if _, err := sdk.ValAddressFromBech32(msg.Validator); err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidAddress, "invalid validator address (%s)", err)
+	}
+
+	if msg.Amount == 0 {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, "amount must be greater than zero")
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var (
+	_ legacytx.LegacyMsg = &MsgPartialClawback{}
+	_ sdk.Msg            = &MsgPartialClawback{}
+)
+
+func (msg *MsgPartialClawback) GetSignBytes() []byte {
+	bz := Amino.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgPartialClawback) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgPartialClawback) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgPartialClawback) Type() string {
+	return "kyve/team/MsgPartialClawback"
+}
+
+func (msg *MsgPartialClawback) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	if err := msg.ValidateAmount(); err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, ErrInvalidPartialClawback.Error(), err)
+	}
+
+	return nil
+}
+
+// HasFraction returns true if the partial clawback is given as a fraction.
+func (msg *MsgPartialClawback) HasFraction() bool {
+	return !msg.Fraction.IsNil() && !msg.Fraction.IsZero()
+}
+
+// ValidateAmount checks that either an amount or a fraction between zero
+// and one is provided.
+func (msg *MsgPartialClawback) ValidateAmount() error {
+	if msg.Amount > 0 && msg.HasFraction() {
+		return fmt.Errorf("amount and fraction can not be set both")
+	}
+
+	if msg.Amount == 0 && !msg.HasFraction() {
+		return fmt.Errorf("either amount or fraction has to be set")
+	}
+
+	if msg.HasFraction() && (msg.Fraction.IsNegative() || msg.Fraction.GT(math.LegacyOneDec())) {
+		return fmt.Errorf("fraction %v has to be between zero and one", msg.Fraction)
+	}
+
+	return nil
+}
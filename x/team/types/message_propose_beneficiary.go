@@ -0,0 +1,47 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var (
+	_ legacytx.LegacyMsg = &MsgProposeBeneficiary{}
+	_ sdk.Msg            = &MsgProposeBeneficiary{}
+)
+
+func (msg *MsgProposeBeneficiary) GetSignBytes() []byte {
+	bz := Amino.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgProposeBeneficiary) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgProposeBeneficiary) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgProposeBeneficiary) Type() string {
+	return "kyve/team/MsgProposeBeneficiary"
+}
+
+func (msg *MsgProposeBeneficiary) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.NewBeneficiary); err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidAddress, "invalid beneficiary address (%s)", err)
+	}
+
+	return nil
+}
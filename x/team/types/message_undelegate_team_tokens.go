@@ -0,0 +1,51 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var (
+	_ legacytx.LegacyMsg = &MsgUndelegateTeamTokens{}
+	_ sdk.Msg            = &MsgUndelegateTeamTokens{}
+)
+
+func (msg *MsgUndelegateTeamTokens) GetSignBytes() []byte {
+	bz := Amino.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUndelegateTeamTokens) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUndelegateTeamTokens) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUndelegateTeamTokens) Type() string {
+	return "kyve/team/MsgUndelegateTeamTokens"
+}
+
+func (msg *MsgUndelegateTeamTokens) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	if _, err := sdk.ValAddressFromBech32(msg.Validator); err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidAddress, "invalid validator address (%s)", err)
+	}
+
+	if msg.Amount == 0 {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, "amount must be greater than zero")
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsTypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var (
+	_ legacytx.LegacyMsg = &MsgUpdateTeamAuthorities{}
+	_ sdk.Msg            = &MsgUpdateTeamAuthorities{}
+)
+
+func (msg *MsgUpdateTeamAuthorities) GetSignBytes() []byte {
+	bz := Amino.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateTeamAuthorities) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUpdateTeamAuthorities) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateTeamAuthorities) Type() string {
+	return "kyve/team/MsgUpdateTeamAuthorities"
+}
+
+func (msg *MsgUpdateTeamAuthorities) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	authorities := TeamAuthorities{
+		Foundation: msg.FoundationAuthority,
+		Bcp:        msg.BcpAuthority,
+	}
+	if err := authorities.Validate(); err != nil {
+		return errors.Wrapf(errorsTypes.ErrInvalidRequest, ErrInvalidTeamAuthorities.Error(), err)
+	}
+
+	return nil
+}
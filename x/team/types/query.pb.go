@@ -95,8 +95,12 @@ type QueryTeamInfoResponse struct {
 	// required_module_balance is the balance the team module should have. If this is less than the module balance
 	// something went wrong
 	RequiredModuleBalance uint64 `protobuf:"varint,12,opt,name=required_module_balance,json=requiredModuleBalance,proto3" json:"required_module_balance,omitempty"`
-	// team_module_balance is the team module balance in $KYVE
+	// team_module_balance is the team module balance in $KYVE, including the delegated and unbonding $KYVE
 	TeamModuleBalance uint64 `protobuf:"varint,13,opt,name=team_module_balance,json=teamModuleBalance,proto3" json:"team_module_balance,omitempty"`
+	// total_delegated is the amount in $KYVE the team module has delegated to validators
+	TotalDelegated uint64 `protobuf:"varint,14,opt,name=total_delegated,json=totalDelegated,proto3" json:"total_delegated,omitempty"`
+	// total_unbonding is the amount in $KYVE which is currently unbonding to the team module
+	TotalUnbonding uint64 `protobuf:"varint,15,opt,name=total_unbonding,json=totalUnbonding,proto3" json:"total_unbonding,omitempty"`
 }
 
 func (m *QueryTeamInfoResponse) Reset()         { *m = QueryTeamInfoResponse{} }
@@ -223,6 +227,20 @@ func (m *QueryTeamInfoResponse) GetTeamModuleBalance() uint64 {
 	return 0
 }
 
+func (m *QueryTeamInfoResponse) GetTotalDelegated() uint64 {
+	if m != nil {
+		return m.TotalDelegated
+	}
+	return 0
+}
+
+func (m *QueryTeamInfoResponse) GetTotalUnbonding() uint64 {
+	if m != nil {
+		return m.TotalUnbonding
+	}
+	return 0
+}
+
 // QueryAccountsRequest is request type for the Query/TeamVestingAccounts RPC method.
 type QueryTeamVestingAccountsRequest struct {
 }
@@ -763,6 +781,10 @@ type QueryVestingPlan struct {
 	ClawbackAmount uint64 `protobuf:"varint,7,opt,name=clawback_amount,json=clawbackAmount,proto3" json:"clawback_amount,omitempty"`
 	// maximum_vesting_amount ...
 	MaximumVestingAmount uint64 `protobuf:"varint,8,opt,name=maximum_vesting_amount,json=maximumVestingAmount,proto3" json:"maximum_vesting_amount,omitempty"`
+	// partial_clawback_amount is the part of the clawback amount caused by partial clawbacks
+	PartialClawbackAmount uint64 `protobuf:"varint,9,opt,name=partial_clawback_amount,json=partialClawbackAmount,proto3" json:"partial_clawback_amount,omitempty"`
+	// partial_clawbacks ...
+	PartialClawbacks []PartialClawback `protobuf:"bytes,10,rep,name=partial_clawbacks,json=partialClawbacks,proto3" json:"partial_clawbacks"`
 }
 
 func (m *QueryVestingPlan) Reset()         { *m = QueryVestingPlan{} }
@@ -854,6 +876,276 @@ func (m *QueryVestingPlan) GetMaximumVestingAmount() uint64 {
 	return 0
 }
 
+func (m *QueryVestingPlan) GetPartialClawbackAmount() uint64 {
+	if m != nil {
+		return m.PartialClawbackAmount
+	}
+	return 0
+}
+
+func (m *QueryVestingPlan) GetPartialClawbacks() []PartialClawback {
+	if m != nil {
+		return m.PartialClawbacks
+	}
+	return nil
+}
+
+// QueryTeamBeneficiaryHistoryRequest is request type for the Query/TeamBeneficiaryHistory RPC method.
+type QueryTeamBeneficiaryHistoryRequest struct {
+	// id is a unique identify for each vesting account, tied to a single team member.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryTeamBeneficiaryHistoryRequest) Reset()         { *m = QueryTeamBeneficiaryHistoryRequest{} }
+func (m *QueryTeamBeneficiaryHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTeamBeneficiaryHistoryRequest) ProtoMessage()    {}
+func (*QueryTeamBeneficiaryHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6dd564523865e528, []int{12}
+}
+func (m *QueryTeamBeneficiaryHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTeamBeneficiaryHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTeamBeneficiaryHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTeamBeneficiaryHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTeamBeneficiaryHistoryRequest.Merge(m, src)
+}
+func (m *QueryTeamBeneficiaryHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTeamBeneficiaryHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTeamBeneficiaryHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTeamBeneficiaryHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryTeamBeneficiaryHistoryRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QueryTeamBeneficiaryHistoryResponse is the response type for the Query/TeamBeneficiaryHistory RPC method.
+type QueryTeamBeneficiaryHistoryResponse struct {
+	// beneficiary is the current beneficiary of the account
+	Beneficiary string `protobuf:"bytes,1,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	// pending_beneficiary is the proposed beneficiary which has not accepted yet
+	PendingBeneficiary string `protobuf:"bytes,2,opt,name=pending_beneficiary,json=pendingBeneficiary,proto3" json:"pending_beneficiary,omitempty"`
+	// changes are all beneficiary changes of the account in chronological order
+	Changes []BeneficiaryChange `protobuf:"bytes,3,rep,name=changes,proto3" json:"changes"`
+}
+
+func (m *QueryTeamBeneficiaryHistoryResponse) Reset()         { *m = QueryTeamBeneficiaryHistoryResponse{} }
+func (m *QueryTeamBeneficiaryHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTeamBeneficiaryHistoryResponse) ProtoMessage()    {}
+func (*QueryTeamBeneficiaryHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6dd564523865e528, []int{13}
+}
+func (m *QueryTeamBeneficiaryHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTeamBeneficiaryHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTeamBeneficiaryHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTeamBeneficiaryHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTeamBeneficiaryHistoryResponse.Merge(m, src)
+}
+func (m *QueryTeamBeneficiaryHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTeamBeneficiaryHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTeamBeneficiaryHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTeamBeneficiaryHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryTeamBeneficiaryHistoryResponse) GetBeneficiary() string {
+	if m != nil {
+		return m.Beneficiary
+	}
+	return ""
+}
+
+func (m *QueryTeamBeneficiaryHistoryResponse) GetPendingBeneficiary() string {
+	if m != nil {
+		return m.PendingBeneficiary
+	}
+	return ""
+}
+
+func (m *QueryTeamBeneficiaryHistoryResponse) GetChanges() []BeneficiaryChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+// QueryTeamDelegationsRequest is request type for the Query/TeamDelegations RPC method.
+type QueryTeamDelegationsRequest struct {
+	// id is a unique identify for each vesting account, tied to a single team member.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryTeamDelegationsRequest) Reset()         { *m = QueryTeamDelegationsRequest{} }
+func (m *QueryTeamDelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTeamDelegationsRequest) ProtoMessage()    {}
+func (*QueryTeamDelegationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6dd564523865e528, []int{14}
+}
+func (m *QueryTeamDelegationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTeamDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTeamDelegationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTeamDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTeamDelegationsRequest.Merge(m, src)
+}
+func (m *QueryTeamDelegationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTeamDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTeamDelegationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTeamDelegationsRequest proto.InternalMessageInfo
+
+func (m *QueryTeamDelegationsRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QueryTeamDelegationsResponse is the response type for the Query/TeamDelegations RPC method.
+type QueryTeamDelegationsResponse struct {
+	// delegations are the bonded delegations of the account
+	Delegations []TeamDelegation `protobuf:"bytes,1,rep,name=delegations,proto3" json:"delegations"`
+	// unbondings are the unbonding delegations of the account
+	Unbondings []TeamUnbonding `protobuf:"bytes,2,rep,name=unbondings,proto3" json:"unbondings"`
+	// total_delegated is the amount in $KYVE of the account which is bonded
+	TotalDelegated uint64 `protobuf:"varint,3,opt,name=total_delegated,json=totalDelegated,proto3" json:"total_delegated,omitempty"`
+	// total_unbonding is the amount in $KYVE of the account which is unbonding
+	TotalUnbonding uint64 `protobuf:"varint,4,opt,name=total_unbonding,json=totalUnbonding,proto3" json:"total_unbonding,omitempty"`
+	// max_delegation is the amount in $KYVE the account can have delegated at most right now
+	MaxDelegation uint64 `protobuf:"varint,5,opt,name=max_delegation,json=maxDelegation,proto3" json:"max_delegation,omitempty"`
+	// delegation_losses is the amount in $KYVE the account lost through slashing
+	DelegationLosses uint64 `protobuf:"varint,6,opt,name=delegation_losses,json=delegationLosses,proto3" json:"delegation_losses,omitempty"`
+	// pending_claims are the claims of the account which get paid once the unbonding completed
+	PendingClaims []TeamPendingClaim `protobuf:"bytes,7,rep,name=pending_claims,json=pendingClaims,proto3" json:"pending_claims"`
+}
+
+func (m *QueryTeamDelegationsResponse) Reset()         { *m = QueryTeamDelegationsResponse{} }
+func (m *QueryTeamDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTeamDelegationsResponse) ProtoMessage()    {}
+func (*QueryTeamDelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6dd564523865e528, []int{15}
+}
+func (m *QueryTeamDelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTeamDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTeamDelegationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTeamDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTeamDelegationsResponse.Merge(m, src)
+}
+func (m *QueryTeamDelegationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTeamDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTeamDelegationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTeamDelegationsResponse proto.InternalMessageInfo
+
+func (m *QueryTeamDelegationsResponse) GetDelegations() []TeamDelegation {
+	if m != nil {
+		return m.Delegations
+	}
+	return nil
+}
+
+func (m *QueryTeamDelegationsResponse) GetUnbondings() []TeamUnbonding {
+	if m != nil {
+		return m.Unbondings
+	}
+	return nil
+}
+
+func (m *QueryTeamDelegationsResponse) GetTotalDelegated() uint64 {
+	if m != nil {
+		return m.TotalDelegated
+	}
+	return 0
+}
+
+func (m *QueryTeamDelegationsResponse) GetTotalUnbonding() uint64 {
+	if m != nil {
+		return m.TotalUnbonding
+	}
+	return 0
+}
+
+func (m *QueryTeamDelegationsResponse) GetMaxDelegation() uint64 {
+	if m != nil {
+		return m.MaxDelegation
+	}
+	return 0
+}
+
+func (m *QueryTeamDelegationsResponse) GetDelegationLosses() uint64 {
+	if m != nil {
+		return m.DelegationLosses
+	}
+	return 0
+}
+
+func (m *QueryTeamDelegationsResponse) GetPendingClaims() []TeamPendingClaim {
+	if m != nil {
+		return m.PendingClaims
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryTeamInfoRequest)(nil), "kyve.team.v1beta1.QueryTeamInfoRequest")
 	proto.RegisterType((*QueryTeamInfoResponse)(nil), "kyve.team.v1beta1.QueryTeamInfoResponse")
@@ -867,85 +1159,111 @@ func init() {
 	proto.RegisterType((*QueryTeamVestingStatusByTimeResponse)(nil), "kyve.team.v1beta1.QueryTeamVestingStatusByTimeResponse")
 	proto.RegisterType((*QueryVestingStatus)(nil), "kyve.team.v1beta1.QueryVestingStatus")
 	proto.RegisterType((*QueryVestingPlan)(nil), "kyve.team.v1beta1.QueryVestingPlan")
+	proto.RegisterType((*QueryTeamBeneficiaryHistoryRequest)(nil), "kyve.team.v1beta1.QueryTeamBeneficiaryHistoryRequest")
+	proto.RegisterType((*QueryTeamBeneficiaryHistoryResponse)(nil), "kyve.team.v1beta1.QueryTeamBeneficiaryHistoryResponse")
+	proto.RegisterType((*QueryTeamDelegationsRequest)(nil), "kyve.team.v1beta1.QueryTeamDelegationsRequest")
+	proto.RegisterType((*QueryTeamDelegationsResponse)(nil), "kyve.team.v1beta1.QueryTeamDelegationsResponse")
 }
 
 func init() { proto.RegisterFile("kyve/team/v1beta1/query.proto", fileDescriptor_6dd564523865e528) }
 
 var fileDescriptor_6dd564523865e528 = []byte{
-	// 1164 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xcf, 0xba, 0xce, 0xaf, 0x97, 0xa4, 0xdf, 0x64, 0xe2, 0x26, 0xae, 0xbf, 0xad, 0x9b, 0x6e,
-	0x5a, 0x35, 0xd0, 0xe2, 0x4d, 0x9c, 0x28, 0x45, 0x11, 0x20, 0x25, 0xa5, 0xa0, 0x0a, 0x81, 0xc0,
-	0xb4, 0x91, 0xe0, 0xb2, 0x1a, 0xef, 0x4e, 0x9c, 0x91, 0xf7, 0x87, 0xb3, 0x3b, 0x9b, 0xd4, 0xaa,
-	0x7a, 0x81, 0x7f, 0x00, 0x89, 0xbf, 0x03, 0x09, 0xce, 0x9c, 0xe0, 0xd4, 0x13, 0xaa, 0xc4, 0x05,
-	0x2e, 0x08, 0x25, 0xfc, 0x0b, 0x1c, 0xb8, 0xa1, 0x7d, 0x33, 0xbb, 0xf6, 0x7a, 0xed, 0x36, 0xb9,
-	0x71, 0x5b, 0xef, 0xe7, 0x7d, 0xe6, 0xf3, 0x79, 0x6f, 0x66, 0xde, 0x5b, 0xc3, 0xf5, 0x76, 0xf7,
-	0x98, 0x19, 0x82, 0x51, 0xd7, 0x38, 0xde, 0x68, 0x32, 0x41, 0x37, 0x8c, 0xa3, 0x88, 0x05, 0xdd,
-	0x5a, 0x27, 0xf0, 0x85, 0x4f, 0x16, 0x62, 0xb8, 0x16, 0xc3, 0x35, 0x05, 0x57, 0x4a, 0x2d, 0xbf,
-	0xe5, 0x23, 0x6a, 0xc4, 0x4f, 0x32, 0xb0, 0x72, 0xad, 0xe5, 0xfb, 0x2d, 0x87, 0x19, 0xb4, 0xc3,
-	0x0d, 0xea, 0x79, 0xbe, 0xa0, 0x82, 0xfb, 0x5e, 0x98, 0xa0, 0x79, 0x15, 0x5c, 0x13, 0x51, 0x7d,
-	0x09, 0x4a, 0x9f, 0xc5, 0x9a, 0x8f, 0x19, 0x75, 0x1f, 0x79, 0x07, 0x7e, 0x83, 0x1d, 0x45, 0x2c,
-	0x14, 0xfa, 0xef, 0xe3, 0x70, 0x65, 0x00, 0x08, 0x3b, 0xbe, 0x17, 0x32, 0xb2, 0x01, 0xa5, 0x03,
-	0x3f, 0xf2, 0x6c, 0x14, 0x31, 0x69, 0x24, 0x0e, 0xfd, 0x80, 0x8b, 0x6e, 0x59, 0x5b, 0xd1, 0xd6,
-	0xa6, 0x1b, 0x8b, 0x3d, 0x6c, 0x37, 0x81, 0xc8, 0x2a, 0xcc, 0x35, 0xad, 0x4e, 0x5f, 0x6c, 0x01,
-	0x63, 0x67, 0x9b, 0x56, 0xa7, 0x17, 0x54, 0x87, 0x2b, 0xc2, 0x17, 0xd4, 0x31, 0x63, 0x77, 0x26,
-	0x75, 0x1c, 0xdf, 0xc2, 0x65, 0xca, 0x97, 0x56, 0xb4, 0xb5, 0x62, 0x63, 0x11, 0xc1, 0xd8, 0xcd,
-	0x6e, 0x0a, 0x91, 0x2d, 0x58, 0xe2, 0x61, 0x18, 0x31, 0x3b, 0x47, 0x2a, 0x22, 0xa9, 0x24, 0xd1,
-	0x01, 0xd6, 0x0e, 0x5c, 0xa5, 0xc7, 0x94, 0x3b, 0xb4, 0xe9, 0xb0, 0x1c, 0x71, 0x1c, 0x89, 0xcb,
-	0x69, 0xc0, 0x00, 0x77, 0x1b, 0x96, 0xa5, 0xcb, 0x34, 0x19, 0x33, 0x60, 0x27, 0x34, 0xb0, 0xc3,
-	0xf2, 0x04, 0x32, 0x65, 0x12, 0x69, 0x5a, 0x0d, 0x09, 0xc6, 0x9a, 0x96, 0x43, 0xb9, 0xcb, 0xec,
-	0x21, 0xcc, 0x49, 0xa9, 0xa9, 0x02, 0x72, 0xdc, 0xf7, 0xe0, 0xff, 0x3d, 0xbf, 0x79, 0xf6, 0x14,
-	0xb2, 0x7b, 0x29, 0xe5, 0xf8, 0x69, 0x65, 0xa9, 0x65, 0xf9, 0x91, 0x27, 0x52, 0xe6, 0x74, 0x5f,
-	0x65, 0x77, 0x25, 0x96, 0x70, 0xb6, 0x61, 0x39, 0xf5, 0x3b, 0xc0, 0x02, 0x99, 0x67, 0xe2, 0x36,
-	0xcb, 0xcb, 0xd4, 0x76, 0x90, 0x39, 0x33, 0x50, 0xdb, 0xbc, 0x66, 0xc0, 0x8e, 0x22, 0x1e, 0x30,
-	0xdb, 0x74, 0x7d, 0x3b, 0x72, 0x98, 0xd9, 0xa4, 0x0e, 0xf5, 0x2c, 0x56, 0x9e, 0x95, 0x9a, 0x09,
-	0xfc, 0x31, 0xa2, 0x7b, 0x12, 0x24, 0x35, 0x58, 0xc4, 0x5d, 0x1c, 0xe0, 0xcc, 0x21, 0x67, 0x21,
-	0x86, 0x32, 0xf1, 0xfa, 0x4d, 0xb8, 0x91, 0x1e, 0xed, 0x7d, 0x16, 0x0a, 0xee, 0xb5, 0x94, 0x93,
-	0x30, 0x39, 0xfe, 0x6d, 0x58, 0x19, 0x1d, 0xa2, 0x2e, 0xc2, 0x87, 0x30, 0xa5, 0x12, 0x0c, 0xcb,
-	0xda, 0xca, 0xa5, 0xb5, 0x99, 0xfa, 0xed, 0x5a, 0xee, 0xca, 0xd6, 0xf2, 0x2b, 0xec, 0x15, 0x5f,
-	0xfc, 0x71, 0x63, 0xac, 0x91, 0x92, 0xf5, 0x75, 0xa8, 0x8e, 0x10, 0x53, 0x76, 0xc8, 0x65, 0x28,
-	0x70, 0x1b, 0x6f, 0x58, 0xb1, 0x51, 0xe0, 0xb6, 0x7e, 0x38, 0x32, 0x83, 0xd4, 0xdd, 0x43, 0x98,
-	0x54, 0x02, 0xc8, 0xbb, 0xa0, 0xb9, 0x84, 0xab, 0x1b, 0x70, 0x7d, 0x50, 0xe9, 0x73, 0x41, 0x45,
-	0x14, 0x8e, 0xb2, 0xf6, 0xa3, 0x96, 0xcf, 0x26, 0x61, 0x28, 0x6b, 0x37, 0x61, 0x36, 0x90, 0x6c,
-	0xd3, 0xa6, 0x82, 0xa9, 0xce, 0x31, 0xa3, 0xde, 0xbd, 0x4f, 0x05, 0x23, 0xf7, 0xa1, 0xd8, 0x71,
-	0xa8, 0x87, 0x8d, 0x62, 0xa6, 0xbe, 0x3a, 0xc4, 0x3a, 0x6a, 0xa8, 0xf5, 0x3f, 0x75, 0xa8, 0xd7,
-	0x40, 0x02, 0x79, 0x17, 0x26, 0x42, 0x54, 0xc3, 0xb6, 0x31, 0x3c, 0xeb, 0x7e, 0xaa, 0xb2, 0xa6,
-	0x48, 0xfa, 0x23, 0x58, 0x1d, 0x6e, 0x7e, 0xaf, 0xfb, 0x98, 0xbb, 0x6c, 0x44, 0xd2, 0x84, 0x40,
-	0x51, 0x70, 0x97, 0xa1, 0xdd, 0x62, 0x03, 0x9f, 0xf5, 0x9f, 0x34, 0xb8, 0xf5, 0xea, 0xb5, 0xfe,
-	0xfb, 0xe5, 0xf8, 0xf9, 0x12, 0x90, 0x3c, 0x8c, 0x17, 0x0e, 0x1b, 0xca, 0x31, 0x0b, 0x45, 0xdc,
-	0x21, 0xdc, 0xf4, 0x9c, 0xc5, 0x17, 0x2e, 0x86, 0xf6, 0x11, 0xd9, 0x45, 0xa0, 0xd7, 0x80, 0x22,
-	0xcf, 0xf1, 0xad, 0x76, 0x8f, 0x51, 0xe8, 0x6b, 0x40, 0x4f, 0x14, 0xa6, 0x38, 0x6f, 0x43, 0xd9,
-	0x8a, 0x82, 0x80, 0x79, 0xc2, 0xc4, 0x4e, 0x23, 0x1b, 0x8a, 0xa4, 0xc9, 0x89, 0xb0, 0xa4, 0xf0,
-	0x07, 0x09, 0xac, 0x98, 0xeb, 0x50, 0x52, 0x2a, 0x59, 0x7b, 0x72, 0x24, 0x10, 0x89, 0x65, 0xfc,
-	0xed, 0xc0, 0xd5, 0x80, 0xb9, 0x94, 0x7b, 0xdc, 0x6b, 0x99, 0x91, 0x97, 0xa5, 0xa9, 0x81, 0x90,
-	0x06, 0x3c, 0x51, 0xb8, 0xe2, 0xde, 0x86, 0xcb, 0x69, 0xa3, 0x94, 0x04, 0x39, 0x07, 0xe6, 0x92,
-	0xfe, 0x28, 0xc3, 0x56, 0x61, 0x4e, 0x96, 0x20, 0xdb, 0xf3, 0x67, 0xf1, 0x65, 0xd2, 0x00, 0xef,
-	0xc0, 0xff, 0x92, 0xb5, 0xb2, 0xcd, 0x3d, 0x91, 0x48, 0x02, 0xef, 0xc2, 0x42, 0xaf, 0xcb, 0x66,
-	0xbb, 0xf9, 0x7c, 0x0a, 0xa8, 0x60, 0xfd, 0x9f, 0x02, 0xcc, 0x0f, 0x1e, 0x0f, 0xa2, 0xc3, 0xac,
-	0xe5, 0xbb, 0x2e, 0xf3, 0x2c, 0xe6, 0x32, 0xb5, 0x77, 0xd3, 0x8d, 0xcc, 0x3b, 0xb9, 0xcd, 0x6d,
-	0xe6, 0x61, 0x1d, 0xe3, 0xd2, 0x84, 0x82, 0x06, 0x42, 0x0d, 0xef, 0x05, 0x84, 0x7a, 0xe7, 0x22,
-	0x10, 0xf1, 0x34, 0xce, 0xc6, 0x1f, 0x70, 0x8f, 0x87, 0x87, 0xcc, 0xc6, 0x0d, 0x9b, 0x6e, 0x94,
-	0xfa, 0x29, 0x1f, 0x28, 0x8c, 0xdc, 0x03, 0x22, 0x59, 0xf2, 0x70, 0x28, 0x91, 0x22, 0x32, 0xe6,
-	0x11, 0x91, 0x27, 0x43, 0x6a, 0xe0, 0x51, 0xea, 0x8b, 0x4e, 0x25, 0xc6, 0xe5, 0xe7, 0x47, 0x1f,
-	0x21, 0x55, 0xa8, 0xc0, 0x94, 0xe5, 0xd0, 0x93, 0x26, 0xb5, 0xda, 0x6a, 0x73, 0xd2, 0xdf, 0xaa,
-	0xe4, 0xf8, 0x9c, 0xec, 0xdf, 0x64, 0x5a, 0x72, 0x7c, 0xad, 0x36, 0x70, 0x0b, 0x96, 0x5c, 0xfa,
-	0x94, 0xbb, 0x91, 0x9b, 0xa6, 0xa7, 0xe2, 0xe5, 0x16, 0x95, 0x14, 0x9a, 0xb4, 0x53, 0xc4, 0xea,
-	0x7f, 0x4f, 0xc0, 0x38, 0xd6, 0x9e, 0x7c, 0xad, 0xc1, 0x54, 0xf2, 0x2d, 0x45, 0xee, 0x8c, 0xba,
-	0x86, 0x03, 0x9f, 0x61, 0x95, 0xb5, 0xd7, 0x07, 0xca, 0x2e, 0xa2, 0xdf, 0xfa, 0xea, 0xd7, 0xbf,
-	0xbe, 0x2d, 0x54, 0xc9, 0x35, 0x63, 0xf8, 0xf7, 0x9e, 0xc9, 0x63, 0xe1, 0xef, 0x35, 0x58, 0x1c,
-	0x32, 0xd3, 0x48, 0xfd, 0x55, 0x3a, 0xc3, 0x67, 0x64, 0x65, 0xf3, 0x42, 0x1c, 0x65, 0x73, 0x1d,
-	0x6d, 0xbe, 0x49, 0xd6, 0x46, 0xd9, 0x4c, 0x8b, 0x9b, 0x58, 0xfb, 0x41, 0x03, 0x92, 0x5f, 0x91,
-	0x6c, 0x9c, 0x5f, 0x3d, 0x31, 0x5c, 0xbf, 0x08, 0x45, 0xf9, 0xdd, 0x42, 0xbf, 0x35, 0x72, 0xef,
-	0x9c, 0x7e, 0x8d, 0x67, 0xdc, 0x7e, 0x4e, 0xbe, 0xd3, 0x60, 0x21, 0xd7, 0xf6, 0xc9, 0xfa, 0x39,
-	0xf4, 0x33, 0xc3, 0xb5, 0xb2, 0x71, 0x01, 0x86, 0x32, 0xbc, 0x89, 0x86, 0xdf, 0x22, 0x77, 0x5f,
-	0x67, 0x58, 0xb6, 0x78, 0xe9, 0xf7, 0x17, 0x0d, 0x96, 0x47, 0x8c, 0x29, 0xb2, 0x7d, 0x6e, 0x0f,
-	0x99, 0x19, 0x59, 0xb9, 0x7f, 0x61, 0x9e, 0xca, 0x60, 0x0f, 0x33, 0x78, 0x87, 0xec, 0x9c, 0x2f,
-	0x03, 0xb3, 0xd9, 0x35, 0xe3, 0x81, 0x8b, 0x99, 0x18, 0xcf, 0xe2, 0xc7, 0xe7, 0x7b, 0x0f, 0x5e,
-	0x9c, 0x56, 0xb5, 0x97, 0xa7, 0x55, 0xed, 0xcf, 0xd3, 0xaa, 0xf6, 0xcd, 0x59, 0x75, 0xec, 0xe5,
-	0x59, 0x75, 0xec, 0xb7, 0xb3, 0xea, 0xd8, 0x97, 0x6f, 0xb4, 0xb8, 0x38, 0x8c, 0x9a, 0x35, 0xcb,
-	0x77, 0x8d, 0x8f, 0xbe, 0xd8, 0x7f, 0xf8, 0x09, 0x13, 0x27, 0x7e, 0xd0, 0x36, 0xac, 0x43, 0xca,
-	0x3d, 0xe3, 0xa9, 0x94, 0x13, 0xdd, 0x0e, 0x0b, 0x9b, 0x13, 0xf8, 0x17, 0x69, 0xf3, 0xdf, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xc6, 0xf5, 0xa4, 0x1a, 0xa8, 0x0d, 0x00, 0x00,
+	// 1506 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4b, 0x6f, 0x14, 0xc7,
+	0x16, 0x76, 0xdb, 0x83, 0x1f, 0xc7, 0xef, 0xb2, 0xb1, 0x87, 0x01, 0x06, 0xd3, 0x06, 0xe1, 0x7b,
+	0x81, 0x69, 0x7b, 0xf0, 0x35, 0x08, 0xdd, 0x7b, 0x25, 0xdb, 0x40, 0x82, 0xf2, 0x90, 0x33, 0xc1,
+	0x48, 0xc9, 0xa6, 0x55, 0xd3, 0x5d, 0x9e, 0x29, 0xb9, 0x1f, 0x43, 0x77, 0xb5, 0xf1, 0x08, 0xb1,
+	0x49, 0x36, 0x59, 0x46, 0xca, 0x5f, 0xc8, 0x26, 0x8a, 0x22, 0x25, 0xeb, 0x48, 0x91, 0xc8, 0x8a,
+	0x55, 0x84, 0x94, 0x4d, 0x56, 0x11, 0x82, 0xfc, 0x90, 0xa8, 0xab, 0xaa, 0x9f, 0x33, 0x6d, 0xc6,
+	0xbb, 0xec, 0xda, 0xf5, 0x9d, 0xef, 0x9c, 0xef, 0xd4, 0xe3, 0x9c, 0x33, 0x86, 0x8b, 0x87, 0xdd,
+	0x23, 0xa2, 0x31, 0x82, 0x6d, 0xed, 0x68, 0xa3, 0x49, 0x18, 0xde, 0xd0, 0x9e, 0x04, 0xc4, 0xeb,
+	0xd6, 0x3a, 0x9e, 0xcb, 0x5c, 0x34, 0x1f, 0xc2, 0xb5, 0x10, 0xae, 0x49, 0xb8, 0xb2, 0xd8, 0x72,
+	0x5b, 0x2e, 0x47, 0xb5, 0xf0, 0x4b, 0x18, 0x56, 0x2e, 0xb4, 0x5c, 0xb7, 0x65, 0x11, 0x0d, 0x77,
+	0xa8, 0x86, 0x1d, 0xc7, 0x65, 0x98, 0x51, 0xd7, 0xf1, 0x23, 0xb4, 0x37, 0x0a, 0xf7, 0xc9, 0x51,
+	0x75, 0x09, 0x16, 0x3f, 0x09, 0x63, 0x3e, 0x22, 0xd8, 0x7e, 0xe8, 0x1c, 0xb8, 0x0d, 0xf2, 0x24,
+	0x20, 0x3e, 0x53, 0xbf, 0x1f, 0x85, 0xb3, 0x39, 0xc0, 0xef, 0xb8, 0x8e, 0x4f, 0xd0, 0x06, 0x2c,
+	0x1e, 0xb8, 0x81, 0x63, 0xf2, 0x20, 0x3a, 0x0e, 0x58, 0xdb, 0xf5, 0x28, 0xeb, 0x96, 0x95, 0x15,
+	0x65, 0x6d, 0xa2, 0xb1, 0x90, 0x60, 0xdb, 0x11, 0x84, 0x56, 0x61, 0xba, 0x69, 0x74, 0x52, 0xb6,
+	0xc3, 0xdc, 0x76, 0xaa, 0x69, 0x74, 0x12, 0xa3, 0x3a, 0x9c, 0x65, 0x2e, 0xc3, 0x96, 0x1e, 0xaa,
+	0xd3, 0xb1, 0x65, 0xb9, 0x06, 0x77, 0x53, 0x1e, 0x59, 0x51, 0xd6, 0x4a, 0x8d, 0x05, 0x0e, 0x86,
+	0x6a, 0xb6, 0x63, 0x08, 0x6d, 0xc2, 0x12, 0xf5, 0xfd, 0x80, 0x98, 0x3d, 0xa4, 0x12, 0x27, 0x2d,
+	0x0a, 0x34, 0xc7, 0xba, 0x0b, 0xe7, 0xf0, 0x11, 0xa6, 0x16, 0x6e, 0x5a, 0xa4, 0x87, 0x78, 0x86,
+	0x13, 0x97, 0x63, 0x83, 0x1c, 0x77, 0x0b, 0x96, 0x85, 0xca, 0x38, 0x19, 0xdd, 0x23, 0x4f, 0xb1,
+	0x67, 0xfa, 0xe5, 0x51, 0xce, 0x14, 0x49, 0xc4, 0x69, 0x35, 0x04, 0x18, 0xc6, 0x34, 0x2c, 0x4c,
+	0x6d, 0x62, 0xf6, 0x61, 0x8e, 0x89, 0x98, 0xd2, 0xa0, 0x87, 0xfb, 0x7f, 0x38, 0x9f, 0xe8, 0xed,
+	0x65, 0x8f, 0x73, 0x76, 0x92, 0x52, 0x0f, 0x3f, 0xde, 0x59, 0x6c, 0x18, 0x6e, 0xe0, 0xb0, 0x98,
+	0x39, 0x91, 0xda, 0xd9, 0x6d, 0x81, 0x45, 0x9c, 0x2d, 0x58, 0x8e, 0xf5, 0xe6, 0x58, 0x20, 0xf2,
+	0x8c, 0xd4, 0x66, 0x79, 0x99, 0xbd, 0xcd, 0x33, 0x27, 0x73, 0x7b, 0xdb, 0x1b, 0xd3, 0x23, 0x4f,
+	0x02, 0xea, 0x11, 0x53, 0xb7, 0x5d, 0x33, 0xb0, 0x88, 0xde, 0xc4, 0x16, 0x76, 0x0c, 0x52, 0x9e,
+	0x12, 0x31, 0x23, 0xf8, 0x23, 0x8e, 0xee, 0x08, 0x10, 0xd5, 0x60, 0x81, 0x9f, 0x62, 0x8e, 0x33,
+	0xcd, 0x39, 0xf3, 0x21, 0x94, 0xb5, 0xbf, 0x06, 0xb3, 0x62, 0x3f, 0x4c, 0x62, 0x91, 0x16, 0x66,
+	0xc4, 0x2c, 0xcf, 0x70, 0xdb, 0x19, 0xbe, 0x7c, 0x2f, 0x5a, 0x4d, 0x0c, 0x03, 0xa7, 0xe9, 0x3a,
+	0x26, 0x75, 0x5a, 0xe5, 0xd9, 0x94, 0xe1, 0x7e, 0xb4, 0xaa, 0x5e, 0x86, 0x4b, 0xf1, 0x63, 0x79,
+	0x4c, 0x7c, 0x46, 0x9d, 0x96, 0xcc, 0xcd, 0x8f, 0x1e, 0xd4, 0x21, 0xac, 0x14, 0x9b, 0xc8, 0xa7,
+	0xf5, 0x1e, 0x8c, 0xcb, 0x2d, 0xf3, 0xcb, 0xca, 0xca, 0xc8, 0xda, 0x64, 0xfd, 0x6a, 0xad, 0xa7,
+	0x08, 0xd4, 0x7a, 0x3d, 0xec, 0x94, 0x5e, 0xfe, 0x79, 0x69, 0xa8, 0x11, 0x93, 0xd5, 0x75, 0xa8,
+	0x16, 0x04, 0x93, 0x72, 0xd0, 0x0c, 0x0c, 0x53, 0x93, 0xbf, 0xd9, 0x52, 0x63, 0x98, 0x9a, 0x6a,
+	0xbb, 0x30, 0x83, 0x58, 0xdd, 0x7d, 0x18, 0x93, 0x01, 0x38, 0xef, 0x94, 0xe2, 0x22, 0xae, 0xaa,
+	0xc1, 0xc5, 0x7c, 0xa4, 0x4f, 0x19, 0x66, 0x81, 0x5f, 0x24, 0xed, 0x67, 0x05, 0xaa, 0x45, 0x0c,
+	0x29, 0xed, 0x32, 0x4c, 0x79, 0x82, 0xad, 0x9b, 0x98, 0x11, 0x59, 0x8b, 0x26, 0xe5, 0xda, 0x3d,
+	0xcc, 0x08, 0xba, 0x0d, 0xa5, 0x8e, 0x85, 0x1d, 0x5e, 0x7a, 0x26, 0xeb, 0xab, 0x7d, 0xa4, 0xf3,
+	0x18, 0xd2, 0xff, 0x9e, 0x85, 0x9d, 0x06, 0x27, 0xa0, 0xff, 0xc1, 0xa8, 0xcf, 0xa3, 0x95, 0x47,
+	0x0a, 0xb3, 0x4e, 0x53, 0xa5, 0x34, 0x49, 0x52, 0x1f, 0xc2, 0x6a, 0x7f, 0xf1, 0x3b, 0xdd, 0x47,
+	0xd4, 0x26, 0x05, 0x49, 0x23, 0x04, 0x25, 0x46, 0x6d, 0xc2, 0xe5, 0x96, 0x1a, 0xfc, 0x5b, 0x7d,
+	0xa1, 0xc0, 0x95, 0x93, 0x7d, 0xfd, 0xf3, 0xb7, 0xe3, 0xd7, 0x11, 0x40, 0xbd, 0x30, 0x7f, 0xc2,
+	0xfc, 0xa5, 0x1d, 0x11, 0x9f, 0x85, 0x35, 0xc7, 0x8e, 0xef, 0x59, 0xf8, 0x84, 0x43, 0xe8, 0x31,
+	0x47, 0xb6, 0x39, 0x90, 0x94, 0xb4, 0xc0, 0xb1, 0x5c, 0xe3, 0x30, 0x61, 0x0c, 0xa7, 0x4a, 0xda,
+	0xbe, 0xc4, 0x24, 0xe7, 0x0e, 0x94, 0x8d, 0xc0, 0xf3, 0x88, 0xc3, 0x74, 0x5e, 0xbb, 0x44, 0x89,
+	0x12, 0x34, 0xd1, 0x63, 0x96, 0x24, 0xbe, 0x1b, 0xc1, 0x92, 0xb9, 0x0e, 0x8b, 0x32, 0x4a, 0x56,
+	0x9e, 0x68, 0x32, 0x48, 0x60, 0x19, 0x7d, 0x77, 0xe1, 0x9c, 0x47, 0x6c, 0x4c, 0x1d, 0xea, 0xb4,
+	0xf4, 0xc0, 0xc9, 0xd2, 0x64, 0x8b, 0x89, 0x0d, 0xf6, 0x9d, 0xa3, 0x34, 0xf7, 0x2a, 0xcc, 0xc4,
+	0xa5, 0x57, 0x10, 0x44, 0x67, 0x99, 0x8e, 0x2a, 0xae, 0x30, 0x5b, 0x85, 0x69, 0xb1, 0x05, 0xd9,
+	0x2e, 0x32, 0xc5, 0x17, 0xa3, 0x92, 0x7a, 0x0d, 0x66, 0x23, 0x5f, 0xd9, 0x76, 0x11, 0x85, 0x88,
+	0x0c, 0xaf, 0xc3, 0x7c, 0x52, 0xb7, 0xb3, 0xfd, 0x61, 0x2e, 0x06, 0xa4, 0xb1, 0xfa, 0x55, 0x09,
+	0xe6, 0xf2, 0xd7, 0x03, 0xa9, 0x30, 0x65, 0xb8, 0xb6, 0x4d, 0x1c, 0x83, 0xd8, 0x44, 0x9e, 0xdd,
+	0x44, 0x23, 0xb3, 0x26, 0x8e, 0xf9, 0x90, 0x38, 0x7c, 0x1f, 0xc3, 0xad, 0xf1, 0x19, 0xf6, 0x98,
+	0x1c, 0x07, 0xe6, 0x39, 0x94, 0xdc, 0x0b, 0x8f, 0x85, 0xfd, 0x3d, 0x6b, 0x7f, 0x40, 0x1d, 0xea,
+	0xb7, 0x89, 0xc9, 0x0f, 0x6c, 0xa2, 0xb1, 0x98, 0xa6, 0x3c, 0x90, 0x18, 0xba, 0x01, 0x48, 0xb0,
+	0xc4, 0xe5, 0x90, 0x41, 0x4a, 0x9c, 0x31, 0xc7, 0x11, 0x71, 0x33, 0x44, 0x0c, 0x7e, 0x95, 0x52,
+	0xd6, 0x71, 0x88, 0x33, 0x62, 0xa0, 0x49, 0x11, 0xe2, 0x08, 0x15, 0x18, 0x37, 0x2c, 0xfc, 0xb4,
+	0x89, 0x8d, 0x43, 0x79, 0x38, 0xf1, 0xdf, 0x72, 0xcb, 0xf9, 0x77, 0x74, 0x7e, 0x63, 0xf1, 0x96,
+	0xf3, 0x65, 0x79, 0x80, 0x9b, 0xb0, 0x64, 0xe3, 0x63, 0x6a, 0x07, 0x76, 0x9c, 0x9e, 0xb4, 0x17,
+	0x47, 0xb4, 0x28, 0xd1, 0xa8, 0x9c, 0x0a, 0xd6, 0x16, 0x2c, 0x77, 0xb0, 0xc7, 0x28, 0xb6, 0xf4,
+	0x7c, 0x18, 0x71, 0x5c, 0x67, 0x25, 0xbc, 0x9b, 0x8d, 0xb6, 0x0f, 0xf3, 0x79, 0x5e, 0xd8, 0xca,
+	0xc3, 0x26, 0xa3, 0xf6, 0x79, 0xc2, 0x7b, 0x59, 0x27, 0xb2, 0x88, 0xcf, 0xe5, 0x7c, 0xfb, 0xea,
+	0x26, 0xa8, 0x71, 0x49, 0xda, 0x21, 0x0e, 0x39, 0xa0, 0x06, 0xc5, 0x5e, 0xf7, 0x7d, 0xea, 0x33,
+	0xd7, 0xeb, 0x16, 0x95, 0xf4, 0x5f, 0x14, 0x58, 0x3d, 0x91, 0x26, 0x0b, 0xd9, 0x0a, 0x4c, 0x36,
+	0x13, 0x34, 0xaa, 0x63, 0xa9, 0x25, 0xa4, 0xc1, 0x42, 0x87, 0xf0, 0x26, 0xac, 0xa7, 0x2d, 0xc5,
+	0x8d, 0x42, 0x12, 0x4a, 0x45, 0x40, 0xf7, 0x60, 0xcc, 0x68, 0x63, 0xa7, 0x45, 0xc2, 0x02, 0x16,
+	0x66, 0x7f, 0xa5, 0x4f, 0xf6, 0x29, 0xc2, 0x2e, 0x37, 0x8e, 0x9a, 0x98, 0xa4, 0xaa, 0x37, 0xe1,
+	0x7c, 0xac, 0x5f, 0xce, 0x0b, 0xe1, 0xc8, 0x5d, 0x94, 0xef, 0x77, 0x23, 0x70, 0xa1, 0xbf, 0xbd,
+	0x4c, 0xf4, 0x21, 0x4c, 0x9a, 0xc9, 0xb2, 0x6c, 0xfe, 0x97, 0x0b, 0xfa, 0x6b, 0xe2, 0x40, 0xca,
+	0x4a, 0x73, 0xd1, 0x03, 0x80, 0x78, 0x5c, 0xf1, 0xcb, 0xc3, 0xdc, 0xd3, 0x4a, 0x81, 0xa7, 0x78,
+	0x82, 0x91, 0x8e, 0x52, 0xcc, 0x7e, 0x53, 0xd2, 0xc8, 0xa0, 0x53, 0x52, 0xa9, 0xdf, 0x94, 0x14,
+	0x16, 0x36, 0x1b, 0x1f, 0xeb, 0x89, 0x58, 0x59, 0x09, 0xa7, 0x6d, 0x7c, 0x9c, 0xe4, 0x14, 0x96,
+	0xa2, 0xc4, 0x44, 0xb7, 0x5c, 0xdf, 0x27, 0xd1, 0x70, 0x3d, 0x97, 0x00, 0x1f, 0xf2, 0x75, 0xb4,
+	0x07, 0x33, 0xd1, 0xf9, 0xf3, 0x8a, 0x16, 0x96, 0xc1, 0x91, 0x82, 0x8e, 0x16, 0x66, 0xbc, 0x27,
+	0x8c, 0x79, 0x85, 0x97, 0x49, 0x4f, 0x77, 0x52, 0x6b, 0x7e, 0xfd, 0xf5, 0x04, 0x9c, 0xe1, 0x67,
+	0x85, 0xbe, 0x54, 0x60, 0x3c, 0xfa, 0xf9, 0x83, 0xae, 0x15, 0xf5, 0xb9, 0xdc, 0x2f, 0xa7, 0xca,
+	0xda, 0xbb, 0x0d, 0xc5, 0xa1, 0xab, 0x57, 0xbe, 0xf8, 0xfd, 0xaf, 0x6f, 0x86, 0xab, 0xe8, 0x82,
+	0xd6, 0xff, 0x27, 0x9a, 0x4e, 0xc3, 0xc0, 0x3f, 0x2a, 0xb0, 0xd0, 0x67, 0x68, 0x44, 0xf5, 0x93,
+	0xe2, 0xf4, 0x1f, 0x42, 0x2b, 0xb7, 0x4e, 0xc5, 0x91, 0x32, 0xd7, 0xb9, 0xcc, 0x7f, 0xa3, 0xb5,
+	0x22, 0x99, 0x71, 0xf5, 0x8a, 0xa4, 0xfd, 0xa4, 0x00, 0xea, 0xf5, 0x88, 0x36, 0x06, 0x8f, 0x1e,
+	0x09, 0xae, 0x9f, 0x86, 0x22, 0xf5, 0x6e, 0x72, 0xbd, 0x35, 0x74, 0x63, 0x40, 0xbd, 0xda, 0x33,
+	0x6a, 0x3e, 0x47, 0x3f, 0x28, 0x30, 0xdf, 0x33, 0x57, 0xa1, 0xf5, 0x01, 0xe2, 0x67, 0xa6, 0xd7,
+	0xca, 0xc6, 0x29, 0x18, 0x52, 0xf0, 0x2d, 0x2e, 0xf8, 0x26, 0xba, 0xfe, 0x2e, 0xc1, 0x62, 0x86,
+	0x12, 0x7a, 0x7f, 0x53, 0x60, 0xb9, 0x60, 0x0e, 0x44, 0x5b, 0x03, 0x6b, 0xc8, 0x0c, 0xa1, 0x95,
+	0xdb, 0xa7, 0xe6, 0xc9, 0x0c, 0x76, 0x78, 0x06, 0xff, 0x45, 0x77, 0x07, 0xcb, 0x40, 0x6f, 0x76,
+	0x75, 0x46, 0x6d, 0xc2, 0x33, 0xd1, 0x9e, 0x85, 0x9f, 0xcf, 0xd1, 0x0b, 0x05, 0x96, 0xfa, 0xb7,
+	0x03, 0xf4, 0x9f, 0x93, 0x74, 0x15, 0x76, 0x9d, 0xca, 0xd6, 0x69, 0x69, 0x32, 0x9b, 0x3b, 0x3c,
+	0x9b, 0x3a, 0x5a, 0x2f, 0xca, 0x26, 0xd5, 0x69, 0xf4, 0xb6, 0x20, 0x8b, 0x43, 0xf9, 0x56, 0x81,
+	0xd9, 0x5c, 0x89, 0x47, 0xb5, 0x93, 0x54, 0xf4, 0xf6, 0x8e, 0x8a, 0x36, 0xb0, 0xfd, 0xa0, 0xef,
+	0x33, 0xd5, 0x1d, 0xb8, 0xcc, 0x9d, 0xdd, 0x97, 0x6f, 0xaa, 0xca, 0xab, 0x37, 0x55, 0xe5, 0xf5,
+	0x9b, 0xaa, 0xf2, 0xf5, 0xdb, 0xea, 0xd0, 0xab, 0xb7, 0xd5, 0xa1, 0x3f, 0xde, 0x56, 0x87, 0x3e,
+	0xff, 0x57, 0x8b, 0xb2, 0x76, 0xd0, 0xac, 0x19, 0xae, 0xad, 0x7d, 0xf0, 0xd9, 0xe3, 0xfb, 0x1f,
+	0x13, 0xf6, 0xd4, 0xf5, 0x0e, 0x35, 0xa3, 0x8d, 0xa9, 0xa3, 0x1d, 0x0b, 0xe7, 0xac, 0xdb, 0x21,
+	0x7e, 0x73, 0x94, 0xff, 0x03, 0xe9, 0xd6, 0xdf, 0x03, 0x00, 0x66, 0xea, 0x08, 0x05, 0xc6, 0x12,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -970,6 +1288,10 @@ type QueryClient interface {
 	TeamVestingStatus(ctx context.Context, in *QueryTeamVestingStatusRequest, opts ...grpc.CallOption) (*QueryTeamVestingStatusResponse, error)
 	// TeamCurrentVestingStatus queries the current vesting progress of a team vesting account
 	TeamVestingStatusByTime(ctx context.Context, in *QueryTeamVestingStatusByTimeRequest, opts ...grpc.CallOption) (*QueryTeamVestingStatusByTimeResponse, error)
+	// TeamBeneficiaryHistory queries all beneficiary changes of a team vesting account
+	TeamBeneficiaryHistory(ctx context.Context, in *QueryTeamBeneficiaryHistoryRequest, opts ...grpc.CallOption) (*QueryTeamBeneficiaryHistoryResponse, error)
+	// TeamDelegations queries the delegations and unbondings of a team vesting account
+	TeamDelegations(ctx context.Context, in *QueryTeamDelegationsRequest, opts ...grpc.CallOption) (*QueryTeamDelegationsResponse, error)
 }
 
 type queryClient struct {
@@ -1025,6 +1347,24 @@ func (c *queryClient) TeamVestingStatusByTime(ctx context.Context, in *QueryTeam
 	return out, nil
 }
 
+func (c *queryClient) TeamBeneficiaryHistory(ctx context.Context, in *QueryTeamBeneficiaryHistoryRequest, opts ...grpc.CallOption) (*QueryTeamBeneficiaryHistoryResponse, error) {
+	out := new(QueryTeamBeneficiaryHistoryResponse)
+	err := c.cc.Invoke(ctx, "/kyve.team.v1beta1.Query/TeamBeneficiaryHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TeamDelegations(ctx context.Context, in *QueryTeamDelegationsRequest, opts ...grpc.CallOption) (*QueryTeamDelegationsResponse, error) {
+	out := new(QueryTeamDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/kyve.team.v1beta1.Query/TeamDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// TeamInfo queries all important information from the team module
@@ -1037,6 +1377,10 @@ type QueryServer interface {
 	TeamVestingStatus(context.Context, *QueryTeamVestingStatusRequest) (*QueryTeamVestingStatusResponse, error)
 	// TeamCurrentVestingStatus queries the current vesting progress of a team vesting account
 	TeamVestingStatusByTime(context.Context, *QueryTeamVestingStatusByTimeRequest) (*QueryTeamVestingStatusByTimeResponse, error)
+	// TeamBeneficiaryHistory queries all beneficiary changes of a team vesting account
+	TeamBeneficiaryHistory(context.Context, *QueryTeamBeneficiaryHistoryRequest) (*QueryTeamBeneficiaryHistoryResponse, error)
+	// TeamDelegations queries the delegations and unbondings of a team vesting account
+	TeamDelegations(context.Context, *QueryTeamDelegationsRequest) (*QueryTeamDelegationsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1058,6 +1402,12 @@ func (*UnimplementedQueryServer) TeamVestingStatus(ctx context.Context, req *Que
 func (*UnimplementedQueryServer) TeamVestingStatusByTime(ctx context.Context, req *QueryTeamVestingStatusByTimeRequest) (*QueryTeamVestingStatusByTimeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TeamVestingStatusByTime not implemented")
 }
+func (*UnimplementedQueryServer) TeamBeneficiaryHistory(ctx context.Context, req *QueryTeamBeneficiaryHistoryRequest) (*QueryTeamBeneficiaryHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TeamBeneficiaryHistory not implemented")
+}
+func (*UnimplementedQueryServer) TeamDelegations(ctx context.Context, req *QueryTeamDelegationsRequest) (*QueryTeamDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TeamDelegations not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1153,6 +1503,42 @@ func _Query_TeamVestingStatusByTime_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TeamBeneficiaryHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTeamBeneficiaryHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TeamBeneficiaryHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.team.v1beta1.Query/TeamBeneficiaryHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TeamBeneficiaryHistory(ctx, req.(*QueryTeamBeneficiaryHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TeamDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTeamDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TeamDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.team.v1beta1.Query/TeamDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TeamDelegations(ctx, req.(*QueryTeamDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kyve.team.v1beta1.Query",
@@ -1178,6 +1564,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TeamVestingStatusByTime",
 			Handler:    _Query_TeamVestingStatusByTime_Handler,
 		},
+		{
+			MethodName: "TeamBeneficiaryHistory",
+			Handler:    _Query_TeamBeneficiaryHistory_Handler,
+		},
+		{
+			MethodName: "TeamDelegations",
+			Handler:    _Query_TeamDelegations_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kyve/team/v1beta1/query.proto",
@@ -1226,6 +1620,16 @@ func (m *QueryTeamInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TotalUnbonding != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalUnbonding))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.TotalDelegated != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalDelegated))
+		i--
+		dAtA[i] = 0x70
+	}
 	if m.TeamModuleBalance != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.TeamModuleBalance))
 		i--
@@ -1676,6 +2080,25 @@ func (m *QueryVestingPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PartialClawbacks) > 0 {
+		for iNdEx := len(m.PartialClawbacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PartialClawbacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.PartialClawbackAmount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PartialClawbackAmount))
+		i--
+		dAtA[i] = 0x48
+	}
 	if m.MaximumVestingAmount != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.MaximumVestingAmount))
 		i--
@@ -1729,29 +2152,221 @@ func (m *QueryVestingPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryTeamBeneficiaryHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryTeamInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryTeamBeneficiaryHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTeamInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTeamBeneficiaryHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTeamBeneficiaryHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTeamBeneficiaryHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTeamBeneficiaryHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PendingBeneficiary) > 0 {
+		i -= len(m.PendingBeneficiary)
+		copy(dAtA[i:], m.PendingBeneficiary)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PendingBeneficiary)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Beneficiary) > 0 {
+		i -= len(m.Beneficiary)
+		copy(dAtA[i:], m.Beneficiary)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Beneficiary)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTeamDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTeamDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTeamDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTeamDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTeamDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTeamDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingClaims) > 0 {
+		for iNdEx := len(m.PendingClaims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingClaims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.DelegationLosses != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DelegationLosses))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxDelegation != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxDelegation))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TotalUnbonding != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalUnbonding))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TotalDelegated != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalDelegated))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Unbondings) > 0 {
+		for iNdEx := len(m.Unbondings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryTeamInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTeamInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -1796,6 +2411,12 @@ func (m *QueryTeamInfoResponse) Size() (n int) {
 	if m.TeamModuleBalance != 0 {
 		n += 1 + sovQuery(uint64(m.TeamModuleBalance))
 	}
+	if m.TotalDelegated != 0 {
+		n += 1 + sovQuery(uint64(m.TotalDelegated))
+	}
+	if m.TotalUnbonding != 0 {
+		n += 1 + sovQuery(uint64(m.TotalUnbonding))
+	}
 	return n
 }
 
@@ -1986,6 +2607,101 @@ func (m *QueryVestingPlan) Size() (n int) {
 	if m.MaximumVestingAmount != 0 {
 		n += 1 + sovQuery(uint64(m.MaximumVestingAmount))
 	}
+	if m.PartialClawbackAmount != 0 {
+		n += 1 + sovQuery(uint64(m.PartialClawbackAmount))
+	}
+	if len(m.PartialClawbacks) > 0 {
+		for _, e := range m.PartialClawbacks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTeamBeneficiaryHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryTeamBeneficiaryHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Beneficiary)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PendingBeneficiary)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTeamDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryTeamDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Unbondings) > 0 {
+		for _, e := range m.Unbondings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.TotalDelegated != 0 {
+		n += 1 + sovQuery(uint64(m.TotalDelegated))
+	}
+	if m.TotalUnbonding != 0 {
+		n += 1 + sovQuery(uint64(m.TotalUnbonding))
+	}
+	if m.MaxDelegation != 0 {
+		n += 1 + sovQuery(uint64(m.MaxDelegation))
+	}
+	if m.DelegationLosses != 0 {
+		n += 1 + sovQuery(uint64(m.DelegationLosses))
+	}
+	if len(m.PendingClaims) > 0 {
+		for _, e := range m.PendingClaims {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2347,21 +3063,59 @@ func (m *QueryTeamInfoResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegated", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			m.TotalDelegated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalDelegated |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalUnbonding", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
+			m.TotalUnbonding = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalUnbonding |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
@@ -3586,6 +4340,573 @@ func (m *QueryVestingPlan) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartialClawbackAmount", wireType)
+			}
+			m.PartialClawbackAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartialClawbackAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartialClawbacks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PartialClawbacks = append(m.PartialClawbacks, PartialClawback{})
+			if err := m.PartialClawbacks[len(m.PartialClawbacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTeamBeneficiaryHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTeamBeneficiaryHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTeamBeneficiaryHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTeamBeneficiaryHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTeamBeneficiaryHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTeamBeneficiaryHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Beneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingBeneficiary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingBeneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, BeneficiaryChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTeamDelegationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTeamDelegationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTeamDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTeamDelegationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTeamDelegationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTeamDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegations = append(m.Delegations, TeamDelegation{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbondings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unbondings = append(m.Unbondings, TeamUnbonding{})
+			if err := m.Unbondings[len(m.Unbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegated", wireType)
+			}
+			m.TotalDelegated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalDelegated |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalUnbonding", wireType)
+			}
+			m.TotalUnbonding = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalUnbonding |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDelegation", wireType)
+			}
+			m.MaxDelegation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDelegation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationLosses", wireType)
+			}
+			m.DelegationLosses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelegationLosses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingClaims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingClaims = append(m.PendingClaims, TeamPendingClaim{})
+			if err := m.PendingClaims[len(m.PendingClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
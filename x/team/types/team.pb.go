@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -77,6 +78,62 @@ func (m *Authority) GetRewardsClaimed() uint64 {
 	return 0
 }
 
+// TeamAuthorities are the addresses which are allowed to manage the team
+// vesting accounts. They can be updated by governance.
+type TeamAuthorities struct {
+	// foundation is the foundation authority address
+	Foundation string `protobuf:"bytes,1,opt,name=foundation,proto3" json:"foundation,omitempty"`
+	// bcp is the bcp authority address
+	Bcp string `protobuf:"bytes,2,opt,name=bcp,proto3" json:"bcp,omitempty"`
+}
+
+func (m *TeamAuthorities) Reset()         { *m = TeamAuthorities{} }
+func (m *TeamAuthorities) String() string { return proto.CompactTextString(m) }
+func (*TeamAuthorities) ProtoMessage()    {}
+func (*TeamAuthorities) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a9a907d008be83cf, []int{1}
+}
+func (m *TeamAuthorities) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TeamAuthorities) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TeamAuthorities.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TeamAuthorities) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TeamAuthorities.Merge(m, src)
+}
+func (m *TeamAuthorities) XXX_Size() int {
+	return m.Size()
+}
+func (m *TeamAuthorities) XXX_DiscardUnknown() {
+	xxx_messageInfo_TeamAuthorities.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TeamAuthorities proto.InternalMessageInfo
+
+func (m *TeamAuthorities) GetFoundation() string {
+	if m != nil {
+		return m.Foundation
+	}
+	return ""
+}
+
+func (m *TeamAuthorities) GetBcp() string {
+	if m != nil {
+		return m.Bcp
+	}
+	return ""
+}
+
 // TeamVestingAccount ...
 type TeamVestingAccount struct {
 	// id is a unique identify for each vesting account, tied to a single team member.
@@ -96,13 +153,32 @@ type TeamVestingAccount struct {
 	TotalRewards uint64 `protobuf:"varint,7,opt,name=total_rewards,json=totalRewards,proto3" json:"total_rewards,omitempty"`
 	// rewards claimed is the amount inflation rewards claimed by account holder
 	RewardsClaimed uint64 `protobuf:"varint,8,opt,name=rewards_claimed,json=rewardsClaimed,proto3" json:"rewards_claimed,omitempty"`
+	// schedule is the vesting schedule of the account. If it is not set the
+	// default schedule with a one year cliff, three years of vesting and two
+	// years of unlocking is used.
+	Schedule *VestingSchedule `protobuf:"bytes,9,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// beneficiary is the address of the team member which is entitled to the
+	// unlocked tokens and the inflation rewards of this account. The beneficiary
+	// can claim them directly. If empty only the authority can claim.
+	Beneficiary string `protobuf:"bytes,10,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	// pending_beneficiary is the address which was proposed as the new beneficiary
+	// and which still has to accept the transfer.
+	PendingBeneficiary string `protobuf:"bytes,11,opt,name=pending_beneficiary,json=pendingBeneficiary,proto3" json:"pending_beneficiary,omitempty"`
+	// pending_beneficiary_proposer is the address which proposed the pending beneficiary.
+	PendingBeneficiaryProposer string `protobuf:"bytes,12,opt,name=pending_beneficiary_proposer,json=pendingBeneficiaryProposer,proto3" json:"pending_beneficiary_proposer,omitempty"`
+	// partial_clawbacks reduce the allocation of the account going forward. The
+	// part of a partial clawback which has vested before its time stays vested.
+	PartialClawbacks []PartialClawback `protobuf:"bytes,13,rep,name=partial_clawbacks,json=partialClawbacks,proto3" json:"partial_clawbacks"`
+	// delegation_losses is the amount of $KYVE the account lost because validators
+	// it delegated to got slashed. It is deducted from the claimable unlocked amount.
+	DelegationLosses uint64 `protobuf:"varint,14,opt,name=delegation_losses,json=delegationLosses,proto3" json:"delegation_losses,omitempty"`
 }
 
 func (m *TeamVestingAccount) Reset()         { *m = TeamVestingAccount{} }
 func (m *TeamVestingAccount) String() string { return proto.CompactTextString(m) }
 func (*TeamVestingAccount) ProtoMessage()    {}
 func (*TeamVestingAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a9a907d008be83cf, []int{1}
+	return fileDescriptor_a9a907d008be83cf, []int{2}
 }
 func (m *TeamVestingAccount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -187,201 +263,2276 @@ func (m *TeamVestingAccount) GetRewardsClaimed() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*Authority)(nil), "kyve.team.v1beta1.Authority")
-	proto.RegisterType((*TeamVestingAccount)(nil), "kyve.team.v1beta1.TeamVestingAccount")
+func (m *TeamVestingAccount) GetSchedule() *VestingSchedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("kyve/team/v1beta1/team.proto", fileDescriptor_a9a907d008be83cf) }
+func (m *TeamVestingAccount) GetBeneficiary() string {
+	if m != nil {
+		return m.Beneficiary
+	}
+	return ""
+}
 
-var fileDescriptor_a9a907d008be83cf = []byte{
-	// 338 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xc1, 0x4e, 0xc2, 0x40,
-	0x10, 0x86, 0x69, 0x45, 0xc4, 0x0d, 0x82, 0xec, 0xa9, 0x31, 0xa6, 0x31, 0x78, 0x50, 0x3c, 0xd0,
-	0x10, 0x9f, 0x00, 0x89, 0x27, 0x13, 0x0f, 0x84, 0x90, 0xe0, 0x85, 0x6c, 0xb7, 0x13, 0xd8, 0xb4,
-	0xdb, 0x25, 0xed, 0x14, 0xe4, 0x2d, 0x7c, 0x18, 0x1f, 0xc2, 0x23, 0x47, 0x8f, 0x06, 0x5e, 0xc4,
-	0xb0, 0xbb, 0x10, 0x13, 0x3d, 0x78, 0x9c, 0xef, 0xff, 0x33, 0xf3, 0xe7, 0x1f, 0x72, 0x19, 0xaf,
-	0x16, 0x10, 0x20, 0x30, 0x19, 0x2c, 0xba, 0x21, 0x20, 0xeb, 0xea, 0xa1, 0x33, 0xcf, 0x14, 0x2a,
-	0xda, 0xdc, 0xa9, 0x1d, 0x0d, 0xac, 0xda, 0x1a, 0x93, 0xd3, 0x5e, 0x81, 0x33, 0x95, 0x09, 0x5c,
-	0xd1, 0x6b, 0x72, 0x86, 0x0a, 0x59, 0x32, 0xc9, 0x60, 0xc9, 0xb2, 0x28, 0xf7, 0x9c, 0x2b, 0xe7,
-	0xb6, 0x3c, 0xa8, 0x69, 0x38, 0x30, 0x8c, 0xde, 0x90, 0x86, 0x95, 0x27, 0x3c, 0x61, 0x42, 0x42,
-	0xe4, 0xb9, 0xda, 0x56, 0xb7, 0xb8, 0x6f, 0x68, 0xeb, 0xdd, 0x25, 0x74, 0x08, 0x4c, 0x8e, 0x20,
-	0x47, 0x91, 0x4e, 0x7b, 0x9c, 0xab, 0x22, 0x45, 0x5a, 0x27, 0xae, 0x88, 0xec, 0x66, 0x57, 0x44,
-	0xb4, 0x4d, 0xce, 0xcd, 0x51, 0x96, 0x24, 0x8a, 0x33, 0x14, 0x2a, 0xb5, 0x0b, 0x1b, 0x9a, 0xf7,
-	0x0e, 0x98, 0xb6, 0x48, 0x8d, 0x2b, 0x29, 0x21, 0xe5, 0x20, 0x21, 0x45, 0xef, 0xc8, 0xc4, 0xfb,
-	0xc9, 0xe8, 0x05, 0xa9, 0xf2, 0x84, 0x2d, 0x43, 0xc6, 0x63, 0xaf, 0xac, 0xf5, 0xc3, 0xbc, 0x3b,
-	0x55, 0xa4, 0x89, 0xe2, 0x31, 0x44, 0x87, 0xec, 0xc7, 0xe6, 0xd4, 0x9e, 0xdb, 0xf0, 0xf4, 0x8e,
-	0x34, 0x13, 0x96, 0xe3, 0xde, 0x36, 0x41, 0x21, 0xc1, 0xab, 0x18, 0xef, 0x4e, 0xb0, 0xbe, 0xa1,
-	0x90, 0xf0, 0xbb, 0xb6, 0x93, 0xff, 0xd5, 0x56, 0xfd, 0xab, 0xb6, 0x87, 0xfe, 0xc7, 0xc6, 0x77,
-	0xd6, 0x1b, 0xdf, 0xf9, 0xda, 0xf8, 0xce, 0xdb, 0xd6, 0x2f, 0xad, 0xb7, 0x7e, 0xe9, 0x73, 0xeb,
-	0x97, 0x5e, 0xda, 0x53, 0x81, 0xb3, 0x22, 0xec, 0x70, 0x25, 0x83, 0xa7, 0xf1, 0xe8, 0xf1, 0x19,
-	0x70, 0xa9, 0xb2, 0x38, 0xe0, 0x33, 0x26, 0xd2, 0xe0, 0xd5, 0xbc, 0x1d, 0x57, 0x73, 0xc8, 0xc3,
-	0x8a, 0x7e, 0xf8, 0xfd, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7f, 0xc5, 0x6b, 0x77, 0x10, 0x02,
-	0x00, 0x00,
+func (m *TeamVestingAccount) GetPendingBeneficiary() string {
+	if m != nil {
+		return m.PendingBeneficiary
+	}
+	return ""
 }
 
-func (m *Authority) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TeamVestingAccount) GetPendingBeneficiaryProposer() string {
+	if m != nil {
+		return m.PendingBeneficiaryProposer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Authority) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TeamVestingAccount) GetPartialClawbacks() []PartialClawback {
+	if m != nil {
+		return m.PartialClawbacks
+	}
+	return nil
 }
 
-func (m *Authority) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RewardsClaimed != 0 {
-		i = encodeVarintTeam(dAtA, i, uint64(m.RewardsClaimed))
-		i--
-		dAtA[i] = 0x10
+func (m *TeamVestingAccount) GetDelegationLosses() uint64 {
+	if m != nil {
+		return m.DelegationLosses
 	}
-	if m.TotalRewards != 0 {
-		i = encodeVarintTeam(dAtA, i, uint64(m.TotalRewards))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+// PartialClawback reduces the allocation of a team vesting account from a
+// given time on.
+type PartialClawback struct {
+	// time is the unix timestamp in seconds from which on the amount stops vesting.
+	Time uint64 `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	// amount is the number of tokens which are removed from the allocation.
+	Amount uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *PartialClawback) Reset()         { *m = PartialClawback{} }
+func (m *PartialClawback) String() string { return proto.CompactTextString(m) }
+func (*PartialClawback) ProtoMessage()    {}
+func (*PartialClawback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a9a907d008be83cf, []int{3}
+}
+func (m *PartialClawback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PartialClawback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PartialClawback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *PartialClawback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartialClawback.Merge(m, src)
+}
+func (m *PartialClawback) XXX_Size() int {
+	return m.Size()
+}
+func (m *PartialClawback) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartialClawback.DiscardUnknown(m)
 }
 
-func (m *TeamVestingAccount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PartialClawback proto.InternalMessageInfo
+
+func (m *PartialClawback) GetTime() uint64 {
+	if m != nil {
+		return m.Time
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *TeamVestingAccount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PartialClawback) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
 }
 
-func (m *TeamVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RewardsClaimed != 0 {
-		i = encodeVarintTeam(dAtA, i, uint64(m.RewardsClaimed))
-		i--
-		dAtA[i] = 0x40
+// VestingSchedule defines the shape of the vesting and unlocking of a team vesting account.
+type VestingSchedule struct {
+	// cliff_duration is the time in seconds after the commencement before anything vests
+	// and after which the unlocking starts.
+	CliffDuration uint64 `protobuf:"varint,1,opt,name=cliff_duration,json=cliffDuration,proto3" json:"cliff_duration,omitempty"`
+	// vesting_duration is the time in seconds after the commencement until the
+	// entire allocation has vested.
+	VestingDuration uint64 `protobuf:"varint,2,opt,name=vesting_duration,json=vestingDuration,proto3" json:"vesting_duration,omitempty"`
+	// unlock_duration is the time in seconds it takes to unlock all vested tokens
+	// after the cliff.
+	UnlockDuration uint64 `protobuf:"varint,3,opt,name=unlock_duration,json=unlockDuration,proto3" json:"unlock_duration,omitempty"`
+	// monthly_steps vests the allocation in monthly steps instead of linearly.
+	MonthlySteps bool `protobuf:"varint,4,opt,name=monthly_steps,json=monthlySteps,proto3" json:"monthly_steps,omitempty"`
+}
+
+func (m *VestingSchedule) Reset()         { *m = VestingSchedule{} }
+func (m *VestingSchedule) String() string { return proto.CompactTextString(m) }
+func (*VestingSchedule) ProtoMessage()    {}
+func (*VestingSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a9a907d008be83cf, []int{4}
+}
+func (m *VestingSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VestingSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VestingSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.TotalRewards != 0 {
-		i = encodeVarintTeam(dAtA, i, uint64(m.TotalRewards))
-		i--
-		dAtA[i] = 0x38
+}
+func (m *VestingSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingSchedule.Merge(m, src)
+}
+func (m *VestingSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *VestingSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VestingSchedule proto.InternalMessageInfo
+
+func (m *VestingSchedule) GetCliffDuration() uint64 {
+	if m != nil {
+		return m.CliffDuration
 	}
-	if m.LastClaimedTime != 0 {
-		i = encodeVarintTeam(dAtA, i, uint64(m.LastClaimedTime))
-		i--
-		dAtA[i] = 0x30
+	return 0
+}
+
+func (m *VestingSchedule) GetVestingDuration() uint64 {
+	if m != nil {
+		return m.VestingDuration
 	}
-	if m.UnlockedClaimed != 0 {
-		i = encodeVarintTeam(dAtA, i, uint64(m.UnlockedClaimed))
-		i--
-		dAtA[i] = 0x28
+	return 0
+}
+
+func (m *VestingSchedule) GetUnlockDuration() uint64 {
+	if m != nil {
+		return m.UnlockDuration
 	}
-	if m.Clawback != 0 {
-		i = encodeVarintTeam(dAtA, i, uint64(m.Clawback))
-		i--
-		dAtA[i] = 0x20
+	return 0
+}
+
+func (m *VestingSchedule) GetMonthlySteps() bool {
+	if m != nil {
+		return m.MonthlySteps
 	}
-	if m.Commencement != 0 {
-		i = encodeVarintTeam(dAtA, i, uint64(m.Commencement))
-		i--
-		dAtA[i] = 0x18
+	return false
+}
+
+// BeneficiaryChange is an entry of the audit trail of beneficiary changes
+// of a team vesting account.
+type BeneficiaryChange struct {
+	// account_id is the id of the team vesting account.
+	AccountId uint64 `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// index is the position of the change in the history of the account.
+	Index uint64 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// previous_beneficiary is the beneficiary before the change.
+	PreviousBeneficiary string `protobuf:"bytes,3,opt,name=previous_beneficiary,json=previousBeneficiary,proto3" json:"previous_beneficiary,omitempty"`
+	// new_beneficiary is the beneficiary after the change.
+	NewBeneficiary string `protobuf:"bytes,4,opt,name=new_beneficiary,json=newBeneficiary,proto3" json:"new_beneficiary,omitempty"`
+	// proposer is the address which proposed the change.
+	Proposer string `protobuf:"bytes,5,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// timestamp is the unix timestamp in seconds when the change was applied.
+	Timestamp uint64 `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *BeneficiaryChange) Reset()         { *m = BeneficiaryChange{} }
+func (m *BeneficiaryChange) String() string { return proto.CompactTextString(m) }
+func (*BeneficiaryChange) ProtoMessage()    {}
+func (*BeneficiaryChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a9a907d008be83cf, []int{5}
+}
+func (m *BeneficiaryChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BeneficiaryChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BeneficiaryChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.TotalAllocation != 0 {
-		i = encodeVarintTeam(dAtA, i, uint64(m.TotalAllocation))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *BeneficiaryChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeneficiaryChange.Merge(m, src)
+}
+func (m *BeneficiaryChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *BeneficiaryChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeneficiaryChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BeneficiaryChange proto.InternalMessageInfo
+
+func (m *BeneficiaryChange) GetAccountId() uint64 {
+	if m != nil {
+		return m.AccountId
 	}
-	if m.Id != 0 {
-		i = encodeVarintTeam(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *BeneficiaryChange) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func encodeVarintTeam(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTeam(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *BeneficiaryChange) GetPreviousBeneficiary() string {
+	if m != nil {
+		return m.PreviousBeneficiary
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *Authority) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *BeneficiaryChange) GetNewBeneficiary() string {
+	if m != nil {
+		return m.NewBeneficiary
 	}
-	var l int
-	_ = l
-	if m.TotalRewards != 0 {
-		n += 1 + sovTeam(uint64(m.TotalRewards))
+	return ""
+}
+
+func (m *BeneficiaryChange) GetProposer() string {
+	if m != nil {
+		return m.Proposer
 	}
-	if m.RewardsClaimed != 0 {
-		n += 1 + sovTeam(uint64(m.RewardsClaimed))
+	return ""
+}
+
+func (m *BeneficiaryChange) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+// TeamDelegation tracks the locked $KYVE of a team vesting account which the
+// team module has delegated to a validator.
+type TeamDelegation struct {
+	// account_id is the id of the team vesting account.
+	AccountId uint64 `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// validator is the operator address of the validator.
+	Validator string `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	// amount is the amount of $KYVE of the account bonded to the validator.
+	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *TeamDelegation) Reset()         { *m = TeamDelegation{} }
+func (m *TeamDelegation) String() string { return proto.CompactTextString(m) }
+func (*TeamDelegation) ProtoMessage()    {}
+func (*TeamDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a9a907d008be83cf, []int{6}
+}
+func (m *TeamDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TeamDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TeamDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TeamDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TeamDelegation.Merge(m, src)
+}
+func (m *TeamDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *TeamDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_TeamDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TeamDelegation proto.InternalMessageInfo
+
+func (m *TeamDelegation) GetAccountId() uint64 {
+	if m != nil {
+		return m.AccountId
+	}
+	return 0
+}
+
+func (m *TeamDelegation) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *TeamDelegation) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+// TeamUnbonding tracks the $KYVE of a team vesting account which are currently
+// unbonding from a validator.
+type TeamUnbonding struct {
+	// account_id is the id of the team vesting account.
+	AccountId uint64 `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// validator is the operator address of the validator.
+	Validator string `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	// creation_height is the height at which the unbonding was started.
+	CreationHeight int64 `protobuf:"varint,3,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty"`
+	// completion_time is the unix timestamp in nanoseconds at which the unbonding completes.
+	CompletionTime int64 `protobuf:"varint,4,opt,name=completion_time,json=completionTime,proto3" json:"completion_time,omitempty"`
+	// amount is the amount of $KYVE of the account which is still unbonding.
+	Amount uint64 `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *TeamUnbonding) Reset()         { *m = TeamUnbonding{} }
+func (m *TeamUnbonding) String() string { return proto.CompactTextString(m) }
+func (*TeamUnbonding) ProtoMessage()    {}
+func (*TeamUnbonding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a9a907d008be83cf, []int{7}
+}
+func (m *TeamUnbonding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TeamUnbonding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TeamUnbonding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TeamUnbonding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TeamUnbonding.Merge(m, src)
+}
+func (m *TeamUnbonding) XXX_Size() int {
+	return m.Size()
+}
+func (m *TeamUnbonding) XXX_DiscardUnknown() {
+	xxx_messageInfo_TeamUnbonding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TeamUnbonding proto.InternalMessageInfo
+
+func (m *TeamUnbonding) GetAccountId() uint64 {
+	if m != nil {
+		return m.AccountId
+	}
+	return 0
+}
+
+func (m *TeamUnbonding) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *TeamUnbonding) GetCreationHeight() int64 {
+	if m != nil {
+		return m.CreationHeight
+	}
+	return 0
+}
+
+func (m *TeamUnbonding) GetCompletionTime() int64 {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return 0
+}
+
+func (m *TeamUnbonding) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+// TeamPendingClaim is a claim of unlocked $KYVE which could not be paid right away
+// because the tokens of the account were still delegated. It gets paid once the
+// unbonding of the tokens has completed.
+type TeamPendingClaim struct {
+	// account_id is the id of the team vesting account.
+	AccountId uint64 `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// recipient is the receiver address of the claim.
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount is the amount of $KYVE which gets paid to the recipient.
+	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// authority is the address which initiated the claim.
+	Authority string `protobuf:"bytes,4,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *TeamPendingClaim) Reset()         { *m = TeamPendingClaim{} }
+func (m *TeamPendingClaim) String() string { return proto.CompactTextString(m) }
+func (*TeamPendingClaim) ProtoMessage()    {}
+func (*TeamPendingClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a9a907d008be83cf, []int{8}
+}
+func (m *TeamPendingClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TeamPendingClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TeamPendingClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TeamPendingClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TeamPendingClaim.Merge(m, src)
+}
+func (m *TeamPendingClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *TeamPendingClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_TeamPendingClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TeamPendingClaim proto.InternalMessageInfo
+
+func (m *TeamPendingClaim) GetAccountId() uint64 {
+	if m != nil {
+		return m.AccountId
+	}
+	return 0
+}
+
+func (m *TeamPendingClaim) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *TeamPendingClaim) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *TeamPendingClaim) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Authority)(nil), "kyve.team.v1beta1.Authority")
+	proto.RegisterType((*TeamAuthorities)(nil), "kyve.team.v1beta1.TeamAuthorities")
+	proto.RegisterType((*TeamVestingAccount)(nil), "kyve.team.v1beta1.TeamVestingAccount")
+	proto.RegisterType((*PartialClawback)(nil), "kyve.team.v1beta1.PartialClawback")
+	proto.RegisterType((*VestingSchedule)(nil), "kyve.team.v1beta1.VestingSchedule")
+	proto.RegisterType((*BeneficiaryChange)(nil), "kyve.team.v1beta1.BeneficiaryChange")
+	proto.RegisterType((*TeamDelegation)(nil), "kyve.team.v1beta1.TeamDelegation")
+	proto.RegisterType((*TeamUnbonding)(nil), "kyve.team.v1beta1.TeamUnbonding")
+	proto.RegisterType((*TeamPendingClaim)(nil), "kyve.team.v1beta1.TeamPendingClaim")
+}
+
+func init() { proto.RegisterFile("kyve/team/v1beta1/team.proto", fileDescriptor_a9a907d008be83cf) }
+
+var fileDescriptor_a9a907d008be83cf = []byte{
+	// 841 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0xdf, 0x6e, 0xdb, 0x36,
+	0x14, 0xc6, 0xa3, 0xd8, 0xce, 0xec, 0x93, 0xf8, 0x1f, 0x1b, 0x0c, 0x42, 0x90, 0x79, 0x81, 0x8a,
+	0x21, 0xed, 0x06, 0xd8, 0xc8, 0x76, 0xbd, 0x61, 0x89, 0x3b, 0x60, 0xc3, 0x86, 0x21, 0x50, 0xd3,
+	0x02, 0xdd, 0x8d, 0x40, 0x53, 0x27, 0x36, 0x11, 0x89, 0x14, 0x44, 0xda, 0xae, 0x1f, 0x60, 0x97,
+	0x03, 0xf6, 0x2a, 0xbb, 0xd9, 0x33, 0xf4, 0xb2, 0x97, 0xbb, 0x2a, 0x86, 0xe4, 0x45, 0x06, 0x91,
+	0xb4, 0x2c, 0x37, 0x2d, 0x56, 0xa0, 0x77, 0xe2, 0xef, 0x7c, 0x87, 0x3c, 0x3a, 0xe7, 0xa3, 0x04,
+	0xc7, 0x37, 0xab, 0x05, 0x8e, 0x34, 0xd2, 0x74, 0xb4, 0x38, 0x9b, 0xa0, 0xa6, 0x67, 0x66, 0x31,
+	0xcc, 0x72, 0xa9, 0x25, 0xe9, 0x17, 0xd1, 0xa1, 0x01, 0x2e, 0x7a, 0x74, 0x38, 0x95, 0x53, 0x69,
+	0xa2, 0xa3, 0xe2, 0xc9, 0x0a, 0x83, 0x17, 0xd0, 0x3a, 0x9f, 0xeb, 0x99, 0xcc, 0xb9, 0x5e, 0x91,
+	0x87, 0xd0, 0xd6, 0x52, 0xd3, 0x24, 0xca, 0x71, 0x49, 0xf3, 0x58, 0xf9, 0xde, 0x89, 0xf7, 0xa8,
+	0x1e, 0x1e, 0x18, 0x18, 0x5a, 0x46, 0x4e, 0xa1, 0xeb, 0xc2, 0x11, 0x4b, 0x28, 0x4f, 0x31, 0xf6,
+	0x77, 0x8d, 0xac, 0xe3, 0xf0, 0xd8, 0xd2, 0x60, 0x0c, 0xdd, 0x2b, 0xa4, 0xe9, 0x7a, 0x7b, 0x8e,
+	0x8a, 0x0c, 0x00, 0xae, 0xe5, 0x5c, 0xc4, 0x54, 0x73, 0x29, 0xcc, 0xee, 0xad, 0xb0, 0x42, 0x48,
+	0x0f, 0x6a, 0x13, 0x96, 0x99, 0xfd, 0x5a, 0x61, 0xf1, 0x18, 0xfc, 0xd1, 0x00, 0x52, 0xec, 0xf2,
+	0x1c, 0x95, 0xe6, 0x62, 0x7a, 0xce, 0x98, 0x9c, 0x0b, 0x4d, 0x3a, 0xb0, 0xcb, 0x63, 0x57, 0xde,
+	0x2e, 0x8f, 0xc9, 0x63, 0xe8, 0xd9, 0xca, 0x69, 0x92, 0x48, 0x66, 0xb7, 0xb7, 0x55, 0x75, 0x0d,
+	0x3f, 0x2f, 0x31, 0x09, 0xe0, 0x80, 0xc9, 0x34, 0x45, 0xc1, 0x30, 0x45, 0xa1, 0xfd, 0x9a, 0x7d,
+	0xc7, 0x2a, 0x23, 0x47, 0xd0, 0x64, 0x09, 0x5d, 0x4e, 0x28, 0xbb, 0xf1, 0xeb, 0x26, 0x5e, 0xae,
+	0x8b, 0xa3, 0xe6, 0x22, 0x91, 0xec, 0x06, 0xe3, 0xb2, 0x01, 0x0d, 0x7b, 0xd4, 0x9a, 0xbb, 0x0e,
+	0x90, 0x2f, 0xa1, 0x9f, 0x50, 0xa5, 0xd7, 0xb2, 0x48, 0xf3, 0x14, 0xfd, 0x3d, 0xab, 0x2d, 0x02,
+	0x4e, 0x77, 0xc5, 0x53, 0xbc, 0xdf, 0xfb, 0x4f, 0x3e, 0xac, 0xf7, 0xcd, 0x77, 0xf5, 0x9e, 0x7c,
+	0x07, 0x4d, 0xc5, 0x66, 0x18, 0xcf, 0x13, 0xf4, 0x5b, 0x27, 0xde, 0xa3, 0xfd, 0xaf, 0x83, 0xe1,
+	0x3d, 0x4b, 0x0c, 0x5d, 0x53, 0x9f, 0x3a, 0x65, 0x58, 0xe6, 0x90, 0x13, 0xd8, 0x9f, 0xa0, 0xc0,
+	0x6b, 0xce, 0x38, 0xcd, 0x57, 0x3e, 0x98, 0x81, 0x54, 0x11, 0x19, 0xc1, 0x83, 0x0c, 0x45, 0xcc,
+	0xc5, 0x34, 0xaa, 0x2a, 0xf7, 0x8d, 0x92, 0xb8, 0xd0, 0x45, 0x25, 0xe1, 0x7b, 0x38, 0x7e, 0x47,
+	0x42, 0x94, 0xe5, 0x32, 0x93, 0x0a, 0x73, 0xff, 0xc0, 0x64, 0x1e, 0xdd, 0xcf, 0xbc, 0x74, 0x0a,
+	0xf2, 0x0c, 0xfa, 0x19, 0xcd, 0x35, 0xa7, 0x49, 0xb4, 0x9e, 0x86, 0xf2, 0xdb, 0x27, 0xb5, 0xf7,
+	0xbc, 0xdd, 0xa5, 0xd5, 0x8e, 0x9d, 0xf4, 0xa2, 0xfe, 0xea, 0xcd, 0xe7, 0x3b, 0x61, 0x2f, 0xdb,
+	0xc6, 0x8a, 0x7c, 0x05, 0xfd, 0x18, 0x13, 0x9c, 0x1a, 0x7b, 0x44, 0x89, 0x54, 0x0a, 0x95, 0xdf,
+	0x31, 0x6d, 0xed, 0x6d, 0x02, 0xbf, 0x18, 0x1e, 0x7c, 0x0b, 0xdd, 0xb7, 0xf6, 0x25, 0x04, 0xea,
+	0x66, 0xb0, 0xd6, 0x8d, 0xe6, 0x99, 0x7c, 0x0a, 0x7b, 0x34, 0x2d, 0x9c, 0xea, 0x5c, 0xe8, 0x56,
+	0xc1, 0x5f, 0x1e, 0x74, 0xdf, 0xea, 0x3a, 0xf9, 0x02, 0x3a, 0x2c, 0xe1, 0xd7, 0xd7, 0x51, 0x3c,
+	0xcf, 0x37, 0x17, 0xa3, 0x1e, 0xb6, 0x0d, 0x7d, 0xe2, 0x60, 0xe1, 0xbb, 0x85, 0xcd, 0xdc, 0x08,
+	0x9d, 0xc5, 0x1d, 0x2f, 0xa5, 0xa7, 0xe0, 0xac, 0xb8, 0x51, 0x5a, 0x97, 0x77, 0x2c, 0x2e, 0x85,
+	0x0f, 0xa1, 0x9d, 0x4a, 0xa1, 0x67, 0xc9, 0x2a, 0x52, 0x1a, 0x33, 0x65, 0xcc, 0xde, 0x0c, 0x0f,
+	0x1c, 0x7c, 0x5a, 0xb0, 0xe0, 0x8d, 0x07, 0xfd, 0xca, 0x38, 0xc6, 0x33, 0x2a, 0xa6, 0x48, 0x3e,
+	0x03, 0xa0, 0xf6, 0x32, 0x46, 0xe5, 0x4d, 0x6c, 0x39, 0xf2, 0x53, 0x4c, 0x0e, 0xa1, 0xc1, 0x45,
+	0x8c, 0x2f, 0x5d, 0x89, 0x76, 0x41, 0xce, 0xe0, 0x30, 0xcb, 0x71, 0xc1, 0xe5, 0x5c, 0x6d, 0xb9,
+	0xa6, 0x66, 0x66, 0xff, 0x60, 0x1d, 0xab, 0xda, 0xe6, 0x14, 0xba, 0x02, 0x97, 0x5b, 0xea, 0xba,
+	0x51, 0x77, 0x04, 0x2e, 0xab, 0xc2, 0x23, 0x68, 0x96, 0x5e, 0x6a, 0x18, 0x45, 0xb9, 0x26, 0xc7,
+	0xd0, 0x2a, 0xc6, 0xa2, 0x34, 0x4d, 0x33, 0x77, 0x01, 0x37, 0x20, 0x40, 0xe8, 0x14, 0x9f, 0x98,
+	0x27, 0xe5, 0xac, 0xff, 0xef, 0xe5, 0x8e, 0xa1, 0xb5, 0xa0, 0x09, 0x8f, 0xa9, 0x96, 0xb9, 0xfb,
+	0x58, 0x6d, 0x40, 0x65, 0xf6, 0xb5, 0xad, 0xd9, 0xff, 0xed, 0x41, 0xbb, 0x38, 0xe7, 0x99, 0x98,
+	0x48, 0xe3, 0xf1, 0x8f, 0x3b, 0xe6, 0x14, 0xba, 0x2c, 0x47, 0x6b, 0xda, 0x19, 0xf2, 0xe9, 0xcc,
+	0x9e, 0x57, 0x0b, 0x3b, 0x6b, 0xfc, 0xa3, 0xa1, 0x46, 0x28, 0xd3, 0x2c, 0x41, 0x23, 0x35, 0x56,
+	0xad, 0x3b, 0x61, 0x89, 0xaf, 0xb6, 0x4d, 0xdb, 0xd8, 0x2a, 0xfc, 0x77, 0x0f, 0x7a, 0x45, 0xe1,
+	0x97, 0xf6, 0x6a, 0x9a, 0x6f, 0xcc, 0x07, 0xd4, 0x9e, 0x23, 0xe3, 0x19, 0x47, 0x77, 0x07, 0x5a,
+	0xe1, 0x06, 0xbc, 0xaf, 0x45, 0x45, 0x16, 0x5d, 0xff, 0x8d, 0xdc, 0x98, 0x37, 0xe0, 0x62, 0xfc,
+	0xea, 0x76, 0xe0, 0xbd, 0xbe, 0x1d, 0x78, 0xff, 0xde, 0x0e, 0xbc, 0x3f, 0xef, 0x06, 0x3b, 0xaf,
+	0xef, 0x06, 0x3b, 0xff, 0xdc, 0x0d, 0x76, 0x7e, 0x7b, 0x3c, 0xe5, 0x7a, 0x36, 0x9f, 0x0c, 0x99,
+	0x4c, 0x47, 0x3f, 0xbf, 0x78, 0xfe, 0xc3, 0xaf, 0xa8, 0x97, 0x32, 0xbf, 0x19, 0xb1, 0x19, 0xe5,
+	0x62, 0xf4, 0xd2, 0xfe, 0x26, 0xf5, 0x2a, 0x43, 0x35, 0xd9, 0x33, 0xff, 0xbd, 0x6f, 0xfe, 0x1b,
+	0x00, 0x7b, 0xa0, 0x45, 0x62, 0x40, 0x07, 0x00, 0x00,
+}
+
+func (m *Authority) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Authority) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Authority) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RewardsClaimed != 0 {
+		i = encodeVarintTeam(dAtA, i, uint64(m.RewardsClaimed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TotalRewards != 0 {
+		i = encodeVarintTeam(dAtA, i, uint64(m.TotalRewards))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TeamAuthorities) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TeamAuthorities) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TeamAuthorities) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bcp) > 0 {
+		i -= len(m.Bcp)
+		copy(dAtA[i:], m.Bcp)
+		i = encodeVarintTeam(dAtA, i, uint64(len(m.Bcp)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Foundation) > 0 {
+		i -= len(m.Foundation)
+		copy(dAtA[i:], m.Foundation)
+		i = encodeVarintTeam(dAtA, i, uint64(len(m.Foundation)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TeamVestingAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TeamVestingAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TeamVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DelegationLosses != 0 {
+		i = encodeVarintTeam(dAtA, i, uint64(m.DelegationLosses))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.PartialClawbacks) > 0 {
+		for iNdEx := len(m.PartialClawbacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PartialClawbacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTeam(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.PendingBeneficiaryProposer) > 0 {
+		i -= len(m.PendingBeneficiaryProposer)
+		copy(dAtA[i:], m.PendingBeneficiaryProposer)
+		i = encodeVarintTeam(dAtA, i, uint64(len(m.PendingBeneficiaryProposer)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.PendingBeneficiary) > 0 {
+		i -= len(m.PendingBeneficiary)
+		copy(dAtA[i:], m.PendingBeneficiary)
+		i = encodeVarintTeam(dAtA, i, uint64(len(m.PendingBeneficiary)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Beneficiary) > 0 {
+		i -= len(m.Beneficiary)
+		copy(dAtA[i:], m.Beneficiary)
+		i = encodeVarintTeam(dAtA, i, uint64(len(m.Beneficiary)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Schedule != nil {
+		{
+			size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTeam(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.RewardsClaimed != 0 {
+		i = encodeVarintTeam(dAtA, i, uint64(m.RewardsClaimed))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.TotalRewards != 0 {
+		i = encodeVarintTeam(dAtA, i, uint64(m.TotalRewards))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.LastClaimedTime != 0 {
+		i = encodeVarintTeam(dAtA, i, uint64(m.LastClaimedTime))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.UnlockedClaimed != 0 {
+		i = encodeVarintTeam(dAtA, i, uint64(m.UnlockedClaimed))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Clawback != 0 {
+		i = encodeVarintTeam(dAtA, i, uint64(m.Clawback))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Commencement != 0 {
+		i = encodeVarintTeam(dAtA, i, uint64(m.Commencement))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TotalAllocation != 0 {
+		i = encodeVarintTeam(dAtA, i, uint64(m.TotalAllocation))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintTeam(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PartialClawback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PartialClawback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PartialClawback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintTeam(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Time != 0 {
+		i = encodeVarintTeam(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VestingSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VestingSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MonthlySteps {
+		i--
+		if m.MonthlySteps {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UnlockDuration != 0 {
+		i = encodeVarintTeam(dAtA, i, uint64(m.UnlockDuration))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.VestingDuration != 0 {
+		i = encodeVarintTeam(dAtA, i, uint64(m.VestingDuration))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CliffDuration != 0 {
+		i = encodeVarintTeam(dAtA, i, uint64(m.CliffDuration))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BeneficiaryChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BeneficiaryChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeneficiaryChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		i = encodeVarintTeam(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintTeam(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.NewBeneficiary) > 0 {
+		i -= len(m.NewBeneficiary)
+		copy(dAtA[i:], m.NewBeneficiary)
+		i = encodeVarintTeam(dAtA, i, uint64(len(m.NewBeneficiary)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PreviousBeneficiary) > 0 {
+		i -= len(m.PreviousBeneficiary)
+		copy(dAtA[i:], m.PreviousBeneficiary)
+		i = encodeVarintTeam(dAtA, i, uint64(len(m.PreviousBeneficiary)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Index != 0 {
+		i = encodeVarintTeam(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AccountId != 0 {
+		i = encodeVarintTeam(dAtA, i, uint64(m.AccountId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TeamDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TeamDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TeamDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintTeam(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTeam(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AccountId != 0 {
+		i = encodeVarintTeam(dAtA, i, uint64(m.AccountId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TeamUnbonding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TeamUnbonding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TeamUnbonding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintTeam(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CompletionTime != 0 {
+		i = encodeVarintTeam(dAtA, i, uint64(m.CompletionTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CreationHeight != 0 {
+		i = encodeVarintTeam(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTeam(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AccountId != 0 {
+		i = encodeVarintTeam(dAtA, i, uint64(m.AccountId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TeamPendingClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TeamPendingClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TeamPendingClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTeam(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Amount != 0 {
+		i = encodeVarintTeam(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTeam(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AccountId != 0 {
+		i = encodeVarintTeam(dAtA, i, uint64(m.AccountId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTeam(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTeam(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Authority) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TotalRewards != 0 {
+		n += 1 + sovTeam(uint64(m.TotalRewards))
+	}
+	if m.RewardsClaimed != 0 {
+		n += 1 + sovTeam(uint64(m.RewardsClaimed))
+	}
+	return n
+}
+
+func (m *TeamAuthorities) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Foundation)
+	if l > 0 {
+		n += 1 + l + sovTeam(uint64(l))
+	}
+	l = len(m.Bcp)
+	if l > 0 {
+		n += 1 + l + sovTeam(uint64(l))
+	}
+	return n
+}
+
+func (m *TeamVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTeam(uint64(m.Id))
+	}
+	if m.TotalAllocation != 0 {
+		n += 1 + sovTeam(uint64(m.TotalAllocation))
+	}
+	if m.Commencement != 0 {
+		n += 1 + sovTeam(uint64(m.Commencement))
+	}
+	if m.Clawback != 0 {
+		n += 1 + sovTeam(uint64(m.Clawback))
+	}
+	if m.UnlockedClaimed != 0 {
+		n += 1 + sovTeam(uint64(m.UnlockedClaimed))
+	}
+	if m.LastClaimedTime != 0 {
+		n += 1 + sovTeam(uint64(m.LastClaimedTime))
+	}
+	if m.TotalRewards != 0 {
+		n += 1 + sovTeam(uint64(m.TotalRewards))
+	}
+	if m.RewardsClaimed != 0 {
+		n += 1 + sovTeam(uint64(m.RewardsClaimed))
+	}
+	if m.Schedule != nil {
+		l = m.Schedule.Size()
+		n += 1 + l + sovTeam(uint64(l))
+	}
+	l = len(m.Beneficiary)
+	if l > 0 {
+		n += 1 + l + sovTeam(uint64(l))
+	}
+	l = len(m.PendingBeneficiary)
+	if l > 0 {
+		n += 1 + l + sovTeam(uint64(l))
+	}
+	l = len(m.PendingBeneficiaryProposer)
+	if l > 0 {
+		n += 1 + l + sovTeam(uint64(l))
+	}
+	if len(m.PartialClawbacks) > 0 {
+		for _, e := range m.PartialClawbacks {
+			l = e.Size()
+			n += 1 + l + sovTeam(uint64(l))
+		}
+	}
+	if m.DelegationLosses != 0 {
+		n += 1 + sovTeam(uint64(m.DelegationLosses))
+	}
+	return n
+}
+
+func (m *PartialClawback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Time != 0 {
+		n += 1 + sovTeam(uint64(m.Time))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTeam(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *VestingSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CliffDuration != 0 {
+		n += 1 + sovTeam(uint64(m.CliffDuration))
+	}
+	if m.VestingDuration != 0 {
+		n += 1 + sovTeam(uint64(m.VestingDuration))
+	}
+	if m.UnlockDuration != 0 {
+		n += 1 + sovTeam(uint64(m.UnlockDuration))
+	}
+	if m.MonthlySteps {
+		n += 2
+	}
+	return n
+}
+
+func (m *BeneficiaryChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AccountId != 0 {
+		n += 1 + sovTeam(uint64(m.AccountId))
+	}
+	if m.Index != 0 {
+		n += 1 + sovTeam(uint64(m.Index))
+	}
+	l = len(m.PreviousBeneficiary)
+	if l > 0 {
+		n += 1 + l + sovTeam(uint64(l))
+	}
+	l = len(m.NewBeneficiary)
+	if l > 0 {
+		n += 1 + l + sovTeam(uint64(l))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovTeam(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovTeam(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *TeamDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AccountId != 0 {
+		n += 1 + sovTeam(uint64(m.AccountId))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTeam(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTeam(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *TeamUnbonding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AccountId != 0 {
+		n += 1 + sovTeam(uint64(m.AccountId))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTeam(uint64(l))
+	}
+	if m.CreationHeight != 0 {
+		n += 1 + sovTeam(uint64(m.CreationHeight))
+	}
+	if m.CompletionTime != 0 {
+		n += 1 + sovTeam(uint64(m.CompletionTime))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTeam(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *TeamPendingClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AccountId != 0 {
+		n += 1 + sovTeam(uint64(m.AccountId))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTeam(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTeam(uint64(m.Amount))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTeam(uint64(l))
+	}
+	return n
+}
+
+func sovTeam(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTeam(x uint64) (n int) {
+	return sovTeam(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Authority) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTeam
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Authority: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Authority: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRewards", wireType)
+			}
+			m.TotalRewards = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTeam
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalRewards |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsClaimed", wireType)
+			}
+			m.RewardsClaimed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTeam
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardsClaimed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTeam(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTeam
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TeamAuthorities) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTeam
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TeamAuthorities: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TeamAuthorities: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Foundation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTeam
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTeam
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTeam
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Foundation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bcp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTeam
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTeam
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTeam
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bcp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTeam(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTeam
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TeamVestingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTeam
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TeamVestingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TeamVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTeam
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAllocation", wireType)
+			}
+			m.TotalAllocation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTeam
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalAllocation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commencement", wireType)
+			}
+			m.Commencement = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTeam
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Commencement |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clawback", wireType)
+			}
+			m.Clawback = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTeam
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Clawback |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockedClaimed", wireType)
+			}
+			m.UnlockedClaimed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTeam
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnlockedClaimed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastClaimedTime", wireType)
+			}
+			m.LastClaimedTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTeam
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastClaimedTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRewards", wireType)
+			}
+			m.TotalRewards = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTeam
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalRewards |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsClaimed", wireType)
+			}
+			m.RewardsClaimed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTeam
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardsClaimed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTeam
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTeam
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTeam
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Schedule == nil {
+				m.Schedule = &VestingSchedule{}
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTeam
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTeam
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTeam
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Beneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingBeneficiary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTeam
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTeam
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTeam
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingBeneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingBeneficiaryProposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTeam
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTeam
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTeam
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingBeneficiaryProposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartialClawbacks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTeam
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTeam
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTeam
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PartialClawbacks = append(m.PartialClawbacks, PartialClawback{})
+			if err := m.PartialClawbacks[len(m.PartialClawbacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationLosses", wireType)
+			}
+			m.DelegationLosses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTeam
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelegationLosses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTeam(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTeam
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PartialClawback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTeam
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PartialClawback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PartialClawback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTeam
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTeam
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTeam(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTeam
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VestingSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTeam
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VestingSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VestingSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CliffDuration", wireType)
+			}
+			m.CliffDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTeam
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CliffDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingDuration", wireType)
+			}
+			m.VestingDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTeam
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VestingDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockDuration", wireType)
+			}
+			m.UnlockDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTeam
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnlockDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonthlySteps", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTeam
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MonthlySteps = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTeam(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTeam
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BeneficiaryChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTeam
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BeneficiaryChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BeneficiaryChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountId", wireType)
+			}
+			m.AccountId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTeam
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTeam
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousBeneficiary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTeam
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTeam
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTeam
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousBeneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewBeneficiary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTeam
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTeam
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTeam
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewBeneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTeam
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTeam
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTeam
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTeam
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTeam(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTeam
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *TeamVestingAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTeam(uint64(m.Id))
-	}
-	if m.TotalAllocation != 0 {
-		n += 1 + sovTeam(uint64(m.TotalAllocation))
-	}
-	if m.Commencement != 0 {
-		n += 1 + sovTeam(uint64(m.Commencement))
-	}
-	if m.Clawback != 0 {
-		n += 1 + sovTeam(uint64(m.Clawback))
-	}
-	if m.UnlockedClaimed != 0 {
-		n += 1 + sovTeam(uint64(m.UnlockedClaimed))
-	}
-	if m.LastClaimedTime != 0 {
-		n += 1 + sovTeam(uint64(m.LastClaimedTime))
-	}
-	if m.TotalRewards != 0 {
-		n += 1 + sovTeam(uint64(m.TotalRewards))
-	}
-	if m.RewardsClaimed != 0 {
-		n += 1 + sovTeam(uint64(m.RewardsClaimed))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTeam(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTeam(x uint64) (n int) {
-	return sovTeam(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Authority) Unmarshal(dAtA []byte) error {
+func (m *TeamDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -404,17 +2555,17 @@ func (m *Authority) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Authority: wiretype end group for non-group")
+			return fmt.Errorf("proto: TeamDelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Authority: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TeamDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountId", wireType)
 			}
-			m.TotalRewards = 0
+			m.AccountId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTeam
@@ -424,16 +2575,48 @@ func (m *Authority) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalRewards |= uint64(b&0x7F) << shift
+				m.AccountId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTeam
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTeam
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTeam
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardsClaimed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.RewardsClaimed = 0
+			m.Amount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTeam
@@ -443,7 +2626,7 @@ func (m *Authority) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RewardsClaimed |= uint64(b&0x7F) << shift
+				m.Amount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -469,7 +2652,7 @@ func (m *Authority) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TeamVestingAccount) Unmarshal(dAtA []byte) error {
+func (m *TeamUnbonding) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -492,17 +2675,17 @@ func (m *TeamVestingAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TeamVestingAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: TeamUnbonding: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TeamVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TeamUnbonding: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountId", wireType)
 			}
-			m.Id = 0
+			m.AccountId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTeam
@@ -512,16 +2695,16 @@ func (m *TeamVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.AccountId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalAllocation", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
-			m.TotalAllocation = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTeam
@@ -531,16 +2714,29 @@ func (m *TeamVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalAllocation |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTeam
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTeam
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commencement", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
 			}
-			m.Commencement = 0
+			m.CreationHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTeam
@@ -550,16 +2746,16 @@ func (m *TeamVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Commencement |= uint64(b&0x7F) << shift
+				m.CreationHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Clawback", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
 			}
-			m.Clawback = 0
+			m.CompletionTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTeam
@@ -569,16 +2765,16 @@ func (m *TeamVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Clawback |= uint64(b&0x7F) << shift
+				m.CompletionTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnlockedClaimed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.UnlockedClaimed = 0
+			m.Amount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTeam
@@ -588,16 +2784,66 @@ func (m *TeamVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnlockedClaimed |= uint64(b&0x7F) << shift
+				m.Amount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTeam(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTeam
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TeamPendingClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTeam
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TeamPendingClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TeamPendingClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastClaimedTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountId", wireType)
 			}
-			m.LastClaimedTime = 0
+			m.AccountId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTeam
@@ -607,16 +2853,16 @@ func (m *TeamVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastClaimedTime |= uint64(b&0x7F) << shift
+				m.AccountId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalRewards", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
-			m.TotalRewards = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTeam
@@ -626,16 +2872,29 @@ func (m *TeamVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalRewards |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTeam
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTeam
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardsClaimed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.RewardsClaimed = 0
+			m.Amount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTeam
@@ -645,11 +2904,43 @@ func (m *TeamVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RewardsClaimed |= uint64(b&0x7F) << shift
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTeam
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTeam
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTeam
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTeam(dAtA[iNdEx:])
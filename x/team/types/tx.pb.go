@@ -5,9 +5,11 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	grpc "google.golang.org/grpc"
@@ -32,6 +34,7 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // MsgClaimUnlockedTokens ...
 type MsgClaimUnlocked struct {
 	// authority is the foundation which is allowed to payout unlocked tokens
+	// or the beneficiary of the team vesting account
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 	// id is the unique identifier of the team member
 	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
@@ -243,6 +246,7 @@ var xxx_messageInfo_MsgClaimAuthorityRewardsResponse proto.InternalMessageInfo
 // MsgClaimAccountRewards ...
 type MsgClaimAccountRewards struct {
 	// authority is the foundation which is allowed to payout unlocked tokens
+	// or the beneficiary of the team vesting account
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 	// id is the unique identifier of the team member
 	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
@@ -451,6 +455,232 @@ func (m *MsgClawbackResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgClawbackResponse proto.InternalMessageInfo
 
+// MsgPartialClawback ...
+type MsgPartialClawback struct {
+	// authority is the foundation which is allowed to modify team accounts
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// id is the unique identifier of the team member
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// amount is the number of tokens which should be removed from the allocation.
+	// Either amount or fraction has to be set.
+	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// fraction is the share of the not yet clawed back allocation which should
+	// be removed. Either amount or fraction has to be set.
+	Fraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=fraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fraction"`
+	// time is a unix timestamp (in seconds) of when the partial clawback should be applied.
+	// If zero the partial clawback is applied from the current block on.
+	Time uint64 `protobuf:"varint,5,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (m *MsgPartialClawback) Reset()         { *m = MsgPartialClawback{} }
+func (m *MsgPartialClawback) String() string { return proto.CompactTextString(m) }
+func (*MsgPartialClawback) ProtoMessage()    {}
+func (*MsgPartialClawback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ad042ec4c659ded, []int{8}
+}
+func (m *MsgPartialClawback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPartialClawback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPartialClawback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPartialClawback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPartialClawback.Merge(m, src)
+}
+func (m *MsgPartialClawback) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPartialClawback) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPartialClawback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPartialClawback proto.InternalMessageInfo
+
+func (m *MsgPartialClawback) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgPartialClawback) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MsgPartialClawback) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *MsgPartialClawback) GetTime() uint64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+// MsgPartialClawbackResponse defines the Msg/PartialClawback response type.
+type MsgPartialClawbackResponse struct {
+}
+
+func (m *MsgPartialClawbackResponse) Reset()         { *m = MsgPartialClawbackResponse{} }
+func (m *MsgPartialClawbackResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPartialClawbackResponse) ProtoMessage()    {}
+func (*MsgPartialClawbackResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ad042ec4c659ded, []int{9}
+}
+func (m *MsgPartialClawbackResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPartialClawbackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPartialClawbackResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPartialClawbackResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPartialClawbackResponse.Merge(m, src)
+}
+func (m *MsgPartialClawbackResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPartialClawbackResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPartialClawbackResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPartialClawbackResponse proto.InternalMessageInfo
+
+// MsgReverseClawback ...
+type MsgReverseClawback struct {
+	// authority is the foundation which is allowed to modify team accounts
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// id is the unique identifier of the team member
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// full reverses the (full) clawback of the account if true, otherwise the
+	// partial clawback with the given index is reversed.
+	Full bool `protobuf:"varint,3,opt,name=full,proto3" json:"full,omitempty"`
+	// index is the index of the partial clawback which should be reversed.
+	Index uint64 `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (m *MsgReverseClawback) Reset()         { *m = MsgReverseClawback{} }
+func (m *MsgReverseClawback) String() string { return proto.CompactTextString(m) }
+func (*MsgReverseClawback) ProtoMessage()    {}
+func (*MsgReverseClawback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ad042ec4c659ded, []int{10}
+}
+func (m *MsgReverseClawback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReverseClawback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReverseClawback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReverseClawback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReverseClawback.Merge(m, src)
+}
+func (m *MsgReverseClawback) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReverseClawback) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReverseClawback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReverseClawback proto.InternalMessageInfo
+
+func (m *MsgReverseClawback) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgReverseClawback) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MsgReverseClawback) GetFull() bool {
+	if m != nil {
+		return m.Full
+	}
+	return false
+}
+
+func (m *MsgReverseClawback) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+// MsgReverseClawbackResponse defines the Msg/ReverseClawback response type.
+type MsgReverseClawbackResponse struct {
+}
+
+func (m *MsgReverseClawbackResponse) Reset()         { *m = MsgReverseClawbackResponse{} }
+func (m *MsgReverseClawbackResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReverseClawbackResponse) ProtoMessage()    {}
+func (*MsgReverseClawbackResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ad042ec4c659ded, []int{11}
+}
+func (m *MsgReverseClawbackResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReverseClawbackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReverseClawbackResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReverseClawbackResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReverseClawbackResponse.Merge(m, src)
+}
+func (m *MsgReverseClawbackResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReverseClawbackResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReverseClawbackResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReverseClawbackResponse proto.InternalMessageInfo
+
 // MsgCreateTeamVestingAccount ...
 type MsgCreateTeamVestingAccount struct {
 	// authority ...
@@ -459,13 +689,19 @@ type MsgCreateTeamVestingAccount struct {
 	TotalAllocation uint64 `protobuf:"varint,2,opt,name=total_allocation,json=totalAllocation,proto3" json:"total_allocation,omitempty"`
 	// commencement is the unix timestamp of the member's official start date.
 	Commencement uint64 `protobuf:"varint,3,opt,name=commencement,proto3" json:"commencement,omitempty"`
+	// schedule is the (optional) vesting schedule of the account, defaults to
+	// the standard team vesting schedule.
+	Schedule *VestingSchedule `protobuf:"bytes,4,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// beneficiary is the (optional) address of the team member which can claim
+	// the unlocked tokens and inflation rewards of the account.
+	Beneficiary string `protobuf:"bytes,5,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
 }
 
 func (m *MsgCreateTeamVestingAccount) Reset()         { *m = MsgCreateTeamVestingAccount{} }
 func (m *MsgCreateTeamVestingAccount) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateTeamVestingAccount) ProtoMessage()    {}
 func (*MsgCreateTeamVestingAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1ad042ec4c659ded, []int{8}
+	return fileDescriptor_1ad042ec4c659ded, []int{12}
 }
 func (m *MsgCreateTeamVestingAccount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -515,6 +751,20 @@ func (m *MsgCreateTeamVestingAccount) GetCommencement() uint64 {
 	return 0
 }
 
+func (m *MsgCreateTeamVestingAccount) GetSchedule() *VestingSchedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return nil
+}
+
+func (m *MsgCreateTeamVestingAccount) GetBeneficiary() string {
+	if m != nil {
+		return m.Beneficiary
+	}
+	return ""
+}
+
 // MsgCreateTeamVestingAccountResponse defines the Msg/CreateTeamVestingAccount response type.
 type MsgCreateTeamVestingAccountResponse struct {
 }
@@ -523,7 +773,7 @@ func (m *MsgCreateTeamVestingAccountResponse) Reset()         { *m = MsgCreateTe
 func (m *MsgCreateTeamVestingAccountResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateTeamVestingAccountResponse) ProtoMessage()    {}
 func (*MsgCreateTeamVestingAccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1ad042ec4c659ded, []int{9}
+	return fileDescriptor_1ad042ec4c659ded, []int{13}
 }
 func (m *MsgCreateTeamVestingAccountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -552,794 +802,3749 @@ func (m *MsgCreateTeamVestingAccountResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCreateTeamVestingAccountResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgClaimUnlocked)(nil), "kyve.team.v1beta1.MsgClaimUnlocked")
-	proto.RegisterType((*MsgClaimUnlockedResponse)(nil), "kyve.team.v1beta1.MsgClaimUnlockedResponse")
-	proto.RegisterType((*MsgClaimAuthorityRewards)(nil), "kyve.team.v1beta1.MsgClaimAuthorityRewards")
-	proto.RegisterType((*MsgClaimAuthorityRewardsResponse)(nil), "kyve.team.v1beta1.MsgClaimAuthorityRewardsResponse")
-	proto.RegisterType((*MsgClaimAccountRewards)(nil), "kyve.team.v1beta1.MsgClaimAccountRewards")
-	proto.RegisterType((*MsgClaimAccountRewardsResponse)(nil), "kyve.team.v1beta1.MsgClaimAccountRewardsResponse")
-	proto.RegisterType((*MsgClawback)(nil), "kyve.team.v1beta1.MsgClawback")
-	proto.RegisterType((*MsgClawbackResponse)(nil), "kyve.team.v1beta1.MsgClawbackResponse")
-	proto.RegisterType((*MsgCreateTeamVestingAccount)(nil), "kyve.team.v1beta1.MsgCreateTeamVestingAccount")
-	proto.RegisterType((*MsgCreateTeamVestingAccountResponse)(nil), "kyve.team.v1beta1.MsgCreateTeamVestingAccountResponse")
+// MsgProposeBeneficiary ...
+type MsgProposeBeneficiary struct {
+	// creator is either the authority or the current beneficiary of the account
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// id is the unique identifier of the team member
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// new_beneficiary is the address which should receive the beneficiary rights
+	NewBeneficiary string `protobuf:"bytes,3,opt,name=new_beneficiary,json=newBeneficiary,proto3" json:"new_beneficiary,omitempty"`
 }
 
-func init() { proto.RegisterFile("kyve/team/v1beta1/tx.proto", fileDescriptor_1ad042ec4c659ded) }
-
-var fileDescriptor_1ad042ec4c659ded = []byte{
-	// 568 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x4f, 0x6f, 0xd3, 0x4e,
-	0x10, 0xed, 0xa6, 0xf9, 0x55, 0xed, 0xfc, 0xa0, 0x14, 0x97, 0x16, 0xb3, 0x48, 0x56, 0x94, 0x0a,
-	0xd4, 0x14, 0x61, 0x2b, 0x54, 0xea, 0x81, 0x5b, 0x5a, 0x71, 0x42, 0xe5, 0x10, 0xa0, 0x12, 0x5c,
-	0xaa, 0xcd, 0x7a, 0xe5, 0xac, 0x12, 0x7b, 0x23, 0xef, 0x26, 0x69, 0x6e, 0xd0, 0x4f, 0xc0, 0x47,
-	0xa9, 0x50, 0x0f, 0x88, 0x13, 0x47, 0x8e, 0x15, 0x27, 0x8e, 0x28, 0x39, 0xf4, 0x6b, 0x20, 0xc7,
-	0xce, 0x86, 0xfc, 0x71, 0x68, 0x03, 0xe2, 0x14, 0xcd, 0xbc, 0x37, 0x33, 0xef, 0x65, 0xe4, 0x59,
-	0xc0, 0xb5, 0x4e, 0x8b, 0x39, 0x8a, 0x11, 0xdf, 0x69, 0x15, 0x2b, 0x4c, 0x91, 0xa2, 0xa3, 0x4e,
-	0xec, 0x46, 0x28, 0x94, 0x30, 0x6e, 0x47, 0x98, 0x1d, 0x61, 0x76, 0x82, 0xe1, 0xbb, 0x54, 0x48,
-	0x5f, 0x48, 0xc7, 0x97, 0x9e, 0xd3, 0x2a, 0x46, 0x3f, 0x31, 0x17, 0xdf, 0x8b, 0x81, 0xe3, 0x7e,
-	0xe4, 0xc4, 0x41, 0x0c, 0xe5, 0x3f, 0x23, 0x58, 0x3b, 0x94, 0xde, 0x41, 0x9d, 0x70, 0xff, 0x75,
-	0x50, 0x17, 0xb4, 0xc6, 0x5c, 0x63, 0x0f, 0x56, 0x48, 0x53, 0x55, 0x45, 0xc8, 0x55, 0xc7, 0x44,
-	0x39, 0xb4, 0xbd, 0xb2, 0x6f, 0x7e, 0x3b, 0x7f, 0x7c, 0x27, 0xa9, 0x2c, 0xb9, 0x6e, 0xc8, 0xa4,
-	0x7c, 0xa9, 0x42, 0x1e, 0x78, 0xe5, 0x21, 0xd5, 0x58, 0x85, 0x0c, 0x77, 0xcd, 0x4c, 0x0e, 0x6d,
-	0x67, 0xcb, 0x19, 0xee, 0x1a, 0x9b, 0xb0, 0x44, 0x7c, 0xd1, 0x0c, 0x94, 0xb9, 0xd8, 0xcf, 0x25,
-	0x51, 0xd4, 0x3f, 0x64, 0x94, 0x37, 0x38, 0x0b, 0x94, 0x99, 0xfd, 0x5d, 0x7f, 0x4d, 0x7d, 0xba,
-	0x7a, 0x7a, 0x79, 0xb6, 0x33, 0x9c, 0x97, 0xc7, 0x60, 0x8e, 0x6b, 0x2f, 0x33, 0xd9, 0x10, 0x81,
-	0x64, 0xf9, 0x8f, 0x68, 0x08, 0x96, 0x06, 0x15, 0x65, 0xd6, 0x26, 0xa1, 0x2b, 0xe7, 0x36, 0x38,
-	0x34, 0x94, 0x49, 0x37, 0xb4, 0x38, 0xbf, 0xa1, 0x3c, 0xe4, 0xd2, 0x34, 0x6b, 0x63, 0x5f, 0x10,
-	0x6c, 0x6a, 0x12, 0xa5, 0xd1, 0xfc, 0x3f, 0xb5, 0xf5, 0xaf, 0xf7, 0x96, 0x03, 0x6b, 0xba, 0x03,
-	0x6d, 0xf2, 0x3d, 0x82, 0xff, 0x63, 0x4a, 0xbb, 0x42, 0x68, 0xed, 0xaf, 0x39, 0xc3, 0xb0, 0x4c,
-	0x93, 0x9e, 0x89, 0x37, 0x1d, 0x4f, 0xa8, 0xdc, 0x80, 0xf5, 0x5f, 0x24, 0x68, 0x69, 0xe7, 0x08,
-	0xee, 0x47, 0xf9, 0x90, 0x11, 0xc5, 0x5e, 0x31, 0xe2, 0x1f, 0x31, 0xa9, 0x78, 0xe0, 0x25, 0x4e,
-	0xe6, 0x96, 0x5a, 0x80, 0x35, 0x25, 0x14, 0xa9, 0x1f, 0x93, 0x7a, 0x5d, 0x50, 0xa2, 0xb8, 0x08,
-	0x12, 0xe1, 0xb7, 0xfa, 0xf9, 0x92, 0x4e, 0x1b, 0x79, 0xb8, 0x41, 0x85, 0xef, 0xb3, 0x80, 0x32,
-	0x9f, 0xe9, 0x2d, 0x8d, 0xe4, 0x26, 0xdc, 0x3c, 0x80, 0xad, 0x19, 0xaa, 0x07, 0xee, 0x9e, 0x7c,
-	0xca, 0xc2, 0xe2, 0xa1, 0xf4, 0x0c, 0x02, 0x37, 0x47, 0x6f, 0xc2, 0x96, 0x3d, 0x71, 0x70, 0xec,
-	0xf1, 0x8f, 0x0f, 0x3f, 0xba, 0x02, 0x69, 0x30, 0xca, 0x28, 0xc3, 0xb2, 0xde, 0xaf, 0x95, 0x5a,
-	0xd8, 0xc7, 0xf1, 0xc3, 0xd9, 0xb8, 0xee, 0x79, 0x8a, 0xc0, 0x4c, 0xdd, 0x8c, 0x9d, 0xd2, 0x24,
-	0x85, 0x8f, 0xf7, 0xae, 0xc7, 0xd7, 0x22, 0x3a, 0xb0, 0x31, 0xfd, 0xec, 0xcc, 0xfa, 0x7b, 0xc6,
-	0xc9, 0x78, 0xf7, 0x1a, 0x64, 0x3d, 0x5a, 0xc2, 0xfa, 0xb4, 0xc3, 0x50, 0x98, 0xd5, 0x6b, 0x84,
-	0x8a, 0x8b, 0x57, 0xa6, 0x0e, 0x86, 0xe2, 0xff, 0xde, 0x5d, 0x9e, 0xed, 0xa0, 0xfd, 0x83, 0xaf,
-	0x5d, 0x0b, 0x5d, 0x74, 0x2d, 0xf4, 0xa3, 0x6b, 0xa1, 0x0f, 0x3d, 0x6b, 0xe1, 0xa2, 0x67, 0x2d,
-	0x7c, 0xef, 0x59, 0x0b, 0x6f, 0x0b, 0x1e, 0x57, 0xd5, 0x66, 0xc5, 0xa6, 0xc2, 0x77, 0x9e, 0xbf,
-	0x39, 0x7a, 0xf6, 0x82, 0xa9, 0xb6, 0x08, 0x6b, 0x0e, 0xad, 0x12, 0x1e, 0x38, 0x27, 0xf1, 0x0b,
-	0xa7, 0x3a, 0x0d, 0x26, 0x2b, 0x4b, 0xfd, 0x67, 0x69, 0xf7, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x04, 0xce, 0xde, 0x1c, 0xfb, 0x06, 0x00, 0x00,
+func (m *MsgProposeBeneficiary) Reset()         { *m = MsgProposeBeneficiary{} }
+func (m *MsgProposeBeneficiary) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeBeneficiary) ProtoMessage()    {}
+func (*MsgProposeBeneficiary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ad042ec4c659ded, []int{14}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// ClaimUnlocked ...
-	ClaimUnlocked(ctx context.Context, in *MsgClaimUnlocked, opts ...grpc.CallOption) (*MsgClaimUnlockedResponse, error)
-	// Clawback ...
-	Clawback(ctx context.Context, in *MsgClawback, opts ...grpc.CallOption) (*MsgClawbackResponse, error)
-	// CreateTeamVestingAccount ...
-	CreateTeamVestingAccount(ctx context.Context, in *MsgCreateTeamVestingAccount, opts ...grpc.CallOption) (*MsgCreateTeamVestingAccountResponse, error)
-	// ClaimAuthorityRewards ...
-	ClaimAuthorityRewards(ctx context.Context, in *MsgClaimAuthorityRewards, opts ...grpc.CallOption) (*MsgClaimAuthorityRewardsResponse, error)
-	// ClaimInflationRewards ...
-	ClaimAccountRewards(ctx context.Context, in *MsgClaimAccountRewards, opts ...grpc.CallOption) (*MsgClaimAccountRewardsResponse, error)
+func (m *MsgProposeBeneficiary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgProposeBeneficiary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeBeneficiary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgProposeBeneficiary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeBeneficiary.Merge(m, src)
 }
-
-func (c *msgClient) ClaimUnlocked(ctx context.Context, in *MsgClaimUnlocked, opts ...grpc.CallOption) (*MsgClaimUnlockedResponse, error) {
-	out := new(MsgClaimUnlockedResponse)
-	err := c.cc.Invoke(ctx, "/kyve.team.v1beta1.Msg/ClaimUnlocked", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgProposeBeneficiary) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposeBeneficiary) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeBeneficiary.DiscardUnknown(m)
 }
 
-func (c *msgClient) Clawback(ctx context.Context, in *MsgClawback, opts ...grpc.CallOption) (*MsgClawbackResponse, error) {
-	out := new(MsgClawbackResponse)
-	err := c.cc.Invoke(ctx, "/kyve.team.v1beta1.Msg/Clawback", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgProposeBeneficiary proto.InternalMessageInfo
+
+func (m *MsgProposeBeneficiary) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CreateTeamVestingAccount(ctx context.Context, in *MsgCreateTeamVestingAccount, opts ...grpc.CallOption) (*MsgCreateTeamVestingAccountResponse, error) {
-	out := new(MsgCreateTeamVestingAccountResponse)
-	err := c.cc.Invoke(ctx, "/kyve.team.v1beta1.Msg/CreateTeamVestingAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgProposeBeneficiary) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) ClaimAuthorityRewards(ctx context.Context, in *MsgClaimAuthorityRewards, opts ...grpc.CallOption) (*MsgClaimAuthorityRewardsResponse, error) {
-	out := new(MsgClaimAuthorityRewardsResponse)
-	err := c.cc.Invoke(ctx, "/kyve.team.v1beta1.Msg/ClaimAuthorityRewards", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgProposeBeneficiary) GetNewBeneficiary() string {
+	if m != nil {
+		return m.NewBeneficiary
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) ClaimAccountRewards(ctx context.Context, in *MsgClaimAccountRewards, opts ...grpc.CallOption) (*MsgClaimAccountRewardsResponse, error) {
-	out := new(MsgClaimAccountRewardsResponse)
-	err := c.cc.Invoke(ctx, "/kyve.team.v1beta1.Msg/ClaimAccountRewards", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgProposeBeneficiaryResponse defines the Msg/ProposeBeneficiary response type.
+type MsgProposeBeneficiaryResponse struct {
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// ClaimUnlocked ...
-	ClaimUnlocked(context.Context, *MsgClaimUnlocked) (*MsgClaimUnlockedResponse, error)
-	// Clawback ...
-	Clawback(context.Context, *MsgClawback) (*MsgClawbackResponse, error)
-	// CreateTeamVestingAccount ...
-	CreateTeamVestingAccount(context.Context, *MsgCreateTeamVestingAccount) (*MsgCreateTeamVestingAccountResponse, error)
-	// ClaimAuthorityRewards ...
-	ClaimAuthorityRewards(context.Context, *MsgClaimAuthorityRewards) (*MsgClaimAuthorityRewardsResponse, error)
-	// ClaimInflationRewards ...
-	ClaimAccountRewards(context.Context, *MsgClaimAccountRewards) (*MsgClaimAccountRewardsResponse, error)
-}
-
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgProposeBeneficiaryResponse) Reset()         { *m = MsgProposeBeneficiaryResponse{} }
+func (m *MsgProposeBeneficiaryResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeBeneficiaryResponse) ProtoMessage()    {}
+func (*MsgProposeBeneficiaryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ad042ec4c659ded, []int{15}
 }
-
-func (*UnimplementedMsgServer) ClaimUnlocked(ctx context.Context, req *MsgClaimUnlocked) (*MsgClaimUnlockedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClaimUnlocked not implemented")
+func (m *MsgProposeBeneficiaryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) Clawback(ctx context.Context, req *MsgClawback) (*MsgClawbackResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Clawback not implemented")
+func (m *MsgProposeBeneficiaryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeBeneficiaryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) CreateTeamVestingAccount(ctx context.Context, req *MsgCreateTeamVestingAccount) (*MsgCreateTeamVestingAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateTeamVestingAccount not implemented")
+func (m *MsgProposeBeneficiaryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeBeneficiaryResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) ClaimAuthorityRewards(ctx context.Context, req *MsgClaimAuthorityRewards) (*MsgClaimAuthorityRewardsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClaimAuthorityRewards not implemented")
+func (m *MsgProposeBeneficiaryResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) ClaimAccountRewards(ctx context.Context, req *MsgClaimAccountRewards) (*MsgClaimAccountRewardsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClaimAccountRewards not implemented")
+func (m *MsgProposeBeneficiaryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeBeneficiaryResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgProposeBeneficiaryResponse proto.InternalMessageInfo
+
+// MsgAcceptBeneficiary ...
+type MsgAcceptBeneficiary struct {
+	// creator is the pending beneficiary of the account
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// id is the unique identifier of the team member
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func _Msg_ClaimUnlocked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgClaimUnlocked)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ClaimUnlocked(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.team.v1beta1.Msg/ClaimUnlocked",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ClaimUnlocked(ctx, req.(*MsgClaimUnlocked))
+func (m *MsgAcceptBeneficiary) Reset()         { *m = MsgAcceptBeneficiary{} }
+func (m *MsgAcceptBeneficiary) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptBeneficiary) ProtoMessage()    {}
+func (*MsgAcceptBeneficiary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ad042ec4c659ded, []int{16}
+}
+func (m *MsgAcceptBeneficiary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptBeneficiary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptBeneficiary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgAcceptBeneficiary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptBeneficiary.Merge(m, src)
+}
+func (m *MsgAcceptBeneficiary) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptBeneficiary) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptBeneficiary.DiscardUnknown(m)
 }
 
-func _Msg_Clawback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgClawback)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Clawback(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.team.v1beta1.Msg/Clawback",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Clawback(ctx, req.(*MsgClawback))
+var xxx_messageInfo_MsgAcceptBeneficiary proto.InternalMessageInfo
+
+func (m *MsgAcceptBeneficiary) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_CreateTeamVestingAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateTeamVestingAccount)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateTeamVestingAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.team.v1beta1.Msg/CreateTeamVestingAccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateTeamVestingAccount(ctx, req.(*MsgCreateTeamVestingAccount))
+func (m *MsgAcceptBeneficiary) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_ClaimAuthorityRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgClaimAuthorityRewards)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ClaimAuthorityRewards(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.team.v1beta1.Msg/ClaimAuthorityRewards",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ClaimAuthorityRewards(ctx, req.(*MsgClaimAuthorityRewards))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgAcceptBeneficiaryResponse defines the Msg/AcceptBeneficiary response type.
+type MsgAcceptBeneficiaryResponse struct {
 }
 
-func _Msg_ClaimAccountRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgClaimAccountRewards)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ClaimAccountRewards(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.team.v1beta1.Msg/ClaimAccountRewards",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ClaimAccountRewards(ctx, req.(*MsgClaimAccountRewards))
+func (m *MsgAcceptBeneficiaryResponse) Reset()         { *m = MsgAcceptBeneficiaryResponse{} }
+func (m *MsgAcceptBeneficiaryResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptBeneficiaryResponse) ProtoMessage()    {}
+func (*MsgAcceptBeneficiaryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ad042ec4c659ded, []int{17}
+}
+func (m *MsgAcceptBeneficiaryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptBeneficiaryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptBeneficiaryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgAcceptBeneficiaryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptBeneficiaryResponse.Merge(m, src)
+}
+func (m *MsgAcceptBeneficiaryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptBeneficiaryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptBeneficiaryResponse.DiscardUnknown(m)
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "kyve.team.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ClaimUnlocked",
-			Handler:    _Msg_ClaimUnlocked_Handler,
-		},
-		{
-			MethodName: "Clawback",
-			Handler:    _Msg_Clawback_Handler,
-		},
-		{
-			MethodName: "CreateTeamVestingAccount",
-			Handler:    _Msg_CreateTeamVestingAccount_Handler,
-		},
-		{
-			MethodName: "ClaimAuthorityRewards",
-			Handler:    _Msg_ClaimAuthorityRewards_Handler,
-		},
-		{
-			MethodName: "ClaimAccountRewards",
-			Handler:    _Msg_ClaimAccountRewards_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "kyve/team/v1beta1/tx.proto",
+var xxx_messageInfo_MsgAcceptBeneficiaryResponse proto.InternalMessageInfo
+
+// MsgUpdateTeamAuthorities defines a SDK message for updating the team authorities.
+type MsgUpdateTeamAuthorities struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// foundation_authority is the new foundation authority address
+	FoundationAuthority string `protobuf:"bytes,2,opt,name=foundation_authority,json=foundationAuthority,proto3" json:"foundation_authority,omitempty"`
+	// bcp_authority is the new bcp authority address
+	BcpAuthority string `protobuf:"bytes,3,opt,name=bcp_authority,json=bcpAuthority,proto3" json:"bcp_authority,omitempty"`
 }
 
-func (m *MsgClaimUnlocked) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateTeamAuthorities) Reset()         { *m = MsgUpdateTeamAuthorities{} }
+func (m *MsgUpdateTeamAuthorities) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateTeamAuthorities) ProtoMessage()    {}
+func (*MsgUpdateTeamAuthorities) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ad042ec4c659ded, []int{18}
+}
+func (m *MsgUpdateTeamAuthorities) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateTeamAuthorities) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateTeamAuthorities.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgClaimUnlocked) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateTeamAuthorities) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateTeamAuthorities.Merge(m, src)
+}
+func (m *MsgUpdateTeamAuthorities) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateTeamAuthorities) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateTeamAuthorities.DiscardUnknown(m)
 }
 
-func (m *MsgClaimUnlocked) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Amount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
+var xxx_messageInfo_MsgUpdateTeamAuthorities proto.InternalMessageInfo
+
+func (m *MsgUpdateTeamAuthorities) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgUpdateTeamAuthorities) GetFoundationAuthority() string {
+	if m != nil {
+		return m.FoundationAuthority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgClaimUnlockedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateTeamAuthorities) GetBcpAuthority() string {
+	if m != nil {
+		return m.BcpAuthority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgClaimUnlockedResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUpdateTeamAuthoritiesResponse defines the Msg/UpdateTeamAuthorities response type.
+type MsgUpdateTeamAuthoritiesResponse struct {
 }
 
-func (m *MsgClaimUnlockedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUpdateTeamAuthoritiesResponse) Reset()         { *m = MsgUpdateTeamAuthoritiesResponse{} }
+func (m *MsgUpdateTeamAuthoritiesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateTeamAuthoritiesResponse) ProtoMessage()    {}
+func (*MsgUpdateTeamAuthoritiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ad042ec4c659ded, []int{19}
 }
-
-func (m *MsgClaimAuthorityRewards) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateTeamAuthoritiesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateTeamAuthoritiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateTeamAuthoritiesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgClaimAuthorityRewards) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateTeamAuthoritiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateTeamAuthoritiesResponse.Merge(m, src)
+}
+func (m *MsgUpdateTeamAuthoritiesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateTeamAuthoritiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateTeamAuthoritiesResponse.DiscardUnknown(m)
 }
 
-func (m *MsgClaimAuthorityRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Amount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgUpdateTeamAuthoritiesResponse proto.InternalMessageInfo
+
+// MsgDelegateTeamTokens ...
+type MsgDelegateTeamTokens struct {
+	// authority is either the foundation or the bcp authority
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// id is the unique identifier of the team member
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// validator is the operator address of the validator the locked tokens get delegated to
+	Validator string `protobuf:"bytes,3,opt,name=validator,proto3" json:"validator,omitempty"`
+	// amount is the amount of locked $KYVE which gets delegated
+	Amount uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (m *MsgClaimAuthorityRewardsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgDelegateTeamTokens) Reset()         { *m = MsgDelegateTeamTokens{} }
+func (m *MsgDelegateTeamTokens) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateTeamTokens) ProtoMessage()    {}
+func (*MsgDelegateTeamTokens) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ad042ec4c659ded, []int{20}
+}
+func (m *MsgDelegateTeamTokens) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateTeamTokens) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateTeamTokens.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgClaimAuthorityRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgDelegateTeamTokens) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateTeamTokens.Merge(m, src)
 }
-
-func (m *MsgClaimAuthorityRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgDelegateTeamTokens) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateTeamTokens) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateTeamTokens.DiscardUnknown(m)
 }
 
-func (m *MsgClaimAccountRewards) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgDelegateTeamTokens proto.InternalMessageInfo
+
+func (m *MsgDelegateTeamTokens) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgClaimAccountRewards) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgDelegateTeamTokens) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (m *MsgClaimAccountRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Amount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgDelegateTeamTokens) GetValidator() string {
+	if m != nil {
+		return m.Validator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgClaimAccountRewardsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgDelegateTeamTokens) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgClaimAccountRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgDelegateTeamTokensResponse defines the Msg/DelegateTeamTokens response type.
+type MsgDelegateTeamTokensResponse struct {
 }
 
-func (m *MsgClaimAccountRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgDelegateTeamTokensResponse) Reset()         { *m = MsgDelegateTeamTokensResponse{} }
+func (m *MsgDelegateTeamTokensResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateTeamTokensResponse) ProtoMessage()    {}
+func (*MsgDelegateTeamTokensResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ad042ec4c659ded, []int{21}
 }
-
-func (m *MsgClawback) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgDelegateTeamTokensResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateTeamTokensResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateTeamTokensResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgDelegateTeamTokensResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateTeamTokensResponse.Merge(m, src)
+}
+func (m *MsgDelegateTeamTokensResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateTeamTokensResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateTeamTokensResponse.DiscardUnknown(m)
 }
 
-func (m *MsgClawback) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgDelegateTeamTokensResponse proto.InternalMessageInfo
+
+// MsgUndelegateTeamTokens ...
+type MsgUndelegateTeamTokens struct {
+	// authority is either the foundation or the bcp authority
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// id is the unique identifier of the team member
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// validator is the operator address of the validator the tokens get undelegated from
+	Validator string `protobuf:"bytes,3,opt,name=validator,proto3" json:"validator,omitempty"`
+	// amount is the amount of $KYVE which gets undelegated
+	Amount uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (m *MsgClawback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Clawback != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Clawback))
-		i--
-		dAtA[i] = 0x18
+func (m *MsgUndelegateTeamTokens) Reset()         { *m = MsgUndelegateTeamTokens{} }
+func (m *MsgUndelegateTeamTokens) String() string { return proto.CompactTextString(m) }
+func (*MsgUndelegateTeamTokens) ProtoMessage()    {}
+func (*MsgUndelegateTeamTokens) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ad042ec4c659ded, []int{22}
+}
+func (m *MsgUndelegateTeamTokens) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUndelegateTeamTokens) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUndelegateTeamTokens.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *MsgUndelegateTeamTokens) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUndelegateTeamTokens.Merge(m, src)
+}
+func (m *MsgUndelegateTeamTokens) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUndelegateTeamTokens) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUndelegateTeamTokens.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUndelegateTeamTokens proto.InternalMessageInfo
+
+func (m *MsgUndelegateTeamTokens) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgUndelegateTeamTokens) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgClawbackResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUndelegateTeamTokens) GetValidator() string {
+	if m != nil {
+		return m.Validator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgClawbackResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUndelegateTeamTokens) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
 }
 
-func (m *MsgClawbackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgUndelegateTeamTokensResponse defines the Msg/UndelegateTeamTokens response type.
+type MsgUndelegateTeamTokensResponse struct {
 }
 
-func (m *MsgCreateTeamVestingAccount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUndelegateTeamTokensResponse) Reset()         { *m = MsgUndelegateTeamTokensResponse{} }
+func (m *MsgUndelegateTeamTokensResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUndelegateTeamTokensResponse) ProtoMessage()    {}
+func (*MsgUndelegateTeamTokensResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ad042ec4c659ded, []int{23}
+}
+func (m *MsgUndelegateTeamTokensResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUndelegateTeamTokensResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUndelegateTeamTokensResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgUndelegateTeamTokensResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUndelegateTeamTokensResponse.Merge(m, src)
+}
+func (m *MsgUndelegateTeamTokensResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUndelegateTeamTokensResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUndelegateTeamTokensResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateTeamVestingAccount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUndelegateTeamTokensResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgClaimUnlocked)(nil), "kyve.team.v1beta1.MsgClaimUnlocked")
+	proto.RegisterType((*MsgClaimUnlockedResponse)(nil), "kyve.team.v1beta1.MsgClaimUnlockedResponse")
+	proto.RegisterType((*MsgClaimAuthorityRewards)(nil), "kyve.team.v1beta1.MsgClaimAuthorityRewards")
+	proto.RegisterType((*MsgClaimAuthorityRewardsResponse)(nil), "kyve.team.v1beta1.MsgClaimAuthorityRewardsResponse")
+	proto.RegisterType((*MsgClaimAccountRewards)(nil), "kyve.team.v1beta1.MsgClaimAccountRewards")
+	proto.RegisterType((*MsgClaimAccountRewardsResponse)(nil), "kyve.team.v1beta1.MsgClaimAccountRewardsResponse")
+	proto.RegisterType((*MsgClawback)(nil), "kyve.team.v1beta1.MsgClawback")
+	proto.RegisterType((*MsgClawbackResponse)(nil), "kyve.team.v1beta1.MsgClawbackResponse")
+	proto.RegisterType((*MsgPartialClawback)(nil), "kyve.team.v1beta1.MsgPartialClawback")
+	proto.RegisterType((*MsgPartialClawbackResponse)(nil), "kyve.team.v1beta1.MsgPartialClawbackResponse")
+	proto.RegisterType((*MsgReverseClawback)(nil), "kyve.team.v1beta1.MsgReverseClawback")
+	proto.RegisterType((*MsgReverseClawbackResponse)(nil), "kyve.team.v1beta1.MsgReverseClawbackResponse")
+	proto.RegisterType((*MsgCreateTeamVestingAccount)(nil), "kyve.team.v1beta1.MsgCreateTeamVestingAccount")
+	proto.RegisterType((*MsgCreateTeamVestingAccountResponse)(nil), "kyve.team.v1beta1.MsgCreateTeamVestingAccountResponse")
+	proto.RegisterType((*MsgProposeBeneficiary)(nil), "kyve.team.v1beta1.MsgProposeBeneficiary")
+	proto.RegisterType((*MsgProposeBeneficiaryResponse)(nil), "kyve.team.v1beta1.MsgProposeBeneficiaryResponse")
+	proto.RegisterType((*MsgAcceptBeneficiary)(nil), "kyve.team.v1beta1.MsgAcceptBeneficiary")
+	proto.RegisterType((*MsgAcceptBeneficiaryResponse)(nil), "kyve.team.v1beta1.MsgAcceptBeneficiaryResponse")
+	proto.RegisterType((*MsgUpdateTeamAuthorities)(nil), "kyve.team.v1beta1.MsgUpdateTeamAuthorities")
+	proto.RegisterType((*MsgUpdateTeamAuthoritiesResponse)(nil), "kyve.team.v1beta1.MsgUpdateTeamAuthoritiesResponse")
+	proto.RegisterType((*MsgDelegateTeamTokens)(nil), "kyve.team.v1beta1.MsgDelegateTeamTokens")
+	proto.RegisterType((*MsgDelegateTeamTokensResponse)(nil), "kyve.team.v1beta1.MsgDelegateTeamTokensResponse")
+	proto.RegisterType((*MsgUndelegateTeamTokens)(nil), "kyve.team.v1beta1.MsgUndelegateTeamTokens")
+	proto.RegisterType((*MsgUndelegateTeamTokensResponse)(nil), "kyve.team.v1beta1.MsgUndelegateTeamTokensResponse")
 }
 
-func (m *MsgCreateTeamVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Commencement != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Commencement))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.TotalAllocation != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TotalAllocation))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("kyve/team/v1beta1/tx.proto", fileDescriptor_1ad042ec4c659ded) }
+
+var fileDescriptor_1ad042ec4c659ded = []byte{
+	// 1066 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x4f, 0x4f, 0x1b, 0x47,
+	0x14, 0x67, 0x8d, 0x49, 0xcd, 0x83, 0x40, 0xb2, 0x98, 0xc4, 0xdd, 0x10, 0xe3, 0x18, 0xa5, 0x05,
+	0x2a, 0xbc, 0x01, 0xa4, 0x1c, 0x22, 0xb5, 0x11, 0x24, 0x3d, 0xa5, 0x54, 0xd5, 0x26, 0x41, 0x6a,
+	0x2f, 0x68, 0x3c, 0x3b, 0xac, 0x57, 0xde, 0xdd, 0x59, 0xed, 0x8c, 0x6d, 0x7c, 0x6b, 0xf3, 0x09,
+	0x7a, 0xea, 0xa7, 0xe8, 0x21, 0xaa, 0x72, 0xea, 0xa9, 0x47, 0xd4, 0x13, 0xca, 0xa9, 0xed, 0x21,
+	0xaa, 0xe0, 0x90, 0x5b, 0x3f, 0x43, 0xb5, 0x7f, 0x3c, 0x5e, 0xbc, 0xbb, 0xd8, 0x50, 0xab, 0x87,
+	0x9c, 0xbc, 0x33, 0xef, 0x37, 0xef, 0xfd, 0x7e, 0xef, 0x3d, 0xcd, 0x1b, 0x19, 0x94, 0x66, 0xb7,
+	0x4d, 0x54, 0x4e, 0x90, 0xad, 0xb6, 0x37, 0xeb, 0x84, 0xa3, 0x4d, 0x95, 0x1f, 0xd5, 0x5c, 0x8f,
+	0x72, 0x2a, 0xdf, 0xf4, 0x6d, 0x35, 0xdf, 0x56, 0x8b, 0x6c, 0xca, 0x6d, 0x4c, 0x99, 0x4d, 0x99,
+	0x6a, 0x33, 0x43, 0x6d, 0x6f, 0xfa, 0x3f, 0x21, 0x56, 0xf9, 0x38, 0x34, 0x1c, 0x04, 0x2b, 0x35,
+	0x5c, 0x44, 0xa6, 0xa2, 0x41, 0x0d, 0x1a, 0xee, 0xfb, 0x5f, 0xd1, 0xee, 0x52, 0x4a, 0x60, 0x3f,
+	0x52, 0x60, 0xad, 0xfe, 0x2a, 0xc1, 0x8d, 0x3d, 0x66, 0x3c, 0xb1, 0x90, 0x69, 0xbf, 0x74, 0x2c,
+	0x8a, 0x9b, 0x44, 0x97, 0x1f, 0xc2, 0x34, 0x6a, 0xf1, 0x06, 0xf5, 0x4c, 0xde, 0x2d, 0x49, 0x15,
+	0x69, 0x75, 0x7a, 0xb7, 0xf4, 0xf6, 0xcd, 0x46, 0x31, 0x8a, 0xb6, 0xa3, 0xeb, 0x1e, 0x61, 0xec,
+	0x39, 0xf7, 0x4c, 0xc7, 0xd0, 0xfa, 0x50, 0x79, 0x0e, 0x72, 0xa6, 0x5e, 0xca, 0x55, 0xa4, 0xd5,
+	0xbc, 0x96, 0x33, 0x75, 0xf9, 0x16, 0x5c, 0x43, 0x36, 0x6d, 0x39, 0xbc, 0x34, 0x19, 0xec, 0x45,
+	0x2b, 0xdf, 0xbf, 0x47, 0xb0, 0xe9, 0x9a, 0xc4, 0xe1, 0xa5, 0xfc, 0x30, 0xff, 0x02, 0xfa, 0x68,
+	0xee, 0xd5, 0xfb, 0xd7, 0xeb, 0xfd, 0x78, 0x55, 0x05, 0x4a, 0x83, 0xdc, 0x35, 0xc2, 0x5c, 0xea,
+	0x30, 0x52, 0xfd, 0x45, 0xea, 0x1b, 0x77, 0x7a, 0x27, 0x34, 0xd2, 0x41, 0x9e, 0xce, 0xae, 0x2c,
+	0xb0, 0x2f, 0x28, 0x97, 0x2d, 0x68, 0xf2, 0xea, 0x82, 0xaa, 0x50, 0xc9, 0xe2, 0x2c, 0x84, 0xfd,
+	0x26, 0xc1, 0x2d, 0x01, 0xc2, 0xd8, 0x8f, 0xff, 0x5f, 0x65, 0xfd, 0xdf, 0x75, 0xab, 0x40, 0x39,
+	0x5d, 0x81, 0x10, 0xf9, 0x83, 0x04, 0x33, 0x21, 0xa4, 0x53, 0x47, 0xb8, 0x39, 0x36, 0x65, 0x0a,
+	0x14, 0x70, 0xe4, 0x33, 0xd2, 0x26, 0xd6, 0x09, 0x96, 0x8b, 0xb0, 0x10, 0xa3, 0x20, 0xa8, 0xfd,
+	0x29, 0x81, 0xbc, 0xc7, 0x8c, 0x6f, 0x90, 0xc7, 0x4d, 0x64, 0x8d, 0x9d, 0x61, 0x56, 0xee, 0x1f,
+	0x43, 0xe1, 0xd0, 0x43, 0x98, 0x9b, 0xd4, 0x89, 0x52, 0xbf, 0x72, 0xfc, 0x6e, 0x79, 0xe2, 0xaf,
+	0x77, 0xcb, 0x77, 0xc2, 0x10, 0x4c, 0x6f, 0xd6, 0x4c, 0xaa, 0xda, 0x88, 0x37, 0x6a, 0x5f, 0x11,
+	0x03, 0xe1, 0xee, 0x53, 0x82, 0x35, 0x71, 0x48, 0x96, 0x21, 0xcf, 0x4d, 0x9b, 0x94, 0xa6, 0x02,
+	0xb7, 0xc1, 0x77, 0x42, 0xf2, 0x12, 0x28, 0x49, 0x69, 0x42, 0xf9, 0x4f, 0xa1, 0x72, 0x8d, 0xb4,
+	0x89, 0xc7, 0xc8, 0xd8, 0x95, 0xcb, 0x90, 0x3f, 0x6c, 0x59, 0x56, 0xa0, 0xbb, 0xa0, 0x05, 0xdf,
+	0x72, 0x11, 0xa6, 0x4c, 0x47, 0x27, 0x47, 0x81, 0xe4, 0xbc, 0x16, 0x2e, 0x32, 0x68, 0x0f, 0xf0,
+	0xea, 0xd3, 0xce, 0xc1, 0x1d, 0xbf, 0x90, 0x1e, 0x41, 0x9c, 0xbc, 0x20, 0xc8, 0xde, 0x27, 0x8c,
+	0x9b, 0x8e, 0x11, 0xb5, 0xde, 0x95, 0xf9, 0xaf, 0xc1, 0x0d, 0x4e, 0x39, 0xb2, 0x0e, 0x90, 0x65,
+	0x51, 0x8c, 0x82, 0xca, 0x84, 0x6a, 0xe6, 0x83, 0xfd, 0x1d, 0xb1, 0x2d, 0x57, 0x61, 0x16, 0x53,
+	0xdb, 0x26, 0x0e, 0x26, 0x36, 0x11, 0xa5, 0x3d, 0xb7, 0x27, 0x7f, 0x01, 0x05, 0x86, 0x1b, 0x44,
+	0x6f, 0x59, 0x24, 0x50, 0x3b, 0xb3, 0x55, 0xad, 0x25, 0xe6, 0x42, 0x2d, 0xe2, 0xfe, 0x3c, 0x42,
+	0x6a, 0xe2, 0x8c, 0x5c, 0x81, 0x99, 0x3a, 0x71, 0xc8, 0xa1, 0x89, 0x4d, 0xe4, 0x75, 0x83, 0x32,
+	0x4f, 0x6b, 0xf1, 0xad, 0x44, 0xda, 0xee, 0xc3, 0xca, 0x05, 0x79, 0x11, 0xf9, 0xfb, 0x59, 0x82,
+	0x45, 0xbf, 0x2b, 0x3c, 0xea, 0x52, 0x46, 0x76, 0xfb, 0x0e, 0xe5, 0x2d, 0xf8, 0x08, 0xfb, 0xa7,
+	0xa9, 0x37, 0x34, 0x6f, 0x3d, 0x60, 0xa2, 0xea, 0x3b, 0x30, 0xef, 0x90, 0xce, 0x41, 0x9c, 0xfa,
+	0xb0, 0x0b, 0x74, 0xce, 0x21, 0x9d, 0x18, 0x8d, 0x47, 0xb3, 0xbe, 0xae, 0x5e, 0x80, 0xea, 0x32,
+	0xdc, 0x4d, 0x65, 0x2b, 0xf4, 0x34, 0xa0, 0xb8, 0xc7, 0x7c, 0x95, 0xc4, 0xe5, 0x63, 0x56, 0x33,
+	0x40, 0xa5, 0x0c, 0x4b, 0x69, 0x91, 0x04, 0x93, 0x7f, 0xc2, 0x19, 0xf5, 0xd2, 0xd5, 0xa3, 0x0a,
+	0xf4, 0x2e, 0x7d, 0x93, 0x5c, 0xfd, 0x32, 0x7f, 0x06, 0xc5, 0x43, 0xda, 0x72, 0xf4, 0xa0, 0xf3,
+	0x0e, 0xfa, 0x2e, 0x72, 0x43, 0x5c, 0x2c, 0xf4, 0x4f, 0x89, 0xd9, 0x23, 0x7f, 0x0e, 0xd7, 0xeb,
+	0xd8, 0x8d, 0x79, 0x19, 0x56, 0x9b, 0xd9, 0x3a, 0x76, 0xc5, 0xf1, 0x8c, 0xf9, 0x96, 0xaa, 0x57,
+	0x24, 0xe5, 0x38, 0x6c, 0xb7, 0xa7, 0xc4, 0x22, 0x46, 0x04, 0x7b, 0x41, 0x9b, 0xc4, 0x19, 0xdf,
+	0x78, 0x7b, 0x0c, 0xd3, 0x6d, 0x64, 0x99, 0x7a, 0x50, 0xea, 0x50, 0xd0, 0xbd, 0xb7, 0x6f, 0x36,
+	0xee, 0x46, 0x7e, 0xf6, 0x7b, 0xb6, 0x01, 0x87, 0xe2, 0x4c, 0xec, 0x8e, 0xce, 0xc7, 0xef, 0xe8,
+	0x84, 0xdc, 0xb0, 0x15, 0x93, 0x4a, 0x84, 0xd6, 0xdf, 0x25, 0xb8, 0xed, 0x27, 0xc4, 0xd1, 0x3f,
+	0x00, 0xb5, 0xf7, 0x60, 0x39, 0x43, 0x4b, 0x4f, 0xef, 0xd6, 0x09, 0xc0, 0xe4, 0x1e, 0x33, 0x64,
+	0x04, 0xd7, 0xcf, 0xbf, 0x38, 0x57, 0x52, 0xae, 0xba, 0xc1, 0xa7, 0x9d, 0xf2, 0xd9, 0x08, 0xa0,
+	0x5e, 0x28, 0x59, 0x83, 0x82, 0x98, 0x50, 0xe5, 0xcc, 0x83, 0x81, 0x5d, 0xf9, 0xe4, 0x62, 0xbb,
+	0xf0, 0xf9, 0x4a, 0x82, 0x52, 0xe6, 0x18, 0xa9, 0x65, 0x38, 0xc9, 0xc0, 0x2b, 0x0f, 0x2f, 0x87,
+	0x17, 0x24, 0xba, 0xb0, 0x98, 0xfe, 0xa8, 0xbd, 0x28, 0x3d, 0x83, 0x60, 0x65, 0xfb, 0x12, 0x60,
+	0x11, 0x9a, 0xc1, 0x42, 0xda, 0xb3, 0x73, 0xed, 0x22, 0x5f, 0xe7, 0xa0, 0xca, 0xe6, 0xc8, 0x50,
+	0x11, 0xd4, 0x05, 0x39, 0x65, 0xf4, 0xac, 0xa6, 0x3b, 0x4a, 0x22, 0x95, 0x07, 0xa3, 0x22, 0x45,
+	0x44, 0x1b, 0x6e, 0x26, 0xa7, 0xc3, 0xa7, 0xe9, 0x6e, 0x12, 0x40, 0x45, 0x1d, 0x11, 0x28, 0xc2,
+	0x19, 0x30, 0x3f, 0xf8, 0x98, 0xbc, 0x9f, 0xc1, 0xf9, 0x3c, 0x4c, 0xd9, 0x18, 0x09, 0x16, 0x0f,
+	0x34, 0xf8, 0x76, 0xcb, 0x08, 0x34, 0x00, 0x53, 0x36, 0x46, 0x82, 0xc5, 0x5b, 0x34, 0x7d, 0xa6,
+	0x65, 0xb4, 0x68, 0x2a, 0x58, 0xd9, 0xbe, 0x04, 0x38, 0xde, 0x2d, 0x29, 0x93, 0x23, 0xa3, 0x5b,
+	0x92, 0x48, 0xe5, 0xc1, 0xa8, 0x48, 0x11, 0xb1, 0x0d, 0xc5, 0xd4, 0xfb, 0x7b, 0x3d, 0x83, 0x7e,
+	0x0a, 0x56, 0xd9, 0x1a, 0x1d, 0xdb, 0x8b, 0xab, 0x4c, 0x7d, 0xff, 0xfe, 0xf5, 0xba, 0xb4, 0xfb,
+	0xe4, 0xf8, 0xb4, 0x2c, 0x9d, 0x9c, 0x96, 0xa5, 0xbf, 0x4f, 0xcb, 0xd2, 0x8f, 0x67, 0xe5, 0x89,
+	0x93, 0xb3, 0xf2, 0xc4, 0x1f, 0x67, 0xe5, 0x89, 0xef, 0xd6, 0x0c, 0x93, 0x37, 0x5a, 0xf5, 0x1a,
+	0xa6, 0xb6, 0xfa, 0xec, 0xdb, 0xfd, 0x2f, 0xbf, 0x26, 0xbc, 0x43, 0xbd, 0xa6, 0x8a, 0x1b, 0xc8,
+	0x74, 0xd4, 0xa3, 0xf0, 0x2f, 0x01, 0xde, 0x75, 0x09, 0xab, 0x5f, 0x0b, 0xfe, 0x0c, 0xd8, 0xfe,
+	0x77, 0x00, 0xfd, 0xfa, 0xde, 0xcc, 0xa5, 0x10, 0x00, 0x00,
 }
 
-func (m *MsgCreateTeamVestingAccountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// ClaimUnlocked ...
+	ClaimUnlocked(ctx context.Context, in *MsgClaimUnlocked, opts ...grpc.CallOption) (*MsgClaimUnlockedResponse, error)
+	// Clawback ...
+	Clawback(ctx context.Context, in *MsgClawback, opts ...grpc.CallOption) (*MsgClawbackResponse, error)
+	// CreateTeamVestingAccount ...
+	CreateTeamVestingAccount(ctx context.Context, in *MsgCreateTeamVestingAccount, opts ...grpc.CallOption) (*MsgCreateTeamVestingAccountResponse, error)
+	// ClaimAuthorityRewards ...
+	ClaimAuthorityRewards(ctx context.Context, in *MsgClaimAuthorityRewards, opts ...grpc.CallOption) (*MsgClaimAuthorityRewardsResponse, error)
+	// ClaimInflationRewards ...
+	ClaimAccountRewards(ctx context.Context, in *MsgClaimAccountRewards, opts ...grpc.CallOption) (*MsgClaimAccountRewardsResponse, error)
+	// ProposeBeneficiary ...
+	ProposeBeneficiary(ctx context.Context, in *MsgProposeBeneficiary, opts ...grpc.CallOption) (*MsgProposeBeneficiaryResponse, error)
+	// AcceptBeneficiary ...
+	AcceptBeneficiary(ctx context.Context, in *MsgAcceptBeneficiary, opts ...grpc.CallOption) (*MsgAcceptBeneficiaryResponse, error)
+	// PartialClawback ...
+	PartialClawback(ctx context.Context, in *MsgPartialClawback, opts ...grpc.CallOption) (*MsgPartialClawbackResponse, error)
+	// ReverseClawback ...
+	ReverseClawback(ctx context.Context, in *MsgReverseClawback, opts ...grpc.CallOption) (*MsgReverseClawbackResponse, error)
+	// UpdateTeamAuthorities defines a governance operation for updating the team authorities.
+	// The authority is hard-coded to the x/gov module account.
+	UpdateTeamAuthorities(ctx context.Context, in *MsgUpdateTeamAuthorities, opts ...grpc.CallOption) (*MsgUpdateTeamAuthoritiesResponse, error)
+	// DelegateTeamTokens ...
+	DelegateTeamTokens(ctx context.Context, in *MsgDelegateTeamTokens, opts ...grpc.CallOption) (*MsgDelegateTeamTokensResponse, error)
+	// UndelegateTeamTokens ...
+	UndelegateTeamTokens(ctx context.Context, in *MsgUndelegateTeamTokens, opts ...grpc.CallOption) (*MsgUndelegateTeamTokensResponse, error)
 }
 
-func (m *MsgCreateTeamVestingAccountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type msgClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *MsgCreateTeamVestingAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *msgClient) ClaimUnlocked(ctx context.Context, in *MsgClaimUnlocked, opts ...grpc.CallOption) (*MsgClaimUnlockedResponse, error) {
+	out := new(MsgClaimUnlockedResponse)
+	err := c.cc.Invoke(ctx, "/kyve.team.v1beta1.Msg/ClaimUnlocked", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *MsgClaimUnlocked) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
-	}
-	if m.Amount != 0 {
-		n += 1 + sovTx(uint64(m.Amount))
-	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+
+func (c *msgClient) Clawback(ctx context.Context, in *MsgClawback, opts ...grpc.CallOption) (*MsgClawbackResponse, error) {
+	out := new(MsgClawbackResponse)
+	err := c.cc.Invoke(ctx, "/kyve.team.v1beta1.Msg/Clawback", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgClaimUnlockedResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) CreateTeamVestingAccount(ctx context.Context, in *MsgCreateTeamVestingAccount, opts ...grpc.CallOption) (*MsgCreateTeamVestingAccountResponse, error) {
+	out := new(MsgCreateTeamVestingAccountResponse)
+	err := c.cc.Invoke(ctx, "/kyve.team.v1beta1.Msg/CreateTeamVestingAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgClaimAuthorityRewards) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Amount != 0 {
-		n += 1 + sovTx(uint64(m.Amount))
-	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (c *msgClient) ClaimAuthorityRewards(ctx context.Context, in *MsgClaimAuthorityRewards, opts ...grpc.CallOption) (*MsgClaimAuthorityRewardsResponse, error) {
+	out := new(MsgClaimAuthorityRewardsResponse)
+	err := c.cc.Invoke(ctx, "/kyve.team.v1beta1.Msg/ClaimAuthorityRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgClaimAuthorityRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) ClaimAccountRewards(ctx context.Context, in *MsgClaimAccountRewards, opts ...grpc.CallOption) (*MsgClaimAccountRewardsResponse, error) {
+	out := new(MsgClaimAccountRewardsResponse)
+	err := c.cc.Invoke(ctx, "/kyve.team.v1beta1.Msg/ClaimAccountRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgClaimAccountRewards) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
-	}
-	if m.Amount != 0 {
-		n += 1 + sovTx(uint64(m.Amount))
-	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (c *msgClient) ProposeBeneficiary(ctx context.Context, in *MsgProposeBeneficiary, opts ...grpc.CallOption) (*MsgProposeBeneficiaryResponse, error) {
+	out := new(MsgProposeBeneficiaryResponse)
+	err := c.cc.Invoke(ctx, "/kyve.team.v1beta1.Msg/ProposeBeneficiary", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgClaimAccountRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) AcceptBeneficiary(ctx context.Context, in *MsgAcceptBeneficiary, opts ...grpc.CallOption) (*MsgAcceptBeneficiaryResponse, error) {
+	out := new(MsgAcceptBeneficiaryResponse)
+	err := c.cc.Invoke(ctx, "/kyve.team.v1beta1.Msg/AcceptBeneficiary", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgClawback) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
-	}
-	if m.Clawback != 0 {
-		n += 1 + sovTx(uint64(m.Clawback))
+func (c *msgClient) PartialClawback(ctx context.Context, in *MsgPartialClawback, opts ...grpc.CallOption) (*MsgPartialClawbackResponse, error) {
+	out := new(MsgPartialClawbackResponse)
+	err := c.cc.Invoke(ctx, "/kyve.team.v1beta1.Msg/PartialClawback", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgClawbackResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) ReverseClawback(ctx context.Context, in *MsgReverseClawback, opts ...grpc.CallOption) (*MsgReverseClawbackResponse, error) {
+	out := new(MsgReverseClawbackResponse)
+	err := c.cc.Invoke(ctx, "/kyve.team.v1beta1.Msg/ReverseClawback", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgCreateTeamVestingAccount) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) UpdateTeamAuthorities(ctx context.Context, in *MsgUpdateTeamAuthorities, opts ...grpc.CallOption) (*MsgUpdateTeamAuthoritiesResponse, error) {
+	out := new(MsgUpdateTeamAuthoritiesResponse)
+	err := c.cc.Invoke(ctx, "/kyve.team.v1beta1.Msg/UpdateTeamAuthorities", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
+	return out, nil
+}
+
+func (c *msgClient) DelegateTeamTokens(ctx context.Context, in *MsgDelegateTeamTokens, opts ...grpc.CallOption) (*MsgDelegateTeamTokensResponse, error) {
+	out := new(MsgDelegateTeamTokensResponse)
+	err := c.cc.Invoke(ctx, "/kyve.team.v1beta1.Msg/DelegateTeamTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UndelegateTeamTokens(ctx context.Context, in *MsgUndelegateTeamTokens, opts ...grpc.CallOption) (*MsgUndelegateTeamTokensResponse, error) {
+	out := new(MsgUndelegateTeamTokensResponse)
+	err := c.cc.Invoke(ctx, "/kyve.team.v1beta1.Msg/UndelegateTeamTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// ClaimUnlocked ...
+	ClaimUnlocked(context.Context, *MsgClaimUnlocked) (*MsgClaimUnlockedResponse, error)
+	// Clawback ...
+	Clawback(context.Context, *MsgClawback) (*MsgClawbackResponse, error)
+	// CreateTeamVestingAccount ...
+	CreateTeamVestingAccount(context.Context, *MsgCreateTeamVestingAccount) (*MsgCreateTeamVestingAccountResponse, error)
+	// ClaimAuthorityRewards ...
+	ClaimAuthorityRewards(context.Context, *MsgClaimAuthorityRewards) (*MsgClaimAuthorityRewardsResponse, error)
+	// ClaimInflationRewards ...
+	ClaimAccountRewards(context.Context, *MsgClaimAccountRewards) (*MsgClaimAccountRewardsResponse, error)
+	// ProposeBeneficiary ...
+	ProposeBeneficiary(context.Context, *MsgProposeBeneficiary) (*MsgProposeBeneficiaryResponse, error)
+	// AcceptBeneficiary ...
+	AcceptBeneficiary(context.Context, *MsgAcceptBeneficiary) (*MsgAcceptBeneficiaryResponse, error)
+	// PartialClawback ...
+	PartialClawback(context.Context, *MsgPartialClawback) (*MsgPartialClawbackResponse, error)
+	// ReverseClawback ...
+	ReverseClawback(context.Context, *MsgReverseClawback) (*MsgReverseClawbackResponse, error)
+	// UpdateTeamAuthorities defines a governance operation for updating the team authorities.
+	// The authority is hard-coded to the x/gov module account.
+	UpdateTeamAuthorities(context.Context, *MsgUpdateTeamAuthorities) (*MsgUpdateTeamAuthoritiesResponse, error)
+	// DelegateTeamTokens ...
+	DelegateTeamTokens(context.Context, *MsgDelegateTeamTokens) (*MsgDelegateTeamTokensResponse, error)
+	// UndelegateTeamTokens ...
+	UndelegateTeamTokens(context.Context, *MsgUndelegateTeamTokens) (*MsgUndelegateTeamTokensResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) ClaimUnlocked(ctx context.Context, req *MsgClaimUnlocked) (*MsgClaimUnlockedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimUnlocked not implemented")
+}
+func (*UnimplementedMsgServer) Clawback(ctx context.Context, req *MsgClawback) (*MsgClawbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Clawback not implemented")
+}
+func (*UnimplementedMsgServer) CreateTeamVestingAccount(ctx context.Context, req *MsgCreateTeamVestingAccount) (*MsgCreateTeamVestingAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTeamVestingAccount not implemented")
+}
+func (*UnimplementedMsgServer) ClaimAuthorityRewards(ctx context.Context, req *MsgClaimAuthorityRewards) (*MsgClaimAuthorityRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimAuthorityRewards not implemented")
+}
+func (*UnimplementedMsgServer) ClaimAccountRewards(ctx context.Context, req *MsgClaimAccountRewards) (*MsgClaimAccountRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimAccountRewards not implemented")
+}
+func (*UnimplementedMsgServer) ProposeBeneficiary(ctx context.Context, req *MsgProposeBeneficiary) (*MsgProposeBeneficiaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeBeneficiary not implemented")
+}
+func (*UnimplementedMsgServer) AcceptBeneficiary(ctx context.Context, req *MsgAcceptBeneficiary) (*MsgAcceptBeneficiaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptBeneficiary not implemented")
+}
+func (*UnimplementedMsgServer) PartialClawback(ctx context.Context, req *MsgPartialClawback) (*MsgPartialClawbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PartialClawback not implemented")
+}
+func (*UnimplementedMsgServer) ReverseClawback(ctx context.Context, req *MsgReverseClawback) (*MsgReverseClawbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReverseClawback not implemented")
+}
+func (*UnimplementedMsgServer) UpdateTeamAuthorities(ctx context.Context, req *MsgUpdateTeamAuthorities) (*MsgUpdateTeamAuthoritiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTeamAuthorities not implemented")
+}
+func (*UnimplementedMsgServer) DelegateTeamTokens(ctx context.Context, req *MsgDelegateTeamTokens) (*MsgDelegateTeamTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateTeamTokens not implemented")
+}
+func (*UnimplementedMsgServer) UndelegateTeamTokens(ctx context.Context, req *MsgUndelegateTeamTokens) (*MsgUndelegateTeamTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndelegateTeamTokens not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_ClaimUnlocked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimUnlocked)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimUnlocked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.team.v1beta1.Msg/ClaimUnlocked",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimUnlocked(ctx, req.(*MsgClaimUnlocked))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Clawback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClawback)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Clawback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.team.v1beta1.Msg/Clawback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Clawback(ctx, req.(*MsgClawback))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateTeamVestingAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateTeamVestingAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateTeamVestingAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.team.v1beta1.Msg/CreateTeamVestingAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateTeamVestingAccount(ctx, req.(*MsgCreateTeamVestingAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimAuthorityRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimAuthorityRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimAuthorityRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.team.v1beta1.Msg/ClaimAuthorityRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimAuthorityRewards(ctx, req.(*MsgClaimAuthorityRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimAccountRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimAccountRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimAccountRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.team.v1beta1.Msg/ClaimAccountRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimAccountRewards(ctx, req.(*MsgClaimAccountRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ProposeBeneficiary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposeBeneficiary)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProposeBeneficiary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.team.v1beta1.Msg/ProposeBeneficiary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposeBeneficiary(ctx, req.(*MsgProposeBeneficiary))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptBeneficiary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptBeneficiary)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptBeneficiary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.team.v1beta1.Msg/AcceptBeneficiary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptBeneficiary(ctx, req.(*MsgAcceptBeneficiary))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PartialClawback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPartialClawback)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PartialClawback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.team.v1beta1.Msg/PartialClawback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PartialClawback(ctx, req.(*MsgPartialClawback))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReverseClawback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReverseClawback)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReverseClawback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.team.v1beta1.Msg/ReverseClawback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReverseClawback(ctx, req.(*MsgReverseClawback))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateTeamAuthorities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateTeamAuthorities)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateTeamAuthorities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.team.v1beta1.Msg/UpdateTeamAuthorities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateTeamAuthorities(ctx, req.(*MsgUpdateTeamAuthorities))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DelegateTeamTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateTeamTokens)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateTeamTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.team.v1beta1.Msg/DelegateTeamTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateTeamTokens(ctx, req.(*MsgDelegateTeamTokens))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UndelegateTeamTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUndelegateTeamTokens)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UndelegateTeamTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.team.v1beta1.Msg/UndelegateTeamTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UndelegateTeamTokens(ctx, req.(*MsgUndelegateTeamTokens))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kyve.team.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ClaimUnlocked",
+			Handler:    _Msg_ClaimUnlocked_Handler,
+		},
+		{
+			MethodName: "Clawback",
+			Handler:    _Msg_Clawback_Handler,
+		},
+		{
+			MethodName: "CreateTeamVestingAccount",
+			Handler:    _Msg_CreateTeamVestingAccount_Handler,
+		},
+		{
+			MethodName: "ClaimAuthorityRewards",
+			Handler:    _Msg_ClaimAuthorityRewards_Handler,
+		},
+		{
+			MethodName: "ClaimAccountRewards",
+			Handler:    _Msg_ClaimAccountRewards_Handler,
+		},
+		{
+			MethodName: "ProposeBeneficiary",
+			Handler:    _Msg_ProposeBeneficiary_Handler,
+		},
+		{
+			MethodName: "AcceptBeneficiary",
+			Handler:    _Msg_AcceptBeneficiary_Handler,
+		},
+		{
+			MethodName: "PartialClawback",
+			Handler:    _Msg_PartialClawback_Handler,
+		},
+		{
+			MethodName: "ReverseClawback",
+			Handler:    _Msg_ReverseClawback_Handler,
+		},
+		{
+			MethodName: "UpdateTeamAuthorities",
+			Handler:    _Msg_UpdateTeamAuthorities_Handler,
+		},
+		{
+			MethodName: "DelegateTeamTokens",
+			Handler:    _Msg_DelegateTeamTokens_Handler,
+		},
+		{
+			MethodName: "UndelegateTeamTokens",
+			Handler:    _Msg_UndelegateTeamTokens_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "kyve/team/v1beta1/tx.proto",
+}
+
+func (m *MsgClaimUnlocked) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimUnlocked) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimUnlocked) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimUnlockedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimUnlockedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimUnlockedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimAuthorityRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimAuthorityRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimAuthorityRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimAuthorityRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimAuthorityRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimAuthorityRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimAccountRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimAccountRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimAccountRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimAccountRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimAccountRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimAccountRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClawback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClawback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClawback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Clawback != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Clawback))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClawbackResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClawbackResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClawbackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPartialClawback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPartialClawback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPartialClawback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Time != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Fraction.Size()
+		i -= size
+		if _, err := m.Fraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPartialClawbackResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPartialClawbackResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPartialClawbackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReverseClawback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReverseClawback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReverseClawback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Index != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Full {
+		i--
+		if m.Full {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReverseClawbackResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReverseClawbackResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReverseClawbackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateTeamVestingAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateTeamVestingAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateTeamVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Beneficiary) > 0 {
+		i -= len(m.Beneficiary)
+		copy(dAtA[i:], m.Beneficiary)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Beneficiary)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Schedule != nil {
+		{
+			size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Commencement != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Commencement))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TotalAllocation != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TotalAllocation))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateTeamVestingAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateTeamVestingAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateTeamVestingAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposeBeneficiary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeBeneficiary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeBeneficiary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewBeneficiary) > 0 {
+		i -= len(m.NewBeneficiary)
+		copy(dAtA[i:], m.NewBeneficiary)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewBeneficiary)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposeBeneficiaryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeBeneficiaryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeBeneficiaryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptBeneficiary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptBeneficiary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptBeneficiary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptBeneficiaryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptBeneficiaryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptBeneficiaryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateTeamAuthorities) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateTeamAuthorities) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateTeamAuthorities) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BcpAuthority) > 0 {
+		i -= len(m.BcpAuthority)
+		copy(dAtA[i:], m.BcpAuthority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BcpAuthority)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FoundationAuthority) > 0 {
+		i -= len(m.FoundationAuthority)
+		copy(dAtA[i:], m.FoundationAuthority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FoundationAuthority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateTeamAuthoritiesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateTeamAuthoritiesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateTeamAuthoritiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegateTeamTokens) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateTeamTokens) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateTeamTokens) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegateTeamTokensResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateTeamTokensResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateTeamTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUndelegateTeamTokens) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegateTeamTokens) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegateTeamTokens) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUndelegateTeamTokensResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegateTeamTokensResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegateTeamTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgClaimUnlocked) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimUnlockedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimAuthorityRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimAuthorityRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimAccountRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimAccountRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClawback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if m.Clawback != 0 {
+		n += 1 + sovTx(uint64(m.Clawback))
+	}
+	return n
+}
+
+func (m *MsgClawbackResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPartialClawback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	l = m.Fraction.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Time != 0 {
+		n += 1 + sovTx(uint64(m.Time))
+	}
+	return n
+}
+
+func (m *MsgPartialClawbackResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReverseClawback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if m.Full {
+		n += 2
+	}
+	if m.Index != 0 {
+		n += 1 + sovTx(uint64(m.Index))
+	}
+	return n
+}
+
+func (m *MsgReverseClawbackResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateTeamVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TotalAllocation != 0 {
+		n += 1 + sovTx(uint64(m.TotalAllocation))
+	}
+	if m.Commencement != 0 {
+		n += 1 + sovTx(uint64(m.Commencement))
+	}
+	if m.Schedule != nil {
+		l = m.Schedule.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Beneficiary)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateTeamVestingAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgProposeBeneficiary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.NewBeneficiary)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgProposeBeneficiaryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAcceptBeneficiary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgAcceptBeneficiaryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateTeamAuthorities) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FoundationAuthority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BcpAuthority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateTeamAuthoritiesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDelegateTeamTokens) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *MsgDelegateTeamTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUndelegateTeamTokens) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	l = len(m.Authority)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if m.TotalAllocation != 0 {
-		n += 1 + sovTx(uint64(m.TotalAllocation))
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *MsgUndelegateTeamTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgClaimUnlocked) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimUnlocked: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimUnlocked: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimUnlockedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimUnlockedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimUnlockedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimAuthorityRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimAuthorityRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimAuthorityRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimAuthorityRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimAuthorityRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimAuthorityRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimAccountRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimAccountRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimAccountRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimAccountRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimAccountRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimAccountRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClawback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClawback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClawback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clawback", wireType)
+			}
+			m.Clawback = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Clawback |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClawbackResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClawbackResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClawbackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPartialClawback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPartialClawback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPartialClawback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPartialClawbackResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPartialClawbackResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPartialClawbackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReverseClawback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReverseClawback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReverseClawback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Full", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Full = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	if m.Commencement != 0 {
-		n += 1 + sovTx(uint64(m.Commencement))
+	return nil
+}
+func (m *MsgReverseClawbackResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReverseClawbackResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReverseClawbackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateTeamVestingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateTeamVestingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateTeamVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAllocation", wireType)
+			}
+			m.TotalAllocation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalAllocation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commencement", wireType)
+			}
+			m.Commencement = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Commencement |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Schedule == nil {
+				m.Schedule = &VestingSchedule{}
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Beneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateTeamVestingAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateTeamVestingAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateTeamVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgCreateTeamVestingAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgClaimUnlocked) Unmarshal(dAtA []byte) error {
+func (m *MsgProposeBeneficiary) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1362,15 +4567,15 @@ func (m *MsgClaimUnlocked) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimUnlocked: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgProposeBeneficiary: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimUnlocked: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgProposeBeneficiary: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1398,7 +4603,7 @@ func (m *MsgClaimUnlocked) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
@@ -1420,27 +4625,8 @@ func (m *MsgClaimUnlocked) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			m.Amount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewBeneficiary", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1468,7 +4654,7 @@ func (m *MsgClaimUnlocked) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.NewBeneficiary = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1491,7 +4677,7 @@ func (m *MsgClaimUnlocked) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimUnlockedResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgProposeBeneficiaryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1514,10 +4700,10 @@ func (m *MsgClaimUnlockedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimUnlockedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgProposeBeneficiaryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimUnlockedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgProposeBeneficiaryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1541,7 +4727,7 @@ func (m *MsgClaimUnlockedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimAuthorityRewards) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptBeneficiary) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1564,15 +4750,15 @@ func (m *MsgClaimAuthorityRewards) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimAuthorityRewards: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptBeneficiary: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimAuthorityRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptBeneficiary: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1600,32 +4786,13 @@ func (m *MsgClaimAuthorityRewards) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			m.Amount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1635,24 +4802,11 @@ func (m *MsgClaimAuthorityRewards) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1674,7 +4828,7 @@ func (m *MsgClaimAuthorityRewards) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimAuthorityRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptBeneficiaryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1697,10 +4851,10 @@ func (m *MsgClaimAuthorityRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimAuthorityRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptBeneficiaryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimAuthorityRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptBeneficiaryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1724,7 +4878,7 @@ func (m *MsgClaimAuthorityRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimAccountRewards) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateTeamAuthorities) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1747,10 +4901,10 @@ func (m *MsgClaimAccountRewards) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimAccountRewards: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateTeamAuthorities: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimAccountRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateTeamAuthorities: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1786,10 +4940,10 @@ func (m *MsgClaimAccountRewards) Unmarshal(dAtA []byte) error {
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FoundationAuthority", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1799,33 +4953,27 @@ func (m *MsgClaimAccountRewards) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.Amount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 4:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FoundationAuthority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BcpAuthority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1853,7 +5001,7 @@ func (m *MsgClaimAccountRewards) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.BcpAuthority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1876,7 +5024,7 @@ func (m *MsgClaimAccountRewards) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimAccountRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateTeamAuthoritiesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1899,10 +5047,10 @@ func (m *MsgClaimAccountRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimAccountRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateTeamAuthoritiesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimAccountRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateTeamAuthoritiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1926,7 +5074,7 @@ func (m *MsgClaimAccountRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClawback) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateTeamTokens) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1949,10 +5097,10 @@ func (m *MsgClawback) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClawback: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateTeamTokens: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClawback: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateTeamTokens: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2007,10 +5155,42 @@ func (m *MsgClawback) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Clawback", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.Clawback = 0
+			m.Amount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2020,7 +5200,7 @@ func (m *MsgClawback) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Clawback |= uint64(b&0x7F) << shift
+				m.Amount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2046,7 +5226,7 @@ func (m *MsgClawback) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClawbackResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateTeamTokensResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2069,10 +5249,10 @@ func (m *MsgClawbackResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClawbackResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateTeamTokensResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClawbackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateTeamTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2096,7 +5276,7 @@ func (m *MsgClawbackResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateTeamVestingAccount) Unmarshal(dAtA []byte) error {
+func (m *MsgUndelegateTeamTokens) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2119,10 +5299,10 @@ func (m *MsgCreateTeamVestingAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateTeamVestingAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUndelegateTeamTokens: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateTeamVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUndelegateTeamTokens: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2159,9 +5339,9 @@ func (m *MsgCreateTeamVestingAccount) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalAllocation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.TotalAllocation = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2171,16 +5351,48 @@ func (m *MsgCreateTeamVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalAllocation |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commencement", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.Commencement = 0
+			m.Amount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2190,7 +5402,7 @@ func (m *MsgCreateTeamVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Commencement |= uint64(b&0x7F) << shift
+				m.Amount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2216,7 +5428,7 @@ func (m *MsgCreateTeamVestingAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateTeamVestingAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUndelegateTeamTokensResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2239,10 +5451,10 @@ func (m *MsgCreateTeamVestingAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateTeamVestingAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUndelegateTeamTokensResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateTeamVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUndelegateTeamTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 	"strconv"
 	"time"
 
@@ -14,6 +15,8 @@ type VestingPlan struct {
 
 	ClawbackAmount uint64
 
+	PartialClawbackAmount uint64
+
 	TokenVestingStart uint64
 
 	TokenVestingFinished uint64
@@ -40,6 +43,72 @@ type VestingStatus struct {
 	RemainingUnvestedAmount uint64
 }
 
+// DefaultVestingSchedule returns the standard team vesting schedule which is
+// used for all accounts without a schedule.
+func DefaultVestingSchedule() VestingSchedule {
+	return VestingSchedule{
+		CliffDuration:   CLIFF_DURATION,
+		VestingDuration: VESTING_DURATION,
+		UnlockDuration:  UNLOCK_DURATION,
+	}
+}
+
+// GetVestingSchedule returns the schedule of the account or the default
+// schedule if the account has none.
+func (m *TeamVestingAccount) GetVestingSchedule() VestingSchedule {
+	if m.Schedule == nil {
+		return DefaultVestingSchedule()
+	}
+
+	return *m.Schedule
+}
+
+// Validate checks that the schedule is well-defined
+func (m *VestingSchedule) Validate() error {
+	if m.VestingDuration == 0 {
+		return errors.New("vesting duration must be greater than zero")
+	}
+
+	if m.UnlockDuration == 0 {
+		return errors.New("unlock duration must be greater than zero")
+	}
+
+	if m.CliffDuration > m.VestingDuration {
+		return errors.New("cliff duration must not be greater than the vesting duration")
+	}
+
+	return nil
+}
+
+// DefaultTeamAuthorities returns the team authorities which were injected
+// through the linker flags.
+func DefaultTeamAuthorities() TeamAuthorities {
+	return TeamAuthorities{
+		Foundation: FOUNDATION_ADDRESS,
+		Bcp:        BCP_ADDRESS,
+	}
+}
+
+// Validate checks that both authorities are valid KYVE addresses
+func (m *TeamAuthorities) Validate() error {
+	for _, address := range []string{m.Foundation, m.Bcp} {
+		prefix, _, err := bech32.DecodeAndConvert(address)
+		if err != nil {
+			return err
+		}
+		if prefix != "kyve" {
+			return fmt.Errorf("team authority %v is not a KYVE address", address)
+		}
+	}
+
+	return nil
+}
+
+// IsAuthority returns true if the address is either the foundation or the bcp authority
+func (m *TeamAuthorities) IsAuthority(address string) bool {
+	return m.Foundation == address || m.Bcp == address
+}
+
 var (
 	TEAM_FOUNDATION_STRING = "kyve1u7ukf2nv6v5j5y2yqprm8yqruue2rlmrkx4xgq"
 	TEAM_BCP_STRING        = "kyve1ruxaec07ca3dh0amkzxjap7av3xjt5vjgnd424"
@@ -83,3 +152,13 @@ func init() {
 	}
 	TGE = uint64(tge.Unix())
 }
+
+// GetPartialClawbackAmount returns the total amount removed from the allocation
+// by partial clawbacks.
+func (m *TeamVestingAccount) GetPartialClawbackAmount() (amount uint64) {
+	for _, partialClawback := range m.PartialClawbacks {
+		amount += partialClawback.Amount
+	}
+
+	return
+}